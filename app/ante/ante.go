@@ -15,6 +15,16 @@ import (
 // transaction-level processing (e.g. fee payment, signature verification) before
 // being passed onto it's respective handler.
 func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
+	anteHandler := newRoutingAnteHandler(options)
+	if options.PriorityLane != nil {
+		return options.PriorityLane.AnteHandler(anteHandler)
+	}
+	return anteHandler
+}
+
+// newRoutingAnteHandler returns the ante handler routing the txs to the
+// Ethereum or SDK ante handler.
+func newRoutingAnteHandler(options HandlerOptions) sdk.AnteHandler {
 	return func(
 		ctx sdk.Context, tx sdk.Tx, sim bool,
 	) (newCtx sdk.Context, err error) {
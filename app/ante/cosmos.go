@@ -26,9 +26,15 @@ func newCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		cosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
+		cosmosante.NewFeeConverterDecorator(options.FeeMarketKeeper, options.EvmKeeper), // swap fees paid in an alternate denom
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		cosmosante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.DistributionKeeper, options.FeegrantKeeper, options.StakingKeeper, options.TxFeeChecker),
+		cosmosante.NewFaucetFeeExemptionDecorator( // skip the fees of the faucet claims of the accounts without balance
+			options.FaucetKeeper, options.BankKeeper,
+			cosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
+			cosmosante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.DistributionKeeper, options.FeegrantKeeper, options.StakingKeeper, options.TxFeeChecker),
+			cosmosante.NewMinPriorityFeeDecorator(options.EvmKeeper, options.MempoolMinPriorityFee), // local mempool policy, CheckTx only
+		),
+		cosmosante.RestoreConvertedFeeTxDecorator{}, // the signatures are verified against the original tx
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
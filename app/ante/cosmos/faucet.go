@@ -0,0 +1,102 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package cosmos
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	faucettypes "github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+// MaxFaucetClaimGas is the maximum gas limit of a fee-less faucet claim.
+const MaxFaucetClaimGas = 200_000
+
+// FaucetFeeExemptionDecorator lets the accounts without balance claim funds
+// from the faucet without paying fees, when enabled by the faucet params. The
+// fee-less claims skip the wrapped fee decorators, while the other txs go
+// through them as usual.
+//
+// A tx is fee-less if it holds a single faucet claim paying its fee payer, sets
+// a zero fee without fee granter, and its fee payer has no balance. The claim
+// is validated upfront, so that the txs that would fail on delivery are
+// rejected without entering the mempool.
+type FaucetFeeExemptionDecorator struct {
+	faucetKeeper  FaucetKeeper
+	bankKeeper    FaucetBankKeeper
+	feeDecorators []sdk.AnteDecorator
+}
+
+// FaucetBankKeeper defines the exposed interface of the bank keeper needed to
+// check the balance of the faucet claimers.
+type FaucetBankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// NewFaucetFeeExemptionDecorator creates a new FaucetFeeExemptionDecorator
+// wrapping the given fee decorators. The fees are never exempted if the faucet
+// keeper is nil.
+func NewFaucetFeeExemptionDecorator(fk FaucetKeeper, bk FaucetBankKeeper, feeDecorators ...sdk.AnteDecorator) FaucetFeeExemptionDecorator {
+	return FaucetFeeExemptionDecorator{
+		faucetKeeper:  fk,
+		bankKeeper:    bk,
+		feeDecorators: feeDecorators,
+	}
+}
+
+func (fed FaucetFeeExemptionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if claimer, ok := fed.feeLessClaimer(ctx, tx); ok {
+		if err := fed.faucetKeeper.ValidateClaim(ctx, claimer); err != nil {
+			return ctx, err
+		}
+		return next(ctx, tx, simulate)
+	}
+
+	handler := next
+	for i := len(fed.feeDecorators) - 1; i >= 0; i-- {
+		decorator, inner := fed.feeDecorators[i], handler
+		handler = func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return decorator.AnteHandle(ctx, tx, simulate, inner)
+		}
+	}
+	return handler(ctx, tx, simulate)
+}
+
+// feeLessClaimer returns the address of the account claiming funds from the
+// faucet, and true if the tx is eligible to the fee exemption.
+func (fed FaucetFeeExemptionDecorator) feeLessClaimer(ctx sdk.Context, tx sdk.Tx) (sdk.AccAddress, bool) {
+	if fed.faucetKeeper == nil {
+		return nil, false
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !feeTx.GetFee().IsZero() || feeTx.FeeGranter() != nil || feeTx.GetGas() > MaxFaucetClaimGas {
+		return nil, false
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, false
+	}
+
+	msg, ok := msgs[0].(*faucettypes.MsgRequestFunds)
+	if !ok {
+		return nil, false
+	}
+
+	params := fed.faucetKeeper.GetParams(ctx)
+	if !params.EnableFaucet || !params.EnableFeeExemption {
+		return nil, false
+	}
+
+	claimer, err := faucettypes.ParseAddress(msg.Address)
+	if err != nil || !claimer.Equals(feeTx.FeePayer()) {
+		return nil, false
+	}
+
+	if !fed.bankKeeper.GetAllBalances(ctx, claimer).IsZero() {
+		return nil, false
+	}
+
+	return claimer, true
+}
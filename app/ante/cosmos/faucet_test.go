@@ -0,0 +1,114 @@
+package cosmos_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cosmosante "github.com/evmos/evmos/v19/app/ante/cosmos"
+	"github.com/evmos/evmos/v19/testutil"
+	testutiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/utils"
+	faucettypes "github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+func (suite *AnteTestSuite) TestFaucetFeeExemptionDecorator() {
+	// the signer of the suite is funded, so the claims are sent by a new account
+	addr, priv := testutiltx.NewAccAddressAndKey()
+	other := testutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func(params *faucettypes.Params, args *testutiltx.CosmosTxArgs)
+		errContains string
+	}{
+		{
+			"pass - fee-less claim of an account without balance",
+			func(*faucettypes.Params, *testutiltx.CosmosTxArgs) {},
+			"",
+		},
+		{
+			"pass - fee-less claim with a hex address",
+			func(_ *faucettypes.Params, args *testutiltx.CosmosTxArgs) {
+				args.Msgs = []sdk.Msg{faucettypes.NewMsgRequestFunds(addr, utils.CosmosToEthAddr(addr).Hex())}
+			},
+			"",
+		},
+		{
+			"fail - faucet disabled",
+			func(params *faucettypes.Params, _ *testutiltx.CosmosTxArgs) {
+				params.EnableFaucet = false
+			},
+			"insufficient fee",
+		},
+		{
+			"fail - fee exemption disabled",
+			func(params *faucettypes.Params, _ *testutiltx.CosmosTxArgs) {
+				params.EnableFeeExemption = false
+			},
+			"insufficient fee",
+		},
+		{
+			"fail - claim paying another address",
+			func(_ *faucettypes.Params, args *testutiltx.CosmosTxArgs) {
+				args.Msgs = []sdk.Msg{faucettypes.NewMsgRequestFunds(addr, other.Hex())}
+			},
+			"insufficient fee",
+		},
+		{
+			"fail - gas above the fee-less limit",
+			func(_ *faucettypes.Params, args *testutiltx.CosmosTxArgs) {
+				args.Gas = cosmosante.MaxFaucetClaimGas + 1
+			},
+			"insufficient fee",
+		},
+		{
+			"fail - claimer with balance",
+			func(*faucettypes.Params, *testutiltx.CosmosTxArgs) {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(1))))
+				suite.Require().NoError(err)
+			},
+			"insufficient fee",
+		},
+		{
+			"fail - cooldown not elapsed",
+			func(*faucettypes.Params, *testutiltx.CosmosTxArgs) {
+				suite.app.FaucetKeeper.SetClaimHeight(suite.ctx, addr, suite.ctx.BlockHeight())
+			},
+			"cooldown",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+
+			params := faucettypes.DefaultParams()
+			params.EnableFaucet = true
+			args := testutiltx.CosmosTxArgs{
+				TxCfg:   suite.clientCtx.TxConfig,
+				Priv:    priv,
+				ChainID: suite.ctx.ChainID(),
+				Gas:     cosmosante.MaxFaucetClaimGas,
+				Fees:    sdk.Coins{},
+				Msgs:    []sdk.Msg{faucettypes.NewMsgRequestFunds(addr, addr.String())},
+			}
+			tc.malleate(&params, &args)
+
+			suite.Require().NoError(suite.app.FaucetKeeper.SetParams(suite.ctx, params))
+			err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, faucettypes.ModuleName, params.Amount)
+			suite.Require().NoError(err)
+
+			tx, err := testutiltx.PrepareCosmosTx(suite.ctx, suite.app, args)
+			suite.Require().NoError(err)
+
+			// the validator minimum gas prices are only enforced on CheckTx
+			_, err = suite.anteHandler(suite.ctx.WithIsCheckTx(true), tx, false)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package cosmos
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	evmante "github.com/evmos/evmos/v19/app/ante/evm"
+)
+
+// FeeConverterDecorator swaps the fee of a Cosmos tx paid in one of the
+// alternate denominations accepted by the fee market for its value in the EVM
+// denomination, at the current conversion rate, using the fee conversion
+// pool. The tx is rejected if the pool lacks the depth to cover the
+// converted fee. The following decorators see the converted fee, so that the
+// minimum gas price check and the fee deduction proceed as usual.
+//
+// Txs paying their fee in the EVM denomination are passed through unchanged.
+//
+// CONTRACT: the decorator must be followed by a RestoreConvertedFeeTxDecorator
+// placed after the fee deduction.
+type FeeConverterDecorator struct {
+	feesKeeper evmante.FeeMarketKeeper
+	evmKeeper  evmante.EVMKeeper
+}
+
+// NewFeeConverterDecorator creates a new FeeConverterDecorator instance used
+// only for Cosmos transactions.
+func NewFeeConverterDecorator(fk evmante.FeeMarketKeeper, ek evmante.EVMKeeper) FeeConverterDecorator {
+	return FeeConverterDecorator{feesKeeper: fk, evmKeeper: ek}
+}
+
+func (fcd FeeConverterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	fee := sigTx.GetFee()
+	if len(fee) != 1 || fee[0].IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	if _, found := fcd.feesKeeper.FeeConversionRate(ctx, fee[0].Denom); !found {
+		return next(ctx, tx, simulate)
+	}
+
+	if sigTx.FeeGranter() != nil {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"fee grants are not supported for fees paid in %s", fee[0].Denom,
+		)
+	}
+
+	evmParams := fcd.evmKeeper.GetParams(ctx)
+	converted, err := fcd.feesKeeper.ConvertFee(ctx, sigTx.FeePayer(), fee[0], evmParams.GetEvmDenom())
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, convertedFeeTx{Tx: sigTx, fee: sdk.Coins{converted}}, simulate)
+}
+
+// RestoreConvertedFeeTxDecorator passes the tx wrapped by the
+// FeeConverterDecorator back to the following decorators, as the signatures
+// of the tx commit to the fee paid in the alternate denomination.
+type RestoreConvertedFeeTxDecorator struct{}
+
+func (RestoreConvertedFeeTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if wrapped, ok := tx.(convertedFeeTx); ok {
+		tx = wrapped.Tx
+	}
+	return next(ctx, tx, simulate)
+}
+
+// convertedFeeTx overrides the fee of a tx with the converted fee.
+type convertedFeeTx struct {
+	authsigning.Tx
+	fee sdk.Coins
+}
+
+// GetFee returns the converted fee.
+func (tx convertedFeeTx) GetFee() sdk.Coins {
+	return tx.fee
+}
+
+// GetExtensionOptions returns the extension options of the wrapped tx, if any.
+func (tx convertedFeeTx) GetExtensionOptions() []*codectypes.Any {
+	if extTx, ok := tx.Tx.(authante.HasExtensionOptionsTx); ok {
+		return extTx.GetExtensionOptions()
+	}
+	return nil
+}
+
+// GetNonCriticalExtensionOptions returns the non critical extension options of
+// the wrapped tx, if any.
+func (tx convertedFeeTx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	if extTx, ok := tx.Tx.(authante.HasExtensionOptionsTx); ok {
+		return extTx.GetNonCriticalExtensionOptions()
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package cosmos_test
+
+import (
+	"cosmossdk.io/math"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/evmos/evmos/v19/testutil"
+	testutiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/utils"
+	erc20types "github.com/evmos/evmos/v19/x/erc20/types"
+	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
+)
+
+func (suite *AnteTestSuite) TestFeeConverterDecorator() {
+	const (
+		altDenom = "ibc/usdc"
+		gas      = 200_000
+	)
+
+	var (
+		grantee, _ = testutiltx.NewAccAddressAndKey()
+		// the converted fee covers the base fee at the initial rate
+		altFee = sdk.NewCoin(altDenom, math.NewInt(1e14))
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func(args *testutiltx.CosmosTxArgs)
+		poolBalance math.Int
+		expConverts []math.Int
+		errContains string
+	}{
+		{
+			"pass - fee in the EVM denom is not converted",
+			func(args *testutiltx.CosmosTxArgs) {
+				args.Fees = sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(2e14)))
+			},
+			math.NewInt(1e15),
+			[]math.Int{math.ZeroInt()},
+			"",
+		},
+		{
+			"fail - pool lacks depth",
+			func(*testutiltx.CosmosTxArgs) {},
+			math.NewInt(2e14 - 1),
+			nil,
+			"lacks depth",
+		},
+		{
+			"fail - fee granter",
+			func(args *testutiltx.CosmosTxArgs) {
+				args.FeeGranter = grantee
+			},
+			math.NewInt(1e15),
+			nil,
+			"fee grants are not supported",
+		},
+		{
+			"pass - pool exhausted by the first tx",
+			func(*testutiltx.CosmosTxArgs) {},
+			math.NewInt(3e14),
+			[]math.Int{math.NewInt(2e14)},
+			"lacks depth",
+		},
+		{
+			"pass - rate updated mid-block applies to the following txs",
+			func(*testutiltx.CosmosTxArgs) {},
+			math.NewInt(1e15),
+			[]math.Int{math.NewInt(2e14), math.NewInt(3e14)},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			addr := sdk.AccAddress(suite.priv.PubKey().Address())
+
+			suite.app.Erc20Keeper.SetToken(suite.ctx, erc20types.NewTokenPair(testutiltx.GenerateAddress(), altDenom, erc20types.OWNER_MODULE))
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.FeeConversions = []feemarkettypes.FeeConversion{{Denom: altDenom, Rate: math.LegacyNewDec(2)}}
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, feemarkettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, tc.poolBalance)))
+			suite.Require().NoError(err)
+			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, sdk.NewCoins(sdk.NewCoin(altDenom, math.NewInt(1e15))))
+			suite.Require().NoError(err)
+
+			args := testutiltx.CosmosTxArgs{
+				TxCfg:   suite.clientCtx.TxConfig,
+				Priv:    suite.priv,
+				ChainID: suite.ctx.ChainID(),
+				Gas:     gas,
+				Fees:    sdk.NewCoins(altFee),
+				Msgs:    []sdk.Msg{sdktestutil.NewTestMsg(addr)},
+			}
+			tc.malleate(&args)
+
+			poolAddr := suite.app.FeeMarketKeeper.FeeConversionPoolAddress()
+			for i, expConverted := range tc.expConverts {
+				if i == 1 {
+					_, err := suite.app.FeeMarketKeeper.UpdateFeeConversionRate(suite.ctx, &feemarkettypes.MsgUpdateFeeConversionRate{
+						Sender: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+						Denom:  altDenom,
+						Rate:   math.LegacyNewDec(3),
+					})
+					suite.Require().NoError(err)
+				}
+
+				tx, err := testutiltx.PrepareCosmosTx(suite.ctx, suite.app, args)
+				suite.Require().NoError(err)
+
+				poolBefore := suite.app.BankKeeper.GetBalance(suite.ctx, poolAddr, utils.BaseDenom)
+				evmBalanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, addr, utils.BaseDenom)
+
+				suite.ctx, err = suite.anteHandler(suite.ctx, tx, false)
+				suite.Require().NoError(err)
+
+				// converted fees are deducted from the pool, not the payer balance
+				suite.Require().Equal(evmBalanceBefore.Amount.Sub(args.Fees.AmountOf(utils.BaseDenom)), suite.app.BankKeeper.GetBalance(suite.ctx, addr, utils.BaseDenom).Amount)
+				suite.Require().Equal(poolBefore.Amount.Sub(expConverted), suite.app.BankKeeper.GetBalance(suite.ctx, poolAddr, utils.BaseDenom).Amount)
+			}
+
+			if tc.errContains == "" {
+				return
+			}
+
+			tx, err := testutiltx.PrepareCosmosTx(suite.ctx, suite.app, args)
+			suite.Require().NoError(err)
+
+			_, err = suite.anteHandler(suite.ctx, tx, false)
+			suite.Require().Error(err)
+			suite.Require().Contains(err.Error(), tc.errContains)
+		})
+	}
+}
@@ -3,7 +3,11 @@
 
 package cosmos
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	faucettypes "github.com/evmos/evmos/v19/x/faucet/types"
+)
 
 // BankKeeper defines the exposed interface for using functionality of the bank keeper
 // in the context of the cosmos AnteHandler package.
@@ -13,3 +17,10 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 }
+
+// FaucetKeeper defines the exposed interface of the faucet keeper needed to
+// exempt the faucet claims from the fees.
+type FaucetKeeper interface {
+	GetParams(ctx sdk.Context) faucettypes.Params
+	ValidateClaim(ctx sdk.Context, addr sdk.AccAddress) error
+}
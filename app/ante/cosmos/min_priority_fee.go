@@ -0,0 +1,72 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package cosmos
+
+import (
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	evmante "github.com/evmos/evmos/v19/app/ante/evm"
+	evmostypes "github.com/evmos/evmos/v19/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// MinPriorityFeeDecorator rejects the Cosmos txs whose effective tip per gas
+// is below the minimum priority fee of the local mempool. It is a local
+// mempool policy of the validator, so it only applies in CheckTx and the
+// blocks including lower tips are still valid.
+//
+// The tip of a tx is its fee per gas above the base fee, capped by the max
+// priority price of its ExtensionOptionDynamicFeeTx, if any. The txs are left
+// to the min-gas-prices of the validator when the base fee is disabled.
+type MinPriorityFeeDecorator struct {
+	evmKeeper      evmante.DynamicFeeEVMKeeper
+	minPriorityFee uint64
+}
+
+// NewMinPriorityFeeDecorator creates a new MinPriorityFeeDecorator. The check
+// is disabled if minPriorityFee is zero.
+func NewMinPriorityFeeDecorator(ek evmante.DynamicFeeEVMKeeper, minPriorityFee uint64) MinPriorityFeeDecorator {
+	return MinPriorityFeeDecorator{evmKeeper: ek, minPriorityFee: minPriorityFee}
+}
+
+func (mpd MinPriorityFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if mpd.minPriorityFee == 0 || !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
+	}
+
+	evmParams := mpd.evmKeeper.GetParams(ctx)
+	baseFee := mpd.evmKeeper.GetBaseFee(ctx, evmParams.ChainConfig.EthereumConfig(mpd.evmKeeper.ChainID()))
+	if baseFee == nil || feeTx.GetGas() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeCap := feeTx.GetFee().AmountOfNoDenomValidation(evmParams.EvmDenom).Quo(sdkmath.NewIntFromUint64(feeTx.GetGas()))
+	tipCap := sdkmath.NewInt(math.MaxInt64)
+	if hasExtOptsTx, ok := feeTx.(authante.HasExtensionOptionsTx); ok {
+		for _, opt := range hasExtOptsTx.GetExtensionOptions() {
+			if extOpt, ok := opt.GetCachedValue().(*evmostypes.ExtensionOptionDynamicFeeTx); ok {
+				tipCap = extOpt.MaxPriorityPrice
+				break
+			}
+		}
+	}
+
+	effectivePrice := evmtypes.EffectiveGasPrice(baseFee, feeCap.BigInt(), tipCap.BigInt())
+	if err := evmante.CheckMempoolPriorityFee(new(big.Int).Sub(effectivePrice, baseFee), mpd.minPriorityFee); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,75 @@
+package cosmos_test
+
+import (
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	cosmosante "github.com/evmos/evmos/v19/app/ante/cosmos"
+	"github.com/evmos/evmos/v19/testutil"
+	"github.com/evmos/evmos/v19/types"
+	"github.com/evmos/evmos/v19/utils"
+)
+
+func (suite *AnteTestSuite) TestMinPriorityFeeDecorator() {
+	suite.enableFeemarket, suite.enableLondonHF = true, true
+	defer func() { suite.enableFeemarket, suite.enableLondonHF = false, false }()
+	suite.SetupTest()
+
+	const minPriorityFee = 1000
+	baseFee := math.NewIntFromBigInt(suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx))
+	suite.Require().True(baseFee.IsPositive())
+
+	testMsg := banktypes.MsgSend{
+		FromAddress: "evmos1x8fhpj9nmhqk8z9kpgjt95ck2xwyue0ptzkucp",
+		ToAddress:   "evmos1dx67l23hz9l0k9hcher8xz04uj7wf3yu26l2yn",
+		Amount:      sdk.Coins{sdk.Coin{Amount: math.NewInt(10), Denom: utils.BaseDenom}},
+	}
+
+	// buildTx returns a tx paying the given tip on top of the base fee, capped by
+	// the max priority price of a dynamic fee extension option if not nil
+	buildTx := func(tip int64, maxPriorityPrice *math.Int) sdk.Tx {
+		txBuilder := suite.CreateTestCosmosTxBuilder(baseFee.AddRaw(tip), utils.BaseDenom, &testMsg)
+		if maxPriorityPrice != nil {
+			option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionDynamicFeeTx{MaxPriorityPrice: *maxPriorityPrice})
+			suite.Require().NoError(err)
+			txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(option)
+		}
+		return txBuilder.GetTx()
+	}
+	lowTipCap := math.NewInt(minPriorityFee - 1)
+
+	testCases := []struct {
+		name     string
+		tx       sdk.Tx
+		minFee   uint64
+		checkTx  bool
+		simulate bool
+		expPass  bool
+	}{
+		{"tip above the minimum", buildTx(minPriorityFee, nil), minPriorityFee, true, false, true},
+		{"tip below the minimum", buildTx(minPriorityFee-1, nil), minPriorityFee, true, false, false},
+		{"tip capped below the minimum", buildTx(2*minPriorityFee, &lowTipCap), minPriorityFee, true, false, false},
+		{"tip below the minimum on simulation", buildTx(minPriorityFee-1, nil), minPriorityFee, true, true, true},
+		{"tip below the minimum on delivery", buildTx(minPriorityFee-1, nil), minPriorityFee, false, false, true},
+		{"tip below the disabled minimum", buildTx(0, nil), 0, true, false, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			dec := cosmosante.NewMinPriorityFeeDecorator(suite.app.EvmKeeper, tc.minFee)
+			ctx := suite.ctx.WithIsCheckTx(tc.checkTx)
+
+			_, err := dec.AnteHandle(ctx, tc.tx, tc.simulate, testutil.NextFn)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, errortypes.ErrInsufficientFee)
+				suite.Require().Contains(err.Error(), "priority fee below the minimum of the local mempool")
+			}
+		})
+	}
+}
@@ -119,6 +119,7 @@ func (suite *AnteTestSuite) SetupTest() {
 		StakingKeeper:          suite.app.StakingKeeper,
 		IBCKeeper:              suite.app.IBCKeeper,
 		FeeMarketKeeper:        suite.app.FeeMarketKeeper,
+		FaucetKeeper:           suite.app.FaucetKeeper,
 		SignModeHandler:        encodingConfig.TxConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		ExtensionOptionChecker: types.HasDynamicFeeExtensionOption,
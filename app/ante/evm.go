@@ -18,6 +18,8 @@ func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			options.DistributionKeeper,
 			options.StakingKeeper,
 			options.MaxTxGasWanted,
+			options.TxReplacements,
+			options.MempoolMinPriorityFee,
 		),
 	)
 }
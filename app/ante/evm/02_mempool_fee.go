@@ -3,6 +3,8 @@
 package evm
 
 import (
+	"math/big"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -26,3 +28,23 @@ func CheckMempoolFee(fee, mempoolMinGasPrice, gasLimit sdkmath.LegacyDec, isLond
 
 	return nil
 }
+
+// CheckMempoolPriorityFee checks if the effective tip per gas of the tx is at
+// least as large as the local validator's minimum priority fee. The check is
+// disabled when the minimum is zero.
+func CheckMempoolPriorityFee(tip *big.Int, mempoolMinPriorityFee uint64) error {
+	if mempoolMinPriorityFee == 0 {
+		return nil
+	}
+
+	minPriorityFee := new(big.Int).SetUint64(mempoolMinPriorityFee)
+	if tip.Cmp(minPriorityFee) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"priority fee below the minimum of the local mempool; got: %s required: %s",
+			tip, minPriorityFee,
+		)
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 // It checks the following requirements:
 // - nil MUST be passed as the from address
 // - If the transaction is a contract creation or call, the corresponding operation must be enabled in the EVM parameters
+// - The transaction data must not exceed the calldata size limit of the EVM parameters
 func ValidateMsg(
 	evmParams evmtypes.Params,
 	txData evmtypes.TxData,
@@ -26,6 +27,10 @@ func ValidateMsg(
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid from address; expected nil; got: %q", from.String())
 	}
 
+	if err := evmtypes.ValidateCalldataSize(txData.GetData(), evmParams.MaxCalldataBytes); err != nil {
+		return err
+	}
+
 	return checkDisabledCreateCall(
 		txData,
 		&evmParams.AccessControl,
@@ -164,6 +164,41 @@ func (suite *EvmAnteTestSuite) TestValidateMsg() {
 				params := evmtypes.DefaultParams()
 				params.AccessControl.Create.AccessType = evmtypes.AccessTypeRestricted
 
+				return validateMsgParams{
+					evmParams: params,
+					txData:    txData,
+					from:      nil,
+				}
+			},
+		},
+		{
+			name:          "fail: call with data above the calldata limit",
+			expectedError: evmtypes.ErrOversizedData,
+			getFunctionParams: func() validateMsgParams {
+				txArgs := getTxByType("call", keyring.GetAddr(1))
+				txArgs.Input = make([]byte, evmtypes.DefaultMaxCalldataBytes+1)
+				txData, err := txArgs.ToTxData()
+				suite.Require().NoError(err)
+
+				return validateMsgParams{
+					evmParams: evmtypes.DefaultParams(),
+					txData:    txData,
+					from:      nil,
+				}
+			},
+		},
+		{
+			name:          "success: call with data above the default limit and no calldata limit",
+			expectedError: nil,
+			getFunctionParams: func() validateMsgParams {
+				txArgs := getTxByType("call", keyring.GetAddr(1))
+				txArgs.Input = make([]byte, evmtypes.DefaultMaxCalldataBytes+1)
+				txData, err := txArgs.ToTxData()
+				suite.Require().NoError(err)
+
+				params := evmtypes.DefaultParams()
+				params.MaxCalldataBytes = 0
+
 				return validateMsgParams{
 					evmParams: params,
 					txData:    txData,
@@ -62,10 +62,8 @@ func SignatureVerification(
 ) error {
 	ethTx := msg.AsTransaction()
 
-	if !allowUnprotectedTxs && !ethTx.Protected() {
-		return errorsmod.Wrapf(
-			errortypes.ErrNotSupported,
-			"rejected unprotected Ethereum transaction. Please EIP155 sign your transaction to protect it against replay-attacks")
+	if err := msg.ValidateReplayProtection(allowUnprotectedTxs); err != nil {
+		return err
 	}
 
 	sender, err := signer.Sender(ethTx)
@@ -81,3 +79,10 @@ func SignatureVerification(
 	msg.From = sender.Hex()
 	return nil
 }
+
+// IsUnsignedSimulation returns true if the message is an unsigned transaction
+// submitted for simulation, which defines the sender on its from field
+// instead of recovering it from the signature.
+func IsUnsignedSimulation(msg *evmtypes.MsgEthereumTx, simulate bool) bool {
+	return simulate && msg.From != "" && msg.IsUnsigned()
+}
@@ -17,13 +17,15 @@ import (
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This method will fail if:
 // - from address is NOT an EOA
-// - account balance is lower than the transaction cost
+// - account balance is lower than the transaction cost, which is only the
+// transaction value if its fees are sponsored by a paymaster
 func VerifyAccountBalance(
 	ctx sdk.Context,
 	accountKeeper evmtypes.AccountKeeper,
 	account *statedb.Account,
 	from common.Address,
 	txData evmtypes.TxData,
+	sponsored bool,
 ) error {
 	// check whether the sender address is EOA
 	if account != nil && account.IsContract() {
@@ -39,7 +41,11 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
+	checkBalance := keeper.CheckSenderBalance
+	if sponsored {
+		checkBalance = keeper.CheckSponsoredSenderBalance
+	}
+	if err := checkBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
 
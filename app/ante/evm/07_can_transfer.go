@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
 	"github.com/evmos/evmos/v19/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
@@ -55,5 +56,17 @@ func CanTransfer(
 		)
 	}
 
+	// reject the topmost value transfers to the module accounts that cannot
+	// receive them, as the EVM would
+	if msg.To() != nil && msg.Value().Sign() > 0 &&
+		evm.Context.IsBlockedRecipient != nil && evm.Context.IsBlockedRecipient(*msg.To()) {
+		return errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"%s: %s",
+			vm.ErrModuleAccountRecipient,
+			msg.To(),
+		)
+	}
+
 	return nil
 }
@@ -8,13 +8,17 @@ import (
 
 	"cosmossdk.io/math"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v19/app/ante/evm"
 	"github.com/evmos/evmos/v19/testutil/integration/evmos/factory"
 	"github.com/evmos/evmos/v19/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v19/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v19/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	faucettypes "github.com/evmos/evmos/v19/x/faucet/types"
 )
 
 func (suite *EvmAnteTestSuite) TestCanTransfer() {
@@ -51,6 +55,34 @@ func (suite *EvmAnteTestSuite) TestCanTransfer() {
 				txArgs.Amount = invalidAmount
 			},
 		},
+		{
+			name:          "fail: value transfer to a blocked module account",
+			expectedError: vm.ErrModuleAccountRecipient,
+			isLondon:      true,
+			malleate: func(txArgs *evmtypes.EvmTxArgs) {
+				to := common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+				txArgs.To = &to
+			},
+		},
+		{
+			name:          "success: value transfer to an allowed module account",
+			expectedError: nil,
+			isLondon:      true,
+			malleate: func(txArgs *evmtypes.EvmTxArgs) {
+				to := common.BytesToAddress(authtypes.NewModuleAddress(faucettypes.ModuleName))
+				txArgs.To = &to
+			},
+		},
+		{
+			name:          "success: zero value call to a blocked module account",
+			expectedError: nil,
+			isLondon:      true,
+			malleate: func(txArgs *evmtypes.EvmTxArgs) {
+				to := common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+				txArgs.To = &to
+				txArgs.Amount = big.NewInt(0)
+			},
+		},
 		{
 			name:          "success: valid tx and sufficient balance",
 			expectedError: nil,
@@ -42,18 +42,18 @@ type ConsumeGasKeepers struct {
 	Staking      anteutils.StakingKeeper
 }
 
-// ConsumeFeesAndEmitEvent deduces fees from sender and emits the event
+// ConsumeFeesAndEmitEvent deduces fees from the fee payer and emits the event
 func ConsumeFeesAndEmitEvent(
 	ctx sdktypes.Context,
 	keepers *ConsumeGasKeepers,
 	fees sdktypes.Coins,
-	from sdktypes.AccAddress,
+	feePayer sdktypes.AccAddress,
 ) error {
 	if err := deductFees(
 		ctx,
 		keepers,
 		fees,
-		from,
+		feePayer,
 	); err != nil {
 		return err
 	}
@@ -62,6 +62,7 @@ func ConsumeFeesAndEmitEvent(
 		sdktypes.NewEvent(
 			sdktypes.EventTypeTx,
 			sdktypes.NewAttribute(sdktypes.AttributeKeyFee, fees.String()),
+			sdktypes.NewAttribute(sdktypes.AttributeKeyFeePayer, feePayer.String()),
 		),
 	)
 	return nil
@@ -176,6 +176,7 @@ func (suite *EvmAnteTestSuite) TestConsumeGasAndEmitEvent() {
 				expectedEvent := sdktypes.NewEvent(
 					sdktypes.EventTypeTx,
 					sdktypes.NewAttribute(sdktypes.AttributeKeyFee, tc.fees.String()),
+					sdktypes.NewAttribute(sdktypes.AttributeKeyFeePayer, sender.String()),
 				)
 				// Check events are present
 				events := unitNetwork.GetContext().EventManager().Events()
@@ -0,0 +1,95 @@
+package evm_test
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestAnteHandlerAccessPolicy() {
+	var (
+		sender, privKey = utiltx.NewAddrKey()
+		contract        = utiltx.GenerateAddress()
+		other           = utiltx.GenerateAddress()
+		code            = common.FromHex("0x600160005260206000f3")
+	)
+
+	testCases := []struct {
+		name          string
+		accessControl evmtypes.AccessControl
+		policy        evmtypes.AccessPolicy
+		to            *common.Address
+		expErr        error
+	}{
+		{"deployment without policy", evmtypes.AccessControl{}, evmtypes.AccessPolicy{}, nil, nil},
+		{"deployment by an allowed deployer", evmtypes.AccessControl{}, evmtypes.AccessPolicy{DeployerAllowlist: []string{sender.Hex()}}, nil, nil},
+		{"deployment by a deployer not allowed", evmtypes.AccessControl{}, evmtypes.AccessPolicy{DeployerAllowlist: []string{other.Hex()}}, nil, evmtypes.ErrAccessPolicyViolation},
+		{"deployment by a denied deployer", evmtypes.AccessControl{}, evmtypes.AccessPolicy{DeployerDenylist: []string{sender.Hex()}}, nil, evmtypes.ErrAccessPolicyViolation},
+		{"call to an allowed contract", evmtypes.AccessControl{}, evmtypes.AccessPolicy{ContractAllowlist: []string{contract.Hex()}}, &contract, nil},
+		{"call to a contract not allowed", evmtypes.AccessControl{}, evmtypes.AccessPolicy{ContractAllowlist: []string{other.Hex()}}, &contract, evmtypes.ErrAccessPolicyViolation},
+		{"call to a denied contract", evmtypes.AccessControl{}, evmtypes.AccessPolicy{ContractDenylist: []string{contract.Hex()}}, &contract, evmtypes.ErrAccessPolicyViolation},
+		// both sources are enforced, the access control params first
+		{
+			"deployment by an allowed deployer while the creations are disabled",
+			evmtypes.AccessControl{Create: evmtypes.AccessControlType{AccessType: evmtypes.AccessTypeRestricted}},
+			evmtypes.AccessPolicy{DeployerAllowlist: []string{sender.Hex()}},
+			nil,
+			evmtypes.ErrCreateDisabled,
+		},
+		{
+			"call to an allowed contract while the calls are disabled",
+			evmtypes.AccessControl{Call: evmtypes.AccessControlType{AccessType: evmtypes.AccessTypeRestricted}},
+			evmtypes.AccessPolicy{ContractAllowlist: []string{contract.Hex()}},
+			&contract,
+			evmtypes.ErrCallDisabled,
+		},
+		{
+			"call to a denied contract while the calls are allowed",
+			evmtypes.AccessControl{Call: evmtypes.AccessControlType{AccessType: evmtypes.AccessTypePermissionless}},
+			evmtypes.AccessPolicy{ContractDenylist: []string{contract.Hex()}},
+			&contract,
+			evmtypes.ErrAccessPolicyViolation,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			codeHash := crypto.Keccak256(code)
+			suite.app.EvmKeeper.SetCode(suite.ctx, codeHash, code)
+			suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, contract, statedb.Account{Balance: big.NewInt(0), CodeHash: codeHash}))
+			suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, sender, statedb.Account{Balance: big.NewInt(1e18), CodeHash: evmtypes.EmptyCodeHash}))
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.AccessControl = tc.accessControl
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+			suite.Require().NoError(suite.app.EvmKeeper.SetAccessPolicy(suite.ctx, tc.policy))
+
+			signedTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				ChainID:   suite.app.EvmKeeper.ChainID(),
+				To:        tc.to,
+				GasLimit:  100000,
+				GasFeeCap: big.NewInt(2 * ethparams.InitialBaseFee),
+				GasTipCap: big.NewInt(1),
+				Input:     code,
+			})
+			signedTx.From = sender.Hex()
+			tx := suite.CreateTestTx(signedTx, privKey, 1, false)
+
+			ctx, _ := suite.ctx.CacheContext()
+			_, err := suite.anteHandler(ctx, tx, false)
+			if tc.expErr != nil {
+				suite.Require().Error(err)
+				suite.Require().True(errors.Is(err, tc.expErr), err.Error())
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
@@ -1264,3 +1264,140 @@ func (suite *AnteTestSuite) TestAnteHandlerWithParams() {
 	}
 	suite.evmParamsOption = nil
 }
+
+func (suite *AnteTestSuite) TestAnteHandlerTxSizeLimit() {
+	addr, privKey := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+
+	ethTxParams := &evmtypes.EvmTxArgs{
+		ChainID:   suite.app.EvmKeeper.ChainID(),
+		Nonce:     1,
+		Amount:    big.NewInt(10),
+		GasLimit:  100000,
+		GasFeeCap: big.NewInt(ethparams.InitialBaseFee + 1),
+		GasTipCap: big.NewInt(1),
+		Accesses:  &types.AccessList{},
+		Input:     []byte("call bytes"),
+		To:        &to,
+	}
+
+	testCases := []struct {
+		name       string
+		maxTxBytes func(txSize int) uint64
+		expErr     error
+	}{
+		{
+			"fail - tx bytes above the limit",
+			func(txSize int) uint64 { return uint64(txSize - 1) },
+			evmtypes.ErrOversizedData,
+		},
+		{
+			"success - tx bytes at the limit",
+			func(txSize int) uint64 { return uint64(txSize) },
+			nil,
+		},
+		{
+			"success - tx size limit disabled",
+			func(int) uint64 { return 0 },
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.evmParamsOption = nil
+			suite.SetupTest() // reset
+
+			signedTx := evmtypes.NewTx(ethTxParams)
+			signedTx.From = addr.Hex()
+			tx := suite.CreateTestTx(signedTx, privKey, 1, false)
+
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+			suite.Require().NoError(err)
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.MaxTxBytes = tc.maxTxBytes(len(txBytes))
+			params.MaxCalldataBytes = 0
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+			suite.ctx = suite.ctx.WithIsCheckTx(true).WithTxBytes(txBytes)
+			err = suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt((ethparams.InitialBaseFee+10)*100000))
+			suite.Require().NoError(err)
+
+			_, err = suite.anteHandler(suite.ctx, tx, false)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(errors.Is(err, tc.expErr))
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerUnsignedSimulation() {
+	addr := utiltx.GenerateAddress()
+	to := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		simulate bool
+		expPass  bool
+	}{
+		{
+			"fail - unsigned tx outside of a simulation",
+			false,
+			false,
+		},
+		{
+			"success - unsigned tx on a simulation",
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.evmParamsOption = nil
+			suite.SetupTest() // reset
+
+			msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				ChainID:   suite.app.EvmKeeper.ChainID(),
+				Nonce:     1,
+				Amount:    big.NewInt(10),
+				GasLimit:  100000,
+				GasFeeCap: big.NewInt(ethparams.InitialBaseFee + 1),
+				GasTipCap: big.NewInt(1),
+				Accesses:  &types.AccessList{},
+				To:        &to,
+			})
+
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			tx, err := msg.BuildTx(txBuilder, evmtypes.DefaultEVMDenom)
+			suite.Require().NoError(err)
+
+			// BuildTx clears the from field, which defines the sender of unsigned txs
+			msg.From = addr.Hex()
+			suite.Require().NoError(txBuilder.SetMsgs(msg))
+			tx = txBuilder.GetTx()
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+			suite.ctx = suite.ctx.WithIsCheckTx(true)
+			err = suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt((ethparams.InitialBaseFee+10)*100000))
+			suite.Require().NoError(err)
+
+			_, err = suite.anteHandler(suite.ctx, tx, tc.simulate)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
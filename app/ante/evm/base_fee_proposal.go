@@ -0,0 +1,228 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	"container/heap"
+	"math/big"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// BaseFeeProposalFilter excludes from the block proposals the EVM txs whose
+// fee cap is below the base fee of the proposed block.
+//
+// The base fee of a block is computed at its beginning from the gas wanted by
+// the previous block, so the EVM txs accepted into the mempool at a lower base
+// fee can no longer pay it when the fees rise, and would fail on delivery
+// while wasting the block space.
+type BaseFeeProposalFilter struct {
+	feeMarketKeeper ProposalFeeMarketKeeper
+	txDecoder       sdk.TxDecoder
+}
+
+// NewBaseFeeProposalFilter creates a new BaseFeeProposalFilter.
+func NewBaseFeeProposalFilter(feeMarketKeeper ProposalFeeMarketKeeper, txDecoder sdk.TxDecoder) *BaseFeeProposalFilter {
+	return &BaseFeeProposalFilter{
+		feeMarketKeeper: feeMarketKeeper,
+		txDecoder:       txDecoder,
+	}
+}
+
+// PrepareProposalHandler wraps the given handler to remove the EVM txs that
+// can't pay the base fee of the proposed block from the txs proposed by
+// CometBFT. The remaining EVM txs are reordered by effective tip, while the
+// txs of each sender are kept in nonce order and the other txs are left in
+// place.
+func (f *BaseFeeProposalFilter) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		// the base fee the feemarket module sets at the beginning of the block
+		baseFee := f.feeMarketKeeper.CalculateBaseFee(ctx)
+		if baseFee == nil {
+			return next(ctx, req)
+		}
+
+		var (
+			txs    = make([][]byte, 0, len(req.Txs))
+			ethTxs []proposalEthTx
+			// positions of the reordered EVM txs in the proposal
+			slots []int
+		)
+		for _, txBz := range req.Txs {
+			msgs := f.ethMsgs(txBz)
+			if isUnderpriced(msgs, baseFee) {
+				continue
+			}
+
+			if ethTx, ok := newProposalEthTx(txBz, msgs, len(txs), baseFee); ok {
+				slots = append(slots, len(txs))
+				ethTxs = append(ethTxs, ethTx)
+			}
+			txs = append(txs, txBz)
+		}
+
+		for i, ethTx := range orderByTipAndNonce(ethTxs) {
+			txs[slots[i]] = ethTx.bz
+		}
+
+		if excluded := len(req.Txs) - len(txs); excluded > 0 {
+			ctx.Logger().Debug("excluded underpriced EVM txs from the block proposal", "txs", excluded, "base_fee", baseFee)
+		}
+
+		req.Txs = txs
+		return next(ctx, req)
+	}
+}
+
+// ProcessProposalHandler wraps the given handler to reject the block proposals
+// including an EVM tx that can't pay the base fee of the block, if enabled by
+// the feemarket params.
+func (f *BaseFeeProposalFilter) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		if !f.feeMarketKeeper.GetParams(ctx).RejectUnderpricedProposals {
+			return next(ctx, req)
+		}
+
+		baseFee := f.feeMarketKeeper.CalculateBaseFee(ctx)
+		if baseFee == nil {
+			return next(ctx, req)
+		}
+
+		for _, txBz := range req.Txs {
+			if isUnderpriced(f.ethMsgs(txBz), baseFee) {
+				ctx.Logger().Info("rejected block proposal including an underpriced EVM tx", "height", req.Height, "base_fee", baseFee)
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// ethMsgs returns the EVM msgs of the given tx, if it can be decoded.
+func (f *BaseFeeProposalFilter) ethMsgs(txBz []byte) []*evmtypes.MsgEthereumTx {
+	tx, err := f.txDecoder(txBz)
+	if err != nil {
+		return nil
+	}
+
+	var msgs []*evmtypes.MsgEthereumTx
+	for _, msg := range tx.GetMsgs() {
+		if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			msgs = append(msgs, ethMsg)
+		}
+	}
+	return msgs
+}
+
+// isUnderpriced returns true if the fee cap of any of the given EVM msgs is
+// below the given base fee. The msgs with invalid tx data are left to the
+// ante handler.
+func isUnderpriced(msgs []*evmtypes.MsgEthereumTx, baseFee *big.Int) bool {
+	for _, msg := range msgs {
+		if tx := msg.AsTransaction(); tx != nil && tx.GasFeeCap().Cmp(baseFee) < 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// proposalEthTx is an EVM tx of a block proposal.
+type proposalEthTx struct {
+	bz     []byte
+	sender common.Address
+	nonce  uint64
+	tip    *big.Int
+	// position of the tx in the proposal, breaking the ties between tips
+	index int
+}
+
+// newProposalEthTx returns the proposalEthTx of the given tx, and false if it
+// is not a valid EVM tx holding a single msg.
+func newProposalEthTx(txBz []byte, msgs []*evmtypes.MsgEthereumTx, index int, baseFee *big.Int) (proposalEthTx, bool) {
+	if len(msgs) != 1 {
+		return proposalEthTx{}, false
+	}
+
+	tx := msgs[0].AsTransaction()
+	if tx == nil {
+		return proposalEthTx{}, false
+	}
+
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return proposalEthTx{}, false
+	}
+
+	return proposalEthTx{
+		bz:     txBz,
+		sender: sender,
+		nonce:  tx.Nonce(),
+		tip:    effectiveTip(tx.GasFeeCap(), tx.GasTipCap(), baseFee),
+		index:  index,
+	}, true
+}
+
+// orderByTipAndNonce orders the given EVM txs by decreasing effective tip,
+// while keeping the txs of each sender in nonce order, as geth does.
+func orderByTipAndNonce(txs []proposalEthTx) []proposalEthTx {
+	bySender := make(map[common.Address][]proposalEthTx)
+	for _, tx := range txs {
+		bySender[tx.sender] = append(bySender[tx.sender], tx)
+	}
+
+	heads := make(ethTxsByTip, 0, len(bySender))
+	for sender, senderTxs := range bySender {
+		sort.SliceStable(senderTxs, func(i, j int) bool {
+			return senderTxs[i].nonce < senderTxs[j].nonce
+		})
+		heads = append(heads, senderTxs[0])
+		bySender[sender] = senderTxs[1:]
+	}
+	heap.Init(&heads)
+
+	ordered := make([]proposalEthTx, 0, len(txs))
+	for heads.Len() > 0 {
+		head := heads[0]
+		ordered = append(ordered, head)
+
+		if senderTxs := bySender[head.sender]; len(senderTxs) > 0 {
+			heads[0], bySender[head.sender] = senderTxs[0], senderTxs[1:]
+			heap.Fix(&heads, 0)
+		} else {
+			heap.Pop(&heads)
+		}
+	}
+	return ordered
+}
+
+// ethTxsByTip is a heap of EVM txs ordered by decreasing effective tip, then
+// by position in the proposal.
+type ethTxsByTip []proposalEthTx
+
+func (h ethTxsByTip) Len() int { return len(h) }
+
+func (h ethTxsByTip) Less(i, j int) bool {
+	if cmp := h[i].tip.Cmp(h[j].tip); cmp != 0 {
+		return cmp > 0
+	}
+	return h[i].index < h[j].index
+}
+
+func (h ethTxsByTip) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *ethTxsByTip) Push(x any) { *h = append(*h, x.(proposalEthTx)) }
+
+func (h *ethTxsByTip) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,142 @@
+package evm_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v19/app/ante/evm"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/utils"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestBaseFeeProposalFilter() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	// the previous block used its whole gas limit, so the base fee rises by
+	// 1/8 at the beginning of the proposed block
+	const maxGas = 10_000_000
+	ctx := suite.ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: maxGas}})
+	suite.app.FeeMarketKeeper.SetBlockGasWanted(ctx, maxGas)
+
+	baseFee := suite.app.FeeMarketKeeper.GetBaseFee(ctx)
+	projectedBaseFee := suite.app.FeeMarketKeeper.CalculateBaseFee(ctx)
+	suite.Require().Equal(1, projectedBaseFee.Cmp(baseFee))
+
+	to := utiltx.GenerateAddress()
+	newSender := func() (common.Address, cryptotypes.PrivKey) {
+		addr, privKey := utiltx.NewAddrKey()
+		suite.Require().NoError(suite.app.EvmKeeper.SetBalance(ctx, addr, big.NewInt(1e18)))
+		return addr, privKey
+	}
+
+	// buildTx returns the bytes of a dynamic fee tx, or of a legacy tx if the
+	// tip cap is nil
+	buildTx := func(addr common.Address, privKey cryptotypes.PrivKey, nonce uint64, feeCap, tipCap *big.Int) []byte {
+		args := &evmtypes.EvmTxArgs{
+			ChainID:  suite.app.EvmKeeper.ChainID(),
+			Nonce:    nonce,
+			Amount:   big.NewInt(10),
+			GasLimit: 100000,
+			To:       &to,
+		}
+		if tipCap == nil {
+			args.GasPrice = feeCap
+		} else {
+			args.GasFeeCap, args.GasTipCap, args.Accesses = feeCap, tipCap, &ethtypes.AccessList{}
+		}
+
+		msg := evmtypes.NewTx(args)
+		msg.From = addr.Hex()
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(suite.CreateTestTx(msg, privKey, 1, false))
+		suite.Require().NoError(err)
+		return txBytes
+	}
+
+	highFeeCap := new(big.Int).Mul(projectedBaseFee, big.NewInt(2))
+	// the fee cap covers the base fee of the mempool, but not the one of the
+	// proposed block
+	lowFeeCap := new(big.Int).Add(baseFee, big.NewInt(1))
+
+	addrA, keyA := newSender()
+	addrB, keyB := newSender()
+	addrC, keyC := newSender()
+	addrD, keyD := newSender()
+
+	txA0 := buildTx(addrA, keyA, 0, highFeeCap, big.NewInt(1))
+	txA1 := buildTx(addrA, keyA, 1, highFeeCap, big.NewInt(50))
+	txB0 := buildTx(addrB, keyB, 0, lowFeeCap, big.NewInt(1))
+	txC0 := buildTx(addrC, keyC, 0, highFeeCap, big.NewInt(20))
+	txD0 := buildTx(addrD, keyD, 0, lowFeeCap, nil)
+	cosmosTx, err := suite.clientCtx.TxConfig.TxEncoder()(suite.CreateTestCosmosTxBuilder(
+		sdkmath.NewInt(1), utils.BaseDenom,
+		&banktypes.MsgSend{FromAddress: sdk.AccAddress(addrA.Bytes()).String(), ToAddress: sdk.AccAddress(to.Bytes()).String()},
+	).GetTx())
+	suite.Require().NoError(err)
+
+	ethTxs := [][]byte{txA0, txB0, txC0, txA1, txD0}
+
+	// every EVM tx pays the base fee of the mempool
+	checkCtx, _ := ctx.WithIsCheckTx(true).CacheContext()
+	for _, txBz := range ethTxs {
+		_, err := suite.anteHandler(checkCtx.WithTxBytes(txBz), suite.DecodeTx(txBz), false)
+		suite.Require().NoError(err)
+	}
+
+	// deliver returns the number of EVM txs of the given block failing on
+	// delivery, once the base fee has risen
+	deliver := func(txs [][]byte) (failed int) {
+		deliverCtx, _ := ctx.CacheContext()
+		suite.app.FeeMarketKeeper.BeginBlock(deliverCtx, abci.RequestBeginBlock{})
+		suite.Require().Equal(projectedBaseFee, suite.app.FeeMarketKeeper.GetBaseFee(deliverCtx))
+
+		for _, txBz := range txs {
+			if string(txBz) == string(cosmosTx) {
+				continue
+			}
+			if _, err := suite.anteHandler(deliverCtx.WithTxBytes(txBz), suite.DecodeTx(txBz), false); err != nil {
+				suite.Require().ErrorContains(err, "max fee per gas less than block base fee")
+				failed++
+			}
+		}
+		return failed
+	}
+	suite.Require().Equal(2, deliver(ethTxs))
+
+	filter := evm.NewBaseFeeProposalFilter(suite.app.FeeMarketKeeper, suite.clientCtx.TxConfig.TxDecoder())
+	prepareProposal := filter.PrepareProposalHandler(func(_ sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		return abci.ResponsePrepareProposal{Txs: req.Txs}
+	})
+	processProposal := filter.ProcessProposalHandler(func(sdk.Context, abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+	})
+
+	// the underpriced txs are excluded and the EVM txs are reordered by tip,
+	// keeping the nonce order of each sender and the position of the other txs
+	candidates := [][]byte{txA0, txB0, cosmosTx, txC0, txA1, txD0}
+	res := prepareProposal(ctx, abci.RequestPrepareProposal{Txs: candidates, Height: ctx.BlockHeight()})
+	suite.Require().Equal([][]byte{txC0, cosmosTx, txA0, txA1}, res.Txs)
+	suite.Require().Zero(deliver(res.Txs))
+
+	// the underpriced proposals are only rejected if enabled
+	process := func(txs [][]byte) abci.ResponseProcessProposal_ProposalStatus {
+		return processProposal(ctx, abci.RequestProcessProposal{Txs: txs, Height: ctx.BlockHeight()}).Status
+	}
+	suite.Require().Equal(abci.ResponseProcessProposal_ACCEPT, process(candidates))
+
+	params := suite.app.FeeMarketKeeper.GetParams(ctx)
+	params.RejectUnderpricedProposals = true
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(ctx, params))
+
+	suite.Require().Equal(abci.ResponseProcessProposal_REJECT, process(candidates))
+	suite.Require().Equal(abci.ResponseProcessProposal_ACCEPT, process(res.Txs))
+}
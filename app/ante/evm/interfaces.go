@@ -5,10 +5,13 @@ package evm
 import (
 	"math/big"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 
@@ -28,12 +31,25 @@ type EVMKeeper interface { //nolint: revive
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
+	GetTxPaymaster(ctx sdk.Context, sender common.Address, tx *ethtypes.Transaction, maxCost *big.Int) (common.Address, bool, error)
+	SetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash, feePayer common.Address)
+	GetAccessPolicyLists(ctx sdk.Context) *evmtypes.AccessPolicyLists
+	CheckAccessPolicy(ctx sdk.Context, lists *evmtypes.AccessPolicyLists, sender common.Address, to *common.Address) error
 }
 
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
+	FeeConversionRate(ctx sdk.Context, denom string) (math.LegacyDec, bool)
+	ConvertFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coin, evmDenom string) (sdk.Coin, error)
+}
+
+// ProposalFeeMarketKeeper defines the expected keeper interface used to check
+// the fees of the EVM txs of the block proposals
+type ProposalFeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
+	CalculateBaseFee(ctx sdk.Context) *big.Int
 }
 
 // DynamicFeeEVMKeeper is a subset of EVMKeeper interface that supports dynamic fee checker
@@ -0,0 +1,85 @@
+package evm_test
+
+import (
+	"errors"
+	"math/big"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/app/ante"
+	"github.com/evmos/evmos/v19/encoding"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestAnteHandlerMinPriorityFee() {
+	to := utiltx.GenerateAddress()
+
+	suite.SetupTest() // reset
+
+	const minPriorityFee = 1000
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	anteHandler := ante.NewAnteHandler(ante.HandlerOptions{
+		Cdc:                   suite.app.AppCodec(),
+		AccountKeeper:         suite.app.AccountKeeper,
+		BankKeeper:            suite.app.BankKeeper,
+		DistributionKeeper:    suite.app.DistrKeeper,
+		EvmKeeper:             suite.app.EvmKeeper,
+		FeegrantKeeper:        suite.app.FeeGrantKeeper,
+		IBCKeeper:             suite.app.IBCKeeper,
+		StakingKeeper:         suite.app.StakingKeeper,
+		FeeMarketKeeper:       suite.app.FeeMarketKeeper,
+		SignModeHandler:       encodingConfig.TxConfig.SignModeHandler(),
+		SigGasConsumer:        ante.SigVerificationGasConsumer,
+		MempoolMinPriorityFee: minPriorityFee,
+	})
+
+	// buildTx returns the bytes of a tx of a new sender paying the given tip,
+	// as a dynamic fee tx or as a legacy tx
+	buildTx := func(tip int64, legacy bool) []byte {
+		addr, privKey := utiltx.NewAddrKey()
+		err := suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt(1e18))
+		suite.Require().NoError(err)
+
+		args := &evmtypes.EvmTxArgs{
+			ChainID:  suite.app.EvmKeeper.ChainID(),
+			Amount:   big.NewInt(10),
+			GasLimit: 100000,
+			To:       &to,
+		}
+		if legacy {
+			args.GasPrice = big.NewInt(ethparams.InitialBaseFee + tip)
+		} else {
+			args.GasFeeCap = big.NewInt(ethparams.InitialBaseFee + 2*minPriorityFee)
+			args.GasTipCap = big.NewInt(tip)
+			args.Accesses = &types.AccessList{}
+		}
+
+		signedTx := evmtypes.NewTx(args)
+		signedTx.From = addr.Hex()
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(suite.CreateTestTx(signedTx, privKey, 1, false))
+		suite.Require().NoError(err)
+		return txBytes
+	}
+
+	for _, legacy := range []bool{false, true} {
+		lowTip, highTip := buildTx(minPriorityFee-1, legacy), buildTx(minPriorityFee, legacy)
+
+		// the tx paying a tip below the minimum is rejected from the mempool
+		checkCtx, _ := suite.ctx.WithIsCheckTx(true).CacheContext()
+		_, err := anteHandler(checkCtx.WithTxBytes(lowTip), suite.DecodeTx(lowTip), false)
+		suite.Require().Error(err)
+		suite.Require().True(errors.Is(err, errortypes.ErrInsufficientFee), err.Error())
+		suite.Require().Contains(err.Error(), "priority fee below the minimum of the local mempool")
+
+		_, err = anteHandler(checkCtx.WithTxBytes(highTip), suite.DecodeTx(highTip), false)
+		suite.Require().NoError(err)
+
+		// the same tx is still valid in a block proposed by another validator
+		deliverCtx, _ := suite.ctx.CacheContext()
+		_, err = anteHandler(deliverCtx.WithTxBytes(lowTip), suite.DecodeTx(lowTip), false)
+		suite.Require().NoError(err)
+	}
+}
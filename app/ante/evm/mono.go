@@ -12,6 +12,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	anteutils "github.com/evmos/evmos/v19/app/ante/utils"
@@ -31,10 +32,13 @@ type MonoDecorator struct {
 	distributionKeeper anteutils.DistributionKeeper
 	stakingKeeper      anteutils.StakingKeeper
 	maxGasWanted       uint64
+	txReplacements     *TxReplacementTracker
+	minPriorityFee     uint64
 }
 
 type DecoratorUtils struct {
 	EvmParams          evmtypes.Params
+	AccessPolicy       *evmtypes.AccessPolicyLists
 	EthConfig          *params.ChainConfig
 	Rules              params.Rules
 	Signer             ethtypes.Signer
@@ -49,7 +53,9 @@ type DecoratorUtils struct {
 	TxFee              sdk.Coins
 }
 
-// NewMonoDecorator creates a new MonoDecorator
+// NewMonoDecorator creates a new MonoDecorator. The replacement of the EVM txs
+// of the local mempool is disabled if txReplacements is nil, and the minimum
+// priority fee of the local mempool if minPriorityFee is zero.
 func NewMonoDecorator(
 	accountKeeper evmtypes.AccountKeeper,
 	bankKeeper evmtypes.BankKeeper,
@@ -58,6 +64,8 @@ func NewMonoDecorator(
 	distributionKeeper anteutils.DistributionKeeper,
 	stakingKeeper anteutils.StakingKeeper,
 	maxGasWanted uint64,
+	txReplacements *TxReplacementTracker,
+	minPriorityFee uint64,
 ) MonoDecorator {
 	return MonoDecorator{
 		accountKeeper:      accountKeeper,
@@ -67,6 +75,8 @@ func NewMonoDecorator(
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
 		maxGasWanted:       maxGasWanted,
+		txReplacements:     txReplacements,
+		minPriorityFee:     minPriorityFee,
 	}
 }
 
@@ -97,6 +107,7 @@ func NewMonoDecoratorUtils(
 
 	return &DecoratorUtils{
 		EvmParams:          evmParams,
+		AccessPolicy:       ek.GetAccessPolicyLists(ctx),
 		EthConfig:          ethCfg,
 		Rules:              rules,
 		Signer:             ethtypes.MakeSigner(ethCfg, blockHeight),
@@ -124,18 +135,42 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 	}
 
+	// only the single message txs of the local mempool can be replaced
+	trackReplacements := md.txReplacements != nil && ctx.IsCheckTx() && !simulate && len(tx.GetMsgs()) == 1
+	if trackReplacements && ctx.IsReCheckTx() {
+		// evict the replaced txs from the mempool
+		if err := md.txReplacements.CheckReplaced(ctx.TxBytes()); err != nil {
+			return ctx, err
+		}
+	}
+
+	var (
+		trackedSender common.Address
+		trackedNonce  uint64
+		trackedTx     *ethtypes.Transaction
+	)
+
 	// 1. setup ctx
 	ctx, err = SetupContext(ctx, tx, md.evmKeeper)
 	if err != nil {
 		return ctx, err
 	}
 
+	if simulate {
+		ctx = evmtypes.WithSimulation(ctx)
+	}
+
 	// 2. get utils
 	decUtils, err := NewMonoDecoratorUtils(ctx, md.evmKeeper, md.feeMarketKeeper)
 	if err != nil {
 		return ctx, err
 	}
 
+	// enforce the tx size limit of the EVM parameters
+	if err := evmtypes.ValidateTxBytes(len(ctx.TxBytes()), decUtils.EvmParams.MaxTxBytes); err != nil {
+		return ctx, err
+	}
+
 	// Use the lowest priority of all the messages as the final one.
 	for i, msg := range tx.GetMsgs() {
 		ethMsg, txData, from, err := evmtypes.UnpackEthMsg(msg)
@@ -153,6 +188,11 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
 				return ctx, err
 			}
+
+			tip := effectiveTip(txData.GetGasFeeCap(), txData.GetGasTipCap(), decUtils.BaseFee)
+			if err := CheckMempoolPriorityFee(tip, md.minPriorityFee); err != nil {
+				return ctx, err
+			}
 		}
 
 		// 3. min gas price (global min fee)
@@ -165,6 +205,13 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		// NOTE: unsigned transactions are accepted on simulations, using the
+		// from address of the message as sender
+		unsignedSimulation := IsUnsignedSimulation(ethMsg, simulate)
+		if unsignedSimulation {
+			from = nil
+		}
+
 		// 4. validate msg contents
 		err = ValidateMsg(
 			decUtils.EvmParams,
@@ -176,19 +223,38 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 
 		// 5. signature verification
-		if err := SignatureVerification(
-			ethMsg,
-			decUtils.Signer,
-			decUtils.EvmParams.AllowUnprotectedTxs,
-		); err != nil {
-			return ctx, err
+		if !unsignedSimulation {
+			if err := SignatureVerification(
+				ethMsg,
+				decUtils.Signer,
+				decUtils.EvmParams.AllowUnprotectedTxs,
+			); err != nil {
+				return ctx, err
+			}
 		}
 
 		// NOTE: sender address has been verified and cached
 		from = ethMsg.GetFrom()
-
-		// 6. account balance verification
 		fromAddr := common.HexToAddress(ethMsg.From)
+
+		// reject the deployments and calls denied by the access policy before
+		// charging any fee, the access control params being checked by
+		// ValidateMsg
+		if err := md.evmKeeper.CheckAccessPolicy(ctx, decUtils.AccessPolicy, fromAddr, txData.GetTo()); err != nil {
+			return ctx, err
+		}
+
+		// 6. account balance verification, the fees being paid by the
+		// designated paymaster if any
+		paymaster, sponsored, err := md.evmKeeper.GetTxPaymaster(ctx, fromAddr, ethMsg.AsTransaction(), txData.Fee())
+		if err != nil {
+			return ctx, err
+		}
+		feePayer := from
+		if sponsored {
+			feePayer = paymaster.Bytes()
+		}
+
 		// TODO: Use account from AccountKeeper instead
 		account := md.evmKeeper.GetAccount(ctx, fromAddr)
 		if err := VerifyAccountBalance(
@@ -197,17 +263,23 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			account,
 			fromAddr,
 			txData,
+			sponsored,
 		); err != nil {
 			return ctx, err
 		}
 
 		// 7. can transfer
-		coreMsg, err := ethMsg.AsMessage(decUtils.Signer, decUtils.BaseFee)
-		if err != nil {
-			return ctx, errorsmod.Wrapf(
-				err,
-				"failed to create an ethereum core.Message from signer %T", decUtils.Signer,
-			)
+		var coreMsg core.Message
+		if unsignedSimulation {
+			coreMsg = evmtypes.NewUnsignedMessage(ethMsg.AsTransaction(), fromAddr, decUtils.BaseFee)
+		} else {
+			coreMsg, err = ethMsg.AsMessage(decUtils.Signer, decUtils.BaseFee)
+			if err != nil {
+				return ctx, errorsmod.Wrapf(
+					err,
+					"failed to create an ethereum core.Message from signer %T", decUtils.Signer,
+				)
+			}
 		}
 
 		if err := CanTransfer(
@@ -264,11 +336,15 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				Staking:      md.stakingKeeper,
 			},
 			msgFees,
-			from,
+			feePayer,
 		)
 		if err != nil {
 			return ctx, err
 		}
+		if sponsored {
+			// the unused gas is refunded to the paymaster
+			md.evmKeeper.SetTxFeePayerTransient(ctx, ethMsg.AsTransaction().Hash(), paymaster)
+		}
 
 		gasWanted := UpdateCumulativeGasWanted(
 			ctx,
@@ -294,10 +370,29 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		decUtils.TxGasLimit += gas
 
 		// 10. increment sequence
-		if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
-			return ctx, err
+		txNonce := txData.GetNonce()
+		if simulate {
+			// the nonce is not enforced on simulations
+			txNonce = acc.GetSequence()
+		}
+
+		replacement := false
+		if trackReplacements && txNonce < acc.GetSequence() {
+			// the nonce of a replacement was already consumed by the replaced tx
+			replacement, err = md.txReplacements.CanReplace(ctx, fromAddr, txNonce, ethMsg.AsTransaction(), decUtils.BaseFee)
+			if err != nil {
+				return ctx, err
+			}
 		}
 
+		if !replacement {
+			if err := IncrementNonce(ctx, md.accountKeeper, acc, txNonce); err != nil {
+				return ctx, err
+			}
+		}
+
+		trackedSender, trackedNonce, trackedTx = fromAddr, txNonce, ethMsg.AsTransaction()
+
 		// 11. gas wanted
 		if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
 			return ctx, err
@@ -317,5 +412,9 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
-	return next(ctx, tx, simulate)
+	newCtx, err = next(ctx, tx, simulate)
+	if err == nil && trackReplacements {
+		md.txReplacements.Track(ctx, trackedSender, trackedNonce, trackedTx)
+	}
+	return newCtx, err
 }
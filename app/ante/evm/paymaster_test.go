@@ -0,0 +1,97 @@
+package evm_test
+
+import (
+	"errors"
+	"math/big"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+var (
+	// acceptingPaymasterCode returns true to any call
+	acceptingPaymasterCode = common.FromHex("0x600160005260206000f3")
+	// decliningPaymasterCode returns false to any call
+	decliningPaymasterCode = common.FromHex("0x600060005260206000f3")
+)
+
+// setPaymaster sets a paymaster contract with the given code and balance,
+// registering it in the params if requested.
+func (suite *AnteTestSuite) setPaymaster(code []byte, balance *big.Int, register bool) common.Address {
+	paymaster := utiltx.GenerateAddress()
+	codeHash := crypto.Keccak256(code)
+	suite.app.EvmKeeper.SetCode(suite.ctx, codeHash, code)
+	err := suite.app.EvmKeeper.SetAccount(suite.ctx, paymaster, statedb.Account{Balance: balance, CodeHash: codeHash})
+	suite.Require().NoError(err)
+
+	if register {
+		params := suite.app.EvmKeeper.GetParams(suite.ctx)
+		params.Paymasters = append(params.Paymasters, paymaster.Hex())
+		suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	}
+	return paymaster
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerPaymaster() {
+	to := utiltx.GenerateAddress()
+	funds := big.NewInt(1e18)
+
+	testCases := []struct {
+		name        string
+		code        []byte
+		register    bool
+		storageKeys []common.Hash
+		expErr      error
+	}{
+		{"fees paid by the paymaster", acceptingPaymasterCode, true, nil, nil},
+		{"paymaster declining the tx", decliningPaymasterCode, true, nil, evmtypes.ErrPaymasterRejected},
+		{"reverting paymaster", common.FromHex("0x60006000fd"), true, nil, evmtypes.ErrPaymasterRejected},
+		{"unregistered paymaster", acceptingPaymasterCode, false, nil, errortypes.ErrInsufficientFunds},
+		{"access list entry with storage keys", acceptingPaymasterCode, true, []common.Hash{{}}, errortypes.ErrInsufficientFunds},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			paymaster := suite.setPaymaster(tc.code, funds, tc.register)
+
+			// the sender has no funds
+			sender, privKey := utiltx.NewAddrKey()
+			signedTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				ChainID:   suite.app.EvmKeeper.ChainID(),
+				To:        &to,
+				GasLimit:  100000,
+				GasFeeCap: big.NewInt(2 * ethparams.InitialBaseFee),
+				GasTipCap: big.NewInt(1),
+				Accesses:  &types.AccessList{{Address: paymaster, StorageKeys: tc.storageKeys}},
+			})
+			signedTx.From = sender.Hex()
+			tx := suite.CreateTestTx(signedTx, privKey, 1, false)
+
+			ctx, _ := suite.ctx.CacheContext()
+			_, err := suite.anteHandler(ctx, tx, false)
+			if tc.expErr != nil {
+				suite.Require().Error(err)
+				suite.Require().True(errors.Is(err, tc.expErr), err.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			balance := suite.app.EvmKeeper.GetBalance(ctx, paymaster)
+			suite.Require().Equal(-1, balance.Cmp(funds), "the fees must be deducted from the paymaster")
+			suite.Require().Zero(suite.app.EvmKeeper.GetBalance(ctx, sender).Sign())
+			suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetNonce(ctx, sender))
+
+			feePayer, found := suite.app.EvmKeeper.GetTxFeePayerTransient(ctx, signedTx.AsTransaction().Hash())
+			suite.Require().True(found)
+			suite.Require().Equal(paymaster, feePayer)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriorityLaneBand is the lowest priority of the txs of the priority lane.
+// The fee-derived priorities of the other txs are capped right below it, so
+// that no fee can outbid the txs of the lane.
+const PriorityLaneBand = int64(1) << 62
+
+// PriorityLane prioritizes the txs including any of the configured msg types
+// (e.g. oracle votes or governance msgs) over the other txs of the local
+// mempool, regardless of the fees they pay.
+//
+// The number of txs of the lane included in a block proposal can be limited
+// by a quota, so that the lane can't be abused by wrapping spam txs with a
+// prioritized msg. The txs of the lane beyond the quota are left in the
+// mempool for the next blocks.
+type PriorityLane struct {
+	msgTypeURLs map[string]struct{}
+	// maximum number of txs of the lane per block proposal, zero means unlimited
+	quota     uint64
+	txDecoder sdk.TxDecoder
+}
+
+// NewPriorityLane creates a new PriorityLane for the given msg type URLs, with
+// the given per-block quota.
+func NewPriorityLane(msgTypeURLs []string, quota uint64, txDecoder sdk.TxDecoder) *PriorityLane {
+	lane := &PriorityLane{
+		msgTypeURLs: make(map[string]struct{}, len(msgTypeURLs)),
+		quota:       quota,
+		txDecoder:   txDecoder,
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		lane.msgTypeURLs[msgTypeURL] = struct{}{}
+	}
+
+	return lane
+}
+
+// IsPriorityTx returns true if the given tx includes any of the msg types of
+// the lane.
+func (l *PriorityLane) IsPriorityTx(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if _, found := l.msgTypeURLs[sdk.MsgTypeURL(msg)]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// Priority returns the priority of the given tx from its fee-derived
+// priority. The txs of the lane get a priority within the reserved band,
+// ordered by their fees, while the priority of the other txs is capped below
+// the band.
+func (l *PriorityLane) Priority(tx sdk.Tx, feePriority int64) int64 {
+	if feePriority >= PriorityLaneBand {
+		feePriority = PriorityLaneBand - 1
+	}
+
+	if !l.IsPriorityTx(tx) {
+		return feePriority
+	}
+
+	if feePriority < 0 {
+		feePriority = 0
+	}
+	return PriorityLaneBand + feePriority
+}
+
+// AnteHandler wraps the given ante handler to set the priority of the txs
+// according to the lane.
+func (l *PriorityLane) AnteHandler(next sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		newCtx, err := next(ctx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+
+		return newCtx.WithPriority(l.Priority(tx, newCtx.Priority())), nil
+	}
+}
+
+// PrepareProposalHandler wraps the given handler to move the txs of the lane
+// proposed by CometBFT ahead of the other txs, up to the quota. The txs of the
+// lane beyond the quota are removed from the proposal.
+func (l *PriorityLane) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		var laneTxs, otherTxs [][]byte
+		for _, txBz := range req.Txs {
+			tx, err := l.txDecoder(txBz)
+			if err != nil || !l.IsPriorityTx(tx) {
+				// the txs that can't be decoded are left to the next handler
+				otherTxs = append(otherTxs, txBz)
+				continue
+			}
+
+			if l.quota > 0 && uint64(len(laneTxs)) >= l.quota {
+				continue
+			}
+			laneTxs = append(laneTxs, txBz)
+		}
+
+		req.Txs = append(laneTxs, otherTxs...)
+		return next(ctx, req)
+	}
+}
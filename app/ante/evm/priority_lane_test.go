@@ -0,0 +1,137 @@
+package evm_test
+
+import (
+	"math/big"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/app/ante"
+	"github.com/evmos/evmos/v19/app/ante/evm"
+	"github.com/evmos/evmos/v19/encoding"
+	"github.com/evmos/evmos/v19/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestAnteHandlerPriorityLane() {
+	const (
+		// number of txs fitting in a block
+		blockCapacity = 10
+		// number of EVM txs spamming the mempool
+		spamTxs = 2 * blockCapacity
+	)
+
+	suite.SetupTest() // reset
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	lane := evm.NewPriorityLane(
+		[]string{sdk.MsgTypeURL(&govtypesv1.MsgVote{})},
+		1,
+		suite.clientCtx.TxConfig.TxDecoder(),
+	)
+	anteHandler := ante.NewAnteHandler(ante.HandlerOptions{
+		Cdc:                suite.app.AppCodec(),
+		AccountKeeper:      suite.app.AccountKeeper,
+		BankKeeper:         suite.app.BankKeeper,
+		DistributionKeeper: suite.app.DistrKeeper,
+		EvmKeeper:          suite.app.EvmKeeper,
+		FeegrantKeeper:     suite.app.FeeGrantKeeper,
+		IBCKeeper:          suite.app.IBCKeeper,
+		StakingKeeper:      suite.app.StakingKeeper,
+		FeeMarketKeeper:    suite.app.FeeMarketKeeper,
+		SignModeHandler:    encodingConfig.TxConfig.SignModeHandler(),
+		SigGasConsumer:     ante.SigVerificationGasConsumer,
+		PriorityLane:       lane,
+	})
+
+	// the voters pay the minimum fee
+	const voteGas = uint64(200000)
+	voteFees := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewIntFromUint64(voteGas)))
+	voters := make([]sdk.AccAddress, 2)
+	voterKeys := make([]cryptotypes.PrivKey, 2)
+	for i := range voters {
+		voters[i], voterKeys[i] = utiltx.NewAccAddressAndKey()
+		suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, voters[i]))
+		suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, voters[i], voteFees))
+	}
+
+	checkCtx, _ := suite.ctx.WithIsCheckTx(true).CacheContext()
+
+	type mempoolTx struct {
+		bz       []byte
+		priority int64
+	}
+	var mempoolTxs []mempoolTx
+
+	// checkTx runs the tx through the ante handler and adds it to the mempool
+	checkTx := func(tx sdk.Tx) []byte {
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		newCtx, err := anteHandler(checkCtx.WithTxBytes(txBytes), tx, false)
+		suite.Require().NoError(err)
+
+		mempoolTxs = append(mempoolTxs, mempoolTx{bz: txBytes, priority: newCtx.Priority()})
+		return txBytes
+	}
+
+	// the mempool is spammed with EVM txs paying high fees
+	to := utiltx.GenerateAddress()
+	gasPrice := big.NewInt(1_000_000_000)
+	var spamBzs [][]byte
+	for i := 0; i < spamTxs; i++ {
+		addr, privKey := utiltx.NewAddrKey()
+		err := suite.app.EvmKeeper.SetBalance(checkCtx, addr, new(big.Int).Mul(gasPrice, big.NewInt(1_000_000)))
+		suite.Require().NoError(err)
+
+		signedTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.app.EvmKeeper.ChainID(),
+			Nonce:    0,
+			Amount:   big.NewInt(10),
+			GasLimit: 100000,
+			GasPrice: new(big.Int).Add(gasPrice, big.NewInt(int64(i))),
+			To:       &to,
+		})
+		signedTx.From = addr.Hex()
+
+		spamBzs = append(spamBzs, checkTx(suite.CreateTestTx(signedTx, privKey, 1, false)))
+	}
+
+	var voteBzs [][]byte
+	for i, voter := range voters {
+		txBuilder, err := suite.CreateTestEIP712MsgVoteV1(voter, voterKeys[i], suite.ctx.ChainID(), voteGas, voteFees)
+		suite.Require().NoError(err)
+		voteBzs = append(voteBzs, checkTx(txBuilder.GetTx()))
+	}
+
+	// the votes are prioritized over every EVM tx, regardless of their fees
+	for _, mempoolTx := range mempoolTxs[:spamTxs] {
+		suite.Require().Less(mempoolTx.priority, evm.PriorityLaneBand)
+	}
+	for _, mempoolTx := range mempoolTxs[spamTxs:] {
+		suite.Require().GreaterOrEqual(mempoolTx.priority, evm.PriorityLaneBand)
+	}
+
+	// the mempool proposes the txs with the highest priorities up to the
+	// capacity of the block
+	sort.SliceStable(mempoolTxs, func(i, j int) bool {
+		return mempoolTxs[i].priority > mempoolTxs[j].priority
+	})
+	var proposedTxs [][]byte
+	for _, mempoolTx := range mempoolTxs[:blockCapacity] {
+		proposedTxs = append(proposedTxs, mempoolTx.bz)
+	}
+	suite.Require().Equal(voteBzs, proposedTxs[:2])
+
+	// only the quota of votes is included in the block proposal, first
+	prepareProposal := lane.PrepareProposalHandler(func(_ sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		return abci.ResponsePrepareProposal{Txs: req.Txs}
+	})
+	res := prepareProposal(suite.ctx, abci.RequestPrepareProposal{Txs: append(spamBzs[:2:2], voteBzs...)})
+	suite.Require().Equal([][]byte{voteBzs[0], spamBzs[0], spamBzs[1]}, res.Txs)
+}
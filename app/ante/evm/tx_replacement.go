@@ -0,0 +1,214 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	"math/big"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// DefaultPriceBump is the default minimum tip increase, in percent, required
+// to replace an EVM tx of the local mempool. It matches the geth tx pool.
+const DefaultPriceBump = 10
+
+// txSlot identifies the EVM txs of a sender with a given nonce.
+type txSlot struct {
+	sender common.Address
+	nonce  uint64
+}
+
+// pendingTx is the EVM tx of the local mempool occupying a slot.
+type pendingTx struct {
+	hash      string
+	gasFeeCap *big.Int
+	gasTipCap *big.Int
+	// height of the check state the tx was last checked against
+	height int64
+}
+
+// TxReplacementTracker tracks the sender and nonce of the EVM txs accepted
+// into the local mempool, so that an EVM tx can be replaced by a tx of the
+// same sender and nonce that pays a tip at least PriceBump percent higher.
+//
+// The replaced tx is rejected when it is re-checked after the next block,
+// which evicts it from the mempool, and it is filtered out of the block
+// proposals until then.
+//
+// NOTE: the pending txs are only known while they are checked or re-checked
+// against the current check state, so replacements require the mempool
+// recheck to be enabled to work across blocks.
+type TxReplacementTracker struct {
+	mu        sync.Mutex
+	priceBump uint64
+	height    int64
+	pending   map[txSlot]pendingTx
+	// hashes of the replaced txs and the height they were replaced at
+	replaced map[string]int64
+}
+
+// NewTxReplacementTracker creates a new TxReplacementTracker with the given
+// price bump percentage.
+func NewTxReplacementTracker(priceBump uint64) *TxReplacementTracker {
+	return &TxReplacementTracker{
+		priceBump: priceBump,
+		pending:   make(map[txSlot]pendingTx),
+		replaced:  make(map[string]int64),
+	}
+}
+
+// CheckReplaced returns an error if the given tx was replaced, so that it is
+// evicted from the mempool on recheck.
+func (t *TxReplacementTracker) CheckReplaced(txBytes []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	hash := string(tmhash.Sum(txBytes))
+	if _, found := t.replaced[hash]; !found {
+		return nil
+	}
+
+	delete(t.replaced, hash)
+	return errorsmod.Wrapf(
+		errortypes.ErrInvalidSequence,
+		"tx %X was replaced by a tx with a higher tip", []byte(hash),
+	)
+}
+
+// CanReplace returns true if the given tx replaces the EVM tx of the local
+// mempool with the same sender and nonce. It returns false if there is no
+// such tx, and an error if the tip of the given tx is not high enough.
+func (t *TxReplacementTracker) CanReplace(
+	ctx sdk.Context,
+	sender common.Address,
+	nonce uint64,
+	tx *ethtypes.Transaction,
+	baseFee *big.Int,
+) (bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.advance(ctx.BlockHeight())
+
+	old, found := t.pending[txSlot{sender, nonce}]
+	if !found || old.height != ctx.BlockHeight() {
+		return false, nil
+	}
+
+	oldTip := effectiveTip(old.gasFeeCap, old.gasTipCap, baseFee)
+	newTip := effectiveTip(tx.GasFeeCap(), tx.GasTipCap(), baseFee)
+
+	// newTip >= oldTip * (100 + priceBump) / 100
+	minTip := new(big.Int).Mul(oldTip, new(big.Int).SetUint64(100+t.priceBump))
+	minTip.Quo(minTip, big.NewInt(100))
+
+	if newTip.Cmp(minTip) < 0 || newTip.Cmp(oldTip) <= 0 {
+		return false, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"replacement transaction underpriced; tip %s, required at least %s (%d%% bump)",
+			newTip, minTip, t.priceBump,
+		)
+	}
+
+	return true, nil
+}
+
+// Track records the given tx as the EVM tx of the local mempool with the
+// sender and nonce. The tx previously recorded for them, if any, is marked as
+// replaced.
+func (t *TxReplacementTracker) Track(
+	ctx sdk.Context,
+	sender common.Address,
+	nonce uint64,
+	tx *ethtypes.Transaction,
+) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.advance(ctx.BlockHeight())
+
+	slot := txSlot{sender, nonce}
+	hash := string(tmhash.Sum(ctx.TxBytes()))
+
+	if old, found := t.pending[slot]; found && old.hash != hash && old.height == ctx.BlockHeight() {
+		t.replaced[old.hash] = ctx.BlockHeight()
+	}
+
+	t.pending[slot] = pendingTx{
+		hash:      hash,
+		gasFeeCap: tx.GasFeeCap(),
+		gasTipCap: tx.GasTipCap(),
+		height:    ctx.BlockHeight(),
+	}
+}
+
+// IsReplaced returns true if the given tx was replaced.
+func (t *TxReplacementTracker) IsReplaced(txBytes []byte) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, found := t.replaced[string(tmhash.Sum(txBytes))]
+	return found
+}
+
+// PrepareProposalHandler wraps the given handler to remove the replaced txs
+// from the txs proposed by CometBFT, so that their replacements are included
+// instead.
+func (t *TxReplacementTracker) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		txs := make([][]byte, 0, len(req.Txs))
+		for _, txBz := range req.Txs {
+			if !t.IsReplaced(txBz) {
+				txs = append(txs, txBz)
+			}
+		}
+
+		req.Txs = txs
+		return next(ctx, req)
+	}
+}
+
+// advance prunes the pending txs that were not checked against the check
+// state of the given height, i.e. the txs included in a block or evicted from
+// the mempool, as well as the replaced txs that survived a recheck. Caller
+// must hold the lock.
+func (t *TxReplacementTracker) advance(height int64) {
+	if height <= t.height {
+		return
+	}
+
+	for slot, tx := range t.pending {
+		if tx.height < height {
+			delete(t.pending, slot)
+		}
+	}
+
+	for hash, replacedAt := range t.replaced {
+		if replacedAt < height-1 {
+			delete(t.replaced, hash)
+		}
+	}
+
+	t.height = height
+}
+
+// effectiveTip returns the tip paid per unit of gas by a tx with the given fee
+// and tip caps, for the given base fee.
+func effectiveTip(gasFeeCap, gasTipCap, baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return new(big.Int).Set(gasTipCap)
+	}
+
+	tip := new(big.Int).Sub(gasFeeCap, baseFee)
+	if tip.Cmp(gasTipCap) > 0 {
+		return new(big.Int).Set(gasTipCap)
+	}
+	return tip
+}
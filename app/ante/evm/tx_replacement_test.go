@@ -0,0 +1,159 @@
+package evm_test
+
+import (
+	"errors"
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/app/ante"
+	"github.com/evmos/evmos/v19/app/ante/evm"
+	"github.com/evmos/evmos/v19/encoding"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestAnteHandlerTxReplacement() {
+	addr, privKey := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+
+	suite.SetupTest() // reset
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	tracker := evm.NewTxReplacementTracker(evm.DefaultPriceBump)
+	anteHandler := ante.NewAnteHandler(ante.HandlerOptions{
+		Cdc:                suite.app.AppCodec(),
+		AccountKeeper:      suite.app.AccountKeeper,
+		BankKeeper:         suite.app.BankKeeper,
+		DistributionKeeper: suite.app.DistrKeeper,
+		EvmKeeper:          suite.app.EvmKeeper,
+		FeegrantKeeper:     suite.app.FeeGrantKeeper,
+		IBCKeeper:          suite.app.IBCKeeper,
+		StakingKeeper:      suite.app.StakingKeeper,
+		FeeMarketKeeper:    suite.app.FeeMarketKeeper,
+		SignModeHandler:    encodingConfig.TxConfig.SignModeHandler(),
+		SigGasConsumer:     ante.SigVerificationGasConsumer,
+		TxReplacements:     tracker,
+	})
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+	suite.Require().NoError(acc.SetSequence(1))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	err := suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt((ethparams.InitialBaseFee+1000)*100000*3))
+	suite.Require().NoError(err)
+
+	// buildTx returns the bytes of the tx with nonce 1 paying the given tip
+	buildTx := func(tip int64) []byte {
+		signedTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:   suite.app.EvmKeeper.ChainID(),
+			Nonce:     1,
+			Amount:    big.NewInt(10),
+			GasLimit:  100000,
+			GasFeeCap: big.NewInt(ethparams.InitialBaseFee + 1000),
+			GasTipCap: big.NewInt(tip),
+			Accesses:  &types.AccessList{},
+			To:        &to,
+		})
+		signedTx.From = addr.Hex()
+
+		tx := suite.CreateTestTx(signedTx, privKey, 1, false)
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+		return txBytes
+	}
+
+	bz1 := buildTx(100)
+	bz2 := buildTx(105)
+	bz3 := buildTx(110)
+
+	checkCtx, _ := suite.ctx.WithIsCheckTx(true).CacheContext()
+
+	// the first tx is accepted into the mempool
+	_, err = anteHandler(checkCtx.WithTxBytes(bz1), suite.DecodeTx(bz1), false)
+	suite.Require().NoError(err)
+
+	// a tx with the same nonce and a tip less than 10% higher is rejected
+	_, err = anteHandler(checkCtx.WithTxBytes(bz2), suite.DecodeTx(bz2), false)
+	suite.Require().Error(err)
+	suite.Require().True(errors.Is(err, errortypes.ErrInsufficientFee), err.Error())
+	suite.Require().Contains(err.Error(), "replacement transaction underpriced")
+
+	// a tx with the same nonce and a tip 10% higher replaces the first one
+	_, err = anteHandler(checkCtx.WithTxBytes(bz3), suite.DecodeTx(bz3), false)
+	suite.Require().NoError(err)
+	suite.Require().True(tracker.IsReplaced(bz1))
+	suite.Require().False(tracker.IsReplaced(bz3))
+	suite.Require().Equal(uint64(2), suite.app.AccountKeeper.GetAccount(checkCtx, addr.Bytes()).GetSequence())
+
+	// the replaced tx is excluded from the block proposal
+	prepareProposal := tracker.PrepareProposalHandler(func(_ sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		return abci.ResponsePrepareProposal{Txs: req.Txs}
+	})
+	res := prepareProposal(suite.ctx, abci.RequestPrepareProposal{Txs: [][]byte{bz1, bz3}})
+	suite.Require().Equal([][]byte{bz3}, res.Txs)
+
+	// the replacement lands in the block
+	deliverCtx, _ := suite.ctx.CacheContext()
+	_, err = anteHandler(deliverCtx.WithTxBytes(bz3), suite.DecodeTx(bz3), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), suite.app.AccountKeeper.GetAccount(deliverCtx, addr.Bytes()).GetSequence())
+
+	// the replaced tx is evicted from the mempool on recheck
+	recheckCtx, _ := deliverCtx.WithIsCheckTx(true).WithIsReCheckTx(true).CacheContext()
+	recheckCtx = recheckCtx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	_, err = anteHandler(recheckCtx.WithTxBytes(bz1), suite.DecodeTx(bz1), false)
+	suite.Require().Error(err)
+	suite.Require().True(errors.Is(err, errortypes.ErrInvalidSequence), err.Error())
+	suite.Require().False(tracker.IsReplaced(bz1))
+
+	// the included tx can no longer be replaced
+	_, err = anteHandler(recheckCtx.WithIsReCheckTx(false).WithTxBytes(bz2), suite.DecodeTx(bz2), false)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "invalid nonce")
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerTxReplacementDisabled() {
+	addr, privKey := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+
+	suite.SetupTest() // reset
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+	suite.Require().NoError(acc.SetSequence(1))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	err := suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt((ethparams.InitialBaseFee+1000)*100000*2))
+	suite.Require().NoError(err)
+
+	checkCtx := suite.ctx.WithIsCheckTx(true)
+	for _, tip := range []int64{100, 1000} {
+		signedTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:   suite.app.EvmKeeper.ChainID(),
+			Nonce:     1,
+			Amount:    big.NewInt(10),
+			GasLimit:  100000,
+			GasFeeCap: big.NewInt(ethparams.InitialBaseFee + 1000),
+			GasTipCap: big.NewInt(tip),
+			Accesses:  &types.AccessList{},
+			To:        &to,
+		})
+		signedTx.From = addr.Hex()
+		tx := suite.CreateTestTx(signedTx, privKey, 1, false)
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		_, err = suite.anteHandler(checkCtx.WithTxBytes(txBytes), tx, false)
+		if tip == 100 {
+			suite.Require().NoError(err)
+		} else {
+			// same nonce txs are rejected by default
+			suite.Require().Error(err)
+			suite.Require().True(errors.Is(err, errortypes.ErrInvalidSequence), err.Error())
+		}
+	}
+}
@@ -665,3 +665,11 @@ func (suite *AnteTestSuite) prepareAccount(ctx sdk.Context, addr sdk.AccAddress,
 		WithBlockGasMeter(storetypes.NewGasMeter(1e19)).
 		WithBlockHeight(ctx.BlockHeight() + 1)
 }
+
+// DecodeTx decodes the given tx bytes into a fresh copy of the tx, as the ante
+// handler caches the sender in the tx messages.
+func (suite *AnteTestSuite) DecodeTx(txBytes []byte) sdk.Tx {
+	tx, err := suite.clientCtx.TxConfig.TxDecoder()(txBytes)
+	suite.Require().NoError(err)
+	return tx
+}
@@ -14,6 +14,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 
+	cosmosante "github.com/evmos/evmos/v19/app/ante/cosmos"
 	evmante "github.com/evmos/evmos/v19/app/ante/evm"
 	anteutils "github.com/evmos/evmos/v19/app/ante/utils"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
@@ -22,20 +23,31 @@ import (
 // HandlerOptions defines the list of module keepers required to run the Evmos
 // AnteHandler decorators.
 type HandlerOptions struct {
-	Cdc                    codec.BinaryCodec
-	AccountKeeper          evmtypes.AccountKeeper
-	BankKeeper             evmtypes.BankKeeper
-	DistributionKeeper     anteutils.DistributionKeeper
-	IBCKeeper              *ibckeeper.Keeper
-	StakingKeeper          anteutils.StakingKeeper
-	FeeMarketKeeper        evmante.FeeMarketKeeper
-	EvmKeeper              evmante.EVMKeeper
-	FeegrantKeeper         ante.FeegrantKeeper
+	Cdc                codec.BinaryCodec
+	AccountKeeper      evmtypes.AccountKeeper
+	BankKeeper         evmtypes.BankKeeper
+	DistributionKeeper anteutils.DistributionKeeper
+	IBCKeeper          *ibckeeper.Keeper
+	StakingKeeper      anteutils.StakingKeeper
+	FeeMarketKeeper    evmante.FeeMarketKeeper
+	EvmKeeper          evmante.EVMKeeper
+	FeegrantKeeper     ante.FeegrantKeeper
+	// FaucetKeeper enables the fee exemption of the faucet claims when set
+	FaucetKeeper           cosmosante.FaucetKeeper
 	ExtensionOptionChecker ante.ExtensionOptionChecker
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	MaxTxGasWanted         uint64
 	TxFeeChecker           ante.TxFeeChecker
+	// TxReplacements enables the replacement by fee of the EVM txs of the
+	// local mempool when set
+	TxReplacements *evmante.TxReplacementTracker
+	// PriorityLane prioritizes the txs with the configured msg types over the
+	// other txs of the local mempool when set
+	PriorityLane *evmante.PriorityLane
+	// MempoolMinPriorityFee is the minimum effective tip per gas of the dynamic
+	// fee txs accepted into the local mempool, disabled when zero
+	MempoolMinPriorityFee uint64
 }
 
 // Validate checks if the keepers are defined
@@ -27,6 +27,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
 	tmos "github.com/cometbft/cometbft/libs/os"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -80,6 +81,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
@@ -117,6 +121,8 @@ import (
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 
 	// unnamed import of statik for swagger UI support
@@ -129,6 +135,7 @@ import (
 	v17 "github.com/evmos/evmos/v19/app/upgrades/v17"
 	v18 "github.com/evmos/evmos/v19/app/upgrades/v18"
 	v19 "github.com/evmos/evmos/v19/app/upgrades/v19"
+	v20 "github.com/evmos/evmos/v19/app/upgrades/v20"
 	"github.com/evmos/evmos/v19/encoding"
 	"github.com/evmos/evmos/v19/ethereum/eip712"
 	srvflags "github.com/evmos/evmos/v19/server/flags"
@@ -140,9 +147,15 @@ import (
 	erc20client "github.com/evmos/evmos/v19/x/erc20/client"
 	erc20keeper "github.com/evmos/evmos/v19/x/erc20/keeper"
 	erc20types "github.com/evmos/evmos/v19/x/erc20/types"
+	"github.com/evmos/evmos/v19/x/erc721"
+	erc721keeper "github.com/evmos/evmos/v19/x/erc721/keeper"
+	erc721types "github.com/evmos/evmos/v19/x/erc721/types"
 	"github.com/evmos/evmos/v19/x/evm"
 	evmkeeper "github.com/evmos/evmos/v19/x/evm/keeper"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	"github.com/evmos/evmos/v19/x/faucet"
+	faucetkeeper "github.com/evmos/evmos/v19/x/faucet/keeper"
+	faucettypes "github.com/evmos/evmos/v19/x/faucet/types"
 	"github.com/evmos/evmos/v19/x/feemarket"
 	feemarketkeeper "github.com/evmos/evmos/v19/x/feemarket/keeper"
 	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
@@ -218,6 +231,7 @@ var (
 		ica.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
 		feegrantmodule.AppModuleBasic{},
+		nftmodule.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{AppModuleBasic: &ibctransfer.AppModuleBasic{}},
@@ -229,6 +243,8 @@ var (
 		epochs.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 		incentives.AppModuleBasic{},
+		faucet.AppModuleBasic{},
+		erc721.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -240,9 +256,19 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:            nil,
+		nft.ModuleName:                 nil,
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
 		inflationtypes.ModuleName:      {authtypes.Minter},
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		feemarkettypes.ModuleName:      nil, // holds the fee conversion pool
+		faucettypes.ModuleName:         nil, // holds the testnet faucet funds
+		erc721types.ModuleName:         nil,
+	}
+
+	// module accounts that explicitly support receiving funds outside of their
+	// own module logic, through bank sends and EVM value transfers
+	allowedReceivingModAcc = map[string]bool{
+		faucettypes.ModuleName: true, // the faucet pays out of its balance
 	}
 )
 
@@ -282,6 +308,7 @@ type Evmos struct {
 	ParamsKeeper          paramskeeper.Keeper
 	FeeGrantKeeper        feegrantkeeper.Keeper
 	AuthzKeeper           authzkeeper.Keeper
+	NFTKeeper             nftkeeper.Keeper
 	IBCKeeper             *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	ICAHostKeeper         icahostkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
@@ -301,6 +328,8 @@ type Evmos struct {
 	Erc20Keeper     erc20keeper.Keeper
 	EpochsKeeper    epochskeeper.Keeper
 	VestingKeeper   vestingkeeper.Keeper
+	FaucetKeeper    faucetkeeper.Keeper
+	Erc721Keeper    erc721keeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -341,14 +370,21 @@ func NewEvmos(
 	// setup memiavl if it's enabled in config
 	baseAppOptions = memiavlstore.SetupMemIAVL(logger, homePath, appOpts, false, false, baseAppOptions)
 
-	// Setup Mempool and Proposal Handlers
-	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		mempool := mempool.NoOpMempool{}
-		app.SetMempool(mempool)
-		handler := baseapp.NewDefaultProposalHandler(mempool, app)
-		app.SetPrepareProposal(handler.PrepareProposalHandler())
-		app.SetProcessProposal(handler.ProcessProposalHandler())
-	})
+	// Setup the replacement by fee of the EVM txs of the local mempool, if enabled
+	var txReplacements *ethante.TxReplacementTracker
+	if cast.ToBool(appOpts.Get(srvflags.EVMMempoolReplaceByFee)) {
+		txReplacements = ethante.NewTxReplacementTracker(cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)))
+	}
+
+	// Setup the priority lane of the local mempool, if any msg type is prioritized
+	var priorityLane *ethante.PriorityLane
+	if priorityMsgs := cast.ToStringSlice(appOpts.Get(srvflags.EVMMempoolPriorityMsgs)); len(priorityMsgs) > 0 {
+		priorityLane = ethante.NewPriorityLane(
+			priorityMsgs,
+			cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriorityQuota)),
+			encodingConfig.TxConfig.TxDecoder(),
+		)
+	}
 
 	// NOTE we use custom transaction decoder that supports the sdk.Tx interface instead of sdk.StdTx
 	bApp := baseapp.NewBaseApp(
@@ -420,6 +456,7 @@ func NewEvmos(
 	app.UpgradeKeeper = *upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp, authAddr)
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.MsgServiceRouter(), app.AccountKeeper)
+	app.NFTKeeper = nftkeeper.NewKeeper(keys[nftkeeper.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper)
 
 	tracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
 
@@ -429,16 +466,25 @@ func NewEvmos(
 		keys[feemarkettypes.StoreKey],
 		tkeys[feemarkettypes.TransientKey],
 		app.GetSubspace(feemarkettypes.ModuleName),
-	)
+		app.BankKeeper, app.DistrKeeper,
+	).WithErc20Keeper(&app.Erc20Keeper).WithFeeStateStreams(feemarketkeeper.NewFeeStateStreams(
+		cast.ToInt(appOpts.Get(srvflags.EVMFeeStateMaxStreams)),
+		feemarketkeeper.DefaultFeeStateBufferSize,
+	))
 
 	evmKeeper := evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, stakingKeeper, app.FeeMarketKeeper,
+		app.AccountKeeper, app.BankKeeper, stakingKeeper, app.AuthzKeeper, app.FeeMarketKeeper,
 		// FIX: Temporary solution to solve keeper interdependency while new precompile module
 		// is being developed.
 		&app.Erc20Keeper,
 		tracer, app.GetSubspace(evmtypes.ModuleName),
-	)
+	).WithVersionedMultiStore(app.CommitMultiStore()).
+		WithBlockedModuleAccounts(blockedModuleAccounts()).
+		WithTraceLimits(
+			cast.ToInt(appOpts.Get(srvflags.EVMTraceMaxStructLogs)),
+			cast.ToInt(appOpts.Get(srvflags.EVMTraceMaxResultBytes)),
+		)
 	app.EvmKeeper = evmKeeper
 
 	// Create IBC Keeper
@@ -489,10 +535,29 @@ func NewEvmos(
 		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.EvmKeeper, app.StakingKeeper, govKeeper, // NOTE: app.govKeeper not defined yet, use govKeeper
 	)
 
+	app.FaucetKeeper = faucetkeeper.NewKeeper(
+		keys[faucettypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper,
+	)
+
+	app.Erc721Keeper = erc721keeper.NewKeeper(
+		keys[erc721types.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.NFTKeeper, app.EvmKeeper,
+	)
+
+	// register the EVM hooks
+	// NOTE: the EVM keeper is passed by reference, so that it will contain these hooks
+	app.EvmKeeper.SetHooks(
+		evmkeeper.NewMultiEvmHooks(
+			app.Erc721Keeper.Hooks(),
+		),
+	)
+
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.StakingKeeper,
-		app.AuthzKeeper, &app.TransferKeeper,
+		app.AuthzKeeper, &app.TransferKeeper, app.IBCKeeper.ChannelKeeper,
+		app.DistrKeeper,
 	)
 
 	app.TransferKeeper = transferkeeper.NewKeeper(
@@ -522,6 +587,7 @@ func NewEvmos(
 		epochskeeper.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
 			app.InflationKeeper.Hooks(),
+			app.Erc20Keeper.Hooks(),
 		),
 	)
 
@@ -610,6 +676,7 @@ func NewEvmos(
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
+		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
@@ -625,6 +692,8 @@ func NewEvmos(
 			app.GetSubspace(erc20types.ModuleName)),
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, *app.StakingKeeper.Keeper),
+		faucet.NewAppModule(app.FaucetKeeper, app.AccountKeeper),
+		erc721.NewAppModule(app.Erc721Keeper, app.AccountKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -654,10 +723,13 @@ func NewEvmos(
 		genutiltypes.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
+		nft.ModuleName,
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
+		faucettypes.ModuleName,
+		erc721types.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
@@ -682,12 +754,15 @@ func NewEvmos(
 		evidencetypes.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
+		nft.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		// Evmos modules
 		vestingtypes.ModuleName,
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
+		faucettypes.ModuleName,
+		erc721types.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
@@ -717,6 +792,7 @@ func NewEvmos(
 		icatypes.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
+		nft.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		// Evmos modules
@@ -724,6 +800,8 @@ func NewEvmos(
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
 		epochstypes.ModuleName,
+		faucettypes.ModuleName,
+		erc721types.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
@@ -781,9 +859,11 @@ func NewEvmos(
 	app.SetBeginBlocker(app.BeginBlocker)
 
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
+	minPriorityFee := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolMinPriorityFee))
 
-	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
+	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted, minPriorityFee, txReplacements, priorityLane)
 	app.setPostHandler()
+	app.setProposalHandlers(encodingConfig.TxConfig, txReplacements, priorityLane)
 	app.SetEndBlocker(app.EndBlocker)
 	app.setupUpgradeHandlers()
 
@@ -828,7 +908,13 @@ func NewEvmos(
 // Name returns the name of the App
 func (app *Evmos) Name() string { return app.BaseApp.Name() }
 
-func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *Evmos) setAnteHandler(
+	txConfig client.TxConfig,
+	maxGasWanted uint64,
+	minPriorityFee uint64,
+	txReplacements *ethante.TxReplacementTracker,
+	priorityLane *ethante.PriorityLane,
+) {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -840,10 +926,14 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		DistributionKeeper:     app.DistrKeeper,
 		IBCKeeper:              app.IBCKeeper,
 		FeeMarketKeeper:        app.FeeMarketKeeper,
+		FaucetKeeper:           app.FaucetKeeper,
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
 		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper),
+		TxReplacements:         txReplacements,
+		PriorityLane:           priorityLane,
+		MempoolMinPriorityFee:  minPriorityFee,
 	}
 
 	if err := options.Validate(); err != nil {
@@ -866,6 +956,32 @@ func (app *Evmos) setPostHandler() {
 	app.SetPostHandler(post.NewPostHandler(options))
 }
 
+// setProposalHandlers sets the mempool and the proposal handlers, which
+// exclude the EVM txs that can't pay the base fee of the proposed block.
+func (app *Evmos) setProposalHandlers(
+	txConfig client.TxConfig,
+	txReplacements *ethante.TxReplacementTracker,
+	priorityLane *ethante.PriorityLane,
+) {
+	mempool := mempool.NoOpMempool{}
+	app.SetMempool(mempool)
+
+	handler := baseapp.NewDefaultProposalHandler(mempool, app.BaseApp)
+	baseFeeFilter := ethante.NewBaseFeeProposalFilter(app.FeeMarketKeeper, txConfig.TxDecoder())
+
+	prepareProposal := handler.PrepareProposalHandler()
+	if txReplacements != nil {
+		prepareProposal = txReplacements.PrepareProposalHandler(prepareProposal)
+	}
+	prepareProposal = baseFeeFilter.PrepareProposalHandler(prepareProposal)
+	if priorityLane != nil {
+		prepareProposal = priorityLane.PrepareProposalHandler(prepareProposal)
+	}
+
+	app.SetPrepareProposal(prepareProposal)
+	app.SetProcessProposal(baseFeeFilter.ProcessProposalHandler(handler.ProcessProposalHandler()))
+}
+
 // BeginBlocker runs the Tendermint ABCI BeginBlock logic. It executes state changes at the beginning
 // of the new block for every registered module. If there is a registered fork at the current height,
 // BeginBlocker will schedule the upgrade plan and perform the state migration (if any).
@@ -936,14 +1052,8 @@ func (app *Evmos) ModuleAccountAddrs() map[string]bool {
 func (app *Evmos) BlockedAddrs() map[string]bool {
 	blockedAddrs := make(map[string]bool)
 
-	accs := make([]string, 0, len(maccPerms))
-	for k := range maccPerms {
-		accs = append(accs, k)
-	}
-	sort.Strings(accs)
-
-	for _, acc := range accs {
-		blockedAddrs[authtypes.NewModuleAddress(acc).String()] = true
+	for _, acc := range blockedModuleAccounts() {
+		blockedAddrs[sdk.AccAddress(acc.Bytes()).String()] = true
 	}
 
 	blockedPrecompilesHex := evmtypes.DefaultStaticPrecompiles
@@ -958,6 +1068,26 @@ func (app *Evmos) BlockedAddrs() map[string]bool {
 	return blockedAddrs
 }
 
+// blockedModuleAccounts returns the addresses of the module accounts that are
+// not allowed to receive external tokens, i.e. all of them except the allowed
+// receiving ones.
+func blockedModuleAccounts() []common.Address {
+	accs := make([]string, 0, len(maccPerms))
+	for k := range maccPerms {
+		if !allowedReceivingModAcc[k] {
+			accs = append(accs, k)
+		}
+	}
+	sort.Strings(accs)
+
+	addrs := make([]common.Address, 0, len(accs))
+	for _, acc := range accs {
+		addrs = append(addrs, common.BytesToAddress(authtypes.NewModuleAddress(acc)))
+	}
+
+	return addrs
+}
+
 // LegacyAmino returns Evmos's amino codec.
 //
 // NOTE: This is solely to be used for testing purposes as it may be desirable
@@ -1041,6 +1171,13 @@ func (app *Evmos) RegisterTendermintService(clientCtx client.Context) {
 		app.interfaceRegistry,
 		app.Query,
 	)
+
+	// feed the feemarket fee state streams with the new blocks of the node
+	if client, ok := clientCtx.Client.(rpcclient.EventsClient); ok {
+		if err := app.FeeMarketKeeper.StartFeeStateStreams(client); err != nil {
+			app.Logger().Error("failed to start the fee state streams", "error", err.Error())
+		}
+	}
 }
 
 // RegisterNodeService registers the node gRPC service on the provided
@@ -1158,6 +1295,14 @@ func (app *Evmos) setupUpgradeHandlers() {
 		),
 	)
 
+	// v20 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v20.UpgradeName,
+		v20.CreateUpgradeHandler(
+			app.mm, app.configurator,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1178,6 +1323,11 @@ func (app *Evmos) setupUpgradeHandlers() {
 		storeUpgrades = &storetypes.StoreUpgrades{
 			Deleted: []string{"revenue"},
 		}
+	case v20.UpgradeName:
+		// the faucet, nft and erc721 modules are added in v20
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{faucettypes.StoreKey, nftkeeper.StoreKey, erc721types.StoreKey},
+		}
 	default:
 		// no-op
 	}
@@ -15,6 +15,7 @@ import (
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -24,7 +25,9 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	epochstypes "github.com/evmos/evmos/v19/x/epochs/types"
 	erc20types "github.com/evmos/evmos/v19/x/erc20/types"
+	erc721types "github.com/evmos/evmos/v19/x/erc721/types"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	faucettypes "github.com/evmos/evmos/v19/x/faucet/types"
 	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
 	inflationtypes "github.com/evmos/evmos/v19/x/inflation/v1/types"
 	vestingtypes "github.com/evmos/evmos/v19/x/vesting/types"
@@ -43,7 +46,7 @@ func StoreKeys() (
 		distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey, consensusparamtypes.StoreKey,
-		feegrant.StoreKey, authzkeeper.StoreKey,
+		feegrant.StoreKey, authzkeeper.StoreKey, nftkeeper.StoreKey,
 		// ibc keys
 		ibcexported.StoreKey, ibctransfertypes.StoreKey,
 		// ica keys
@@ -52,7 +55,8 @@ func StoreKeys() (
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
 		// evmos keys
 		inflationtypes.StoreKey, erc20types.StoreKey,
-		epochstypes.StoreKey, vestingtypes.StoreKey,
+		epochstypes.StoreKey, vestingtypes.StoreKey, faucettypes.StoreKey,
+		erc721types.StoreKey,
 	}
 
 	keys := sdk.NewKVStoreKeys(storeKeys...)
@@ -0,0 +1,55 @@
+package v20_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/app"
+	v20 "github.com/evmos/evmos/v19/app/upgrades/v20"
+	"github.com/evmos/evmos/v19/utils"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func TestUpgradeMigratesEvmParams(t *testing.T) {
+	chainID := utils.TestnetChainID + "-1"
+	evmosApp := app.Setup(false, nil, chainID)
+	ctx := evmosApp.BaseApp.NewContext(false, tmproto.Header{Height: 100, ChainID: chainID, Time: time.Now().UTC()})
+
+	// store the evm params as the chains at the consensus version 7 do, without
+	// the params set by the migration to version 8
+	params := evmosApp.EvmKeeper.GetParams(ctx)
+	params.PrecompileGasRatio = math.LegacyZeroDec()
+	params.MaxTxBytes = 0
+	params.MaxCalldataBytes = 0
+	params.MaxLogsPerTx = 0
+	params.PostTxHooksActivationHeight = 0
+	evmStore := ctx.KVStore(evmosApp.GetKey(evmtypes.StoreKey))
+	evmStore.Set(evmtypes.KeyPrefixParams, evmosApp.AppCodec().MustMarshal(&params))
+
+	vm := evmosApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	vm[evmtypes.ModuleName] = 7
+	evmosApp.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+
+	evmosApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: v20.UpgradeName, Height: ctx.BlockHeight()})
+	require.Equal(t, uint64(8), evmosApp.UpgradeKeeper.GetModuleVersionMap(ctx)[evmtypes.ModuleName])
+
+	expParams := params
+	expParams.PrecompileGasRatio = evmtypes.DefaultPrecompileGasRatio
+	expParams.MaxTxBytes = evmtypes.DefaultMaxTxBytes
+	expParams.MaxCalldataBytes = evmtypes.DefaultMaxCalldataBytes
+	expParams.MaxLogsPerTx = evmtypes.DefaultMaxLogsPerTx
+	expParams.PostTxHooksActivationHeight = ctx.BlockHeight()
+
+	params = evmosApp.EvmKeeper.GetParams(ctx)
+	require.Equal(t, expParams, params)
+	require.NoError(t, params.Validate())
+
+	// the post tx hooks are active from the upgrade height
+	require.False(t, params.PostTxHooksActive(ctx.BlockHeight()-1))
+	require.True(t, params.PostTxHooksActive(ctx.BlockHeight()))
+}
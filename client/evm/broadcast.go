@@ -0,0 +1,173 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evm
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// PollInterval is the interval between the queries of the receipt of a
+// broadcasted tx.
+var PollInterval = time.Second
+
+// WrapTx wraps the given signed EVM tx into a Cosmos tx holding it as single
+// msg, with the ExtensionOptionsEthereumTx extension option and the fees of the
+// EVM tx in the given EVM denom.
+func (b *TxBuilder) WrapTx(msg *evmtypes.MsgEthereumTx, evmDenom string) (signing.Tx, error) {
+	// the Cosmos tx must not set the sender of the msg, which is recovered from
+	// its signature
+	wrapped := *msg
+	return wrapped.BuildTx(b.txConfig.NewTxBuilder(), evmDenom)
+}
+
+// EncodeTx returns the bytes of the Cosmos tx wrapping the given signed EVM tx,
+// in the EVM denom queried over gRPC.
+func (b *TxBuilder) EncodeTx(ctx context.Context, msg *evmtypes.MsgEthereumTx) ([]byte, error) {
+	if b.evmClient == nil {
+		return nil, errors.New("a gRPC connection is required to wrap EVM txs into Cosmos txs")
+	}
+
+	res, err := b.evmClient.Params(ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := b.WrapTx(msg, res.Params.EvmDenom)
+	if err != nil {
+		return nil, err
+	}
+	return b.txConfig.TxEncoder()(tx)
+}
+
+// Broadcast broadcasts the given signed EVM tx, with the eth_sendRawTransaction
+// JSON-RPC method, or wrapped into a Cosmos tx over gRPC otherwise, and returns
+// its hash once accepted into the mempool.
+func (b *TxBuilder) Broadcast(ctx context.Context, msg *evmtypes.MsgEthereumTx) (common.Hash, error) {
+	tx := msg.AsTransaction()
+	if b.ethClient != nil {
+		return tx.Hash(), b.ethClient.SendTransaction(ctx, tx)
+	}
+
+	txBytes, err := b.EncodeTx(ctx, msg)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	res, err := b.txClient.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if res.TxResponse.Code != 0 {
+		return common.Hash{}, errorsmod.ABCIError(res.TxResponse.Codespace, res.TxResponse.Code, res.TxResponse.RawLog)
+	}
+	return tx.Hash(), nil
+}
+
+// WaitForReceipt polls the receipt of the EVM tx of the given hash until it is
+// included in a block or the context is done.
+//
+// The receipts queried over gRPC are built from the Cosmos tx including the
+// EVM tx, and only hold the status, gas used, logs, block number and contract
+// address of the tx.
+func (b *TxBuilder) WaitForReceipt(ctx context.Context, hash common.Hash) (*ethtypes.Receipt, error) {
+	ticker := time.NewTicker(PollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := b.receipt(ctx, hash)
+		if err == nil {
+			return receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// receipt returns the receipt of the EVM tx of the given hash, and
+// ethereum.NotFound if the tx is not included in a block yet.
+func (b *TxBuilder) receipt(ctx context.Context, hash common.Hash) (*ethtypes.Receipt, error) {
+	if b.ethClient != nil {
+		return b.ethClient.TransactionReceipt(ctx, hash)
+	}
+
+	res, err := b.txClient.GetTxsEvent(ctx, &txtypes.GetTxsEventRequest{
+		Events: []string{fmt.Sprintf("%s.%s='%s'", evmtypes.EventTypeEthereumTx, evmtypes.AttributeKeyEthereumTxHash, hash.Hex())},
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.TxResponses) == 0 || len(res.Txs) == 0 {
+		return nil, ethereum.NotFound
+	}
+
+	txResponse := res.TxResponses[0]
+	if txResponse.Code != 0 {
+		return nil, errorsmod.ABCIError(txResponse.Codespace, txResponse.Code, txResponse.RawLog)
+	}
+
+	data, err := hex.DecodeString(txResponse.Data)
+	if err != nil {
+		return nil, err
+	}
+	msgRes, err := evmtypes.DecodeTxResponse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := &ethtypes.Receipt{
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: msgRes.GasUsed,
+		GasUsed:           msgRes.GasUsed,
+		Logs:              evmtypes.LogsToEthereum(msgRes.Logs),
+		TxHash:            hash,
+		BlockNumber:       big.NewInt(txResponse.Height),
+	}
+	if msgRes.Failed() {
+		receipt.Status = ethtypes.ReceiptStatusFailed
+	}
+	receipt.Bloom = ethtypes.BytesToBloom(ethtypes.LogsBloom(receipt.Logs))
+
+	// the contract address is derived from the sender and nonce of the tx,
+	// unpacked by the codec of the gRPC connection
+	if msgs := res.Txs[0].GetBody().GetMessages(); len(msgs) == 1 {
+		if msg, ok := msgs[0].GetCachedValue().(*evmtypes.MsgEthereumTx); ok {
+			tx := msg.AsTransaction()
+			receipt.Type = tx.Type()
+			if tx.To() == nil {
+				from, err := msg.GetSender(b.chainID)
+				if err != nil {
+					return nil, err
+				}
+				receipt.ContractAddress = crypto.CreateAddress(from, tx.Nonce())
+			}
+		}
+	}
+	return receipt, nil
+}
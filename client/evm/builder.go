@@ -0,0 +1,249 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/evmos/evmos/v19/server/config"
+	evmostypes "github.com/evmos/evmos/v19/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
+)
+
+// TxBuilder builds, signs and broadcasts the EVM txs of an Evmos chain, filling
+// their nonce, gas limit and fees from the gRPC or JSON-RPC endpoints of a
+// node.
+//
+// The JSON-RPC endpoint is preferred when both are set, as its nonces account
+// for the txs pending in the mempool. The gRPC endpoint is required to wrap the
+// EVM txs into Cosmos txs.
+type TxBuilder struct {
+	txConfig client.TxConfig
+	chainID  *big.Int
+
+	// gRPC clients, nil without a gRPC endpoint
+	evmClient       evmtypes.QueryClient
+	feeMarketClient feemarkettypes.QueryClient
+	txClient        txtypes.ServiceClient
+
+	// JSON-RPC client, nil without a JSON-RPC endpoint
+	ethClient *ethclient.Client
+}
+
+// NewTxBuilder creates a new TxBuilder querying the given gRPC connection and
+// JSON-RPC client, any of which can be nil. A client.Context can be used as
+// gRPC connection.
+//
+// NOTE: the gRPC connection must encode the messages with the codec of the
+// Cosmos SDK, e.g. using the grpc.ForceCodec dial option.
+func NewTxBuilder(
+	ctx context.Context,
+	txConfig client.TxConfig,
+	grpcConn gogogrpc.ClientConn,
+	ethClient *ethclient.Client,
+) (*TxBuilder, error) {
+	if grpcConn == nil && ethClient == nil {
+		return nil, errors.New("either a gRPC connection or a JSON-RPC client is required")
+	}
+
+	b := &TxBuilder{
+		txConfig:  txConfig,
+		ethClient: ethClient,
+	}
+
+	var err error
+	if grpcConn != nil {
+		b.evmClient = evmtypes.NewQueryClient(grpcConn)
+		b.feeMarketClient = feemarkettypes.NewQueryClient(grpcConn)
+		b.txClient = txtypes.NewServiceClient(grpcConn)
+	}
+
+	if ethClient != nil {
+		b.chainID, err = ethClient.ChainID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+
+	res, err := tmservice.NewServiceClient(grpcConn).GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	b.chainID, err = evmostypes.ParseChainID(res.DefaultNodeInfo.Network)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// ChainID returns the EIP-155 chain ID of the chain.
+func (b *TxBuilder) ChainID() *big.Int {
+	return new(big.Int).Set(b.chainID)
+}
+
+// Signer returns the signer of the EVM txs of the chain.
+func (b *TxBuilder) Signer() ethtypes.Signer {
+	return ethtypes.LatestSignerForChainID(b.chainID)
+}
+
+// TxArgs defines the arguments of an EVM tx. The nonce, gas limit and fees
+// left nil are filled by the TxBuilder.
+type TxArgs struct {
+	From       common.Address
+	To         *common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+
+	Nonce    *uint64
+	GasLimit *uint64
+
+	// Legacy builds a legacy tx paying the GasPrice instead of an EIP-1559
+	// dynamic fee tx paying the GasTipCap and the base fee up to the GasFeeCap.
+	Legacy    bool
+	GasPrice  *big.Int
+	GasFeeCap *big.Int
+	GasTipCap *big.Int
+}
+
+// BuildTx returns the unsigned EVM tx of the given arguments, filling its
+// missing nonce, fees and gas limit.
+func (b *TxBuilder) BuildTx(ctx context.Context, args TxArgs) (*evmtypes.MsgEthereumTx, error) {
+	if args.Nonce == nil {
+		nonce, err := b.Nonce(ctx, args.From)
+		if err != nil {
+			return nil, err
+		}
+		args.Nonce = &nonce
+	}
+
+	if (args.Legacy && args.GasPrice == nil) || (!args.Legacy && (args.GasFeeCap == nil || args.GasTipCap == nil)) {
+		fees, err := b.SuggestFees(ctx)
+		if err != nil {
+			return nil, err
+		}
+		args = fees.fill(args)
+	}
+
+	if args.GasLimit == nil {
+		gas, err := b.EstimateGas(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		args.GasLimit = &gas
+	}
+
+	txArgs := &evmtypes.EvmTxArgs{
+		ChainID:  b.chainID,
+		Nonce:    *args.Nonce,
+		GasLimit: *args.GasLimit,
+		Input:    args.Data,
+		Amount:   args.Value,
+		To:       args.To,
+	}
+	if args.Legacy {
+		txArgs.GasPrice = args.GasPrice
+	} else {
+		txArgs.GasFeeCap, txArgs.GasTipCap = args.GasFeeCap, args.GasTipCap
+	}
+	if args.AccessList != nil {
+		txArgs.Accesses = &args.AccessList
+	}
+
+	msg := evmtypes.NewTx(txArgs)
+	msg.From = args.From.Hex()
+	return msg, nil
+}
+
+// Sign signs the given EVM tx with the key of its sender on the given
+// keyring.
+func (b *TxBuilder) Sign(msg *evmtypes.MsgEthereumTx, signer keyring.Signer) error {
+	return msg.Sign(b.Signer(), signer)
+}
+
+// SignWithKey signs the given EVM tx with the given private key.
+func (b *TxBuilder) SignWithKey(msg *evmtypes.MsgEthereumTx, key *ecdsa.PrivateKey) error {
+	tx, err := ethtypes.SignTx(msg.AsTransaction(), b.Signer(), key)
+	if err != nil {
+		return err
+	}
+	return msg.FromEthereumTx(tx)
+}
+
+// Nonce returns the next nonce of the given account.
+func (b *TxBuilder) Nonce(ctx context.Context, address common.Address) (uint64, error) {
+	if b.ethClient != nil {
+		return b.ethClient.PendingNonceAt(ctx, address)
+	}
+
+	res, err := b.evmClient.Account(ctx, &evmtypes.QueryAccountRequest{Address: address.Hex()})
+	if err != nil {
+		return 0, err
+	}
+	return res.Nonce, nil
+}
+
+// EstimateGas returns the gas limit of the tx of the given arguments.
+func (b *TxBuilder) EstimateGas(ctx context.Context, args TxArgs) (uint64, error) {
+	if b.ethClient != nil {
+		return b.ethClient.EstimateGas(ctx, ethereum.CallMsg{
+			From:       args.From,
+			To:         args.To,
+			GasPrice:   args.GasPrice,
+			GasFeeCap:  args.GasFeeCap,
+			GasTipCap:  args.GasTipCap,
+			Value:      args.Value,
+			Data:       args.Data,
+			AccessList: args.AccessList,
+		})
+	}
+
+	input := hexutil.Bytes(args.Data)
+	callArgs := evmtypes.TransactionArgs{
+		From:                 &args.From,
+		To:                   args.To,
+		GasPrice:             (*hexutil.Big)(args.GasPrice),
+		MaxFeePerGas:         (*hexutil.Big)(args.GasFeeCap),
+		MaxPriorityFeePerGas: (*hexutil.Big)(args.GasTipCap),
+		Value:                (*hexutil.Big)(args.Value),
+		Data:                 &input,
+		ChainID:              (*hexutil.Big)(b.chainID),
+	}
+	if args.AccessList != nil {
+		callArgs.AccessList = &args.AccessList
+	}
+
+	bz, err := json.Marshal(&callArgs)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := b.evmClient.EstimateGas(ctx, &evmtypes.EthCallRequest{
+		Args:    bz,
+		GasCap:  config.DefaultGasCap,
+		ChainId: b.chainID.Int64(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.Gas, nil
+}
@@ -0,0 +1,229 @@
+//go:build norace
+// +build norace
+
+package evm_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/evmos/evmos/v19/client/evm"
+	"github.com/evmos/evmos/v19/testutil/network"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
+)
+
+var (
+	// val is the validator of the in-process test network the examples
+	// broadcast their txs to
+	val *network.Validator
+	// grpcConn is the gRPC connection to the validator
+	grpcConn *grpc.ClientConn
+	// ethClient is the JSON-RPC client of the validator
+	ethClient *ethclient.Client
+)
+
+// discardLogger discards the logs of the test network, which would be mixed
+// with the output of the examples.
+type discardLogger struct{}
+
+func (discardLogger) Log(...interface{})          {}
+func (discardLogger) Logf(string, ...interface{}) {}
+
+func TestMain(m *testing.M) {
+	os.Exit(runWithNetwork(m))
+}
+
+func runWithNetwork(m *testing.M) int {
+	dir, err := os.MkdirTemp("", "evm-client")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+	cfg.TimeoutCommit = time.Second
+
+	nw, err := network.New(discardLogger{}, dir, cfg)
+	if err != nil {
+		panic(err)
+	}
+	defer nw.Cleanup()
+
+	if _, err := nw.WaitForHeight(2); err != nil {
+		panic(err)
+	}
+
+	val = nw.Validators[0]
+	grpcConn, err = grpc.Dial(
+		val.AppConfig.GRPC.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(cfg.InterfaceRegistry).GRPCCodec())),
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer grpcConn.Close()
+
+	ethClient, err = ethclient.Dial(fmt.Sprintf("http://%s", val.AppConfig.JSONRPC.Address))
+	if err != nil {
+		panic(err)
+	}
+	defer ethClient.Close()
+
+	return m.Run()
+}
+
+// transfer sends the given value from the validator to a new account with the
+// given tx builder, and prints the receipt status and the recipient balance.
+func transfer(builder *evm.TxBuilder, legacy bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	to := utiltx.GenerateAddress()
+	msg, err := builder.BuildTx(ctx, evm.TxArgs{
+		From:   common.BytesToAddress(val.Address),
+		To:     &to,
+		Value:  big.NewInt(1000),
+		Legacy: legacy,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if err := builder.Sign(msg, val.ClientCtx.Keyring); err != nil {
+		panic(err)
+	}
+
+	hash, err := builder.Broadcast(ctx, msg)
+	if err != nil {
+		panic(err)
+	}
+
+	receipt, err := builder.WaitForReceipt(ctx, hash)
+	if err != nil {
+		panic(err)
+	}
+
+	balance, err := ethClient.BalanceAt(ctx, to, receipt.BlockNumber)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("tx type:", msg.AsTransaction().Type())
+	fmt.Println("status:", receipt.Status)
+	fmt.Println("gas used:", receipt.GasUsed)
+	fmt.Println("balance:", balance)
+}
+
+// This example fills, signs and broadcasts a dynamic fee tx over JSON-RPC.
+func ExampleTxBuilder_jsonRPC() {
+	builder, err := evm.NewTxBuilder(context.Background(), val.ClientCtx.TxConfig, nil, ethClient)
+	if err != nil {
+		panic(err)
+	}
+
+	transfer(builder, false)
+	// Output:
+	// tx type: 2
+	// status: 1
+	// gas used: 21000
+	// balance: 1000
+}
+
+// This example fills, signs and broadcasts a legacy tx over JSON-RPC.
+func ExampleTxBuilder_jsonRPCLegacy() {
+	builder, err := evm.NewTxBuilder(context.Background(), val.ClientCtx.TxConfig, nil, ethClient)
+	if err != nil {
+		panic(err)
+	}
+
+	transfer(builder, true)
+	// Output:
+	// tx type: 0
+	// status: 1
+	// gas used: 21000
+	// balance: 1000
+}
+
+// This example fills a dynamic fee tx over gRPC, and broadcasts it wrapped
+// into a Cosmos tx.
+func ExampleTxBuilder_gRPC() {
+	builder, err := evm.NewTxBuilder(context.Background(), val.ClientCtx.TxConfig, grpcConn, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	transfer(builder, false)
+	// Output:
+	// tx type: 2
+	// status: 1
+	// gas used: 21000
+	// balance: 1000
+}
+
+// This example fills a legacy tx over gRPC, and broadcasts it wrapped into a
+// Cosmos tx.
+func ExampleTxBuilder_gRPCLegacy() {
+	builder, err := evm.NewTxBuilder(context.Background(), val.ClientCtx.TxConfig, grpcConn, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	transfer(builder, true)
+	// Output:
+	// tx type: 0
+	// status: 1
+	// gas used: 21000
+	// balance: 1000
+}
+
+// This example streams the fee state of the new blocks from the feemarket gRPC
+// service, as an off-chain fee oracle would.
+func Example_subscribeFeeState() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	queryClient := feemarkettypes.NewQueryClient(grpcConn)
+	stream, err := queryClient.SubscribeFeeState(ctx, &feemarkettypes.QuerySubscribeFeeStateRequest{})
+	if err != nil {
+		panic(err)
+	}
+
+	var states []feemarkettypes.EventFeeState
+	for len(states) < 3 {
+		res, err := stream.Recv()
+		if err != nil {
+			panic(err)
+		}
+
+		// the stream drops the oldest fee states if the client doesn't keep up
+		if res.Dropped > 0 {
+			fmt.Println("dropped:", res.Dropped)
+		}
+		states = append(states, res.FeeState)
+	}
+
+	for i, state := range states {
+		if i > 0 && state.Height != states[i-1].Height+1 {
+			fmt.Println("missed height:", states[i-1].Height+1)
+		}
+		if _, ok := new(big.Int).SetString(state.BaseFee, 10); !ok {
+			fmt.Println("invalid base fee:", state.BaseFee)
+		}
+	}
+	fmt.Println("fee states:", len(states))
+	// Output:
+	// fee states: 3
+}
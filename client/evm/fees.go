@@ -0,0 +1,116 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evm
+
+import (
+	"context"
+	"math/big"
+
+	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
+)
+
+// Fees are the fees suggested for the EVM txs of the next blocks.
+type Fees struct {
+	// BaseFee is the base fee of the latest block, nil if the fee market is
+	// disabled
+	BaseFee *big.Int
+	// GasTipCap is the suggested tip of the dynamic fee txs
+	GasTipCap *big.Int
+	// GasFeeCap is the suggested fee cap of the dynamic fee txs, covering twice
+	// the base fee as geth does, so that the tx remains valid for several
+	// blocks of rising base fee
+	GasFeeCap *big.Int
+	// GasPrice is the suggested gas price of the legacy txs
+	GasPrice *big.Int
+}
+
+// SuggestFees returns the fees suggested for the EVM txs of the next blocks,
+// from the eth_gasPrice and eth_maxPriorityFeePerGas JSON-RPC methods, or from
+// the feemarket gRPC queries otherwise.
+func (b *TxBuilder) SuggestFees(ctx context.Context) (Fees, error) {
+	var (
+		fees Fees
+		err  error
+	)
+
+	if b.ethClient != nil {
+		header, err := b.ethClient.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return Fees{}, err
+		}
+		fees.BaseFee = header.BaseFee
+
+		if fees.GasTipCap, err = b.ethClient.SuggestGasTipCap(ctx); err != nil {
+			return Fees{}, err
+		}
+		if fees.GasPrice, err = b.ethClient.SuggestGasPrice(ctx); err != nil {
+			return Fees{}, err
+		}
+	} else if fees, err = b.suggestFeesGRPC(ctx); err != nil {
+		return Fees{}, err
+	}
+
+	fees.GasFeeCap = fees.feeCap(fees.GasTipCap)
+	return fees, nil
+}
+
+// suggestFeesGRPC returns the base fee, tip and gas price suggested from the
+// feemarket gRPC queries. As the JSON-RPC server does, the tip is the maximum
+// base fee increase of a block and the gas price covers the base fee and the
+// tip, and at least the global minimum gas price.
+func (b *TxBuilder) suggestFeesGRPC(ctx context.Context) (Fees, error) {
+	params, err := b.feeMarketClient.Params(ctx, &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return Fees{}, err
+	}
+
+	res, err := b.feeMarketClient.BaseFee(ctx, &feemarkettypes.QueryBaseFeeRequest{})
+	if err != nil {
+		return Fees{}, err
+	}
+
+	fees := Fees{GasTipCap: big.NewInt(0), GasPrice: big.NewInt(0)}
+	if res.BaseFee != nil {
+		fees.BaseFee = res.BaseFee.BigInt()
+
+		// BaseFee * (ElasticityMultiplier - 1) / BaseFeeChangeDenominator
+		if params.Params.ElasticityMultiplier > 1 && params.Params.BaseFeeChangeDenominator > 0 {
+			fees.GasTipCap.Mul(fees.BaseFee, big.NewInt(int64(params.Params.ElasticityMultiplier)-1))
+			fees.GasTipCap.Div(fees.GasTipCap, big.NewInt(int64(params.Params.BaseFeeChangeDenominator)))
+		}
+		fees.GasPrice.Add(fees.BaseFee, fees.GasTipCap)
+	}
+
+	if minGasPrice := params.Params.MinGasPrice.TruncateInt().BigInt(); fees.GasPrice.Cmp(minGasPrice) < 0 {
+		fees.GasPrice = minGasPrice
+	}
+	return fees, nil
+}
+
+// fill sets the missing fees of the given tx arguments.
+func (f Fees) fill(args TxArgs) TxArgs {
+	if args.Legacy {
+		if args.GasPrice == nil {
+			args.GasPrice = f.GasPrice
+		}
+		return args
+	}
+
+	if args.GasTipCap == nil {
+		args.GasTipCap = f.GasTipCap
+	}
+	if args.GasFeeCap == nil {
+		args.GasFeeCap = f.feeCap(args.GasTipCap)
+	}
+	return args
+}
+
+// feeCap returns the fee cap covering the given tip and twice the base fee.
+func (f Fees) feeCap(tip *big.Int) *big.Int {
+	feeCap := new(big.Int).Set(tip)
+	if f.BaseFee != nil {
+		feeCap.Add(feeCap, new(big.Int).Mul(f.BaseFee, big.NewInt(2)))
+	}
+	return feeCap
+}
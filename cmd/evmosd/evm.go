@@ -0,0 +1,355 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/cmd/evmosd/opendb"
+	"github.com/evmos/evmos/v19/x/evm/keeper"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// EVMCmd returns the offline EVM state maintenance commands.
+func EVMCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm",
+		Short: "Offline EVM state maintenance commands",
+	}
+
+	cmd.AddCommand(
+		PruneOrphanedStorageCmd(appCreator),
+		StateSnapshotCmd(appCreator),
+	)
+	return cmd
+}
+
+// PruneOrphanedStorageCmd returns a command that reports the EVM storage left
+// under addresses that have no account or no code.
+func PruneOrphanedStorageCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-orphaned-storage",
+		Short: "Report the EVM storage of self-destructed contracts",
+		Long: `Scan the latest application state for EVM storage slots stored under addresses
+that have no account or no code, e.g. the storage of contracts self-destructed
+before the storage prune queue, and print them as JSON.
+
+The application state is not modified: deleting the slots outside of consensus
+would change the app hash of the node. The reported storage is pruned on chain
+by queueing it in an upgrade handler (see the EVM keeper QueueOrphanedStorage),
+after which it is deleted at the end of the following blocks.
+
+The node must be stopped while running this command.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			home := serverCtx.Viper.GetString(flags.FlagHome)
+
+			db, err := opendb.OpenReadOnlyDB(home, sdkserver.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stderr))
+			evmosApp, ok := appCreator(logger, db, nil, serverCtx.Viper).(*app.Evmos)
+			if !ok {
+				return fmt.Errorf("unexpected application type, expected %T", &app.Evmos{})
+			}
+
+			ctx := evmosApp.NewUncachedContext(true, tmproto.Header{})
+			orphaned := evmosApp.EvmKeeper.GetOrphanedStorage(ctx)
+			if orphaned == nil {
+				orphaned = []keeper.OrphanedStorage{}
+			}
+
+			bz, err := json.MarshalIndent(orphaned, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// StateSnapshotCmd returns the EVM state snapshot commands.
+func StateSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Export and import snapshots of the EVM contracts state",
+	}
+
+	cmd.AddCommand(
+		ExportStateSnapshotCmd(appCreator),
+		ImportStateSnapshotCmd(),
+	)
+	return cmd
+}
+
+// ExportStateSnapshotCmd returns a command that exports the EVM contracts of
+// the application state to a snapshot file.
+func ExportStateSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [snapshot-file]",
+		Short: "Export the EVM contracts state to a snapshot file",
+		Long: `Export the nonce, code and storage slots of the EVM contracts of the application
+state to a compact snapshot file, at the latest height or at the given height
+if it is still available in the application store. The checksum of the
+snapshot is printed, to be published with it so that the importers can check
+it against a trusted source.
+
+The node must be stopped while running this command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			home := serverCtx.Viper.GetString(flags.FlagHome)
+
+			height, err := cmd.Flags().GetInt64(flags.FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			db, err := opendb.OpenReadOnlyDB(home, sdkserver.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stderr))
+			evmosApp, ok := appCreator(logger, db, nil, serverCtx.Viper).(*app.Evmos)
+			if !ok {
+				return fmt.Errorf("unexpected application type, expected %T", &app.Evmos{})
+			}
+
+			if height <= 0 {
+				height = evmosApp.LastBlockHeight()
+			}
+			ms, err := evmosApp.CommitMultiStore().CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return fmt.Errorf("failed to load the state at height %d: %w", height, err)
+			}
+			ctx := sdk.NewContext(ms, tmproto.Header{Height: height}, false, logger)
+
+			file, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			checksum, err := evmosApp.EvmKeeper.ExportStateSnapshot(ctx, file)
+			if err != nil {
+				return err
+			}
+			if err := file.Sync(); err != nil {
+				return err
+			}
+
+			cmd.Printf("exported the EVM state at height %d, checksum %s\n", height, checksum.Hex())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flags.FlagHeight, 0, "Height of the exported state, the latest height if not set")
+	return cmd
+}
+
+// ImportStateSnapshotCmd returns a command that imports the EVM contracts of a
+// snapshot file into the genesis file.
+func ImportStateSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [snapshot-file]",
+		Short: "Import the EVM contracts state of a snapshot file into genesis.json",
+		Long: `Import the EVM contracts of a snapshot file into genesis.json, adding the accounts
+of the contracts that are missing from the auth genesis state, so that a new
+network is bootstrapped with the contracts state instead of replaying its
+history. The snapshot is rejected if it is malformed, if its checksum doesn't
+match the --checksum flag when set, or if a contract is already in the EVM
+genesis state.
+
+The snapshot only holds the EVM contracts: to sync a node of an existing
+network, use the state sync snapshots of the whole application state instead
+(see the snapshots command).`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			expChecksum, err := cmd.Flags().GetString(flagChecksum)
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			accounts, checksum, err := readStateSnapshot(file)
+			if err != nil {
+				return err
+			}
+			if expChecksum != "" && !strings.EqualFold(expChecksum, checksum.Hex()) {
+				return fmt.Errorf("snapshot checksum mismatch: expected %s, got %s", expChecksum, checksum.Hex())
+			}
+
+			if err := importStateSnapshotGenesis(clientCtx, appState, accounts); err != nil {
+				return err
+			}
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			if err := genutil.ExportGenesisFile(genDoc, genFile); err != nil {
+				return err
+			}
+
+			cmd.Printf("imported %d EVM contracts, checksum %s\n", len(accounts), checksum.Hex())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagChecksum, "", "Expected checksum of the snapshot")
+	return cmd
+}
+
+const flagChecksum = "checksum"
+
+// snapshotGenesisAccount is a contract of a state snapshot, with its nonce.
+type snapshotGenesisAccount struct {
+	evmtypes.GenesisAccount
+	nonce uint64
+}
+
+// readStateSnapshot reads the contracts of the given snapshot, and returns
+// them with the snapshot checksum.
+func readStateSnapshot(file *os.File) ([]snapshotGenesisAccount, common.Hash, error) {
+	sr, err := evmtypes.NewStateSnapshotReader(file)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	var accounts []snapshotGenesisAccount
+	codes := make(map[common.Hash][]byte)
+
+	onAccount := func(account evmtypes.StateSnapshotAccount) error {
+		if len(account.Code) > 0 {
+			codes[account.CodeHash] = account.Code
+		}
+		code, ok := codes[account.CodeHash]
+		if !ok {
+			return fmt.Errorf("code not found for account %s with code hash %s", account.Address, account.CodeHash)
+		}
+
+		accounts = append(accounts, snapshotGenesisAccount{
+			GenesisAccount: evmtypes.GenesisAccount{
+				Address: account.Address.Hex(),
+				Code:    common.Bytes2Hex(code),
+			},
+			nonce: account.Nonce,
+		})
+		return nil
+	}
+
+	onSlot := func(_ common.Address, key, value common.Hash) error {
+		account := &accounts[len(accounts)-1]
+		account.Storage = append(account.Storage, evmtypes.NewState(key, value))
+		return nil
+	}
+
+	if err := sr.Read(onAccount, onSlot); err != nil {
+		return nil, common.Hash{}, err
+	}
+	return accounts, sr.Checksum(), nil
+}
+
+// importStateSnapshotGenesis adds the given contracts to the EVM genesis
+// state, and their missing accounts to the auth genesis state.
+func importStateSnapshotGenesis(clientCtx client.Context, appState map[string]json.RawMessage, accounts []snapshotGenesisAccount) error {
+	var evmGenState evmtypes.GenesisState
+	if err := clientCtx.Codec.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState); err != nil {
+		return fmt.Errorf("failed to unmarshal EVM genesis state: %w", err)
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	contracts := make(map[string]struct{}, len(evmGenState.Accounts))
+	for _, account := range evmGenState.Accounts {
+		contracts[common.HexToAddress(account.Address).Hex()] = struct{}{}
+	}
+
+	for _, account := range accounts {
+		if _, ok := contracts[account.Address]; ok {
+			return fmt.Errorf("contract %s is already in the EVM genesis state", account.Address)
+		}
+		evmGenState.Accounts = append(evmGenState.Accounts, account.GenesisAccount)
+
+		addr := sdk.AccAddress(common.HexToAddress(account.Address).Bytes())
+		if !accs.Contains(addr) {
+			accs = append(accs, authtypes.NewBaseAccount(addr, nil, 0, account.nonce))
+		}
+	}
+
+	if err := evmGenState.Validate(); err != nil {
+		return fmt.Errorf("invalid EVM genesis state: %w", err)
+	}
+
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := clientCtx.Codec.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+	evmGenStateBz, err := clientCtx.Codec.MarshalJSON(&evmGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal EVM genesis state: %w", err)
+	}
+
+	appState[authtypes.ModuleName] = authGenStateBz
+	appState[evmtypes.ModuleName] = evmGenStateBz
+	return nil
+}
@@ -0,0 +1,94 @@
+package main_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/app"
+	evmosd "github.com/evmos/evmos/v19/cmd/evmosd"
+	"github.com/evmos/evmos/v19/encoding"
+	"github.com/evmos/evmos/v19/utils"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// writeSnapshotFile writes a snapshot of a contract with a storage slot.
+func writeSnapshotFile(t *testing.T, path string, address common.Address) common.Hash {
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	code := []byte{0x60, 0x00}
+	sw, err := evmtypes.NewStateSnapshotWriter(file, 10)
+	require.NoError(t, err)
+	require.NoError(t, sw.WriteAccount(evmtypes.StateSnapshotAccount{
+		Address:  address,
+		Nonce:    5,
+		CodeHash: crypto.Keccak256Hash(code),
+		Code:     code,
+	}))
+	require.NoError(t, sw.WriteSlot(common.HexToHash("0x1"), common.HexToHash("0x2a")))
+
+	checksum, err := sw.Close()
+	require.NoError(t, err)
+	return checksum
+}
+
+func runEvmosd(t *testing.T, args ...string) error {
+	rootCmd, _ := evmosd.NewRootCmd()
+	rootCmd.SetArgs(args)
+	return svrcmd.Execute(rootCmd, "evmosd", app.DefaultNodeHome)
+}
+
+func TestImportStateSnapshotCmd(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, runEvmosd(t,
+		"init", "evmos-test",
+		fmt.Sprintf("--home=%s", home),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, utils.TestnetChainID+"-1"),
+	))
+
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	snapshotFile := filepath.Join(home, "evm.snapshot")
+	checksum := writeSnapshotFile(t, snapshotFile, address)
+
+	// the snapshot is rejected if its checksum doesn't match the expected one
+	err := runEvmosd(t, "evm", "snapshot", "import", snapshotFile,
+		fmt.Sprintf("--home=%s", home), fmt.Sprintf("--checksum=%s", common.Hash{}.Hex()))
+	require.ErrorContains(t, err, "snapshot checksum mismatch")
+
+	require.NoError(t, runEvmosd(t, "evm", "snapshot", "import", snapshotFile,
+		fmt.Sprintf("--home=%s", home), fmt.Sprintf("--checksum=%s", checksum.Hex())))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	cdc := encoding.MakeConfig(app.ModuleBasics).Codec
+	var evmGenState evmtypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState))
+	require.Equal(t, []evmtypes.GenesisAccount{{
+		Address: address.Hex(),
+		Code:    "6000",
+		Storage: evmtypes.Storage{evmtypes.NewState(common.HexToHash("0x1"), common.HexToHash("0x2a"))},
+	}}, evmGenState.Accounts)
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	require.Len(t, accs, 1)
+	require.Equal(t, address.Bytes(), accs[0].GetAddress().Bytes())
+	require.Equal(t, uint64(5), accs[0].GetSequence())
+
+	// the contracts can't be imported twice
+	err = runEvmosd(t, "evm", "snapshot", "import", snapshotFile, fmt.Sprintf("--home=%s", home))
+	require.ErrorContains(t, err, "already in the EVM genesis state")
+}
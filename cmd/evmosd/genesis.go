@@ -0,0 +1,233 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// evmAccountsPath is the JSON path of the EVM genesis accounts in a genesis file.
+const evmAccountsPath = "app_state." + evmtypes.ModuleName + ".accounts"
+
+// ValidateGenesisCmd extends the validate-genesis command of the SDK to report
+// every EVM genesis account entry that is not in its normalized form, with
+// its line in the genesis file and its normalized value, before validating
+// the genesis file.
+func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := genutilcli.ValidateGenesisCmd(mbm)
+	validate := cmd.RunE
+
+	cmd.Long = `Validate the genesis file at the default location or at the location passed as an arg.
+
+The EVM genesis accounts must be in their normalized form, so that the genesis
+files holding identical states are identical: 0x-prefixed addresses, either
+checksummed or lower-case, code in lower-case hex without prefix and storage
+keys and values as 0x-prefixed lower-case 32 bytes hashes. Every offending entry
+is reported with its line in the genesis file and its normalized value.`
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		genesis := server.GetServerContextFromCmd(cmd).Config.GenesisFile()
+		if len(args) > 0 {
+			genesis = args[0]
+		}
+
+		bz, err := os.ReadFile(genesis)
+		if err != nil {
+			return err
+		}
+
+		entries, err := InvalidEVMGenesisEntries(client.GetClientContextFromCmd(cmd).Codec, bz)
+		if err != nil {
+			return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+		}
+
+		if len(entries) > 0 {
+			for _, entry := range entries {
+				fmt.Fprintln(cmd.ErrOrStderr(), entry)
+			}
+			return fmt.Errorf("genesis file %s holds %d invalid EVM genesis entries", genesis, len(entries))
+		}
+
+		return validate(cmd, args)
+	}
+
+	return cmd
+}
+
+// EVMGenesisEntry is an entry of the EVM genesis accounts that is invalid or
+// not in its normalized form.
+type EVMGenesisEntry struct {
+	// Path is the JSON path of the entry in the genesis file
+	Path string
+	// Line is the line of the entry in the genesis file
+	Line  int
+	Value string
+	// Normalized is the normalized value, empty if the value can't be normalized
+	Normalized string
+	Err        error
+}
+
+// String implements the stringer interface.
+func (e EVMGenesisEntry) String() string {
+	str := fmt.Sprintf("line %d: %s %q: %s", e.Line, e.Path, e.Value, e.Err)
+	if e.Normalized != "" {
+		str += fmt.Sprintf(" (normalized: %q)", e.Normalized)
+	}
+	return str
+}
+
+// InvalidEVMGenesisEntries returns the entries of the EVM genesis accounts of
+// the given genesis file that are invalid or not in their normalized form,
+// and the duplicated accounts and storage keys, in their order in the file.
+func InvalidEVMGenesisEntries(cdc codec.JSONCodec, genesis []byte) ([]EVMGenesisEntry, error) {
+	var genDoc struct {
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesis, &genDoc); err != nil {
+		return nil, err
+	}
+
+	evmGenesis, ok := genDoc.AppState[evmtypes.ModuleName]
+	if !ok {
+		return nil, nil
+	}
+
+	var genState evmtypes.GenesisState
+	if err := cdc.UnmarshalJSON(evmGenesis, &genState); err != nil {
+		return nil, err
+	}
+
+	lines, err := jsonStringLines(genesis, evmAccountsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []EVMGenesisEntry
+	check := func(path, value string, normalize func(string) (string, error), validate func(string) error) string {
+		normalized, err := normalize(value)
+		if err == nil {
+			err = validate(value)
+		}
+		if err != nil {
+			entries = append(entries, EVMGenesisEntry{Path: path, Line: lines[path], Value: value, Normalized: normalized, Err: err})
+		}
+		return normalized
+	}
+
+	seenAccounts := make(map[common.Address]string)
+	for i, acc := range genState.Accounts {
+		accPath := fmt.Sprintf("%s[%d]", evmAccountsPath, i)
+
+		addressPath := accPath + ".address"
+		address := check(addressPath, acc.Address, evmtypes.NormalizeGenesisAddress, evmtypes.ValidateGenesisAddress)
+		if address != "" {
+			if seen, ok := seenAccounts[common.HexToAddress(address)]; ok {
+				entries = append(entries, EVMGenesisEntry{
+					Path: addressPath, Line: lines[addressPath], Value: acc.Address,
+					Err: fmt.Errorf("duplicated genesis account, first defined at %s", seen),
+				})
+			} else {
+				seenAccounts[common.HexToAddress(address)] = addressPath
+			}
+		}
+
+		check(accPath+".code", acc.Code, evmtypes.NormalizeGenesisCode, evmtypes.ValidateGenesisCode)
+
+		seenKeys := make(map[string]string)
+		for j, state := range acc.Storage {
+			statePath := fmt.Sprintf("%s.storage[%d]", accPath, j)
+
+			keyPath := statePath + ".key"
+			key := check(keyPath, state.Key, evmtypes.NormalizeGenesisHash, evmtypes.ValidateGenesisHash)
+			check(statePath+".value", state.Value, evmtypes.NormalizeGenesisHash, evmtypes.ValidateGenesisHash)
+
+			if key == "" {
+				continue
+			}
+			if seen, ok := seenKeys[key]; ok {
+				entries = append(entries, EVMGenesisEntry{
+					Path: keyPath, Line: lines[keyPath], Value: state.Key,
+					Err: fmt.Errorf("duplicated state key, first defined at %s", seen),
+				})
+			} else {
+				seenKeys[key] = keyPath
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// jsonStringLines returns the line of each string value of the given JSON
+// document under the given path prefix, indexed by JSON path, e.g.
+// "app_state.evm.accounts[0].address".
+func jsonStringLines(bz []byte, prefix string) (map[string]int, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	lines := make(map[string]int)
+
+	var walk func(path string) error
+	walk = func(path string) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch tok := tok.(type) {
+		case json.Delim:
+			switch tok {
+			case '{':
+				for dec.More() {
+					key, err := dec.Token()
+					if err != nil {
+						return err
+					}
+					field := key.(string)
+					if path != "" {
+						field = path + "." + field
+					}
+					if err := walk(field); err != nil {
+						return err
+					}
+				}
+			case '[':
+				for i := 0; dec.More(); i++ {
+					if err := walk(path + "[" + strconv.Itoa(i) + "]"); err != nil {
+						return err
+					}
+				}
+			}
+			// consume the closing delimiter
+			_, err := dec.Token()
+			return err
+		case string:
+			if strings.HasPrefix(path, prefix) {
+				offset := dec.InputOffset()
+				lines[path] = bytes.Count(bz[:offset], []byte("\n")) + 1
+			}
+		}
+		return nil
+	}
+
+	if err := walk(""); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return lines, nil
+}
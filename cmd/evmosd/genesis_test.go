@@ -0,0 +1,63 @@
+package main_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/app"
+	evmosd "github.com/evmos/evmos/v19/cmd/evmosd"
+	"github.com/evmos/evmos/v19/encoding"
+)
+
+func TestInvalidEVMGenesisEntries(t *testing.T) {
+	bz, err := os.ReadFile(filepath.Join("testdata", "genesis_messy.json"))
+	require.NoError(t, err)
+
+	cdc := encoding.MakeConfig(app.ModuleBasics).Codec
+	entries, err := evmosd.InvalidEVMGenesisEntries(cdc, bz)
+	require.NoError(t, err)
+
+	expEntries := []struct {
+		path       string
+		line       int
+		normalized string
+		errMsg     string
+	}{
+		{
+			"app_state.evm.accounts[0].storage[0].value", 13,
+			"0x000000000000000000000000000000000000000000000000000000000000002a", "must be a 0x-prefixed lower-case 32 bytes hash",
+		},
+		{
+			"app_state.evm.accounts[0].storage[1].key", 16,
+			"0x0000000000000000000000000000000000000000000000000000000000000001", "must be a 0x-prefixed lower-case 32 bytes hash",
+		},
+		{
+			"app_state.evm.accounts[0].storage[1].key", 16,
+			"", "duplicated state key, first defined at app_state.evm.accounts[0].storage[0].key",
+		},
+		{
+			"app_state.evm.accounts[1].address", 22,
+			"0xde0B295669a9FD93d5F28D9Ec85E40f4cb697BAe", "must be 0x-prefixed",
+		},
+		{
+			"app_state.evm.accounts[1].code", 23,
+			"60806040", "code must be a lower-case hex string without 0x prefix",
+		},
+		{
+			"app_state.evm.accounts[2].address", 27,
+			"", "duplicated genesis account, first defined at app_state.evm.accounts[0].address",
+		},
+	}
+
+	require.Len(t, entries, len(expEntries))
+	for i, exp := range expEntries {
+		require.Equal(t, exp.path, entries[i].Path)
+		require.Equal(t, exp.line, entries[i].Line, exp.path)
+		require.Equal(t, exp.normalized, entries[i].Normalized, exp.path)
+		require.ErrorContains(t, entries[i].Err, exp.errMsg)
+	}
+	require.Contains(t, entries[3].String(), `line 22: app_state.evm.accounts[1].address "de0b295669a9fd93d5f28d9ec85e40f4cb697bae"`)
+}
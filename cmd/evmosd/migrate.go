@@ -20,12 +20,17 @@ import (
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 
 	"github.com/evmos/evmos/v19/utils"
+	feemarketv5 "github.com/evmos/evmos/v19/x/feemarket/migrations/v5"
+	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
 )
 
 // FlagGenesisTime defines the genesis time in string format
 const FlagGenesisTime = "genesis-time"
 
-var migrationMap = genutiltypes.MigrationMap{}
+var migrationMap = genutiltypes.MigrationMap{
+	"v19":  migrateFeeMarketGenesis,
+	"tv19": migrateFeeMarketGenesis,
+}
 
 // GetMigrationCallback returns a MigrationCallback for a given version.
 func GetMigrationCallback(version, chainID string) genutiltypes.MigrationCallback {
@@ -36,6 +41,22 @@ func GetMigrationCallback(version, chainID string) genutiltypes.MigrationCallbac
 	return migrationMap[version]
 }
 
+// migrateFeeMarketGenesis migrates the x/feemarket genesis state to hold the
+// base fee of the last block as a first-class field.
+func migrateFeeMarketGenesis(appState genutiltypes.AppMap, clientCtx client.Context) genutiltypes.AppMap {
+	bz, ok := appState[feemarkettypes.ModuleName]
+	if !ok {
+		return appState
+	}
+
+	var state feemarkettypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(bz, &state)
+
+	state = feemarketv5.MigrateGenesis(state)
+	appState[feemarkettypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&state)
+	return appState
+}
+
 // MigrateGenesisCmd returns a command to execute genesis state migration.
 func MigrateGenesisCmd() *cobra.Command {
 	cmd := &cobra.Command{
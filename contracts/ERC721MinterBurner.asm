@@ -0,0 +1,257 @@
+; ERC721MinterBurner is the ERC721 contract deployed by the erc721 module to
+; represent the NFTs of a registered x/nft class on the EVM.
+;
+; It implements the ERC721 and ERC721Metadata interfaces. The deployer is the
+; owner of the contract and is the only account allowed to mint and burn
+; tokens, as the module escrows the NFTs while they are converted.
+;
+; It is written directly in EVM assembly so the compiled artifact in
+; compiled_contracts/ERC721MinterBurner.json can be reproduced without a
+; Solidity toolchain. The address arguments are expected to be ABI encoded.
+;
+; Storage layout:
+;   owner                      -> slot 0
+;   balanceOf(a)               -> slot keccak256(a . 1)
+;   ownerOf(id)                -> slot keccak256(id . 2)
+;   getApproved(id)            -> slot keccak256(id . 3)
+;   isApprovedForAll(o, op)    -> slot keccak256(op . keccak256(o . 4))
+;   tokenURI(id)               -> string at keccak256(id . 5)
+;   name, symbol               -> strings at keccak256("name"), keccak256("symbol")
+;
+; A string stored at a base slot holds its length in the base slot and its
+; words in the following slots.
+;
+; Notation: `@label` pushes a label offset, `sel:"sig"` pushes a function
+; selector, `keccak:"str"` pushes the hash of a string and `str:"str"` pushes
+; the string left-aligned in a 32 byte word.
+
+; constructor(string name, string symbol)
+  CALLER 0 SSTORE
+  ; copy the ABI encoded arguments, appended to the code, to memory
+  RUNTIME_OFFSET RUNTIME_LEN ADD DUP1 CODESIZE SUB SWAP1 0 CODECOPY
+  @ctorSymbol 0 MLOAD keccak:"name" @_ctorStoreString JUMP
+ctorSymbol:
+  @ctorDone 0x20 MLOAD keccak:"symbol" @_ctorStoreString JUMP
+ctorDone:
+  RUNTIME_LEN DUP1 RUNTIME_OFFSET 0 CODECOPY 0 RETURN
+
+; stores the string in memory at ptr at the base slot
+; [ret ptr base] -> []
+_ctorStoreString:
+  DUP2 MLOAD DUP2 SSTORE
+  DUP2 MLOAD 31 ADD 5 SHR 0                                   ; [ret ptr base words i]
+ctorStoreLoop:
+  DUP2 DUP2 LT ISZERO @ctorStoreDone JUMPI
+  DUP1 5 SHL DUP5 ADD 0x20 ADD MLOAD                          ; [ret ptr base words i word]
+  DUP2 DUP5 ADD 1 ADD SSTORE
+  1 ADD @ctorStoreLoop JUMP
+ctorStoreDone:
+  POP POP POP POP JUMP
+
+.runtime
+  CALLVALUE @revert JUMPI
+  0 CALLDATALOAD 0xe0 SHR
+  DUP1 sel:"ownerOf(uint256)" EQ @ownerOf JUMPI
+  DUP1 sel:"balanceOf(address)" EQ @balanceOf JUMPI
+  DUP1 sel:"transferFrom(address,address,uint256)" EQ @transferFrom JUMPI
+  DUP1 sel:"safeTransferFrom(address,address,uint256)" EQ @safeTransferFrom JUMPI
+  DUP1 sel:"safeTransferFrom(address,address,uint256,bytes)" EQ @safeTransferFromWithData JUMPI
+  DUP1 sel:"approve(address,uint256)" EQ @approve JUMPI
+  DUP1 sel:"getApproved(uint256)" EQ @getApproved JUMPI
+  DUP1 sel:"setApprovalForAll(address,bool)" EQ @setApprovalForAll JUMPI
+  DUP1 sel:"isApprovedForAll(address,address)" EQ @isApprovedForAll JUMPI
+  DUP1 sel:"tokenURI(uint256)" EQ @tokenURI JUMPI
+  DUP1 sel:"name()" EQ @name JUMPI
+  DUP1 sel:"symbol()" EQ @symbol JUMPI
+  DUP1 sel:"supportsInterface(bytes4)" EQ @supportsInterface JUMPI
+  DUP1 sel:"owner()" EQ @owner JUMPI
+  DUP1 sel:"mint(address,uint256,string)" EQ @mint JUMPI
+  DUP1 sel:"burn(uint256)" EQ @burn JUMPI
+revert:
+  0 0 REVERT
+
+stop:
+  STOP
+
+; returns the word on top of the stack
+ret:
+  0 MSTORE 0x20 0 RETURN
+
+owner:
+  0 SLOAD @ret JUMP
+
+name:
+  keccak:"name" @_returnString JUMP
+
+symbol:
+  keccak:"symbol" @_returnString JUMP
+
+balanceOf:
+  4 CALLDATALOAD DUP1 ISZERO @revert JUMPI
+  0 MSTORE 1 0x20 MSTORE 0x40 0 KECCAK256 SLOAD @ret JUMP
+
+ownerOf:
+  @ret 4 CALLDATALOAD @_owner JUMP
+
+tokenURI:
+  @tokenURIExists 4 CALLDATALOAD @_owner JUMP
+tokenURIExists:
+  POP 4 CALLDATALOAD 0 MSTORE 5 0x20 MSTORE 0x40 0 KECCAK256 @_returnString JUMP
+
+getApproved:
+  @getApprovedExists 4 CALLDATALOAD @_owner JUMP
+getApprovedExists:
+  POP 4 CALLDATALOAD 0 MSTORE 3 0x20 MSTORE 0x40 0 KECCAK256 SLOAD @ret JUMP
+
+isApprovedForAll:
+  @ret 0x24 CALLDATALOAD 4 CALLDATALOAD @_isOperator JUMP
+
+supportsInterface:
+  ; ERC165, ERC721 and ERC721Metadata
+  4 CALLDATALOAD 0xe0 SHR
+  DUP1 0x01ffc9a7 EQ SWAP1 DUP1 0x80ac58cd EQ SWAP1 0x5b5e139f EQ OR OR @ret JUMP
+
+setApprovalForAll:
+  CALLER 0 MSTORE 4 0x20 MSTORE 0x40 0 KECCAK256 0x20 MSTORE
+  4 CALLDATALOAD 0 MSTORE 0x40 0 KECCAK256                    ; [slot]
+  0x24 CALLDATALOAD ISZERO ISZERO DUP1 SWAP2 SSTORE           ; [approved]
+  0 MSTORE
+  4 CALLDATALOAD CALLER keccak:"ApprovalForAll(address,address,bool)" 0x20 0 LOG3
+  STOP
+
+approve:
+  ; the caller must be the owner of the token or one of its operators
+  @approveOwner 0x24 CALLDATALOAD @_owner JUMP
+approveOwner:                                                 ; [owner]
+  DUP1 CALLER EQ @approveAllowed JUMPI
+  @approveOperator CALLER DUP3 @_isOperator JUMP
+approveOperator:                                              ; [owner approved]
+  ISZERO @revert JUMPI
+approveAllowed:                                               ; [owner]
+  0x24 CALLDATALOAD 0 MSTORE 3 0x20 MSTORE
+  4 CALLDATALOAD 0x40 0 KECCAK256 SSTORE
+  0x24 CALLDATALOAD 4 CALLDATALOAD DUP3 keccak:"Approval(address,address,uint256)" 0 0 LOG4
+  STOP
+
+transferFrom:
+  @stop 0x44 CALLDATALOAD 0x24 CALLDATALOAD 4 CALLDATALOAD @_transfer JUMP
+
+safeTransferFrom:
+  @safeTransferFromDone 0x44 CALLDATALOAD 0x24 CALLDATALOAD 4 CALLDATALOAD @_transfer JUMP
+safeTransferFromDone:
+  ; no data
+  0 0x184 MSTORE 0xa4 @checkReceived JUMP
+
+safeTransferFromWithData:
+  @safeTransferFromWithDataDone 0x44 CALLDATALOAD 0x24 CALLDATALOAD 4 CALLDATALOAD @_transfer JUMP
+safeTransferFromWithDataDone:
+  ; copy the length and the padded words of the data
+  0x64 CALLDATALOAD 4 ADD                                     ; [offset]
+  DUP1 CALLDATALOAD 31 ADD 5 SHR 5 SHL 0x20 ADD               ; [offset size]
+  DUP1 SWAP2 0x184 CALLDATACOPY
+  0x84 ADD                                                    ; [calldata size]
+
+; calls onERC721Received(operator, from, id, data) on the receiver if it is a
+; contract and checks the returned selector
+checkReceived:                                                ; [calldata size]
+  0x24 CALLDATALOAD EXTCODESIZE ISZERO @stop JUMPI
+  sel:"onERC721Received(address,address,uint256,bytes)" 0xe0 SHL 0x100 MSTORE
+  CALLER 0x104 MSTORE
+  4 CALLDATALOAD 0x124 MSTORE
+  0x44 CALLDATALOAD 0x144 MSTORE
+  0x80 0x164 MSTORE
+  0 0 MSTORE
+  0x20 0 DUP3 0x100 0 0x24 CALLDATALOAD GAS CALL ISZERO @revert JUMPI
+  0x20 RETURNDATASIZE LT @revert JUMPI
+  0 MLOAD 0xe0 SHR sel:"onERC721Received(address,address,uint256,bytes)" EQ ISZERO @revert JUMPI
+  STOP
+
+mint:
+  0 SLOAD CALLER EQ ISZERO @revert JUMPI
+  4 CALLDATALOAD DUP1 ISZERO @revert JUMPI                    ; [to]
+  ; the token must not exist
+  0x24 CALLDATALOAD 0 MSTORE 2 0x20 MSTORE 0x40 0 KECCAK256   ; [to slot]
+  DUP1 SLOAD @revert JUMPI
+  DUP2 SWAP1 SSTORE                                           ; [to]
+  DUP1 0 MSTORE 1 0x20 MSTORE 0x40 0 KECCAK256 DUP1 SLOAD 1 ADD SWAP1 SSTORE
+  ; copy the length and the padded words of the URI to memory and store it
+  0x44 CALLDATALOAD 4 ADD                                     ; [to offset]
+  DUP1 CALLDATALOAD 31 ADD 5 SHR 5 SHL 0x20 ADD               ; [to offset size]
+  SWAP1 0x100 CALLDATACOPY
+  @minted 0x100 0x24 CALLDATALOAD 0 MSTORE 5 0x20 MSTORE 0x40 0 KECCAK256 @_storeString JUMP
+minted:                                                       ; [to]
+  0x24 CALLDATALOAD SWAP1 0 keccak:"Transfer(address,address,uint256)" 0 0 LOG4
+  STOP
+
+burn:
+  0 SLOAD CALLER EQ ISZERO @revert JUMPI
+  @burnOwner 4 CALLDATALOAD @_owner JUMP
+burnOwner:                                                    ; [owner]
+  ; clear the approval, the owner and the URI of the token
+  0 4 CALLDATALOAD 0 MSTORE 3 0x20 MSTORE 0x40 0 KECCAK256 SSTORE
+  0 2 0x20 MSTORE 0x40 0 KECCAK256 SSTORE
+  0 5 0x20 MSTORE 0x40 0 KECCAK256 SSTORE
+  DUP1 0 MSTORE 1 0x20 MSTORE 0x40 0 KECCAK256 DUP1 SLOAD 1 SWAP1 SUB SWAP1 SSTORE
+  4 CALLDATALOAD 0 DUP3 keccak:"Transfer(address,address,uint256)" 0 0 LOG4
+  STOP
+
+; returns the owner of the token, reverting if it doesn't exist
+; [ret id] -> [owner]
+_owner:
+  0 MSTORE 2 0x20 MSTORE 0x40 0 KECCAK256 SLOAD
+  DUP1 ISZERO @revert JUMPI
+  SWAP1 JUMP
+
+; [ret operator owner] -> [approved]
+_isOperator:
+  0 MSTORE 4 0x20 MSTORE 0x40 0 KECCAK256 0x20 MSTORE
+  0 MSTORE 0x40 0 KECCAK256 SLOAD
+  SWAP1 JUMP
+
+; transfers the token, checking that the caller is the owner, the approved
+; address or an operator of the owner
+; [ret id to from] -> []
+_transfer:
+  @transferOwner DUP4 @_owner JUMP
+transferOwner:                                                ; [ret id to from owner]
+  DUP2 EQ ISZERO @revert JUMPI
+  DUP2 ISZERO @revert JUMPI
+  DUP1 CALLER EQ @transferAllowed JUMPI
+  DUP3 0 MSTORE 3 0x20 MSTORE 0x40 0 KECCAK256 SLOAD CALLER EQ @transferAllowed JUMPI
+  @transferOperator CALLER DUP3 @_isOperator JUMP
+transferOperator:                                             ; [ret id to from approved]
+  ISZERO @revert JUMPI
+transferAllowed:                                              ; [ret id to from]
+  0 DUP4 0 MSTORE 3 0x20 MSTORE 0x40 0 KECCAK256 SSTORE
+  DUP1 0 MSTORE 1 0x20 MSTORE 0x40 0 KECCAK256 DUP1 SLOAD 1 SWAP1 SUB SWAP1 SSTORE
+  DUP2 0 MSTORE 0x40 0 KECCAK256 DUP1 SLOAD 1 ADD SWAP1 SSTORE
+  DUP2 DUP4 0 MSTORE 2 0x20 MSTORE 0x40 0 KECCAK256 SSTORE
+  DUP3 DUP3 DUP3 keccak:"Transfer(address,address,uint256)" 0 0 LOG4
+  POP POP POP JUMP
+
+; stores the string in memory at ptr at the base slot
+; [ret ptr base] -> []
+_storeString:
+  DUP2 MLOAD DUP2 SSTORE
+  DUP2 MLOAD 31 ADD 5 SHR 0                                   ; [ret ptr base words i]
+storeLoop:
+  DUP2 DUP2 LT ISZERO @storeDone JUMPI
+  DUP1 5 SHL DUP5 ADD 0x20 ADD MLOAD                          ; [ret ptr base words i word]
+  DUP2 DUP5 ADD 1 ADD SSTORE
+  1 ADD @storeLoop JUMP
+storeDone:
+  POP POP POP POP JUMP
+
+; returns the ABI encoded string stored at the base slot
+; [base] -> never returns
+_returnString:
+  0x20 0 MSTORE
+  DUP1 SLOAD DUP1 0x20 MSTORE
+  31 ADD 5 SHR 0                                              ; [base words i]
+returnLoop:
+  DUP2 DUP2 LT ISZERO @returnDone JUMPI
+  DUP1 DUP4 ADD 1 ADD SLOAD                                   ; [base words i word]
+  DUP2 5 SHL 0x40 ADD MSTORE
+  1 ADD @returnLoop JUMP
+returnDone:
+  5 SHL 0x40 ADD 0 RETURN
@@ -0,0 +1,33 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+var (
+	// ERC721MinterBurnerJSON are the compiled bytes of the ERC721MinterBurnerContract,
+	// assembled from ERC721MinterBurner.asm
+	//
+	//go:embed compiled_contracts/ERC721MinterBurner.json
+	ERC721MinterBurnerJSON []byte
+
+	// ERC721MinterBurnerContract is the compiled erc721 contract
+	ERC721MinterBurnerContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(ERC721MinterBurnerJSON, &ERC721MinterBurnerContract)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(ERC721MinterBurnerContract.Bin) == 0 {
+		panic("failed to load ERC721MinterBurner smart contract")
+	}
+}
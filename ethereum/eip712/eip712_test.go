@@ -3,12 +3,14 @@ package eip712_test
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"cosmossdk.io/math"
 
 	chainparams "cosmossdk.io/simapp/params"
 	"github.com/cosmos/cosmos-sdk/client"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/evmos/evmos/v19/ethereum/eip712"
@@ -18,9 +20,13 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	evmostypes "github.com/evmos/evmos/v19/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/evmos/evmos/v19/app"
@@ -263,6 +269,18 @@ func (suite *EIP712TestSuite) TestEIP712() {
 			msgs:          []sdk.Msg{},
 			expectSuccess: false,
 		},
+		{
+			title:   "Succeeds - Numeric ChainID",
+			chainID: "5151",
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(
+					suite.createTestAddress(),
+					suite.createTestAddress(),
+					suite.makeCoins(suite.denom, math.NewInt(1)),
+				),
+			},
+			expectSuccess: true,
+		},
 		{
 			title:   "Fails - Invalid ChainID",
 			chainID: "invalidchainid",
@@ -629,3 +647,77 @@ func (suite *EIP712TestSuite) TestTypedDataGeneration() {
 	suite.Require().NoError(err)
 	suite.Require().False(typedData.Types["TypemsgType1"] == nil)
 }
+
+// TestTypedDataEthereumTx tests that the typed data of a Cosmos tx wrapping an
+// Ethereum tx displays the Ethereum tx fields.
+// TestTypedDataDomainChainID tests that the EIP-712 domain uses the EIP-155
+// chain-id of both chain-id formats.
+func (suite *EIP712TestSuite) TestTypedDataDomainChainID() {
+	testCases := []struct {
+		chainID    string
+		expChainID int64
+	}{
+		{utils.TestnetChainID + "-1", 9000},
+		{"5151", 5151},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.chainID, func() {
+			msg := banktypes.NewMsgSend(
+				suite.createTestAddress(),
+				suite.createTestAddress(),
+				suite.makeCoins(suite.denom, math.NewInt(1)),
+			)
+			signDoc := legacytx.StdSignBytes(tc.chainID, 1, 1, 0, legacytx.StdFee{Gas: 21000}, []sdk.Msg{msg}, "", nil)
+
+			typedData, err := eip712.GetEIP712TypedDataForMsg(signDoc)
+			if suite.useLegacyEIP712TypedData {
+				typedData, err = eip712.LegacyGetEIP712TypedDataForMsg(signDoc)
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(big.NewInt(tc.expChainID), (*big.Int)(typedData.Domain.ChainId))
+		})
+	}
+}
+
+func (suite *EIP712TestSuite) TestTypedDataEthereumTx() {
+	if suite.useLegacyEIP712TypedData {
+		suite.T().Skip("the legacy EIP-712 typed data doesn't support Ethereum txs")
+	}
+
+	from, priv := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+	chainID := utils.TestnetChainID + "-1"
+	ethChainID, err := evmostypes.ParseChainID(chainID)
+	suite.Require().NoError(err)
+
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  ethChainID,
+		Nonce:    1,
+		To:       &to,
+		Amount:   big.NewInt(10),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(5),
+		Input:    []byte("test"),
+	})
+	msg.From = from.Hex()
+	err = msg.Sign(ethtypes.LatestSignerForChainID(ethChainID), utiltx.NewSigner(priv))
+	suite.Require().NoError(err)
+
+	display, err := msg.Display()
+	suite.Require().NoError(err)
+
+	signDoc := legacytx.StdSignBytes(chainID, 1, 1, 0, legacytx.StdFee{Gas: 21000}, []sdk.Msg{msg}, "", nil)
+	typedData, err := eip712.GetEIP712TypedDataForMsg(signDoc)
+	suite.Require().NoError(err)
+
+	msgValue, ok := typedData.Message["msg0"].(map[string]interface{})["value"].(map[string]interface{})
+	suite.Require().True(ok)
+	suite.Require().Equal(display.From, msgValue["from"])
+	suite.Require().Equal(display.To, msgValue["to"])
+	suite.Require().Equal(display.Value, msgValue["value"])
+	suite.Require().Equal(display.Data, msgValue["data"])
+	suite.Require().Equal("21000", msgValue["gas"])
+	suite.Require().Equal(display.Fee, msgValue["fee"])
+	suite.Require().Equal(display.Raw, msgValue["raw"])
+}
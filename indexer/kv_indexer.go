@@ -104,6 +104,7 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 				}
 				txResult.GasUsed = parsedTx.GasUsed
 				txResult.Failed = parsedTx.Failed
+				txResult.FeePayer = parsedTx.FeePayerHex()
 			}
 
 			cumulativeGasUsed += txResult.GasUsed
@@ -197,6 +198,41 @@ func LoadFirstBlock(db dbm.DB) (int64, error) {
 	return parseBlockNumberFromKey(it.Key())
 }
 
+// RewindToBlock deletes the eth txs indexed for the blocks above the given
+// height and returns the number of txs removed. It is used to rewind the db
+// after a rollback of the chain state, so that the txs of the rolled back
+// blocks are indexed again once those blocks are re-executed.
+func RewindToBlock(db dbm.DB, height int64) (int, error) {
+	it, err := db.Iterator(TxIndexKey(height+1, 0), []byte{KeyPrefixTxIndex + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "RewindToBlock")
+	}
+	defer it.Close()
+
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	removed := 0
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(TxHashKey(common.BytesToHash(it.Value()))); err != nil {
+			return 0, errorsmod.Wrap(err, "delete tx-hash key")
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return 0, errorsmod.Wrap(err, "delete tx-index key")
+		}
+		removed++
+	}
+
+	if err := it.Error(); err != nil {
+		return 0, errorsmod.Wrap(err, "RewindToBlock")
+	}
+
+	if err := batch.Write(); err != nil {
+		return 0, errorsmod.Wrap(err, "RewindToBlock")
+	}
+	return removed, nil
+}
+
 // isEthTx check if the tx is an eth tx
 func isEthTx(tx sdk.Tx) bool {
 	extTx, ok := tx.(authante.HasExtensionOptionsTx)
@@ -188,6 +188,166 @@ func TestKVIndexer(t *testing.T) {
 	}
 }
 
+func TestRewindToBlock(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// buildBlock returns a block at the given height with a single eth tx
+	buildBlock := func(height int64) (*tmtypes.Block, []*abci.ResponseDeliverTx, common.Hash) {
+		to := common.BigToAddress(big.NewInt(1))
+		tx := types.NewTx(&types.EvmTxArgs{
+			Nonce:    uint64(height),
+			To:       &to,
+			Amount:   big.NewInt(1000),
+			GasLimit: 21000,
+		})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+		results := []*abci.ResponseDeliverTx{
+			{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: ""},
+						{Key: "recipient", Value: to.Hex()},
+					}},
+				},
+			},
+		}
+		return block, results, txHash
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	block1, results1, txHash1 := buildBlock(1)
+	block2, results2, txHash2 := buildBlock(2)
+	require.NoError(t, idxer.IndexBlock(block1, results1))
+	require.NoError(t, idxer.IndexBlock(block2, results2))
+
+	// rewinding to the head is a no-op
+	removed, err := indexer.RewindToBlock(db, 2)
+	require.NoError(t, err)
+	require.Zero(t, removed)
+
+	// roll back the last block
+	removed, err = indexer.RewindToBlock(db, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), last)
+
+	_, err = idxer.GetByTxHash(txHash2)
+	require.ErrorContains(t, err, "tx not found")
+	_, err = idxer.GetByBlockAndIndex(2, 0)
+	require.ErrorContains(t, err, "tx not found")
+
+	res, err := idxer.GetByTxHash(txHash1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Height)
+
+	// the tx is found again once the block is re-executed and indexed
+	require.NoError(t, idxer.IndexBlock(block2, results2))
+	res, err = idxer.GetByTxHash(txHash2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Height)
+}
+
+func TestKVIndexerFeePayer(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	feePayer := utiltx.GenerateAddress()
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 21000,
+	})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), utiltx.NewSigner(priv)))
+	txHash := tx.AsTransaction().Hash()
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		attrs       []abci.EventAttribute
+		expFeePayer string
+	}{
+		{
+			"fees paid by the sender",
+			nil,
+			"",
+		},
+		{
+			"fees paid by a fee payer",
+			[]abci.EventAttribute{{Key: types.AttributeKeyFeePayer, Value: feePayer.Hex()}},
+			feePayer.Hex(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			attrs := append([]abci.EventAttribute{
+				{Key: "amount", Value: "1000"},
+				{Key: "ethereumTxHash", Value: txHash.Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "txGasUsed", Value: "21000"},
+				{Key: "txHash", Value: ""},
+			}, tc.attrs...)
+			block := &tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+			results := []*abci.ResponseDeliverTx{
+				{
+					Code: 0,
+					Events: []abci.Event{
+						{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+						}},
+						{Type: types.EventTypeEthereumTx, Attributes: attrs},
+					},
+				},
+			}
+
+			idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+			require.NoError(t, idxer.IndexBlock(block, results))
+
+			res, err := idxer.GetByTxHash(txHash)
+			require.NoError(t, err)
+			require.Equal(t, tc.expFeePayer, res.FeePayer)
+		})
+	}
+}
+
 // MakeEncodingConfig creates the EncodingConfig
 func MakeEncodingConfig() params.EncodingConfig {
 	return evmenc.MakeConfig(app.ModuleBasics)
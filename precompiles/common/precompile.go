@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"time"
 
+	"cosmossdk.io/math"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
@@ -138,34 +139,30 @@ func (p Precompile) RunSetup(
 		}
 	}
 
-	initialGas := ctx.GasMeter().GasConsumed()
-
-	defer HandleGasError(ctx, contract, initialGas, &err)()
-
-	// set the default SDK gas configuration to track gas usage
-	// we are changing the gas meter type, so it panics gracefully when out of gas
-	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(contract.Gas)).
+	// run the precompile call against a new gas meter so that the Cosmos gas
+	// consumed does not depend on the gas already consumed by the transaction ctx.
+	// The meter is limited to the Cosmos gas that converts into the gas left to the
+	// contract, so that the call runs out of gas as soon as it can't be paid. The
+	// consumed gas is converted and charged to the EVM at the end of the call.
+	ctx = ctx.WithGasMeter(newPrecompileGasMeter(contract.Gas, gasRatio(ctx, stateDB))).
 		WithKVGasConfig(p.KvGasConfig).
 		WithTransientKVGasConfig(p.TransientKVGasConfig)
-	// we need to consume the gas that was already used by the EVM
-	ctx.GasMeter().ConsumeGas(initialGas, "creating a new gas meter")
 
-	return ctx, stateDB, s, method, initialGas, args, nil
+	return ctx, stateDB, s, method, ctx.GasMeter().GasConsumed(), args, nil
 }
 
 // HandleGasError handles the out of gas panic by resetting the gas meter and returning an error.
 // This is used in order to avoid panics and to allow for the EVM to continue cleanup if the tx or query run out of gas.
-func HandleGasError(ctx sdk.Context, contract *vm.Contract, initialGas storetypes.Gas, err *error) func() {
+func HandleGasError(ctx sdk.Context, contract *vm.Contract, err *error) func() {
 	return func() {
 		if r := recover(); r != nil {
 			switch r.(type) {
 			case sdk.ErrorOutOfGas:
-				// update contract gas
-				usedGas := ctx.GasMeter().GasConsumed() - initialGas
-				_ = contract.UseGas(usedGas)
+				// the gas meter is limited to the gas left to the contract, which
+				// is entirely consumed
+				_ = contract.UseGas(contract.Gas)
 
 				*err = vm.ErrOutOfGas
-				// FIXME: add InfiniteGasMeter with previous Gas limit.
 				ctx = ctx.WithKVGasConfig(storetypes.GasConfig{}).
 					WithTransientKVGasConfig(storetypes.GasConfig{})
 			default:
@@ -175,6 +172,60 @@ func HandleGasError(ctx sdk.Context, contract *vm.Contract, initialGas storetype
 	}
 }
 
+// RevertOnError rolls back the changes performed on the stateDB cache ctx during
+// the precompile call if it returns an error, so that a failed call does not leave
+// partial keeper writes behind.
+func RevertOnError(stateDB *statedb.StateDB, s snapshot, err *error) func() {
+	return func() {
+		if *err != nil {
+			stateDB.RevertMultiStore(s.MultiStore, s.Events)
+		}
+	}
+}
+
+// ChargeGas converts the Cosmos gas consumed during the precompile call into EVM gas
+// using the precompile gas ratio of the EVM params and charges it to the contract in
+// a single step. It returns an out of gas error without charging any gas if the
+// contract has not enough gas left to cover the cost.
+func (p Precompile) ChargeGas(ctx sdk.Context, stateDB *statedb.StateDB, contract *vm.Contract, initialGas storetypes.Gas) error {
+	consumed := ctx.GasMeter().GasConsumed() - initialGas
+
+	cost, ok := ConvertCosmosGas(consumed, gasRatio(ctx, stateDB))
+	if !ok || contract.Gas < cost {
+		return vm.ErrOutOfGas
+	}
+
+	_ = contract.UseGas(cost)
+	return nil
+}
+
+// ConvertCosmosGas converts the given amount of Cosmos gas into EVM gas using the
+// provided ratio, rounding up. It returns false if the result overflows a uint64.
+func ConvertCosmosGas(gas storetypes.Gas, ratio math.LegacyDec) (uint64, bool) {
+	cost := math.LegacyNewDecFromInt(math.NewIntFromUint64(gas)).Mul(ratio).Ceil().TruncateInt()
+	if !cost.IsUint64() {
+		return 0, false
+	}
+	return cost.Uint64(), true
+}
+
+// gasRatio returns the precompile gas ratio of the EVM params, which are read on
+// a separate gas meter to not charge them to the call.
+func gasRatio(ctx sdk.Context, stateDB *statedb.StateDB) math.LegacyDec {
+	return stateDB.Keeper().GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())).GetPrecompileGasRatio()
+}
+
+// newPrecompileGasMeter returns a gas meter limited to the Cosmos gas that
+// converts into the given EVM gas with the provided ratio, rounding down. The
+// meter is infinite if the limit overflows a uint64.
+func newPrecompileGasMeter(gas uint64, ratio math.LegacyDec) storetypes.GasMeter {
+	limit := math.LegacyNewDecFromInt(math.NewIntFromUint64(gas)).Quo(ratio).TruncateInt()
+	if !limit.IsUint64() {
+		return storetypes.NewInfiniteGasMeter()
+	}
+	return storetypes.NewGasMeter(limit.Uint64())
+}
+
 // AddJournalEntries adds the balanceChange (if corresponds)
 // and precompileCall entries on the stateDB journal
 // This allows to revert the call changes within an evm tx
@@ -135,19 +135,20 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, err
 	}
 
+	// This reverts any state changes of the call on the cache ctx if it returns an error.
+	defer cmn.RevertOnError(stateDB, snapshot, &err)()
+
 	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
-	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+	defer cmn.HandleGasError(ctx, contract, &err)()
 
 	bz, err = p.HandleMethod(ctx, contract, stateDB, method, args)
 	if err != nil {
 		return nil, err
 	}
 
-	cost := ctx.GasMeter().GasConsumed() - initialGas
-
-	if !contract.UseGas(cost) {
-		return nil, vm.ErrOutOfGas
+	if err := p.ChargeGas(ctx, stateDB, contract, initialGas); err != nil {
+		return nil, err
 	}
 	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
 		return nil, err
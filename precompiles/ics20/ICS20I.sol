@@ -91,3 +91,20 @@ interface ICS20I is IICS20Authorization {
     ) external view returns (string memory hash);
 
 }
+
+/// @author Evmos Team
+/// @title ICS20 Transfer Callback Interface
+/// @dev The interface that contracts sending ICS20 transfers can implement to be
+/// notified of the result of their packets. The callback is called by the ERC20
+/// module account with the gas limit set by the ERC20 module parameters.
+interface IICS20Callback {
+    /// @dev Called when the packet sent by the contract is acknowledged or times out.
+    /// @param sequence The sequence of the packet.
+    /// @param success Whether the packet was successfully acknowledged.
+    /// @param ack The acknowledgement bytes, empty on timeout.
+    function onPacketResult(
+        uint64 sequence,
+        bool success,
+        bytes calldata ack
+    ) external;
+}
@@ -10,9 +10,11 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v19/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	erc20keeper "github.com/evmos/evmos/v19/x/erc20/keeper"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 	transferkeeper "github.com/evmos/evmos/v19/x/ibc/transfer/keeper"
@@ -31,6 +33,13 @@ type Precompile struct {
 	stakingKeeper  stakingkeeper.Keeper
 	transferKeeper transferkeeper.Keeper
 	channelKeeper  channelkeeper.Keeper
+	erc20Keeper    erc20keeper.Keeper
+}
+
+// LoadABI loads the ICS-20 ABI from the embedded abi.json file
+// for the ICS-20 precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
 }
 
 // NewPrecompile creates a new ICS-20 Precompile instance as a
@@ -40,8 +49,9 @@ func NewPrecompile(
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
+	erc20Keeper erc20keeper.Keeper,
 ) (*Precompile, error) {
-	newAbi, err := cmn.LoadABI(f, "abi.json")
+	newAbi, err := LoadABI()
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +67,7 @@ func NewPrecompile(
 		transferKeeper: transferKeeper,
 		channelKeeper:  channelKeeper,
 		stakingKeeper:  stakingKeeper,
+		erc20Keeper:    erc20Keeper,
 	}
 
 	// SetAddress defines the address of the ICS-20 compile contract.
@@ -90,9 +101,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, err
 	}
 
+	// This reverts any state changes of the call on the cache ctx if it returns an error.
+	defer cmn.RevertOnError(stateDB, snapshot, &err)()
+
 	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
-	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+	defer cmn.HandleGasError(ctx, contract, &err)()
 
 	switch method.Name {
 	// TODO Approval transactions => need cosmos-sdk v0.46 & ibc-go v6.2.0
@@ -125,10 +139,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, err
 	}
 
-	cost := ctx.GasMeter().GasConsumed() - initialGas
-
-	if !contract.UseGas(cost) {
-		return nil, vm.ErrOutOfGas
+	if err := p.ChargeGas(ctx, stateDB, contract, initialGas); err != nil {
+		return nil, err
 	}
 
 	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
@@ -69,6 +69,11 @@ func (p *Precompile) Transfer(
 		return nil, err
 	}
 
+	// contracts that send packets are notified of their acknowledgement or timeout
+	if contract.CallerAddress != origin {
+		p.erc20Keeper.RegisterIBCCallback(ctx, msg.SourcePort, msg.SourceChannel, res.Sequence, contract.CallerAddress)
+	}
+
 	if contract.CallerAddress != origin && msg.Token.Denom == utils.BaseDenom {
 		// escrow address is also changed on this tx, and it is not a module account
 		// so we need to account for this on the UpdateDirties
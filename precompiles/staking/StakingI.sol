@@ -291,6 +291,19 @@ interface StakingI is authorization.AuthorizationI {
             PageResponse calldata pageResponse
         );
 
+    /// @dev Queries the validator that proposed the current block.
+    /// @return proposer The operator address of the block proposer, or the zero address if unknown.
+    function blockProposer() external view returns (address proposer);
+
+    /// @dev Queries if an address is part of the bonded validator set of the current block.
+    /// @param validatorAddress The operator address of the validator.
+    /// @return True if the validator is bonded, false otherwise.
+    function isValidator(address validatorAddress) external view returns (bool);
+
+    /// @dev Queries the number of validators in the bonded validator set of the current block.
+    /// @return count The number of bonded validators.
+    function validatorCount() external view returns (uint32 count);
+
     /// @dev CreateValidator defines an Event emitted when a create a new validator.
     /// @param validatorAddress The address of the validator
     /// @param value The amount of coin being self delegated
@@ -36,8 +36,22 @@ const (
 	// RedelegationsMethod defines the ABI method name for the staking
 	// Redelegations query.
 	RedelegationsMethod = "redelegations"
+	// BlockProposerMethod defines the ABI method name for the block
+	// proposer query.
+	BlockProposerMethod = "blockProposer"
+	// IsValidatorMethod defines the ABI method name for the query that
+	// checks if an address is part of the bonded validator set.
+	IsValidatorMethod = "isValidator"
+	// ValidatorCountMethod defines the ABI method name for the bonded
+	// validator count query.
+	ValidatorCountMethod = "validatorCount"
 )
 
+// GasValidatorSetQuery is the flat gas charged by the blockProposer, isValidator
+// and validatorCount queries. The validator set is cached per block, so the
+// cost does not depend on the size of the set.
+const GasValidatorSetQuery uint64 = 1_000
+
 // Delegation returns the delegation that a delegator has with a specific validator.
 func (p Precompile) Delegation(
 	ctx sdk.Context,
@@ -196,6 +210,62 @@ func (p Precompile) Redelegations(
 	return out.Pack(method.Outputs)
 }
 
+// BlockProposer returns the operator address of the validator that proposed
+// the current block. It returns the zero address if the proposer is unknown.
+func (p Precompile) BlockProposer(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	ctx.GasMeter().ConsumeGas(GasValidatorSetQuery, BlockProposerMethod)
+
+	return method.Outputs.Pack(p.getValidatorSet(ctx).proposer)
+}
+
+// IsValidator returns true if the given operator address belongs to the
+// bonded validator set of the current block.
+func (p Precompile) IsValidator(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	validatorAddress, err := ParseIsValidatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(GasValidatorSetQuery, IsValidatorMethod)
+
+	_, found := p.getValidatorSet(ctx).validators[validatorAddress]
+
+	return method.Outputs.Pack(found)
+}
+
+// ValidatorCount returns the number of validators in the bonded validator
+// set of the current block.
+func (p Precompile) ValidatorCount(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	ctx.GasMeter().ConsumeGas(GasValidatorSetQuery, ValidatorCountMethod)
+
+	count := uint32(len(p.getValidatorSet(ctx).validators)) // #nosec G701 -- the bonded set is capped by MaxValidators, which is a uint32
+
+	return method.Outputs.Pack(count)
+}
+
 // Allowance returns the remaining allowance of a grantee to the contract.
 func (p Precompile) Allowance(
 	ctx sdk.Context,
@@ -3,6 +3,7 @@ package staking_test
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,8 @@ import (
 	"github.com/evmos/evmos/v19/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v19/precompiles/common"
 	"github.com/evmos/evmos/v19/precompiles/staking"
+	"github.com/evmos/evmos/v19/precompiles/staking/testdata"
+	evmosutil "github.com/evmos/evmos/v19/testutil"
 	testutiltx "github.com/evmos/evmos/v19/testutil/tx"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 )
@@ -785,3 +788,239 @@ func (s *PrecompileTestSuite) TestAllowance() {
 		})
 	}
 }
+
+// operatorHex returns the hex operator address of the i-th genesis validator.
+func (s *PrecompileTestSuite) operatorHex(i int) common.Address {
+	operatorAddress, err := sdk.ValAddressFromBech32(s.validators[i].OperatorAddress)
+	s.Require().NoError(err)
+	return common.BytesToAddress(operatorAddress.Bytes())
+}
+
+// withProposer returns a block context proposed by the given consensus address.
+func (s *PrecompileTestSuite) withProposer(ctx sdk.Context, proposer sdk.ConsAddress, headerHash []byte) sdk.Context {
+	header := ctx.BlockHeader()
+	header.ProposerAddress = proposer
+	return ctx.WithBlockHeader(header).WithHeaderHash(headerHash)
+}
+
+func (s *PrecompileTestSuite) TestBlockProposer() {
+	method := s.precompile.Methods[staking.BlockProposerMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() (sdk.Context, []interface{})
+		expProposer func() common.Address
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of args",
+			func() (sdk.Context, []interface{}) {
+				return s.ctx, []interface{}{s.address}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1),
+		},
+		{
+			"success - proposer is a bonded validator",
+			func() (sdk.Context, []interface{}) {
+				consAddr, err := s.validators[1].GetConsAddr()
+				s.Require().NoError(err)
+				return s.withProposer(s.ctx, consAddr, []byte("block")), []interface{}{}
+			},
+			func() common.Address { return s.operatorHex(1) },
+			false,
+			"",
+		},
+		{
+			"success - unknown proposer returns the zero address",
+			func() (sdk.Context, []interface{}) {
+				unknown := sdk.ConsAddress(testutiltx.GenerateAddress().Bytes())
+				return s.withProposer(s.ctx, unknown, []byte("block")), []interface{}{}
+			},
+			func() common.Address { return common.Address{} },
+			false,
+			"",
+		},
+		{
+			"success - empty proposer returns the zero address",
+			func() (sdk.Context, []interface{}) {
+				return s.withProposer(s.ctx, nil, nil), []interface{}{}
+			},
+			func() common.Address { return common.Address{} },
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 100000)
+
+			ctx, args := tc.malleate()
+			bz, err := s.precompile.BlockProposer(ctx, &method, contract, args)
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				out, err := s.precompile.Unpack(staking.BlockProposerMethod, bz)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expProposer(), out[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestIsValidator() {
+	method := s.precompile.Methods[staking.IsValidatorMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expBonded   bool
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - zero address",
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			false,
+			true,
+			"invalid validator address",
+		},
+		{
+			"success - bonded validator",
+			func() []interface{} {
+				return []interface{}{s.operatorHex(2)}
+			},
+			true,
+			false,
+			"",
+		},
+		{
+			"success - not a validator",
+			func() []interface{} {
+				return []interface{}{testutiltx.GenerateAddress()}
+			},
+			false,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 100000)
+
+			bz, err := s.precompile.IsValidator(s.ctx, &method, contract, tc.malleate())
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				out, err := s.precompile.Unpack(staking.IsValidatorMethod, bz)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expBonded, out[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestValidatorCount() {
+	method := s.precompile.Methods[staking.ValidatorCountMethod]
+	contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 100000)
+
+	bz, err := s.precompile.ValidatorCount(s.ctx, &method, contract, []interface{}{})
+	s.Require().NoError(err)
+
+	out, err := s.precompile.Unpack(staking.ValidatorCountMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(len(s.validators)), out[0])
+
+	_, err = s.precompile.ValidatorCount(s.ctx, &method, contract, []interface{}{s.address})
+	s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1))
+}
+
+func (s *PrecompileTestSuite) TestValidatorSetCache() {
+	method := s.precompile.Methods[staking.BlockProposerMethod]
+
+	consAddr0, err := s.validators[0].GetConsAddr()
+	s.Require().NoError(err)
+	consAddr1, err := s.validators[1].GetConsAddr()
+	s.Require().NoError(err)
+
+	blockProposer := func(ctx sdk.Context) (common.Address, uint64) {
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 100000)
+		bz, err := s.precompile.BlockProposer(ctx, &method, contract, []interface{}{})
+		s.Require().NoError(err)
+		out, err := s.precompile.Unpack(staking.BlockProposerMethod, bz)
+		s.Require().NoError(err)
+		return out[0].(common.Address), ctx.GasMeter().GasConsumed()
+	}
+
+	// the first call of the block loads the set from the store
+	proposer, gasMiss := blockProposer(s.withProposer(s.ctx, consAddr0, []byte("block A")))
+	s.Require().Equal(s.operatorHex(0), proposer)
+
+	// repeated calls within the same block are served from the cache with the same gas
+	proposer, gasHit := blockProposer(s.withProposer(s.ctx, consAddr1, []byte("block A")))
+	s.Require().Equal(s.operatorHex(0), proposer)
+	s.Require().Equal(staking.GasValidatorSetQuery, gasMiss)
+	s.Require().Equal(gasMiss, gasHit)
+
+	// a different block invalidates the cache
+	proposer, _ = blockProposer(s.withProposer(s.ctx, consAddr1, []byte("block B")))
+	s.Require().Equal(s.operatorHex(1), proposer)
+
+	// contexts without a header hash (CheckTx, queries) are never cached
+	proposer, _ = blockProposer(s.withProposer(s.ctx, consAddr0, nil))
+	s.Require().Equal(s.operatorHex(0), proposer)
+	proposer, _ = blockProposer(s.withProposer(s.ctx, consAddr1, []byte("block B")))
+	s.Require().Equal(s.operatorHex(1), proposer)
+}
+
+func (s *PrecompileTestSuite) TestBlockProposerFromContract() {
+	gate, err := testdata.LoadProposerGateContract()
+	s.Require().NoError(err)
+	gateAddr, err := s.DeployContract(gate)
+	s.Require().NoError(err)
+	s.ctx, err = evmosutil.CommitAndCreateNewCtx(s.ctx, s.app, time.Second, nil)
+	s.Require().NoError(err)
+	s.Require().True(s.app.EvmKeeper.IsContract(s.ctx, gateAddr))
+
+	consAddr, err := s.validators[2].GetConsAddr()
+	s.Require().NoError(err)
+	ctx := s.withProposer(s.ctx, consAddr, []byte("proposed block"))
+
+	for i := range s.validators {
+		input, err := gate.ABI.Pack("proposerBranch", s.operatorHex(i))
+		s.Require().NoError(err)
+
+		res, err := s.app.EvmKeeper.CallEVMWithData(ctx, s.address, &gateAddr, input, false)
+		s.Require().NoError(err)
+
+		expBranch := big.NewInt(2)
+		if i == 2 {
+			expBranch = big.NewInt(1)
+		}
+		s.Require().Equal(expBranch, new(big.Int).SetBytes(res.Ret), "validator %d", i)
+	}
+}
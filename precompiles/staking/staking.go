@@ -30,7 +30,8 @@ var f embed.FS
 // Precompile defines the precompiled contract for staking.
 type Precompile struct {
 	cmn.Precompile
-	stakingKeeper stakingkeeper.Keeper
+	stakingKeeper     stakingkeeper.Keeper
+	validatorSetCache *validatorSetCache
 }
 
 // LoadABI loads the staking ABI from the embedded abi.json file
@@ -58,7 +59,8 @@ func NewPrecompile(
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
 		},
-		stakingKeeper: stakingKeeper,
+		stakingKeeper:     stakingKeeper,
+		validatorSetCache: &validatorSetCache{},
 	}
 	// SetAddress defines the address of the staking precompiled contract.
 	p.SetAddress(common.HexToAddress(evmtypes.StakingPrecompileAddress))
@@ -91,9 +93,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, err
 	}
 
+	// This reverts any state changes of the call on the cache ctx if it returns an error.
+	defer cmn.RevertOnError(stateDB, snapshot, &err)()
+
 	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
-	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+	defer cmn.HandleGasError(ctx, contract, &err)()
 
 	switch method.Name {
 	// Authorization transactions
@@ -131,6 +136,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegation(ctx, method, contract, args)
 	case RedelegationsMethod:
 		bz, err = p.Redelegations(ctx, method, contract, args)
+	case BlockProposerMethod:
+		bz, err = p.BlockProposer(ctx, method, contract, args)
+	case IsValidatorMethod:
+		bz, err = p.IsValidator(ctx, method, contract, args)
+	case ValidatorCountMethod:
+		bz, err = p.ValidatorCount(ctx, method, contract, args)
 	// Authorization queries
 	case authorization.AllowanceMethod:
 		bz, err = p.Allowance(ctx, method, contract, args)
@@ -140,10 +151,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, err
 	}
 
-	cost := ctx.GasMeter().GasConsumed() - initialGas
-
-	if !contract.UseGas(cost) {
-		return nil, vm.ErrOutOfGas
+	if err := p.ChargeGas(ctx, stateDB, contract, initialGas); err != nil {
+		return nil, err
 	}
 
 	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
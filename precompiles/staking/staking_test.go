@@ -10,12 +10,14 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/evmos/evmos/v19/app"
 	"github.com/evmos/evmos/v19/precompiles/authorization"
 	"github.com/evmos/evmos/v19/precompiles/staking"
 	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
@@ -473,3 +475,159 @@ func (s *PrecompileTestSuite) TestRun() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestRunGasIndependentOfCtxGasMeter() {
+	var gasUsed []uint64
+
+	for _, consumed := range []uint64{0, 500_000, 5_000_000} {
+		s.SetupTest()
+
+		err := s.CreateAuthorization(s.address, staking.DelegateAuthz, nil)
+		s.Require().NoError(err)
+
+		// the gas already consumed on the ctx must not affect the precompile cost
+		ctx := s.ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+		ctx.GasMeter().ConsumeGas(consumed, "test")
+		stateDB := statedb.New(ctx, s.app.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes())))
+
+		contract, _, err := s.runPrecompile(stateDB, s.delegateInput(), 1_000_000)
+		s.Require().NoError(err, "expected no error when running the precompile with %d gas consumed", consumed)
+		gasUsed = append(gasUsed, 1_000_000-contract.Gas)
+	}
+
+	s.Require().NotZero(gasUsed[0])
+	s.Require().Equal(gasUsed[0], gasUsed[1], "expected the same gas cost regardless of the ctx gas meter")
+	s.Require().Equal(gasUsed[0], gasUsed[2], "expected the same gas cost regardless of the ctx gas meter")
+}
+
+func (s *PrecompileTestSuite) TestRunPrecompileGasRatio() {
+	runDelegate := func(ratio math.LegacyDec) uint64 {
+		s.SetupTest()
+
+		params := s.app.EvmKeeper.GetParams(s.ctx)
+		params.PrecompileGasRatio = ratio
+		s.Require().NoError(s.app.EvmKeeper.SetParams(s.ctx, params))
+
+		err := s.CreateAuthorization(s.address, staking.DelegateAuthz, nil)
+		s.Require().NoError(err)
+
+		contract, _, err := s.runPrecompile(s.stateDB, s.delegateInput(), 1_000_000)
+		s.Require().NoError(err, "expected no error when running the precompile")
+		return 1_000_000 - contract.Gas
+	}
+
+	gasUsed := runDelegate(math.LegacyOneDec())
+	s.Require().Equal(2*gasUsed, runDelegate(math.LegacyNewDec(2)), "expected the gas cost to be scaled by the ratio")
+}
+
+func (s *PrecompileTestSuite) TestRunGasMeterLimitedByContractGas() {
+	runDelegate := func(gas uint64) (*vm.Contract, error) {
+		s.SetupTest()
+
+		params := s.app.EvmKeeper.GetParams(s.ctx)
+		params.PrecompileGasRatio = math.LegacyNewDec(2)
+		s.Require().NoError(s.app.EvmKeeper.SetParams(s.ctx, params))
+
+		err := s.CreateAuthorization(s.address, staking.DelegateAuthz, nil)
+		s.Require().NoError(err)
+
+		contract, _, err := s.runPrecompile(s.stateDB, s.delegateInput(), gas)
+		return contract, err
+	}
+
+	contract, err := runDelegate(1_000_000)
+	s.Require().NoError(err)
+	cost := 1_000_000 - contract.Gas
+
+	// the call can consume the Cosmos gas converting into the gas left to it
+	contract, err = runDelegate(cost)
+	s.Require().NoError(err)
+	s.Require().Zero(contract.Gas)
+
+	// the call runs out of gas as soon as it can't be paid
+	contract, err = runDelegate(cost - 1)
+	s.Require().ErrorContains(err, vm.ErrOutOfGas.Error())
+	s.Require().Zero(contract.Gas)
+}
+
+func (s *PrecompileTestSuite) TestRunOutOfGasRevertsState() {
+	s.SetupTest()
+
+	err := s.CreateAuthorization(s.address, staking.DelegateAuthz, nil)
+	s.Require().NoError(err)
+
+	delegatorAddr := sdk.AccAddress(s.address.Bytes())
+	prevDelegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegatorAddr, s.validators[0].GetOperator())
+	s.Require().True(found)
+
+	// not enough gas to pay for the Cosmos gas consumed by the delegation
+	contract, bz, err := s.runPrecompile(s.stateDB, s.delegateInput(), 8000)
+	s.Require().ErrorContains(err, vm.ErrOutOfGas.Error())
+	s.Require().Nil(bz)
+	s.Require().Zero(contract.Gas, "expected the call running out of gas to consume all its gas")
+
+	cacheCtx, err := s.stateDB.GetCacheContext()
+	s.Require().NoError(err)
+	delegation, found := s.app.StakingKeeper.GetDelegation(cacheCtx, delegatorAddr, s.validators[0].GetOperator())
+	s.Require().True(found)
+	s.Require().Equal(prevDelegation.Shares, delegation.Shares, "expected no partial writes on the cache ctx")
+
+	s.Require().NoError(s.stateDB.Commit())
+	delegation, found = s.app.StakingKeeper.GetDelegation(s.ctx, delegatorAddr, s.validators[0].GetOperator())
+	s.Require().True(found)
+	s.Require().Equal(prevDelegation.Shares, delegation.Shares, "expected no partial writes after commit")
+}
+
+// delegateInput returns the packed input to delegate to the first validator.
+func (s *PrecompileTestSuite) delegateInput() []byte {
+	input, err := s.precompile.Pack(
+		staking.DelegateMethod,
+		s.address,
+		s.validators[0].GetOperator().String(),
+		big.NewInt(1000),
+	)
+	s.Require().NoError(err, "failed to pack input")
+	return input
+}
+
+// runPrecompile runs the staking precompile with the given input and gas limit
+// on the provided stateDB and returns the contract used for the call.
+func (s *PrecompileTestSuite) runPrecompile(stateDB *statedb.StateDB, input []byte, gas uint64) (*vm.Contract, []byte, error) {
+	baseFee := s.app.FeeMarketKeeper.GetBaseFee(s.ctx)
+
+	contract := vm.NewPrecompile(vm.AccountRef(s.address), s.precompile, big.NewInt(0), gas)
+	contract.Input = input
+	contractAddr := contract.Address()
+
+	txArgs := evmtypes.EvmTxArgs{
+		ChainID:   s.app.EvmKeeper.ChainID(),
+		Nonce:     0,
+		To:        &contractAddr,
+		GasLimit:  gas,
+		GasPrice:  app.MainnetMinGasPrices.BigInt(),
+		GasFeeCap: baseFee,
+		GasTipCap: big.NewInt(1),
+		Accesses:  &ethtypes.AccessList{},
+	}
+	msgEthereumTx := evmtypes.NewTx(&txArgs)
+	msgEthereumTx.From = s.address.String()
+	err := msgEthereumTx.Sign(s.ethSigner, s.signer)
+	s.Require().NoError(err, "failed to sign Ethereum message")
+
+	proposerAddress := s.ctx.BlockHeader().ProposerAddress
+	cfg, err := s.app.EvmKeeper.EVMConfig(s.ctx, proposerAddress, s.app.EvmKeeper.ChainID())
+	s.Require().NoError(err, "failed to instantiate EVM config")
+
+	msg, err := msgEthereumTx.AsMessage(s.ethSigner, baseFee)
+	s.Require().NoError(err, "failed to instantiate Ethereum message")
+
+	evm := s.app.EvmKeeper.NewEVM(s.ctx, msg, cfg, nil, stateDB)
+
+	precompiles, found, err := s.app.EvmKeeper.GetPrecompileInstance(s.ctx, contractAddr)
+	s.Require().NoError(err, "failed to instantiate precompile")
+	s.Require().True(found, "not found precompile")
+	evm.WithPrecompiles(precompiles.Map, precompiles.Addresses)
+
+	bz, err := s.precompile.Run(evm, contract, false)
+	return contract, bz, err
+}
@@ -0,0 +1,25 @@
+; ProposerGate asks the staking precompile for the block proposer and
+; branches on whether it matches the address passed as first argument:
+;
+;   proposerBranch(address expected) returns (uint256)
+;
+; It returns 1 if the expected address proposed the current block and 2
+; otherwise. The function selector is not checked.
+;
+; The compiled bytecode is stored in ProposerGate.json.
+
+; constructor: copy the runtime code and return it
+  PUSH1 0x53 DUP1 PUSH1 0x0b PUSH1 0x00 CODECOPY PUSH1 0x00 RETURN
+
+; runtime: call blockProposer() and store the result at memory 0x00
+  PUSH4 0x5fc7a3fc PUSH1 0xe0 SHL PUSH1 0x00 MSTORE
+  PUSH1 0x20 PUSH1 0x00 PUSH1 0x04 PUSH1 0x00
+  PUSH20 0x0000000000000000000000000000000000000800
+  GAS STATICCALL
+  ISZERO PUSH1 0x4d JUMPI
+  PUSH1 0x00 MLOAD PUSH1 0x04 CALLDATALOAD EQ PUSH1 0x42 JUMPI
+  PUSH1 0x02 PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+proposer: ; 0x42
+  JUMPDEST PUSH1 0x01 PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+revert: ; 0x4d
+  JUMPDEST PUSH1 0x00 PUSH1 0x00 REVERT
@@ -0,0 +1,15 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package testdata
+
+import (
+	contractutils "github.com/evmos/evmos/v19/contracts/utils"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// LoadProposerGateContract loads the ProposerGate contract, which branches
+// on the block proposer returned by the staking precompile.
+func LoadProposerGateContract() (evmtypes.CompiledContract, error) {
+	return contractutils.LegacyLoadContractFromJSONFile("ProposerGate.json")
+}
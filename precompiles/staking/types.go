@@ -409,6 +409,21 @@ func NewValidatorRequest(args []interface{}) (*stakingtypes.QueryValidatorReques
 	return &stakingtypes.QueryValidatorRequest{ValidatorAddr: validatorAddress}, nil
 }
 
+// ParseIsValidatorArgs parses the arguments of the isValidator query and
+// returns the given validator operator address.
+func ParseIsValidatorArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	validatorHexAddr, ok := args[0].(common.Address)
+	if !ok || validatorHexAddr == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidValidator, args[0])
+	}
+
+	return validatorHexAddr, nil
+}
+
 // NewValidatorsRequest create a new QueryValidatorsRequest instance and does sanity checks
 // on the given arguments before populating the request.
 func NewValidatorsRequest(method *abi.Method, args []interface{}) (*stakingtypes.QueryValidatorsRequest, error) {
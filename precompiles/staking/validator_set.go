@@ -0,0 +1,83 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package staking
+
+import (
+	"bytes"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// validatorSet is the snapshot of the block proposer and the bonded validator
+// set that is exposed by the blockProposer, isValidator and validatorCount
+// queries.
+type validatorSet struct {
+	proposer   common.Address
+	validators map[common.Address]struct{}
+}
+
+// validatorSetCache holds the validator set of the block being executed so
+// that repeated queries within the same block don't hit the store again.
+//
+// Only contexts that carry a header hash (i.e. blocks being proposed or
+// delivered) are cached. CheckTx and gRPC query contexts share the height of
+// the block being executed while reading from a different state, so they
+// always load the set from the store.
+type validatorSetCache struct {
+	mu         sync.Mutex
+	headerHash []byte
+	set        *validatorSet
+}
+
+// getValidatorSet returns the validator set for the block of the given context.
+// The store is read with an infinite gas meter so that the gas charged to the
+// caller doesn't depend on whether the set was cached.
+func (p Precompile) getValidatorSet(ctx sdk.Context) *validatorSet {
+	headerHash := ctx.HeaderHash()
+	cacheable := p.validatorSetCache != nil && len(headerHash) > 0
+
+	if cacheable {
+		p.validatorSetCache.mu.Lock()
+		defer p.validatorSetCache.mu.Unlock()
+
+		if p.validatorSetCache.set != nil && bytes.Equal(p.validatorSetCache.headerHash, headerHash) {
+			return p.validatorSetCache.set
+		}
+	}
+
+	set := p.loadValidatorSet(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+
+	if cacheable {
+		p.validatorSetCache.headerHash = append([]byte(nil), headerHash...)
+		p.validatorSetCache.set = set
+	}
+
+	return set
+}
+
+// loadValidatorSet reads the proposer of the current block header and the
+// bonded validators of the last end block from the staking store.
+func (p Precompile) loadValidatorSet(ctx sdk.Context) *validatorSet {
+	set := &validatorSet{
+		validators: make(map[common.Address]struct{}),
+	}
+
+	p.stakingKeeper.IterateLastValidatorPowers(ctx, func(operator sdk.ValAddress, _ int64) bool {
+		set.validators[common.BytesToAddress(operator)] = struct{}{}
+		return false
+	})
+
+	proposerAddr := ctx.BlockHeader().ProposerAddress
+	if len(proposerAddr) == 0 {
+		return set
+	}
+
+	if validator, found := p.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(proposerAddr)); found {
+		set.proposer = common.BytesToAddress(validator.GetOperator())
+	}
+
+	return set
+}
@@ -155,4 +155,31 @@ interface VestingI {
     function balances(
         address vestingAddress
     ) external view returns (Coin[] memory locked, Coin[] memory unvested, Coin[] memory vested);
+
+    /// @dev Defines a query for getting the amount of native coins of an account that are
+    /// still locked up at the current block time. Returns zero for non-vesting accounts.
+    /// @param vestingAddress The address of the vesting account.
+    function locked(
+        address vestingAddress
+    ) external view returns (uint256 amount);
+
+    /// @dev Defines a query for getting the amount of native coins of an account that are
+    /// not vested yet at the current block time. Returns zero for non-vesting accounts.
+    /// @param vestingAddress The address of the vesting account.
+    function unvested(
+        address vestingAddress
+    ) external view returns (uint256 amount);
+
+    /// @dev Defines a query for getting the amount of native coins of an account that are
+    /// vested at the current block time. Returns zero for non-vesting accounts.
+    /// @param vestingAddress The address of the vesting account.
+    function vested(
+        address vestingAddress
+    ) external view returns (uint256 amount);
+
+    /// @dev Defines a query for checking if an account is a clawback vesting account.
+    /// @param vestingAddress The address of the account.
+    function isClawbackVesting(
+        address vestingAddress
+    ) external view returns (bool isClawback);
 }
@@ -3,8 +3,12 @@
 package vesting
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v19/utils"
+	vestingtypes "github.com/evmos/evmos/v19/x/vesting/types"
 )
 
 const (
@@ -32,3 +36,95 @@ func (p Precompile) Balances(
 
 	return method.Outputs.Pack(out.Locked, out.Unvested, out.Vested)
 }
+
+const (
+	// LockedMethod defines the ABI method name for the Locked query.
+	LockedMethod = "locked"
+	// UnvestedMethod defines the ABI method name for the Unvested query.
+	UnvestedMethod = "unvested"
+	// VestedMethod defines the ABI method name for the Vested query.
+	VestedMethod = "vested"
+	// IsClawbackVestingMethod defines the ABI method name for the IsClawbackVesting query.
+	IsClawbackVestingMethod = "isClawbackVesting"
+
+	// GasPerSchedulePeriod is the gas charged for each lockup or vesting period
+	// that is iterated to compute the balances of a clawback vesting account.
+	GasPerSchedulePeriod uint64 = 500
+)
+
+// Locked returns the amount of native coins of an account that are still
+// locked up at the current block time.
+func (p Precompile) Locked(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.scheduleAmount(ctx, method, args, func(va *vestingtypes.ClawbackVestingAccount) sdk.Coins {
+		return va.GetLockedUpCoins(ctx.BlockTime())
+	})
+}
+
+// Unvested returns the amount of native coins of an account that are not
+// vested yet at the current block time.
+func (p Precompile) Unvested(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.scheduleAmount(ctx, method, args, func(va *vestingtypes.ClawbackVestingAccount) sdk.Coins {
+		return va.GetVestingCoins(ctx.BlockTime())
+	})
+}
+
+// Vested returns the amount of native coins of an account that are vested
+// at the current block time.
+func (p Precompile) Vested(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.scheduleAmount(ctx, method, args, func(va *vestingtypes.ClawbackVestingAccount) sdk.Coins {
+		return va.GetVestedCoins(ctx.BlockTime())
+	})
+}
+
+// IsClawbackVesting returns true if the given account is a clawback vesting account.
+func (p Precompile) IsClawbackVesting(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	address, err := ParseVestingAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = p.vestingKeeper.GetClawbackVestingAccount(ctx, address.Bytes())
+	return method.Outputs.Pack(err == nil)
+}
+
+// scheduleAmount returns the native coin amount of the given schedule balance
+// of a clawback vesting account. Accounts that are not clawback vesting accounts
+// return a zero amount. The gas for iterating the account periods is consumed
+// before the balance is computed.
+func (p Precompile) scheduleAmount(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+	balance func(va *vestingtypes.ClawbackVestingAccount) sdk.Coins,
+) ([]byte, error) {
+	address, err := ParseVestingAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	va, err := p.vestingKeeper.GetClawbackVestingAccount(ctx, address.Bytes())
+	if err != nil {
+		return method.Outputs.Pack(big.NewInt(0))
+	}
+
+	periods := uint64(len(va.LockupPeriods) + len(va.VestingPeriods))
+	ctx.GasMeter().ConsumeGas(periods*GasPerSchedulePeriod, "vesting schedule periods")
+
+	return method.Outputs.Pack(balance(va).AmountOf(utils.BaseDenom).BigInt())
+}
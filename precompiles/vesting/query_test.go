@@ -4,9 +4,16 @@ package vesting_test
 
 import (
 	"fmt"
+	"math/big"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	cmn "github.com/evmos/evmos/v19/precompiles/common"
 	"github.com/evmos/evmos/v19/precompiles/vesting"
+	"github.com/evmos/evmos/v19/precompiles/vesting/testdata"
+	evmosutil "github.com/evmos/evmos/v19/testutil"
 )
 
 func (s *PrecompileTestSuite) TestBalances() {
@@ -93,3 +100,127 @@ func (s *PrecompileTestSuite) TestBalances() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestScheduleQueries() {
+	testCases := []struct {
+		name        string
+		malleate    func() common.Address
+		elapsed     time.Duration
+		expLocked   int64
+		expUnvested int64
+		expVested   int64
+		expClawback bool
+	}{
+		{
+			"success - non-vesting account returns zeros",
+			func() common.Address { return s.address },
+			0,
+			0, 0, 0,
+			false,
+		},
+		{
+			"success - clawback vesting account mid-schedule",
+			func() common.Address {
+				s.CreateTestClawbackVestingAccount(s.address, toAddr)
+				s.FundTestClawbackVestingAccount()
+				return toAddr
+			},
+			// lockup period not finished yet and two of four vesting periods passed
+			4500 * time.Second,
+			1000, 500, 500,
+			true,
+		},
+		{
+			"success - clawback vesting account after lockup",
+			func() common.Address {
+				s.CreateTestClawbackVestingAccount(s.address, toAddr)
+				s.FundTestClawbackVestingAccount()
+				return toAddr
+			},
+			6500 * time.Second,
+			0, 250, 750,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			addr := tc.malleate()
+			s.ctx = s.ctx.WithBlockTime(time.Now().Add(tc.elapsed))
+
+			for _, q := range []struct {
+				method string
+				query  func(sdk.Context, *abi.Method, []interface{}) ([]byte, error)
+				exp    int64
+			}{
+				{vesting.LockedMethod, s.precompile.Locked, tc.expLocked},
+				{vesting.UnvestedMethod, s.precompile.Unvested, tc.expUnvested},
+				{vesting.VestedMethod, s.precompile.Vested, tc.expVested},
+			} {
+				method := s.precompile.Methods[q.method]
+				bz, err := q.query(s.ctx, &method, []interface{}{addr})
+				s.Require().NoError(err)
+
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(big.NewInt(q.exp).String(), out[0].(*big.Int).String(), q.method)
+			}
+
+			method := s.precompile.Methods[vesting.IsClawbackVestingMethod]
+			bz, err := s.precompile.IsClawbackVesting(s.ctx, &method, []interface{}{addr})
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expClawback, out[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestScheduleQueriesGas() {
+	method := s.precompile.Methods[vesting.LockedMethod]
+
+	s.CreateTestClawbackVestingAccount(s.address, toAddr)
+	s.FundTestClawbackVestingAccount()
+
+	gasBefore := s.ctx.GasMeter().GasConsumed()
+	_, err := s.precompile.Locked(s.ctx, &method, []interface{}{toAddr})
+	s.Require().NoError(err)
+
+	periods := uint64(len(lockupPeriods) + len(vestingPeriods))
+	s.Require().GreaterOrEqual(s.ctx.GasMeter().GasConsumed()-gasBefore, periods*vesting.GasPerSchedulePeriod)
+}
+
+func (s *PrecompileTestSuite) TestScheduleQueriesFromContract() {
+	querier, err := testdata.LoadVestingQuerierContract()
+	s.Require().NoError(err)
+	querierAddr, err := s.DeployContract(querier)
+	s.Require().NoError(err)
+	s.ctx, err = evmosutil.CommitAndCreateNewCtx(s.ctx, s.app, time.Second, nil)
+	s.Require().NoError(err)
+	s.Require().True(s.app.EvmKeeper.IsContract(s.ctx, querierAddr))
+
+	s.CreateTestClawbackVestingAccount(s.address, toAddr)
+	s.FundTestClawbackVestingAccount()
+
+	// mid-schedule: lockup period not finished and two of four vesting periods passed
+	s.ctx = s.ctx.WithBlockTime(time.Now().Add(4500 * time.Second))
+
+	for methodName, exp := range map[string]interface{}{
+		vesting.LockedMethod:            big.NewInt(1000),
+		vesting.UnvestedMethod:          big.NewInt(500),
+		vesting.VestedMethod:            big.NewInt(500),
+		vesting.IsClawbackVestingMethod: true,
+	} {
+		input, err := s.precompile.Pack(methodName, toAddr)
+		s.Require().NoError(err)
+
+		res, err := s.app.EvmKeeper.CallEVMWithData(s.ctx, s.address, &querierAddr, input, false)
+		s.Require().NoError(err, methodName)
+
+		out, err := s.precompile.Unpack(methodName, res.Ret)
+		s.Require().NoError(err)
+		s.Require().Equal(exp, out[0], methodName)
+	}
+}
@@ -0,0 +1,19 @@
+; VestingQuerier forwards its calldata to the vesting precompile with a
+; STATICCALL and returns (or reverts with) the precompile return data.
+; It is used to test the read-only vesting queries from a contract.
+;
+; The compiled bytecode is stored in VestingQuerier.json.
+
+; constructor: copy the runtime code and return it
+  PUSH1 0x36 DUP1 PUSH1 0x0b PUSH1 0x00 CODECOPY PUSH1 0x00 RETURN
+
+; runtime
+  CALLDATASIZE PUSH1 0x00 PUSH1 0x00 CALLDATACOPY
+  PUSH1 0x00 PUSH1 0x00 CALLDATASIZE PUSH1 0x00
+  PUSH20 0x0000000000000000000000000000000000000803
+  GAS STATICCALL
+  RETURNDATASIZE PUSH1 0x00 PUSH1 0x00 RETURNDATACOPY
+  RETURNDATASIZE SWAP1 PUSH1 0x32 JUMPI
+  PUSH1 0x00 REVERT
+success: ; 0x32
+  JUMPDEST PUSH1 0x00 RETURN
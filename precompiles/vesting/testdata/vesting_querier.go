@@ -0,0 +1,15 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package testdata
+
+import (
+	contractutils "github.com/evmos/evmos/v19/contracts/utils"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// LoadVestingQuerierContract loads the VestingQuerier contract, which
+// forwards its calldata to the vesting precompile with a static call.
+func LoadVestingQuerierContract() (evmtypes.CompiledContract, error) {
+	return contractutils.LegacyLoadContractFromJSONFile("VestingQuerier.json")
+}
@@ -230,6 +230,21 @@ func NewBalancesRequest(args []interface{}) (*vestingtypes.QueryBalancesRequest,
 	return msg, nil
 }
 
+// ParseVestingAddressArgs parses the vesting address argument of the
+// vesting schedule queries.
+func ParseVestingAddressArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	address, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "vestingAddress", "Address", args[0])
+	}
+
+	return address, nil
+}
+
 // validateBasicArgs validates the basic arguments and length of the provided arguments.
 func validateBasicArgs(args []interface{}, expectedLength int) (common.Address, common.Address, error) {
 	if len(args) != expectedLength {
@@ -87,9 +87,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, err
 	}
 
+	// This reverts any state changes of the call on the cache ctx if it returns an error.
+	defer cmn.RevertOnError(stateDB, snapshot, &err)()
+
 	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
-	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+	defer cmn.HandleGasError(ctx, contract, &err)()
 
 	switch method.Name {
 	// Approval transaction
@@ -109,16 +112,22 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// Vesting queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, method, args)
+	case LockedMethod:
+		bz, err = p.Locked(ctx, method, args)
+	case UnvestedMethod:
+		bz, err = p.Unvested(ctx, method, args)
+	case VestedMethod:
+		bz, err = p.Vested(ctx, method, args)
+	case IsClawbackVestingMethod:
+		bz, err = p.IsClawbackVesting(ctx, method, args)
 	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	cost := ctx.GasMeter().GasConsumed() - initialGas
-
-	if !contract.UseGas(cost) {
-		return nil, vm.ErrOutOfGas
+	if err := p.ChargeGas(ctx, stateDB, contract, initialGas); err != nil {
+		return nil, err
 	}
 
 	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
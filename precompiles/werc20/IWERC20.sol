@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "./../erc20/IERC20MetadataAllowance.sol";
+
+/**
+ * @author Evmos Team
+ * @title Wrapped ERC20 Interface
+ * @dev Interface of the wrapped native coin, compatible with WETH9.
+ */
+interface IWERC20 is IERC20MetadataAllowance {
+    /** @dev Emitted when the native coins are deposited in exchange for the wrapped token.
+      * @param dst The address of the depositor.
+      * @param wad The amount of native coins deposited.
+    */
+    event Deposit(address indexed dst, uint256 wad);
+
+    /** @dev Emitted when the wrapped token is withdrawn in exchange for the native coins.
+      * @param src The address of the withdrawer.
+      * @param wad The amount of wrapped tokens withdrawn.
+    */
+    event Withdrawal(address indexed src, uint256 wad);
+
+    /** @dev Default fallback payable function. Deposits the sent native coins.
+    */
+    fallback() external payable;
+
+    /** @dev Default receive payable function. Deposits the sent native coins.
+    */
+    receive() external payable;
+
+    /** @dev Deposits the native coins sent with the call in exchange for the
+      * same amount of wrapped tokens.
+    */
+    function deposit() external payable;
+
+    /** @dev Withdraws the given amount of wrapped tokens in exchange for the
+      * same amount of native coins.
+      * @param wad The amount of wrapped tokens to withdraw.
+    */
+    function withdraw(uint256 wad) external;
+}
@@ -0,0 +1,9 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package werc20
+
+// Errors that have formatted information are defined here as a string.
+const (
+	ErrWithdrawAmountExceedsBalance = "WERC20: withdraw amount %s exceeds balance %s"
+)
@@ -0,0 +1,66 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package werc20
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+)
+
+const (
+	// EventTypeDeposit defines the event type for the WERC-20 deposit transaction.
+	EventTypeDeposit = "Deposit"
+	// EventTypeWithdrawal defines the event type for the WERC-20 withdraw transaction.
+	EventTypeWithdrawal = "Withdrawal"
+)
+
+// EmitDepositEvent creates a new Deposit event emitted on deposit transactions.
+func (p Precompile) EmitDepositEvent(ctx sdk.Context, stateDB vm.StateDB, dst common.Address, amount *big.Int) error {
+	return p.emitEvent(ctx, stateDB, EventTypeDeposit, dst, amount)
+}
+
+// EmitWithdrawalEvent creates a new Withdrawal event emitted on withdraw transactions.
+func (p Precompile) EmitWithdrawalEvent(ctx sdk.Context, stateDB vm.StateDB, src common.Address, amount *big.Int) error {
+	return p.emitEvent(ctx, stateDB, EventTypeWithdrawal, src, amount)
+}
+
+// emitEvent emits a WERC-20 event with the given account as indexed topic and
+// the amount as data.
+func (p Precompile) emitEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, account common.Address, amount *big.Int) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(account)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(amount)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
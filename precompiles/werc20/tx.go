@@ -0,0 +1,87 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package werc20
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+const (
+	// DepositMethod defines the ABI method name for the WERC-20 deposit
+	// transaction.
+	DepositMethod = "deposit"
+	// WithdrawMethod defines the ABI method name for the WERC-20 withdraw
+	// transaction.
+	WithdrawMethod = "withdraw"
+)
+
+// Deposit handles the payable deposit function. The sent coins are transferred
+// to the precompile account by the EVM before the call, so they are credited
+// back to the caller, whose bank balance is its wrapped token balance.
+func (p *Precompile) Deposit(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB) ([]byte, error) {
+	caller := contract.CallerAddress
+	depositedAmount := contract.Value()
+
+	if depositedAmount.Sign() > 0 {
+		coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(depositedAmount)}}
+		if err := p.bankKeeper.SendCoins(ctx, p.Address().Bytes(), caller.Bytes(), coins); err != nil {
+			return nil, err
+		}
+
+		// add the balance changes to the journal, as the deposit is payable and
+		// the EVM has already moved the coins to the precompile account
+		p.SetBalanceChangeEntries(
+			cmn.NewBalanceChangeEntry(p.Address(), depositedAmount, cmn.Sub),
+			cmn.NewBalanceChangeEntry(caller, depositedAmount, cmn.Add),
+		)
+	}
+
+	if err := p.EmitDepositEvent(ctx, stateDB, caller, depositedAmount); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// Withdraw handles the withdraw function. The wrapped tokens are the native
+// coins of the caller, so it only checks that the caller holds the withdrawn
+// amount.
+func (p *Precompile) Withdraw(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, args []interface{}) ([]byte, error) {
+	amount, err := ParseWithdrawArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	caller := contract.CallerAddress
+	balance := p.bankKeeper.GetBalance(ctx, caller.Bytes(), p.tokenPair.Denom)
+	if balance.Amount.BigInt().Cmp(amount) < 0 {
+		return nil, fmt.Errorf(ErrWithdrawAmountExceedsBalance, amount, balance.Amount)
+	}
+
+	if err := p.EmitWithdrawalEvent(ctx, stateDB, caller, amount); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ParseWithdrawArgs parses the arguments of the withdraw method.
+func ParseWithdrawArgs(args []interface{}) (*big.Int, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	amount, ok := args[0].(*big.Int)
+	if !ok || amount == nil {
+		return nil, fmt.Errorf("invalid amount: %v", args[0])
+	}
+
+	return amount, nil
+}
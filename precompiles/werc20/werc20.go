@@ -0,0 +1,163 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package werc20
+
+import (
+	"embed"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	erc20 "github.com/evmos/evmos/v19/precompiles/erc20"
+	erc20types "github.com/evmos/evmos/v19/x/erc20/types"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	transferkeeper "github.com/evmos/evmos/v19/x/ibc/transfer/keeper"
+)
+
+const (
+	// abiPath defines the path to the WERC-20 precompile ABI JSON file.
+	abiPath = "abi.json"
+
+	GasDeposit  = 23_878
+	GasWithdraw = 9_207
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Precompile defines the precompiled contract for the wrapped native coin. It
+// extends the ERC-20 precompile of the native token pair with the WETH9
+// deposit and withdraw methods.
+//
+// The wrapped balance of an account is its bank balance of the native coin, so
+// the wrapped total supply always matches the bank supply. Deposits credit the
+// sent coins back to the caller and withdrawals only check the caller balance.
+type Precompile struct {
+	erc20.Precompile
+	tokenPair  erc20types.TokenPair
+	bankKeeper bankkeeper.Keeper
+}
+
+// NewPrecompile creates a new WERC-20 Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	tokenPair erc20types.TokenPair,
+	bankKeeper bankkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	transferKeeper transferkeeper.Keeper,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, abiPath)
+	if err != nil {
+		return nil, err
+	}
+
+	erc20Precompile, err := erc20.NewPrecompile(tokenPair, bankKeeper, authzKeeper, transferKeeper)
+	if err != nil {
+		return nil, err
+	}
+
+	// use the WERC-20 ABI so that the deposit, withdraw, fallback and receive
+	// methods are resolved on the calls
+	erc20Precompile.ABI = newABI
+
+	return &Precompile{
+		Precompile: *erc20Precompile,
+		tokenPair:  tokenPair,
+		bankKeeper: bankKeeper,
+	}, nil
+}
+
+// RequiredGas calculates the contract gas used for the WERC-20 methods, using
+// the ERC-20 precompile costs for the methods it defines.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: the calls without a method ID are deposits through the fallback or
+	// receive methods
+	if len(input) < 4 {
+		return GasDeposit
+	}
+
+	methodID := input[:4]
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return 0
+	}
+
+	switch method.Name {
+	case DepositMethod:
+		return GasDeposit
+	case WithdrawMethod:
+		return GasWithdraw
+	default:
+		return p.Precompile.RequiredGas(input)
+	}
+}
+
+// Run executes the precompiled contract WERC-20 methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This reverts any state changes of the call on the cache ctx if it returns an error.
+	defer cmn.RevertOnError(stateDB, snapshot, &err)()
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, &err)()
+
+	bz, err = p.HandleMethod(ctx, contract, stateDB, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.ChargeGas(ctx, stateDB, contract, initialGas); err != nil {
+		return nil, err
+	}
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+func (p Precompile) IsTransaction(methodName string) bool {
+	switch methodName {
+	// NOTE: the fallback and receive methods have no name
+	case "",
+		DepositMethod,
+		WithdrawMethod:
+		return true
+	default:
+		return p.Precompile.IsTransaction(methodName)
+	}
+}
+
+// HandleMethod handles the execution of each of the WERC-20 methods, deferring
+// the ERC-20 ones to the embedded ERC-20 precompile.
+func (p *Precompile) HandleMethod(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) (bz []byte, err error) {
+	switch {
+	case method.Type == abi.Fallback,
+		method.Type == abi.Receive,
+		method.Name == DepositMethod:
+		return p.Deposit(ctx, contract, stateDB)
+	case method.Name == WithdrawMethod:
+		return p.Withdraw(ctx, contract, stateDB, args)
+	default:
+		return p.Precompile.HandleMethod(ctx, contract, stateDB, method, args)
+	}
+}
@@ -0,0 +1,159 @@
+package werc20_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/precompiles/erc20"
+	"github.com/evmos/evmos/v19/precompiles/testutil"
+	"github.com/evmos/evmos/v19/precompiles/werc20"
+	"github.com/evmos/evmos/v19/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v19/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v19/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v19/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	erc20keeper "github.com/evmos/evmos/v19/x/erc20/keeper"
+	erc20types "github.com/evmos/evmos/v19/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	"github.com/stretchr/testify/suite"
+)
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the
+// WERC20 precompile tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *werc20.Precompile
+	// passCheck is the log check of the successful calls
+	passCheck testutil.LogCheckArgs
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(integrationNetwork)
+
+	s.network = integrationNetwork
+	s.factory = factory.New(integrationNetwork, grpcHandler)
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+
+	ctx := integrationNetwork.GetContext()
+	pair, found := integrationNetwork.App.Erc20Keeper.GetWrappedNativeTokenPair(ctx)
+	s.Require().True(found, "expected the wrapped native token to be registered")
+
+	precompile, err := werc20.NewPrecompile(
+		pair,
+		integrationNetwork.App.BankKeeper,
+		integrationNetwork.App.AuthzKeeper,
+		integrationNetwork.App.TransferKeeper,
+	)
+	s.Require().NoError(err, "failed to create the WERC20 precompile")
+
+	s.precompile = precompile
+	s.passCheck = testutil.LogCheckArgs{ABIEvents: precompile.Events}.WithExpPass(true)
+}
+
+// call executes a call to the WERC20 precompile sending the given amount.
+func (s *PrecompileTestSuite) call(
+	sender testkeyring.Key,
+	amount *big.Int,
+	method string,
+	check testutil.LogCheckArgs,
+	args ...interface{},
+) {
+	precompileAddr := s.precompile.Address()
+	txArgs := evmtypes.EvmTxArgs{To: &precompileAddr, Amount: amount}
+
+	var err error
+	if method == "" {
+		_, err = s.factory.ExecuteEthTx(sender.Priv, txArgs)
+	} else {
+		callArgs := factory.CallArgs{
+			ContractABI: s.precompile.ABI,
+			MethodName:  method,
+			Args:        args,
+		}
+		_, _, err = s.factory.CallContractAndCheckLogs(sender.Priv, txArgs, callArgs, check)
+	}
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+}
+
+// requireBacked checks that no native coins are escrowed in the wrapped token
+// account, so that the wrapped supply matches the bank supply.
+func (s *PrecompileTestSuite) requireBacked() {
+	msg, broken := erc20keeper.WrappedNativeBackingInvariant(s.network.App.Erc20Keeper)(s.network.GetContext())
+	s.Require().False(broken, msg)
+}
+
+func (s *PrecompileTestSuite) balance(addr common.Address) *big.Int {
+	return s.network.App.BankKeeper.GetBalance(s.network.GetContext(), addr.Bytes(), s.network.GetDenom()).Amount.BigInt()
+}
+
+func (s *PrecompileTestSuite) TestDeposit() {
+	sender := s.keyring.GetKey(0)
+	amount := big.NewInt(1e18)
+
+	s.call(sender, amount, werc20.DepositMethod, s.passCheck.WithExpEvents(werc20.EventTypeDeposit))
+	s.requireBacked()
+
+	// sending coins without calldata deposits them through the receive method
+	s.call(sender, amount, "", testutil.LogCheckArgs{})
+	s.requireBacked()
+	s.Require().Zero(s.balance(s.precompile.Address()).Sign())
+}
+
+func (s *PrecompileTestSuite) TestWithdraw() {
+	sender := s.keyring.GetKey(0)
+	balance := s.balance(sender.Addr)
+
+	// the gas limit is set to not fail on the gas estimation
+	exceedsBalanceCheck := testutil.LogCheckArgs{ABIEvents: s.precompile.Events}.WithErrContains("exceeds balance")
+	precompileAddr := s.precompile.Address()
+	_, _, err := s.factory.CallContractAndCheckLogs(
+		sender.Priv,
+		evmtypes.EvmTxArgs{To: &precompileAddr, GasLimit: 100_000},
+		factory.CallArgs{
+			ContractABI: s.precompile.ABI,
+			MethodName:  werc20.WithdrawMethod,
+			Args:        []interface{}{new(big.Int).Add(balance, big.NewInt(1))},
+		},
+		exceedsBalanceCheck,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+
+	s.call(sender, nil, werc20.WithdrawMethod, s.passCheck.WithExpEvents(werc20.EventTypeWithdrawal), big.NewInt(1e18))
+	s.requireBacked()
+}
+
+func (s *PrecompileTestSuite) TestTransferWrapped() {
+	sender := s.keyring.GetKey(0)
+	receiver := utiltx.GenerateAddress()
+	amount := big.NewInt(1e18)
+
+	s.call(sender, amount, werc20.DepositMethod, s.passCheck.WithExpEvents(werc20.EventTypeDeposit))
+	s.call(sender, nil, erc20.TransferMethod, s.passCheck.WithExpEvents(erc20.EventTypeTransfer), receiver, amount)
+
+	// the transferred wrapped tokens are native coins of the receiver
+	s.Require().Equal(amount, s.balance(receiver))
+	s.requireBacked()
+
+	res, err := s.network.GetERC20Client().WrappedNativeToken(s.network.GetContext(), &erc20types.QueryWrappedNativeTokenRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.precompile.Address().Hex(), common.HexToAddress(res.TokenPair.Erc20Address).Hex())
+	s.Require().Equal(s.network.GetDenom(), res.TokenPair.Denom)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/personal"
 	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/web3"
+	srvflags "github.com/evmos/evmos/v19/server/flags"
 	"github.com/evmos/evmos/v19/types"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
@@ -64,6 +65,17 @@ func init() {
 			indexer types.EVMTxIndexer,
 		) []rpc.API {
 			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+
+			var filterStore *filters.FilterStore
+			if ctx.Viper.GetBool(srvflags.JSONRPCPersistentFilters) {
+				store, err := filters.OpenFilterStore(ctx.Config.RootDir)
+				if err != nil {
+					ctx.Logger.Error("failed to open filter store, filters won't be persisted", "error", err.Error())
+				} else {
+					filterStore = store
+				}
+			}
+
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -74,7 +86,7 @@ func init() {
 				{
 					Namespace: EthNamespace,
 					Version:   apiVersion,
-					Service:   filters.NewPublicAPI(ctx.Logger, clientCtx, tmWSClient, evmBackend),
+					Service:   filters.NewPublicAPI(ctx.Logger, clientCtx, tmWSClient, evmBackend, filterStore),
 					Public:    true,
 				},
 			}
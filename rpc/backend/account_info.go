@@ -3,18 +3,25 @@
 package backend
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
 	sdkmath "cosmossdk.io/math"
+	tmcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	rpctypes "github.com/evmos/evmos/v19/rpc/types"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 	"github.com/pkg/errors"
@@ -39,8 +46,34 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 	return res.Code, nil
 }
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof and any storage proofs.
+//
+// Evmos has no state trie: the proofs are the ICS-23 proofs of the store keys
+// of the account state against the app hash. Each proof is made of the hex
+// encoded proof operations returned by the ABCI query, from the proof of the
+// key in its IAVL module store to the proof of the module store in the
+// multistore, whose root is the app hash of the header of the following block.
+// The account proof is the concatenation of the proofs of:
+//   - the auth account (nonce) in the "acc" store
+//   - the EVM denom balance in the "bank" store
+//   - the code hash in the "evm" store, an absence proof for EOAs
+//
+// and the storage proofs are the proofs of the storage slots in the "evm"
+// store. The storage hash is always empty as the slots are not stored in a
+// trie.
+// Proofs at historical heights require the state of the height, which is only
+// kept by archive nodes.
 func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error) {
+	// the storage keys are checked before any query, as done by geth
+	keys := make([]common.Hash, len(storageKeys))
+	for i, key := range storageKeys {
+		hexKey, err := decodeStorageKey(key)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = hexKey
+	}
+
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
 	if err != nil {
 		return nil, err
@@ -75,8 +108,7 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
 
 	for i, key := range storageKeys {
-		hexKey := common.HexToHash(key)
-		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+		valueBz, proof, err := b.queryProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, keys[i].Bytes()))
 		if err != nil {
 			return nil, err
 		}
@@ -100,7 +132,25 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	// query account proofs
 	accountKey := authtypes.AddressStoreKey(sdk.AccAddress(address.Bytes()))
-	_, proof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	_, proof, err := b.queryProof(clientCtx, authtypes.StoreKey, accountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// query balance proofs
+	params, err := b.queryClient.Params(ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	balanceKey := append(banktypes.CreateAccountBalancesPrefix(address.Bytes()), []byte(params.Params.EvmDenom)...)
+	_, balanceProof, err := b.queryProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// query code hash proofs
+	_, codeHashProof, err := b.queryProof(clientCtx, evmtypes.StoreKey, evmtypes.CodeHashKey(address))
 	if err != nil {
 		return nil, err
 	}
@@ -110,17 +160,53 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		return nil, errors.New("invalid balance")
 	}
 
+	accountProof := GetHexProofs(proof)
+	accountProof = append(accountProof, GetHexProofs(balanceProof)...)
+	accountProof = append(accountProof, GetHexProofs(codeHashProof)...)
+
 	return &rpctypes.AccountResult{
 		Address:      address,
-		AccountProof: GetHexProofs(proof),
+		AccountProof: accountProof,
 		Balance:      (*hexutil.Big)(balance.BigInt()),
 		CodeHash:     common.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Evmos doesn't have a storage hash. TODO: implement?
+		StorageHash:  common.Hash{}, // NOTE: Evmos doesn't have a storage trie
 		StorageProof: storageProofs,
 	}, nil
 }
 
+// queryProof queries the value and the proof of the given store key at the
+// height of the client context. The state of the pruned heights is not
+// available to the query.
+func (b *Backend) queryProof(clientCtx client.Context, storeKey string, key []byte) ([]byte, *tmcrypto.ProofOps, error) {
+	value, proof, err := b.queryClient.GetProof(clientCtx, storeKey, key)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "failed to query the %s store proof at height %d, the state may be pruned", storeKey, clientCtx.Height)
+	}
+	return value, proof, nil
+}
+
+// decodeStorageKey decodes a hex storage key of up to 32 bytes, with or
+// without the 0x prefix, as done by geth.
+func decodeStorageKey(key string) (common.Hash, error) {
+	hexKey := key
+	if strings.HasPrefix(hexKey, "0x") || strings.HasPrefix(hexKey, "0X") {
+		hexKey = hexKey[2:]
+	}
+	if len(hexKey)%2 == 1 {
+		hexKey = "0" + hexKey
+	}
+
+	bz, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("invalid storage key %q: %w", key, err)
+	}
+	if len(bz) > common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid storage key %q: longer than %d bytes", key, common.HashLength)
+	}
+	return common.BytesToHash(bz), nil
+}
+
 // GetStorageAt returns the contract storage at the given address, block number, and key.
 func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
@@ -142,6 +228,60 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 	return value.Bytes(), nil
 }
 
+// StorageRangeAt returns up to maxResults storage slots of the given contract,
+// starting from keyStart, as committed at the end of the block of the given
+// hash. The storage is iterated in ascending order of the slot keys rather
+// than of their hashes, so keyStart and the returned next key are slot keys.
+func (b *Backend) StorageRangeAt(blockHash common.Hash, address common.Address, keyStart hexutil.Bytes, maxResults int) (*rpctypes.StorageRangeResult, error) {
+	if maxResults <= 0 {
+		return nil, fmt.Errorf("max results must be positive: %d", maxResults)
+	}
+
+	resBlock, err := b.TendermintBlockByHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %s not found", blockHash.Hex())
+	}
+
+	req := &evmtypes.QueryStorageRangeRequest{
+		Address: address.String(),
+		Height:  resBlock.Block.Height,
+		Pagination: &query.PageRequest{
+			Key:   keyStart,
+			Limit: uint64(maxResults),
+		},
+	}
+
+	// the state of the block is read from the versioned store by the query, so
+	// it is executed against the latest state
+	res, err := b.queryClient.StorageRange(b.ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.StorageRangeResult{
+		Storage: make(rpctypes.StorageMap, len(res.Storage)),
+	}
+
+	for _, state := range res.Storage {
+		key := common.HexToHash(state.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(state.Value),
+		}
+	}
+
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		nextKey := common.BytesToHash(res.Pagination.NextKey)
+		result.NextKey = &nextKey
+	}
+
+	return result, nil
+}
+
 // GetBalance returns the provided account's balance up to the provided block number.
 func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
@@ -173,12 +313,51 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, errors.New("couldn't fetch balance. Node state is pruned")
 	}
 
-	return (*hexutil.Big)(val.BigInt()), nil
+	balance := val.BigInt()
+	if blockNum == rpctypes.EthPendingBlockNumber && b.cfg.JSONRPC.PendingBalanceOverlay {
+		balance = b.pendingBalance(address, balance)
+	}
+
+	return (*hexutil.Big)(balance), nil
+}
+
+// pendingBalance applies the effects of the EVM txs in the local mempool to the
+// given balance of the address. The value and maximum fee of the txs sent by the
+// address are deducted and the value of the txs it receives is added. The
+// resulting balance is never negative.
+func (b *Backend) pendingBalance(address common.Address, balance *big.Int) *big.Int {
+	pendingTxs, err := b.pendingEthTxsBySender()
+	if err != nil {
+		b.logger.Debug("failed to fetch pending transactions", "error", err.Error())
+		return balance
+	}
+
+	balance = new(big.Int).Set(balance)
+	for sender, txs := range pendingTxs {
+		for _, tx := range txs {
+			if sender == address {
+				balance.Sub(balance, tx.Cost())
+			}
+			if to := tx.To(); to != nil && *to == address {
+				balance.Add(balance, tx.Value())
+			}
+		}
+	}
+
+	if balance.Sign() < 0 {
+		return new(big.Int)
+	}
+
+	return balance
 }
 
 // GetTransactionCount returns the number of transactions at the given address up to the given block number.
 func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error) {
 	n := hexutil.Uint64(0)
+	if err := b.checkSyncedForLatest(blockNum); err != nil {
+		return &n, err
+	}
+
 	bn, err := b.BlockNumber()
 	if err != nil {
 		return &n, err
@@ -3,12 +3,17 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"strings"
 
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmtypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/evmos/evmos/v19/rpc/backend/mocks"
@@ -147,11 +152,26 @@ func (suite *BackendTestSuite) TestGetProof() {
 					authtypes.AddressStoreKey(sdk.AccAddress(address1.Bytes())),
 					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
+				RegisterParamsWithoutHeader(queryClient, bn.Int64())
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/bank/key",
+					append(banktypes.CreateAccountBalancesPrefix(address1.Bytes()), []byte(evmtypes.DefaultEVMDenom)...),
+					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/evm/key",
+					evmtypes.CodeHashKey(address1),
+					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
 			},
 			true,
 			&rpctypes.AccountResult{
 				Address:      address1,
-				AccountProof: []string{""},
+				AccountProof: []string{"", "", ""},
 				Balance:      (*hexutil.Big)(big.NewInt(0)),
 				CodeHash:     common.HexToHash(""),
 				Nonce:        0x0,
@@ -165,6 +185,24 @@ func (suite *BackendTestSuite) TestGetProof() {
 				},
 			},
 		},
+		{
+			"fail - storage key longer than 32 bytes",
+			address1,
+			[]string{"0x" + strings.Repeat("00", 33)},
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(rpctypes.BlockNumber, common.Address) {},
+			false,
+			&rpctypes.AccountResult{},
+		},
+		{
+			"fail - invalid hex storage key",
+			address1,
+			[]string{"0xzz"},
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(rpctypes.BlockNumber, common.Address) {},
+			false,
+			&rpctypes.AccountResult{},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -245,6 +283,111 @@ func (suite *BackendTestSuite) TestGetStorageAt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestStorageRangeAt() {
+	var (
+		addr    = utiltx.GenerateAddress()
+		slot1   = common.BigToHash(big.NewInt(1))
+		slot2   = common.BigToHash(big.NewInt(2))
+		value   = common.BytesToHash([]byte("value"))
+		storage = []evmtypes.State{evmtypes.NewState(slot1, value), evmtypes.NewState(slot2, value)}
+	)
+
+	testCases := []struct {
+		name         string
+		keyStart     hexutil.Bytes
+		maxResults   int
+		registerMock func()
+		expResult    *rpctypes.StorageRangeResult
+		expPass      bool
+	}{
+		{
+			"fail - non positive max results",
+			nil,
+			0,
+			func() {},
+			nil,
+			false,
+		},
+		{
+			"fail - block not found",
+			nil,
+			2,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockByHashNotFound(client, common.Hash{}, nil)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - query client errors on getting the storage range",
+			nil,
+			2,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlockByHash(client, common.Hash{}, nil)
+				suite.Require().NoError(err)
+				RegisterStorageRangeError(queryClient, addr, &query.PageRequest{Limit: 2})
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - last page",
+			nil,
+			2,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlockByHash(client, common.Hash{}, nil)
+				suite.Require().NoError(err)
+				RegisterStorageRange(queryClient, addr, &query.PageRequest{Limit: 2}, storage, nil)
+			},
+			&rpctypes.StorageRangeResult{
+				Storage: rpctypes.StorageMap{
+					crypto.Keccak256Hash(slot1.Bytes()): {Key: &slot1, Value: value},
+					crypto.Keccak256Hash(slot2.Bytes()): {Key: &slot2, Value: value},
+				},
+			},
+			true,
+		},
+		{
+			"pass - next key",
+			slot1.Bytes(),
+			1,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlockByHash(client, common.Hash{}, nil)
+				suite.Require().NoError(err)
+				RegisterStorageRange(queryClient, addr, &query.PageRequest{Key: slot1.Bytes(), Limit: 1}, storage[:1], slot2.Bytes())
+			},
+			&rpctypes.StorageRangeResult{
+				Storage: rpctypes.StorageMap{
+					crypto.Keccak256Hash(slot1.Bytes()): {Key: &slot1, Value: value},
+				},
+				NextKey: &slot2,
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.registerMock()
+
+			result, err := suite.backend.StorageRangeAt(common.Hash{}, addr, tc.keyStart, tc.maxResults)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetBalance() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
 
@@ -429,3 +572,60 @@ func (suite *BackendTestSuite) TestGetTransactionCount() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetBalancePendingOverlay() {
+	addr := utiltx.GenerateAddress()
+	fromAddr, priv := utiltx.NewAddrKey()
+	_, otherPriv := utiltx.NewAddrKey()
+	pendingBlock := rpctypes.EthPendingBlockNumber
+
+	_, bzReceive := suite.buildSignedDynamicFeeTx(otherPriv, 0, big.NewInt(1), addr, big.NewInt(10))
+	_, bzSend := suite.buildSignedDynamicFeeTx(priv, 0, big.NewInt(1), addr, big.NewInt(10))
+
+	testCases := []struct {
+		name       string
+		addr       common.Address
+		overlay    bool
+		expBalance *big.Int
+	}{
+		{
+			"pass - overlay disabled",
+			addr,
+			false,
+			big.NewInt(1),
+		},
+		{
+			"pass - value of the pending txs received",
+			addr,
+			true,
+			big.NewInt(21),
+		},
+		{
+			"pass - cost of the pending tx sent is capped to zero",
+			fromAddr,
+			true,
+			big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.PendingBalanceOverlay = tc.overlay
+
+			var header metadata.MD
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			RegisterParams(queryClient, &header, 1)
+			RegisterBlock(client, 1, nil)
+			RegisterBalance(queryClient, tc.addr, pendingBlock.Int64())
+			if tc.overlay {
+				RegisterUnconfirmedTxs(client, nil, tmtypes.Txs{bzReceive, bzSend})
+			}
+
+			balance, err := suite.backend.GetBalance(tc.addr, rpctypes.BlockNumberOrHash{BlockNumber: &pendingBlock})
+			suite.Require().NoError(err)
+			suite.Require().Equal((*hexutil.Big)(tc.expBalance), balance)
+		})
+	}
+}
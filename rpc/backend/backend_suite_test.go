@@ -12,6 +12,7 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -86,11 +87,17 @@ func (suite *BackendTestSuite) SetupTest() {
 	suite.backend.cfg.JSONRPC.GasCap = 0
 	suite.backend.cfg.JSONRPC.EVMTimeout = 0
 	suite.backend.cfg.JSONRPC.AllowInsecureUnlock = true
+	suite.backend.cfg.JSONRPC.API = []string{"eth", "personal"}
 	suite.backend.queryClient.QueryClient = mocks.NewEVMQueryClient(suite.T())
 	suite.backend.clientCtx.Client = mocks.NewClient(suite.T())
 	suite.backend.queryClient.FeeMarket = mocks.NewFeeMarketQueryClient(suite.T())
 	suite.backend.ctx = rpctypes.ContextWithHeight(1)
 
+	// by default no base fee nor fee history is persisted, so the backend falls
+	// back to the base fee queried at the block height and replays the blocks
+	RegisterFeeMarketBlockBaseFeeNotFound(suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient))
+	RegisterFeeMarketFeeHistoryNotFound(suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient))
+
 	// Add codec
 	encCfg := encoding.MakeConfig(app.ModuleBasics)
 	suite.backend.clientCtx.Codec = encCfg.Codec
@@ -120,6 +127,35 @@ func (suite *BackendTestSuite) buildEthereumTx() (*evmtypes.MsgEthereumTx, []byt
 	return msgEthereumTx, bz
 }
 
+// buildSignedDynamicFeeTx returns a dynamic fee Ethereum transaction signed by
+// the given key and its encoded Cosmos tx bytes
+func (suite *BackendTestSuite) buildSignedDynamicFeeTx(
+	priv cryptotypes.PrivKey, nonce uint64, tip *big.Int, to common.Address, value *big.Int,
+) (*evmtypes.MsgEthereumTx, []byte) {
+	msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   suite.backend.chainID,
+		Nonce:     nonce,
+		To:        &to,
+		Amount:    value,
+		GasLimit:  21000,
+		GasFeeCap: big.NewInt(2_000_000_000),
+		GasTipCap: tip,
+		Accesses:  &ethtypes.AccessList{},
+	})
+
+	ethSigner := ethtypes.LatestSignerForChainID(suite.backend.chainID)
+	msgEthereumTx.From = common.BytesToAddress(priv.PubKey().Address()).Hex()
+	err := msgEthereumTx.Sign(ethSigner, utiltx.NewSigner(priv))
+	suite.Require().NoError(err)
+
+	tx, err := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	suite.Require().NoError(err)
+
+	bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	return msgEthereumTx, bz
+}
+
 // buildFormattedBlock returns a formatted block for testing
 func (suite *BackendTestSuite) buildFormattedBlock(
 	blockRes *tmrpctypes.ResultBlockResults,
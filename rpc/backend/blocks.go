@@ -7,10 +7,11 @@ import (
 	"math"
 	"math/big"
 	"strconv"
+	"time"
 
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -18,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/trie"
 	rpctypes "github.com/evmos/evmos/v19/rpc/types"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -56,6 +58,14 @@ func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
 // block number. Depending on fullTx it either returns the full transaction
 // objects or if false only the hashes of the transactions.
 func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	if err := b.checkSyncedForLatest(blockNum); err != nil {
+		return nil, err
+	}
+
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		return b.pendingBlock(fullTx)
+	}
+
 	resBlock, err := b.TendermintBlockByNumber(blockNum)
 	if err != nil {
 		return nil, nil
@@ -81,6 +91,142 @@ func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (
 	return res, nil
 }
 
+// pendingBlock returns the JSON-RPC compatible Ethereum block that would be
+// built on top of the latest block with the EVM txs of the local mempool,
+// ordered by priority and capped by the block gas limit. As on go-ethereum,
+// the hash, nonce and miner of the pending block are not defined.
+func (b *Backend) pendingBlock(fullTx bool) (map[string]interface{}, error) {
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.EthLatestBlockNumber)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	latest := resBlock.Block
+	height := latest.Height + 1
+
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(rpctypes.ContextWithHeight(latest.Height), b.clientCtx, latest.Height)
+	if err != nil {
+		b.logger.Error("failed to query consensus params", "error", err.Error())
+	}
+
+	baseFee, err := b.pendingBaseFee(latest.Height, gasLimit)
+	if err != nil {
+		b.logger.Debug("failed to compute the pending block base fee", "height", height, "error", err.Error())
+	}
+
+	pendingTxs, err := b.pendingEthTxsBySender()
+	if err != nil {
+		b.logger.Debug("failed to fetch pending transactions", "error", err.Error())
+	}
+
+	var (
+		txs     ethtypes.Transactions
+		gasUsed uint64
+		size    uint64
+	)
+
+	signer := ethtypes.LatestSignerForChainID(b.chainID)
+	ordered := ethtypes.NewTransactionsByPriceAndNonce(signer, pendingTxs, baseFee)
+	for tx := ordered.Peek(); tx != nil; tx = ordered.Peek() {
+		// NOTE: a gas limit equal to -1 means that block gas is unlimited
+		if gasLimit > -1 && gasUsed+tx.Gas() > uint64(gasLimit) { //#nosec G701 -- checked for int overflow already
+			// skip the remaining txs of the sender, as they depend on this one
+			ordered.Pop()
+			continue
+		}
+
+		txs = append(txs, tx)
+		gasUsed += tx.Gas()
+		size += uint64(tx.Size())
+		ordered.Shift()
+	}
+
+	ethRPCTxs := make([]interface{}, 0, len(txs))
+	for txIndex, tx := range txs {
+		if !fullTx {
+			ethRPCTxs = append(ethRPCTxs, tx.Hash())
+			continue
+		}
+
+		rpcTx, err := rpctypes.NewRPCTransaction(
+			tx,
+			common.Hash{},
+			uint64(height),  //#nosec G701 -- checked for int overflow already
+			uint64(txIndex), //#nosec G701 -- checked for int overflow already
+			baseFee,
+			b.chainID,
+		)
+		if err != nil {
+			b.logger.Debug("NewTransactionFromData for pending tx failed", "hash", tx.Hash().Hex(), "error", err.Error())
+			continue
+		}
+		ethRPCTxs = append(ethRPCTxs, rpcTx)
+	}
+
+	header := latest.Header
+	header.Height = height
+	header.Time = time.Now().UTC()
+	header.LastBlockID = tmtypes.BlockID{Hash: latest.Hash()}
+
+	formattedBlock := rpctypes.FormatBlock(
+		header, int(size), //#nosec G701 -- checked for int overflow already
+		gasLimit, new(big.Int).SetUint64(gasUsed),
+		ethRPCTxs, ethtypes.Bloom{}, common.Address{}, baseFee,
+	)
+
+	// the state root and txs root of the pending block are provisional
+	formattedBlock["stateRoot"] = common.Hash{}
+	if len(txs) > 0 {
+		formattedBlock["transactionsRoot"] = ethtypes.DeriveSha(txs, trie.NewStackTrie(nil))
+	}
+
+	// pending block markers
+	formattedBlock["hash"] = nil
+	formattedBlock["nonce"] = nil
+	formattedBlock["miner"] = nil
+
+	return formattedBlock, nil
+}
+
+// pendingBaseFee returns the provisional base fee of the block that follows
+// the given height, computed from the fee market parameters and the block gas
+// wanted at that height. It returns nil if the base fee is not enabled.
+func (b *Backend) pendingBaseFee(height, gasLimit int64) (*big.Int, error) {
+	ctx := rpctypes.ContextWithHeight(height)
+
+	res, err := b.queryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	params := res.Params
+	pendingHeight := height + 1
+	if !params.IsBaseFeeEnabled(pendingHeight) {
+		return nil, nil
+	}
+
+	if pendingHeight == params.EnableHeight {
+		return params.BoundBaseFee(params.BaseFee.BigInt()), nil
+	}
+
+	blockGas, err := b.queryClient.FeeMarket.BlockGas(ctx, &feemarkettypes.QueryBlockGasRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	limit := new(big.Int).SetUint64(math.MaxUint64)
+	if gasLimit > -1 {
+		limit = big.NewInt(gasLimit)
+	}
+
+	parentGasUsed := uint64(blockGas.Gas) //#nosec G701 -- gas wanted is never negative
+	if params.GasWantedEmaWindow > 0 {
+		parentGasUsed = blockGas.GasWantedEma
+	}
+
+	return params.CalcBaseFee(parentGasUsed, limit), nil
+}
+
 // GetBlockByHash returns the JSON-RPC compatible Ethereum block identified by
 // hash.
 func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
@@ -229,6 +375,9 @@ func (b *Backend) BlockNumberFromTendermint(blockNrOrHash rpctypes.BlockNumberOr
 		}
 		return rpctypes.NewBlockNumber(blockNumber), nil
 	case blockNrOrHash.BlockNumber != nil:
+		if err := b.checkSyncedForLatest(*blockNrOrHash.BlockNumber); err != nil {
+			return rpctypes.EthEarliestBlockNumber, err
+		}
 		return *blockNrOrHash.BlockNumber, nil
 	default:
 		return rpctypes.EthEarliestBlockNumber, nil
@@ -413,31 +562,9 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		b.logger.Debug("failed to query BlockBloom", "height", block.Height, "error", err.Error())
 	}
 
-	req := &evmtypes.QueryValidatorAccountRequest{
-		ConsAddress: sdk.ConsAddress(block.Header.ProposerAddress).String(),
-	}
-
-	var validatorAccAddr sdk.AccAddress
+	validatorAddr := b.blockMiner(block.Height, block.Header.ProposerAddress)
 
 	ctx := rpctypes.ContextWithHeight(block.Height)
-	res, err := b.queryClient.ValidatorAccount(ctx, req)
-	if err != nil {
-		b.logger.Debug(
-			"failed to query validator operator address",
-			"height", block.Height,
-			"cons-address", req.ConsAddress,
-			"error", err.Error(),
-		)
-		// use zero address as the validator operator address
-		validatorAccAddr = sdk.AccAddress(common.Address{}.Bytes())
-	} else {
-		validatorAccAddr, err = sdk.AccAddressFromBech32(res.AccountAddress)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	validatorAddr := common.BytesToAddress(validatorAccAddr)
 
 	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(ctx, b.clientCtx, block.Height)
 	if err != nil {
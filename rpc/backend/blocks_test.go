@@ -1629,3 +1629,147 @@ func (suite *BackendTestSuite) TestEthBlockFromTendermintBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestBlockTags() {
+	appHeight := int64(1)
+	addr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		tag       string
+		expNumber ethrpc.BlockNumber
+		expHeight int64
+	}{
+		{ethrpc.BlockParamEarliest, ethrpc.EthEarliestBlockNumber, 1},
+		{ethrpc.BlockParamLatest, ethrpc.EthLatestBlockNumber, appHeight},
+		{ethrpc.BlockParamSafe, ethrpc.EthLatestBlockNumber, appHeight},
+		{ethrpc.BlockParamFinalized, ethrpc.EthLatestBlockNumber, appHeight},
+		{ethrpc.BlockParamPending, ethrpc.EthPendingBlockNumber, appHeight},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.tag), func() {
+			suite.SetupTest() // reset test and queries
+
+			input := []byte(fmt.Sprintf("%q", tc.tag))
+
+			var blockNum ethrpc.BlockNumber
+			suite.Require().NoError(blockNum.UnmarshalJSON(input))
+			suite.Require().Equal(tc.expNumber, blockNum)
+
+			var blockNrOrHash ethrpc.BlockNumberOrHash
+			suite.Require().NoError(blockNrOrHash.UnmarshalJSON(input))
+			suite.Require().Equal(tc.expNumber, *blockNrOrHash.BlockNumber)
+
+			var header metadata.MD
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			if blockNum < 0 {
+				// the app state height is only queried for the latest block
+				RegisterParams(queryClient, &header, appHeight)
+			}
+			RegisterBalance(queryClient, addr, blockNum.Int64())
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			expResultBlock, err := RegisterBlock(client, tc.expHeight, nil)
+			suite.Require().NoError(err)
+
+			// eth_getBlockByNumber
+			resultBlock, err := suite.backend.TendermintBlockByNumber(blockNum)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expResultBlock, resultBlock)
+
+			// eth_getBalance
+			resolved, err := suite.backend.BlockNumberFromTendermint(blockNrOrHash)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expNumber, resolved)
+
+			balance, err := suite.backend.GetBalance(addr, blockNrOrHash)
+			suite.Require().NoError(err)
+			suite.Require().Equal((*hexutil.Big)(big.NewInt(1)), balance)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetPendingBlock() {
+	_, privA := utiltx.NewAddrKey()
+	_, privB := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+
+	// the pending txs are ordered by effective tip, keeping the sender nonce order
+	txA0, bzA0 := suite.buildSignedDynamicFeeTx(privA, 0, big.NewInt(1), to, big.NewInt(1))
+	txA1, bzA1 := suite.buildSignedDynamicFeeTx(privA, 1, big.NewInt(100), to, big.NewInt(1))
+	txB0, bzB0 := suite.buildSignedDynamicFeeTx(privB, 0, big.NewInt(50), to, big.NewInt(1))
+
+	// base fee decreases from the default one, as the latest block is empty
+	expBaseFee := big.NewInt(875_000_000)
+
+	testCases := []struct {
+		name      string
+		fullTx    bool
+		mempool   tmtypes.Txs
+		expHashes []common.Hash
+	}{
+		{
+			"pass - empty mempool",
+			false,
+			nil,
+			[]common.Hash{},
+		},
+		{
+			"pass - mempool txs ordered by priority",
+			false,
+			tmtypes.Txs{bzA0, bzA1, bzB0},
+			[]common.Hash{txB0.AsTransaction().Hash(), txA0.AsTransaction().Hash(), txA1.AsTransaction().Hash()},
+		},
+		{
+			"pass - mempool txs ordered by priority with full txs",
+			true,
+			tmtypes.Txs{bzA1, bzB0, bzA0},
+			[]common.Hash{txB0.AsTransaction().Hash(), txA0.AsTransaction().Hash(), txA1.AsTransaction().Hash()},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+
+			var header metadata.MD
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			RegisterParams(queryClient, &header, 1)
+			resBlock, err := RegisterBlock(client, 1, nil)
+			suite.Require().NoError(err)
+			RegisterConsensusParams(client, 1)
+			RegisterFeeMarketParams(feeMarketClient, 1)
+			RegisterFeeMarketBlockGas(feeMarketClient, 1, 0)
+			RegisterUnconfirmedTxs(client, nil, tc.mempool)
+
+			block, err := suite.backend.GetBlockByNumber(ethrpc.EthPendingBlockNumber, tc.fullTx)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(hexutil.Uint64(2), block["number"])
+			suite.Require().Equal(common.BytesToHash(resBlock.Block.Hash()), block["parentHash"])
+			suite.Require().Equal((*hexutil.Big)(expBaseFee), block["baseFeePerGas"])
+			suite.Require().Nil(block["hash"])
+			suite.Require().Nil(block["nonce"])
+			suite.Require().Nil(block["miner"])
+			suite.Require().Equal((*hexutil.Big)(new(big.Int).SetUint64(uint64(21000*len(tc.mempool)))), block["gasUsed"])
+
+			txs, ok := block["transactions"].([]interface{})
+			suite.Require().True(ok)
+			suite.Require().Len(txs, len(tc.expHashes))
+			for i, expHash := range tc.expHashes {
+				if !tc.fullTx {
+					suite.Require().Equal(expHash, txs[i])
+					continue
+				}
+
+				rpcTx, ok := txs[i].(*ethrpc.RPCTransaction)
+				suite.Require().True(ok)
+				suite.Require().Equal(expHash, rpcTx.Hash)
+				suite.Require().Nil(rpcTx.BlockHash)
+			}
+
+			if len(tc.expHashes) == 0 {
+				suite.Require().Equal(ethtypes.EmptyRootHash, block["transactionsRoot"])
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	rpctypes "github.com/evmos/evmos/v19/rpc/types"
 	"github.com/evmos/evmos/v19/types"
@@ -104,6 +105,11 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	txHash, err := b.sendRawTransaction(data)
+	return txHash, toRPCError(err)
+}
+
+func (b *Backend) sendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	// RLP decode raw transaction bytes
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(data); err != nil {
@@ -135,6 +141,13 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
+	// surface the replay protection error before broadcasting in case the
+	// global parameter rejects unprotected txs
+	if err := ethereumTx.ValidateReplayProtection(res.Params.AllowUnprotectedTxs); err != nil {
+		b.logger.Debug("tx failed replay protection validation", "error", err.Error())
+		return common.Hash{}, err
+	}
+
 	cosmosTx, err := ethereumTx.BuildTx(b.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom)
 	if err != nil {
 		b.logger.Error("failed to build cosmos tx", "error", err.Error())
@@ -148,6 +161,11 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
+	if err := validateTxSize(ethereumTx, txBytes, res.Params); err != nil {
+		b.logger.Debug("tx failed size validation", "error", err.Error())
+		return common.Hash{}, err
+	}
+
 	txHash := ethereumTx.AsTransaction().Hash()
 
 	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
@@ -163,6 +181,30 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	return txHash, nil
 }
 
+// validateTxSize returns an error if the encoded tx or the data of the Ethereum
+// tx exceed the size limits of the EVM parameters, so that the tx is rejected at
+// submission instead of being dropped from the mempool.
+func validateTxSize(msg *evmtypes.MsgEthereumTx, txBytes []byte, params evmtypes.Params) error {
+	if err := evmtypes.ValidateCalldataSize(msg.AsTransaction().Data(), params.MaxCalldataBytes); err != nil {
+		return err
+	}
+
+	return evmtypes.ValidateTxBytes(len(txBytes), params.MaxTxBytes)
+}
+
+// validateFeeCaps returns the same error as geth's state transition if the
+// maxPriorityFeePerGas is higher than the maxFeePerGas, so that the tx is rejected
+// at submission instead of failing during execution.
+func validateFeeCaps(args evmtypes.TransactionArgs) error {
+	if args.MaxFeePerGas.ToInt().Cmp(args.MaxPriorityFeePerGas.ToInt()) < 0 {
+		return fmt.Errorf(
+			"%w: maxPriorityFeePerGas (%v), maxFeePerGas (%v)",
+			core.ErrTipAboveFeeCap, args.MaxPriorityFeePerGas, args.MaxFeePerGas,
+		)
+	}
+	return nil
+}
+
 // SetTxDefaults populates tx message with default values in case they are not
 // provided on the args
 func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
@@ -196,8 +238,8 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 				args.MaxFeePerGas = (*hexutil.Big)(gasFeeCap)
 			}
 
-			if args.MaxFeePerGas.ToInt().Cmp(args.MaxPriorityFeePerGas.ToInt()) < 0 {
-				return args, fmt.Errorf("maxFeePerGas (%v) < maxPriorityFeePerGas (%v)", args.MaxFeePerGas, args.MaxPriorityFeePerGas)
+			if err := validateFeeCaps(args); err != nil {
+				return args, err
 			}
 		} else {
 			if args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil {
@@ -220,8 +262,8 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 	} else {
 		// Both maxPriorityfee and maxFee set by caller. Sanity-check their internal relation
-		if args.MaxFeePerGas.ToInt().Cmp(args.MaxPriorityFeePerGas.ToInt()) < 0 {
-			return args, fmt.Errorf("maxFeePerGas (%v) < maxPriorityFeePerGas (%v)", args.MaxFeePerGas, args.MaxPriorityFeePerGas)
+		if err := validateFeeCaps(args); err != nil {
+			return args, err
 		}
 	}
 
@@ -298,6 +340,22 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		blockNr = *blockNrOptional
 	}
 
+	gas, err := b.estimateGas(args, blockNr)
+	if err != nil && isRevertError(err) {
+		// the revert data is lost through the gRPC query, replay the call at
+		// the gas cap to return it like geth does
+		if _, callErr := b.DoCall(args, blockNr, nil, nil); callErr != nil {
+			return 0, callErr
+		}
+	}
+	return gas, toRPCError(err)
+}
+
+func (b *Backend) estimateGas(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (hexutil.Uint64, error) {
+	if err := b.checkSyncedForLatest(blockNr); err != nil {
+		return 0, err
+	}
+
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return 0, err
@@ -330,7 +388,20 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 // estimated gas used on the operation or an error if fails.
 func (b *Backend) DoCall(
 	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	overrides *evmtypes.BlockOverrides, stateOverrides evmtypes.StateOverride,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, err := b.doCall(args, blockNr, overrides, stateOverrides)
+	return res, toRPCError(err)
+}
+
+func (b *Backend) doCall(
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	overrides *evmtypes.BlockOverrides, stateOverrides evmtypes.StateOverride,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	if err := b.checkSyncedForLatest(blockNr); err != nil {
+		return nil, err
+	}
+
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
@@ -346,23 +417,24 @@ func (b *Backend) DoCall(
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		DefaultBaseFee:  b.cfg.JSONRPC.CallDefaultBaseFee,
 	}
 
-	// From ContextWithHeight: if the provided height is 0,
-	// it will return an empty context and the gRPC query will use
-	// the latest block height for querying.
-	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
-	timeout := b.RPCEVMTimeout()
+	if overrides != nil {
+		req.BlockOverrides, err = json.Marshal(overrides)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	// Setup context so it may be canceled the call has completed
-	// or, in case of unmetered gas, setup a context with a timeout.
-	var cancel context.CancelFunc
-	if timeout > 0 {
-		ctx, cancel = context.WithTimeout(ctx, timeout)
-	} else {
-		ctx, cancel = context.WithCancel(ctx)
+	if stateOverrides != nil {
+		req.StateOverrides, err = json.Marshal(stateOverrides)
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	ctx, cancel := b.evmCallContext(blockNr)
 	// Make sure the context is canceled when the call has completed
 	// this makes sure resources are cleaned up.
 	defer cancel()
@@ -382,6 +454,144 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// CallMany executes the bundles of transactions sequentially on the state at
+// the end of the given block, without committing it, as the eth_callMany api
+// of Erigon. The state overrides are applied before the first bundle. It
+// returns the value or the error of each transaction.
+func (b *Backend) CallMany(
+	bundles []rpctypes.Bundle, stateContext rpctypes.StateContext, stateOverrides evmtypes.StateOverride,
+) ([][]rpctypes.CallManyResult, error) {
+	if stateContext.TransactionIndex != nil && *stateContext.TransactionIndex != -1 {
+		return nil, errors.New("only the state at the end of a block can be simulated, the transaction index must be -1")
+	}
+
+	blockNr, err := b.BlockNumberFromTendermint(stateContext.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	req := evmtypes.EthCallManyRequest{
+		Bundles: make([]evmtypes.EthCallBundle, len(bundles)),
+	}
+	for i, bundle := range bundles {
+		req.Bundles[i].Args, err = json.Marshal(bundle.Transactions)
+		if err != nil {
+			return nil, err
+		}
+
+		if bundle.BlockOverride != nil {
+			req.Bundles[i].BlockOverrides, err = json.Marshal(bundle.BlockOverride)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if stateOverrides != nil {
+		req.StateOverrides, err = json.Marshal(stateOverrides)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := b.callMany(&req, blockNr)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	results := make([][]rpctypes.CallManyResult, len(res.Bundles))
+	for i, bundle := range res.Bundles {
+		results[i] = make([]rpctypes.CallManyResult, len(bundle.Results))
+		for j, result := range bundle.Results {
+			results[i][j] = rpctypes.CallManyResult{GasUsed: hexutil.Uint64(result.GasUsed)}
+			switch result.VmError {
+			case "":
+				results[i][j].Value = result.Ret
+			case vm.ErrExecutionReverted.Error():
+				results[i][j].Error = evmtypes.NewExecErrorWithReason(result.Ret).Error()
+			default:
+				results[i][j].Error = result.VmError
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// CreateAccessList returns the access list of the given transaction on the
+// state at the end of the given block, with the gas it uses with the access
+// list, as the eth_createAccessList api of go-ethereum. The sender, the
+// recipient and the precompiles are not part of the access list.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+) (*rpctypes.AccessListResult, error) {
+	bz, err := json.Marshal([]evmtypes.TransactionArgs{args})
+	if err != nil {
+		return nil, err
+	}
+
+	req := evmtypes.EthCallManyRequest{
+		Bundles:          []evmtypes.EthCallBundle{{Args: bz}},
+		CreateAccessList: true,
+	}
+
+	res, err := b.callMany(&req, blockNr)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+	if len(res.Bundles) != 1 || len(res.Bundles[0].Results) != 1 {
+		return nil, errors.New("invalid access list response")
+	}
+
+	result := res.Bundles[0].Results[0]
+	return &rpctypes.AccessListResult{
+		Accesslist: evmtypes.AccessList(result.AccessList).ToEthAccessList(),
+		Error:      result.VmError,
+		GasUsed:    hexutil.Uint64(result.GasUsed),
+	}, nil
+}
+
+// callMany queries the EthCallMany of the given request on the state at the
+// end of the given block.
+func (b *Backend) callMany(req *evmtypes.EthCallManyRequest, blockNr rpctypes.BlockNumber) (*evmtypes.EthCallManyResponse, error) {
+	if err := b.checkSyncedForLatest(blockNr); err != nil {
+		return nil, err
+	}
+
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req.GasCap = b.RPCGasCap()
+	req.ProposerAddress = sdk.ConsAddress(header.Block.ProposerAddress)
+	req.ChainId = b.chainID.Int64()
+	req.DefaultBaseFee = b.cfg.JSONRPC.CallDefaultBaseFee
+
+	ctx, cancel := b.evmCallContext(blockNr)
+	defer cancel()
+
+	return b.queryClient.EthCallMany(ctx, req)
+}
+
+// evmCallContext returns the context of the EVM call queries at the given
+// block, which is canceled after the EVM timeout if set.
+func (b *Backend) evmCallContext(blockNr rpctypes.BlockNumber) (context.Context, context.CancelFunc) {
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	if timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
@@ -404,14 +614,16 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 		result = big.NewInt(b.RPCMinGasPrice())
 	}
 
-	// return at least GlobalMinGasPrice from FeeMarket module
-	minGasPrice, err := b.GlobalMinGasPrice()
+	// return at least the gas price floor of the node, which covers the
+	// GlobalMinGasPrice from FeeMarket module and the minimum gas prices of the
+	// node, below which its mempool rejects the txs
+	res, err := b.queryClient.GasPriceFloor(b.ctx, &evmtypes.QueryGasPriceFloorRequest{})
 	if err != nil {
 		return nil, err
 	}
-	minGasPriceInt := minGasPrice.TruncateInt().BigInt()
-	if result.Cmp(minGasPriceInt) < 0 {
-		result = minGasPriceInt
+	floor := res.GasPriceFloor.Ceil().TruncateInt().BigInt()
+	if result.Cmp(floor) < 0 {
+		result = floor
 	}
 
 	return (*hexutil.Big)(result), nil
@@ -8,11 +8,13 @@ import (
 	"cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/evmos/evmos/v19/rpc/backend/mocks"
 	rpctypes "github.com/evmos/evmos/v19/rpc/types"
 	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 	"google.golang.org/grpc/metadata"
 )
@@ -284,6 +286,89 @@ func (suite *BackendTestSuite) TestResend() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSetTxDefaults() {
+	baseFee := math.NewInt(100)
+	toAddr := utiltx.GenerateAddress()
+	nonce := hexutil.Uint64(1)
+	gas := hexutil.Uint64(21000)
+
+	testCases := []struct {
+		name      string
+		args      evmtypes.TransactionArgs
+		expPass   bool
+		expFeeCap *big.Int
+	}{
+		{
+			"fail - maxPriorityFeePerGas higher than maxFeePerGas",
+			evmtypes.TransactionArgs{
+				MaxFeePerGas:         (*hexutil.Big)(big.NewInt(10)),
+				MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(11)),
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - default tip higher than maxFeePerGas",
+			evmtypes.TransactionArgs{
+				MaxFeePerGas: (*hexutil.Big)(big.NewInt(1)),
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - maxFeePerGas defaults to 2 x baseFee + maxPriorityFeePerGas",
+			evmtypes.TransactionArgs{
+				MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(5)),
+			},
+			true,
+			big.NewInt(205),
+		},
+		{
+			"pass - maxFeePerGas equal to maxPriorityFeePerGas",
+			evmtypes.TransactionArgs{
+				MaxFeePerGas:         (*hexutil.Big)(big.NewInt(10)),
+				MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(10)),
+			},
+			true,
+			big.NewInt(10),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+
+			var header metadata.MD
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+			RegisterParams(queryClient, &header, 1)
+			_, err := RegisterBlock(client, 1, nil)
+			suite.Require().NoError(err)
+			_, err = RegisterBlockResults(client, 1)
+			suite.Require().NoError(err)
+			RegisterBaseFee(queryClient, baseFee)
+			if tc.args.MaxPriorityFeePerGas == nil {
+				// the tip is suggested from the fee market params
+				RegisterFeeMarketParams(feeMarketClient, 1)
+			}
+
+			tc.args.From = &suite.from
+			tc.args.To = &toAddr
+			tc.args.Nonce = &nonce
+			tc.args.Gas = &gas
+
+			args, err := suite.backend.SetTxDefaults(tc.args)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFeeCap, args.MaxFeePerGas.ToInt())
+			} else {
+				suite.Require().ErrorIs(err, core.ErrTipAboveFeeCap)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestSendRawTransaction() {
 	ethTx, bz := suite.buildEthereumTx()
 
@@ -298,6 +383,12 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 	cosmosTx, _ := ethTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
 	txBytes, _ := suite.backend.clientCtx.TxConfig.TxEncoder()(cosmosTx)
 
+	// Homestead signed (non EIP-155) tx
+	unprotectedTx, _ := suite.buildEthereumTx()
+	err = unprotectedTx.Sign(ethtypes.HomesteadSigner{}, suite.signer)
+	suite.Require().NoError(err)
+	unprotectedBz, _ := rlp.EncodeToBytes(unprotectedTx.AsTransaction())
+
 	testCases := []struct {
 		name         string
 		registerMock func()
@@ -341,6 +432,17 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 			common.Hash{},
 			false,
 		},
+		{
+			"fail - unprotected transactions rejected by the evm params",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+			},
+			unprotectedBz,
+			common.Hash{},
+			false,
+		},
 		{
 			"fail - failed to broadcast transaction",
 			func() {
@@ -385,6 +487,47 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSendRawTransactionSizeLimits() {
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+	ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
+
+	// call and create txs above the calldata and init code size limits
+	signedTxBytes := func(to *common.Address, input []byte) []byte {
+		tx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.backend.chainID,
+			To:       to,
+			Amount:   big.NewInt(0),
+			GasLimit: 100000,
+			GasPrice: big.NewInt(1),
+			Input:    input,
+		})
+		tx.From = suite.from.Hex()
+		suite.Require().NoError(tx.Sign(ethSigner, suite.signer))
+		bz, err := rlp.EncodeToBytes(tx.AsTransaction())
+		suite.Require().NoError(err)
+		return bz
+	}
+	oversizedCallBz := signedTxBytes(&common.Address{}, make([]byte, evmtypes.DefaultMaxCalldataBytes+1))
+	oversizedCreateBz := signedTxBytes(nil, make([]byte, evmtypes.MaxInitCodeSize+1))
+
+	testCases := []struct {
+		name   string
+		rawTx  []byte
+		expErr error
+	}{
+		{"fail - calldata above the evm params limit", oversizedCallBz, evmtypes.ErrOversizedData},
+		{"fail - init code above the EIP-3860 limit", oversizedCreateBz, evmtypes.ErrMaxInitCodeSizeExceeded},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			_, err := suite.backend.SendRawTransaction(tc.rawTx)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestDoCall() {
 	_, bz := suite.buildEthereumTx()
 	gasPrice := (*hexutil.Big)(big.NewInt(1))
@@ -405,14 +548,23 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 	argsBz, err := json.Marshal(callArgs)
 	suite.Require().NoError(err)
+	overrides := &evmtypes.BlockOverrides{BaseFee: gasPrice}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+	nonce := hexutil.Uint64(1)
+	stateOverrides := evmtypes.StateOverride{toAddr: {Nonce: &nonce}}
+	stateOverridesBz, err := json.Marshal(stateOverrides)
+	suite.Require().NoError(err)
 
 	testCases := []struct {
-		name         string
-		registerMock func()
-		blockNum     rpctypes.BlockNumber
-		callArgs     evmtypes.TransactionArgs
-		expEthTx     *evmtypes.MsgEthereumTxResponse
-		expPass      bool
+		name           string
+		registerMock   func()
+		blockNum       rpctypes.BlockNumber
+		callArgs       evmtypes.TransactionArgs
+		overrides      *evmtypes.BlockOverrides
+		stateOverrides evmtypes.StateOverride
+		expEthTx       *evmtypes.MsgEthereumTxResponse
+		expPass        bool
 	}{
 		{
 			"fail - Invalid request",
@@ -425,6 +577,8 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
+			nil,
 			&evmtypes.MsgEthereumTxResponse{},
 			false,
 		},
@@ -439,6 +593,31 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
+			nil,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+		},
+		{
+			"pass - Forwards the overrides and the fee default",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				suite.backend.cfg.JSONRPC.CallDefaultBaseFee = true
+				RegisterEthCall(queryClient, &evmtypes.EthCallRequest{
+					Args:           argsBz,
+					ChainId:        suite.backend.chainID.Int64(),
+					BlockOverrides: overridesBz,
+					DefaultBaseFee: true,
+					StateOverrides: stateOverridesBz,
+				})
+			},
+			rpctypes.BlockNumber(1),
+			callArgs,
+			overrides,
+			stateOverrides,
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
 		},
@@ -449,7 +628,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, tc.overrides, tc.stateOverrides)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -460,6 +639,164 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestCallMany() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	txArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal([]evmtypes.TransactionArgs{txArgs, txArgs})
+	suite.Require().NoError(err)
+	overrides := &evmtypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(2))}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+	balance := (*hexutil.Big)(big.NewInt(1))
+	stateOverrides := evmtypes.StateOverride{toAddr: {Balance: &balance}}
+	stateOverridesBz, err := json.Marshal(stateOverrides)
+	suite.Require().NoError(err)
+
+	blockNum := rpctypes.BlockNumber(1)
+	stateContext := rpctypes.StateContext{BlockNumber: rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}}
+	bundles := []rpctypes.Bundle{{Transactions: []evmtypes.TransactionArgs{txArgs, txArgs}, BlockOverride: overrides}}
+	request := &evmtypes.EthCallManyRequest{
+		Bundles:        []evmtypes.EthCallBundle{{Args: argsBz, BlockOverrides: overridesBz}},
+		ChainId:        suite.backend.chainID.Int64(),
+		StateOverrides: stateOverridesBz,
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		stateContext rpctypes.StateContext
+		expResults   [][]rpctypes.CallManyResult
+		expPass      bool
+	}{
+		{
+			"fail - transaction index within the block",
+			func() {},
+			rpctypes.StateContext{BlockNumber: stateContext.BlockNumber, TransactionIndex: new(int)},
+			nil,
+			false,
+		},
+		{
+			"fail - query error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthCallManyError(queryClient, request)
+			},
+			stateContext,
+			nil,
+			false,
+		},
+		{
+			"pass - values and errors of the transactions",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthCallMany(queryClient, request, &evmtypes.EthCallManyResponse{
+					Bundles: []evmtypes.EthCallBundleResult{{Results: []evmtypes.EthCallResult{
+						{Ret: []byte{1}, GasUsed: 21000},
+						{Ret: revertData("reason"), GasUsed: 22000, VmError: vm.ErrExecutionReverted.Error()},
+					}}},
+				})
+			},
+			stateContext,
+			[][]rpctypes.CallManyResult{{
+				{Value: []byte{1}, GasUsed: 21000},
+				{Error: "execution reverted: reason", GasUsed: 22000},
+			}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			results, err := suite.backend.CallMany(bundles, tc.stateContext, stateOverrides)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, results)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	txArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal([]evmtypes.TransactionArgs{txArgs})
+	suite.Require().NoError(err)
+	request := &evmtypes.EthCallManyRequest{
+		Bundles:          []evmtypes.EthCallBundle{{Args: argsBz}},
+		ChainId:          suite.backend.chainID.Int64(),
+		CreateAccessList: true,
+	}
+	accessList := ethtypes.AccessList{{Address: utiltx.GenerateAddress(), StorageKeys: []common.Hash{{1}}}}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - query error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthCallManyError(queryClient, request)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - access list and gas used",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthCallMany(queryClient, request, &evmtypes.EthCallManyResponse{
+					Bundles: []evmtypes.EthCallBundleResult{{Results: []evmtypes.EthCallResult{
+						{GasUsed: 25000, VmError: vm.ErrExecutionReverted.Error(), AccessList: evmtypes.NewAccessList(&accessList)},
+					}}},
+				})
+			},
+			&rpctypes.AccessListResult{
+				Accesslist: &accessList,
+				Error:      vm.ErrExecutionReverted.Error(),
+				GasUsed:    25000,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.CreateAccessList(txArgs, rpctypes.BlockNumber(1))
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
@@ -483,24 +820,46 @@ func (suite *BackendTestSuite) TestGasPrice() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, math.NewInt(1))
+				RegisterGasPriceFloor(queryClient, math.LegacyOneDec())
 			},
 			defaultGasPrice,
 			true,
 		},
 		{
-			"fail - can't get gasFee, FeeMarketParams error",
+			"pass - node min gas price above the base fee",
+			func() {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterBaseFee(queryClient, math.NewInt(1))
+				// the floor of the node is rounded up to an integer gas price
+				RegisterGasPriceFloor(queryClient, math.LegacyMustNewDecFromStr("10.5"))
+			},
+			(*hexutil.Big)(big.NewInt(11)),
+			true,
+		},
+		{
+			"fail - can't get gasFee, GasPriceFloor error",
 			func() {
 				var header metadata.MD
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
-				RegisterFeeMarketParamsError(feeMarketClient, 1)
+				RegisterFeeMarketParams(feeMarketClient, 1)
 				RegisterParams(queryClient, &header, 1)
 				_, err := RegisterBlock(client, 1, nil)
 				suite.Require().NoError(err)
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, math.NewInt(1))
+				RegisterGasPriceFloorError(queryClient)
 			},
 			defaultGasPrice,
 			false,
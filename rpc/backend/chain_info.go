@@ -66,6 +66,12 @@ func (b *Backend) GlobalMinGasPrice() (math.LegacyDec, error) {
 // If the London hard fork is not activated at the current height, the query will
 // return nil.
 func (b *Backend) BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error) {
+	// the base fee applied by consensus is persisted per height, so it's not
+	// affected by later fee market params changes
+	if baseFee := b.storedBaseFee(blockRes.Height); baseFee != nil {
+		return baseFee, nil
+	}
+
 	// return BaseFee if London hard fork is activated and feemarket is enabled
 	res, err := b.queryClient.BaseFee(rpctypes.ContextWithHeight(blockRes.Height), &evmtypes.QueryBaseFeeRequest{})
 	if err != nil || res.BaseFee == nil {
@@ -92,6 +98,39 @@ func (b *Backend) BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, er
 	return res.BaseFee.BigInt(), nil
 }
 
+// storedFeeHistory returns the fee history recorded by the fee market module
+// for the blocks of the given range, by height. The blocks that aren't
+// recorded are missing, as well as all of them if the query fails.
+func (b *Backend) storedFeeHistory(lastHeight, blockCount int64, rewardPercentiles []float64) map[int64]feemarkettypes.FeeHistoryEntry {
+	res, err := b.queryClient.FeeMarket.FeeHistory(b.ctx, &feemarkettypes.QueryFeeHistoryRequest{
+		LastHeight:        lastHeight,
+		BlockCount:        uint64(blockCount), //#nosec G701 -- block count is never negative
+		RewardPercentiles: rewardPercentiles,
+	})
+	if err != nil {
+		return nil
+	}
+
+	entries := make(map[int64]feemarkettypes.FeeHistoryEntry, len(res.Blocks))
+	for _, entry := range res.Blocks {
+		if len(entry.Rewards) == len(rewardPercentiles) {
+			entries[entry.Height] = entry
+		}
+	}
+	return entries
+}
+
+// storedBaseFee returns the base fee persisted by the fee market module for the
+// given height. It returns nil for heights that are pruned or predate the base
+// fee persistence.
+func (b *Backend) storedBaseFee(height int64) *big.Int {
+	res, err := b.queryClient.FeeMarket.BlockBaseFee(b.ctx, &feemarkettypes.QueryBlockBaseFeeRequest{Height: height})
+	if err != nil || res.BaseFee == nil {
+		return nil
+	}
+	return res.BaseFee.BigInt()
+}
+
 // CurrentHeader returns the latest block header
 // This will return error as per node configuration
 // if the ABCI responses are discarded ('discard_abci_responses' config param)
@@ -191,9 +230,34 @@ func (b *Backend) FeeHistory(
 	// rewards should only be calculated if reward percentiles were included
 	calculateRewards := rewardCount != 0
 
+	// the blocks recorded in the fee history of the fee market module aren't
+	// replayed
+	storedFeeHistory := b.storedFeeHistory(blockEnd, blocks, rewardPercentiles)
+
 	// fetch block
 	for blockID := blockStart; blockID <= blockEnd; blockID++ {
 		index := int32(blockID - blockStart) // #nosec G701
+
+		if entry, ok := storedFeeHistory[blockID]; ok {
+			thisBaseFee[index] = (*hexutil.Big)(entry.BaseFee.BigInt())
+			thisGasUsedRatio[index] = entry.GasUsedRatio
+			if calculateRewards {
+				for j := 0; j < rewardCount; j++ {
+					reward[index][j] = (*hexutil.Big)(entry.Rewards[j].BigInt())
+				}
+			}
+
+			// the base fee of the next block is set by the next iteration
+			if blockID == blockEnd {
+				nextBaseFee, err := b.nextBaseFee(blockID)
+				if err != nil {
+					return nil, err
+				}
+				thisBaseFee[index+1] = (*hexutil.Big)(nextBaseFee)
+			}
+			continue
+		}
+
 		// tendermint block
 		tendermintblock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(blockID))
 		if tendermintblock == nil {
@@ -270,6 +334,27 @@ func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	//          = BaseFee * (ElasticityMultiplier - 1) / Denominator
 	// ```t
 	maxDelta := baseFee.Int64() * (int64(params.Params.ElasticityMultiplier) - 1) / int64(params.Params.BaseFeeChangeDenominator) // #nosec G701
+	if params.Params.GasTarget != 0 {
+		// with an explicit gas target, the elasticity multiplier is ignored:
+		// ```
+		// MaxDelta = BaseFee * (GasLimit - GasTarget) / GasTarget / Denominator
+		// ```
+		height, err := b.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(b.ctx, b.clientCtx, int64(height)) // #nosec G701
+		if err != nil {
+			return nil, err
+		}
+		limit := big.NewInt(gasLimit)
+		if gasTarget := params.Params.BlockGasTarget(limit); gasTarget.Sign() > 0 {
+			delta := new(big.Int).Mul(baseFee, new(big.Int).Sub(limit, gasTarget))
+			delta.Div(delta, gasTarget)
+			delta.Div(delta, big.NewInt(int64(params.Params.BaseFeeChangeDenominator)))
+			maxDelta = delta.Int64()
+		}
+	}
 	if maxDelta < 0 {
 		// impossible if the parameter validation passed.
 		maxDelta = 0
@@ -18,6 +18,7 @@ import (
 	"github.com/evmos/evmos/v19/rpc/backend/mocks"
 	rpc "github.com/evmos/evmos/v19/rpc/types"
 	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	evmostypes "github.com/evmos/evmos/v19/types"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
 )
@@ -136,6 +137,19 @@ func (suite *BackendTestSuite) TestBaseFee() {
 			baseFee.BigInt(),
 			true,
 		},
+		{
+			"pass - persisted base fee is used instead of the base fee at height",
+			&tmrpctypes.ResultBlockResults{Height: 1},
+			func() {
+				// the base fee at height is not queried, so it's not affected by
+				// later fee market params changes
+				feeMarketClient := mocks.NewFeeMarketQueryClient(suite.T())
+				suite.backend.queryClient.FeeMarket = feeMarketClient
+				RegisterFeeMarketBlockBaseFee(feeMarketClient, 1, baseFee)
+			},
+			baseFee.BigInt(),
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -158,12 +172,14 @@ func (suite *BackendTestSuite) TestChainId() {
 	expChainID := (*hexutil.Big)(big.NewInt(9000))
 	testCases := []struct {
 		name         string
+		chainID      string
 		registerMock func()
 		expChainID   *hexutil.Big
 		expPass      bool
 	}{
 		{
 			"pass - block is at or past the EIP-155 replay-protection fork block, return chainID from config ",
+			ChainID,
 			func() {
 				var header metadata.MD
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
@@ -172,17 +188,32 @@ func (suite *BackendTestSuite) TestChainId() {
 			expChainID,
 			true,
 		},
+		{
+			"pass - numeric chain-id",
+			"5151",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParamsInvalidHeight(queryClient, &header, int64(1))
+			},
+			(*hexutil.Big)(big.NewInt(5151)),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
 			suite.SetupTest() // reset test and queries
+			chainID, err := evmostypes.ParseChainID(tc.chainID)
+			suite.Require().NoError(err)
+			suite.backend.clientCtx = suite.backend.clientCtx.WithChainID(tc.chainID)
+			suite.backend.chainID = chainID
 			tc.registerMock()
 
-			chainID, err := suite.backend.ChainID()
+			res, err := suite.backend.ChainID()
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(tc.expChainID, chainID)
+				suite.Require().Equal(tc.expChainID, res)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -437,6 +468,37 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
 			true,
 		},
+		{
+			"pass - FeeHistoryResults from the stored fee history",
+			func(_ sdk.AccAddress) {
+				feeMarketClient := mocks.NewFeeMarketQueryClient(suite.T())
+				suite.backend.queryClient.FeeMarket = feeMarketClient
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				rewards := []math.Int{math.NewInt(1), math.NewInt(2), math.NewInt(3), math.NewInt(4)}
+				RegisterFeeMarketFeeHistory(feeMarketClient, &feemarkettypes.QueryFeeHistoryRequest{
+					LastHeight:        2,
+					BlockCount:        2,
+					RewardPercentiles: []float64{25, 50, 75, 100},
+				}, []feemarkettypes.FeeHistoryEntry{
+					{Height: 1, BaseFee: math.NewInt(10), GasUsedRatio: 0.25, Rewards: rewards},
+					{Height: 2, BaseFee: math.NewInt(11), GasUsedRatio: 0.5, Rewards: rewards},
+				})
+				RegisterFeeMarketBlockBaseFee(feeMarketClient, 3, math.NewInt(12))
+			},
+			2,
+			2,
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(10)), (*hexutil.Big)(big.NewInt(11)), (*hexutil.Big)(big.NewInt(12))},
+				GasUsedRatio: []float64{0.25, 0.5},
+				Reward: [][]*hexutil.Big{
+					{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(2)), (*hexutil.Big)(big.NewInt(3)), (*hexutil.Big)(big.NewInt(4))},
+					{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(2)), (*hexutil.Big)(big.NewInt(3)), (*hexutil.Big)(big.NewInt(4))},
+				},
+			},
+			nil,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
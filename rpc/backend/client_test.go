@@ -2,6 +2,7 @@ package backend
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -86,6 +87,35 @@ func RegisterStatusError(client *mocks.Client) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterStatusCatchingUp(client *mocks.Client, earliestHeight, latestHeight int64) {
+	client.On("Status", rpc.ContextWithHeight(1)).
+		Return(&tmrpctypes.ResultStatus{
+			SyncInfo: tmrpctypes.SyncInfo{
+				EarliestBlockHeight: earliestHeight,
+				LatestBlockHeight:   latestHeight,
+				CatchingUp:          true,
+			},
+		}, nil)
+}
+
+// DumpConsensusState
+func RegisterDumpConsensusState(client *mocks.Client, peerHeights ...int64) {
+	peers := make([]tmrpctypes.PeerStateInfo, len(peerHeights))
+	for i, height := range peerHeights {
+		peers[i] = tmrpctypes.PeerStateInfo{
+			NodeAddress: fmt.Sprintf("peer%d", i),
+			PeerState:   []byte(fmt.Sprintf(`{"round_state":{"height":"%d"}}`, height)),
+		}
+	}
+	client.On("DumpConsensusState", rpc.ContextWithHeight(1)).
+		Return(&tmrpctypes.ResultDumpConsensusState{Peers: peers}, nil)
+}
+
+func RegisterDumpConsensusStateError(client *mocks.Client) {
+	client.On("DumpConsensusState", rpc.ContextWithHeight(1)).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Block
 func RegisterBlockMultipleTxs(
 	client *mocks.Client,
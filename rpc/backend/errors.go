@@ -0,0 +1,152 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// JSON-RPC error codes returned by geth.
+const (
+	// ErrCodeDefault is the code of the errors without a more specific code.
+	ErrCodeDefault = -32000
+	// ErrCodeReverted is the code of the reverted executions, returned along
+	// with the revert data.
+	ErrCodeReverted = 3
+	// ErrCodeLimitExceeded is the EIP-1474 code of the requests exceeding a
+	// limit, returned when a tx exceeds the maximum number of logs of the
+	// EVM params, which geth has no counterpart of.
+	ErrCodeLimitExceeded = -32005
+)
+
+var (
+	// grpcErrorPrefix matches the prefix added to the message of the errors
+	// returned through gRPC, which can be nested by the ABCI queries.
+	grpcErrorPrefix = regexp.MustCompile(`^rpc error: code = \w+ desc = `)
+	// invalidNonce matches the error returned by the ante handler on a nonce
+	// mismatch.
+	invalidNonce = regexp.MustCompile(`invalid nonce; got (\d+), expected (\d+)`)
+
+	// sdkErrors are the registered errors whose message is appended to the
+	// errors returned by the ABCI queries and the CheckTx.
+	sdkErrors = []*errortypes.Error{
+		errortypes.ErrInvalidRequest,
+		errortypes.ErrUnknownRequest,
+		errortypes.ErrKeyNotFound,
+		errortypes.ErrUnauthorized,
+		errortypes.ErrInvalidSequence,
+		errortypes.ErrInsufficientFunds,
+		errortypes.ErrInsufficientFee,
+		errortypes.ErrTxInMempoolCache,
+	}
+)
+
+// ErrRemoteMode is returned by the methods requiring the local state of the node
+// when it serves the JSON-RPC from a remote node.
+var ErrRemoteMode = errors.New("not supported in remote mode")
+
+// rpcError is an error returned to the JSON-RPC clients with the code and
+// canonical message geth returns for the same failure, so that the clients
+// can tell the failures apart.
+type rpcError struct {
+	err  error
+	msg  string
+	code int
+}
+
+func (e *rpcError) Error() string { return e.msg }
+
+// ErrorCode implements the rpc.Error interface.
+func (e *rpcError) ErrorCode() int { return e.code }
+
+func (e *rpcError) Unwrap() error { return e.err }
+
+// toRPCError translates the errors returned by the ante handler and the EVM
+// queries into the errors returned by geth for the same failures. The errors
+// that already carry a JSON-RPC code (e.g. reverts) are returned as is.
+func toRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return err
+	}
+
+	msg := errorMessage(err)
+	switch {
+	case strings.HasPrefix(msg, vm.ErrMaxLogsExceeded.Error()):
+		return &rpcError{err: vm.ErrMaxLogsExceeded, msg: vm.ErrMaxLogsExceeded.Error(), code: ErrCodeLimitExceeded}
+	case errors.Is(err, errortypes.ErrTxInMempoolCache):
+		err = core.ErrAlreadyKnown
+	case strings.Contains(msg, core.ErrReplaceUnderpriced.Error()):
+		err = core.ErrReplaceUnderpriced
+	case errors.Is(err, errortypes.ErrInvalidSequence) && invalidNonce.MatchString(msg):
+		err = nonceError(msg)
+	case strings.HasPrefix(msg, core.ErrInsufficientFunds.Error()):
+		// keep the balance details of the errors returned by the EVM queries
+		err = fmt.Errorf("%w%s", core.ErrInsufficientFunds, strings.TrimPrefix(msg, core.ErrInsufficientFunds.Error()))
+	case errors.Is(err, errortypes.ErrInsufficientFunds):
+		err = core.ErrInsufficientFunds
+	case strings.Contains(msg, core.ErrFeeCapTooLow.Error()):
+		err = core.ErrFeeCapTooLow
+	case errors.Is(err, errortypes.ErrInsufficientFee):
+		err = core.ErrUnderpriced
+	default:
+		return &rpcError{err: err, msg: msg, code: ErrCodeDefault}
+	}
+
+	return &rpcError{err: err, msg: err.Error(), code: ErrCodeDefault}
+}
+
+// errorMessage returns the message of the given error, stripped from the
+// gRPC prefixes and the registered error suffixes added by the ABCI queries
+// and the CheckTx.
+func errorMessage(err error) string {
+	msg := err.Error()
+	for {
+		trimmed := grpcErrorPrefix.ReplaceAllString(msg, "")
+		for _, sdkErr := range sdkErrors {
+			trimmed = strings.TrimSuffix(trimmed, ": "+sdkErr.Error())
+		}
+
+		if trimmed == msg {
+			return msg
+		}
+		msg = trimmed
+	}
+}
+
+// nonceError returns the error returned by geth when the nonce of a tx
+// doesn't match the one of its sender.
+func nonceError(msg string) error {
+	matches := invalidNonce.FindStringSubmatch(msg)
+	txNonce, _ := strconv.ParseUint(matches[1], 10, 64)
+	nextNonce, _ := strconv.ParseUint(matches[2], 10, 64)
+
+	if txNonce < nextNonce {
+		return fmt.Errorf("%w: next nonce %d, tx nonce %d", core.ErrNonceTooLow, nextNonce, txNonce)
+	}
+	return fmt.Errorf("%w: next nonce %d, tx nonce %d", core.ErrNonceTooHigh, nextNonce, txNonce)
+}
+
+// isRevertError returns true if the given error is a reverted execution
+// whose revert data was lost through the gRPC query.
+func isRevertError(err error) bool {
+	var revertErr *evmtypes.RevertError
+	if errors.As(err, &revertErr) {
+		return false
+	}
+	return strings.HasPrefix(errorMessage(err), "execution reverted")
+}
@@ -0,0 +1,242 @@
+package backend
+
+import (
+	"errors"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/evmos/v19/rpc/backend/mocks"
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// revertData returns the revert data of a revert with the given reason.
+func revertData(reason string) []byte {
+	reasonType, _ := abi.NewType("string", "", nil)
+	data, _ := abi.Arguments{{Type: reasonType}}.Pack(reason)
+	// Error(string) selector
+	return append(hexutil.MustDecode("0x08c379a0"), data...)
+}
+
+// TestToRPCError pins the codes and messages returned for the errors of the
+// ante handler and the EVM queries, which must match geth's ones.
+func (suite *BackendTestSuite) TestToRPCError() {
+	testCases := []struct {
+		name    string
+		err     error
+		expCode int
+		expMsg  string
+		expErr  error
+	}{
+		{
+			"already known",
+			errorsmod.ABCIError(errortypes.RootCodespace, errortypes.ErrTxInMempoolCache.ABCICode(), "tx already exists in cache"),
+			ErrCodeDefault,
+			"already known",
+			core.ErrAlreadyKnown,
+		},
+		{
+			"replacement transaction underpriced",
+			errorsmod.ABCIError(
+				errortypes.RootCodespace, errortypes.ErrInsufficientFee.ABCICode(),
+				"replacement transaction underpriced; tip 105, required at least 110 (10% bump): insufficient fee",
+			),
+			ErrCodeDefault,
+			"replacement transaction underpriced",
+			core.ErrReplaceUnderpriced,
+		},
+		{
+			"nonce too low",
+			errorsmod.ABCIError(
+				errortypes.RootCodespace, errortypes.ErrInvalidSequence.ABCICode(),
+				"invalid nonce; got 1, expected 3: invalid sequence",
+			),
+			ErrCodeDefault,
+			"nonce too low: next nonce 3, tx nonce 1",
+			core.ErrNonceTooLow,
+		},
+		{
+			"nonce too high",
+			errorsmod.ABCIError(
+				errortypes.RootCodespace, errortypes.ErrInvalidSequence.ABCICode(),
+				"invalid nonce; got 5, expected 3: invalid sequence",
+			),
+			ErrCodeDefault,
+			"nonce too high: next nonce 3, tx nonce 5",
+			core.ErrNonceTooHigh,
+		},
+		{
+			"insufficient funds for the tx costs",
+			errorsmod.ABCIError(
+				errortypes.RootCodespace, errortypes.ErrInsufficientFunds.ABCICode(),
+				"failed to deduct transaction costs from user balance: 10aevmos is smaller than 20aevmos: insufficient funds",
+			),
+			ErrCodeDefault,
+			"insufficient funds for gas * price + value",
+			core.ErrInsufficientFunds,
+		},
+		{
+			"insufficient funds for the value",
+			errorsmod.ABCIError(
+				errortypes.RootCodespace, errortypes.ErrInsufficientFunds.ABCICode(),
+				"failed to transfer 10 from address 0x01 using the EVM block context transfer function: insufficient funds",
+			),
+			ErrCodeDefault,
+			"insufficient funds for gas * price + value",
+			core.ErrInsufficientFunds,
+		},
+		{
+			"insufficient funds of an estimate",
+			status.Error(codes.InvalidArgument, "insufficient funds for gas * price + value: address 0x01 have 0 want 10: invalid request"),
+			ErrCodeDefault,
+			"insufficient funds for gas * price + value: address 0x01 have 0 want 10",
+			core.ErrInsufficientFunds,
+		},
+		{
+			"max fee per gas less than the base fee",
+			errorsmod.ABCIError(
+				errortypes.RootCodespace, errortypes.ErrInsufficientFee.ABCICode(),
+				"max fee per gas less than block base fee (1 < 2): insufficient fee",
+			),
+			ErrCodeDefault,
+			"max fee per gas less than block base fee",
+			core.ErrFeeCapTooLow,
+		},
+		{
+			"insufficient fee",
+			errorsmod.ABCIError(
+				errortypes.RootCodespace, errortypes.ErrInsufficientFee.ABCICode(),
+				"insufficient fee; got: 1 required: 2: insufficient fee",
+			),
+			ErrCodeDefault,
+			"transaction underpriced",
+			core.ErrUnderpriced,
+		},
+		{
+			"vm error of a call",
+			status.Error(codes.Internal, vm.ErrOutOfGas.Error()),
+			ErrCodeDefault,
+			"out of gas",
+			nil,
+		},
+		{
+			"max logs exceeded by a call",
+			status.Error(codes.Internal, vm.ErrMaxLogsExceeded.Error()),
+			ErrCodeLimitExceeded,
+			"max logs per transaction exceeded",
+			vm.ErrMaxLogsExceeded,
+		},
+		{
+			"max logs exceeded by an estimate",
+			status.Error(codes.Internal, "rpc error: code = Internal desc = max logs per transaction exceeded"),
+			ErrCodeLimitExceeded,
+			"max logs per transaction exceeded",
+			vm.ErrMaxLogsExceeded,
+		},
+		{
+			"nested query error",
+			status.Error(codes.Unknown, "rpc error: code = Internal desc = failed to load evm config: unknown request"),
+			ErrCodeDefault,
+			"failed to load evm config",
+			nil,
+		},
+		{
+			"other registered error",
+			evmtypes.ErrOversizedData,
+			ErrCodeDefault,
+			"oversized data",
+			evmtypes.ErrOversizedData,
+		},
+		{
+			"revert",
+			evmtypes.NewExecErrorWithReason(revertData("boom")),
+			ErrCodeReverted,
+			"execution reverted: boom",
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := toRPCError(tc.err)
+
+			var rpcErr rpc.Error
+			suite.Require().True(errors.As(err, &rpcErr))
+			suite.Require().Equal(tc.expCode, rpcErr.ErrorCode())
+			suite.Require().Equal(tc.expMsg, err.Error())
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+
+			// the mapping is idempotent
+			suite.Require().Equal(err, toRPCError(err))
+		})
+	}
+
+	suite.Require().NoError(toRPCError(nil))
+}
+
+func (suite *BackendTestSuite) TestSendRawTransactionNonceTooLow() {
+	ethTx, _ := suite.buildEthereumTx()
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	RegisterParamsWithoutHeader(queryClient, 1)
+
+	suite.Require().NoError(ethTx.Sign(ethtypes.LatestSigner(suite.backend.ChainConfig()), suite.signer))
+	rlpEncodedBz, err := rlp.EncodeToBytes(ethTx.AsTransaction())
+	suite.Require().NoError(err)
+
+	client.On("BroadcastTxSync", mock.Anything, mock.Anything).Return(&tmrpctypes.ResultBroadcastTx{
+		Code:      errortypes.ErrInvalidSequence.ABCICode(),
+		Codespace: errortypes.RootCodespace,
+		Log:       "invalid nonce; got 0, expected 1: invalid sequence",
+	}, nil)
+
+	_, err = suite.backend.SendRawTransaction(rlpEncodedBz)
+	suite.Require().ErrorIs(err, core.ErrNonceTooLow)
+	suite.Require().Equal("nonce too low: next nonce 1, tx nonce 0", err.Error())
+}
+
+func (suite *BackendTestSuite) TestEstimateGasRevert() {
+	_, bz := suite.buildEthereumTx()
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	_, err := RegisterBlock(client, 1, bz)
+	suite.Require().NoError(err)
+
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{
+		To:      &toAddr,
+		ChainID: (*hexutil.Big)(suite.backend.chainID),
+		Value:   (*hexutil.Big)(big.NewInt(1)),
+	}
+
+	// the revert data is lost through the estimate query
+	queryClient.On("EstimateGas", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.InvalidArgument, "execution reverted: boom: invalid request"))
+	queryClient.On("EthCall", mock.Anything, mock.Anything).
+		Return(&evmtypes.MsgEthereumTxResponse{VmError: vm.ErrExecutionReverted.Error(), Ret: revertData("boom")}, nil)
+
+	blockNr := rpctypes.BlockNumber(1)
+	_, err = suite.backend.EstimateGas(callArgs, &blockNr)
+
+	var dataErr rpc.DataError
+	suite.Require().True(errors.As(err, &dataErr))
+	suite.Require().Equal("execution reverted: boom", err.Error())
+	suite.Require().Equal(hexutil.Encode(revertData("boom")), dataErr.ErrorData())
+	suite.Require().Equal(ErrCodeReverted, err.(rpc.Error).ErrorCode())
+}
@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v19/rpc/backend/mocks"
 	rpc "github.com/evmos/evmos/v19/rpc/types"
@@ -65,6 +66,18 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 }
 
 // Params
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data, ContentType: evmtypes.TraceContentTypeJSON}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
 		Return(&evmtypes.QueryParamsResponse{}, nil).
@@ -138,16 +151,36 @@ func TestRegisterParamsError(t *testing.T) {
 	require.Error(t, err)
 }
 
+// contextWithHeight matches the contexts derived from rpc.ContextWithHeight
+// with the given height, as the backend adds a cancel func or a timeout to the
+// context of the calls.
+func contextWithHeight(height int64) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		heights := md.Get(grpctypes.GRPCBlockHeightHeader)
+		return len(heights) == 1 && heights[0] == fmt.Sprint(height)
+	})
+}
+
 // ETH Call
 func RegisterEthCall(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
-	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
-	queryClient.On("EthCall", ctx, request).
+	queryClient.On("EthCall", contextWithHeight(1), request).
 		Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 }
 
 func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
-	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
-	queryClient.On("EthCall", ctx, request).
+	queryClient.On("EthCall", contextWithHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// ETH Call Many
+func RegisterEthCallMany(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallManyRequest, response *evmtypes.EthCallManyResponse) {
+	queryClient.On("EthCallMany", contextWithHeight(1), request).
+		Return(response, nil)
+}
+
+func RegisterEthCallManyError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallManyRequest) {
+	queryClient.On("EthCallMany", contextWithHeight(1), request).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
@@ -164,6 +197,17 @@ func RegisterBaseFee(queryClient *mocks.EVMQueryClient, baseFee math.Int) {
 		Return(&evmtypes.QueryBaseFeeResponse{BaseFee: &baseFee}, nil)
 }
 
+// GasPriceFloor
+func RegisterGasPriceFloor(queryClient *mocks.EVMQueryClient, floor math.LegacyDec) {
+	queryClient.On("GasPriceFloor", rpc.ContextWithHeight(1), &evmtypes.QueryGasPriceFloorRequest{}).
+		Return(&evmtypes.QueryGasPriceFloorResponse{GasPriceFloor: floor}, nil)
+}
+
+func RegisterGasPriceFloorError(queryClient *mocks.EVMQueryClient) {
+	queryClient.On("GasPriceFloor", rpc.ContextWithHeight(1), &evmtypes.QueryGasPriceFloorRequest{}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Base fee returns error
 func RegisterBaseFeeError(queryClient *mocks.EVMQueryClient) {
 	queryClient.On("BaseFee", rpc.ContextWithHeight(1), &evmtypes.QueryBaseFeeRequest{}).
@@ -244,6 +288,16 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterStorageRange(queryClient *mocks.EVMQueryClient, addr common.Address, req *query.PageRequest, storage []evmtypes.State, nextKey []byte) {
+	queryClient.On("StorageRange", rpc.ContextWithHeight(1), &evmtypes.QueryStorageRangeRequest{Address: addr.String(), Height: 1, Pagination: req}).
+		Return(&evmtypes.QueryStorageRangeResponse{Storage: storage, Pagination: &query.PageResponse{NextKey: nextKey}}, nil)
+}
+
+func RegisterStorageRangeError(queryClient *mocks.EVMQueryClient, addr common.Address, req *query.PageRequest) {
+	queryClient.On("StorageRange", rpc.ContextWithHeight(1), &evmtypes.QueryStorageRangeRequest{Address: addr.String(), Height: 1, Pagination: req}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryAccountResponse{
@@ -0,0 +1,120 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	faucettypes "github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+// RequestFaucet claims funds from the faucet module on behalf of the given
+// bech32 or hex address. The claim is signed and its fees are paid by the
+// faucet key of the node config. It returns the hash of the broadcast tx.
+func (b *Backend) RequestFaucet(address string) (common.Hash, error) {
+	if !b.cfg.JSONRPC.FaucetEnable {
+		return common.Hash{}, errors.New("the faucet is disabled on this node")
+	}
+
+	keyInfo, err := b.clientCtx.Keyring.Key(b.cfg.JSONRPC.FaucetKey)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to get the faucet key %q: %w", b.cfg.JSONRPC.FaucetKey, err)
+	}
+	sender, err := keyInfo.GetAddress()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	msg := faucettypes.NewMsgRequestFunds(sender, address)
+	if err := msg.ValidateBasic(); err != nil {
+		return common.Hash{}, err
+	}
+
+	// the claims failing on delivery would still pay the fees, so the
+	// cooldown is checked before broadcasting
+	cooldown, err := faucettypes.NewQueryClient(b.clientCtx).Cooldown(b.ctx, &faucettypes.QueryCooldownRequest{Address: address})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if cooldown.RemainingBlocks > 0 {
+		return common.Hash{}, errorsmod.Wrapf(
+			faucettypes.ErrCooldown,
+			"address %s can claim again at height %d (%d blocks left)", address, cooldown.NextClaimHeight, cooldown.RemainingBlocks,
+		)
+	}
+
+	accNumber, _, err := b.clientCtx.AccountRetriever.GetAccountNumberSequence(b.clientCtx, sender)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to get the faucet account: %w", err)
+	}
+	nonce, err := b.GetTransactionCount(common.BytesToAddress(sender), rpctypes.EthPendingBlockNumber)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	txFactory := tx.Factory{}.
+		WithChainID(b.clientCtx.ChainID).
+		WithKeybase(b.clientCtx.Keyring).
+		WithTxConfig(b.clientCtx.TxConfig).
+		WithAccountNumber(accNumber).
+		WithSequence(uint64(*nonce)).
+		WithGasAdjustment(1.25)
+
+	_, gas, err := tx.CalculateGas(b.clientCtx, txFactory, msg)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to calculate gas: %w", err)
+	}
+
+	// pay the same gas price as the EVM txs, covering the base fee and the
+	// minimum gas price
+	gasPrice, err := b.GasPrice()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	evmParams, err := b.queryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	fee := new(big.Int).Mul(gasPrice.ToInt(), new(big.Int).SetUint64(gas))
+
+	builder := b.clientCtx.TxConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msg); err != nil {
+		return common.Hash{}, err
+	}
+	builder.SetGasLimit(gas)
+	builder.SetFeeAmount(sdk.Coins{sdk.NewCoin(evmParams.Params.EvmDenom, sdkmath.NewIntFromBigInt(fee))})
+
+	if err := tx.Sign(txFactory.WithGas(gas), keyInfo.Name, builder, true); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to sign the faucet claim: %w", err)
+	}
+
+	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
+	rsp, err := syncCtx.BroadcastTx(txBytes)
+	if rsp != nil && rsp.Code != 0 {
+		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+	}
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to broadcast the faucet claim: %w", err)
+	}
+
+	hash := common.BytesToHash(tmtypes.Tx(txBytes).Hash())
+	b.logger.Debug("broadcasted faucet claim", "address", address, "hash", hash.String())
+	return hash, nil
+}
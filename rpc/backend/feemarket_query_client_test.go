@@ -1,7 +1,12 @@
 package backend
 
 import (
+	"cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/evmos/evmos/v19/rpc/backend/mocks"
 	rpc "github.com/evmos/evmos/v19/rpc/types"
 	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
@@ -19,3 +24,31 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// BlockGas
+func RegisterFeeMarketBlockGas(feeMarketClient *mocks.FeeMarketQueryClient, height int64, gas int64) {
+	feeMarketClient.On("BlockGas", rpc.ContextWithHeight(height), &feemarkettypes.QueryBlockGasRequest{}).
+		Return(&feemarkettypes.QueryBlockGasResponse{Gas: gas}, nil)
+}
+
+// BlockBaseFee
+func RegisterFeeMarketBlockBaseFee(feeMarketClient *mocks.FeeMarketQueryClient, height int64, baseFee math.Int) {
+	feeMarketClient.On("BlockBaseFee", rpc.ContextWithHeight(1), &feemarkettypes.QueryBlockBaseFeeRequest{Height: height}).
+		Return(&feemarkettypes.QueryBlockBaseFeeResponse{BaseFee: &baseFee}, nil)
+}
+
+// FeeHistory
+func RegisterFeeMarketFeeHistory(feeMarketClient *mocks.FeeMarketQueryClient, req *feemarkettypes.QueryFeeHistoryRequest, blocks []feemarkettypes.FeeHistoryEntry) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(1), req).
+		Return(&feemarkettypes.QueryFeeHistoryResponse{Blocks: blocks}, nil)
+}
+
+func RegisterFeeMarketFeeHistoryNotFound(feeMarketClient *mocks.FeeMarketQueryClient) {
+	feeMarketClient.On("FeeHistory", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.Unimplemented, "fee history not found")).Maybe()
+}
+
+func RegisterFeeMarketBlockBaseFeeNotFound(feeMarketClient *mocks.FeeMarketQueryClient) {
+	feeMarketClient.On("BlockBaseFee", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.NotFound, "base fee not found")).Maybe()
+}
@@ -0,0 +1,105 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// minerCacheSize is the number of blocks whose miner address is kept in the
+// cache of the backend.
+const minerCacheSize = 1024
+
+// minerCache caches the miner address of the blocks, i.e. the address of the
+// operator of their proposer, which is resolved at the height of the block
+// through the staking keeper.
+type minerCache struct {
+	mu      sync.Mutex
+	miners  map[int64]minerEntry
+	heights []int64 // insertion order of the cached heights
+}
+
+// minerEntry is the miner address of a block, along with the consensus
+// address of its proposer.
+type minerEntry struct {
+	proposer sdk.ConsAddress
+	miner    common.Address
+}
+
+func newMinerCache() *minerCache {
+	return &minerCache{
+		miners: make(map[int64]minerEntry),
+	}
+}
+
+// get returns the cached miner address of the block at the given height and
+// proposed by the given validator.
+func (c *minerCache) get(height int64, proposer sdk.ConsAddress) (common.Address, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, found := c.miners[height]
+	if !found || !entry.proposer.Equals(proposer) {
+		return common.Address{}, false
+	}
+	return entry.miner, true
+}
+
+// add caches the miner address of the block at the given height, evicting the
+// oldest cached block once the cache is full.
+func (c *minerCache) add(height int64, proposer sdk.ConsAddress, miner common.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, found := c.miners[height]; !found {
+		if len(c.heights) == minerCacheSize {
+			delete(c.miners, c.heights[0])
+			c.heights = c.heights[1:]
+		}
+		c.heights = append(c.heights, height)
+	}
+	c.miners[height] = minerEntry{proposer: proposer, miner: miner}
+}
+
+// blockMiner returns the miner address of the block at the given height, as
+// the hex address of the operator of the block proposer. The zero address is
+// returned if the proposer can't be mapped to its operator.
+func (b *Backend) blockMiner(height int64, proposerAddress []byte) common.Address {
+	proposer := sdk.ConsAddress(proposerAddress)
+	if miner, found := b.miners.get(height, proposer); found {
+		return miner
+	}
+
+	req := &evmtypes.QueryValidatorAccountRequest{
+		ConsAddress: proposer.String(),
+	}
+
+	res, err := b.queryClient.ValidatorAccount(rpctypes.ContextWithHeight(height), req)
+	if err != nil {
+		b.logger.Debug(
+			"failed to query validator operator address",
+			"height", height,
+			"cons-address", req.ConsAddress,
+			"error", err.Error(),
+		)
+		// use zero address as the validator operator address, without caching
+		// it so that the query is retried
+		return common.Address{}
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(res.AccountAddress)
+	if err != nil {
+		b.logger.Debug("invalid validator account address", "height", height, "address", res.AccountAddress, "error", err.Error())
+		return common.Address{}
+	}
+
+	miner := common.BytesToAddress(accAddr)
+	b.miners.add(height, proposer, miner)
+	return miner
+}
@@ -0,0 +1,69 @@
+package backend
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/evmos/v19/rpc/backend/mocks"
+	ethrpc "github.com/evmos/evmos/v19/rpc/types"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestBlockMiner() {
+	suite.SetupTest()
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+
+	// the proposers rotate between two validators
+	proposers := []sdk.ConsAddress{utiltx.GenerateAddress().Bytes(), utiltx.GenerateAddress().Bytes()}
+	operators := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+	for height := int64(1); height <= 4; height++ {
+		i := height % 2
+		// the validator account is only queried once per block
+		queryClient.On(
+			"ValidatorAccount",
+			ethrpc.ContextWithHeight(height),
+			&evmtypes.QueryValidatorAccountRequest{ConsAddress: proposers[i].String()},
+		).Return(&evmtypes.QueryValidatorAccountResponse{AccountAddress: sdk.AccAddress(operators[i].Bytes()).String()}, nil).Once()
+	}
+
+	for round := 0; round < 2; round++ {
+		for height := int64(1); height <= 4; height++ {
+			i := height % 2
+			suite.Require().Equal(operators[i], suite.backend.blockMiner(height, proposers[i]))
+		}
+	}
+
+	// the failed mappings fall back to the zero address and are not cached
+	unknown := sdk.ConsAddress(utiltx.GenerateAddress().Bytes())
+	queryClient.On(
+		"ValidatorAccount",
+		ethrpc.ContextWithHeight(5),
+		&evmtypes.QueryValidatorAccountRequest{ConsAddress: unknown.String()},
+	).Return(nil, status.Error(codes.NotFound, "validator not found")).Twice()
+
+	suite.Require().Equal(common.Address{}, suite.backend.blockMiner(5, unknown))
+	suite.Require().Equal(common.Address{}, suite.backend.blockMiner(5, unknown))
+}
+
+func (suite *BackendTestSuite) TestMinerCacheEviction() {
+	cache := newMinerCache()
+	proposer := sdk.ConsAddress(utiltx.GenerateAddress().Bytes())
+	miner := utiltx.GenerateAddress()
+
+	for height := int64(1); height <= minerCacheSize+1; height++ {
+		cache.add(height, proposer, miner)
+	}
+
+	_, found := cache.get(1, proposer)
+	suite.Require().False(found)
+	cached, found := cache.get(minerCacheSize+1, proposer)
+	suite.Require().True(found)
+	suite.Require().Equal(miner, cached)
+
+	// the cached miner is only returned for the same proposer
+	_, found = cache.get(2, utiltx.GenerateAddress().Bytes())
+	suite.Require().False(found)
+}
@@ -47,6 +47,36 @@ func (_m *FeeMarketQueryClient) BaseFee(ctx context.Context, in *types.QueryBase
 	return r0, r1
 }
 
+// BlockBaseFee provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) BlockBaseFee(ctx context.Context, in *types.QueryBlockBaseFeeRequest, opts ...grpc.CallOption) (*types.QueryBlockBaseFeeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryBlockBaseFeeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBlockBaseFeeRequest, ...grpc.CallOption) *types.QueryBlockBaseFeeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBlockBaseFeeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBlockBaseFeeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BlockGas provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlockGasRequest, opts ...grpc.CallOption) (*types.QueryBlockGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -77,6 +107,96 @@ func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlo
 	return r0, r1
 }
 
+// FeeConversionPool provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeConversionPool(ctx context.Context, in *types.QueryFeeConversionPoolRequest, opts ...grpc.CallOption) (*types.QueryFeeConversionPoolResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryFeeConversionPoolResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeConversionPoolRequest, ...grpc.CallOption) *types.QueryFeeConversionPoolResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeConversionPoolResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeConversionPoolRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeConversionRates provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeConversionRates(ctx context.Context, in *types.QueryFeeConversionRatesRequest, opts ...grpc.CallOption) (*types.QueryFeeConversionRatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryFeeConversionRatesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeConversionRatesRequest, ...grpc.CallOption) *types.QueryFeeConversionRatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeConversionRatesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeConversionRatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeHistory provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeHistory(ctx context.Context, in *types.QueryFeeHistoryRequest, opts ...grpc.CallOption) (*types.QueryFeeHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryFeeHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeHistoryRequest, ...grpc.CallOption) *types.QueryFeeHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -107,6 +227,36 @@ func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParam
 	return r0, r1
 }
 
+// SubscribeFeeState provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) SubscribeFeeState(ctx context.Context, in *types.QuerySubscribeFeeStateRequest, opts ...grpc.CallOption) (types.Query_SubscribeFeeStateClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 types.Query_SubscribeFeeStateClient
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySubscribeFeeStateRequest, ...grpc.CallOption) types.Query_SubscribeFeeStateClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Query_SubscribeFeeStateClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QuerySubscribeFeeStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewQueryClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -3,12 +3,14 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -60,6 +62,8 @@ func (b *Backend) Accounts() ([]common.Address, error) {
 // - startingBlock: block number this node started to synchronize from
 // - currentBlock:  block number this node is currently importing
 // - highestBlock:  block number of the highest block header this node has received from peers
+// - safeBlock:     block number of the latest safe block, which is the current block given the instant finality
+// - finalizedBlock: block number of the latest finalized block, which is the current block given the instant finality
 // - pulledStates:  number of state entries processed until now
 // - knownStates:   number of known state entries that still need to be pulled
 func (b *Backend) Syncing() (interface{}, error) {
@@ -73,14 +77,86 @@ func (b *Backend) Syncing() (interface{}, error) {
 	}
 
 	return map[string]interface{}{
-		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
-		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
-		// "highestBlock":  nil, // NA
+		"startingBlock":  hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
+		"currentBlock":   hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
+		"highestBlock":   hexutil.Uint64(b.networkHeight(status.SyncInfo.LatestBlockHeight)),
+		"safeBlock":      hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
+		"finalizedBlock": hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
 		// "pulledStates":  nil, // NA
 		// "knownStates":   nil, // NA
 	}, nil
 }
 
+// networkHeight returns the height of the highest block committed by the peers
+// of the node, as reported by their consensus state. It falls back to the given
+// latest height of the node if the consensus state cannot be queried.
+func (b *Backend) networkHeight(latestHeight int64) int64 {
+	highest := latestHeight
+
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return highest
+	}
+
+	res, err := nc.DumpConsensusState(b.ctx)
+	if err != nil {
+		b.logger.Debug("failed to query the consensus state", "error", err.Error())
+		return highest
+	}
+
+	for _, peer := range res.Peers {
+		var state struct {
+			RoundState struct {
+				Height int64 `json:"height,string"`
+			} `json:"round_state"`
+		}
+		if err := json.Unmarshal(peer.PeerState, &state); err != nil {
+			b.logger.Debug("failed to decode the peer state", "peer", peer.NodeAddress, "error", err.Error())
+			continue
+		}
+
+		// a peer at the consensus height H has committed the block H-1
+		if committed := state.RoundState.Height - 1; committed > highest {
+			highest = committed
+		}
+	}
+
+	return highest
+}
+
+// checkSyncedForLatest returns an error if the JSON-RPC server is configured to
+// reject the queries for the latest or pending block while the node is catching
+// up with the network, and the node is not synced yet.
+func (b *Backend) checkSyncedForLatest(blockNum rpctypes.BlockNumber) error {
+	if !b.cfg.JSONRPC.RejectLatestWhileSyncing {
+		return nil
+	}
+
+	var tag string
+	switch blockNum {
+	case rpctypes.EthLatestBlockNumber:
+		tag = "latest"
+	case rpctypes.EthPendingBlockNumber:
+		tag = "pending"
+	default:
+		return nil
+	}
+
+	status, err := b.clientCtx.Client.Status(b.ctx)
+	if err != nil {
+		return err
+	}
+
+	if status.SyncInfo.CatchingUp {
+		return fmt.Errorf(
+			"node is catching up with the network (current block: %d), queries for the %s block are rejected until it is synced",
+			status.SyncInfo.LatestBlockHeight, tag,
+		)
+	}
+
+	return nil
+}
+
 // SetEtherbase sets the etherbase of the miner
 func (b *Backend) SetEtherbase(etherbase common.Address) bool {
 	if !b.cfg.JSONRPC.AllowInsecureUnlock {
@@ -268,6 +344,12 @@ func (b *Backend) NewMnemonic(uid string,
 // NOTE: this function accepts only integers to have the same interface than go-eth
 // to use float values, the gas prices must be configured using the configuration file
 func (b *Backend) SetGasPrice(gasPrice hexutil.Big) bool {
+	// the minimum gas prices of the remote node can't be set
+	if b.remote {
+		b.logger.Debug("failed to set the gas price", "error", ErrRemoteMode.Error())
+		return false
+	}
+
 	appConf, err := config.GetConfig(b.clientCtx.Viper)
 	if err != nil {
 		b.logger.Debug("could not get the server config", "error", err.Error())
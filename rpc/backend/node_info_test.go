@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v19/rpc/backend/mocks"
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
 	"github.com/evmos/evmos/v19/types"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc/metadata"
@@ -190,13 +191,47 @@ func (suite *BackendTestSuite) TestSyncing() {
 			"pass - Node is catching up",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterStatus(client)
-				status, _ := client.Status(suite.backend.ctx)
-				status.SyncInfo.CatchingUp = true
+				RegisterStatusCatchingUp(client, 1, 10)
+				RegisterDumpConsensusState(client, 90, 101, 100)
+			},
+			map[string]interface{}{
+				"startingBlock":  hexutil.Uint64(1),
+				"currentBlock":   hexutil.Uint64(10),
+				"highestBlock":   hexutil.Uint64(100),
+				"safeBlock":      hexutil.Uint64(10),
+				"finalizedBlock": hexutil.Uint64(10),
+			},
+			true,
+		},
+		{
+			"pass - Node is catching up, peers behind",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterStatusCatchingUp(client, 1, 10)
+				RegisterDumpConsensusState(client, 5)
+			},
+			map[string]interface{}{
+				"startingBlock":  hexutil.Uint64(1),
+				"currentBlock":   hexutil.Uint64(10),
+				"highestBlock":   hexutil.Uint64(10),
+				"safeBlock":      hexutil.Uint64(10),
+				"finalizedBlock": hexutil.Uint64(10),
+			},
+			true,
+		},
+		{
+			"pass - Node is catching up, can't get consensus state",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterStatusCatchingUp(client, 1, 10)
+				RegisterDumpConsensusStateError(client)
 			},
 			map[string]interface{}{
-				"startingBlock": hexutil.Uint64(0),
-				"currentBlock":  hexutil.Uint64(0),
+				"startingBlock":  hexutil.Uint64(1),
+				"currentBlock":   hexutil.Uint64(10),
+				"highestBlock":   hexutil.Uint64(10),
+				"safeBlock":      hexutil.Uint64(10),
+				"finalizedBlock": hexutil.Uint64(10),
 			},
 			true,
 		},
@@ -219,6 +254,87 @@ func (suite *BackendTestSuite) TestSyncing() {
 	}
 }
 
+func (suite *BackendTestSuite) TestCheckSyncedForLatest() {
+	testCases := []struct {
+		name         string
+		reject       bool
+		blockNum     rpctypes.BlockNumber
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"pass - rejection disabled",
+			false,
+			rpctypes.EthLatestBlockNumber,
+			func() {},
+			true,
+		},
+		{
+			"pass - block number is not a tag",
+			true,
+			rpctypes.NewBlockNumber(big.NewInt(1)),
+			func() {},
+			true,
+		},
+		{
+			"pass - node is synced",
+			true,
+			rpctypes.EthLatestBlockNumber,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterStatus(client)
+			},
+			true,
+		},
+		{
+			"fail - can't get status",
+			true,
+			rpctypes.EthLatestBlockNumber,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterStatusError(client)
+			},
+			false,
+		},
+		{
+			"fail - latest block while catching up",
+			true,
+			rpctypes.EthLatestBlockNumber,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterStatusCatchingUp(client, 1, 10)
+			},
+			false,
+		},
+		{
+			"fail - pending block while catching up",
+			true,
+			rpctypes.EthPendingBlockNumber,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterStatusCatchingUp(client, 1, 10)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.RejectLatestWhileSyncing = tc.reject
+			tc.registerMock()
+
+			err := suite.backend.checkSyncedForLatest(tc.blockNum)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestSetEtherbase() {
 	testCases := []struct {
 		name         string
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -17,52 +18,23 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
+// personalNamespace is the JSON-RPC namespace of the node accounts, which
+// must be enabled for the node to sign txs with the keys of its keyring.
+const personalNamespace = "personal"
+
 // SendTransaction sends transaction based on received args using Node's key to sign it
 func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	if !b.cfg.JSONRPC.AllowInsecureUnlock {
-		b.logger.Debug("account unlock with HTTP access is forbidden")
-		return common.Hash{}, fmt.Errorf("account unlock with HTTP access is forbidden")
-	}
-
-	_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
-	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
-	}
-
-	if args.ChainID != nil && (b.chainID).Cmp((*big.Int)(args.ChainID)) != 0 {
-		return common.Hash{}, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.chainID))
-	}
-
-	args, err = b.SetTxDefaults(args)
-	if err != nil {
-		return common.Hash{}, err
-	}
+	txHash, err := b.sendTransaction(args)
+	return txHash, toRPCError(err)
+}
 
-	bn, err := b.BlockNumber()
+func (b *Backend) sendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
+	msg, err := b.signTransaction(args)
 	if err != nil {
-		b.logger.Debug("failed to fetch latest block number", "error", err.Error())
-		return common.Hash{}, err
-	}
-
-	signer := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)))
-
-	// LegacyTx derives chainID from the signature. To make sure the msg.ValidateBasic makes
-	// the corresponding chainID validation, we need to sign the transaction before calling it
-
-	// Sign transaction
-	msg := args.ToTransaction()
-	if err := msg.Sign(signer, b.clientCtx.Keyring); err != nil {
-		b.logger.Debug("failed to sign tx", "error", err.Error())
-		return common.Hash{}, err
-	}
-
-	if err := msg.ValidateBasic(); err != nil {
-		b.logger.Debug("tx failed basic validation", "error", err.Error())
 		return common.Hash{}, err
 	}
 
@@ -88,6 +60,11 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 
+	if err := validateTxSize(msg, txBytes, res.Params); err != nil {
+		b.logger.Debug("tx failed size validation", "error", err.Error())
+		return common.Hash{}, err
+	}
+
 	ethTx := msg.AsTransaction()
 
 	// check the local node config in case unprotected txs are disabled
@@ -114,6 +91,84 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 	return txHash, nil
 }
 
+// SignTransaction fills the defaults of the given tx args and signs the tx
+// with the node's key of the sender, without broadcasting it. It returns the
+// RLP encoded tx along with the tx object.
+func (b *Backend) SignTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error) {
+	msg, err := b.signTransaction(args)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := msg.AsTransaction()
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.SignTransactionResult{
+		Raw: data,
+		Tx:  tx,
+	}, nil
+}
+
+// signTransaction fills the defaults of the given tx args, i.e. the nonce from
+// the pending state, the gas from the estimate and the fees from the gas price
+// oracle, and signs the tx with the key of the sender in the node's keyring.
+// The accounts of the keyring are the unlocked accounts of the node.
+func (b *Backend) signTransaction(args evmtypes.TransactionArgs) (*evmtypes.MsgEthereumTx, error) {
+	if !slices.Contains(b.cfg.JSONRPC.API, personalNamespace) {
+		b.logger.Debug("signing with the node's keys requires the personal namespace")
+		return nil, fmt.Errorf("signing with the node's keys is disabled, enable the %s namespace to use it", personalNamespace)
+	}
+
+	// Look up the wallet containing the requested signer
+	if !b.cfg.JSONRPC.AllowInsecureUnlock {
+		b.logger.Debug("account unlock with HTTP access is forbidden")
+		return nil, fmt.Errorf("account unlock with HTTP access is forbidden")
+	}
+
+	_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
+	if err != nil {
+		b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
+		return nil, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+	}
+
+	if args.ChainID != nil && (b.chainID).Cmp((*big.Int)(args.ChainID)) != 0 {
+		return nil, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.chainID))
+	}
+
+	args, err = b.SetTxDefaults(args)
+	if err != nil {
+		return nil, err
+	}
+
+	bn, err := b.BlockNumber()
+	if err != nil {
+		b.logger.Debug("failed to fetch latest block number", "error", err.Error())
+		return nil, err
+	}
+
+	signer := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)))
+
+	// LegacyTx derives chainID from the signature. To make sure the msg.ValidateBasic makes
+	// the corresponding chainID validation, we need to sign the transaction before calling it
+
+	// Sign transaction
+	msg := args.ToTransaction()
+	if err := msg.Sign(signer, b.clientCtx.Keyring); err != nil {
+		b.logger.Debug("failed to sign tx", "error", err.Error())
+		return nil, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		b.logger.Debug("tx failed basic validation", "error", err.Error())
+		return nil, err
+	}
+
+	return msg, nil
+}
+
 // Sign signs the provided data using the private key of address via Geth's signature standard.
 func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
 	from := sdk.AccAddress(address.Bytes())
@@ -2,10 +2,12 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -14,7 +16,10 @@ import (
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/evmos/evmos/v19/app"
 	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v19/crypto/hd"
+	"github.com/evmos/evmos/v19/encoding"
 	"github.com/evmos/evmos/v19/rpc/backend/mocks"
 	utiltx "github.com/evmos/evmos/v19/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
@@ -53,6 +58,18 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 			hash,
 			false,
 		},
+		{
+			"fail - personal namespace disabled",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				err := suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.Require().NoError(err)
+				suite.backend.cfg.JSONRPC.API = []string{"eth"}
+			},
+			callArgsDefault,
+			hash,
+			false,
+		},
 		{
 			"fail - Block error can't set Tx defaults",
 			func() {
@@ -144,6 +161,125 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSignTransaction() {
+	gas := hexutil.Uint64(21000)
+	nonce := hexutil.Uint64(1)
+	toAddr := utiltx.GenerateAddress()
+	baseFee := math.NewInt(1)
+
+	priv, _ := ethsecp256k1.GenerateKey()
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	legacyArgs := evmtypes.TransactionArgs{
+		From:     &from,
+		To:       &toAddr,
+		GasPrice: (*hexutil.Big)(big.NewInt(10)),
+		Gas:      &gas,
+		Nonce:    &nonce,
+	}
+	dynamicFeeArgs := evmtypes.TransactionArgs{
+		From:                 &from,
+		To:                   &toAddr,
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(10)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1)),
+		Gas:                  &gas,
+		Nonce:                &nonce,
+	}
+
+	// importKey imports the key of the sender in an in-memory keyring
+	importKey := func() {
+		encCfg := encoding.MakeConfig(app.ModuleBasics)
+		suite.backend.clientCtx.Keyring = keyring.NewInMemory(encCfg.Codec, hd.EthSecp256k1Option())
+		armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+		suite.Require().NoError(suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, ""))
+	}
+
+	// registerSigningMocks imports the key of the sender and registers the
+	// queries used to fill the tx defaults
+	registerSigningMocks := func() {
+		var header metadata.MD
+		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+
+		importKey()
+		RegisterParams(queryClient, &header, 1)
+		_, err := RegisterBlock(client, 1, nil)
+		suite.Require().NoError(err)
+		_, err = RegisterBlockResults(client, 1)
+		suite.Require().NoError(err)
+		RegisterBaseFee(queryClient, baseFee)
+		RegisterParamsWithoutHeader(queryClient, 1)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		args         evmtypes.TransactionArgs
+		expTxType    uint8
+		errContains  string
+	}{
+		{
+			"fail - personal namespace disabled",
+			func() {
+				importKey()
+				suite.backend.cfg.JSONRPC.API = []string{"eth"}
+			},
+			legacyArgs,
+			0,
+			"signing with the node's keys is disabled",
+		},
+		{
+			"fail - account not in the keyring",
+			func() {
+				importKey()
+				suite.Require().NoError(suite.backend.clientCtx.Keyring.Delete("test_key"))
+			},
+			legacyArgs,
+			0,
+			"failed to find key in the node's keyring",
+		},
+		{
+			"pass - legacy tx",
+			registerSigningMocks,
+			legacyArgs,
+			ethtypes.LegacyTxType,
+			"",
+		},
+		{
+			"pass - dynamic fee tx",
+			registerSigningMocks,
+			dynamicFeeArgs,
+			ethtypes.DynamicFeeTxType,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.SignTransaction(tc.args)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+
+			tx := new(ethtypes.Transaction)
+			suite.Require().NoError(tx.UnmarshalBinary(res.Raw))
+			suite.Require().Equal(res.Tx.Hash(), tx.Hash())
+			suite.Require().Equal(tc.expTxType, tx.Type())
+			suite.Require().Equal(uint64(nonce), tx.Nonce())
+			suite.Require().Equal(uint64(gas), tx.Gas())
+
+			sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(suite.backend.chainID), tx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(from, sender)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestSign() {
 	from, priv := utiltx.NewAddrKey()
 	testCases := []struct {
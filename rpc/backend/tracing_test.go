@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -10,10 +11,12 @@ import (
 	"github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v19/indexer"
 	"github.com/evmos/evmos/v19/rpc/backend/mocks"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
@@ -262,3 +265,98 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{
+		To:      &toAddr,
+		ChainID: (*hexutil.Big)(suite.backend.chainID),
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+	config := &evmtypes.TraceConfig{Tracer: "callTracer"}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *evmtypes.TraceConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			config,
+			nil,
+			false,
+		},
+		{
+			"fail - trace call error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCallError(queryClient, &evmtypes.QueryTraceCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64(), TraceConfig: config})
+			},
+			config,
+			nil,
+			false,
+		},
+		{
+			"pass - forwards the tracer config",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64(), TraceConfig: config})
+			},
+			config,
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.TraceCall(callArgs, 1, tc.config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTraceRemoteMode() {
+	_, bz := suite.buildEthereumTx()
+	block := types.MakeBlock(1, []types.Tx{bz}, nil, nil)
+	block.ChainID = ChainID
+	resBlock := tmrpctypes.ResultBlock{Block: block, BlockID: block.LastBlockID}
+
+	suite.SetupTest()
+	suite.backend.remote = true
+
+	// the traces fail before querying the chain
+	_, err := suite.backend.TraceTransaction(common.Hash{}, nil)
+	suite.Require().ErrorIs(err, ErrRemoteMode)
+
+	_, err = suite.backend.TraceBlock(1, &evmtypes.TraceConfig{}, &resBlock)
+	suite.Require().ErrorIs(err, ErrRemoteMode)
+
+	_, err = suite.backend.TraceCall(evmtypes.TransactionArgs{}, 1, nil)
+	suite.Require().ErrorIs(err, ErrRemoteMode)
+
+	suite.Require().False(suite.backend.SetGasPrice(hexutil.Big{}))
+}
@@ -244,6 +244,11 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["logs"] = [][]*ethtypes.Log{}
 	}
 
+	// non-standard field attributing the fees of the tx to the account paying them
+	if b.cfg.JSONRPC.ReceiptFeePayer && res.FeePayer != "" {
+		receipt["feePayer"] = common.HexToAddress(res.FeePayer)
+	}
+
 	// If the ContractAddress is 20 0x0 bytes, assume it is not a contract creation
 	if txData.GetTo() == nil {
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
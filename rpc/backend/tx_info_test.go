@@ -15,6 +15,7 @@ import (
 	"github.com/evmos/evmos/v19/indexer"
 	"github.com/evmos/evmos/v19/rpc/backend/mocks"
 	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
 	evmostypes "github.com/evmos/evmos/v19/types"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 	"google.golang.org/grpc/metadata"
@@ -127,6 +128,60 @@ func (suite *BackendTestSuite) TestGetTransactionByHash() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetTransactionByHashAfterRollback() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txHash := msgEthereumTx.AsTransaction().Hash()
+
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	db := dbm.NewMemDB()
+	suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+	suite.Require().NoError(suite.backend.indexer.IndexBlock(block, responseDeliver))
+
+	// roll back the block of the tx
+	removed, err := indexer.RewindToBlock(db, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, removed)
+
+	// the tx is neither indexed nor pending
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	RegisterUnconfirmedTxs(client, nil, nil)
+
+	rpcTx, err := suite.backend.GetTransactionByHash(common.HexToHash(msgEthereumTx.Hash))
+	suite.Require().NoError(err)
+	suite.Require().Nil(rpcTx)
+
+	// the tx is found again once the block is re-executed and indexed
+	suite.Require().NoError(suite.backend.indexer.IndexBlock(block, responseDeliver))
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	_, err = RegisterBlock(client, 1, txBz)
+	suite.Require().NoError(err)
+	_, err = RegisterBlockResults(client, 1)
+	suite.Require().NoError(err)
+	RegisterBaseFee(queryClient, math.NewInt(1))
+
+	rpcTx, err = suite.backend.GetTransactionByHash(common.HexToHash(msgEthereumTx.Hash))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(rpcTx)
+	suite.Require().Equal(common.HexToHash(msgEthereumTx.Hash), rpcTx.Hash)
+}
+
 func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 	msgEthereumTx, bz := suite.buildEthereumTx()
 	rpcTransaction, _ := rpctypes.NewRPCTransaction(msgEthereumTx.AsTransaction(), common.Hash{}, 0, 0, big.NewInt(1), suite.backend.chainID)
@@ -668,3 +723,81 @@ func (suite *BackendTestSuite) TestGetGasUsed() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetTransactionReceiptFeePayer() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := common.HexToHash(msgEthereumTx.Hash)
+	feePayer := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name            string
+		receiptFeePayer bool
+		attrs           []abci.EventAttribute
+		expFeePayer     *common.Address
+	}{
+		{
+			"fee payer field disabled",
+			false,
+			[]abci.EventAttribute{{Key: evmtypes.AttributeKeyFeePayer, Value: feePayer.Hex()}},
+			nil,
+		},
+		{
+			"fees paid by the sender",
+			true,
+			nil,
+			nil,
+		},
+		{
+			"fees paid by a fee payer",
+			true,
+			[]abci.EventAttribute{{Key: evmtypes.AttributeKeyFeePayer, Value: feePayer.Hex()}},
+			&feePayer,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.backend.cfg.JSONRPC.ReceiptFeePayer = tc.receiptFeePayer
+
+			var header metadata.MD
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			RegisterParams(queryClient, &header, 1)
+			RegisterParamsWithoutHeader(queryClient, 1)
+			_, err := RegisterBlock(client, 1, txBz)
+			suite.Require().NoError(err)
+			_, err = RegisterBlockResults(client, 1)
+			suite.Require().NoError(err)
+
+			attrs := append([]abci.EventAttribute{
+				{Key: "ethereumTxHash", Value: txHash.Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "amount", Value: "1000"},
+				{Key: "txGasUsed", Value: "21000"},
+				{Key: "txHash", Value: ""},
+			}, tc.attrs...)
+			block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+			blockResult := []*abci.ResponseDeliverTx{
+				{
+					Code:   0,
+					Events: []abci.Event{{Type: evmtypes.EventTypeEthereumTx, Attributes: attrs}},
+				},
+			}
+
+			suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			suite.Require().NoError(suite.backend.indexer.IndexBlock(block, blockResult))
+
+			txReceipt, err := suite.backend.GetTransactionReceipt(txHash)
+			suite.Require().NoError(err)
+			suite.Require().NotNil(txReceipt)
+
+			if tc.expFeePayer == nil {
+				suite.Require().NotContains(txReceipt, "feePayer")
+			} else {
+				suite.Require().Equal(*tc.expFeePayer, txReceipt["feePayer"])
+			}
+		})
+	}
+}
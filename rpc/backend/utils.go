@@ -103,6 +103,58 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	return nonce, nil
 }
 
+// pendingEthTxsBySender returns the EVM transactions of the local mempool
+// grouped by sender and sorted by nonce. Only the txs that contain
+// `MsgEthereumTx` messages with a valid signature are returned.
+func (b *Backend) pendingEthTxsBySender() (map[common.Address]ethtypes.Transactions, error) {
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make(map[common.Address]ethtypes.Transactions)
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				continue
+			}
+			txs[sender] = append(txs[sender], ethMsg.AsTransaction())
+		}
+	}
+
+	for _, accTxs := range txs {
+		sort.Sort(ethtypes.TxByNonce(accTxs))
+	}
+
+	return txs, nil
+}
+
+// nextBaseFee returns the base fee of the block following the given height,
+// which is calculated from the current header if not persisted yet.
+func (b *Backend) nextBaseFee(height int64) (*big.Int, error) {
+	if nextBaseFee := b.storedBaseFee(height + 1); nextBaseFee != nil {
+		return nextBaseFee, nil
+	}
+
+	cfg := b.ChainConfig()
+	if !cfg.IsLondon(big.NewInt(height + 1)) {
+		return new(big.Int), nil
+	}
+
+	header, err := b.CurrentHeader()
+	if err != nil {
+		return nil, err
+	}
+	return misc.CalcBaseFee(cfg, header), nil
+}
+
 // output: targetOneFeeHistory
 func (b *Backend) processBlock(
 	tendermintBlock *tmrpctypes.ResultBlock,
@@ -119,15 +171,9 @@ func (b *Backend) processBlock(
 
 	// set basefee
 	targetOneFeeHistory.BaseFee = blockBaseFee
-	cfg := b.ChainConfig()
-	if cfg.IsLondon(big.NewInt(blockHeight + 1)) {
-		header, err := b.CurrentHeader()
-		if err != nil {
-			return err
-		}
-		targetOneFeeHistory.NextBaseFee = misc.CalcBaseFee(cfg, header)
-	} else {
-		targetOneFeeHistory.NextBaseFee = new(big.Int)
+	targetOneFeeHistory.NextBaseFee, err = b.nextBaseFee(blockHeight)
+	if err != nil {
+		return err
 	}
 	// set gas used ratio
 	gasLimitUint64, ok := (*ethBlock)["gasLimit"].(hexutil.Uint64)
@@ -13,6 +13,11 @@ import (
 
 type UnsubscribeFunc func()
 
+// SubscriberBufferSize is the number of events buffered for each subscriber.
+// The events published while the buffer of a subscriber is full are dropped
+// for it, so that slow subscribers never block the others.
+const SubscriberBufferSize = 64
+
 type EventBus interface {
 	AddTopic(name string, src <-chan coretypes.ResultEvent) error
 	RemoveTopic(name string)
@@ -86,7 +91,7 @@ func (m *memEventBus) Subscribe(name string) (<-chan coretypes.ResultEvent, Unsu
 		return nil, nil, errors.Errorf("topic not found: %s", name)
 	}
 
-	ch := make(chan coretypes.ResultEvent)
+	ch := make(chan coretypes.ResultEvent, SubscriberBufferSize)
 	m.subscribersMux.Lock()
 	defer m.subscribersMux.Unlock()
 
@@ -3,6 +3,7 @@ package pubsub
 import (
 	"log"
 	"sort"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -137,3 +138,34 @@ func subscribeAndPublish(t *testing.T, eb EventBus, topic string, topicChan chan
 	}
 	wg.Wait()
 }
+
+func TestSlowSubscriber(t *testing.T) {
+	eb := NewEventBus()
+	topicCh := make(chan coretypes.ResultEvent)
+	require.NoError(t, eb.AddTopic("lol", topicCh))
+
+	slowCh, _, err := eb.Subscribe("lol")
+	require.NoError(t, err)
+	fastCh, _, err := eb.Subscribe("lol")
+	require.NoError(t, err)
+
+	// the slow subscriber doesn't read its events, without blocking the fast one
+	total := SubscriberBufferSize + 10
+	for i := 0; i < total; i++ {
+		msg := coretypes.ResultEvent{Query: strconv.Itoa(i)}
+		topicCh <- msg
+
+		select {
+		case received := <-fastCh:
+			require.Equal(t, msg, received)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("event %d not received", i)
+		}
+	}
+
+	// the events beyond the buffer of the slow subscriber are dropped
+	require.Len(t, slowCh, SubscriberBufferSize)
+	for i := 0; i < SubscriberBufferSize; i++ {
+		require.Equal(t, strconv.Itoa(i), (<-slowCh).Query)
+	}
+}
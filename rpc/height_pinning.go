@@ -0,0 +1,226 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockHeightHeader is the HTTP header pinning all the JSON-RPC requests of
+// an HTTP request to the given block height, in decimal or hex format.
+const BlockHeightHeader = "X-Evmos-Block-Height"
+
+// maxPinnedRequestSize is the maximum size of the HTTP requests whose JSON-RPC
+// requests are pinned to a height, matching the limit of the geth RPC server.
+// The larger requests are left to the server, which rejects them.
+const maxPinnedRequestSize = 5 * 1024 * 1024
+
+// blockParamIndexes maps the methods reading the state at a block to the
+// position of their block parameter.
+var blockParamIndexes = map[string]int{
+	"eth_getBalance":                          1,
+	"eth_getTransactionCount":                 1,
+	"eth_getCode":                             1,
+	"eth_getStorageAt":                        2,
+	"eth_getProof":                            2,
+	"eth_call":                                1,
+	"eth_estimateGas":                         1,
+	"eth_getBlockByNumber":                    0,
+	"eth_getBlockTransactionCountByNumber":    0,
+	"eth_getTransactionByBlockNumberAndIndex": 0,
+	"eth_feeHistory":                          1,
+}
+
+// LatestHeightFunc returns the height of the latest committed block.
+type LatestHeightFunc func() (int64, error)
+
+// HeightPinningHandler rewrites the JSON-RPC requests reading the latest
+// state to read it at a pinned height instead, so that the requests of a
+// batch observe the same block even if a block is committed while the batch
+// is served:
+//   - the requests of an HTTP request carrying the BlockHeightHeader are
+//     pinned to the height of the header.
+//   - otherwise, if enabled, the requests of a batch are pinned to the latest
+//     height resolved once before the batch is dispatched.
+//
+// Only the block parameters set to "latest" or omitted are rewritten.
+type HeightPinningHandler struct {
+	next         http.Handler
+	latestHeight LatestHeightFunc
+	pinBatches   bool
+	logger       log.Logger
+}
+
+// NewHeightPinningHandler creates a new HeightPinningHandler serving the
+// rewritten requests with the given handler.
+func NewHeightPinningHandler(next http.Handler, latestHeight LatestHeightFunc, pinBatches bool, logger log.Logger) *HeightPinningHandler {
+	return &HeightPinningHandler{
+		next:         next,
+		latestHeight: latestHeight,
+		pinBatches:   pinBatches,
+		logger:       logger,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *HeightPinningHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		height int64
+		pinned bool
+	)
+
+	if value := r.Header.Get(BlockHeightHeader); value != "" {
+		var err error
+		if height, err = parseBlockHeight(value); err != nil {
+			http.Error(w, fmt.Sprintf("invalid %s header: %s", BlockHeightHeader, err), http.StatusBadRequest)
+			return
+		}
+		pinned = true
+	} else if !h.pinBatches {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPinnedRequestSize+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(body) <= maxPinnedRequestSize {
+		if !pinned && isBatch(body) {
+			if height, err = h.latestHeight(); err != nil {
+				h.logger.Debug("failed to resolve the latest height of the batch", "error", err.Error())
+			} else {
+				pinned = true
+			}
+		}
+
+		if pinned {
+			if rewritten, err := pinRequests(body, height); err == nil {
+				body = rewritten
+			}
+		}
+	}
+
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+	if r.ContentLength >= 0 && len(body) <= maxPinnedRequestSize {
+		r.ContentLength = int64(len(body))
+	}
+	h.next.ServeHTTP(w, r)
+}
+
+// parseBlockHeight parses a positive block height in decimal or hex format.
+func parseBlockHeight(value string) (int64, error) {
+	var (
+		height uint64
+		err    error
+	)
+	if strings.HasPrefix(value, "0x") {
+		height, err = hexutil.DecodeUint64(value)
+	} else {
+		height, err = strconv.ParseUint(value, 10, 63)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if height == 0 || height > uint64(1<<63-1) {
+		return 0, fmt.Errorf("height must be positive, got %s", value)
+	}
+	return int64(height), nil
+}
+
+// pinRequests pins the JSON-RPC request or batch of the given body to the
+// given height.
+func pinRequests(body []byte, height int64) ([]byte, error) {
+	if !isBatch(body) {
+		var msg map[string]json.RawMessage
+		if err := json.Unmarshal(body, &msg); err != nil {
+			return nil, err
+		}
+		pinRequest(msg, height)
+		return json.Marshal(msg)
+	}
+
+	var msgs []map[string]json.RawMessage
+	if err := json.Unmarshal(body, &msgs); err != nil {
+		return nil, err
+	}
+	for _, msg := range msgs {
+		pinRequest(msg, height)
+	}
+	return json.Marshal(msgs)
+}
+
+// pinRequest rewrites the block parameter of the given JSON-RPC request to the
+// given height, if it reads the latest state.
+func pinRequest(msg map[string]json.RawMessage, height int64) {
+	var method string
+	if err := json.Unmarshal(msg["method"], &method); err != nil {
+		return
+	}
+
+	index, found := blockParamIndexes[method]
+	if !found {
+		return
+	}
+
+	var params []json.RawMessage
+	if raw, found := msg["params"]; found && !isNull(raw) {
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return
+		}
+	}
+
+	pinnedParam, err := json.Marshal(hexutil.EncodeUint64(uint64(height)))
+	if err != nil {
+		return
+	}
+
+	switch {
+	case index == len(params):
+		// omitted block parameter
+		params = append(params, pinnedParam)
+	case index > len(params):
+		// missing parameters are left to the server
+		return
+	case isNull(params[index]) || isLatest(params[index]):
+		params[index] = pinnedParam
+	default:
+		// block number or hash object
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(params[index], &obj); err != nil {
+			return
+		}
+		blockNumber, found := obj["blockNumber"]
+		if !found || !isLatest(blockNumber) {
+			return
+		}
+		obj["blockNumber"] = pinnedParam
+		if params[index], err = json.Marshal(obj); err != nil {
+			return
+		}
+	}
+
+	if raw, err := json.Marshal(params); err == nil {
+		msg["params"] = raw
+	}
+}
+
+func isNull(raw json.RawMessage) bool {
+	return string(bytes.TrimSpace(raw)) == "null"
+}
+
+func isLatest(raw json.RawMessage) bool {
+	var tag string
+	return json.Unmarshal(raw, &tag) == nil && tag == "latest"
+}
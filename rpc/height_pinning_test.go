@@ -0,0 +1,163 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/rpc/types"
+)
+
+// testChain is a controllable backend of the eth namespace, which commits a
+// block after every served request. It serves the queried height as the
+// result of the requests.
+type testChain struct {
+	height int64
+}
+
+func (c *testChain) resolve(blockNrOrHash types.BlockNumberOrHash) int64 {
+	defer func() { c.height++ }()
+	if blockNrOrHash.BlockNumber == nil || *blockNrOrHash.BlockNumber == types.EthLatestBlockNumber {
+		return c.height
+	}
+	return blockNrOrHash.BlockNumber.Int64()
+}
+
+func (c *testChain) GetBalance(_ common.Address, blockNrOrHash types.BlockNumberOrHash) *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(c.resolve(blockNrOrHash)))
+}
+
+func (c *testChain) GetTransactionCount(_ common.Address, blockNrOrHash types.BlockNumberOrHash) hexutil.Uint64 {
+	return hexutil.Uint64(c.resolve(blockNrOrHash)) //#nosec G701 -- test heights are positive
+}
+
+// Call accepts an omitted block, defaulting to the latest one.
+func (c *testChain) Call(_ map[string]interface{}, blockNrOrHash *types.BlockNumberOrHash) hexutil.Uint64 {
+	if blockNrOrHash == nil {
+		blockNrOrHash = &types.BlockNumberOrHash{}
+	}
+	return hexutil.Uint64(c.resolve(*blockNrOrHash)) //#nosec G701 -- test heights are positive
+}
+
+func (c *testChain) ChainId() hexutil.Uint64 { //nolint:revive,stylecheck
+	return 9000
+}
+
+type testResponse struct {
+	ID     int             `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newTestServer(t *testing.T, pinBatches bool) (*httptest.Server, *testChain) {
+	chain := &testChain{height: 10}
+	rpcServer := ethrpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("eth", chain))
+
+	latestHeight := func() (int64, error) { return chain.height, nil }
+	srv := httptest.NewServer(NewHeightPinningHandler(rpcServer, latestHeight, pinBatches, log.NewNopLogger()))
+	t.Cleanup(srv.Close)
+	return srv, chain
+}
+
+// post sends the given body and returns the heights served for the requests.
+func post(t *testing.T, url, body string, header http.Header) []int64 {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var responses []testResponse
+	if isBatch([]byte(body)) {
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&responses))
+	} else {
+		var response testResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&response))
+		responses = append(responses, response)
+	}
+
+	heights := make([]int64, len(responses))
+	for i, response := range responses {
+		require.Nil(t, response.Error, "request %d", response.ID)
+		var height hexutil.Big
+		require.NoError(t, json.Unmarshal(response.Result, &height))
+		heights[i] = height.ToInt().Int64()
+	}
+	return heights
+}
+
+const testBatch = `[
+	{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x1111111111111111111111111111111111111111","latest"]},
+	{"jsonrpc":"2.0","id":2,"method":"eth_getTransactionCount","params":["0x1111111111111111111111111111111111111111",{"blockNumber":"latest"}]},
+	{"jsonrpc":"2.0","id":3,"method":"eth_call","params":[{"to":"0x1111111111111111111111111111111111111111"}]},
+	{"jsonrpc":"2.0","id":4,"method":"eth_getBalance","params":["0x1111111111111111111111111111111111111111","0x5"]},
+	{"jsonrpc":"2.0","id":5,"method":"eth_chainId","params":[]}
+]`
+
+func TestHeightPinningBatch(t *testing.T) {
+	srv, chain := newTestServer(t, true)
+
+	// a block is committed between the batch entries, but the implicit-latest
+	// requests read the height of the batch start, while the explicit height
+	// and the other methods are untouched
+	heights := post(t, srv.URL, testBatch, nil)
+	require.Equal(t, []int64{10, 10, 10, 5, 9000}, heights)
+	require.Equal(t, int64(14), chain.height)
+
+	// the single requests are not pinned
+	heights = post(t, srv.URL, `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x1111111111111111111111111111111111111111","latest"]}`, nil)
+	require.Equal(t, []int64{14}, heights)
+}
+
+func TestHeightPinningDisabled(t *testing.T) {
+	srv, _ := newTestServer(t, false)
+
+	// the batch entries read the freshest state
+	heights := post(t, srv.URL, testBatch, nil)
+	require.Equal(t, []int64{10, 11, 12, 5, 9000}, heights)
+}
+
+func TestHeightPinningHeader(t *testing.T) {
+	for _, pinBatches := range []bool{true, false} {
+		srv, _ := newTestServer(t, pinBatches)
+
+		header := http.Header{BlockHeightHeader: []string{"7"}}
+		heights := post(t, srv.URL, testBatch, header)
+		require.Equal(t, []int64{7, 7, 7, 5, 9000}, heights)
+
+		header = http.Header{BlockHeightHeader: []string{"0x8"}}
+		heights = post(t, srv.URL, `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x1111111111111111111111111111111111111111","latest"]}`, header)
+		require.Equal(t, []int64{8}, heights)
+	}
+
+	srv, _ := newTestServer(t, true)
+	for _, value := range []string{"0", "-1", "latest", "0xzz"} {
+		req, err := http.NewRequest(http.MethodPost, srv.URL, bytes.NewBufferString(testBatch))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(BlockHeightHeader, value)
+
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+		require.Equal(t, http.StatusBadRequest, res.StatusCode, value)
+	}
+}
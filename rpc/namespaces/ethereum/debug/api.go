@@ -4,6 +4,7 @@ package debug
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/evmos/evmos/v19/rpc/backend"
 	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	"github.com/evmos/evmos/v19/server/config"
 )
 
 // HandlerT keeps track of the cpu profiler and trace execution
@@ -47,6 +49,7 @@ type API struct {
 	logger  log.Logger
 	backend backend.EVMBackend
 	handler *HandlerT
+	traces  *tracePool
 }
 
 // NewAPI creates a new API definition for the tracing methods of the Ethereum service.
@@ -54,28 +57,44 @@ func NewAPI(
 	ctx *server.Context,
 	backend backend.EVMBackend,
 ) *API {
+	appConf, err := config.GetConfig(ctx.Viper)
+	if err != nil {
+		panic(err)
+	}
+
 	return &API{
 		ctx:     ctx,
 		logger:  ctx.Logger.With("module", "debug"),
 		backend: backend,
 		handler: new(HandlerT),
+		traces:  newTracePool(appConf.JSONRPC.TraceConcurrency, appConf.JSONRPC.TraceQueueSize),
 	}
 }
 
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
-func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+func (a *API) TraceTransaction(ctx context.Context, hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
 	a.logger.Debug("debug_traceTransaction", "hash", hash)
+	if err := a.traces.acquire(ctx, priorityTx); err != nil {
+		return nil, err
+	}
+	defer a.traces.release()
+
 	return a.backend.TraceTransaction(hash, config)
 }
 
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+func (a *API) TraceBlockByNumber(ctx context.Context, height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByNumber", "height", height)
 	if height == 0 {
 		return nil, errors.New("genesis is not traceable")
 	}
+	if err := a.traces.acquire(ctx, priorityBlock); err != nil {
+		return nil, err
+	}
+	defer a.traces.release()
+
 	// Get Tendermint Block
 	resBlock, err := a.backend.TendermintBlockByNumber(height)
 	if err != nil {
@@ -88,8 +107,13 @@ func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.T
 
 // TraceBlockByHash returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+func (a *API) TraceBlockByHash(ctx context.Context, hash common.Hash, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByHash", "hash", hash)
+	if err := a.traces.acquire(ctx, priorityBlock); err != nil {
+		return nil, err
+	}
+	defer a.traces.release()
+
 	// Get Tendermint Block
 	resBlock, err := a.backend.TendermintBlockByHash(hash)
 	if err != nil {
@@ -105,6 +129,28 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
+// TraceCall returns the structured logs created during the execution of the
+// given call on top of the state of the given block, as a JSON object.
+func (a *API) TraceCall(
+	ctx context.Context,
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *evmtypes.TraceConfig,
+) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number or hash", blockNrOrHash)
+	if err := a.traces.acquire(ctx, priorityTx); err != nil {
+		return nil, err
+	}
+	defer a.traces.release()
+
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.backend.TraceCall(args, blockNum, config)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
@@ -329,6 +375,16 @@ func (a *API) SeedHash(number uint64) (string, error) {
 	return fmt.Sprintf("0x%x", ethash.SeedHash(number)), nil
 }
 
+// StorageRangeAt returns the storage of the given contract at the block of the
+// given hash. The state is only committed at the end of each block, so the
+// txIndex is ignored and the storage after the last tx of the block is
+// returned. The keys are iterated in ascending order of the slots, so
+// keyStart and the returned nextKey are slots instead of slot hashes.
+func (a *API) StorageRangeAt(blockHash common.Hash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int) (*rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "hash", blockHash, "index", txIndex, "address", contractAddress, "start", keyStart, "max", maxResult)
+	return a.backend.StorageRangeAt(blockHash, contractAddress, keyStart, maxResult)
+}
+
 // IntermediateRoots executes a block, and returns a list
 // of intermediate roots: the stateroot after each transaction.
 func (a *API) IntermediateRoots(hash common.Hash, _ *evmtypes.TraceConfig) ([]common.Hash, error) {
@@ -0,0 +1,185 @@
+package debug
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/rpc/backend"
+	srvflags "github.com/evmos/evmos/v19/server/flags"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// traceBackend is a backend recording the number of traces executed
+// concurrently. Each trace blocks until the unblock channel is closed or
+// receives a value.
+type traceBackend struct {
+	backend.EVMBackend
+
+	unblock     chan struct{}
+	started     chan common.Hash
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func newTraceBackend() *traceBackend {
+	return &traceBackend{
+		unblock: make(chan struct{}),
+		started: make(chan common.Hash, 100),
+	}
+}
+
+func (b *traceBackend) TraceTransaction(hash common.Hash, _ *evmtypes.TraceConfig) (interface{}, error) {
+	inFlight := b.inFlight.Add(1)
+	defer b.inFlight.Add(-1)
+
+	for {
+		maxInFlight := b.maxInFlight.Load()
+		if inFlight <= maxInFlight || b.maxInFlight.CompareAndSwap(maxInFlight, inFlight) {
+			break
+		}
+	}
+
+	b.started <- hash
+	<-b.unblock
+	return hash, nil
+}
+
+func newTestAPI(t *testing.T, b backend.EVMBackend, workers, queueSize int) *API {
+	ctx := server.NewDefaultContext()
+	ctx.Viper.Set(srvflags.JSONRPCTraceConcurrency, workers)
+	ctx.Viper.Set(srvflags.JSONRPCTraceQueueSize, queueSize)
+
+	api := NewAPI(ctx, b)
+	require.Equal(t, workers, api.traces.workers)
+	require.Equal(t, queueSize, api.traces.queueSize)
+	return api
+}
+
+// waitQueued waits until the given number of traces are queued in the pool.
+func waitQueued(t *testing.T, pool *tracePool, queued int) {
+	require.Eventually(t, func() bool {
+		pool.mu.Lock()
+		defer pool.mu.Unlock()
+		return pool.queued() == queued
+	}, time.Second, time.Millisecond)
+}
+
+func TestTraceConcurrency(t *testing.T) {
+	const traces = 20
+
+	b := newTraceBackend()
+	api := newTestAPI(t, b, 2, traces)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, traces)
+	for i := 0; i < traces; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := api.TraceTransaction(context.Background(), common.Hash{}, nil)
+			errs <- err
+		}()
+	}
+
+	// all the traces beyond the workers are queued
+	waitQueued(t, api.traces, traces-2)
+	require.Equal(t, int32(2), b.inFlight.Load())
+
+	// let the traces complete one at a time
+	go func() {
+		for i := 0; i < traces; i++ {
+			b.unblock <- struct{}{}
+		}
+	}()
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Len(t, b.started, traces)
+	require.Equal(t, int32(2), b.maxInFlight.Load())
+	require.Zero(t, api.traces.running)
+	require.Zero(t, api.traces.queued())
+}
+
+func TestTraceBusy(t *testing.T) {
+	b := newTraceBackend()
+	api := newTestAPI(t, b, 1, 1)
+
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := api.TraceTransaction(context.Background(), common.Hash{}, nil)
+			errs <- err
+		}()
+	}
+	waitQueued(t, api.traces, 1)
+
+	// the queue is full
+	_, err := api.TraceTransaction(context.Background(), common.Hash{}, nil)
+	require.ErrorIs(t, err, ErrTracerBusy)
+
+	close(b.unblock)
+	require.NoError(t, <-errs)
+	require.NoError(t, <-errs)
+}
+
+func TestTraceQueuedCancel(t *testing.T) {
+	b := newTraceBackend()
+	api := newTestAPI(t, b, 1, 1)
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := api.TraceTransaction(context.Background(), common.Hash{}, nil)
+		errs <- err
+	}()
+	<-b.started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queuedErr := make(chan error, 1)
+	go func() {
+		_, err := api.TraceTransaction(ctx, common.Hash{}, nil)
+		queuedErr <- err
+	}()
+	waitQueued(t, api.traces, 1)
+
+	// the cancelled trace leaves the queue without being executed
+	cancel()
+	require.ErrorIs(t, <-queuedErr, context.Canceled)
+	require.Zero(t, api.traces.queued())
+
+	close(b.unblock)
+	require.NoError(t, <-errs)
+	require.Len(t, b.started, 0)
+	require.Zero(t, api.traces.running)
+}
+
+func TestTracePoolPriority(t *testing.T) {
+	pool := newTracePool(1, 2)
+	require.NoError(t, pool.acquire(context.Background(), priorityBlock))
+
+	order := make(chan tracePriority, 2)
+	for _, priority := range []tracePriority{priorityBlock, priorityTx} {
+		priority := priority
+		go func() {
+			require.NoError(t, pool.acquire(context.Background(), priority))
+			order <- priority
+			pool.release()
+		}()
+		waitQueued(t, pool, int(priority)+1)
+	}
+
+	// the transaction trace is executed ahead of the block trace queued first
+	pool.release()
+	require.Equal(t, priorityTx, <-order)
+	require.Equal(t, priorityBlock, <-order)
+}
@@ -0,0 +1,164 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package debug
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// ErrTracerBusy is returned when a trace is requested while all the workers
+// are busy and the queue of pending traces is full.
+var ErrTracerBusy = errors.New("tracer busy")
+
+// Metric keys emitted by the trace pool.
+var (
+	metricKeyTraceRunning  = []string{"debug", "trace", "running"}
+	metricKeyTraceQueued   = []string{"debug", "trace", "queued"}
+	metricKeyTraceRejected = []string{"debug", "trace", "rejected"}
+)
+
+// tracePriority defines the order in which the queued traces are executed.
+type tracePriority int
+
+const (
+	// priorityBlock is the priority of the block traces, which can execute
+	// many transactions.
+	priorityBlock tracePriority = iota
+	// priorityTx is the priority of the single transaction traces, executed
+	// ahead of the queued block traces.
+	priorityTx
+
+	numPriorities
+)
+
+// traceWaiter is a trace queued for execution. Its ready channel is closed
+// once a worker is handed over to it.
+type traceWaiter struct {
+	ready chan struct{}
+}
+
+// tracePool bounds the number of traces executed concurrently. The traces
+// requested while all the workers are busy are queued by priority, in FIFO
+// order within a priority, up to the queue size.
+type tracePool struct {
+	mu        sync.Mutex
+	workers   int
+	queueSize int
+	running   int
+	queues    [numPriorities][]*traceWaiter
+}
+
+// newTracePool creates a pool executing up to the given number of traces
+// concurrently, with up to queueSize traces waiting for a worker.
+func newTracePool(workers, queueSize int) *tracePool {
+	return &tracePool{
+		workers:   workers,
+		queueSize: queueSize,
+	}
+}
+
+// acquire blocks until a worker is available for a trace of the given
+// priority, or the context is done. It returns ErrTracerBusy right away if the
+// queue is full. The worker must be released with release once the trace
+// completes.
+func (p *tracePool) acquire(ctx context.Context, priority tracePriority) error {
+	p.mu.Lock()
+	if p.running < p.workers {
+		p.running++
+		p.updateMetrics()
+		p.mu.Unlock()
+		return nil
+	}
+
+	if p.queued() >= p.queueSize {
+		p.mu.Unlock()
+		telemetry.IncrCounter(1, metricKeyTraceRejected...)
+		return ErrTracerBusy
+	}
+
+	waiter := &traceWaiter{ready: make(chan struct{})}
+	p.queues[priority] = append(p.queues[priority], waiter)
+	p.updateMetrics()
+	p.mu.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return nil
+	case <-ctx.Done():
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if !p.dequeue(priority, waiter) {
+			// the worker was handed over concurrently, pass it on
+			p.releaseLocked()
+		}
+		p.updateMetrics()
+		return ctx.Err()
+	}
+}
+
+// release frees a worker acquired with acquire, handing it over to the next
+// queued trace if any.
+func (p *tracePool) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.releaseLocked()
+	p.updateMetrics()
+}
+
+// releaseLocked hands the worker over to the queued trace with the highest
+// priority, or frees it if the queue is empty.
+//
+// CONTRACT: the caller must hold the lock.
+func (p *tracePool) releaseLocked() {
+	for priority := numPriorities - 1; priority >= 0; priority-- {
+		if len(p.queues[priority]) == 0 {
+			continue
+		}
+
+		waiter := p.queues[priority][0]
+		p.queues[priority] = p.queues[priority][1:]
+		close(waiter.ready)
+		return
+	}
+
+	p.running--
+}
+
+// dequeue removes the given waiter from the queue of its priority. It returns
+// false if the waiter is not queued anymore.
+//
+// CONTRACT: the caller must hold the lock.
+func (p *tracePool) dequeue(priority tracePriority, waiter *traceWaiter) bool {
+	for i, w := range p.queues[priority] {
+		if w == waiter {
+			p.queues[priority] = append(p.queues[priority][:i], p.queues[priority][i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// queued returns the number of traces waiting for a worker.
+//
+// CONTRACT: the caller must hold the lock.
+func (p *tracePool) queued() int {
+	var queued int
+	for _, queue := range p.queues {
+		queued += len(queue)
+	}
+	return queued
+}
+
+// updateMetrics reports the utilization of the pool.
+//
+// CONTRACT: the caller must hold the lock.
+func (p *tracePool) updateMetrics() {
+	telemetry.SetGauge(float32(p.running), metricKeyTraceRunning...)
+	telemetry.SetGauge(float32(p.queued()), metricKeyTraceQueued...)
+}
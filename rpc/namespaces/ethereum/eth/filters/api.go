@@ -66,6 +66,7 @@ type filter struct {
 	crit     filters.FilterCriteria
 	logs     []*ethtypes.Log
 	s        *Subscription // associated subscription in event system
+	cursor   int64         // height of the last block polled by a persisted filter
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -77,10 +78,21 @@ type PublicFilterAPI struct {
 	events    *EventSystem
 	filtersMu sync.Mutex
 	filters   map[rpc.ID]*filter
+	store     *FilterStore // optional, persists log filters across restarts
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
-// NewPublicAPI returns a new PublicFilterAPI instance.
-func NewPublicAPI(logger log.Logger, clientCtx client.Context, tmWSClient *rpcclient.WSClient, backend Backend) *PublicFilterAPI {
+// NewPublicAPI returns a new PublicFilterAPI instance. If a filter store is
+// provided, log filters are persisted and the ones stored from a previous run
+// are restored.
+func NewPublicAPI(
+	logger log.Logger,
+	clientCtx client.Context,
+	tmWSClient *rpcclient.WSClient,
+	backend Backend,
+	store *FilterStore,
+) *PublicFilterAPI {
 	logger = logger.With("api", "filter")
 	api := &PublicFilterAPI{
 		logger:    logger,
@@ -88,6 +100,12 @@ func NewPublicAPI(logger log.Logger, clientCtx client.Context, tmWSClient *rpccl
 		backend:   backend,
 		filters:   make(map[rpc.ID]*filter),
 		events:    NewEventSystem(logger, tmWSClient),
+		store:     store,
+		done:      make(chan struct{}),
+	}
+
+	if err := api.restoreFilters(); err != nil {
+		logger.Error("failed to restore persisted filters", "error", err.Error())
 	}
 
 	go api.timeoutLoop()
@@ -102,14 +120,17 @@ func (api *PublicFilterAPI) timeoutLoop() {
 	defer ticker.Stop()
 
 	for {
-		<-ticker.C
+		select {
+		case <-ticker.C:
+		case <-api.done:
+			return
+		}
 		api.filtersMu.Lock()
 		// #nosec G705
 		for id, f := range api.filters {
 			select {
 			case <-f.deadline.C:
-				f.s.Unsubscribe(api.events)
-				delete(api.filters, id)
+				api.removeFilter(id, f)
 			default:
 				continue
 			}
@@ -118,6 +139,24 @@ func (api *PublicFilterAPI) timeoutLoop() {
 	}
 }
 
+// Shutdown stops the timeout loop of the given API and closes its filter
+// store, if any. It is a function rather than a method so that it isn't
+// exposed as a JSON-RPC endpoint.
+func Shutdown(api *PublicFilterAPI) error {
+	var err error
+	api.closeOnce.Do(func() {
+		close(api.done)
+		if api.store == nil {
+			return
+		}
+
+		api.filtersMu.Lock()
+		defer api.filtersMu.Unlock()
+		err = api.store.Close()
+	})
+	return err
+}
+
 // NewPendingTransactionFilter creates a filter that fetches pending transaction hashes
 // as transactions enter the pending state.
 //
@@ -372,6 +411,7 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 	api.events.WithContext(ctx)
 	rpcSub := notifier.CreateSubscription()
 
+	crit = resolveBlockTags(crit)
 	logsSub, cancelSubs, err := api.events.SubscribeLogs(crit)
 	if err != nil {
 		return &rpc.Subscription{}, err
@@ -448,6 +488,11 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 		return rpc.ID(""), fmt.Errorf("error creating filter: max limit reached")
 	}
 
+	criteria = resolveBlockTags(criteria)
+	if api.store != nil {
+		return api.newPersistedFilter(criteria)
+	}
+
 	var (
 		filterID = rpc.ID("")
 		err      error
@@ -515,6 +560,8 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
 func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
+	crit = resolveBlockTags(crit)
+
 	var filter *Filter
 	if crit.BlockHash != nil {
 		// Block filter requested, construct a single-shot filter
@@ -547,16 +594,14 @@ func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCrit
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_uninstallfilter
 func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	api.filtersMu.Lock()
-	f, found := api.filters[id]
-	if found {
-		delete(api.filters, id)
-	}
-	api.filtersMu.Unlock()
+	defer api.filtersMu.Unlock()
 
+	f, found := api.filters[id]
 	if !found {
 		return false
 	}
-	f.s.Unsubscribe(api.events)
+
+	api.removeFilter(id, f)
 	return true
 }
 
@@ -631,6 +676,10 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 		f.hashes = nil
 		return returnHashes(hashes), nil
 	case filters.LogsSubscription, filters.MinedAndPendingLogsSubscription:
+		if f.s == nil {
+			return api.pollPersistedFilter(id, f)
+		}
+
 		logs := make([]*ethtypes.Log, len(f.logs))
 		copy(logs, f.logs)
 		f.logs = []*ethtypes.Log{}
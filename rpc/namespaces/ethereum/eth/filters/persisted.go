@@ -0,0 +1,174 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package filters
+
+import (
+	"fmt"
+	"time"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/evmos/evmos/v19/rpc/types"
+)
+
+// restoreFilters loads the log filters persisted in a previous run. Filters that
+// have not been polled within the deadline are expired and removed from the store.
+func (api *PublicFilterAPI) restoreFilters() error {
+	if api.store == nil {
+		return nil
+	}
+
+	stored, err := api.store.load()
+	if err != nil {
+		return err
+	}
+
+	api.filtersMu.Lock()
+	defer api.filtersMu.Unlock()
+
+	for id, sf := range stored {
+		elapsed := time.Since(sf.LastPoll)
+		if elapsed >= deadline || len(api.filters) >= int(api.backend.RPCFilterCap()) {
+			if err := api.store.delete(id); err != nil {
+				return err
+			}
+			continue
+		}
+
+		api.filters[id] = &filter{
+			typ:      filters.LogsSubscription,
+			crit:     sf.criteria(),
+			deadline: time.NewTimer(deadline - elapsed),
+			cursor:   sf.Cursor,
+		}
+	}
+
+	return nil
+}
+
+// newPersistedFilter creates a log filter that is polled from the chain state
+// instead of the event system, so that it can be resumed after a restart.
+// The caller must hold the filters lock.
+func (api *PublicFilterAPI) newPersistedFilter(criteria filters.FilterCriteria) (rpc.ID, error) {
+	if criteria.FromBlock != nil && criteria.ToBlock != nil &&
+		criteria.FromBlock.Int64() >= 0 && criteria.ToBlock.Int64() >= 0 &&
+		criteria.FromBlock.Int64() > criteria.ToBlock.Int64() {
+		return rpc.ID(""), fmt.Errorf("invalid from and to block combination: from > to (%d > %d)", criteria.FromBlock, criteria.ToBlock)
+	}
+
+	header, err := api.backend.HeaderByNumber(types.EthLatestBlockNumber)
+	if err != nil {
+		return rpc.ID(""), fmt.Errorf("failed to fetch header by number (latest): %w", err)
+	}
+
+	if header == nil || header.Number == nil {
+		return rpc.ID(""), fmt.Errorf("latest header not found")
+	}
+
+	id := rpc.NewID()
+	f := &filter{
+		typ:      filters.LogsSubscription,
+		crit:     criteria,
+		deadline: time.NewTimer(deadline),
+		cursor:   header.Number.Int64(),
+	}
+
+	if err := api.store.save(id, f.crit, f.cursor, time.Now()); err != nil {
+		return rpc.ID(""), err
+	}
+
+	api.filters[id] = f
+	return id, nil
+}
+
+// pollPersistedFilter returns the logs matching the filter criteria in the blocks
+// committed since the filter cursor and advances the cursor. The caller must hold
+// the filters lock.
+func (api *PublicFilterAPI) pollPersistedFilter(id rpc.ID, f *filter) ([]*ethtypes.Log, error) {
+	header, err := api.backend.HeaderByNumber(types.EthLatestBlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch header by number (latest): %w", err)
+	}
+
+	if header == nil || header.Number == nil {
+		return returnLogs(nil), nil
+	}
+
+	from := f.cursor + 1
+	if f.crit.FromBlock != nil && f.crit.FromBlock.Int64() > from {
+		from = f.crit.FromBlock.Int64()
+	}
+
+	to := header.Number.Int64()
+	if f.crit.ToBlock != nil && f.crit.ToBlock.Int64() >= 0 && f.crit.ToBlock.Int64() < to {
+		to = f.crit.ToBlock.Int64()
+	}
+
+	// the remaining blocks are returned on the following polls
+	if blockLimit := int64(api.backend.RPCBlockRangeCap()); to-from > blockLimit {
+		to = from + blockLimit
+	}
+
+	logs, err := logsInRange(api.backend, f.crit, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if to > f.cursor {
+		f.cursor = to
+	}
+
+	if err := api.store.save(id, f.crit, f.cursor, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return returnLogs(logs), nil
+}
+
+// removeFilter uninstalls the filter and deletes it from the store if it was
+// persisted. The caller must hold the filters lock.
+func (api *PublicFilterAPI) removeFilter(id rpc.ID, f *filter) {
+	delete(api.filters, id)
+
+	if f.s != nil {
+		f.s.Unsubscribe(api.events)
+	}
+
+	if api.store == nil || f.typ != filters.LogsSubscription {
+		return
+	}
+
+	if err := api.store.delete(id); err != nil {
+		api.logger.Error("failed to delete persisted filter", "id", id, "error", err.Error())
+	}
+}
+
+// logsInRange returns the logs matching the addresses and topics of the criteria
+// in the blocks of the given range, in order. It fails if the logs exceed the
+// logs cap of the backend.
+func logsInRange(backend Backend, crit filters.FilterCriteria, from, to int64) ([]*ethtypes.Log, error) {
+	logs := []*ethtypes.Log{}
+	logLimit := int(backend.RPCLogsCap())
+
+	for height := from; height <= to; height++ {
+		blockLogs, err := backend.GetLogsByHeight(&height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch logs for block %d: %w", height, err)
+		}
+
+		unfiltered := make([]*ethtypes.Log, 0)
+		for _, txLogs := range blockLogs {
+			unfiltered = append(unfiltered, txLogs...)
+		}
+
+		filtered := FilterLogs(unfiltered, nil, nil, crit.Addresses, crit.Topics)
+		if len(logs)+len(filtered) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		logs = append(logs, filtered...)
+	}
+
+	return logs, nil
+}
@@ -0,0 +1,120 @@
+package filters
+
+import (
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/rpc/types"
+)
+
+// mockBackend serves the logs of an in-memory chain whose head can be moved.
+type mockBackend struct {
+	Backend
+
+	head int64
+	logs map[int64][]*ethtypes.Log
+}
+
+func (b *mockBackend) HeaderByNumber(_ types.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b *mockBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	return [][]*ethtypes.Log{b.logs[*height]}, nil
+}
+
+func (b *mockBackend) RPCFilterCap() int32     { return 10 }
+func (b *mockBackend) RPCLogsCap() int32       { return 100 }
+func (b *mockBackend) RPCBlockRangeCap() int32 { return 100 }
+
+// newPersistedAPI mimics NewPublicAPI without connecting to the event system.
+func newPersistedAPI(t *testing.T, backend Backend, dir string) *PublicFilterAPI {
+	db, err := dbm.NewGoLevelDB(filterStoreName, dir)
+	require.NoError(t, err)
+
+	api := &PublicFilterAPI{
+		logger:  log.NewTMLogger(log.NewSyncWriter(os.Stdout)),
+		backend: backend,
+		filters: make(map[rpc.ID]*filter),
+		store:   NewFilterStore(db),
+		done:    make(chan struct{}),
+	}
+	require.NoError(t, api.restoreFilters())
+	return api
+}
+
+func TestPersistedFilterSurvivesRestart(t *testing.T) {
+	var (
+		dir      = t.TempDir()
+		address  = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		otherLog = &ethtypes.Log{Address: common.HexToAddress("0x2"), BlockNumber: 11}
+		backend  = &mockBackend{head: 10, logs: make(map[int64][]*ethtypes.Log)}
+	)
+
+	api := newPersistedAPI(t, backend, dir)
+	id, err := api.NewFilter(filters.FilterCriteria{Addresses: []common.Address{address}})
+	require.NoError(t, err)
+
+	// blocks committed before the first poll
+	backend.logs[11] = []*ethtypes.Log{{Address: address, BlockNumber: 11}, otherLog}
+	backend.head = 11
+
+	changes, err := api.GetFilterChanges(id)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+
+	// restart the backend and commit blocks while the node is down
+	require.NoError(t, Shutdown(api))
+	require.NoError(t, Shutdown(api), "shutting down twice should be a no-op")
+	backend.logs[12] = []*ethtypes.Log{{Address: address, BlockNumber: 12}}
+	backend.logs[13] = []*ethtypes.Log{{Address: address, BlockNumber: 13}}
+	backend.head = 13
+
+	api = newPersistedAPI(t, backend, dir)
+	defer api.store.Close()
+
+	changes, err = api.GetFilterChanges(id)
+	require.NoError(t, err)
+	logs, ok := changes.([]*ethtypes.Log)
+	require.True(t, ok)
+	require.Len(t, logs, 2)
+	require.Equal(t, uint64(12), logs[0].BlockNumber)
+	require.Equal(t, uint64(13), logs[1].BlockNumber)
+
+	// the cursor is advanced so no changes are returned twice
+	changes, err = api.GetFilterChanges(id)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	require.True(t, api.UninstallFilter(id))
+	stored, err := api.store.load()
+	require.NoError(t, err)
+	require.Empty(t, stored)
+}
+
+func TestPersistedFilterExpires(t *testing.T) {
+	dir := t.TempDir()
+	backend := &mockBackend{head: 1, logs: make(map[int64][]*ethtypes.Log)}
+
+	api := newPersistedAPI(t, backend, dir)
+	require.NoError(t, api.store.save(rpc.NewID(), filters.FilterCriteria{}, 1, time.Now().Add(-2*deadline)))
+	require.NoError(t, api.store.Close())
+
+	api = newPersistedAPI(t, backend, dir)
+	defer api.store.Close()
+
+	require.Empty(t, api.filters)
+	stored, err := api.store.load()
+	require.NoError(t, err)
+	require.Empty(t, stored)
+}
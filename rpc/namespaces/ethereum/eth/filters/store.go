@@ -0,0 +1,111 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package filters
+
+import (
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// filterStoreName is the name of the database that holds the persisted filters
+// under the node's data directory.
+const filterStoreName = "evmfilters"
+
+// storedFilter is the serializable representation of a log filter. It holds the
+// filter criteria and the height of the last block whose logs were returned to
+// the client.
+type storedFilter struct {
+	BlockHash *common.Hash     `json:"blockHash,omitempty"`
+	FromBlock *big.Int         `json:"fromBlock,omitempty"`
+	ToBlock   *big.Int         `json:"toBlock,omitempty"`
+	Addresses []common.Address `json:"addresses,omitempty"`
+	Topics    [][]common.Hash  `json:"topics,omitempty"`
+	Cursor    int64            `json:"cursor"`
+	LastPoll  time.Time        `json:"lastPoll"`
+}
+
+// criteria returns the filter criteria of the stored filter.
+func (sf storedFilter) criteria() filters.FilterCriteria {
+	return filters.FilterCriteria{
+		BlockHash: sf.BlockHash,
+		FromBlock: sf.FromBlock,
+		ToBlock:   sf.ToBlock,
+		Addresses: sf.Addresses,
+		Topics:    sf.Topics,
+	}
+}
+
+// FilterStore persists log filters so that they survive node restarts.
+type FilterStore struct {
+	db dbm.DB
+}
+
+// NewFilterStore returns a new FilterStore backed by the given database.
+func NewFilterStore(db dbm.DB) *FilterStore {
+	return &FilterStore{db: db}
+}
+
+// OpenFilterStore opens the goleveldb backed filter store under the data
+// directory of the given node home.
+func OpenFilterStore(rootDir string) (*FilterStore, error) {
+	db, err := dbm.NewDB(filterStoreName, dbm.GoLevelDBBackend, filepath.Join(rootDir, "data"))
+	if err != nil {
+		return nil, err
+	}
+	return NewFilterStore(db), nil
+}
+
+// save writes the log filter with the given id and polling cursor to the store.
+func (s *FilterStore) save(id rpc.ID, crit filters.FilterCriteria, cursor int64, lastPoll time.Time) error {
+	bz, err := json.Marshal(storedFilter{
+		BlockHash: crit.BlockHash,
+		FromBlock: crit.FromBlock,
+		ToBlock:   crit.ToBlock,
+		Addresses: crit.Addresses,
+		Topics:    crit.Topics,
+		Cursor:    cursor,
+		LastPoll:  lastPoll.UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	return s.db.SetSync([]byte(id), bz)
+}
+
+// delete removes the filter with the given id from the store.
+func (s *FilterStore) delete(id rpc.ID) error {
+	return s.db.DeleteSync([]byte(id))
+}
+
+// load returns all the filters in the store.
+func (s *FilterStore) load() (map[rpc.ID]storedFilter, error) {
+	it, err := s.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	stored := make(map[rpc.ID]storedFilter)
+	for ; it.Valid(); it.Next() {
+		var sf storedFilter
+		if err := json.Unmarshal(it.Value(), &sf); err != nil {
+			return nil, err
+		}
+		stored[rpc.ID(it.Key())] = sf
+	}
+
+	return stored, it.Error()
+}
+
+// Close closes the underlying database.
+func (s *FilterStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,163 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package filters
+
+import (
+	"context"
+	"fmt"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+
+	"github.com/evmos/evmos/v19/rpc/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// liveEventsBuffer is the number of live events buffered by a logs stream while
+// its historical logs are sent.
+const liveEventsBuffer = 1000
+
+// LogsStream streams the logs of a logs subscription. When the subscription
+// starts from a past block, the historical logs up to a cutover height are
+// queried from the chain and sent first, followed by the logs of the live
+// events of the blocks committed after the cutover height.
+type LogsStream struct {
+	crit    filters.FilterCriteria
+	cutover int64
+	history []*ethtypes.Log
+	live    chan coretypes.ResultEvent
+}
+
+// NewLogsStream creates the logs stream of a subscription from its live events.
+// The events must be subscribed to before the stream is created, so that no
+// block is committed between the historical logs and the live events. The
+// historical range is bounded by the same limits as eth_getLogs.
+func NewLogsStream(ctx context.Context, backend Backend, crit filters.FilterCriteria, events <-chan coretypes.ResultEvent) (*LogsStream, error) {
+	s := &LogsStream{
+		crit:    resolveBlockTags(crit),
+		cutover: -1,
+		live:    make(chan coretypes.ResultEvent, liveEventsBuffer),
+	}
+
+	// buffer the live events while the historical logs are queried and sent, so
+	// that the ones of the blocks committed in the meantime are not dropped
+	go s.bufferEvents(ctx, events)
+
+	if s.crit.FromBlock == nil || s.crit.FromBlock.Int64() < 0 {
+		return s, nil
+	}
+
+	header, err := backend.HeaderByNumber(types.EthLatestBlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch header by number (latest): %w", err)
+	}
+
+	if header == nil || header.Number == nil {
+		return nil, fmt.Errorf("latest header not found")
+	}
+
+	// the live events up to the cutover height are already covered by the
+	// historical logs
+	s.cutover = header.Number.Int64()
+
+	from := s.crit.FromBlock.Int64()
+	if from == 0 {
+		from = 1
+	}
+
+	to := s.cutover
+	if s.crit.ToBlock != nil && s.crit.ToBlock.Int64() >= 0 && s.crit.ToBlock.Int64() < to {
+		to = s.crit.ToBlock.Int64()
+	}
+
+	if from > to {
+		return s, nil
+	}
+
+	if blockLimit := int64(backend.RPCBlockRangeCap()); to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	s.history, err = logsInRange(backend, s.crit, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// bufferEvents forwards the live events to the stream buffer until the events
+// channel is closed or the context is done.
+func (s *LogsStream) bufferEvents(ctx context.Context, events <-chan coretypes.ResultEvent) {
+	defer close(s.live)
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			select {
+			case s.live <- event:
+			case <-ctx.Done():
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Run sends the historical logs and then the logs of the live events, in order,
+// until the events channel is closed, the context is done or sending fails.
+func (s *LogsStream) Run(ctx context.Context, send func(*ethtypes.Log) error) error {
+	for _, log := range s.history {
+		if err := send(log); err != nil {
+			return err
+		}
+	}
+
+	// release the historical logs once sent
+	s.history = nil
+
+	for {
+		select {
+		case event, ok := <-s.live:
+			if !ok {
+				return nil
+			}
+
+			logs, err := s.liveLogs(event)
+			if err != nil {
+				return err
+			}
+
+			for _, log := range logs {
+				if err := send(log); err != nil {
+					return err
+				}
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// liveLogs returns the logs of a live event matching the criteria, skipping the
+// events of the blocks up to the cutover height.
+func (s *LogsStream) liveLogs(event coretypes.ResultEvent) ([]*ethtypes.Log, error) {
+	dataTx, ok := event.Data.(tmtypes.EventDataTx)
+	if !ok || dataTx.Height <= s.cutover {
+		return nil, nil
+	}
+
+	txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tx response: %w", err)
+	}
+
+	return FilterLogs(evmtypes.LogsToEthereum(txResponse.Logs), s.crit.FromBlock, s.crit.ToBlock, s.crit.Addresses, s.crit.Topics), nil
+}
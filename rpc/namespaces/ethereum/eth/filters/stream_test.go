@@ -0,0 +1,251 @@
+package filters
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/rpc/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+var (
+	streamAddress = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	otherAddress  = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+// chainBackend serves the logs of an in-memory chain producing blocks
+// concurrently with the streams reading it.
+type chainBackend struct {
+	Backend
+
+	mu   sync.RWMutex
+	head int64
+	logs map[int64][]*ethtypes.Log
+}
+
+func newChainBackend() *chainBackend {
+	return &chainBackend{logs: make(map[int64][]*ethtypes.Log)}
+}
+
+func (b *chainBackend) HeaderByNumber(_ types.BlockNumber) (*ethtypes.Header, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b *chainBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return [][]*ethtypes.Log{b.logs[*height]}, nil
+}
+
+func (b *chainBackend) RPCLogsCap() int32       { return 100 }
+func (b *chainBackend) RPCBlockRangeCap() int32 { return 100 }
+
+// commit commits the next block, with a log of the streamed address and another
+// one, and returns the event of its tx.
+func (b *chainBackend) commit(t *testing.T) coretypes.ResultEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.head++
+	logs := []*ethtypes.Log{
+		{Address: streamAddress, BlockNumber: uint64(b.head)},
+		{Address: otherAddress, BlockNumber: uint64(b.head), Index: 1},
+	}
+	b.logs[b.head] = logs
+	return txEvent(t, b.head, logs)
+}
+
+// txEvent returns the Tendermint event of a tx emitting the given logs.
+func txEvent(t *testing.T, height int64, logs []*ethtypes.Log) coretypes.ResultEvent {
+	res, err := codectypes.NewAnyWithValue(&evmtypes.MsgEthereumTxResponse{Logs: evmtypes.NewLogsFromEth(logs)})
+	require.NoError(t, err)
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{res}})
+	require.NoError(t, err)
+
+	return coretypes.ResultEvent{
+		Data: tmtypes.EventDataTx{TxResult: abci.TxResult{
+			Height: height,
+			Result: abci.ResponseDeliverTx{Data: data},
+		}},
+	}
+}
+
+// runStream runs the stream in the background and returns the channel of the
+// logs it sends.
+func runStream(ctx context.Context, stream *LogsStream) <-chan *ethtypes.Log {
+	received := make(chan *ethtypes.Log, 1000)
+	go func() {
+		_ = stream.Run(ctx, func(log *ethtypes.Log) error {
+			received <- log
+			return nil
+		})
+	}()
+	return received
+}
+
+// requireBlockLogs requires the next logs received to be the ones of the
+// streamed address in the given blocks, in order.
+func requireBlockLogs(t *testing.T, received <-chan *ethtypes.Log, from, to int64) {
+	for height := from; height <= to; height++ {
+		select {
+		case log := <-received:
+			require.Equal(t, streamAddress, log.Address)
+			require.Equal(t, uint64(height), log.BlockNumber, "gap or duplicate at block %d", height)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("log of block %d not received", height)
+		}
+	}
+}
+
+func TestLogsStreamCatchUp(t *testing.T) {
+	const lastBlock = 200
+
+	backend := newChainBackend()
+	for i := 0; i < 10; i++ {
+		backend.commit(t)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// produce blocks while the stream is created and run, delivering their
+	// events to the subscription
+	events := make(chan coretypes.ResultEvent)
+	go func() {
+		for i := 10; i < lastBlock; i++ {
+			event := backend.commit(t)
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	crit := filters.FilterCriteria{FromBlock: big.NewInt(3), Addresses: []common.Address{streamAddress}}
+	stream, err := NewLogsStream(ctx, backend, crit, events)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, stream.cutover, int64(10))
+
+	received := runStream(ctx, stream)
+	requireBlockLogs(t, received, 3, lastBlock)
+
+	select {
+	case log := <-received:
+		t.Fatalf("unexpected log of block %d", log.BlockNumber)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestLogsStreamDedup(t *testing.T) {
+	backend := newChainBackend()
+	var blockEvents []coretypes.ResultEvent
+	for i := 0; i < 11; i++ {
+		blockEvents = append(blockEvents, backend.commit(t))
+	}
+
+	// the chain is at block 11 but the events of blocks 10 and 11 are
+	// delivered after the cutover height is recorded
+	events := make(chan coretypes.ResultEvent, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	crit := filters.FilterCriteria{FromBlock: big.NewInt(5), Addresses: []common.Address{streamAddress}}
+	stream, err := NewLogsStream(ctx, backend, crit, events)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), stream.cutover)
+
+	events <- blockEvents[9]
+	events <- blockEvents[10]
+	events <- backend.commit(t)
+
+	received := runStream(ctx, stream)
+	requireBlockLogs(t, received, 5, 12)
+
+	select {
+	case log := <-received:
+		t.Fatalf("unexpected log of block %d", log.BlockNumber)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestLogsStreamLive(t *testing.T) {
+	backend := newChainBackend()
+	backend.commit(t)
+
+	events := make(chan coretypes.ResultEvent, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// without fromBlock only the logs of the new blocks are streamed
+	stream, err := NewLogsStream(ctx, backend, filters.FilterCriteria{Addresses: []common.Address{streamAddress}}, events)
+	require.NoError(t, err)
+	require.Empty(t, stream.history)
+
+	events <- backend.commit(t)
+	requireBlockLogs(t, runStream(ctx, stream), 2, 2)
+}
+
+func TestLogsStreamLimits(t *testing.T) {
+	testCases := []struct {
+		name   string
+		blocks int
+		crit   filters.FilterCriteria
+		expErr string
+	}{
+		{
+			"block range exceeds the cap",
+			150,
+			filters.FilterCriteria{FromBlock: big.NewInt(1)},
+			"maximum [from, to] blocks distance: 100",
+		},
+		{
+			"block range bounded by toBlock",
+			150,
+			filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(50), Addresses: []common.Address{streamAddress}},
+			"",
+		},
+		{
+			"logs exceed the cap",
+			80,
+			filters.FilterCriteria{FromBlock: big.NewInt(1)},
+			"query returned more than 100 results",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := newChainBackend()
+			for i := 0; i < tc.blocks; i++ {
+				backend.commit(t)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stream, err := NewLogsStream(ctx, backend, tc.crit, make(chan coretypes.ResultEvent))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, stream.history, 50)
+		})
+	}
+}
@@ -7,8 +7,23 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+
+	"github.com/evmos/evmos/v19/rpc/types"
 )
 
+// resolveBlockTags returns a copy of the criteria where the "safe" and
+// "finalized" block tags of the block range are resolved to the latest block.
+func resolveBlockTags(crit filters.FilterCriteria) filters.FilterCriteria {
+	if crit.FromBlock != nil {
+		crit.FromBlock = big.NewInt(int64(types.NewBlockNumber(crit.FromBlock)))
+	}
+	if crit.ToBlock != nil {
+		crit.ToBlock = big.NewInt(int64(types.NewBlockNumber(crit.ToBlock)))
+	}
+	return crit
+}
+
 // FilterLogs creates a slice of logs matching the given criteria.
 // [] -> anything
 // [A] -> A in first position of log topics, anything after
@@ -0,0 +1,43 @@
+package filters
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/rpc/types"
+)
+
+func TestResolveBlockTags(t *testing.T) {
+	testCases := []struct {
+		tag       string
+		expNumber types.BlockNumber
+	}{
+		{"0x5", types.BlockNumber(5)},
+		{types.BlockParamEarliest, types.EthEarliestBlockNumber},
+		{types.BlockParamLatest, types.EthLatestBlockNumber},
+		{types.BlockParamSafe, types.EthLatestBlockNumber},
+		{types.BlockParamFinalized, types.EthLatestBlockNumber},
+		{types.BlockParamPending, types.EthPendingBlockNumber},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.tag, func(t *testing.T) {
+			var crit filters.FilterCriteria
+			input := fmt.Sprintf("{\"fromBlock\": %q, \"toBlock\": %q}", tc.tag, tc.tag)
+			require.NoError(t, crit.UnmarshalJSON([]byte(input)))
+
+			resolved := resolveBlockTags(crit)
+			require.Equal(t, big.NewInt(int64(tc.expNumber)), resolved.FromBlock)
+			require.Equal(t, big.NewInt(int64(tc.expNumber)), resolved.ToBlock)
+		})
+	}
+
+	// the block range defaults are kept
+	resolved := resolveBlockTags(filters.FilterCriteria{})
+	require.Nil(t, resolved.FromBlock)
+	require.Nil(t, resolved.ToBlock)
+}
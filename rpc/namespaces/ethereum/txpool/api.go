@@ -5,8 +5,7 @@ package txpool
 import (
 	"github.com/cometbft/cometbft/libs/log"
 
-	"github.com/ethereum/go-ethereum/common/hexutil"
-
+	"github.com/evmos/evmos/v19/rpc/quantity"
 	"github.com/evmos/evmos/v19/rpc/types"
 )
 
@@ -44,10 +43,10 @@ func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error)
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() map[string]quantity.Uint {
 	api.logger.Debug("txpool_status")
-	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+	return map[string]quantity.Uint{
+		"pending": quantity.Uint(0),
+		"queued":  quantity.Uint(0),
 	}
 }
@@ -0,0 +1,160 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cometbft/cometbft/libs/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	srvflags "github.com/evmos/evmos/v19/server/flags"
+	"github.com/evmos/evmos/v19/types"
+	"github.com/evmos/evmos/v19/version"
+)
+
+// Namespace is the JSON-RPC namespace of the admin API.
+const Namespace = "evmos"
+
+// SubscriptionsLister lists the active websocket subscriptions of the node.
+type SubscriptionsLister interface {
+	Subscriptions() []rpctypes.WebsocketConnection
+}
+
+// LogLevelSetter adjusts the log levels of the node at runtime.
+type LogLevelSetter interface {
+	SetLevel(module, level string) error
+	String() string
+}
+
+// PruningInfo is the pruning configuration of the node.
+type PruningInfo struct {
+	Strategy   string `json:"strategy"`
+	KeepRecent uint64 `json:"keepRecent"`
+	Interval   uint64 `json:"interval"`
+}
+
+// NodeInfo is the information of the node returned by evmos_getNodeInfo.
+type NodeInfo struct {
+	Version               string      `json:"version"`
+	ChainID               string      `json:"chainId"`
+	Indexer               bool        `json:"indexer"`
+	IndexerHead           int64       `json:"indexerHead"`
+	Pruning               PruningInfo `json:"pruning"`
+	Namespaces            []string    `json:"namespaces"`
+	PeerCount             int         `json:"peerCount"`
+	LogLevel              string      `json:"logLevel"`
+	MempoolMinPriorityFee uint64      `json:"mempoolMinPriorityFee"`
+}
+
+// API is the evmos_ prefixed set of APIs for the operators of the node. It is
+// served on its own listener and only changes the log level of the node.
+type API struct {
+	ctx           *server.Context
+	logger        log.Logger
+	clientCtx     client.Context
+	indexer       types.EVMTxIndexer
+	namespaces    []string
+	subscriptions SubscriptionsLister
+	logLevels     LogLevelSetter
+}
+
+// NewAPI creates an instance of the admin API. The indexer, the subscriptions
+// lister and the log level setter are optional.
+func NewAPI(
+	ctx *server.Context,
+	clientCtx client.Context,
+	indexer types.EVMTxIndexer,
+	namespaces []string,
+	subscriptions SubscriptionsLister,
+	logLevels LogLevelSetter,
+) *API {
+	return &API{
+		ctx:           ctx,
+		logger:        ctx.Logger.With("module", "admin"),
+		clientCtx:     clientCtx,
+		indexer:       indexer,
+		namespaces:    namespaces,
+		subscriptions: subscriptions,
+		logLevels:     logLevels,
+	}
+}
+
+// GetNodeInfo returns the binary version, chain ID, indexer head, pruning
+// settings, enabled JSON-RPC namespaces, peer count and mempool minimum
+// priority fee of the node.
+func (a *API) GetNodeInfo() (*NodeInfo, error) {
+	a.logger.Debug("evmos_getNodeInfo")
+
+	pruningOpts, err := server.GetPruningOptionsFromFlags(a.ctx.Viper)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &NodeInfo{
+		Version: version.Version(),
+		ChainID: a.clientCtx.ChainID,
+		Pruning: PruningInfo{
+			Strategy:   a.ctx.Viper.GetString(server.FlagPruning),
+			KeepRecent: pruningOpts.KeepRecent,
+			Interval:   pruningOpts.Interval,
+		},
+		Namespaces:            a.namespaces,
+		MempoolMinPriorityFee: a.ctx.Viper.GetUint64(srvflags.EVMMempoolMinPriorityFee),
+	}
+
+	if a.indexer != nil {
+		info.Indexer = true
+		if info.IndexerHead, err = a.indexer.LastIndexedBlock(); err != nil {
+			return nil, err
+		}
+	}
+
+	if netClient, ok := a.clientCtx.Client.(rpcclient.NetworkClient); ok {
+		netInfo, err := netClient.NetInfo(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		info.PeerCount = netInfo.NPeers
+	}
+
+	if a.logLevels != nil {
+		info.LogLevel = a.logLevels.String()
+	}
+
+	return info, nil
+}
+
+// SetLogLevel sets the log level of the given module, or the default level of
+// all the modules when it is "*". It returns the resulting log level
+// configuration.
+func (a *API) SetLogLevel(module, level string) (string, error) {
+	a.logger.Debug("evmos_setLogLevel", "module", module, "level", level)
+
+	if a.logLevels == nil {
+		return "", errors.New("the log level of the node cannot be changed at runtime")
+	}
+
+	if err := a.logLevels.SetLevel(module, level); err != nil {
+		return "", err
+	}
+
+	a.logger.Info("log level updated", "module", module, "level", level)
+	return a.logLevels.String(), nil
+}
+
+// ListSubscriptions returns the open websocket connections and their active
+// subscriptions.
+func (a *API) ListSubscriptions() []rpctypes.WebsocketConnection {
+	a.logger.Debug("evmos_listSubscriptions")
+
+	if a.subscriptions == nil {
+		return []rpctypes.WebsocketConnection{}
+	}
+	return a.subscriptions.Subscriptions()
+}
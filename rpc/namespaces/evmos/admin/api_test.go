@@ -0,0 +1,115 @@
+package admin_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/indexer"
+	"github.com/evmos/evmos/v19/rpc/backend/mocks"
+	"github.com/evmos/evmos/v19/rpc/namespaces/evmos/admin"
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	srvflags "github.com/evmos/evmos/v19/server/flags"
+	evmoslog "github.com/evmos/evmos/v19/server/log"
+	"github.com/evmos/evmos/v19/version"
+)
+
+type subscriptionsLister []rpctypes.WebsocketConnection
+
+func (l subscriptionsLister) Subscriptions() []rpctypes.WebsocketConnection {
+	return l
+}
+
+// newAdminClient serves the admin API over HTTP and returns a client connected to it.
+func newAdminClient(t *testing.T, logLevels admin.LogLevelSetter) *ethrpc.Client {
+	ctx := server.NewDefaultContext()
+	ctx.Viper.Set(server.FlagPruning, "custom")
+	ctx.Viper.Set(server.FlagPruningKeepRecent, 100)
+	ctx.Viper.Set(server.FlagPruningInterval, 10)
+	ctx.Viper.Set(srvflags.EVMMempoolMinPriorityFee, 1000)
+
+	tmClient := mocks.NewClient(t)
+	tmClient.On("NetInfo", mock.Anything).Return(&coretypes.ResultNetInfo{NPeers: 3}, nil).Maybe()
+
+	clientCtx := client.Context{}.WithChainID("evmos_9000-1").WithClient(tmClient)
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+
+	subscriptions := subscriptionsLister{
+		{
+			RemoteAddr:    "127.0.0.1:50000",
+			Subscriptions: []rpctypes.WebsocketSubscription{{ID: "0x1", Type: "newHeads"}},
+		},
+	}
+
+	api := admin.NewAPI(ctx, clientCtx, idxer, []string{"eth", "net"}, subscriptions, logLevels)
+
+	rpcServer := ethrpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName(admin.Namespace, api))
+	httpServer := httptest.NewServer(rpcServer)
+	t.Cleanup(httpServer.Close)
+
+	rpcClient, err := ethrpc.DialHTTP(httpServer.URL)
+	require.NoError(t, err)
+	t.Cleanup(rpcClient.Close)
+
+	return rpcClient
+}
+
+func TestGetNodeInfo(t *testing.T) {
+	logFilter, err := evmoslog.NewLevelFilter("info")
+	require.NoError(t, err)
+	rpcClient := newAdminClient(t, logFilter)
+
+	var info admin.NodeInfo
+	require.NoError(t, rpcClient.Call(&info, "evmos_getNodeInfo"))
+
+	require.Equal(t, admin.NodeInfo{
+		Version:               version.Version(),
+		ChainID:               "evmos_9000-1",
+		Indexer:               true,
+		IndexerHead:           -1,
+		Pruning:               admin.PruningInfo{Strategy: "custom", KeepRecent: 100, Interval: 10},
+		Namespaces:            []string{"eth", "net"},
+		PeerCount:             3,
+		LogLevel:              "*:info",
+		MempoolMinPriorityFee: 1000,
+	}, info)
+}
+
+func TestSetLogLevel(t *testing.T) {
+	logFilter, err := evmoslog.NewLevelFilter("info")
+	require.NoError(t, err)
+	rpcClient := newAdminClient(t, logFilter)
+
+	var logLevel string
+	require.NoError(t, rpcClient.Call(&logLevel, "evmos_setLogLevel", "evm", "debug"))
+	require.Equal(t, "*:info,evm:debug", logLevel)
+	require.False(t, logFilter.Filter("evm", "debug"))
+	require.True(t, logFilter.Filter("consensus", "debug"))
+
+	err = rpcClient.Call(&logLevel, "evmos_setLogLevel", "evm", "verbose")
+	require.ErrorContains(t, err, "invalid log level")
+
+	// the log level cannot be changed without a runtime filter
+	rpcClient = newAdminClient(t, nil)
+	err = rpcClient.Call(&logLevel, "evmos_setLogLevel", "evm", "debug")
+	require.ErrorContains(t, err, "cannot be changed at runtime")
+}
+
+func TestListSubscriptions(t *testing.T) {
+	rpcClient := newAdminClient(t, nil)
+
+	var conns []rpctypes.WebsocketConnection
+	require.NoError(t, rpcClient.Call(&conns, "evmos_listSubscriptions"))
+	require.Len(t, conns, 1)
+	require.Equal(t, "127.0.0.1:50000", conns[0].RemoteAddr)
+	require.Equal(t, []rpctypes.WebsocketSubscription{{ID: "0x1", Type: "newHeads"}}, conns[0].Subscriptions)
+}
@@ -0,0 +1,40 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package faucet
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Namespace is the JSON-RPC namespace of the faucet API.
+const Namespace = "evmos"
+
+// Backend claims funds from the faucet module.
+type Backend interface {
+	RequestFaucet(address string) (common.Hash, error)
+}
+
+// API is the evmos_ prefixed faucet API, letting the users of the EVM wallets
+// claim testnet funds for their address. It is only served if enabled by the
+// node config.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new faucet API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger.With("api", "faucet"),
+		backend: backend,
+	}
+}
+
+// RequestFaucet claims funds from the faucet module for the given bech32 or
+// hex address, and returns the hash of the claim tx. The claim is rejected if
+// the cooldown of the address has not elapsed.
+func (a *API) RequestFaucet(address string) (common.Hash, error) {
+	a.logger.Debug("evmos_requestFaucet", "address", address)
+	return a.backend.RequestFaucet(address)
+}
@@ -0,0 +1,205 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+// Package quantity implements the encoding of the quantities of the Ethereum
+// JSON-RPC API, as specified by the execution-apis: a 0x prefixed lowercase
+// hexadecimal number without leading zeros, e.g. "0x0" or "0x41".
+//
+// The quantities are always encoded strictly. They are also parsed strictly,
+// unless the lenient parsing is enabled for backward compatibility, in which
+// case the leading zeros, the uppercase digits and prefix, and the decimal
+// numbers are accepted as well.
+package quantity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ErrUppercase is returned when a quantity has uppercase digits or prefix.
+var ErrUppercase = errors.New("hex number with uppercase digits")
+
+var lenient atomic.Bool
+
+// SetLenient sets if the quantities are parsed leniently.
+func SetLenient(enabled bool) {
+	lenient.Store(enabled)
+}
+
+// IsLenient returns true if the quantities are parsed leniently.
+func IsLenient() bool {
+	return lenient.Load()
+}
+
+// ParseBig parses a quantity of at most 256 bits.
+func ParseBig(input string) (*big.Int, error) {
+	var (
+		value *big.Int
+		err   error
+	)
+	if IsLenient() {
+		value, err = parseLenient(input)
+	} else {
+		value, err = parseStrict(input)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if value.BitLen() > 256 {
+		return nil, hexutil.ErrBig256Range
+	}
+	return value, nil
+}
+
+// ParseUint64 parses a quantity of at most 64 bits.
+func ParseUint64(input string) (uint64, error) {
+	value, err := ParseBig(input)
+	if err != nil {
+		return 0, err
+	}
+
+	if !value.IsUint64() {
+		return 0, hexutil.ErrUint64Range
+	}
+	return value.Uint64(), nil
+}
+
+// EncodeUint64 encodes a uint64 as a quantity.
+func EncodeUint64(value uint64) string {
+	return hexutil.EncodeUint64(value)
+}
+
+// EncodeBig encodes a non-negative big integer as a quantity.
+func EncodeBig(value *big.Int) string {
+	return hexutil.EncodeBig(value)
+}
+
+// parseStrict parses a quantity matching ^0x(0|[1-9a-f][0-9a-f]*)$.
+func parseStrict(input string) (*big.Int, error) {
+	if input == "" {
+		return nil, hexutil.ErrEmptyString
+	}
+	if strings.HasPrefix(input, "0X") {
+		return nil, ErrUppercase
+	}
+	if !strings.HasPrefix(input, "0x") {
+		return nil, hexutil.ErrMissingPrefix
+	}
+
+	digits := input[2:]
+	if digits == "" {
+		return nil, hexutil.ErrEmptyNumber
+	}
+	if len(digits) > 1 && digits[0] == '0' {
+		return nil, hexutil.ErrLeadingZero
+	}
+
+	for _, c := range digits {
+		switch {
+		case '0' <= c && c <= '9', 'a' <= c && c <= 'f':
+		case 'A' <= c && c <= 'F':
+			return nil, ErrUppercase
+		default:
+			return nil, hexutil.ErrSyntax
+		}
+	}
+
+	value, _ := new(big.Int).SetString(digits, 16)
+	return value, nil
+}
+
+// parseLenient parses a hexadecimal number with the 0x prefix in any case, or
+// a decimal number without prefix, with leading zeros allowed in both.
+func parseLenient(input string) (*big.Int, error) {
+	if input == "" {
+		return nil, hexutil.ErrEmptyString
+	}
+
+	base := 10
+	digits := input
+	if len(input) >= 2 && input[0] == '0' && (input[1] == 'x' || input[1] == 'X') {
+		base = 16
+		digits = input[2:]
+		if digits == "" {
+			return nil, hexutil.ErrEmptyNumber
+		}
+	}
+
+	// big.Int also accepts signs and underscores that are not valid digits
+	if strings.ContainsAny(digits, "+-_") {
+		return nil, hexutil.ErrSyntax
+	}
+
+	value, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		return nil, hexutil.ErrSyntax
+	}
+	return value, nil
+}
+
+// unquote returns the string of a JSON string. The JSON numbers are only
+// accepted by the lenient parsing.
+func unquote(input []byte) (string, error) {
+	var s string
+	if err := json.Unmarshal(input, &s); err == nil {
+		return s, nil
+	}
+
+	var n json.Number
+	if IsLenient() && json.Unmarshal(input, &n) == nil {
+		return n.String(), nil
+	}
+	return "", fmt.Errorf("quantity must be a JSON string, got %s", input)
+}
+
+// Uint64 is a uint64 encoded as a quantity.
+type Uint64 uint64
+
+// MarshalText implements encoding.TextMarshaler.
+func (q Uint64) MarshalText() ([]byte, error) {
+	return []byte(EncodeUint64(uint64(q))), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (q *Uint64) UnmarshalJSON(input []byte) error {
+	s, err := unquote(input)
+	if err != nil {
+		return err
+	}
+
+	value, err := ParseUint64(s)
+	if err != nil {
+		return fmt.Errorf("invalid quantity %q: %w", s, err)
+	}
+	*q = Uint64(value)
+	return nil
+}
+
+// Uint is a uint encoded as a quantity.
+type Uint uint
+
+// MarshalText implements encoding.TextMarshaler.
+func (q Uint) MarshalText() ([]byte, error) {
+	return Uint64(q).MarshalText()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (q *Uint) UnmarshalJSON(input []byte) error {
+	var value Uint64
+	if err := value.UnmarshalJSON(input); err != nil {
+		return err
+	}
+
+	if uint64(value) > uint64(^uint(0)) {
+		return fmt.Errorf("invalid quantity %d: %w", value, hexutil.ErrUintRange)
+	}
+	*q = Uint(value)
+	return nil
+}
@@ -0,0 +1,159 @@
+package quantity
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+// The conformance vectors of the uint schema of the execution-apis
+// specification (^0x(0|[1-9a-f][0-9a-f]*)$), with the value expected in
+// lenient mode for the inputs rejected in strict mode.
+var conformanceVectors = []struct {
+	input      string
+	strictErr  error
+	expLenient string // empty means rejected in lenient mode
+}{
+	// valid quantities
+	{"0x0", nil, "0"},
+	{"0x1", nil, "1"},
+	{"0x41", nil, "65"},
+	{"0x400", nil, "1024"},
+	{"0xabcdef", nil, "11259375"},
+	{"0xffffffffffffffff", nil, "18446744073709551615"},
+	// invalid quantities
+	{"", hexutil.ErrEmptyString, ""},
+	{"0x", hexutil.ErrEmptyNumber, ""},
+	{"0x00", hexutil.ErrLeadingZero, "0"},
+	{"0x0400", hexutil.ErrLeadingZero, "1024"},
+	{"0X41", ErrUppercase, "65"},
+	{"0xABCDEF", ErrUppercase, "11259375"},
+	{"0xAbCdEf", ErrUppercase, "11259375"},
+	{"ff", hexutil.ErrMissingPrefix, ""},
+	{"1024", hexutil.ErrMissingPrefix, "1024"},
+	{"01024", hexutil.ErrMissingPrefix, "1024"},
+	{"0xg", hexutil.ErrSyntax, ""},
+	{"0x-1", hexutil.ErrSyntax, ""},
+	{"0x1_0", hexutil.ErrSyntax, ""},
+	{"-1", hexutil.ErrMissingPrefix, ""},
+	{"+1", hexutil.ErrMissingPrefix, ""},
+	{"1.5", hexutil.ErrMissingPrefix, ""},
+	{" 0x1", hexutil.ErrMissingPrefix, ""},
+}
+
+func TestParseBig(t *testing.T) {
+	for _, tc := range conformanceVectors {
+		t.Run(tc.input, func(t *testing.T) {
+			SetLenient(false)
+			value, err := ParseBig(tc.input)
+			if tc.strictErr != nil {
+				require.ErrorIs(t, err, tc.strictErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expLenient, value.String())
+			}
+
+			SetLenient(true)
+			defer SetLenient(false)
+			value, err = ParseBig(tc.input)
+			if tc.expLenient == "" {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expLenient, value.String())
+			}
+		})
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	max256 := "0x" + "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+
+	value, err := ParseBig(max256)
+	require.NoError(t, err)
+	require.Equal(t, 256, value.BitLen())
+
+	_, err = ParseBig("0x1" + max256[2:])
+	require.ErrorIs(t, err, hexutil.ErrBig256Range)
+
+	_, err = ParseUint64("0x10000000000000000")
+	require.ErrorIs(t, err, hexutil.ErrUint64Range)
+}
+
+func TestEncode(t *testing.T) {
+	testCases := []struct {
+		value *big.Int
+		exp   string
+	}{
+		{big.NewInt(0), "0x0"},
+		{big.NewInt(1), "0x1"},
+		{big.NewInt(0x41), "0x41"},
+		{big.NewInt(0x400), "0x400"},
+		{new(big.Int).SetUint64(^uint64(0)), "0xffffffffffffffff"},
+		{new(big.Int).Lsh(big.NewInt(1), 255), "0x8000000000000000000000000000000000000000000000000000000000000000"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.exp, EncodeBig(tc.value))
+		if tc.value.IsUint64() {
+			require.Equal(t, tc.exp, EncodeUint64(tc.value.Uint64()))
+
+			// the encoding is always strict
+			for _, lenient := range []bool{false, true} {
+				SetLenient(lenient)
+				bz, err := json.Marshal(Uint64(tc.value.Uint64()))
+				require.NoError(t, err)
+				require.Equal(t, `"`+tc.exp+`"`, string(bz))
+			}
+			SetLenient(false)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name       string
+		input      string
+		expStrict  bool
+		expLenient bool
+		exp        uint64
+	}{
+		{"quantity", `"0x41"`, true, true, 0x41},
+		{"zero", `"0x0"`, true, true, 0},
+		{"leading zeros", `"0x041"`, false, true, 0x41},
+		{"uppercase digits", `"0x4A"`, false, true, 0x4a},
+		{"decimal string", `"65"`, false, true, 65},
+		{"JSON number", `65`, false, true, 65},
+		{"negative JSON number", `-1`, false, false, 0},
+		{"fractional JSON number", `1.5`, false, false, 0},
+		{"JSON null", `null`, false, false, 0},
+		{"JSON bool", `true`, false, false, 0},
+		{"overflow", `"0x10000000000000000"`, false, false, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, lenient := range []bool{false, true} {
+				SetLenient(lenient)
+
+				var value Uint64
+				err := json.Unmarshal([]byte(tc.input), &value)
+				expPass := tc.expStrict
+				if lenient {
+					expPass = tc.expLenient
+				}
+
+				if expPass {
+					require.NoError(t, err)
+					require.Equal(t, tc.exp, uint64(value))
+				} else {
+					require.Error(t, err)
+				}
+			}
+			SetLenient(false)
+		})
+	}
+}
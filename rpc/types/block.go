@@ -5,20 +5,18 @@ package types
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"math"
 	"math/big"
-	"strings"
 
-	"github.com/spf13/cast"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 
+	"github.com/evmos/evmos/v19/rpc/quantity"
 	"github.com/evmos/evmos/v19/types"
 )
 
@@ -39,16 +37,39 @@ const (
 	BlockParamPending   = "pending"
 )
 
-// NewBlockNumber creates a new BlockNumber instance.
+// NewBlockNumber creates a new BlockNumber instance. The go-ethereum "safe" and
+// "finalized" block numbers resolve to the latest block, as CometBFT provides
+// instant finality.
 func NewBlockNumber(n *big.Int) BlockNumber {
 	if !n.IsInt64() {
 		// default to latest block if it overflows
 		return EthLatestBlockNumber
 	}
 
+	switch n.Int64() {
+	case ethrpc.SafeBlockNumber.Int64(), ethrpc.FinalizedBlockNumber.Int64():
+		return EthLatestBlockNumber
+	}
+
 	return BlockNumber(n.Int64())
 }
 
+// blockNumberFromTag returns the block number of the given block tag and
+// whether the tag is known. Both the "safe" and "finalized" tags resolve to the
+// latest block, as CometBFT provides instant finality.
+func blockNumberFromTag(tag string) (BlockNumber, bool) {
+	switch tag {
+	case BlockParamEarliest:
+		return EthEarliestBlockNumber, true
+	case BlockParamLatest, BlockParamFinalized, BlockParamSafe:
+		return EthLatestBlockNumber, true
+	case BlockParamPending:
+		return EthPendingBlockNumber, true
+	default:
+		return 0, false
+	}
+}
+
 // ContextWithHeight wraps a context with the a gRPC block height header. If the provided height is
 // 0, it will return an empty context and the gRPC query will use the latest block height for querying.
 // Note that all metadata is processed and removed by the CometBFT layer, so it won't be accessible at gRPC server level.
@@ -61,33 +82,22 @@ func ContextWithHeight(height int64) context.Context {
 }
 
 // UnmarshalJSON parses the given JSON fragment into a BlockNumber. It supports:
-// - "latest", "finalized", "earliest" or "pending" as string arguments
-// - the block number
+// - "latest", "safe", "finalized", "earliest" or "pending" as string arguments
+// - the block number as a hex quantity, or as a decimal number in lenient mode
 // Returned errors:
 // - an invalid block number error when the given argument isn't a known strings
 // - an out of range error when the given block number is either too little or too large
 func (bn *BlockNumber) UnmarshalJSON(data []byte) error {
-	input := strings.TrimSpace(string(data))
-	if len(input) >= 2 && input[0] == '"' && input[len(input)-1] == '"' {
-		input = input[1 : len(input)-1]
-	}
-
-	switch input {
-	case BlockParamEarliest:
-		*bn = EthEarliestBlockNumber
-		return nil
-	case BlockParamLatest, BlockParamFinalized, BlockParamSafe:
-		*bn = EthLatestBlockNumber
-		return nil
-	case BlockParamPending:
-		*bn = EthPendingBlockNumber
-		return nil
+	var input string
+	if err := json.Unmarshal(data, &input); err == nil {
+		if tagNumber, ok := blockNumberFromTag(input); ok {
+			*bn = tagNumber
+			return nil
+		}
 	}
 
-	blckNum, err := hexutil.DecodeUint64(input)
-	if errors.Is(err, hexutil.ErrMissingPrefix) {
-		blckNum = cast.ToUint64(input)
-	} else if err != nil {
+	var blckNum quantity.Uint64
+	if err := blckNum.UnmarshalJSON(data); err != nil {
 		return err
 	}
 
@@ -158,40 +168,34 @@ func (bnh *BlockNumberOrHash) checkUnmarshal(e BlockNumberOrHash) error {
 }
 
 func (bnh *BlockNumberOrHash) decodeFromString(input string) error {
-	switch input {
-	case BlockParamEarliest:
-		bn := EthEarliestBlockNumber
+	if bn, ok := blockNumberFromTag(input); ok {
 		bnh.BlockNumber = &bn
-	case BlockParamLatest, BlockParamFinalized:
-		bn := EthLatestBlockNumber
-		bnh.BlockNumber = &bn
-	case BlockParamPending:
-		bn := EthPendingBlockNumber
-		bnh.BlockNumber = &bn
-	default:
-		// check if the input is a block hash
-		if len(input) == 66 {
-			hash := common.Hash{}
-			err := hash.UnmarshalText([]byte(input))
-			if err != nil {
-				return err
-			}
-			bnh.BlockHash = &hash
-			break
-		}
-		// otherwise take the hex string has int64 value
-		blockNumber, err := hexutil.DecodeUint64(input)
-		if err != nil {
-			return err
-		}
+		return nil
+	}
 
-		bnInt, err := types.SafeInt64(blockNumber)
+	// check if the input is a block hash
+	if len(input) == 66 {
+		hash := common.Hash{}
+		err := hash.UnmarshalText([]byte(input))
 		if err != nil {
 			return err
 		}
+		bnh.BlockHash = &hash
+		return nil
+	}
 
-		bn := BlockNumber(bnInt)
-		bnh.BlockNumber = &bn
+	// otherwise take the hex string has int64 value
+	blockNumber, err := quantity.ParseUint64(input)
+	if err != nil {
+		return err
 	}
+
+	bnInt, err := types.SafeInt64(blockNumber)
+	if err != nil {
+		return err
+	}
+
+	bn := BlockNumber(bnInt)
+	bnh.BlockNumber = &bn
 	return nil
 }
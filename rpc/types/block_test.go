@@ -2,10 +2,14 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/rpc/quantity"
 )
 
 func TestUnmarshalBlockNumberOrHash(t *testing.T) {
@@ -100,3 +104,105 @@ func TestUnmarshalBlockNumberOrHash(t *testing.T) {
 		}
 	}
 }
+
+func TestUnmarshalBlockTags(t *testing.T) {
+	testCases := []struct {
+		tag       string
+		expNumber BlockNumber
+	}{
+		{BlockParamEarliest, EthEarliestBlockNumber},
+		{BlockParamLatest, EthLatestBlockNumber},
+		{BlockParamSafe, EthLatestBlockNumber},
+		{BlockParamFinalized, EthLatestBlockNumber},
+		{BlockParamPending, EthPendingBlockNumber},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.tag, func(t *testing.T) {
+			input := []byte(fmt.Sprintf("%q", tc.tag))
+
+			var bn BlockNumber
+			require.NoError(t, bn.UnmarshalJSON(input))
+			require.Equal(t, tc.expNumber, bn)
+
+			bnh := new(BlockNumberOrHash)
+			require.NoError(t, bnh.UnmarshalJSON(input))
+			require.Equal(t, tc.expNumber, *bnh.BlockNumber)
+			require.Nil(t, bnh.BlockHash)
+
+			bnh = new(BlockNumberOrHash)
+			require.NoError(t, bnh.UnmarshalJSON([]byte(fmt.Sprintf("{\"blockNumber\": %q}", tc.tag))))
+			require.Equal(t, tc.expNumber, *bnh.BlockNumber)
+			require.Nil(t, bnh.BlockHash)
+		})
+	}
+}
+
+func TestNewBlockNumber(t *testing.T) {
+	testCases := []struct {
+		name      string
+		number    *big.Int
+		expNumber BlockNumber
+	}{
+		{"block number", big.NewInt(10), BlockNumber(10)},
+		{"latest", big.NewInt(ethrpc.LatestBlockNumber.Int64()), EthLatestBlockNumber},
+		{"pending", big.NewInt(ethrpc.PendingBlockNumber.Int64()), EthPendingBlockNumber},
+		{"safe", big.NewInt(ethrpc.SafeBlockNumber.Int64()), EthLatestBlockNumber},
+		{"finalized", big.NewInt(ethrpc.FinalizedBlockNumber.Int64()), EthLatestBlockNumber},
+		{"overflow", new(big.Int).Lsh(big.NewInt(1), 64), EthLatestBlockNumber},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expNumber, NewBlockNumber(tc.number))
+		})
+	}
+}
+
+func TestUnmarshalBlockNumberQuantity(t *testing.T) {
+	testCases := []struct {
+		name       string
+		input      string
+		expStrict  bool
+		expLenient bool
+		expNumber  BlockNumber
+	}{
+		{"hex quantity", `"0x35"`, true, true, BlockNumber(0x35)},
+		{"zero", `"0x0"`, true, true, EthEarliestBlockNumber},
+		{"leading zeros", `"0x035"`, false, true, BlockNumber(0x35)},
+		{"uppercase digits", `"0x3A"`, false, true, BlockNumber(0x3a)},
+		{"decimal string", `"53"`, false, true, BlockNumber(53)},
+		{"JSON number", `53`, false, true, BlockNumber(53)},
+		{"unknown tag", `"oldest"`, false, false, 0},
+		{"larger than int64", `"0x8000000000000000"`, false, false, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, lenient := range []bool{false, true} {
+				quantity.SetLenient(lenient)
+				expPass := tc.expStrict
+				if lenient {
+					expPass = tc.expLenient
+				}
+
+				var bn BlockNumber
+				err := bn.UnmarshalJSON([]byte(tc.input))
+
+				bnh := new(BlockNumberOrHash)
+				errBnh := bnh.UnmarshalJSON([]byte(fmt.Sprintf("{\"blockNumber\": %s}", tc.input)))
+
+				if expPass {
+					require.NoError(t, err)
+					require.Equal(t, tc.expNumber, bn)
+					require.NoError(t, errBnh)
+					require.Equal(t, tc.expNumber, *bnh.BlockNumber)
+				} else {
+					require.Error(t, err)
+					require.Error(t, errBnh)
+				}
+			}
+			quantity.SetLenient(false)
+		})
+	}
+}
@@ -59,6 +59,8 @@ type ParsedTx struct {
 	EthTxIndex int32
 	GasUsed    uint64
 	Failed     bool
+	// nil means the fees are paid by the sender
+	FeePayer *common.Address
 }
 
 // NewParsedTx initialize a ParsedTx
@@ -66,6 +68,15 @@ func NewParsedTx(msgIndex int) ParsedTx {
 	return ParsedTx{MsgIndex: msgIndex, EthTxIndex: -1}
 }
 
+// FeePayerHex returns the hex address of the fee payer of the tx, or an empty
+// string if the fees are paid by the sender.
+func (tx ParsedTx) FeePayerHex() string {
+	if tx.FeePayer == nil {
+		return ""
+	}
+	return tx.FeePayer.Hex()
+}
+
 // ParsedTxs is the tx infos parsed from eth tx events.
 type ParsedTxs struct {
 	// one item per message
@@ -159,6 +170,7 @@ func ParseTxIndexerResult(txResult *tmrpctypes.ResultTx, tx sdk.Tx, getter func(
 		Failed:            parsedTx.Failed,
 		GasUsed:           parsedTx.GasUsed,
 		CumulativeGasUsed: txs.AccumulativeGasUsed(parsedTx.MsgIndex),
+		FeePayer:          parsedTx.FeePayerHex(),
 	}, nil
 }
 
@@ -248,6 +260,12 @@ func fillTxAttribute(tx *ParsedTx, key string, value string) error {
 		tx.GasUsed = gasUsed
 	case evmtypes.AttributeKeyEthereumTxFailed:
 		tx.Failed = len(value) > 0
+	case evmtypes.AttributeKeyFeePayer:
+		if !common.IsHexAddress(value) {
+			return fmt.Errorf("invalid fee payer address: %s", value)
+		}
+		feePayer := common.HexToAddress(value)
+		tx.FeePayer = &feePayer
 	}
 	return nil
 }
@@ -14,6 +14,7 @@ func TestParseTxResult(t *testing.T) {
 	address := "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"
 	txHash := common.BigToHash(big.NewInt(1))
 	txHash2 := common.BigToHash(big.NewInt(2))
+	feePayer := common.HexToAddress("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")
 
 	testCases := []struct {
 		name     string
@@ -119,6 +120,57 @@ func TestParseTxResult(t *testing.T) {
 				},
 			},
 		},
+		{
+			"format 2 events, fee payer",
+			abci.ResponseDeliverTx{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+					}},
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "amount", Value: "1000"},
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: "14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57"},
+						{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+						{Key: "fee_payer", Value: feePayer.Hex()},
+					}},
+				},
+			},
+			[]*ParsedTx{
+				{
+					MsgIndex:   0,
+					Hash:       txHash,
+					EthTxIndex: 0,
+					GasUsed:    21000,
+					Failed:     false,
+					FeePayer:   &feePayer,
+				},
+			},
+		},
+		{
+			"format 2 events, invalid fee payer",
+			abci.ResponseDeliverTx{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+					}},
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "amount", Value: "1000"},
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "fee_payer", Value: "evmos1"},
+					}},
+				},
+			},
+			nil,
+		},
 		{
 			"format 1 events, failed",
 			abci.ResponseDeliverTx{
@@ -8,6 +8,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -31,6 +33,22 @@ type StorageResult struct {
 	Proof []string     `json:"proof"`
 }
 
+// StorageRangeResult is the result of debug_storageRangeAt. NextKey is nil if
+// Storage includes the last slot of the contract.
+type StorageRangeResult struct {
+	Storage StorageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"`
+}
+
+// StorageMap maps the hashes of the storage keys to their preimage and value.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry defines the format of a storage slot of StorageRangeResult
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
 	BlockHash        *common.Hash         `json:"blockHash"`
@@ -54,21 +72,34 @@ type RPCTransaction struct {
 	S                *hexutil.Big         `json:"s"`
 }
 
-// StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
-
-// OverrideAccount indicates the overriding fields of account during the execution of
-// a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+// Bundle is a bundle of transactions of the eth_callMany api, executed with
+// the same block overrides.
+type Bundle struct {
+	Transactions  []evmtypes.TransactionArgs `json:"transactions"`
+	BlockOverride *evmtypes.BlockOverrides   `json:"blockOverride"`
+}
+
+// StateContext defines the state on which the bundles of the eth_callMany api
+// are executed. Only the state at the end of a block can be simulated, so the
+// transaction index is either omitted or -1.
+type StateContext struct {
+	BlockNumber      BlockNumberOrHash `json:"blockNumber"`
+	TransactionIndex *int              `json:"transactionIndex"`
+}
+
+// CallManyResult is the result of a transaction of the eth_callMany api, which
+// holds either its returned value or its error.
+type CallManyResult struct {
+	Value   hexutil.Bytes  `json:"value,omitempty"`
+	Error   string         `json:"error,omitempty"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+}
+
+// AccessListResult is the result of the eth_createAccessList api.
+type AccessListResult struct {
+	Accesslist *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
 }
 
 type FeeHistoryResult struct {
@@ -89,3 +120,15 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// WebsocketSubscription is an active subscription of a websocket connection.
+type WebsocketSubscription struct {
+	ID   rpc.ID `json:"id"`
+	Type string `json:"type"`
+}
+
+// WebsocketConnection is an open websocket connection and its active subscriptions.
+type WebsocketConnection struct {
+	RemoteAddr    string                  `json:"remoteAddr"`
+	Subscriptions []WebsocketSubscription `json:"subscriptions"`
+}
@@ -11,8 +11,10 @@ import (
 	"math/big"
 	"net"
 	"net/http"
+	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -33,11 +35,14 @@ import (
 	rpcfilters "github.com/evmos/evmos/v19/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/evmos/v19/rpc/types"
 	"github.com/evmos/evmos/v19/server/config"
+	evmostypes "github.com/evmos/evmos/v19/types"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
 type WebsocketsServer interface {
 	Start()
+	// Subscriptions returns the open connections and their active subscriptions.
+	Subscriptions() []types.WebsocketConnection
 }
 
 type SubscriptionResponseJSON struct {
@@ -75,9 +80,13 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+
+	// connsMu guards the active subscriptions of the open connections
+	connsMu sync.RWMutex
+	conns   map[*wsConn]map[rpc.ID]string
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, evmBackend rpcfilters.Backend, cfg *config.Config) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
@@ -86,8 +95,9 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		api:      newPubSubAPI(clientCtx, logger, tmWSClient, evmBackend),
 		logger:   logger,
+		conns:    make(map[*wsConn]map[rpc.ID]string),
 	}
 }
 
@@ -134,6 +144,51 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// Subscriptions returns the open connections sorted by remote address, along
+// with their active subscriptions sorted by ID.
+func (s *websocketsServer) Subscriptions() []types.WebsocketConnection {
+	s.connsMu.RLock()
+	defer s.connsMu.RUnlock()
+
+	conns := make([]types.WebsocketConnection, 0, len(s.conns))
+	for wsConn, subs := range s.conns {
+		conn := types.WebsocketConnection{
+			RemoteAddr:    wsConn.conn.RemoteAddr().String(),
+			Subscriptions: make([]types.WebsocketSubscription, 0, len(subs)),
+		}
+		for id, subType := range subs {
+			conn.Subscriptions = append(conn.Subscriptions, types.WebsocketSubscription{ID: id, Type: subType})
+		}
+		sort.Slice(conn.Subscriptions, func(i, j int) bool {
+			return conn.Subscriptions[i].ID < conn.Subscriptions[j].ID
+		})
+		conns = append(conns, conn)
+	}
+
+	sort.Slice(conns, func(i, j int) bool {
+		return conns[i].RemoteAddr < conns[j].RemoteAddr
+	})
+	return conns
+}
+
+// trackSubscription records the subscription of the given type as active on the connection.
+func (s *websocketsServer) trackSubscription(wsConn *wsConn, id rpc.ID, subType string) {
+	s.connsMu.Lock()
+	defer s.connsMu.Unlock()
+
+	if subs, ok := s.conns[wsConn]; ok {
+		subs[id] = subType
+	}
+}
+
+// untrackSubscription removes the subscription from the active ones of the connection.
+func (s *websocketsServer) untrackSubscription(wsConn *wsConn, id rpc.ID) {
+	s.connsMu.Lock()
+	defer s.connsMu.Unlock()
+
+	delete(s.conns[wsConn], id)
+}
+
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	res := &ErrorResponseJSON{
 		Jsonrpc: "2.0",
@@ -152,10 +207,18 @@ type wsConn struct {
 	mux  *sync.Mutex
 }
 
+// wsWriteTimeout is the time allowed to write a message to a peer. The peers
+// that don't read their messages in time are dropped, which cancels their
+// subscriptions, so that they don't hold the notifications of the others.
+const wsWriteTimeout = 10 * time.Second
+
 func (w *wsConn) WriteJSON(v interface{}) error {
 	w.mux.Lock()
 	defer w.mux.Unlock()
 
+	if err := w.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+		return err
+	}
 	return w.conn.WriteJSON(v)
 }
 
@@ -175,7 +238,16 @@ func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
 func (s *websocketsServer) readLoop(wsConn *wsConn) {
 	// subscriptions of current connection
 	subscriptions := make(map[rpc.ID]pubsub.UnsubscribeFunc)
+
+	s.connsMu.Lock()
+	s.conns[wsConn] = make(map[rpc.ID]string)
+	s.connsMu.Unlock()
+
 	defer func() {
+		s.connsMu.Lock()
+		delete(s.conns, wsConn)
+		s.connsMu.Unlock()
+
 		// cancel all subscriptions when connection closed
 		// #nosec G705
 		for _, unsubFn := range subscriptions {
@@ -240,20 +312,27 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			}
 
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			ready := make(chan struct{})
+			unsubFn, err := s.api.subscribe(wsConn, subID, params, ready)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
 			}
 			subscriptions[subID] = unsubFn
 
+			subType, _ := params[0].(string)
+			s.trackSubscription(wsConn, subID, subType)
+
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
 				ID:      connID,
 				Result:  subID,
 			}
 
-			if err := wsConn.WriteJSON(res); err != nil {
+			err = wsConn.WriteJSON(res)
+			// the notifications must follow the subscription ID
+			close(ready)
+			if err != nil {
 				break
 			}
 		case "eth_unsubscribe":
@@ -272,6 +351,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			unsubFn, ok := subscriptions[subID]
 			if ok {
 				delete(subscriptions, subID)
+				s.untrackSubscription(wsConn, subID)
 				unsubFn()
 			}
 
@@ -343,21 +423,25 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
 	events    *rpcfilters.EventSystem
+	backend   rpcfilters.Backend
 	logger    log.Logger
 	clientCtx client.Context
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, evmBackend rpcfilters.Backend) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
 		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
+		backend:   evmBackend,
 		logger:    logger,
 		clientCtx: clientCtx,
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+// subscribe creates the subscription of the given params. The notifications of
+// the subscriptions replaying past data are held until ready is closed.
+func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
 	method, ok := params[0].(string)
 	if !ok {
 		return nil, errors.New("invalid parameters")
@@ -369,11 +453,17 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		return api.subscribeNewHeads(wsConn, subID)
 	case "logs":
 		if len(params) > 1 {
-			return api.subscribeLogs(wsConn, subID, params[1])
+			return api.subscribeLogs(wsConn, subID, params[1], ready)
 		}
-		return api.subscribeLogs(wsConn, subID, nil)
+		return api.subscribeLogs(wsConn, subID, nil, ready)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		var fullTx bool
+		if len(params) > 1 && params[1] != nil {
+			if fullTx, ok = params[1].(bool); !ok {
+				return nil, errors.New("invalid parameters; full tx flag must be a boolean")
+			}
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -457,7 +547,9 @@ func try(fn func(), l log.Logger, desc string) {
 	fn()
 }
 
-func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+// subscribeLogs subscribes to the logs matching the criteria. When the criteria
+// start from a past block, the historical logs are sent before the live ones.
+func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
 	crit := filters.FilterCriteria{}
 
 	if extra != nil {
@@ -555,6 +647,24 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 				crit.Topics[topicIdx] = subtopicsCollect
 			}
 		}
+
+		if params["fromBlock"] != nil {
+			fromBlock, err := parseBlockNumber(params["fromBlock"])
+			if err != nil {
+				api.logger.Debug("invalid fromBlock", "error", err.Error())
+				return nil, err
+			}
+			crit.FromBlock = fromBlock
+		}
+
+		if params["toBlock"] != nil {
+			toBlock, err := parseBlockNumber(params["toBlock"])
+			if err != nil {
+				api.logger.Debug("invalid toBlock", "error", err.Error())
+				return nil, err
+			}
+			crit.ToBlock = toBlock
+		}
 	}
 
 	sub, unsubFn, err := api.events.SubscribeLogs(crit)
@@ -563,76 +673,104 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		return nil, err
 	}
 
-	go func() {
-		ch := sub.Event()
-		errCh := sub.Err()
-		for {
-			select {
-			case event, ok := <-ch:
-				if !ok {
-					return
-				}
-
-				dataTx, ok := event.Data.(tmtypes.EventDataTx)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", event.Data))
-					continue
-				}
-
-				txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
-				if err != nil {
-					api.logger.Error("failed to decode tx response", "error", err.Error())
-					return
-				}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := rpcfilters.NewLogsStream(ctx, api.backend, crit, sub.Event())
+	if err != nil {
+		cancel()
+		unsubFn()
+		api.logger.Debug("failed to stream logs", "error", err.Error())
+		return nil, err
+	}
 
-				logs := rpcfilters.FilterLogs(evmtypes.LogsToEthereum(txResponse.Logs), crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-				if len(logs) == 0 {
-					continue
-				}
+	go func() {
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return
+		}
 
-				for _, ethLog := range logs {
-					res := &SubscriptionNotification{
-						Jsonrpc: "2.0",
-						Method:  "eth_subscription",
-						Params: &SubscriptionResult{
-							Subscription: subID,
-							Result:       ethLog,
-						},
-					}
+		err := stream.Run(ctx, func(ethLog *ethtypes.Log) error {
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       ethLog,
+				},
+			}
 
-					err = wsConn.WriteJSON(res)
-					if err != nil {
-						try(func() {
-							if err != websocket.ErrCloseSent {
-								_ = wsConn.Close() // #nosec G703
-							}
-						}, api.logger, "closing websocket peer sub")
+			err := wsConn.WriteJSON(res)
+			if err != nil {
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close() // #nosec G703
 					}
-				}
-			case err, ok := <-errCh:
-				if !ok {
-					return
-				}
-				api.logger.Debug("dropping Logs WebSocket subscription", "subscription-id", subID, "error", err.Error())
+				}, api.logger, "closing websocket peer sub")
 			}
+			return err
+		})
+		if err != nil && err != context.Canceled {
+			api.logger.Debug("dropping Logs WebSocket subscription", "subscription-id", subID, "error", err.Error())
 		}
 	}()
 
-	return unsubFn, nil
+	return func() {
+		cancel()
+		unsubFn()
+	}, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+// parseBlockNumber parses the block number or tag of a logs criteria field.
+func parseBlockNumber(param interface{}) (*big.Int, error) {
+	str, ok := param.(string)
+	if !ok {
+		return nil, errors.Errorf("invalid block number: %v", param)
+	}
+
+	data, err := json.Marshal(str)
+	if err != nil {
+		return nil, err
+	}
+
+	var blockNumber types.BlockNumber
+	if err := blockNumber.UnmarshalJSON(data); err != nil {
+		return nil, errors.Wrapf(err, "invalid block number %s", str)
+	}
+
+	return big.NewInt(int64(blockNumber)), nil
+}
+
+// subscribePendingTransactions subscribes to the EVM txs entering the mempool.
+// The notifications hold the full txs if fullTx is true, and their hashes
+// otherwise.
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, fullTx bool) (pubsub.UnsubscribeFunc, error) {
+	var chainID *big.Int
+	if fullTx {
+		var err error
+		if chainID, err = evmostypes.ParseChainID(api.clientCtx.ChainID); err != nil {
+			return nil, errors.Wrap(err, "failed to parse chain id")
+		}
+	}
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+
 	go func() {
 		txsCh := sub.Event()
 		errCh := sub.Err()
 		for {
 			select {
-			case ev := <-txsCh:
+			case <-ctx.Done():
+				return
+			case ev, ok := <-txsCh:
+				if !ok {
+					return
+				}
+
 				data, ok := ev.Data.(tmtypes.EventDataTx)
 				if !ok {
 					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
@@ -646,37 +784,59 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 				}
 
 				for _, ethTx := range ethTxs {
+					result, err := pendingTxResult(ethTx, fullTx, chainID)
+					if err != nil {
+						api.logger.Debug("failed to build pending tx", "hash", ethTx.Hash, "error", err.Error())
+						continue
+					}
+
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
 					err = wsConn.WriteJSON(res)
 					if err != nil {
-						api.logger.Debug("error writing header, will drop peer", "error", err.Error())
+						api.logger.Debug("error writing pending tx, will drop peer", "error", err.Error())
 
 						try(func() {
 							if err != websocket.ErrCloseSent {
 								_ = wsConn.Close() // #nosec G703
 							}
 						}, api.logger, "closing websocket peer sub")
+						return
 					}
 				}
 			case err, ok := <-errCh:
 				if !ok {
 					return
 				}
-				api.logger.Debug("dropping PendingTransactions WebSocket subscription", subID, "error", err.Error())
+				api.logger.Debug("dropping PendingTransactions WebSocket subscription", "subscription-id", subID, "error", err.Error())
 			}
 		}
 	}()
 
-	return unsubFn, nil
+	return func() {
+		cancel()
+		unsubFn()
+	}, nil
+}
+
+// pendingTxResult returns the notification result of a pending EVM tx, which
+// is the tx in its JSON-RPC representation if fullTx is true, and its hash
+// otherwise.
+func pendingTxResult(ethTx *evmtypes.MsgEthereumTx, fullTx bool, chainID *big.Int) (interface{}, error) {
+	if !fullTx {
+		return ethTx.Hash, nil
+	}
+
+	// use zero block values since it's not included in a block yet
+	return types.NewTransactionFromMsg(ethTx, common.Hash{}, 0, 0, nil, chainID)
 }
 
 func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
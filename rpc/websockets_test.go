@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/rpc/types"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func TestPendingTxResult(t *testing.T) {
+	chainID := big.NewInt(9000)
+	to := common.HexToAddress("0x1")
+
+	ethTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   chainID,
+		Nonce:     1,
+		To:        &to,
+		Amount:    big.NewInt(100),
+		GasLimit:  21000,
+		GasFeeCap: big.NewInt(2_000_000_000),
+		GasTipCap: big.NewInt(1),
+		Accesses:  &ethtypes.AccessList{},
+	})
+	from, priv := utiltx.NewAddrKey()
+	ethTx.From = from.Hex()
+	require.NoError(t, ethTx.Sign(ethtypes.LatestSignerForChainID(chainID), utiltx.NewSigner(priv)))
+
+	// only the hash is notified by default
+	result, err := pendingTxResult(ethTx, false, chainID)
+	require.NoError(t, err)
+	require.Equal(t, ethTx.Hash, result)
+
+	// the full tx is notified without block values
+	result, err = pendingTxResult(ethTx, true, chainID)
+	require.NoError(t, err)
+
+	rpcTx, ok := result.(*types.RPCTransaction)
+	require.True(t, ok)
+	require.Equal(t, common.HexToHash(ethTx.Hash), rpcTx.Hash)
+	require.Equal(t, from, rpcTx.From)
+	require.Equal(t, &to, rpcTx.To)
+	require.Equal(t, uint64(1), uint64(rpcTx.Nonce))
+	require.Nil(t, rpcTx.BlockHash)
+	require.Nil(t, rpcTx.BlockNumber)
+	require.Nil(t, rpcTx.TransactionIndex)
+}
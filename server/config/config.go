@@ -5,6 +5,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"path"
 	"time"
 
@@ -47,6 +48,9 @@ const (
 	// DefaultJSONRPCWsAddress is the default address the JSON-RPC WebSocket server binds to.
 	DefaultJSONRPCWsAddress = "127.0.0.1:8546"
 
+	// DefaultJSONRPCAdminAddress is the default address the JSON-RPC admin server binds to.
+	DefaultJSONRPCAdminAddress = "127.0.0.1:8547"
+
 	// DefaultJsonRPCMetricsAddress is the default address the JSON-RPC Metrics server binds to.
 	DefaultJSONRPCMetricsAddress = "127.0.0.1:6065"
 
@@ -59,6 +63,18 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultMempoolPriceBump is the default minimum tip increase, in percent, to replace an eth tx in the mempool
+	DefaultMempoolPriceBump = 10
+
+	// DefaultTraceMaxStructLogs is the default maximum number of struct logs of a trace (0 means unlimited)
+	DefaultTraceMaxStructLogs = 0
+
+	// DefaultTraceMaxResultBytes is the default maximum size in bytes of the result of a trace (512 MiB)
+	DefaultTraceMaxResultBytes = 512 * 1024 * 1024
+
+	// DefaultFeeStateMaxStreams is the default maximum number of concurrent feemarket SubscribeFeeState streams
+	DefaultFeeStateMaxStreams = 100
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -92,6 +108,39 @@ const (
 	// DefaultAllowUnprotectedTxs value is false
 	DefaultAllowUnprotectedTxs = false
 
+	// DefaultPersistentFilters is the default value that defines if log filters are persisted across restarts
+	DefaultPersistentFilters = false
+
+	// DefaultRejectLatestWhileSyncing is the default value that defines if queries for the latest block are
+	// rejected while the node is catching up
+	DefaultRejectLatestWhileSyncing = false
+
+	// DefaultCallDefaultBaseFee is the default value that defines if omitted fee fields of eth_call
+	// default to the base fee
+	DefaultCallDefaultBaseFee = false
+
+	// DefaultPendingBalanceOverlay is the default value that defines if the balance queries for the
+	// pending block include the effects of the mempool txs
+	DefaultPendingBalanceOverlay = false
+
+	// DefaultReceiptFeePayer is the default value that defines if the receipts of the sponsored txs
+	// include the non-standard feePayer field
+	DefaultReceiptFeePayer = false
+
+	// DefaultLenientQuantities is the default value that defines if the hex quantities of the JSON-RPC
+	// requests are parsed leniently
+	DefaultLenientQuantities = false
+
+	// DefaultPinBatchHeight is the default value that defines if the requests of a JSON-RPC batch
+	// reading the latest block are pinned to the same height
+	DefaultPinBatchHeight = true
+
+	// DefaultTraceConcurrency is the default maximum number of debug traces executed concurrently
+	DefaultTraceConcurrency = 2
+
+	// DefaultTraceQueueSize is the default maximum number of debug traces waiting for execution
+	DefaultTraceQueueSize = 16
+
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
@@ -141,6 +190,25 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// MempoolReplaceByFee defines if an eth tx of the local mempool can be replaced by a tx with the
+	// same sender and nonce that pays a higher tip.
+	MempoolReplaceByFee bool `mapstructure:"mempool-replace-by-fee"`
+	// MempoolPriceBump defines the minimum tip increase, in percent, to replace an eth tx of the local mempool.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// TraceMaxStructLogs defines the maximum number of struct logs of a traced tx before the trace fails.
+	TraceMaxStructLogs uint64 `mapstructure:"trace-max-struct-logs"`
+	// TraceMaxResultBytes defines the maximum size in bytes of the result of a trace before it fails.
+	TraceMaxResultBytes uint64 `mapstructure:"trace-max-result-bytes"`
+	// MempoolPriorityMsgs defines the msg type URLs whose txs are prioritized over the other txs of the
+	// local mempool, regardless of their fees.
+	MempoolPriorityMsgs []string `mapstructure:"mempool-priority-msgs"`
+	// MempoolPriorityQuota defines the maximum number of prioritized txs included in each block proposal.
+	MempoolPriorityQuota uint64 `mapstructure:"mempool-priority-quota"`
+	// MempoolMinPriorityFee defines the minimum effective tip per gas, in the EVM denom, of the dynamic
+	// fee txs accepted into the local mempool.
+	MempoolMinPriorityFee uint64 `mapstructure:"mempool-min-priority-fee"`
+	// FeeStateMaxStreams defines the maximum number of concurrent feemarket SubscribeFeeState gRPC streams.
+	FeeStateMaxStreams uint64 `mapstructure:"fee-state-max-streams"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -185,6 +253,42 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// PersistentFilters defines if the log filters created through `eth_newFilter` are persisted
+	// to disk so that they survive node restarts.
+	PersistentFilters bool `mapstructure:"persistent-filters"`
+	// RejectLatestWhileSyncing defines if queries for the latest or pending block are rejected
+	// while the node is catching up with the network, instead of being served with stale data.
+	RejectLatestWhileSyncing bool `mapstructure:"reject-latest-while-syncing"`
+	// CallDefaultBaseFee defines if the omitted fee fields of `eth_call` default to the base fee
+	// of the block instead of zero, so that the call is priced as it would be on-chain.
+	CallDefaultBaseFee bool `mapstructure:"call-default-base-fee"`
+	// PendingBalanceOverlay defines if the `eth_getBalance` queries for the pending block include
+	// the value and maximum fee spent by the EVM txs in the local mempool.
+	PendingBalanceOverlay bool `mapstructure:"pending-balance-overlay"`
+	// PinBatchHeight defines if the requests of a JSON-RPC batch reading the latest block are pinned
+	// to the latest height resolved once for the whole batch, so that they observe the same block.
+	PinBatchHeight bool `mapstructure:"pin-batch-height"`
+	// AdminEnable defines if the admin JSON-RPC server exposing the `evmos` namespace should be enabled.
+	AdminEnable bool `mapstructure:"admin-enable"`
+	// AdminAddress defines the admin HTTP server to listen on. It must be a loopback address.
+	AdminAddress string `mapstructure:"admin-address"`
+	// TraceConcurrency defines the maximum number of `debug` namespace traces executed concurrently.
+	TraceConcurrency int `mapstructure:"trace-concurrency"`
+	// TraceQueueSize defines the maximum number of `debug` namespace traces waiting for a free worker.
+	// The traces requested beyond it are rejected right away.
+	TraceQueueSize int `mapstructure:"trace-queue-size"`
+	// FaucetEnable defines if the `evmos_requestFaucet` method claiming funds from the faucet module
+	// should be served. It must only be enabled on testnets.
+	FaucetEnable bool `mapstructure:"faucet-enable"`
+	// FaucetKey defines the name of the keyring key signing and paying the fees of the faucet claims.
+	FaucetKey string `mapstructure:"faucet-key"`
+	// ReceiptFeePayer defines if the `eth_getTransactionReceipt` responses include the non-standard
+	// `feePayer` field for the txs whose fees are not paid by their sender.
+	ReceiptFeePayer bool `mapstructure:"receipt-fee-payer"`
+	// LenientQuantities defines if the hex quantities of the requests are parsed leniently, accepting
+	// the leading zeros, the uppercase digits and the decimal numbers rejected by the specification.
+	// The quantities of the responses are always encoded strictly.
+	LenientQuantities bool `mapstructure:"lenient-quantities"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -251,17 +355,34 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:              DefaultEVMTracer,
+		MaxTxGasWanted:      DefaultMaxTxGasWanted,
+		MempoolReplaceByFee: false,
+		MempoolPriceBump:    DefaultMempoolPriceBump,
+		TraceMaxStructLogs:  DefaultTraceMaxStructLogs,
+		TraceMaxResultBytes: DefaultTraceMaxResultBytes,
+		MempoolPriorityMsgs: []string{},
+		FeeStateMaxStreams:  DefaultFeeStateMaxStreams,
 	}
 }
 
-// Validate returns an error if the tracer type is invalid.
+// Validate returns an error if the tracer type, the mempool price bump or the
+// prioritized msg types are invalid.
 func (c EVMConfig) Validate() error {
 	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.MempoolReplaceByFee && c.MempoolPriceBump == 0 {
+		return errors.New("mempool price bump must be positive when the replacement by fee is enabled")
+	}
+
+	for _, msgTypeURL := range c.MempoolPriorityMsgs {
+		if len(msgTypeURL) == 0 || msgTypeURL[0] != '/' {
+			return fmt.Errorf("invalid mempool priority msg type URL %s, it must start with '/'", msgTypeURL)
+		}
+	}
+
 	return nil
 }
 
@@ -297,6 +418,19 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		PersistentFilters:        DefaultPersistentFilters,
+		RejectLatestWhileSyncing: DefaultRejectLatestWhileSyncing,
+		CallDefaultBaseFee:       DefaultCallDefaultBaseFee,
+		PendingBalanceOverlay:    DefaultPendingBalanceOverlay,
+		PinBatchHeight:           DefaultPinBatchHeight,
+		AdminEnable:              false,
+		AdminAddress:             DefaultJSONRPCAdminAddress,
+		TraceConcurrency:         DefaultTraceConcurrency,
+		TraceQueueSize:           DefaultTraceQueueSize,
+		FaucetEnable:             false,
+		FaucetKey:                "",
+		ReceiptFeePayer:          DefaultReceiptFeePayer,
+		LenientQuantities:        DefaultLenientQuantities,
 	}
 }
 
@@ -338,6 +472,24 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.TraceConcurrency <= 0 {
+		return errors.New("JSON-RPC trace concurrency cannot be negative or 0")
+	}
+
+	if c.TraceQueueSize < 0 {
+		return errors.New("JSON-RPC trace queue size cannot be negative")
+	}
+
+	if c.AdminEnable {
+		if err := validateLoopbackAddress(c.AdminAddress); err != nil {
+			return fmt.Errorf("invalid JSON-RPC admin address: %w", err)
+		}
+	}
+
+	if c.FaucetEnable && c.FaucetKey == "" {
+		return errors.New("JSON-RPC faucet key cannot be empty when the faucet is enabled")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -351,6 +503,25 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// validateLoopbackAddress returns an error if the given address does not bind to
+// a loopback interface.
+func validateLoopbackAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if host == "localhost" {
+		return nil
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("%s is not a loopback address", address)
+	}
+
+	return nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
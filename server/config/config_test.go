@@ -68,3 +68,100 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRPCConfigAdminAddress(t *testing.T) {
+	testCases := []struct {
+		name    string
+		address string
+		expPass bool
+	}{
+		{"default address", DefaultJSONRPCAdminAddress, true},
+		{"localhost", "localhost:8547", true},
+		{"ipv6 loopback", "[::1]:8547", true},
+		{"all interfaces", "0.0.0.0:8547", false},
+		{"public address", "10.0.0.1:8547", false},
+		{"missing port", "127.0.0.1", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.AdminEnable = true
+			cfg.AdminAddress = tc.address
+
+			err := cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONRPCConfigTracePool(t *testing.T) {
+	testCases := []struct {
+		name        string
+		concurrency int
+		queueSize   int
+		expPass     bool
+	}{
+		{"default", DefaultTraceConcurrency, DefaultTraceQueueSize, true},
+		{"no queue", 1, 0, true},
+		{"zero concurrency", 0, DefaultTraceQueueSize, false},
+		{"negative queue size", DefaultTraceConcurrency, -1, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.TraceConcurrency = tc.concurrency
+			cfg.TraceQueueSize = tc.queueSize
+
+			err := cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestEVMConfigMempoolPriorityMsgs(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msgs    []string
+		expPass bool
+	}{
+		{"default", DefaultEVMConfig().MempoolPriorityMsgs, true},
+		{"gov vote", []string{"/cosmos.gov.v1.MsgVote", "/cosmos.gov.v1beta1.MsgVote"}, true},
+		{"missing slash", []string{"cosmos.gov.v1.MsgVote"}, false},
+		{"empty", []string{""}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultEVMConfig()
+			cfg.MempoolPriorityMsgs = tc.msgs
+
+			err := cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONRPCConfigFaucet(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	require.False(t, cfg.FaucetEnable)
+
+	cfg.FaucetEnable = true
+	require.Error(t, cfg.Validate())
+
+	cfg.FaucetKey = "faucet"
+	require.NoError(t, cfg.Validate())
+}
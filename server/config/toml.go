@@ -18,6 +18,40 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# MempoolReplaceByFee defines if an eth tx of the local mempool can be replaced by a tx with the same
+# sender and nonce that pays a tip at least 'mempool-price-bump' percent higher. The replaced tx is
+# evicted from the mempool. The replacement is charged its fees in check tx mode on top of the fees
+# of the replaced tx, so the sender balance must cover both.
+mempool-replace-by-fee = {{ .EVM.MempoolReplaceByFee }}
+
+# MempoolPriceBump defines the minimum tip increase, in percent, to replace an eth tx of the local mempool.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
+# TraceMaxStructLogs defines the maximum number of struct logs of a tx traced by the debug namespace
+# before the trace fails with a "trace output too large" error (0 means unlimited).
+trace-max-struct-logs = {{ .EVM.TraceMaxStructLogs }}
+
+# TraceMaxResultBytes defines the maximum size in bytes of the result of a trace of the debug namespace
+# before it fails with a "trace output too large" error (0 means unlimited).
+trace-max-result-bytes = {{ .EVM.TraceMaxResultBytes }}
+
+# MempoolPriorityMsgs defines the msg type URLs (e.g. "/cosmos.gov.v1.MsgVote") whose txs are prioritized
+# over the other txs of the local mempool, regardless of the fees they pay.
+mempool-priority-msgs = [{{ range $index, $msg := .EVM.MempoolPriorityMsgs }}{{ if $index }}, {{ end }}"{{ $msg }}"{{ end }}]
+
+# MempoolPriorityQuota defines the maximum number of prioritized txs included in each block proposed by the
+# node, so that the priority can't be abused (0 means unlimited).
+mempool-priority-quota = {{ .EVM.MempoolPriorityQuota }}
+
+# MempoolMinPriorityFee defines the minimum effective tip per gas, in the EVM denom, of the EVM and Cosmos
+# dynamic fee txs accepted into the local mempool, on top of the global min gas price. It only applies in
+# check tx mode, so the blocks proposed by other validators including lower tips are still valid.
+mempool-min-priority-fee = {{ .EVM.MempoolMinPriorityFee }}
+
+# FeeStateMaxStreams defines the maximum number of concurrent feemarket SubscribeFeeState gRPC streams,
+# pushing the fee state of each new block to the off-chain fee oracles.
+fee-state-max-streams = {{ .EVM.FeeStateMaxStreams }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -85,6 +119,58 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# PersistentFilters persists the log filters created through 'eth_newFilter' under the node's data
+# directory, so that polling clients can resume with 'eth_getFilterChanges' after a restart.
+persistent-filters = {{ .JSONRPC.PersistentFilters }}
+
+# RejectLatestWhileSyncing rejects the queries for the 'latest' and 'pending' blocks with an error
+# while the node is catching up with the network, instead of serving stale data.
+reject-latest-while-syncing = {{ .JSONRPC.RejectLatestWhileSyncing }}
+
+# CallDefaultBaseFee defaults the omitted fee fields of 'eth_call' to the base fee of the block
+# instead of zero, so that the call is priced and funded as it would be on-chain.
+call-default-base-fee = {{ .JSONRPC.CallDefaultBaseFee }}
+
+# PendingBalanceOverlay includes the value and maximum fee spent by the EVM transactions in the local
+# mempool on the 'eth_getBalance' queries for the 'pending' block.
+pending-balance-overlay = {{ .JSONRPC.PendingBalanceOverlay }}
+
+# PinBatchHeight pins the requests of a JSON-RPC batch reading the 'latest' block to the latest height
+# resolved once for the whole batch, so that they observe the same block even if a block is committed
+# while the batch is served. Disable it to always read the freshest state. The requests of an HTTP
+# request carrying the 'X-Evmos-Block-Height' header are pinned to its height regardless.
+pin-batch-height = {{ .JSONRPC.PinBatchHeight }}
+
+# AdminEnable enables the admin JSON-RPC server exposing the 'evmos' namespace, with the node info,
+# the runtime log level and the active websocket subscriptions.
+admin-enable = {{ .JSONRPC.AdminEnable }}
+
+# AdminAddress defines the admin JSON-RPC HTTP server address to bind to. Only loopback addresses
+# are accepted.
+admin-address = "{{ .JSONRPC.AdminAddress }}"
+
+# TraceConcurrency defines the maximum number of 'debug' namespace traces executed concurrently.
+trace-concurrency = {{ .JSONRPC.TraceConcurrency }}
+
+# TraceQueueSize defines the maximum number of 'debug' namespace traces waiting for a free worker.
+# The traces requested beyond it fail right away with a "tracer busy" error.
+trace-queue-size = {{ .JSONRPC.TraceQueueSize }}
+
+# FaucetEnable serves the 'evmos_requestFaucet' method, claiming funds from the faucet module on behalf
+# of the requested address. It must only be enabled on testnets.
+faucet-enable = {{ .JSONRPC.FaucetEnable }}
+
+# FaucetKey defines the name of the keyring key signing and paying the fees of the faucet claims.
+faucet-key = "{{ .JSONRPC.FaucetKey }}"
+
+# ReceiptFeePayer adds the non-standard 'feePayer' field to the 'eth_getTransactionReceipt' responses of
+# the transactions whose fees are not paid by their sender.
+receipt-fee-payer = {{ .JSONRPC.ReceiptFeePayer }}
+
+# LenientQuantities accepts the hex quantities of the requests with leading zeros or uppercase digits
+# and the decimal numbers, for backward compatibility. The responses are always encoded strictly.
+lenient-quantities = {{ .JSONRPC.LenientQuantities }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
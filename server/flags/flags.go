@@ -29,6 +29,13 @@ const (
 	GRPCWebAddress = "grpc-web.address"
 )
 
+// JSON-RPC only mode flags, serving the JSON-RPC from a remote node.
+const (
+	JSONRPCOnly = "json-rpc-only"
+	RemoteGRPC  = "remote-grpc"
+	RemoteRPC   = "remote-rpc"
+)
+
 // Cosmos API flags
 const (
 	RPCEnable         = "api.enable"
@@ -58,12 +65,33 @@ const (
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	JSONRPCPersistentFilters        = "json-rpc.persistent-filters"
+	JSONRPCRejectLatestWhileSyncing = "json-rpc.reject-latest-while-syncing"
+	JSONRPCCallDefaultBaseFee       = "json-rpc.call-default-base-fee"
+	JSONRPCPendingBalanceOverlay    = "json-rpc.pending-balance-overlay"
+	JSONRPCPinBatchHeight           = "json-rpc.pin-batch-height"
+	JSONRPCAdminEnable              = "json-rpc.admin-enable"
+	JSONRPCAdminAddress             = "json-rpc.admin-address"
+	JSONRPCTraceConcurrency         = "json-rpc.trace-concurrency"
+	JSONRPCTraceQueueSize           = "json-rpc.trace-queue-size"
+	JSONRPCFaucetEnable             = "json-rpc.faucet-enable"
+	JSONRPCFaucetKey                = "json-rpc.faucet-key"
+	JSONRPCReceiptFeePayer          = "json-rpc.receipt-fee-payer"
+	JSONRPCLenientQuantities        = "json-rpc.lenient-quantities"
 )
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer                = "evm.tracer"
+	EVMMaxTxGasWanted        = "evm.max-tx-gas-wanted"
+	EVMMempoolReplaceByFee   = "evm.mempool-replace-by-fee"
+	EVMMempoolPriceBump      = "evm.mempool-price-bump"
+	EVMTraceMaxStructLogs    = "evm.trace-max-struct-logs"
+	EVMTraceMaxResultBytes   = "evm.trace-max-result-bytes"
+	EVMMempoolPriorityMsgs   = "evm.mempool-priority-msgs"
+	EVMMempoolPriorityQuota  = "evm.mempool-priority-quota"
+	EVMMempoolMinPriorityFee = "evm.mempool-min-priority-fee"
+	EVMFeeStateMaxStreams    = "evm.fee-state-max-streams"
 )
 
 // TLS flags
@@ -3,6 +3,7 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -15,6 +16,12 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/evmos/v19/rpc"
+	"github.com/evmos/evmos/v19/rpc/backend"
+	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/eth/filters"
+	"github.com/evmos/evmos/v19/rpc/namespaces/evmos/admin"
+	"github.com/evmos/evmos/v19/rpc/namespaces/evmos/faucet"
+	"github.com/evmos/evmos/v19/rpc/quantity"
+	evmoslog "github.com/evmos/evmos/v19/server/log"
 
 	"github.com/evmos/evmos/v19/server/config"
 	evmostypes "github.com/evmos/evmos/v19/types"
@@ -27,6 +34,7 @@ func StartJSONRPC(ctx *server.Context,
 	tmEndpoint string,
 	config *config.Config,
 	indexer evmostypes.EVMTxIndexer,
+	logFilter *evmoslog.LevelFilter,
 ) (*http.Server, chan struct{}, error) {
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
@@ -43,6 +51,8 @@ func StartJSONRPC(ctx *server.Context,
 		return nil
 	}))
 
+	quantity.SetLenient(config.JSONRPC.LenientQuantities)
+
 	rpcServer := ethrpc.NewServer()
 
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
@@ -61,8 +71,30 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+
+	if config.JSONRPC.FaucetEnable {
+		if err := rpcServer.RegisterName(faucet.Namespace, faucet.NewAPI(ctx.Logger, evmBackend)); err != nil {
+			ctx.Logger.Error("failed to register service in JSON RPC namespace", "namespace", faucet.Namespace)
+			return nil, nil, err
+		}
+	}
+
+	// resolve the latest height of the batches from the node
+	latestHeight := func() (int64, error) {
+		node, err := clientCtx.GetNode()
+		if err != nil {
+			return 0, err
+		}
+		status, err := node.Status(context.Background())
+		if err != nil {
+			return 0, err
+		}
+		return status.SyncInfo.LatestBlockHeight, nil
+	}
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpc.NewHeightPinningHandler(rpcServer, latestHeight, config.JSONRPC.PinBatchHeight, ctx.Logger)).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -89,6 +121,7 @@ func StartJSONRPC(ctx *server.Context,
 		ctx.Logger.Info("Starting JSON-RPC server", "address", config.JSONRPC.Address)
 		if err := httpSrv.Serve(ln); err != nil {
 			if err == http.ErrServerClosed {
+				shutdownAPIs(ctx, apis)
 				close(httpSrvDone)
 				return
 			}
@@ -109,7 +142,78 @@ func StartJSONRPC(ctx *server.Context,
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, evmBackend, config)
 	wsSrv.Start()
+
+	if config.JSONRPC.AdminEnable {
+		if err := startAdminServer(ctx, clientCtx, config, indexer, wsSrv, logFilter); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	return httpSrv, httpSrvDone, nil
 }
+
+// shutdownAPIs releases the resources held by the registered JSON-RPC
+// services once the server has stopped, such as the persistent filter store.
+func shutdownAPIs(ctx *server.Context, apis []ethrpc.API) {
+	for _, api := range apis {
+		filterAPI, ok := api.Service.(*filters.PublicFilterAPI)
+		if !ok {
+			continue
+		}
+		if err := filters.Shutdown(filterAPI); err != nil {
+			ctx.Logger.Error("failed to close the filter store", "error", err.Error())
+		}
+	}
+}
+
+// startAdminServer starts the admin JSON-RPC server, which only serves the
+// evmos namespace over HTTP on its own loopback address.
+func startAdminServer(
+	ctx *server.Context,
+	clientCtx client.Context,
+	config *config.Config,
+	indexer evmostypes.EVMTxIndexer,
+	wsSrv rpc.WebsocketsServer,
+	logFilter *evmoslog.LevelFilter,
+) error {
+	// NOTE: avoid wrapping a nil filter in a non-nil interface
+	var logLevels admin.LogLevelSetter
+	if logFilter != nil {
+		logLevels = logFilter
+	}
+
+	adminServer := ethrpc.NewServer()
+	adminAPI := admin.NewAPI(ctx, clientCtx, indexer, config.JSONRPC.API, wsSrv, logLevels)
+	if err := adminServer.RegisterName(admin.Namespace, adminAPI); err != nil {
+		ctx.Logger.Error("failed to register service in JSON RPC namespace", "namespace", admin.Namespace)
+		return err
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/", adminServer.ServeHTTP).Methods("POST")
+
+	adminSrv := &http.Server{
+		Addr:              config.JSONRPC.AdminAddress,
+		Handler:           r,
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+		ReadTimeout:       config.JSONRPC.HTTPTimeout,
+		WriteTimeout:      config.JSONRPC.HTTPTimeout,
+		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+	}
+
+	ln, err := Listen(adminSrv.Addr, config)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Info("Starting JSON-RPC admin server", "address", config.JSONRPC.AdminAddress)
+	go func() {
+		if err := adminSrv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			ctx.Logger.Error("failed to start JSON-RPC admin server", "error", err.Error())
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
+
+	"github.com/evmos/evmos/v19/indexer"
+	"github.com/evmos/evmos/v19/server/config"
+	srvflags "github.com/evmos/evmos/v19/server/flags"
+	evmoslog "github.com/evmos/evmos/v19/server/log"
+	evmostypes "github.com/evmos/evmos/v19/types"
+)
+
+// startJSONRPCOnly runs the JSON-RPC and websocket servers against the gRPC and
+// Tendermint RPC endpoints of a remote node, without starting the state machine
+// nor Tendermint. The EVM indexer, when enabled, indexes the blocks of the
+// remote node.
+func startJSONRPCOnly(ctx *server.Context, clientCtx client.Context, logFilter *evmoslog.LevelFilter) error {
+	home := ctx.Config.RootDir
+	logger := ctx.Logger
+
+	config, err := config.GetConfig(ctx.Viper)
+	if err != nil {
+		logger.Error("failed to get server config", "error", err.Error())
+		return err
+	}
+
+	if err := config.ValidateBasic(); err != nil {
+		logger.Error("invalid server config", "error", err.Error())
+		return err
+	}
+
+	remoteGRPC := ctx.Viper.GetString(srvflags.RemoteGRPC)
+	remoteRPC := ctx.Viper.GetString(srvflags.RemoteRPC)
+	if remoteGRPC == "" || remoteRPC == "" {
+		return fmt.Errorf("the --%s and --%s flags are required in JSON-RPC only mode", srvflags.RemoteGRPC, srvflags.RemoteRPC)
+	}
+
+	tmEndpoint := "/websocket"
+	rpcClient, err := rpchttp.New(remoteRPC, tmEndpoint)
+	if err != nil {
+		logger.Error("failed to create the remote Tendermint RPC client", "address", remoteRPC, "error", err.Error())
+		return err
+	}
+
+	// start the websocket client of the event subscriptions of the indexer
+	if err := rpcClient.Start(); err != nil {
+		logger.Error("failed to start the remote Tendermint RPC client", "address", remoteRPC, "error", err.Error())
+		return err
+	}
+
+	defer func() {
+		if err := rpcClient.Stop(); err != nil {
+			logger.Error("failed to stop the remote Tendermint RPC client", "error", err.Error())
+		}
+	}()
+
+	status, err := rpcClient.Status(context.Background())
+	if err != nil {
+		logger.Error("failed to query the status of the remote node", "address", remoteRPC, "error", err.Error())
+		return err
+	}
+
+	chainID := status.NodeInfo.Network
+	if err := evmostypes.ValidateChainIDConfig(chainID, clientCtx.ChainID); err != nil {
+		logger.Error("invalid chain-id", "error", err.Error())
+		return err
+	}
+
+	grpcClient, err := newGRPCClient(clientCtx, config.GRPC, remoteGRPC)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := grpcClient.Close(); err != nil {
+			logger.Error("failed to close the remote gRPC client", "error", err.Error())
+		}
+	}()
+
+	clientCtx = clientCtx.
+		WithClient(rpcClient).
+		WithGRPCClient(grpcClient).
+		WithHomeDir(home).
+		WithChainID(chainID)
+
+	// Enable metrics if --metrics is passed, as for the full node
+	if ctx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
+		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
+	}
+
+	var idxer evmostypes.EVMTxIndexer
+	if config.JSONRPC.EnableIndexer {
+		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			logger.Error("failed to open evm indexer DB", "error", err.Error())
+			return err
+		}
+
+		idxLogger := ctx.Logger.With("indexer", "evm")
+		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		indexerService := NewEVMIndexerService(idxer, rpcClient)
+		indexerService.SetLogger(idxLogger)
+
+		errCh := make(chan error)
+		go func() {
+			if err := indexerService.Start(); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-time.After(types.ServerStartTime): // assume server started successfully
+		}
+	}
+
+	httpSrv, httpSrvDone, err := StartJSONRPC(ctx, clientCtx, remoteRPC, tmEndpoint, &config, idxer, logFilter)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancelFn()
+		if err := httpSrv.Shutdown(shutdownCtx); err != nil {
+			logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
+		} else {
+			logger.Info("HTTP server shut down, waiting 5 sec")
+			select {
+			case <-time.Tick(5 * time.Second):
+			case <-httpSrvDone:
+			}
+		}
+	}()
+
+	// Wait for SIGINT or SIGTERM signal
+	return server.WaitForQuitSignals()
+}
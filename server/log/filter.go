@@ -0,0 +1,144 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package log
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/log"
+	"github.com/rs/zerolog"
+	"github.com/spf13/viper"
+
+	tmcfg "github.com/cometbft/cometbft/config"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverlog "github.com/cosmos/cosmos-sdk/server/log"
+)
+
+// defaultLevelKey is the key of the level applied to the modules without a
+// level of their own.
+const defaultLevelKey = "*"
+
+// LevelFilter is a log level filter that can be changed at runtime. It follows
+// the format of the log_level configuration, i.e. a comma-separated list of
+// module:level pairs with an optional *:level pair for all the other modules.
+type LevelFilter struct {
+	mu     sync.RWMutex
+	levels map[string]zerolog.Level
+}
+
+// NewLevelFilter creates a new LevelFilter from the given log level
+// configuration. The modules without a level log at the info level by default.
+func NewLevelFilter(logLevel string) (*LevelFilter, error) {
+	f := &LevelFilter{
+		levels: map[string]zerolog.Level{defaultLevelKey: zerolog.InfoLevel},
+	}
+
+	if logLevel == "" {
+		return f, nil
+	}
+
+	// prefix simple one word levels (e.g. "info") with "*"
+	if !strings.Contains(logLevel, ":") {
+		logLevel = defaultLevelKey + ":" + logLevel
+	}
+
+	seen := make(map[string]bool)
+	for _, item := range strings.Split(logLevel, ",") {
+		moduleAndLevel := strings.Split(item, ":")
+		if len(moduleAndLevel) != 2 {
+			return nil, fmt.Errorf("expected list in a form of \"module:level\" pairs, given pair %s, list %s", item, logLevel)
+		}
+
+		module := moduleAndLevel[0]
+		if seen[module] {
+			return nil, fmt.Errorf("duplicate module %s in log level list %s", module, logLevel)
+		}
+		seen[module] = true
+
+		if err := f.SetLevel(module, moduleAndLevel[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// SetLevel sets the log level of the given module. An empty module or "*" sets
+// the default level of the modules without a level of their own.
+func (f *LevelFilter) SetLevel(module, level string) error {
+	zlLevel, err := zerolog.ParseLevel(level)
+	if err != nil || zlLevel == zerolog.NoLevel {
+		return fmt.Errorf("invalid log level %q", level)
+	}
+
+	if module == "" {
+		module = defaultLevelKey
+	}
+	if strings.ContainsAny(module, ":,") {
+		return errors.New("module name cannot contain ':' or ','")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.levels[module] = zlLevel
+	return nil
+}
+
+// Filter implements the log.FilterFunc signature. It returns true if the log
+// entry of the given module and level is discarded.
+func (f *LevelFilter) Filter(module, level string) bool {
+	zlLevel, err := zerolog.ParseLevel(level)
+	if err != nil {
+		return false
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	minLevel, ok := f.levels[module]
+	if !ok {
+		minLevel = f.levels[defaultLevelKey]
+	}
+
+	return zlLevel < minLevel
+}
+
+// String returns the log level configuration of the filter, sorted by module.
+func (f *LevelFilter) String() string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	pairs := make([]string, 0, len(f.levels))
+	for module, level := range f.levels {
+		pairs = append(pairs, module+":"+level.String())
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+// NewLogger returns the server logger configured with the log flags of the
+// given viper, using the given filter for the log levels instead of a static
+// one so that they can be adjusted while the node is running.
+func NewLogger(v *viper.Viper, filter *LevelFilter) tmlog.Logger {
+	opts := []log.Option{
+		log.ColorOption(!v.GetBool(flags.FlagLogNoColor)),
+		log.TraceOption(v.GetBool(server.FlagTrace)),
+		log.FilterOption(filter.Filter),
+	}
+	if v.GetString(flags.FlagLogFormat) == tmcfg.LogFormatJSON {
+		opts = append(opts, log.OutputJSONOption())
+	}
+
+	logger := log.NewLogger(tmlog.NewSyncWriter(os.Stdout), opts...).With(log.ModuleKey, "server")
+	return serverlog.CometLoggerWrapper{Logger: logger}
+}
@@ -0,0 +1,32 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevelFilter(t *testing.T) {
+	_, err := NewLevelFilter("evm:info,evm:debug")
+	require.Error(t, err, "expected duplicate modules to fail")
+
+	_, err = NewLevelFilter("evm:verbose")
+	require.Error(t, err, "expected invalid levels to fail")
+
+	filter, err := NewLevelFilter("evm:debug,*:error")
+	require.NoError(t, err)
+	require.Equal(t, "*:error,evm:debug", filter.String())
+
+	require.False(t, filter.Filter("evm", "debug"))
+	require.True(t, filter.Filter("consensus", "info"))
+	require.False(t, filter.Filter("consensus", "error"))
+
+	require.NoError(t, filter.SetLevel("consensus", "info"))
+	require.False(t, filter.Filter("consensus", "info"))
+
+	require.NoError(t, filter.SetLevel("*", "debug"))
+	require.False(t, filter.Filter("p2p", "debug"))
+
+	require.Error(t, filter.SetLevel("evm", ""))
+	require.Error(t, filter.SetLevel("evm:x", "info"))
+}
@@ -0,0 +1,85 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	tmcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/evmos/evmos/v19/indexer"
+)
+
+// NewRollbackCmd creates a command to rollback tendermint, multistore and
+// eth tx indexer state by one height.
+func NewRollbackCmd(opts StartOptions) *cobra.Command {
+	var removeBlock bool
+
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "rollback cosmos-sdk, tendermint and evm indexer state by one height",
+		Long: `
+A state rollback is performed to recover from an incorrect application state transition,
+when Tendermint has persisted an incorrect app hash and is thus unable to make
+progress. Rollback overwrites a state at height n with the state at height n - 1.
+The application also rolls back to height n - 1. No blocks are removed, so upon
+restarting Tendermint the transactions in block n will be re-executed against the
+application.
+
+The per-height records of the application (e.g. the base fee history of the fee
+market module) are part of the multistore and are rolled back with it. The eth
+txs indexed for block n by the custom tx indexer of the JSON-RPC are removed, so
+that they are indexed again once block n is re-executed.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+			cfg := ctx.Config
+			home := cfg.RootDir
+			db, err := opts.DBOpener(ctx.Viper, home, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			app := opts.AppCreator(ctx.Logger, db, nil, ctx.Viper)
+			// rollback tendermint state
+			height, hash, err := tmcmd.RollbackState(ctx.Config, removeBlock)
+			if err != nil {
+				return fmt.Errorf("failed to rollback tendermint state: %w", err)
+			}
+			// rollback the multistore
+			if err := app.CommitMultiStore().RollbackToVersion(height); err != nil {
+				return fmt.Errorf("failed to rollback to version: %w", err)
+			}
+
+			fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+
+			// rollback the eth tx indexer, if it was ever enabled
+			if _, err := os.Stat(filepath.Join(home, "data", "evmindexer.db")); os.IsNotExist(err) {
+				return nil
+			}
+
+			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return fmt.Errorf("failed to open evm indexer DB: %w", err)
+			}
+			defer idxDB.Close()
+
+			removed, err := indexer.RewindToBlock(idxDB, height)
+			if err != nil {
+				return fmt.Errorf("failed to rollback evm indexer: %w", err)
+			}
+
+			fmt.Printf("Removed %d eth txs above height %d from the evm indexer\n", removed, height)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, opts.DefaultNodeHome, "The application home directory")
+	cmd.Flags().BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	return cmd
+}
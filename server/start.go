@@ -54,6 +54,7 @@ import (
 	ethdebug "github.com/evmos/evmos/v19/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/evmos/v19/server/config"
 	srvflags "github.com/evmos/evmos/v19/server/flags"
+	evmoslog "github.com/evmos/evmos/v19/server/log"
 	evmostypes "github.com/evmos/evmos/v19/types"
 )
 
@@ -124,6 +125,25 @@ which accepts a path for the resulting pprof file.
 				return err
 			}
 
+			// replace the server logger with one whose log levels can be adjusted at runtime
+			logFilter, err := evmoslog.NewLevelFilter(serverCtx.Viper.GetString(flags.FlagLogLevel))
+			if err != nil {
+				return err
+			}
+			serverCtx.Logger = evmoslog.NewLogger(serverCtx.Viper, logFilter)
+
+			if jsonRPCOnly, _ := cmd.Flags().GetBool(srvflags.JSONRPCOnly); jsonRPCOnly {
+				serverCtx.Logger.Info("starting JSON-RPC only node against a remote node")
+				err = startJSONRPCOnly(serverCtx, clientCtx, logFilter)
+				errCode, ok := err.(server.ErrorCode)
+				if !ok {
+					return err
+				}
+
+				serverCtx.Logger.Debug(fmt.Sprintf("received quit signal: %d", errCode.Code))
+				return nil
+			}
+
 			withTM, _ := cmd.Flags().GetBool(srvflags.WithTendermint)
 			if !withTM {
 				serverCtx.Logger.Info("starting ABCI without Tendermint")
@@ -144,7 +164,7 @@ which accepts a path for the resulting pprof file.
 			serverCtx.Logger.Info("starting ABCI with Tendermint")
 
 			// amino is needed here for backwards compatibility of REST routes
-			err = startInProcess(serverCtx, clientCtx, opts)
+			err = startInProcess(serverCtx, clientCtx, opts, logFilter)
 			errCode, ok := err.(server.ErrorCode)
 			if !ok {
 				return err
@@ -175,6 +195,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(srvflags.AppDBBackend, "", "The type of database for application and snapshots databases")
 
 	cmd.Flags().Bool(srvflags.GRPCOnly, false, "Start the node in gRPC query only mode without Tendermint process")
+	cmd.Flags().Bool(srvflags.JSONRPCOnly, false, "Start the node in JSON-RPC only mode against a remote node, without the state machine nor Tendermint")
+	cmd.Flags().String(srvflags.RemoteGRPC, "", "the gRPC address of the remote node served in JSON-RPC only mode")
+	cmd.Flags().String(srvflags.RemoteRPC, "", "the Tendermint RPC address of the remote node served in JSON-RPC only mode (e.g. tcp://localhost:26657)")
 	cmd.Flags().Bool(srvflags.GRPCEnable, config.DefaultGRPCEnable, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(srvflags.GRPCAddress, serverconfig.DefaultGRPCAddress, "the gRPC server address to listen on")
 	cmd.Flags().Bool(srvflags.GRPCWebEnable, config.DefaultGRPCWebEnable, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled.)")
@@ -200,9 +223,30 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+	cmd.Flags().Bool(srvflags.JSONRPCPersistentFilters, config.DefaultPersistentFilters, "Persist the log filters to disk so that they survive node restarts")                          //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCRejectLatestWhileSyncing, config.DefaultRejectLatestWhileSyncing, "Reject the queries for the latest block while the node is catching up")         //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCCallDefaultBaseFee, config.DefaultCallDefaultBaseFee, "Default the omitted fee fields of eth_call to the base fee instead of zero")                //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCPendingBalanceOverlay, config.DefaultPendingBalanceOverlay, "Include the effects of the mempool txs on the balance queries for the pending block") //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCPinBatchHeight, config.DefaultPinBatchHeight, "Pin the requests of a JSON-RPC batch reading the latest block to the same height")                  //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCAdminEnable, false, "Define if the admin JSON-RPC server should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCAdminAddress, config.DefaultJSONRPCAdminAddress, "the admin JSON-RPC server address to listen on (loopback only)")
+	cmd.Flags().Int(srvflags.JSONRPCTraceConcurrency, config.DefaultTraceConcurrency, "Sets the maximum number of debug traces executed concurrently")
+	cmd.Flags().Int(srvflags.JSONRPCTraceQueueSize, config.DefaultTraceQueueSize, "Sets the maximum number of debug traces waiting for execution")
+	cmd.Flags().Bool(srvflags.JSONRPCFaucetEnable, false, "Define if the evmos_requestFaucet method should be served (testnets only)")
+	cmd.Flags().String(srvflags.JSONRPCFaucetKey, "", "the name of the keyring key signing the faucet claims")
+	cmd.Flags().Bool(srvflags.JSONRPCReceiptFeePayer, config.DefaultReceiptFeePayer, "Include the fee payer of the sponsored txs in their receipts")
+	cmd.Flags().Bool(srvflags.JSONRPCLenientQuantities, config.DefaultLenientQuantities, "Accept the non-canonical hex quantities and the decimal numbers in the JSON-RPC requests")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMMempoolReplaceByFee, false, "allow an eth tx of the local mempool to be replaced by a tx with the same sender and nonce that pays a higher tip")            //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, config.DefaultMempoolPriceBump, "the minimum tip increase, in percent, to replace an eth tx of the local mempool")                      //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMTraceMaxStructLogs, config.DefaultTraceMaxStructLogs, "the maximum number of struct logs of a traced tx before the trace fails (0 means unlimited)")      //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMTraceMaxResultBytes, config.DefaultTraceMaxResultBytes, "the maximum size in bytes of the result of a trace before it fails (0 means unlimited)")         //nolint:lll
+	cmd.Flags().StringSlice(srvflags.EVMMempoolPriorityMsgs, []string{}, "the msg type URLs whose txs are prioritized over the other txs of the local mempool")                              //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriorityQuota, 0, "the maximum number of prioritized txs included in each block proposal (0 means unlimited)")                                     //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolMinPriorityFee, 0, "the minimum effective tip per gas of the dynamic fee txs accepted into the local mempool")                                     //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMFeeStateMaxStreams, config.DefaultFeeStateMaxStreams, "the maximum number of concurrent feemarket SubscribeFeeState gRPC streams")                        //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -278,7 +322,7 @@ func startStandAlone(ctx *server.Context, opts StartOptions) error {
 }
 
 // legacyAminoCdc is used for the legacy REST API
-func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOptions) (err error) {
+func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOptions, logFilter *evmoslog.LevelFilter) (err error) {
 	cfg := ctx.Config
 	home := cfg.RootDir
 	logger := ctx.Logger
@@ -339,6 +383,19 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		return err
 	}
 
+	genDocProvider := node.DefaultGenesisDocProviderFunc(cfg)
+
+	genDoc, err := genDocProvider()
+	if err != nil {
+		logger.Error("failed to load genesis", "error", err.Error())
+		return err
+	}
+
+	if err := evmostypes.ValidateChainIDConfig(genDoc.ChainID, clientCtx.ChainID); err != nil {
+		logger.Error("invalid chain-id", "error", err.Error())
+		return err
+	}
+
 	app := opts.AppCreator(ctx.Logger, db, traceWriter, ctx.Viper)
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
@@ -347,8 +404,6 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		return err
 	}
 
-	genDocProvider := node.DefaultGenesisDocProviderFunc(cfg)
-
 	var (
 		tmNode   *node.Node
 		gRPCOnly = ctx.Viper.GetBool(srvflags.GRPCOnly)
@@ -361,6 +416,14 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	} else {
 		logger.Info("starting node with ABCI Tendermint in-process")
 
+		if config.JSONRPC.EnableIndexer {
+			appHeight := app.CommitMultiStore().LastCommitID().Version
+			if err := checkIndexerHead(home, server.GetAppDBBackend(ctx.Viper), appHeight); err != nil {
+				logger.Error("inconsistent evm indexer DB", "error", err.Error())
+				return err
+			}
+		}
+
 		tmNode, err = node.NewNode(
 			cfg,
 			pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
@@ -438,11 +501,6 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	}
 
 	if config.API.Enable || config.JSONRPC.Enable {
-		genDoc, err := genDocProvider()
-		if err != nil {
-			return err
-		}
-
 		clientCtx = clientCtx.
 			WithHomeDir(home).
 			WithChainID(genDoc.ChainID)
@@ -455,28 +513,10 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 				return errorsmod.Wrapf(err, "invalid grpc address %s", config.GRPC.Address)
 			}
 
-			maxSendMsgSize := config.GRPC.MaxSendMsgSize
-			if maxSendMsgSize == 0 {
-				maxSendMsgSize = serverconfig.DefaultGRPCMaxSendMsgSize
-			}
-
-			maxRecvMsgSize := config.GRPC.MaxRecvMsgSize
-			if maxRecvMsgSize == 0 {
-				maxRecvMsgSize = serverconfig.DefaultGRPCMaxRecvMsgSize
-			}
-
 			grpcAddress := fmt.Sprintf("127.0.0.1:%s", port)
 
 			// If grpc is enabled, configure grpc client for grpc gateway and json-rpc.
-			grpcClient, err := grpc.NewClient(
-				grpcAddress,
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-				grpc.WithDefaultCallOptions(
-					grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
-					grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
-					grpc.MaxCallSendMsgSize(maxSendMsgSize),
-				),
-			)
+			grpcClient, err := newGRPCClient(clientCtx, config.GRPC, grpcAddress)
 			if err != nil {
 				return err
 			}
@@ -543,16 +583,11 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	)
 
 	if config.JSONRPC.Enable {
-		genDoc, err := genDocProvider()
-		if err != nil {
-			return err
-		}
-
 		clientCtx := clientCtx.WithChainID(genDoc.ChainID)
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer)
+		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer, logFilter)
 		if err != nil {
 			return err
 		}
@@ -631,12 +666,62 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	return server.WaitForQuitSignals()
 }
 
+// newGRPCClient creates a gRPC client of the node listening on the given address,
+// with the message size limits of the gRPC config.
+func newGRPCClient(clientCtx client.Context, cfg serverconfig.GRPCConfig, address string) (*grpc.ClientConn, error) {
+	maxSendMsgSize := cfg.MaxSendMsgSize
+	if maxSendMsgSize == 0 {
+		maxSendMsgSize = serverconfig.DefaultGRPCMaxSendMsgSize
+	}
+
+	maxRecvMsgSize := cfg.MaxRecvMsgSize
+	if maxRecvMsgSize == 0 {
+		maxRecvMsgSize = serverconfig.DefaultGRPCMaxRecvMsgSize
+	}
+
+	return grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
+			grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(maxSendMsgSize),
+		),
+	)
+}
+
 // OpenIndexerDB opens the custom eth indexer db, using the same db backend as the main app
 func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// checkIndexerHead returns an error if the eth tx indexer contains txs of blocks
+// above the application height, which happens when the chain state was rolled
+// back without rolling back the indexer. Serving those txs would conflict with
+// the re-execution of the rolled back blocks.
+func checkIndexerHead(rootDir string, backendType dbm.BackendType, appHeight int64) error {
+	idxDB, err := OpenIndexerDB(rootDir, backendType)
+	if err != nil {
+		return err
+	}
+	defer idxDB.Close()
+
+	last, err := indexer.LoadLastBlock(idxDB)
+	if err != nil {
+		return err
+	}
+
+	if last > appHeight {
+		return fmt.Errorf(
+			"evm indexer head %d exceeds the chain head %d: run `rollback` to rewind the indexer, "+
+				"or remove the data/evmindexer.db directory and re-index with `index-eth-tx`",
+			last, appHeight,
+		)
+	}
+	return nil
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
@@ -654,5 +739,12 @@ func startTelemetry(cfg config.Config) (*telemetry.Metrics, error) {
 	if !cfg.Telemetry.Enabled {
 		return nil, nil
 	}
-	return telemetry.New(cfg.Telemetry)
+
+	metrics, err := telemetry.New(cfg.Telemetry)
+	if err != nil {
+		return nil, err
+	}
+
+	evmostypes.SetTelemetryEnabled(true)
+	return metrics, nil
 }
@@ -53,7 +53,7 @@ func AddCommands(
 		tendermintCmd,
 		sdkserver.ExportCmd(appExport, opts.DefaultNodeHome),
 		version.NewVersionCommand(),
-		sdkserver.NewRollbackCmd(opts.AppCreator, opts.DefaultNodeHome),
+		NewRollbackCmd(opts),
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
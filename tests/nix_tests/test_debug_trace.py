@@ -2,6 +2,7 @@ import requests
 from pystarport import ports
 
 from .utils import (
+    ADDRS,
     derive_new_account,
     send_transaction,
     sign_transaction,
@@ -49,3 +50,26 @@ def test_trace_blk(evmos_cluster):
     rsp = requests.post(url, json=params)
     assert rsp.status_code == 200
     assert len(rsp.json()["result"]) == total
+
+
+def test_trace_call(evmos_cluster):
+    sender = ADDRS["validator"]
+    call = {
+        "from": sender,
+        "to": "0x2956c404227Cc544Ea6c3f4a36702D0FD73d20A2",
+        "value": hex(1),
+    }
+
+    url = f"http://127.0.0.1:{ports.evmrpc_port(evmos_cluster.base_port(0))}"
+    params = {
+        "method": "debug_traceCall",
+        "params": [call, "latest", {"tracer": "callTracer"}],
+        "id": 1,
+        "jsonrpc": "2.0",
+    }
+    rsp = requests.post(url, json=params)
+    assert rsp.status_code == 200
+    result = rsp.json()["result"]
+    assert result["type"] == "CALL"
+    assert result["from"] == sender.lower()
+    assert result["value"] == hex(1)
@@ -61,7 +61,8 @@ def test_basic(cluster):
         res = []
         with ThreadPoolExecutor(len(tc["blocks"])) as exec:
             tasks = [
-                exec.submit(call, method, [size, b, percentiles]) for b in tc["blocks"]
+                exec.submit(call, method, [hex(size), b, percentiles])
+                for b in tc["blocks"]
             ]
             res = [future.result()["result"][field] for future in as_completed(tasks)]
         assert len(res) == len(tc["blocks"])
@@ -70,7 +71,7 @@ def test_basic(cluster):
 
     for x in range(max):
         i = x + 1
-        fee_history = call(method, [size, hex(i), percentiles])
+        fee_history = call(method, [hex(size), hex(i), percentiles])
         # start to reduce diff on i <= size - min
         diff = size - min - i
         reduce = size - diff
@@ -0,0 +1,67 @@
+import base64
+import json
+
+import requests
+from pystarport import ports
+
+from .utils import ADDRS, CONTRACTS, deploy_contract
+
+
+def grpc_trace_call(port: int, args: dict, **params):
+    """
+    do a trace_call through grpc gateway directly
+    """
+    params["args"] = base64.b64encode(json.dumps(args).encode()).decode()
+    url = f"http://localhost:{port}/evmos/evm/v1/trace_call"
+    return requests.get(url, params).json()
+
+
+def test_trace_call(evmos_cluster):
+    w3 = evmos_cluster.w3
+    contract, _ = deploy_contract(w3, CONTRACTS["Greeter"])
+    args = {
+        "from": ADDRS["validator"],
+        "to": contract.address,
+        "data": contract.encodeABI(fn_name="setGreeting", args=["world"]),
+    }
+    api_port = ports.api_port(evmos_cluster.base_port(0))
+
+    # default struct logger
+    rsp = grpc_trace_call(api_port, args, gas_cap=25000000)
+    assert "code" not in rsp, str(rsp)
+    assert rsp["content_type"] == "application/json"
+    result = json.loads(base64.b64decode(rsp["data"]))
+    assert not result["failed"]
+    assert any(log["op"] == "SSTORE" for log in result["structLogs"])
+
+    # the traced call is not committed
+    assert contract.caller.greet() == "Hello"
+
+    # javascript tracer and its options from the trace config
+    tracer = (
+        "{data: [], fault: function(log) {}, "
+        "step: function(log) { if (log.op.toString() == 'SSTORE') "
+        "this.data.push(log.op.toString()); }, "
+        "result: function() { return this.data; }}"
+    )
+    rsp = grpc_trace_call(
+        api_port,
+        args,
+        gas_cap=25000000,
+        **{"trace_config.tracer": tracer, "trace_config.timeout": "10s"},
+    )
+    assert "code" not in rsp, str(rsp)
+    assert json.loads(base64.b64decode(rsp["data"])) == ["SSTORE"]
+
+    # the trace config is validated as for the debug namespace
+    rsp = grpc_trace_call(
+        api_port, args, gas_cap=25000000, **{"trace_config.limit": -1}
+    )
+    assert rsp["code"] == 3, str(rsp)
+    assert "output limit cannot be negative" in rsp["message"]
+
+    rsp = grpc_trace_call(
+        api_port, args, gas_cap=25000000, **{"trace_config.timeout": "invalid"}
+    )
+    assert rsp["code"] == 3, str(rsp)
+    assert "timeout value" in rsp["message"]
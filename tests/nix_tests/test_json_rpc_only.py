@@ -0,0 +1,135 @@
+import subprocess
+from pathlib import Path
+
+import pytest
+import requests
+from pystarport import ports
+from web3 import Web3
+
+from .network import setup_custom_evmos
+from .utils import (
+    ADDRS,
+    CONTRACTS,
+    KEYS,
+    deploy_contract,
+    send_transaction,
+    supervisorctl,
+    w3_wait_for_new_blocks,
+    wait_for_block,
+    wait_for_port,
+)
+
+
+@pytest.fixture(scope="module")
+def custom_evmos(tmp_path_factory):
+    # reuse rollback-test config because it has an extra fullnode
+    yield from setup_custom_evmos(
+        tmp_path_factory.mktemp("json-rpc-only"),
+        26500,
+        Path(__file__).parent / "configs/rollback-test.jsonnet",
+    )
+
+
+@pytest.fixture(scope="module")
+def remote_w3(custom_evmos):
+    """
+    restart the fullnode in json-rpc only mode against the validator
+    """
+    for i in range(2):
+        wait_for_block(custom_evmos.cosmos_cli(i), 2)
+    supervisorctl(custom_evmos.base_dir / "../tasks.ini", "stop", "evmos_9000-1-node1")
+
+    validator_port = custom_evmos.base_port(0)
+    with (custom_evmos.base_dir / "node1.log").open("a") as logfile:
+        proc = subprocess.Popen(
+            [
+                custom_evmos.chain_binary,
+                "start",
+                "--json-rpc-only",
+                "--remote-grpc",
+                f"127.0.0.1:{ports.grpc_port(validator_port)}",
+                "--remote-rpc",
+                f"tcp://127.0.0.1:{ports.rpc_port(validator_port)}",
+                "--home",
+                custom_evmos.base_dir / "node1",
+            ],
+            stdout=logfile,
+            stderr=subprocess.STDOUT,
+        )
+        try:
+            port = ports.evmrpc_port(custom_evmos.base_port(1))
+            wait_for_port(port)
+            yield Web3(Web3.HTTPProvider(f"http://127.0.0.1:{port}"))
+        finally:
+            proc.terminate()
+            proc.wait()
+
+
+def rpc_call(w3, method, params):
+    rsp = requests.post(
+        w3.provider.endpoint_uri,
+        json={"jsonrpc": "2.0", "id": 1, "method": method, "params": params},
+    )
+    assert rsp.status_code == 200
+    return rsp.json()
+
+
+def test_chain_queries(custom_evmos, remote_w3):
+    w3 = custom_evmos.w3
+    assert remote_w3.eth.chain_id == w3.eth.chain_id
+
+    w3_wait_for_new_blocks(remote_w3, 1)
+    height = w3.eth.block_number
+    assert abs(remote_w3.eth.block_number - height) <= 1
+
+    assert remote_w3.eth.get_block(height) == w3.eth.get_block(height)
+    assert remote_w3.eth.get_balance(ADDRS["validator"], height) == w3.eth.get_balance(
+        ADDRS["validator"], height
+    )
+    assert remote_w3.eth.gas_price > 0
+
+
+def test_transactions(custom_evmos, remote_w3):
+    w3 = custom_evmos.w3
+
+    # the txs sent to the remote mode node are broadcasted by the remote node
+    receipt = send_transaction(
+        remote_w3, {"to": ADDRS["community"], "value": 1000}, KEYS["validator"]
+    )
+    assert receipt.status == 1
+    assert remote_w3.eth.get_transaction_receipt(
+        receipt.transactionHash
+    ) == w3.eth.get_transaction_receipt(receipt.transactionHash)
+
+    contract, _ = deploy_contract(remote_w3, CONTRACTS["Greeter"])
+    assert contract.caller.greet() == "Hello"
+
+    tx = contract.functions.setGreeting("world").build_transaction()
+    receipt = send_transaction(remote_w3, tx)
+    assert receipt.status == 1
+    assert contract.caller.greet() == "world"
+
+    logs = remote_w3.eth.get_logs(
+        {
+            "fromBlock": receipt.blockNumber,
+            "toBlock": receipt.blockNumber,
+            "address": contract.address,
+        }
+    )
+    assert len(logs) == 1
+    assert logs == w3.eth.get_logs(
+        {
+            "fromBlock": receipt.blockNumber,
+            "toBlock": receipt.blockNumber,
+            "address": contract.address,
+        }
+    )
+
+
+def test_tracing_not_supported(custom_evmos, remote_w3):
+    receipt = send_transaction(
+        custom_evmos.w3, {"to": ADDRS["community"], "value": 1000}
+    )
+
+    rsp = rpc_call(remote_w3, "debug_traceTransaction", [receipt.transactionHash.hex()])
+    assert "not supported in remote mode" in rsp["error"]["message"]
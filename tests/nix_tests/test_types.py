@@ -318,12 +318,16 @@ def test_fee_history(evmos_cluster, geth):
     w3: Web3 = evmos_cluster.w3
     eth_rpc = w3.provider
     geth_rpc = geth.w3.provider
-    make_same_rpc_calls(eth_rpc, geth_rpc, "eth_feeHistory", [4, "latest", [10, 90]])
+    make_same_rpc_calls(
+        eth_rpc, geth_rpc, "eth_feeHistory", ["0x4", "latest", [10, 90]]
+    )
 
-    make_same_rpc_calls(eth_rpc, geth_rpc, "eth_feeHistory", [4, "0x5000", [10, 90]])
+    make_same_rpc_calls(
+        eth_rpc, geth_rpc, "eth_feeHistory", ["0x4", "0x5000", [10, 90]]
+    )
 
     _ = send_and_get_hash(w3)
-    fee_history = eth_rpc.make_request("eth_feeHistory", [4, "latest", [100]])
+    fee_history = eth_rpc.make_request("eth_feeHistory", ["0x4", "latest", [100]])
 
     compare_types(fee_history, EXPECTED_FEE_HISTORY)
 
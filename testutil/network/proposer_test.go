@@ -0,0 +1,88 @@
+//go:build norace
+// +build norace
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v19/testutil/network"
+)
+
+type ProposerTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+	client  *rpc.Client
+}
+
+func (s *ProposerTestSuite) SetupSuite() {
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 3
+
+	var err error
+	s.network, err = network.New(s.T(), s.T().TempDir(), cfg)
+	s.Require().NoError(err)
+
+	_, err = s.network.WaitForHeight(2)
+	s.Require().NoError(err)
+
+	s.client, err = rpc.Dial(fmt.Sprintf("http://%s", s.network.Validators[0].AppConfig.JSONRPC.Address))
+	s.Require().NoError(err)
+}
+
+func (s *ProposerTestSuite) TearDownSuite() {
+	s.client.Close()
+	s.network.Cleanup()
+}
+
+func (s *ProposerTestSuite) TestNetPeers() {
+	var listening bool
+	s.Require().NoError(s.client.Call(&listening, "net_listening"))
+	s.Require().True(listening)
+
+	var peers int
+	s.Require().NoError(s.client.Call(&peers, "net_peerCount"))
+	s.Require().Equal(len(s.network.Validators)-1, peers)
+}
+
+func (s *ProposerTestSuite) TestBlockMiner() {
+	height, err := s.network.WaitForHeightWithTimeout(12, 2*time.Minute)
+	s.Require().NoError(err)
+
+	// the operators of the validators by consensus address
+	operators := make(map[string]common.Address, len(s.network.Validators))
+	for _, val := range s.network.Validators {
+		operators[val.PubKey.Address().String()] = common.BytesToAddress(val.ValAddress)
+	}
+
+	miners := make(map[common.Address]bool)
+	for h := int64(1); h <= height; h++ {
+		block, err := s.network.Validators[0].RPCClient.Block(context.Background(), &h)
+		s.Require().NoError(err)
+
+		expMiner, found := operators[block.Block.ProposerAddress.String()]
+		s.Require().True(found, "unknown proposer at height %d", h)
+
+		var res map[string]interface{}
+		s.Require().NoError(s.client.Call(&res, "eth_getBlockByNumber", hexutil.EncodeBig(big.NewInt(h)), false))
+		s.Require().Equal(expMiner, common.HexToAddress(res["miner"].(string)), "height %d", h)
+		miners[expMiner] = true
+	}
+
+	// the proposer rotates between the validators
+	s.Require().Greater(len(miners), 1)
+}
+
+func TestProposerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposerTestSuite))
+}
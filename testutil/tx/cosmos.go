@@ -54,9 +54,12 @@ func PrepareCosmosTx(
 	txBuilder.SetGasLimit(args.Gas)
 
 	var fees sdk.Coins
-	if args.GasPrice != nil {
+	switch {
+	case args.GasPrice != nil:
 		fees = sdk.Coins{{Denom: utils.BaseDenom, Amount: args.GasPrice.MulRaw(int64(args.Gas))}}
-	} else {
+	case args.Fees != nil:
+		fees = args.Fees
+	default:
 		fees = sdk.Coins{DefaultFee}
 	}
 
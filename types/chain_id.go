@@ -23,6 +23,8 @@ var (
 		regexEIP155,
 		regexEpochSeparator,
 		regexEpoch))
+	// numericChainID matches the chain identifiers made of the EIP155 chain-id only
+	numericChainID = regexp.MustCompile(fmt.Sprintf(`^(%s)$`, regexEIP155))
 )
 
 // IsValidChainID returns false if the given chain identifier is incorrectly formatted.
@@ -31,17 +33,34 @@ func IsValidChainID(chainID string) bool {
 		return false
 	}
 
-	return evmosChainID.MatchString(chainID)
+	return evmosChainID.MatchString(chainID) || numericChainID.MatchString(chainID)
 }
 
-// ParseChainID parses a string chain identifier's epoch to an Ethereum-compatible
-// chain-id in *big.Int format. The function returns an error if the chain-id has an invalid format
+// IsNumericChainID returns true if the given chain identifier is made of the
+// EIP155 chain-id only (eg. "5151"), instead of the {identifier}_{EIP155}-{epoch}
+// format.
+func IsNumericChainID(chainID string) bool {
+	return numericChainID.MatchString(strings.TrimSpace(chainID))
+}
+
+// ParseChainID parses a string chain identifier to an Ethereum-compatible
+// chain-id in *big.Int format. The chain identifier can either follow the
+// {identifier}_{EIP155}-{epoch} format, or be the EIP155 chain-id itself. The
+// function returns an error if the chain-id has an invalid format
 func ParseChainID(chainID string) (*big.Int, error) {
 	chainID = strings.TrimSpace(chainID)
 	if len(chainID) > 48 {
 		return nil, errorsmod.Wrapf(ErrInvalidChainID, "chain-id '%s' cannot exceed 48 chars", chainID)
 	}
 
+	if numericChainID.MatchString(chainID) {
+		chainIDInt, ok := new(big.Int).SetString(chainID, 10)
+		if !ok {
+			return nil, errorsmod.Wrapf(ErrInvalidChainID, "chain-id %s must be base-10 integer format", chainID)
+		}
+		return chainIDInt, nil
+	}
+
 	matches := evmosChainID.FindStringSubmatch(chainID)
 	if matches == nil || len(matches) != 4 || matches[1] == "" {
 		return nil, errorsmod.Wrapf(ErrInvalidChainID, "%s: %v", chainID, matches)
@@ -55,3 +74,46 @@ func ParseChainID(chainID string) (*big.Int, error) {
 
 	return chainIDInt, nil
 }
+
+// ValidateChainIDConfig checks that the chain-id configured for the node
+// matches the one of the genesis file. It returns a descriptive error if any of
+// them is invalid, or if they follow different formats. An empty configured
+// chain-id is not checked.
+func ValidateChainIDConfig(genesisChainID, configChainID string) error {
+	if _, err := ParseChainID(genesisChainID); err != nil {
+		return errorsmod.Wrapf(err, "invalid genesis chain-id")
+	}
+
+	if configChainID == "" {
+		return nil
+	}
+
+	if _, err := ParseChainID(configChainID); err != nil {
+		return errorsmod.Wrapf(err, "invalid configured chain-id")
+	}
+
+	if IsNumericChainID(genesisChainID) != IsNumericChainID(configChainID) {
+		return errorsmod.Wrapf(
+			ErrInvalidChainID,
+			"the genesis chain-id '%s' is in the %s format while the configured chain-id '%s' is in the %s format",
+			genesisChainID, chainIDFormat(genesisChainID), configChainID, chainIDFormat(configChainID),
+		)
+	}
+
+	if genesisChainID != configChainID {
+		return errorsmod.Wrapf(
+			ErrInvalidChainID,
+			"the configured chain-id '%s' doesn't match the genesis chain-id '%s'", configChainID, genesisChainID,
+		)
+	}
+
+	return nil
+}
+
+// chainIDFormat returns a description of the format of the given chain-id.
+func chainIDFormat(chainID string) string {
+	if IsNumericChainID(chainID) {
+		return "numeric"
+	}
+	return "{identifier}_{EIP155}-{epoch}"
+}
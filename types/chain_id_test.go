@@ -21,6 +21,18 @@ func TestParseChainID(t *testing.T) {
 		{
 			"valid chain-id, multiple digits", "aragonchain_256-1", false, big.NewInt(256),
 		},
+		{
+			"valid chain-id, numeric", "5151", false, big.NewInt(5151),
+		},
+		{
+			"invalid chain-id, numeric starting with 0", "05151", true, nil,
+		},
+		{
+			"invalid chain-id, numeric zero", "0", true, nil,
+		},
+		{
+			"invalid chain-id, numeric with epoch", "5151-1", true, nil,
+		},
 		{
 			"invalid chain-id, double dash", "aragonchain-1-1", true, nil,
 		},
@@ -85,3 +97,32 @@ func TestParseChainID(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateChainIDConfig(t *testing.T) {
+	testCases := []struct {
+		name           string
+		genesisChainID string
+		configChainID  string
+		errContains    string
+	}{
+		{"same chain-id", "evmos_9000-1", "evmos_9000-1", ""},
+		{"same numeric chain-id", "5151", "5151", ""},
+		{"no configured chain-id", "5151", "", ""},
+		{"invalid genesis chain-id", "evmos", "", "invalid genesis chain-id"},
+		{"invalid configured chain-id", "5151", "evmos", "invalid configured chain-id"},
+		{"numeric genesis, evmos config", "5151", "evmos_5151-1", "is in the numeric format"},
+		{"evmos genesis, numeric config", "evmos_5151-1", "5151", "is in the numeric format"},
+		{"different chain-id", "evmos_9000-1", "evmos_9000-2", "doesn't match"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateChainIDConfig(tc.genesisChainID, tc.configChainID)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
@@ -42,6 +42,9 @@ type TxResult struct {
 	// cumulative_gas_used specifies the cumulated amount of gas used for all
 	// processed messages within the current batch transaction.
 	CumulativeGasUsed uint64 `protobuf:"varint,7,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// fee_payer is the hex address of the account paying the fees of the eth
+	// transaction. It is empty if the fees are paid by the sender.
+	FeePayer string `protobuf:"bytes,8,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
 }
 
 func (m *TxResult) Reset()         { *m = TxResult{} }
@@ -84,26 +87,27 @@ func init() {
 func init() { proto.RegisterFile("ethermint/types/v1/indexer.proto", fileDescriptor_1197e10a8be8ed28) }
 
 var fileDescriptor_1197e10a8be8ed28 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x18, 0x86, 0x73, 0xb6, 0x4d, 0xe3, 0xa1, 0x83, 0x51, 0x4a, 0x54, 0x88, 0x87, 0x53, 0xa6, 0x84,
-	0xe2, 0x22, 0x1d, 0x5d, 0xc4, 0xf5, 0xa8, 0x8b, 0x4b, 0x48, 0x9b, 0xcf, 0xbb, 0x83, 0x5e, 0xaf,
-	0xf4, 0xbe, 0x84, 0xf8, 0x0f, 0x1c, 0xfd, 0x09, 0xfe, 0x1c, 0xc7, 0x8e, 0x8e, 0xd2, 0xe2, 0xff,
-	0x90, 0x5e, 0x42, 0x5d, 0x8e, 0x7b, 0x79, 0x9e, 0x8f, 0x17, 0x5e, 0xca, 0x00, 0x25, 0xac, 0xb5,
-	0x5a, 0x62, 0x86, 0x6f, 0x2b, 0xb0, 0x59, 0x3d, 0xce, 0xd4, 0xb2, 0x84, 0x06, 0xd6, 0xe9, 0x6a,
-	0x6d, 0xd0, 0x84, 0xe1, 0xc1, 0x48, 0x9d, 0x91, 0xd6, 0xe3, 0xab, 0x0b, 0x61, 0x84, 0x71, 0x38,
-	0xdb, 0xff, 0x5a, 0xf3, 0xf6, 0x97, 0xd0, 0x60, 0xda, 0x70, 0xb0, 0xd5, 0x02, 0xc3, 0x11, 0xf5,
-	0x25, 0x28, 0x21, 0x31, 0x22, 0x8c, 0x24, 0x3d, 0xde, 0xa5, 0xf0, 0x92, 0x06, 0xd8, 0xe4, 0xae,
-	0x22, 0x3a, 0x62, 0x24, 0x39, 0xe5, 0x43, 0x6c, 0x9e, 0xf6, 0x31, 0xbc, 0xa6, 0xc7, 0xda, 0x8a,
-	0x8e, 0xf5, 0x1c, 0x0b, 0xb4, 0x15, 0x2d, 0x64, 0xf4, 0x04, 0x50, 0xe6, 0x87, 0xdb, 0x3e, 0x23,
-	0xc9, 0x80, 0x53, 0x40, 0x39, 0xed, 0xce, 0x47, 0xd4, 0x7f, 0x2d, 0xd4, 0x02, 0xca, 0x68, 0xc0,
-	0x48, 0x12, 0xf0, 0x2e, 0xed, 0x1b, 0x45, 0x61, 0xf3, 0xca, 0x42, 0x19, 0xf9, 0x8c, 0x24, 0x7d,
-	0x3e, 0x14, 0x85, 0x7d, 0xb6, 0x50, 0x86, 0x29, 0x3d, 0x9f, 0x57, 0xba, 0x5a, 0x14, 0xa8, 0x6a,
-	0xc8, 0x0f, 0xd6, 0xd0, 0x59, 0x67, 0xff, 0xe8, 0xb1, 0xf5, 0x27, 0xfd, 0xf7, 0xcf, 0x1b, 0xef,
-	0x61, 0xf2, 0xb5, 0x8d, 0xc9, 0x66, 0x1b, 0x93, 0x9f, 0x6d, 0x4c, 0x3e, 0x76, 0xb1, 0xb7, 0xd9,
-	0xc5, 0xde, 0xf7, 0x2e, 0xf6, 0x5e, 0x98, 0x50, 0x28, 0xab, 0x59, 0x3a, 0x37, 0x3a, 0x83, 0x5a,
-	0x1b, 0xdb, 0xbd, 0xf5, 0xf8, 0xbe, 0x9d, 0x77, 0xe6, 0xbb, 0xa9, 0xee, 0xfe, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x8e, 0x96, 0x1b, 0x8f, 0x78, 0x01, 0x00, 0x00,
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0x3f, 0x4b, 0xc3, 0x40,
+	0x14, 0xc0, 0x73, 0xfd, 0x93, 0xa6, 0x87, 0x0e, 0x46, 0x29, 0xd1, 0x42, 0x3c, 0x9c, 0x32, 0x25,
+	0x14, 0x27, 0x3b, 0xba, 0x88, 0x9b, 0x1c, 0x75, 0x71, 0x09, 0x69, 0xf3, 0x7a, 0x09, 0x34, 0xbd,
+	0x92, 0x7b, 0x09, 0xe9, 0xea, 0xe4, 0xe8, 0x47, 0xf0, 0xe3, 0x38, 0x76, 0x74, 0x94, 0xf6, 0x8b,
+	0x48, 0xae, 0x47, 0x5d, 0x8e, 0xfb, 0xf1, 0xfb, 0x3d, 0x1e, 0x3c, 0xca, 0x00, 0x33, 0x28, 0x8b,
+	0x7c, 0x8d, 0x11, 0x6e, 0x37, 0xa0, 0xa2, 0x7a, 0x12, 0xe5, 0xeb, 0x14, 0x1a, 0x28, 0xc3, 0x4d,
+	0x29, 0x51, 0xba, 0xee, 0xa9, 0x08, 0x75, 0x11, 0xd6, 0x93, 0x9b, 0x2b, 0x21, 0x85, 0xd4, 0x3a,
+	0x6a, 0x7f, 0xc7, 0xf2, 0xee, 0xbd, 0x43, 0x9d, 0x59, 0xc3, 0x41, 0x55, 0x2b, 0x74, 0x47, 0xd4,
+	0xce, 0x20, 0x17, 0x19, 0x7a, 0x84, 0x91, 0xa0, 0xcb, 0x0d, 0xb9, 0xd7, 0xd4, 0xc1, 0x26, 0xd6,
+	0x2b, 0xbc, 0x0e, 0x23, 0xc1, 0x39, 0x1f, 0x60, 0xf3, 0xdc, 0xa2, 0x3b, 0xa6, 0xc3, 0x42, 0x09,
+	0xe3, 0xba, 0xda, 0x39, 0x85, 0x12, 0x47, 0xc9, 0xe8, 0x19, 0x60, 0x16, 0x9f, 0x66, 0x7b, 0x8c,
+	0x04, 0x7d, 0x4e, 0x01, 0xb3, 0x99, 0x19, 0x1f, 0x51, 0x7b, 0x99, 0xe4, 0x2b, 0x48, 0xbd, 0x3e,
+	0x23, 0x81, 0xc3, 0x0d, 0xb5, 0x1b, 0x45, 0xa2, 0xe2, 0x4a, 0x41, 0xea, 0xd9, 0x8c, 0x04, 0x3d,
+	0x3e, 0x10, 0x89, 0x7a, 0x55, 0x90, 0xba, 0x21, 0xbd, 0x5c, 0x54, 0x45, 0xb5, 0x4a, 0x30, 0xaf,
+	0x21, 0x3e, 0x55, 0x03, 0x5d, 0x5d, 0xfc, 0xab, 0x27, 0xd3, 0x8f, 0xe9, 0x70, 0x09, 0x10, 0x6f,
+	0x92, 0x2d, 0x94, 0x9e, 0xc3, 0x48, 0x30, 0xe4, 0xce, 0x12, 0xe0, 0xa5, 0xe5, 0x69, 0xef, 0xe3,
+	0xeb, 0xd6, 0x7a, 0x9c, 0x7e, 0xef, 0x7d, 0xb2, 0xdb, 0xfb, 0xe4, 0x77, 0xef, 0x93, 0xcf, 0x83,
+	0x6f, 0xed, 0x0e, 0xbe, 0xf5, 0x73, 0xf0, 0xad, 0x37, 0x26, 0x72, 0xcc, 0xaa, 0x79, 0xb8, 0x90,
+	0x45, 0x04, 0x75, 0x21, 0x95, 0x79, 0xeb, 0xc9, 0xc3, 0xf1, 0xf6, 0x73, 0x5b, 0xdf, 0xf1, 0xfe,
+	0x6f, 0x00, 0xc8, 0x6b, 0x01, 0x6a, 0x95, 0x01, 0x00, 0x00,
 }
 
 func (m *TxResult) Marshal() (dAtA []byte, err error) {
@@ -126,6 +130,13 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.CumulativeGasUsed != 0 {
 		i = encodeVarintIndexer(dAtA, i, uint64(m.CumulativeGasUsed))
 		i--
@@ -207,6 +218,10 @@ func (m *TxResult) Size() (n int) {
 	if m.CumulativeGasUsed != 0 {
 		n += 1 + sovIndexer(uint64(m.CumulativeGasUsed))
 	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
 	return n
 }
 
@@ -379,6 +394,38 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndexer(dAtA[iNdEx:])
@@ -0,0 +1,20 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import "sync/atomic"
+
+// telemetryEnabled tracks whether the application telemetry was started by the
+// node. It is used by the modules to skip the collection of metrics that are
+// not free to compute when no sink is registered.
+var telemetryEnabled atomic.Bool
+
+// SetTelemetryEnabled sets whether the application telemetry is enabled.
+func SetTelemetryEnabled(enabled bool) {
+	telemetryEnabled.Store(enabled)
+}
+
+// IsTelemetryEnabled returns true if the application telemetry is enabled.
+func IsTelemetryEnabled() bool {
+	return telemetryEnabled.Load()
+}
@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
 		GetParamsCmd(),
+		GetWrappedNativeTokenCmd(),
 	)
 	return cmd
 }
@@ -36,7 +37,7 @@ func GetTokenPairsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "token-pairs",
 		Short: "Gets registered token pairs",
-		Long:  "Gets registered token pairs",
+		Long:  "Gets registered token pairs, along with the name, symbol and decimals returned by their ERC20 contracts",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -73,7 +74,7 @@ func GetTokenPairCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "token-pair TOKEN",
 		Short: "Get a registered token pair",
-		Long:  "Get a registered token pair",
+		Long:  "Get a registered token pair, along with the name, symbol and decimals returned by its ERC20 contract",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -129,3 +130,33 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetWrappedNativeTokenCmd queries the token pair of the wrapped native coin
+func GetWrappedNativeTokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wrapped-native-token",
+		Short: "Gets the token pair of the wrapped native coin",
+		Long:  "Gets the token pair of the wrapped native coin, whose ERC20 address is the wrapped native token contract",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryWrappedNativeTokenRequest{}
+
+			res, err := queryClient.WrappedNativeToken(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
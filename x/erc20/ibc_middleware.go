@@ -38,6 +38,10 @@ func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
 // It receives the tokens through the default ICS20 OnRecvPacket callback logic
 // and then automatically converts the Cosmos Coin to their ERC20 token
 // representation.
+// If the memo requests an EVM call, the tokens are credited to the account
+// derived from the packet sender, which then executes the call. A failed call
+// results in an error acknowledgement so that the sender chain refunds the
+// tokens.
 // If the acknowledgement fails, this callback will default to the ibc-core
 // packet callback.
 func (im IBCMiddleware) OnRecvPacket(
@@ -45,6 +49,11 @@ func (im IBCMiddleware) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) exported.Acknowledgement {
+	hook, packet, err := im.keeper.GetIBCHook(ctx, packet)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
 	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
 
 	// return if the acknowledgement is an error ACK
@@ -52,12 +61,23 @@ func (im IBCMiddleware) OnRecvPacket(
 		return ack
 	}
 
-	return im.keeper.OnRecvPacket(ctx, packet, ack)
+	ack = im.keeper.OnRecvPacket(ctx, packet, ack)
+	if !ack.Success() || hook == nil {
+		return ack
+	}
+
+	if err := im.keeper.ExecuteIBCHook(ctx, hook); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface.
 // It refunds the token transferred and then automatically converts the
 // Cosmos Coin to their ERC20 token representation.
+// Finally, it notifies the contract that sent the packet, if any, of the
+// packet result.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -78,12 +98,19 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return err
 	}
 
-	return im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack); err != nil {
+		return err
+	}
+
+	im.keeper.ExecuteIBCCallback(ctx, packet, ack.Success(), acknowledgement)
+	return nil
 }
 
 // OnTimeoutPacket implements the IBCModule interface.
 // It refunds the token transferred and then automatically converts the
 // Cosmos Coin to their ERC20 token representation.
+// Finally, it notifies the contract that sent the packet, if any, of the
+// timeout.
 func (im IBCMiddleware) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -98,5 +125,10 @@ func (im IBCMiddleware) OnTimeoutPacket(
 		return err
 	}
 
-	return im.keeper.OnTimeoutPacket(ctx, packet, data)
+	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
+		return err
+	}
+
+	im.keeper.ExecuteIBCCallback(ctx, packet, false, nil)
+	return nil
 }
@@ -100,7 +100,8 @@ func (suite *KeeperTestSuite) TestBalanceOf() {
 			authtypes.NewModuleAddress(govtypes.ModuleName),
 			suite.app.AccountKeeper, suite.app.BankKeeper,
 			mockEVMKeeper, suite.app.StakingKeeper,
-			s.app.AuthzKeeper, &s.app.TransferKeeper,
+			s.app.AuthzKeeper, &s.app.TransferKeeper, s.app.IBCKeeper.ChannelKeeper,
+			s.app.DistrKeeper,
 		)
 
 		tc.malleate()
@@ -198,7 +199,8 @@ func (suite *KeeperTestSuite) TestQueryERC20ForceFail() {
 			suite.app.GetKey("erc20"), suite.app.AppCodec(),
 			authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper,
 			suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper,
-			s.app.AuthzKeeper, &s.app.TransferKeeper,
+			s.app.AuthzKeeper, &s.app.TransferKeeper, s.app.IBCKeeper.ChannelKeeper,
+			s.app.DistrKeeper,
 		)
 
 		tc.malleate()
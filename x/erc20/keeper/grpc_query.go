@@ -19,7 +19,9 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
-// TokenPairs returns all registered pairs
+// TokenPairs returns all registered pairs, along with the metadata of their
+// ERC20 contracts. The metadata is read from the store cache, and retrieved
+// from the contracts for at most ERC20MetadataQueryLimit pairs otherwise.
 func (k Keeper) TokenPairs(c context.Context, req *types.QueryTokenPairsRequest) (*types.QueryTokenPairsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -41,13 +43,37 @@ func (k Keeper) TokenPairs(c context.Context, req *types.QueryTokenPairsRequest)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	var (
+		metadata []types.ERC20Metadata
+		fetched  int
+	)
+	for _, pair := range pairs {
+		contract := pair.GetERC20Contract()
+		if pairMetadata, found := k.GetERC20Metadata(ctx, contract); found {
+			metadata = append(metadata, pairMetadata)
+			continue
+		}
+
+		if fetched >= types.ERC20MetadataQueryLimit {
+			continue
+		}
+		fetched++
+
+		if pairMetadata, found := k.FetchERC20Metadata(ctx, contract); found {
+			metadata = append(metadata, pairMetadata)
+		}
+	}
+
 	return &types.QueryTokenPairsResponse{
 		TokenPairs: pairs,
 		Pagination: pageRes,
+		Metadata:   metadata,
 	}, nil
 }
 
-// TokenPair returns a given registered token pair
+// TokenPair returns a given registered token pair, along with the metadata of
+// its ERC20 contract
 func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (*types.QueryTokenPairResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -77,7 +103,17 @@ func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (
 		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
 	}
 
-	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
+	res := &types.QueryTokenPairResponse{TokenPair: pair}
+
+	metadata, found := k.GetERC20Metadata(ctx, pair.GetERC20Contract())
+	if !found {
+		metadata, found = k.FetchERC20Metadata(ctx, pair.GetERC20Contract())
+	}
+	if found {
+		res.Metadata = &metadata
+	}
+
+	return res, nil
 }
 
 // Params returns the params of the erc20 module
@@ -86,3 +122,15 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// WrappedNativeToken returns the token pair of the wrapped native coin
+func (k Keeper) WrappedNativeToken(c context.Context, _ *types.QueryWrappedNativeTokenRequest) (*types.QueryWrappedNativeTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetWrappedNativeTokenPair(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "wrapped native token is not registered")
+	}
+
+	return &types.QueryWrappedNativeTokenResponse{TokenPair: pair}, nil
+}
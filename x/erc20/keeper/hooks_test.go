@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/evmos/evmos/v19/x/epochs/types"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestSweepDustEpochHook() {
+	testCases := []struct {
+		name            string
+		epochIdentifier string
+		threshold       math.Int
+		hookIdentifier  string
+		expSwept        int64
+	}{
+		{"sweep disabled", "", math.ZeroInt(), epochstypes.DayEpochID, 0},
+		{"other epoch", epochstypes.WeekEpochID, math.ZeroInt(), epochstypes.DayEpochID, 0},
+		{"surplus below the threshold", epochstypes.DayEpochID, math.NewInt(31), epochstypes.DayEpochID, 0},
+		{"surplus equal to the threshold", epochstypes.DayEpochID, math.NewInt(30), epochstypes.DayEpochID, 30},
+		{"no threshold", epochstypes.DayEpochID, math.ZeroInt(), epochstypes.DayEpochID, 30},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			// register the pair and convert part of the ERC20 tokens into coins
+			contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			_, err := suite.app.Erc20Keeper.ConvertERC20(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgConvertERC20(math.NewInt(50), sender, contract, suite.address),
+			)
+			suite.Require().NoError(err)
+
+			// tokens sent directly to the module account don't back any coin
+			suite.TransferERC20TokenToModule(contract, suite.address, big.NewInt(30))
+			suite.Commit()
+
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			params.DustSweepEpochIdentifier = tc.epochIdentifier
+			params.DustSweepThreshold = tc.threshold
+			suite.Require().NoError(suite.app.Erc20Keeper.SetParams(suite.ctx, params))
+
+			denom := types.CreateDenom(contract.String())
+			communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom)
+			suite.Require().True(communityPool.IsZero())
+
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			suite.app.Erc20Keeper.Hooks().AfterEpochEnd(ctx, tc.hookIdentifier, 1)
+
+			communityPool = suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom)
+			suite.Require().Equal(math.LegacyNewDec(tc.expSwept), communityPool)
+
+			// the module account ERC20 balance is left untouched and backs the swept coins
+			suite.Require().Equal(big.NewInt(80), suite.BalanceOf(contract, types.ModuleAddress))
+			suite.Require().Equal(math.NewInt(50+tc.expSwept), suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount)
+
+			var sweepEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeSweepDust {
+					sweepEvents = append(sweepEvents, event)
+				}
+			}
+
+			if tc.expSwept == 0 {
+				suite.Require().Empty(sweepEvents)
+				return
+			}
+
+			suite.Require().Len(sweepEvents, 1)
+			suite.Require().Equal([]abci.EventAttribute{
+				{Key: types.AttributeKeyCosmosCoin, Value: denom},
+				{Key: types.AttributeKeyERC20Token, Value: contract.String()},
+				{Key: types.AttributeKeySupply, Value: "50"},
+				{Key: types.AttributeKeyModuleBalance, Value: "80"},
+				{Key: sdk.AttributeKeyAmount, Value: "30"},
+			}, sweepEvents[0].Attributes)
+
+			// a second sweep finds no surplus left
+			ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			suite.app.Erc20Keeper.Hooks().AfterEpochEnd(ctx, tc.hookIdentifier, 2)
+			suite.Require().Equal(math.LegacyNewDec(tc.expSwept), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom))
+		})
+	}
+	suite.mintFeeCollector = false
+}
@@ -0,0 +1,177 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// RegisterIBCCallback registers the given contract to be notified of the result
+// of the packet it sent. The callback gas limit is the IBCHookGasCap param at
+// the time of the registration, and no callback is registered if it is zero.
+//
+// Registering a callback also prunes the orphan callbacks of the channel.
+func (k Keeper) RegisterIBCCallback(
+	ctx sdk.Context,
+	portID, channelID string,
+	sequence uint64,
+	contract common.Address,
+) {
+	gasLimit := k.GetIBCHookGasCap(ctx)
+	if gasLimit == 0 {
+		return
+	}
+
+	k.pruneIBCCallbacks(ctx, portID, channelID)
+	k.SetIBCCallback(ctx, portID, channelID, sequence, types.IBCCallback{
+		Contract: contract,
+		GasLimit: gasLimit,
+	})
+}
+
+// GetIBCCallback returns the callback of the given packet.
+func (k Keeper) GetIBCCallback(ctx sdk.Context, portID, channelID string, sequence uint64) (types.IBCCallback, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.IBCCallbackKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.IBCCallback{}, false
+	}
+
+	callback, err := types.IBCCallbackFromBytes(bz)
+	if err != nil {
+		return types.IBCCallback{}, false
+	}
+
+	return callback, true
+}
+
+// SetIBCCallback stores the callback of the given packet.
+func (k Keeper) SetIBCCallback(ctx sdk.Context, portID, channelID string, sequence uint64, callback types.IBCCallback) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.IBCCallbackKey(portID, channelID, sequence), callback.Bytes())
+}
+
+// DeleteIBCCallback removes the callback of the given packet.
+func (k Keeper) DeleteIBCCallback(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.IBCCallbackKey(portID, channelID, sequence))
+}
+
+// pruneIBCCallbacks removes the oldest callbacks of a channel whose packets
+// are no longer pending, i.e. they were acknowledged or timed out without
+// the callback being executed. At most IBCCallbackPruneLimit callbacks are
+// checked.
+func (k Keeper) pruneIBCCallbacks(ctx sdk.Context, portID, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IBCCallbackChannelKey(portID, channelID))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var orphans [][]byte
+	for checked := 0; iterator.Valid() && checked < types.IBCCallbackPruneLimit; iterator.Next() {
+		checked++
+
+		sequence := sdk.BigEndianToUint64(iterator.Key())
+		if len(k.channelKeeper.GetPacketCommitment(ctx, portID, channelID, sequence)) == 0 {
+			orphans = append(orphans, iterator.Key())
+		}
+	}
+
+	for _, key := range orphans {
+		store.Delete(key)
+	}
+}
+
+// ExecuteIBCCallback notifies the contract that sent the given packet of its
+// result by calling onPacketResult(sequence, success, ack) on it from the
+// module address.
+//
+// Callback failures are logged and emitted in the callback event, but never
+// returned, so that the acknowledgement or timeout of the packet succeeds.
+func (k Keeper) ExecuteIBCCallback(ctx sdk.Context, packet channeltypes.Packet, success bool, ack []byte) {
+	callback, found := k.GetIBCCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+
+	k.DeleteIBCCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyHookContract, callback.Contract.Hex()),
+		sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyPacketSuccess, strconv.FormatBool(success)),
+	}
+
+	if err := k.callIBCCallback(ctx, callback, packet.Sequence, success, ack); err != nil {
+		k.Logger(ctx).Error(
+			"failed to execute IBC callback",
+			"contract", callback.Contract.Hex(),
+			"sequence", packet.Sequence,
+			"error", err.Error(),
+		)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyCallbackError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeIBCCallback, attrs...))
+}
+
+// callIBCCallback executes the callback on a cached context that is only written
+// if the call succeeds. The call is only bounded by the callback gas limit so
+// that it can't exhaust the gas of the relayer transaction.
+func (k Keeper) callIBCCallback(
+	ctx sdk.Context,
+	callback types.IBCCallback,
+	sequence uint64,
+	success bool,
+	ack []byte,
+) error {
+	data, err := types.IBCCallbackABI.Pack(types.IBCCallbackMethod, sequence, success, ack)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrABIPack, err.Error())
+	}
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+	if err != nil {
+		return err
+	}
+
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&callback.Contract,
+		nonce,
+		big.NewInt(0),     // amount
+		callback.GasLimit, // gasLimit
+		big.NewInt(0),     // gasFeeCap
+		big.NewInt(0),     // gasTipCap
+		big.NewInt(0),     // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	res, err := k.evmKeeper.ApplyMessage(cacheCtx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return err
+	}
+
+	if res.Failed() {
+		return errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	writeFn()
+	return nil
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/precompiles/ics20"
+	"github.com/evmos/evmos/v19/testutil"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/erc20/keeper/testdata"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe("Callbacks to contracts on the result of the IBC transfers they sent", Ordered, func() {
+	var (
+		amount   int64 = 10
+		sender   sdk.AccAddress
+		recorder common.Address
+	)
+
+	// transferFromRecorder calls the ICS-20 precompile through the recorder and
+	// returns the packet sent by the call.
+	transferFromRecorder := func(receiver string, timeout clienttypes.Height) channeltypes.Packet {
+		ics20ABI, err := ics20.LoadABI()
+		s.Require().NoError(err)
+
+		evmosEndpoint := s.pathOsmosisEvmos.EndpointB
+		data, err := ics20ABI.Pack(
+			ics20.TransferMethod,
+			transfertypes.PortID,
+			evmosEndpoint.ChannelID,
+			utils.BaseDenom,
+			big.NewInt(amount),
+			common.BytesToAddress(sender.Bytes()),
+			receiver,
+			timeout,
+			uint64(0),
+			"",
+		)
+		s.Require().NoError(err)
+
+		evmosApp := s.EvmosChain.App.(*app.Evmos)
+		ctx := s.EvmosChain.GetContext()
+		from := common.BytesToAddress(sender.Bytes())
+
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:   evmosApp.EvmKeeper.ChainID(),
+			Nonce:     evmosApp.EvmKeeper.GetNonce(ctx, from),
+			To:        &recorder,
+			GasLimit:  1_000_000,
+			GasFeeCap: evmosApp.FeeMarketKeeper.GetBaseFee(ctx),
+			GasTipCap: big.NewInt(1),
+			Input:     data,
+			Accesses:  &ethtypes.AccessList{},
+		})
+		msg.From = from.String()
+
+		res, err := testutil.DeliverEthTx(evmosApp, s.EvmosChain.SenderPrivKey, msg)
+		s.Require().NoError(err)
+		_, err = testutil.CheckEthTxResponse(res, evmosApp.AppCodec())
+		s.Require().NoError(err)
+
+		s.EvmosChain.SenderAccount.SetSequence(s.EvmosChain.SenderAccount.GetSequence() + 1) //nolint:errcheck
+		s.EvmosChain.Coordinator.CommitBlock(s.EvmosChain)
+
+		transfer := transfertypes.NewFungibleTokenPacketData(utils.BaseDenom, sdkmath.NewInt(amount).String(), sender.String(), receiver, "")
+		return channeltypes.NewPacket(
+			transfer.GetBytes(),
+			1,
+			evmosEndpoint.ChannelConfig.PortID,
+			evmosEndpoint.ChannelID,
+			evmosEndpoint.Counterparty.ChannelConfig.PortID,
+			evmosEndpoint.Counterparty.ChannelID,
+			timeout,
+			0,
+		)
+	}
+
+	// recorded returns the sequence, the result and the caller recorded by the
+	// callback of the recorder.
+	recorded := func() (uint64, common.Hash, common.Address) {
+		ctx := s.EvmosChain.GetContext()
+		sequence := s.app.EvmKeeper.GetState(ctx, recorder, common.BigToHash(big.NewInt(0)))
+		result := s.app.EvmKeeper.GetState(ctx, recorder, common.BigToHash(big.NewInt(1)))
+		caller := s.app.EvmKeeper.GetState(ctx, recorder, common.BigToHash(big.NewInt(2)))
+		return sequence.Big().Uint64(), result, common.BytesToAddress(caller.Bytes())
+	}
+
+	BeforeEach(func() {
+		s.suiteIBCTesting = true
+		s.SetupTest()
+		s.suiteIBCTesting = false
+
+		sender = s.EvmosChain.SenderAccount.GetAddress()
+
+		contract, err := testdata.LoadIBCCallbackRecorderContract()
+		s.Require().NoError(err)
+		recorder, err = testutil.DeployContract(
+			s.EvmosChain.GetContext(),
+			s.EvmosChain.App.(*app.Evmos),
+			s.EvmosChain.SenderPrivKey,
+			s.queryClientEvm,
+			contract,
+		)
+		s.Require().NoError(err)
+		s.EvmosChain.SenderAccount.SetSequence(s.EvmosChain.SenderAccount.GetSequence() + 1) //nolint:errcheck
+
+		// the recorder sends the transfers on behalf of the sender
+		authorization := &transfertypes.TransferAuthorization{
+			Allocations: []transfertypes.Allocation{
+				{
+					SourcePort:    transfertypes.PortID,
+					SourceChannel: s.pathOsmosisEvmos.EndpointB.ChannelID,
+					SpendLimit:    sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(amount))),
+				},
+			},
+		}
+		ctx := s.EvmosChain.GetContext()
+		expiration := ctx.BlockTime().AddDate(1, 0, 0)
+		err = s.app.AuthzKeeper.SaveGrant(ctx, recorder.Bytes(), sender, authorization, &expiration)
+		s.Require().NoError(err)
+
+		s.EvmosChain.Coordinator.CommitBlock(s.EvmosChain)
+	})
+
+	It("should notify the contract of a successful acknowledgement", func() {
+		osmosisAddress := s.IBCOsmosisChain.SenderAccount.GetAddress().String()
+		packet := transferFromRecorder(osmosisAddress, timeoutHeight)
+
+		_, found := s.app.Erc20Keeper.GetIBCCallback(s.EvmosChain.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		s.Require().True(found)
+
+		err := s.pathOsmosisEvmos.RelayPacket(packet)
+		s.Require().NoError(err)
+
+		sequence, result, caller := recorded()
+		s.Require().Equal(packet.Sequence, sequence)
+		s.Require().Equal(common.BigToHash(big.NewInt(2)), result)
+		s.Require().Equal(types.ModuleAddress, caller)
+
+		_, found = s.app.Erc20Keeper.GetIBCCallback(s.EvmosChain.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		s.Require().False(found)
+	})
+
+	It("should notify the contract of an error acknowledgement", func() {
+		packet := transferFromRecorder("invalid", timeoutHeight)
+
+		err := s.pathOsmosisEvmos.RelayPacket(packet)
+		s.Require().NoError(err)
+
+		sequence, result, _ := recorded()
+		s.Require().Equal(packet.Sequence, sequence)
+		s.Require().Equal(common.BigToHash(big.NewInt(1)), result)
+
+		_, found := s.app.Erc20Keeper.GetIBCCallback(s.EvmosChain.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		s.Require().False(found)
+	})
+
+	It("should notify the contract of a timeout", func() {
+		osmosisAddress := s.IBCOsmosisChain.SenderAccount.GetAddress().String()
+		revision := clienttypes.ParseChainID(s.IBCOsmosisChain.ChainID)
+		timeout := clienttypes.NewHeight(revision, uint64(s.IBCOsmosisChain.GetContext().BlockHeight())+1)
+
+		packet := transferFromRecorder(osmosisAddress, timeout)
+
+		s.coordinator.CommitNBlocks(s.IBCOsmosisChain, 3)
+		err := s.pathOsmosisEvmos.EndpointB.UpdateClient()
+		s.Require().NoError(err)
+		err = s.pathOsmosisEvmos.EndpointB.TimeoutPacket(packet)
+		s.Require().NoError(err)
+
+		sequence, result, _ := recorded()
+		s.Require().Equal(packet.Sequence, sequence)
+		s.Require().Equal(common.BigToHash(big.NewInt(1)), result)
+
+		_, found := s.app.Erc20Keeper.GetIBCCallback(s.EvmosChain.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		s.Require().False(found)
+	})
+})
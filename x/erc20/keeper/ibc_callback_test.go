@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterIBCCallback() {
+	const channelID = "channel-0"
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		postTest func()
+	}{
+		{
+			"no callback - IBC hooks disabled",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.IbcHookGasCap = 0
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			false,
+			func() {},
+		},
+		{
+			"ok - callback registered with the IBC hook gas cap",
+			func() {},
+			true,
+			func() {
+				callback, found := suite.app.Erc20Keeper.GetIBCCallback(suite.ctx, transfertypes.PortID, channelID, 10)
+				suite.Require().True(found)
+				suite.Require().Equal(contract, callback.Contract)
+				suite.Require().Equal(types.DefaultIBCHookGasCap, callback.GasLimit)
+			},
+		},
+		{
+			"ok - orphan callbacks are pruned",
+			func() {
+				callback := types.IBCCallback{Contract: contract, GasLimit: 1}
+				for sequence := uint64(1); sequence <= 3; sequence++ {
+					suite.app.Erc20Keeper.SetIBCCallback(suite.ctx, transfertypes.PortID, channelID, sequence, callback)
+				}
+				// packet 3 is still pending
+				suite.app.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.ctx, transfertypes.PortID, channelID, 3, []byte("commitment"))
+				// callbacks on other channels are kept
+				suite.app.Erc20Keeper.SetIBCCallback(suite.ctx, transfertypes.PortID, "channel-1", 1, callback)
+			},
+			true,
+			func() {
+				_, found := suite.app.Erc20Keeper.GetIBCCallback(suite.ctx, transfertypes.PortID, channelID, 1)
+				suite.Require().False(found)
+				_, found = suite.app.Erc20Keeper.GetIBCCallback(suite.ctx, transfertypes.PortID, channelID, 2)
+				suite.Require().False(found)
+				_, found = suite.app.Erc20Keeper.GetIBCCallback(suite.ctx, transfertypes.PortID, channelID, 3)
+				suite.Require().True(found)
+				_, found = suite.app.Erc20Keeper.GetIBCCallback(suite.ctx, transfertypes.PortID, "channel-1", 1)
+				suite.Require().True(found)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			tc.malleate()
+
+			suite.app.Erc20Keeper.RegisterIBCCallback(suite.ctx, transfertypes.PortID, channelID, 10, contract)
+
+			_, found := suite.app.Erc20Keeper.GetIBCCallback(suite.ctx, transfertypes.PortID, channelID, 10)
+			suite.Require().Equal(tc.expPass, found)
+			tc.postTest()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestExecuteIBCCallback() {
+	packet := channeltypes.Packet{
+		Sequence:      1,
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: "channel-0",
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expEvent  bool
+		expErrMsg string
+	}{
+		{
+			"no-op - no callback registered",
+			func() {},
+			false,
+			"",
+		},
+		{
+			"ok - call to an account without code",
+			func() {
+				callback := types.IBCCallback{Contract: utiltx.GenerateAddress(), GasLimit: types.DefaultIBCHookGasCap}
+				suite.app.Erc20Keeper.SetIBCCallback(suite.ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, callback)
+			},
+			true,
+			"",
+		},
+		{
+			"failure swallowed - gas limit below the intrinsic gas",
+			func() {
+				callback := types.IBCCallback{Contract: utiltx.GenerateAddress(), GasLimit: 1}
+				suite.app.Erc20Keeper.SetIBCCallback(suite.ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, callback)
+			},
+			true,
+			"intrinsic gas too low",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+
+			tc.malleate()
+
+			suite.app.Erc20Keeper.ExecuteIBCCallback(suite.ctx, packet, true, channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement())
+
+			_, found := suite.app.Erc20Keeper.GetIBCCallback(suite.ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+
+			var event *sdk.Event
+			events := suite.ctx.EventManager().Events()
+			for i := range events {
+				if events[i].Type == types.EventTypeIBCCallback {
+					event = &events[i]
+				}
+			}
+			if !tc.expEvent {
+				suite.Require().Nil(event)
+				return
+			}
+
+			suite.Require().NotNil(event)
+			errAttr, hasErr := event.GetAttribute(types.AttributeKeyCallbackError)
+			if tc.expErrMsg == "" {
+				suite.Require().False(hasErr)
+			} else {
+				suite.Require().True(hasErr)
+				suite.Require().Contains(errAttr.Value, tc.expErrMsg)
+			}
+		})
+	}
+}
@@ -253,6 +253,8 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				suite.app.StakingKeeper,
 				suite.app.AuthzKeeper,
 				&suite.app.TransferKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
+				suite.app.DistrKeeper,
 			)
 
 			// Fund receiver account with EVMOS, ERC20 coins and IBC vouchers
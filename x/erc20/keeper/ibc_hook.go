@@ -0,0 +1,126 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// GetIBCHook returns the EVM call requested by the memo of an incoming ICS-20
+// packet, if any.
+//
+// The packet receiver must be the called contract. When the memo contains a
+// call, the returned packet credits the funds to the account derived from the
+// packet sender instead, which then executes the call.
+func (k Keeper) GetIBCHook(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+) (*types.IBCHook, channeltypes.Packet, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// NOTE: the ICS-20 transfer logic returns the error acknowledgement
+		return nil, packet, nil
+	}
+
+	hook, err := types.ParseIBCHookMemo(data.Memo)
+	if err != nil || hook == nil {
+		return nil, packet, err
+	}
+
+	if k.GetIBCHookGasCap(ctx) == 0 {
+		return nil, packet, types.ErrIBCHookDisabled
+	}
+
+	recipient, err := utils.GetEvmosAddressFromBech32(data.Receiver)
+	if err != nil {
+		return nil, packet, errorsmod.Wrap(err, "invalid recipient")
+	}
+
+	if !bytes.Equal(recipient.Bytes(), hook.Contract.Bytes()) {
+		return nil, packet, errorsmod.Wrapf(
+			types.ErrInvalidIBCHook,
+			"receiver %s must be the hook contract %s", data.Receiver, hook.Contract,
+		)
+	}
+
+	hook.Sender = types.DeriveIBCHookSender(packet.DestinationChannel, data.Sender)
+	data.Receiver = hook.Sender.String()
+	packet.Data = data.GetBytes()
+
+	return hook, packet, nil
+}
+
+// ExecuteIBCHook executes the EVM call of an IBC hook from its derived sender,
+// capped by the IBCHookGasCap param. The gas used is charged to the relayer.
+//
+// The call is allowed to send IBC packets (e.g. through the ICS-20 precompile).
+// These are counted and reported in the hook event.
+func (k Keeper) ExecuteIBCHook(ctx sdk.Context, hook *types.IBCHook) error {
+	from := common.BytesToAddress(hook.Sender)
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, hook.Sender)
+	if err != nil {
+		return err
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		&hook.Contract,
+		nonce,
+		big.NewInt(0),           // amount
+		k.GetIBCHookGasCap(ctx), // gasLimit
+		big.NewInt(0),           // gasFeeCap
+		big.NewInt(0),           // gasTipCap
+		big.NewInt(0),           // gasPrice
+		hook.Data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	// use a separate event manager to track the packets sent during the call
+	hookCtx := ctx.WithEventManager(sdk.NewEventManager())
+
+	res, err := k.evmKeeper.ApplyMessage(hookCtx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrIBCHookCallFailed, err.Error())
+	}
+
+	if res.Failed() {
+		return errorsmod.Wrap(types.ErrIBCHookCallFailed, res.VmError)
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "erc20 ibc hook")
+
+	ibcSends := 0
+	for _, event := range hookCtx.EventManager().Events() {
+		if event.Type == channeltypes.EventTypeSendPacket {
+			ibcSends++
+		}
+	}
+
+	ctx.EventManager().EmitEvents(hookCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIBCHook,
+			sdk.NewAttribute(types.AttributeKeyHookSender, from.Hex()),
+			sdk.NewAttribute(types.AttributeKeyHookContract, hook.Contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyHookGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+			sdk.NewAttribute(types.AttributeKeyHookIBCSends, strconv.Itoa(ibcSends)),
+		),
+	)
+
+	return nil
+}
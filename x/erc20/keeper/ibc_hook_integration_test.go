@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/precompiles/ics20"
+	"github.com/evmos/evmos/v19/testutil"
+	teststypes "github.com/evmos/evmos/v19/types/tests"
+	"github.com/evmos/evmos/v19/x/erc20/keeper/testdata"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe("EVM calls triggered by the memo of incoming IBC transfers", Ordered, func() {
+	var (
+		amount         int64 = 10
+		osmosisAddress string
+		hookSender     sdk.AccAddress
+		recorder       common.Address
+	)
+
+	hookMemo := func(contract common.Address, data []byte) string {
+		return fmt.Sprintf(`{"evm":{"contract":"%s","data":"%s"}}`, contract.Hex(), hexutil.Encode(data))
+	}
+
+	BeforeEach(func() {
+		s.suiteIBCTesting = true
+		s.SetupTest()
+		s.suiteIBCTesting = false
+
+		osmosisAddress = s.IBCOsmosisChain.SenderAccount.GetAddress().String()
+		hookSender = types.DeriveIBCHookSender(s.pathOsmosisEvmos.EndpointB.ChannelID, osmosisAddress)
+
+		contract, err := testdata.LoadIBCHookRecorderContract()
+		s.Require().NoError(err)
+		recorder, err = testutil.DeployContract(
+			s.EvmosChain.GetContext(),
+			s.EvmosChain.App.(*app.Evmos),
+			s.EvmosChain.SenderPrivKey,
+			s.queryClientEvm,
+			contract,
+		)
+		s.Require().NoError(err)
+
+		s.EvmosChain.SenderAccount.SetSequence(s.EvmosChain.SenderAccount.GetSequence() + 1) //nolint:errcheck
+		s.EvmosChain.Coordinator.CommitBlock()
+	})
+
+	It("should credit the derived sender and execute the call", func() {
+		word := common.BigToHash(big.NewInt(42))
+		receiver := sdk.AccAddress(recorder.Bytes()).String()
+
+		s.SendAndReceiveMessageWithMemo(s.pathOsmosisEvmos, s.IBCOsmosisChain, "uosmo", amount, osmosisAddress, receiver, 1, hookMemo(recorder, word.Bytes()))
+
+		ctx := s.EvmosChain.GetContext()
+		balance := s.app.BankKeeper.GetBalance(ctx, hookSender, teststypes.UosmoIbcdenom)
+		s.Require().Equal(amount, balance.Amount.Int64())
+
+		caller := s.app.EvmKeeper.GetState(ctx, recorder, common.BigToHash(big.NewInt(0)))
+		s.Require().Equal(common.BytesToAddress(hookSender.Bytes()), common.BytesToAddress(caller.Bytes()))
+		s.Require().Equal(word, s.app.EvmKeeper.GetState(ctx, recorder, common.BigToHash(big.NewInt(1))))
+	})
+
+	It("should refund the sender when the call reverts", func() {
+		receiver := sdk.AccAddress(recorder.Bytes()).String()
+
+		// the recorder reverts on calls without calldata
+		s.SendAndReceiveMessageWithMemo(s.pathOsmosisEvmos, s.IBCOsmosisChain, "uosmo", amount, osmosisAddress, receiver, 1, hookMemo(recorder, nil))
+
+		ctx := s.EvmosChain.GetContext()
+		balance := s.app.BankKeeper.GetBalance(ctx, hookSender, teststypes.UosmoIbcdenom)
+		s.Require().True(balance.IsZero())
+		s.Require().Equal(common.Hash{}, s.app.EvmKeeper.GetState(ctx, recorder, common.BigToHash(big.NewInt(0))))
+
+		// the error acknowledgement released the escrowed tokens on osmosis
+		escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, s.pathOsmosisEvmos.EndpointA.ChannelID)
+		escrowBalance := s.IBCOsmosisChain.GetSimApp().BankKeeper.GetBalance(s.IBCOsmosisChain.GetContext(), escrow, "uosmo")
+		s.Require().True(escrowBalance.IsZero())
+	})
+
+	It("should refund the sender when the receiver is not the hook contract", func() {
+		evmosAddress := s.EvmosChain.SenderAccount.GetAddress()
+		word := common.BigToHash(big.NewInt(42))
+
+		s.SendAndReceiveMessageWithMemo(s.pathOsmosisEvmos, s.IBCOsmosisChain, "uosmo", amount, osmosisAddress, evmosAddress.String(), 1, hookMemo(recorder, word.Bytes()))
+
+		ctx := s.EvmosChain.GetContext()
+		s.Require().True(s.app.BankKeeper.GetBalance(ctx, evmosAddress, teststypes.UosmoIbcdenom).IsZero())
+		s.Require().True(s.app.BankKeeper.GetBalance(ctx, hookSender, teststypes.UosmoIbcdenom).IsZero())
+		s.Require().Equal(common.Hash{}, s.app.EvmKeeper.GetState(ctx, recorder, common.BigToHash(big.NewInt(1))))
+	})
+
+	It("should allow the call to send the received tokens through IBC", func() {
+		ics20ABI, err := ics20.LoadABI()
+		s.Require().NoError(err)
+
+		evmosChannel := s.pathOsmosisEvmos.EndpointB.ChannelID
+		data, err := ics20ABI.Pack(
+			ics20.TransferMethod,
+			transfertypes.PortID,
+			evmosChannel,
+			teststypes.UosmoIbcdenom,
+			big.NewInt(amount),
+			common.BytesToAddress(hookSender.Bytes()),
+			osmosisAddress,
+			timeoutHeight,
+			uint64(0),
+			"",
+		)
+		s.Require().NoError(err)
+
+		ics20Precompile := common.HexToAddress(evmtypes.ICS20PrecompileAddress)
+		receiver := sdk.AccAddress(ics20Precompile.Bytes()).String()
+
+		s.SendAndReceiveMessageWithMemo(s.pathOsmosisEvmos, s.IBCOsmosisChain, "uosmo", amount, osmosisAddress, receiver, 1, hookMemo(ics20Precompile, data))
+
+		ctx := s.EvmosChain.GetContext()
+		s.Require().True(s.app.BankKeeper.GetBalance(ctx, hookSender, teststypes.UosmoIbcdenom).IsZero())
+
+		// the call sent the tokens back to osmosis in a new packet
+		commitment := s.app.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, transfertypes.PortID, evmosChannel, 1)
+		s.Require().NotEmpty(commitment)
+	})
+})
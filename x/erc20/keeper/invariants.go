@@ -0,0 +1,41 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/x/erc20/types"
+)
+
+// RegisterInvariants registers the erc20 module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "wrapped-native-backing", WrappedNativeBackingInvariant(k))
+}
+
+// WrappedNativeBackingInvariant checks that the wrapped native token account
+// holds no native coins. The wrapped balances are the bank balances of the
+// native coin, so the wrapped total supply matches the bank supply as long as
+// every deposit sent to the precompile is credited back to its depositor.
+func WrappedNativeBackingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pair, found := k.GetWrappedNativeTokenPair(ctx)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, "wrapped-native-backing", "wrapped native token is not registered"), false
+		}
+
+		escrowed := k.bankKeeper.GetBalance(ctx, pair.GetERC20Contract().Bytes(), pair.Denom)
+		broken := !escrowed.IsZero()
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "wrapped-native-backing",
+			fmt.Sprintf(
+				"\twrapped native token %s escrowed balance: %s\n\ttotal supply: %s\n",
+				pair.Erc20Address, escrowed, k.bankKeeper.GetSupply(ctx, pair.Denom),
+			),
+		), broken
+	}
+}
@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v19/testutil"
+	"github.com/evmos/evmos/v19/x/erc20/keeper"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestWrappedNativeBackingInvariant() {
+	suite.SetupTest()
+
+	pair, found := suite.app.Erc20Keeper.GetWrappedNativeTokenPair(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(types.WEVMOSContractMainnet, pair.Erc20Address)
+
+	invariant := keeper.WrappedNativeBackingInvariant(suite.app.Erc20Keeper)
+	_, broken := invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// coins held by the wrapped token account are not credited to any holder
+	coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 100))
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, pair.GetERC20Contract().Bytes(), coins)
+	suite.Require().NoError(err)
+
+	msg, broken := invariant(suite.ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "escrowed balance: 100")
+}
@@ -30,6 +30,8 @@ type Keeper struct {
 	stakingKeeper  types.StakingKeeper
 	authzKeeper    authzkeeper.Keeper
 	transferKeeper *transferkeeper.Keeper
+	channelKeeper  types.ChannelKeeper
+	distrKeeper    types.DistributionKeeper
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -43,6 +45,8 @@ func NewKeeper(
 	sk types.StakingKeeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper *transferkeeper.Keeper,
+	channelKeeper types.ChannelKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	// ensure gov module account is set and is not nil
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -59,6 +63,8 @@ func NewKeeper(
 		stakingKeeper:  sk,
 		authzKeeper:    authzKeeper,
 		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		distrKeeper:    distrKeeper,
 	}
 }
 
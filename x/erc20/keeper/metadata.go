@@ -0,0 +1,141 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v19/contracts"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// GetERC20Metadata returns the cached metadata of the given ERC20 contract.
+func (k Keeper) GetERC20Metadata(ctx sdk.Context, contract common.Address) (types.ERC20Metadata, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Metadata)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.ERC20Metadata{}, false
+	}
+
+	var metadata types.ERC20Metadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, true
+}
+
+// SetERC20Metadata caches the metadata of an ERC20 contract.
+func (k Keeper) SetERC20Metadata(ctx sdk.Context, metadata types.ERC20Metadata) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Metadata)
+	store.Set(common.HexToAddress(metadata.Erc20Address).Bytes(), k.cdc.MustMarshal(&metadata))
+}
+
+// DeleteERC20Metadata removes the cached metadata of the given ERC20 contract.
+func (k Keeper) DeleteERC20Metadata(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Metadata)
+	store.Delete(contract.Bytes())
+}
+
+// RefreshERC20Metadata retrieves the metadata of the given ERC20 contract and
+// caches it, so that the queries don't need to call the contract.
+func (k Keeper) RefreshERC20Metadata(ctx sdk.Context, contract common.Address) {
+	metadata, found := k.FetchERC20Metadata(ctx, contract)
+	if !found {
+		k.DeleteERC20Metadata(ctx, contract)
+		return
+	}
+
+	k.SetERC20Metadata(ctx, metadata)
+}
+
+// FetchERC20Metadata retrieves the metadata of the given ERC20 contract with
+// static calls bounded by ERC20MetadataCallGasLimit. It returns false if none
+// of the metadata could be retrieved, e.g. if the address is not a contract.
+func (k Keeper) FetchERC20Metadata(ctx sdk.Context, contract common.Address) (types.ERC20Metadata, bool) {
+	metadata := types.ERC20Metadata{
+		Erc20Address: contract.Hex(),
+		Height:       ctx.BlockHeight(),
+	}
+
+	name := k.staticCallERC20(ctx, contract, "name")
+	metadata.Name, metadata.RawName, metadata.NameEncoding = types.DecodeERC20MetadataString(name)
+
+	symbol := k.staticCallERC20(ctx, contract, "symbol")
+	metadata.Symbol, metadata.RawSymbol, metadata.SymbolEncoding = types.DecodeERC20MetadataString(symbol)
+
+	decimals, hasDecimals := types.DecodeERC20MetadataDecimals(k.staticCallERC20(ctx, contract, "decimals"))
+	metadata.Decimals = decimals
+
+	found := metadata.NameEncoding != types.METADATA_ENCODING_UNSPECIFIED ||
+		metadata.SymbolEncoding != types.METADATA_ENCODING_UNSPECIFIED ||
+		hasDecimals
+	return metadata, found
+}
+
+// staticCallERC20 calls the given method of an ERC20 contract without
+// committing the state changes, and returns its return data. It returns nil if
+// the call failed.
+func (k Keeper) staticCallERC20(ctx sdk.Context, contract common.Address, method string) []byte {
+	data, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(method)
+	if err != nil {
+		return nil
+	}
+
+	res, err := k.callEVMWithGasLimit(ctx, contract, data, types.ERC20MetadataCallGasLimit)
+	if err != nil {
+		k.Logger(ctx).Debug(
+			"failed to retrieve ERC20 metadata",
+			"contract", contract.Hex(),
+			"method", method,
+			"error", err.Error(),
+		)
+		return nil
+	}
+
+	return res.Ret
+}
+
+// callEVMWithGasLimit calls the given contract from the module address with
+// the given gas limit, without committing the state changes.
+func (k Keeper) callEVMWithGasLimit(
+	ctx sdk.Context,
+	contract common.Address,
+	data []byte,
+	gasLimit uint64,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&contract,
+		nonce,
+		big.NewInt(0), // amount
+		gasLimit,      // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		true,                  // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		return nil, errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/erc20/keeper/testdata"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+)
+
+// DeployContractBytes32Metadata deploys a contract returning its name and
+// symbol as bytes32.
+func (suite *KeeperTestSuite) DeployContractBytes32Metadata() common.Address {
+	suite.Commit()
+
+	contract, err := testdata.LoadERC20Bytes32MetadataContract()
+	suite.Require().NoError(err)
+
+	addr, err := testutil.DeployContract(suite.ctx, suite.app, suite.priv, suite.queryClientEvm, contract)
+	suite.Require().NoError(err)
+	suite.Commit()
+	return addr
+}
+
+func (suite *KeeperTestSuite) TestFetchERC20Metadata() {
+	suite.SetupTest()
+
+	erc20Contract, err := suite.DeployContract("coin", "token", erc20Decimals)
+	suite.Require().NoError(err)
+	bytes32Contract := suite.DeployContractBytes32Metadata()
+
+	testCases := []struct {
+		name        string
+		contract    common.Address
+		expFound    bool
+		expMetadata types.ERC20Metadata
+	}{
+		{
+			"ERC20 contract",
+			erc20Contract,
+			true,
+			types.ERC20Metadata{
+				Name:           "coin",
+				Symbol:         "token",
+				Decimals:       uint32(erc20Decimals),
+				NameEncoding:   types.METADATA_ENCODING_STRING,
+				SymbolEncoding: types.METADATA_ENCODING_STRING,
+			},
+		},
+		{
+			"bytes32 metadata contract",
+			bytes32Contract,
+			true,
+			types.ERC20Metadata{
+				Name:           "Maker",
+				Symbol:         "MKR",
+				Decimals:       18,
+				RawName:        common.RightPadBytes([]byte("Maker"), 32),
+				NameEncoding:   types.METADATA_ENCODING_BYTES32,
+				RawSymbol:      common.RightPadBytes([]byte("MKR"), 32),
+				SymbolEncoding: types.METADATA_ENCODING_BYTES32,
+			},
+		},
+		{
+			"not a contract",
+			utiltx.GenerateAddress(),
+			false,
+			types.ERC20Metadata{},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			metadata, found := suite.app.Erc20Keeper.FetchERC20Metadata(suite.ctx, tc.contract)
+			suite.Require().Equal(tc.expFound, found)
+			if !tc.expFound {
+				return
+			}
+
+			tc.expMetadata.Erc20Address = tc.contract.Hex()
+			tc.expMetadata.Height = suite.ctx.BlockHeight()
+			suite.Require().Equal(tc.expMetadata, metadata)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTokenPairsMetadata() {
+	suite.SetupTest()
+
+	contract := suite.DeployContractBytes32Metadata()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// more pairs than the metadata retrieved from the EVM by a query
+	var pairs []types.TokenPair
+	for i := 0; i < types.ERC20MetadataQueryLimit+2; i++ {
+		pair := types.NewTokenPair(contract, fmt.Sprintf("coin%d", i), types.OWNER_EXTERNAL)
+		suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+		pairs = append(pairs, pair)
+	}
+
+	countMetadata := func(metadata []types.ERC20Metadata) (count int) {
+		for _, m := range metadata {
+			if m.Erc20Address == contract.Hex() {
+				suite.Require().Equal("MKR", m.Symbol)
+				suite.Require().Equal(types.METADATA_ENCODING_BYTES32, m.SymbolEncoding)
+				count++
+			}
+		}
+		return count
+	}
+
+	res, err := suite.app.Erc20Keeper.TokenPairs(ctx, &types.QueryTokenPairsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().LessOrEqual(len(res.Metadata), types.ERC20MetadataQueryLimit)
+	suite.Require().GreaterOrEqual(countMetadata(res.Metadata), types.ERC20MetadataQueryLimit-len(types.DefaultTokenPairs))
+
+	// the cached metadata is returned for all the pairs
+	suite.app.Erc20Keeper.RefreshERC20Metadata(suite.ctx, contract)
+	_, found := suite.app.Erc20Keeper.GetERC20Metadata(suite.ctx, contract)
+	suite.Require().True(found)
+
+	res, err = suite.app.Erc20Keeper.TokenPairs(ctx, &types.QueryTokenPairsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(len(pairs), countMetadata(res.Metadata))
+
+	// the metadata is removed along with the pair
+	suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, pairs[0])
+	_, found = suite.app.Erc20Keeper.GetERC20Metadata(suite.ctx, contract)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20RefreshesMetadata() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+	suite.Commit()
+
+	_, found := suite.app.Erc20Keeper.GetERC20Metadata(suite.ctx, contract)
+	suite.Require().False(found)
+
+	// the cached metadata is used by the query once refreshed by a conversion
+	sender := sdk.AccAddress(suite.address.Bytes())
+	msg := types.NewMsgConvertERC20(math.NewInt(10), sender, contract, suite.address)
+	_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	metadata, found := suite.app.Erc20Keeper.GetERC20Metadata(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Equal(erc20Name, metadata.Name)
+	suite.Require().Equal(erc20Symbol, metadata.Symbol)
+	suite.Require().Equal(uint32(erc20Decimals), metadata.Decimals)
+
+	metadata.Name = "cached"
+	suite.app.Erc20Keeper.SetERC20Metadata(suite.ctx, metadata)
+
+	res, err := suite.app.Erc20Keeper.TokenPair(sdk.WrapSDKContext(suite.ctx), &types.QueryTokenPairRequest{Token: contract.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.Metadata)
+	suite.Require().Equal("cached", res.Metadata.Name)
+}
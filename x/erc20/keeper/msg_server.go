@@ -19,6 +19,7 @@ import (
 
 	"github.com/evmos/evmos/v19/contracts"
 	"github.com/evmos/evmos/v19/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
 var _ types.MsgServer = &Keeper{}
@@ -64,7 +65,7 @@ func (k Keeper) ConvertERC20(
 
 // convertERC20IntoCoinsForNativeToken handles the erc20 conversion for a native erc20 token
 // pair:
-//   - escrow tokens on module account
+//   - escrow tokens on module account, using the EIP-2612 permit if provided
 //   - mint coins on bank module
 //   - send minted coins to the receiver
 //   - check if coin balance increased by amount
@@ -86,26 +87,19 @@ func (k Keeper) convertERC20IntoCoinsForNativeToken(
 	}
 
 	// Escrow tokens on module account
-	transferData, err := erc20.Pack("transfer", types.ModuleAddress, msg.Amount.BigInt())
-	if err != nil {
-		return nil, err
+	var (
+		res *evmtypes.MsgEthereumTxResponse
+		err error
+	)
+	if msg.Permit != nil {
+		err = k.escrowERC20WithPermit(ctx, contract, sender, msg.Amount.BigInt(), msg.Permit)
+	} else {
+		res, err = k.escrowERC20(ctx, contract, sender, msg.Amount.BigInt())
 	}
-
-	res, err := k.evmKeeper.CallEVMWithData(ctx, sender, &contract, transferData, true)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check evm call response
-	var unpackedRet types.ERC20BoolResponse
-	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil {
-		return nil, err
-	}
-
-	if !unpackedRet.Value {
-		return nil, errorsmod.Wrap(errortypes.ErrLogic, "failed to execute transfer")
-	}
-
 	// Check expected escrow balance after transfer execution
 	// NOTE: coin fields already validated in the ValidateBasic() of the message
 	coins := sdk.Coins{sdk.Coin{Denom: pair.Denom, Amount: msg.Amount}}
@@ -147,11 +141,17 @@ func (k Keeper) convertERC20IntoCoinsForNativeToken(
 		)
 	}
 
-	// Check for unexpected `Approval` event in logs
-	if err := k.monitorApprovalEvent(res); err != nil {
-		return nil, err
+	// Check for unexpected `Approval` event in logs. A permit escrow spends an
+	// allowance explicitly granted by the sender, so it is not monitored.
+	if res != nil {
+		if err := k.monitorApprovalEvent(res); err != nil {
+			return nil, err
+		}
 	}
 
+	// Refresh the metadata cache of the ERC20 contract
+	k.RefreshERC20Metadata(ctx, contract)
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "convert", "erc20", "total"},
@@ -188,6 +188,83 @@ func (k Keeper) convertERC20IntoCoinsForNativeToken(
 	return &types.MsgConvertERC20Response{}, nil
 }
 
+// escrowERC20 transfers the ERC20 tokens from the sender to the module account
+func (k Keeper) escrowERC20(
+	ctx sdk.Context,
+	contract, sender common.Address,
+	amount *big.Int,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	transferData, err := erc20.Pack("transfer", types.ModuleAddress, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := k.evmKeeper.CallEVMWithData(ctx, sender, &contract, transferData, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check evm call response
+	var unpackedRet types.ERC20BoolResponse
+	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil {
+		return nil, err
+	}
+
+	if !unpackedRet.Value {
+		return nil, errorsmod.Wrap(errortypes.ErrLogic, "failed to execute transfer")
+	}
+
+	return res, nil
+}
+
+// escrowERC20WithPermit submits the sender EIP-2612 permit for the module
+// account and then pulls the ERC20 tokens from the sender with transferFrom.
+// Both calls are made within the message, so a failing permit reverts the
+// whole conversion.
+func (k Keeper) escrowERC20WithPermit(
+	ctx sdk.Context,
+	contract, sender common.Address,
+	amount *big.Int,
+	permit *types.ERC20Permit,
+) error {
+	permitABI := types.ERC20PermitABI
+
+	// Contracts without an EIP-712 domain separator don't implement EIP-2612
+	if _, err := k.evmKeeper.CallEVM(ctx, permitABI, types.ModuleAddress, contract, false, "DOMAIN_SEPARATOR"); err != nil {
+		return errorsmod.Wrapf(types.ErrPermitNotSupported, "contract %s: %s", contract, err)
+	}
+
+	_, err := k.evmKeeper.CallEVM(
+		ctx, permitABI, types.ModuleAddress, contract, true, "permit",
+		sender, types.ModuleAddress, permit.Value.BigInt(), new(big.Int).SetUint64(permit.Deadline),
+		uint8(permit.V), common.BytesToHash(permit.R), common.BytesToHash(permit.S),
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to execute permit")
+	}
+
+	res, err := k.evmKeeper.CallEVM(
+		ctx, permitABI, types.ModuleAddress, contract, true, "transferFrom",
+		sender, types.ModuleAddress, amount,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Check evm call response
+	var unpackedRet types.ERC20BoolResponse
+	if err := permitABI.UnpackIntoInterface(&unpackedRet, "transferFrom", res.Ret); err != nil {
+		return err
+	}
+
+	if !unpackedRet.Value {
+		return errorsmod.Wrap(errortypes.ErrLogic, "failed to execute transferFrom")
+	}
+
+	return nil
+}
+
 // ConvertCoinNativeERC20 handles the coin conversion for a native ERC20 token
 // pair:
 //   - escrow Coins on module account
@@ -258,7 +335,13 @@ func (k Keeper) ConvertCoinNativeERC20(
 	}
 
 	// Check for unexpected `Approval` event in logs
-	return k.monitorApprovalEvent(res)
+	if err := k.monitorApprovalEvent(res); err != nil {
+		return err
+	}
+
+	// Refresh the metadata cache of the ERC20 contract
+	k.RefreshERC20Metadata(ctx, contract)
+	return nil
 }
 
 // UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
@@ -276,3 +359,19 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateTokenPairContract implements the gRPC MsgServer interface. After a successful governance vote
+// it re-points the token pair to the new ERC20 contract only if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) UpdateTokenPairContract(goCtx context.Context, req *types.MsgUpdateTokenPairContract) (*types.MsgUpdateTokenPairContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.UpdateTokenPairERC20Contract(ctx, req.Token, common.HexToAddress(req.NewContractAddress)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateTokenPairContractResponse{}, nil
+}
@@ -3,12 +3,17 @@ package keeper_test
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v19/x/erc20/keeper"
 	"github.com/evmos/evmos/v19/x/erc20/types"
 	erc20mocks "github.com/evmos/evmos/v19/x/erc20/types/mocks"
@@ -155,7 +160,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.app.GetKey("erc20"), suite.app.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper,
 					suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper,
-					suite.app.AuthzKeeper, &suite.app.TransferKeeper,
+					suite.app.AuthzKeeper, &suite.app.TransferKeeper, suite.app.IBCKeeper.ChannelKeeper,
+					suite.app.DistrKeeper,
 				)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
@@ -182,7 +188,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.app.GetKey("erc20"), suite.app.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper,
 					suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper,
-					suite.app.AuthzKeeper, &suite.app.TransferKeeper,
+					suite.app.AuthzKeeper, &suite.app.TransferKeeper, suite.app.IBCKeeper.ChannelKeeper,
+					suite.app.DistrKeeper,
 				)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
@@ -208,7 +215,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.app.GetKey("erc20"), suite.app.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper,
 					suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper,
-					suite.app.AuthzKeeper, &suite.app.TransferKeeper,
+					suite.app.AuthzKeeper, &suite.app.TransferKeeper, suite.app.IBCKeeper.ChannelKeeper,
+					suite.app.DistrKeeper,
 				)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
@@ -235,7 +243,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.app.GetKey("erc20"), suite.app.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper,
 					suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper,
-					suite.app.AuthzKeeper, &suite.app.TransferKeeper,
+					suite.app.AuthzKeeper, &suite.app.TransferKeeper, suite.app.IBCKeeper.ChannelKeeper,
+					suite.app.DistrKeeper,
 				)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
@@ -263,7 +272,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.app.GetKey("erc20"), suite.app.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper,
 					mockBankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper,
-					suite.app.AuthzKeeper, &suite.app.TransferKeeper,
+					suite.app.AuthzKeeper, &suite.app.TransferKeeper, suite.app.IBCKeeper.ChannelKeeper,
+					suite.app.DistrKeeper,
 				)
 
 				mockBankKeeper.On("MintCoins", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to mint"))
@@ -286,7 +296,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.app.GetKey("erc20"), suite.app.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper,
 					mockBankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper,
-					suite.app.AuthzKeeper, &suite.app.TransferKeeper,
+					suite.app.AuthzKeeper, &suite.app.TransferKeeper, suite.app.IBCKeeper.ChannelKeeper,
+					suite.app.DistrKeeper,
 				)
 
 				mockBankKeeper.On("MintCoins", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -310,7 +321,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.app.GetKey("erc20"), suite.app.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper,
 					mockBankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper,
-					suite.app.AuthzKeeper, &suite.app.TransferKeeper,
+					suite.app.AuthzKeeper, &suite.app.TransferKeeper, suite.app.IBCKeeper.ChannelKeeper,
+					suite.app.DistrKeeper,
 				)
 
 				mockBankKeeper.On("MintCoins", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -414,3 +426,316 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairContract() {
+	var (
+		oldContract common.Address
+		newContract common.Address
+		authority   = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgUpdateTokenPairContract
+		expPass  bool
+		errMsg   string
+	}{
+		{
+			"fail - invalid authority",
+			func() *types.MsgUpdateTokenPairContract {
+				return &types.MsgUpdateTokenPairContract{
+					Authority:          "foobar",
+					Token:              oldContract.String(),
+					NewContractAddress: newContract.String(),
+				}
+			},
+			false,
+			"invalid authority",
+		},
+		{
+			"fail - token pair not registered",
+			func() *types.MsgUpdateTokenPairContract {
+				return &types.MsgUpdateTokenPairContract{
+					Authority:          authority,
+					Token:              "erc20/0x0000000000000000000000000000000000000001",
+					NewContractAddress: newContract.String(),
+				}
+			},
+			false,
+			types.ErrTokenPairNotFound.Error(),
+		},
+		{
+			"fail - new contract already registered",
+			func() *types.MsgUpdateTokenPairContract {
+				return &types.MsgUpdateTokenPairContract{
+					Authority:          authority,
+					Token:              oldContract.String(),
+					NewContractAddress: oldContract.String(),
+				}
+			},
+			false,
+			types.ErrTokenPairAlreadyExists.Error(),
+		},
+		{
+			"fail - different decimals",
+			func() *types.MsgUpdateTokenPairContract {
+				contract, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals-1)
+				suite.Require().NoError(err)
+				suite.MintERC20Token(contract, suite.address, types.ModuleAddress, big.NewInt(50))
+				suite.Commit()
+
+				return &types.MsgUpdateTokenPairContract{
+					Authority:          authority,
+					Token:              oldContract.String(),
+					NewContractAddress: contract.String(),
+				}
+			},
+			false,
+			types.ErrIncompatibleMetadata.Error(),
+		},
+		{
+			"fail - insufficient backing on the new contract",
+			func() *types.MsgUpdateTokenPairContract {
+				suite.MintERC20Token(newContract, suite.address, types.ModuleAddress, big.NewInt(49))
+				suite.Commit()
+
+				return &types.MsgUpdateTokenPairContract{
+					Authority:          authority,
+					Token:              oldContract.String(),
+					NewContractAddress: newContract.String(),
+				}
+			},
+			false,
+			types.ErrInsufficientBacking.Error(),
+		},
+		{
+			"pass - new contract backs the supply",
+			func() *types.MsgUpdateTokenPairContract {
+				suite.MintERC20Token(newContract, suite.address, types.ModuleAddress, big.NewInt(50))
+				suite.Commit()
+
+				return &types.MsgUpdateTokenPairContract{
+					Authority:          authority,
+					Token:              types.CreateDenom(oldContract.String()),
+					NewContractAddress: newContract.String(),
+				}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			// register the pair and convert part of the ERC20 tokens into coins
+			oldContract = suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.MintERC20Token(oldContract, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			_, err := suite.app.Erc20Keeper.ConvertERC20(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgConvertERC20(math.NewInt(50), sender, oldContract, suite.address),
+			)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			newContract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err)
+
+			msg := tc.malleate()
+			denom := types.CreateDenom(oldContract.String())
+
+			_, err = suite.app.Erc20Keeper.UpdateTokenPairContract(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				suite.Require().True(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, oldContract))
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the pair is re-pointed to the new contract and keeps its denom
+			suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, oldContract))
+			id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, denom)
+			pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().Equal(newContract.String(), pair.Erc20Address)
+			suite.Require().Equal(id, suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, newContract.String()))
+
+			events := suite.ctx.EventManager().Events()
+			event := events[len(events)-1]
+			suite.Require().Equal(types.EventTypeUpdateTokenPairContract, event.Type)
+			suite.Require().Equal([]abci.EventAttribute{
+				{Key: types.AttributeKeyCosmosCoin, Value: denom},
+				{Key: types.AttributeKeyOldERC20Token, Value: oldContract.String()},
+				{Key: types.AttributeKeyERC20Token, Value: newContract.String()},
+				{Key: types.AttributeKeySupply, Value: "50"},
+				{Key: types.AttributeKeyModuleBalance, Value: "50"},
+			}, event.Attributes)
+
+			// the converted coins are redeemable for the new ERC20 tokens
+			err = suite.app.Erc20Keeper.ConvertCoinNativeERC20(suite.ctx, pair, math.NewInt(50), suite.address, sender)
+			suite.Require().NoError(err)
+			suite.Require().Equal(big.NewInt(50), suite.BalanceOf(newContract, suite.address))
+			suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, denom).IsZero())
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+// signERC20Permit signs an EIP-2612 permit for the erc20 module account on
+// the ERC20PermitMock contract.
+func (suite *KeeperTestSuite) signERC20Permit(
+	priv *ethsecp256k1.PrivKey,
+	contract common.Address,
+	value *big.Int,
+	nonce, deadline uint64,
+) *types.ERC20Permit {
+	owner := common.BytesToAddress(priv.PubKey().Address())
+	uint256Ty, _ := abi.NewType("uint256", "", nil)
+	bytes32Ty, _ := abi.NewType("bytes32", "", nil)
+	addressTy, _ := abi.NewType("address", "", nil)
+
+	domainArgs := abi.Arguments{{Type: bytes32Ty}, {Type: bytes32Ty}, {Type: bytes32Ty}, {Type: uint256Ty}, {Type: addressTy}}
+	domain, err := domainArgs.Pack(
+		crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256Hash([]byte("Permit Token")),
+		crypto.Keccak256Hash([]byte("1")),
+		suite.app.EvmKeeper.ChainID(),
+		contract,
+	)
+	suite.Require().NoError(err)
+
+	permitArgs := abi.Arguments{{Type: bytes32Ty}, {Type: addressTy}, {Type: addressTy}, {Type: uint256Ty}, {Type: uint256Ty}, {Type: uint256Ty}}
+	structHash, err := permitArgs.Pack(
+		crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)")),
+		owner,
+		types.ModuleAddress,
+		value,
+		new(big.Int).SetUint64(nonce),
+		new(big.Int).SetUint64(deadline),
+	)
+	suite.Require().NoError(err)
+
+	digest := crypto.Keccak256([]byte{0x19, 0x01}, crypto.Keccak256(domain), crypto.Keccak256(structHash))
+
+	key, err := priv.ToECDSA()
+	suite.Require().NoError(err)
+	sig, err := crypto.Sign(digest, key)
+	suite.Require().NoError(err)
+
+	return &types.ERC20Permit{
+		Value:    math.NewIntFromBigInt(value),
+		Deadline: deadline,
+		V:        uint32(sig[64]) + 27,
+		R:        sig[:32],
+		S:        sig[32:64],
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20WithPermit() {
+	var (
+		contractAddr common.Address
+		permit       *types.ERC20Permit
+	)
+
+	amount := big.NewInt(100)
+
+	testCases := []struct {
+		name         string
+		contractType int
+		malleate     func()
+		errContains  string
+	}{
+		{
+			"ok - permit conversion",
+			contractPermit,
+			func() {},
+			"",
+		},
+		{
+			"fail - invalid signature",
+			contractPermit,
+			func() {
+				otherKey, _ := ethsecp256k1.GenerateKey()
+				permit = suite.signERC20Permit(otherKey, contractAddr, amount, 0, permit.Deadline)
+			},
+			"failed to execute permit",
+		},
+		{
+			"fail - expired deadline",
+			contractPermit,
+			func() {
+				deadline := uint64(suite.ctx.BlockTime().Unix() - 1)
+				permit = suite.signERC20Permit(suite.priv.(*ethsecp256k1.PrivKey), contractAddr, amount, 0, deadline)
+			},
+			"failed to execute permit",
+		},
+		{
+			"fail - permit value lower than amount",
+			contractPermit,
+			func() {
+				permit = suite.signERC20Permit(suite.priv.(*ethsecp256k1.PrivKey), contractAddr, big.NewInt(10), 0, permit.Deadline)
+			},
+			"method 'transferFrom'",
+		},
+		{
+			"fail - contract without permit",
+			contractMinterBurner,
+			func() {
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, amount)
+				suite.Commit()
+			},
+			types.ErrPermitNotSupported.Error(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			contractAddr = suite.setupRegisterERC20Pair(tc.contractType)
+			suite.Commit()
+
+			deadline := uint64(suite.ctx.BlockTime().Add(time.Hour).Unix())
+			permit = suite.signERC20Permit(suite.priv.(*ethsecp256k1.PrivKey), contractAddr, amount, 0, deadline)
+
+			tc.malleate()
+
+			coinName := types.CreateDenom(contractAddr.String())
+			sender := sdk.AccAddress(suite.address.Bytes())
+			msg := types.NewMsgConvertERC20(math.NewIntFromBigInt(amount), sender, contractAddr, suite.address)
+			msg.Permit = permit
+
+			balanceBefore := suite.BalanceOf(contractAddr, suite.address).(*big.Int)
+
+			// run the conversion on a branched context, as the message router does
+			cacheCtx, writeCache := suite.ctx.CacheContext()
+			_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(cacheCtx), msg)
+			if err == nil {
+				writeCache()
+			}
+
+			balance := suite.BalanceOf(contractAddr, suite.address).(*big.Int)
+			escrowed := suite.BalanceOf(contractAddr, types.ModuleAddress).(*big.Int)
+			cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName)
+
+			if tc.errContains == "" {
+				suite.Require().NoError(err)
+				suite.Require().Equal(new(big.Int).Sub(balanceBefore, amount), balance)
+				suite.Require().Equal(amount, escrowed)
+				suite.Require().Equal(math.NewIntFromBigInt(amount), cosmosBalance.Amount)
+			} else {
+				suite.Require().ErrorContains(err, tc.errContains)
+				suite.Require().Equal(balanceBefore, balance)
+				suite.Require().Zero(escrowed.Sign())
+				suite.Require().True(cosmosBalance.Amount.IsZero())
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
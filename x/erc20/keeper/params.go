@@ -6,6 +6,7 @@ package keeper
 import (
 	"slices"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v19/x/erc20/types"
 )
@@ -19,7 +20,11 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	dynamicPrecompiles := k.getDynamicPrecompiles(ctx)
 	nativePrecompiles := k.getNativePrecompiles(ctx)
-	return types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params = types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params.IbcHookGasCap = k.GetIBCHookGasCap(ctx)
+	params.DustSweepEpochIdentifier = k.GetDustSweepEpochIdentifier(ctx)
+	params.DustSweepThreshold = k.GetDustSweepThreshold(ctx)
+	return params
 }
 
 // SetParams sets the erc20 parameters to the param space.
@@ -35,6 +40,9 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	k.setERC20Enabled(ctx, params.EnableErc20)
 	k.setDynamicPrecompiles(ctx, params.DynamicPrecompiles)
 	k.setNativePrecompiles(ctx, params.NativePrecompiles)
+	k.setIBCHookGasCap(ctx, params.IbcHookGasCap)
+	k.setDustSweepEpochIdentifier(ctx, params.DustSweepEpochIdentifier)
+	k.setDustSweepThreshold(ctx, params.DustSweepThreshold)
 	return nil
 }
 
@@ -94,3 +102,73 @@ func (k Keeper) getNativePrecompiles(ctx sdk.Context) (nativePrecompiles []strin
 	}
 	return nativePrecompiles
 }
+
+// GetIBCHookGasCap returns the gas limit of the EVM calls triggered by the memo
+// of incoming ICS-20 transfers. A zero value means the calls are disabled.
+func (k Keeper) GetIBCHookGasCap(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyIBCHookGasCap)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setIBCHookGasCap sets the IBCHookGasCap param in the store
+func (k Keeper) setIBCHookGasCap(ctx sdk.Context, gasCap uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if gasCap == 0 {
+		store.Delete(types.ParamStoreKeyIBCHookGasCap)
+		return
+	}
+	store.Set(types.ParamStoreKeyIBCHookGasCap, sdk.Uint64ToBigEndian(gasCap))
+}
+
+// GetDustSweepEpochIdentifier returns the epoch at the end of which the surplus
+// of the module account is swept. An empty identifier means the sweep is
+// disabled.
+func (k Keeper) GetDustSweepEpochIdentifier(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.ParamStoreKeyDustSweepEpoch))
+}
+
+// setDustSweepEpochIdentifier sets the DustSweepEpochIdentifier param in the store
+func (k Keeper) setDustSweepEpochIdentifier(ctx sdk.Context, identifier string) {
+	store := ctx.KVStore(k.storeKey)
+	if identifier == "" {
+		store.Delete(types.ParamStoreKeyDustSweepEpoch)
+		return
+	}
+	store.Set(types.ParamStoreKeyDustSweepEpoch, []byte(identifier))
+}
+
+// GetDustSweepThreshold returns the minimum surplus of a token pair that is
+// swept to the community pool.
+func (k Keeper) GetDustSweepThreshold(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyDustSweepThreshold)
+	if len(bz) == 0 {
+		return math.ZeroInt()
+	}
+
+	var threshold math.Int
+	if err := threshold.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return threshold
+}
+
+// setDustSweepThreshold sets the DustSweepThreshold param in the store
+func (k Keeper) setDustSweepThreshold(ctx sdk.Context, threshold math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if threshold.IsNil() || threshold.IsZero() {
+		store.Delete(types.ParamStoreKeyDustSweepThreshold)
+		return
+	}
+
+	bz, err := threshold.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.ParamStoreKeyDustSweepThreshold, bz)
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"reflect"
 
+	"cosmossdk.io/math"
 	"github.com/evmos/evmos/v19/x/erc20/types"
 )
 
@@ -52,6 +53,49 @@ func (suite *KeeperTestSuite) TestParams() {
 			},
 			true,
 		},
+		{
+			"success - Checks if the IBC hook gas cap is set correctly",
+			func() interface{} {
+				params.IbcHookGasCap = 500_000
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+				return params.IbcHookGasCap
+			},
+			func() interface{} {
+				return suite.app.Erc20Keeper.GetParams(suite.ctx).IbcHookGasCap
+			},
+			true,
+		},
+		{
+			"success - Checks if the IBC hook calls can be disabled",
+			func() interface{} {
+				params.IbcHookGasCap = 0
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+				return uint64(0)
+			},
+			func() interface{} {
+				return suite.app.Erc20Keeper.GetIBCHookGasCap(suite.ctx)
+			},
+			true,
+		},
+		{
+			"success - Checks if the dust sweep params are set correctly",
+			func() interface{} {
+				params.DustSweepEpochIdentifier = "week"
+				params.DustSweepThreshold = math.NewInt(1000)
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+				return []interface{}{params.DustSweepEpochIdentifier, params.DustSweepThreshold.String()}
+			},
+			func() interface{} {
+				return []interface{}{
+					suite.app.Erc20Keeper.GetDustSweepEpochIdentifier(suite.ctx),
+					suite.app.Erc20Keeper.GetDustSweepThreshold(suite.ctx).String(),
+				}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
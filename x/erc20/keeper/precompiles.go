@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v19/precompiles/erc20"
+	"github.com/evmos/evmos/v19/precompiles/werc20"
 	"github.com/evmos/evmos/v19/x/erc20/types"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 )
@@ -22,7 +23,7 @@ func (k Keeper) GetERC20PrecompileInstance(
 	params := k.GetParams(ctx)
 
 	if k.IsAvailableERC20Precompile(&params, address) {
-		precompile, err := k.InstantiateERC20Precompile(ctx, address)
+		precompile, err := k.InstantiateERC20Precompile(ctx, address, params.IsNativePrecompile(address))
 		if err != nil {
 			return nil, false, errorsmod.Wrapf(err, "precompiled contract not initialized: %s", address.String())
 		}
@@ -31,8 +32,10 @@ func (k Keeper) GetERC20PrecompileInstance(
 	return nil, false, nil
 }
 
-// InstantiateERC20Precompile returns an ERC20 precompile instance for the given contract address
-func (k Keeper) InstantiateERC20Precompile(ctx sdk.Context, contractAddr common.Address) (vm.PrecompiledContract, error) {
+// InstantiateERC20Precompile returns an ERC20 precompile instance for the given contract address.
+// The native precompiles are instantiated as WERC20 precompiles, which support the deposit and
+// withdraw methods of the wrapped native coin.
+func (k Keeper) InstantiateERC20Precompile(ctx sdk.Context, contractAddr common.Address, native bool) (vm.PrecompiledContract, error) {
 	address := contractAddr.String()
 	// check if the precompile is an ERC20 contract
 	id := k.GetTokenPairID(ctx, address)
@@ -43,6 +46,9 @@ func (k Keeper) InstantiateERC20Precompile(ctx sdk.Context, contractAddr common.
 	if !ok {
 		return nil, fmt.Errorf("token pair not found: %s", address)
 	}
+	if native {
+		return werc20.NewPrecompile(pair, k.bankKeeper, k.authzKeeper, *k.transferKeeper)
+	}
 	return erc20.NewPrecompile(pair, k.bankKeeper, k.authzKeeper, *k.transferKeeper)
 }
 
@@ -52,3 +58,23 @@ func (k Keeper) IsAvailableERC20Precompile(params *types.Params, address common.
 	return params.IsNativePrecompile(address) ||
 		params.IsDynamicPrecompile(address)
 }
+
+// GetWrappedNativeTokenPair returns the token pair of the native precompile
+// that wraps the EVM denomination, if any.
+func (k Keeper) GetWrappedNativeTokenPair(ctx sdk.Context) (types.TokenPair, bool) {
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+
+	for _, precompile := range k.GetParams(ctx).NativePrecompiles {
+		id := k.GetTokenPairID(ctx, precompile)
+		if len(id) == 0 {
+			continue
+		}
+
+		pair, found := k.GetTokenPair(ctx, id)
+		if found && pair.Denom == evmDenom {
+			return pair, true
+		}
+	}
+
+	return types.TokenPair{}, false
+}
@@ -9,6 +9,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/evmos/evmos/v19/contracts"
 	"github.com/evmos/evmos/v19/x/erc20/types"
 )
 
@@ -132,3 +133,105 @@ func (k Keeper) ToggleConversion(
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
+
+// UpdateTokenPairERC20Contract re-points a token pair of an ERC20 origin to a
+// new ERC20 contract, e.g. after the token migrated to a new proxy address.
+//
+// The new contract must report the same decimals as the registered metadata of
+// the pair and the module account must hold a balance of the new ERC20 that
+// backs the whole supply of the Cosmos coin, so that converted coins remain
+// redeemable after the migration.
+func (k Keeper) UpdateTokenPairERC20Contract(
+	ctx sdk.Context,
+	token string,
+	newContract common.Address,
+) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if !pair.IsNativeERC20() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairOwnedByModule, "only token pairs of an ERC20 origin can be migrated: %s", pair.Denom,
+		)
+	}
+
+	if k.IsERC20Registered(ctx, newContract) {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", newContract,
+		)
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrInternalTokenPair, "denom metadata not found: %s", pair.Denom,
+		)
+	}
+
+	erc20Data, err := k.QueryERC20(ctx, newContract)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	// the decimals of the pair are the exponent of the display denom unit
+	var decimals uint32
+	for _, unit := range metadata.DenomUnits {
+		if unit.Exponent > decimals {
+			decimals = unit.Exponent
+		}
+	}
+
+	if uint32(erc20Data.Decimals) != decimals {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrIncompatibleMetadata,
+			"decimals of contract %s don't match the token pair (%d != %d)", newContract, erc20Data.Decimals, decimals,
+		)
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	balance := k.BalanceOf(ctx, erc20, newContract, types.ModuleAddress)
+	if balance == nil {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrEVMCall, "failed to retrieve the module account balance of contract %s", newContract,
+		)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom)
+	if balance.Cmp(supply.Amount.BigInt()) < 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrInsufficientBacking,
+			"module account balance of contract %s is lower than the supply of %s (%s < %s)",
+			newContract, pair.Denom, balance, supply.Amount,
+		)
+	}
+
+	oldContract := pair.Erc20Address
+
+	k.DeleteTokenPair(ctx, pair)
+	pair.Erc20Address = newContract.String()
+	k.SetToken(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateTokenPairContract,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyOldERC20Token, oldContract),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeySupply, supply.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyModuleBalance, balance.String()),
+		),
+	)
+
+	return pair, nil
+}
@@ -18,6 +18,7 @@ const (
 	contractMinterBurner = iota + 1
 	contractDirectBalanceManipulation
 	contractMaliciousDelayed
+	contractPermit
 )
 
 const (
@@ -79,6 +80,8 @@ func (suite *KeeperTestSuite) setupRegisterERC20Pair(contractType int) common.Ad
 		contract, err = suite.DeployContractDirectBalanceManipulation()
 	case contractMaliciousDelayed:
 		contract, err = suite.DeployContractMaliciousDelayed()
+	case contractPermit:
+		contract, err = suite.DeployContractPermit()
 	default:
 		contract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
 	}
@@ -135,7 +138,8 @@ func (suite *KeeperTestSuite) TestRegisterERC20() {
 					suite.app.GetKey("erc20"), suite.app.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper,
 					suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper,
-					suite.app.AuthzKeeper, &suite.app.TransferKeeper,
+					suite.app.AuthzKeeper, &suite.app.TransferKeeper, suite.app.IBCKeeper.ChannelKeeper,
+					suite.app.DistrKeeper,
 				)
 
 				mockEVMKeeper.On("EstimateGasInternal", mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.EstimateGasResponse{Gas: uint64(200)}, nil)
@@ -0,0 +1,87 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/contracts"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+)
+
+// SweepDust reconciles the ERC20 balance of the module account of each enabled
+// native ERC20 token pair against the bank supply of its coin. The tokens sent
+// to the module account outside of a conversion are not backing any coin, so
+// the surplus is converted into coins, as a conversion would, and sent to the
+// community pool. The surplus below the dust sweep threshold is left in the
+// module account.
+//
+// A token pair failing to sweep is logged and skipped, without reverting the
+// sweep of the other token pairs.
+func (k Keeper) SweepDust(ctx sdk.Context) {
+	threshold := k.GetDustSweepThreshold(ctx)
+
+	k.IterateTokenPairs(ctx, func(pair types.TokenPair) (stop bool) {
+		if !pair.Enabled || !pair.IsNativeERC20() {
+			return false
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.sweepTokenPairDust(cacheCtx, pair, threshold); err != nil {
+			k.Logger(ctx).Error(
+				"failed to sweep the module account ERC20 dust",
+				"denom", pair.Denom, "erc20", pair.Erc20Address, "error", err,
+			)
+			return false
+		}
+
+		writeCache()
+		return false
+	})
+}
+
+// sweepTokenPairDust sends the surplus of the module account ERC20 balance of
+// the given token pair over the bank supply of its coin to the community pool,
+// if the surplus reaches the given threshold.
+func (k Keeper) sweepTokenPairDust(ctx sdk.Context, pair types.TokenPair, threshold math.Int) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	balance := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), types.ModuleAddress)
+	if balance == nil {
+		return errorsmod.Wrapf(
+			types.ErrEVMCall, "failed to retrieve the module account balance of contract %s", pair.Erc20Address,
+		)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom)
+	surplus := new(big.Int).Sub(balance, supply.Amount.BigInt())
+	if surplus.Sign() <= 0 || surplus.Cmp(threshold.BigInt()) < 0 {
+		return nil
+	}
+
+	coins := sdk.Coins{sdk.NewCoin(pair.Denom, math.NewIntFromBigInt(surplus))}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, coins, types.ModuleAddress.Bytes()); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSweepDust,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeySupply, supply.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyModuleBalance, balance.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, surplus.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+; ERC20Bytes32Metadata returns its name and symbol as bytes32, as the tokens
+; deployed before the ERC20 standard (e.g. MKR), and reverts on the other
+; methods.
+;
+;   name()     -> bytes32("Maker")
+;   symbol()   -> bytes32("MKR")
+;   decimals() -> 18
+;
+; The compiled bytecode is stored in ERC20Bytes32Metadata.json.
+
+; constructor: copy the runtime code and return it
+  PUSH1 0x86 DUP1 PUSH1 0x0b PUSH1 0x00 CODECOPY PUSH1 0x00 RETURN
+
+; runtime: dispatch on the method selector
+  PUSH1 0x00 CALLDATALOAD PUSH1 0xe0 SHR
+  DUP1 PUSH4 0x06fdde03 EQ PUSH1 0x27 JUMPI
+  DUP1 PUSH4 0x95d89b41 EQ PUSH1 0x51 JUMPI
+  PUSH4 0x313ce567 EQ PUSH1 0x7b JUMPI
+  PUSH1 0x00 DUP1 REVERT
+
+name: ; 0x27
+  JUMPDEST PUSH32 0x4d616b6572000000000000000000000000000000000000000000000000000000
+  PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+
+symbol: ; 0x51
+  JUMPDEST PUSH32 0x4d4b520000000000000000000000000000000000000000000000000000000000
+  PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+
+decimals: ; 0x7b
+  JUMPDEST PUSH1 0x12 PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
@@ -0,0 +1,141 @@
+; ERC20PermitMock is a minimal ERC20 token that implements EIP-2612 permit.
+;
+; It is written directly in EVM assembly so the compiled artifact in
+; ERC20PermitMock.json can be reproduced without a Solidity toolchain.
+; The constructor mints the whole supply to the deployer.
+;
+; Storage layout:
+;   balanceOf(a)     -> slot a
+;   allowance(o, s)  -> slot keccak256(o . s)
+;   nonces(o)        -> slot keccak256(o)
+;
+; Notation: `@label` pushes a label offset, `sel:"sig"` pushes a function
+; selector, `keccak:"str"` pushes the hash of a string and `str:"str"` pushes
+; the string left-aligned in a 32 byte word.
+
+  0xd3c21bcecceda1000000 CALLER SSTORE
+  RUNTIME_LEN DUP1 RUNTIME_OFFSET 0 CODECOPY 0 RETURN
+
+.runtime
+  0 CALLDATALOAD 0xe0 SHR
+  DUP1 sel:"balanceOf(address)" EQ @balanceOf JUMPI
+  DUP1 sel:"transfer(address,uint256)" EQ @transfer JUMPI
+  DUP1 sel:"transferFrom(address,address,uint256)" EQ @transferFrom JUMPI
+  DUP1 sel:"approve(address,uint256)" EQ @approve JUMPI
+  DUP1 sel:"allowance(address,address)" EQ @allowance JUMPI
+  DUP1 sel:"totalSupply()" EQ @totalSupply JUMPI
+  DUP1 sel:"decimals()" EQ @decimals JUMPI
+  DUP1 sel:"name()" EQ @name JUMPI
+  DUP1 sel:"symbol()" EQ @symbol JUMPI
+  DUP1 sel:"nonces(address)" EQ @nonces JUMPI
+  DUP1 sel:"DOMAIN_SEPARATOR()" EQ @domainSeparator JUMPI
+  DUP1 sel:"permit(address,address,uint256,uint256,uint8,bytes32,bytes32)" EQ @permit JUMPI
+revert:
+  0 0 REVERT
+
+stop:
+  STOP
+
+; returns the word on top of the stack
+ret:
+  0 MSTORE 0x20 0 RETURN
+
+balanceOf:
+  4 CALLDATALOAD SLOAD @ret JUMP
+
+totalSupply:
+  0xd3c21bcecceda1000000 @ret JUMP
+
+decimals:
+  18 @ret JUMP
+
+name:
+  0x20 0 MSTORE 12 0x20 MSTORE str:"Permit Token" 0x40 MSTORE 0x60 0 RETURN
+
+symbol:
+  0x20 0 MSTORE 3 0x20 MSTORE str:"PMT" 0x40 MSTORE 0x60 0 RETURN
+
+allowance:
+  4 CALLDATALOAD 0 MSTORE 0x24 CALLDATALOAD 0x20 MSTORE 0x40 0 KECCAK256 SLOAD @ret JUMP
+
+nonces:
+  4 CALLDATALOAD 0 MSTORE 0x20 0 KECCAK256 SLOAD @ret JUMP
+
+domainSeparator:
+  @ret @_domain JUMP
+
+transfer:
+  @success 0x24 CALLDATALOAD 4 CALLDATALOAD CALLER @_transfer JUMP
+
+transferFrom:
+  ; spend the caller allowance
+  4 CALLDATALOAD 0 MSTORE CALLER 0x20 MSTORE 0x40 0 KECCAK256 ; [slot]
+  DUP1 SLOAD 0x44 CALLDATALOAD                                ; [slot allowance amount]
+  DUP2 LT @revert JUMPI
+  SWAP1 SUB SWAP1 SSTORE
+  @success 0x44 CALLDATALOAD 0x24 CALLDATALOAD 4 CALLDATALOAD @_transfer JUMP
+
+approve:
+  @success 0x24 CALLDATALOAD 4 CALLDATALOAD CALLER @_approve JUMP
+
+success:
+  1 @ret JUMP
+
+permit:
+  ; deadline
+  0x64 CALLDATALOAD TIMESTAMP GT @revert JUMPI
+  ; use the owner nonce
+  4 CALLDATALOAD 0 MSTORE 0x20 0 KECCAK256                    ; [slot]
+  DUP1 SLOAD DUP1 1 ADD DUP3 SSTORE                           ; [slot nonce]
+  ; struct hash
+  keccak:"Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)" 0x120 MSTORE
+  4 CALLDATALOAD 0x140 MSTORE
+  0x24 CALLDATALOAD 0x160 MSTORE
+  0x44 CALLDATALOAD 0x180 MSTORE
+  0x1a0 MSTORE
+  0x64 CALLDATALOAD 0x1c0 MSTORE
+  POP
+  0xc0 0x120 KECCAK256                                        ; [structHash]
+  @permitDigest @_domain JUMP
+permitDigest:                                                 ; [structHash separator]
+  0x1901 0xf0 SHL 0x200 MSTORE
+  0x202 MSTORE
+  0x222 MSTORE
+  0x42 0x200 KECCAK256                                        ; [digest]
+  ; recover the signer with the ecrecover precompile
+  0x80 MSTORE
+  0x84 CALLDATALOAD 0xa0 MSTORE
+  0xa4 CALLDATALOAD 0xc0 MSTORE
+  0xc4 CALLDATALOAD 0xe0 MSTORE
+  0 0 MSTORE
+  0x20 0 0x80 0x80 1 GAS STATICCALL ISZERO @revert JUMPI
+  0 MLOAD DUP1 ISZERO @revert JUMPI
+  4 CALLDATALOAD EQ ISZERO @revert JUMPI
+  @stop 0x44 CALLDATALOAD 0x24 CALLDATALOAD 4 CALLDATALOAD @_approve JUMP
+
+; [ret amount to from] -> []
+_transfer:
+  DUP1 SLOAD DUP4 DUP2 LT @revert JUMPI
+  DUP4 SWAP1 SUB DUP2 SSTORE
+  DUP2 SLOAD DUP4 ADD DUP3 SSTORE
+  DUP3 0 MSTORE
+  DUP2 DUP2 keccak:"Transfer(address,address,uint256)" 0x20 0 LOG3
+  POP POP POP JUMP
+
+; [ret value spender owner] -> []
+_approve:
+  DUP1 0 MSTORE DUP2 0x20 MSTORE 0x40 0 KECCAK256
+  DUP4 SWAP1 SSTORE
+  DUP3 0 MSTORE
+  DUP2 DUP2 keccak:"Approval(address,address,uint256)" 0x20 0 LOG3
+  POP POP POP JUMP
+
+; [ret] -> [separator]
+_domain:
+  keccak:"EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)" 0x80 MSTORE
+  keccak:"Permit Token" 0xa0 MSTORE
+  keccak:"1" 0xc0 MSTORE
+  CHAINID 0xe0 MSTORE
+  ADDRESS 0x100 MSTORE
+  0xa0 0x80 KECCAK256
+  SWAP1 JUMP
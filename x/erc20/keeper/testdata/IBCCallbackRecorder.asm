@@ -0,0 +1,30 @@
+; IBCCallbackRecorder forwards its calls to the ICS-20 precompile and records
+; the packet results it is notified of through onPacketResult(uint64,bool,bytes).
+;
+;   slot 0 -> packet sequence
+;   slot 1 -> success + 1 (i.e. 1 on failure and 2 on success)
+;   slot 2 -> caller
+;
+; The compiled bytecode is stored in IBCCallbackRecorder.json.
+
+; constructor: copy the runtime code and return it
+  PUSH1 0x4a DUP1 PUSH1 0x0b PUSH1 0x00 CODECOPY PUSH1 0x00 RETURN
+
+; runtime: dispatch on the onPacketResult selector
+  PUSH1 0x00 CALLDATALOAD PUSH1 0xe0 SHR PUSH4 0x20c4c66a EQ PUSH1 0x35 JUMPI
+
+; forward the calldata to the ICS-20 precompile and bubble up the result
+  CALLDATASIZE PUSH1 0x00 PUSH1 0x00 CALLDATACOPY
+  PUSH1 0x00 PUSH1 0x00 CALLDATASIZE PUSH1 0x00 PUSH1 0x00 PUSH2 0x0802 GAS CALL
+  RETURNDATASIZE PUSH1 0x00 PUSH1 0x00 RETURNDATACOPY
+  PUSH1 0x30 JUMPI
+  RETURNDATASIZE PUSH1 0x00 REVERT
+ok: ; 0x30
+  JUMPDEST RETURNDATASIZE PUSH1 0x00 RETURN
+
+callback: ; 0x35
+  JUMPDEST
+  PUSH1 0x04 CALLDATALOAD PUSH1 0x00 SSTORE
+  PUSH1 0x24 CALLDATALOAD PUSH1 0x01 ADD PUSH1 0x01 SSTORE
+  CALLER PUSH1 0x02 SSTORE
+  STOP
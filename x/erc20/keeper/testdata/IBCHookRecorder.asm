@@ -0,0 +1,18 @@
+; IBCHookRecorder records the caller and the first calldata word of the
+; calls it receives and reverts on calls without calldata.
+;
+;   slot 0 -> caller
+;   slot 1 -> first calldata word
+;
+; The compiled bytecode is stored in IBCHookRecorder.json.
+
+; constructor: copy the runtime code and return it
+  PUSH1 0x16 DUP1 PUSH1 0x0b PUSH1 0x00 CODECOPY PUSH1 0x00 RETURN
+
+; runtime
+  CALLDATASIZE ISZERO PUSH1 0x10 JUMPI
+  CALLER PUSH1 0x00 SSTORE
+  PUSH1 0x00 CALLDATALOAD PUSH1 0x01 SSTORE
+  STOP
+revert: ; 0x10
+  JUMPDEST PUSH1 0x00 PUSH1 0x00 REVERT
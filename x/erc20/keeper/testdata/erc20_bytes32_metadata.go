@@ -0,0 +1,15 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package testdata
+
+import (
+	contractutils "github.com/evmos/evmos/v19/contracts/utils"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// LoadERC20Bytes32MetadataContract loads the ERC20Bytes32Metadata contract,
+// which returns its name and symbol as bytes32.
+func LoadERC20Bytes32MetadataContract() (evmtypes.CompiledContract, error) {
+	return contractutils.LegacyLoadContractFromJSONFile("ERC20Bytes32Metadata.json")
+}
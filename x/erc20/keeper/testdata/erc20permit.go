@@ -0,0 +1,17 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package testdata
+
+import (
+	contractutils "github.com/evmos/evmos/v19/contracts/utils"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// LoadERC20PermitContract loads the ERC20PermitMock contract.
+//
+// This is a minimal ERC20 token implementing EIP-2612 permit, assembled
+// from ERC20PermitMock.asm. The deployer receives the whole supply.
+func LoadERC20PermitContract() (evmtypes.CompiledContract, error) {
+	return contractutils.LegacyLoadContractFromJSONFile("ERC20PermitMock.json")
+}
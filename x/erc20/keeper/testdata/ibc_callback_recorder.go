@@ -0,0 +1,16 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package testdata
+
+import (
+	contractutils "github.com/evmos/evmos/v19/contracts/utils"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// LoadIBCCallbackRecorderContract loads the IBCCallbackRecorder contract, which
+// forwards its calls to the ICS-20 precompile and records the packet results
+// it is notified of.
+func LoadIBCCallbackRecorderContract() (evmtypes.CompiledContract, error) {
+	return contractutils.LegacyLoadContractFromJSONFile("IBCCallbackRecorder.json")
+}
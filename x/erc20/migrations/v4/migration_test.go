@@ -71,5 +71,7 @@ func TestMigrate(t *testing.T) {
 
 	params := types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
 	defaultParams := types.DefaultParams()
+	// the IBC hook gas cap was introduced after v4 and isn't set by the migration
+	defaultParams.IbcHookGasCap = 0
 	require.Equal(t, params, defaultParams)
 }
@@ -27,8 +27,9 @@ var (
 
 const (
 	// Amino names
-	convertERC20Name = "evmos/MsgConvertERC20"
-	updateParams     = "evmos/erc20/MsgUpdateParams"
+	convertERC20Name        = "evmos/MsgConvertERC20"
+	updateParams            = "evmos/erc20/MsgUpdateParams"
+	updateTokenPairContract = "evmos/erc20/MsgUpdateTokenPairContract"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -43,6 +44,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgConvertERC20{},
 		&MsgUpdateParams{},
+		&MsgUpdateTokenPairContract{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -59,4 +61,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParams, nil)
 	cdc.RegisterConcrete(&MsgConvertERC20{}, convertERC20Name, nil)
+	cdc.RegisterConcrete(&MsgUpdateTokenPairContract{}, updateTokenPairContract, nil)
 }
@@ -35,7 +35,7 @@ const (
 	OWNER_UNSPECIFIED Owner = 0
 	// OWNER_MODULE - erc20 is owned by the erc20 module account.
 	OWNER_MODULE Owner = 1
-	// OWNER_EXTERNAL - erc20 is owned by an external owned account.
+	// OWNER_EXTERNAL - erc20 is owned by an external account.
 	OWNER_EXTERNAL Owner = 2
 )
 
@@ -59,6 +59,45 @@ func (Owner) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_668d5dc537f45142, []int{0}
 }
 
+// MetadataEncoding enumerates the encodings of the metadata strings returned by
+// an ERC20 contract.
+type MetadataEncoding int32
+
+const (
+	// METADATA_ENCODING_UNSPECIFIED defines a metadata string that couldn't be
+	// retrieved, as the call to the contract failed.
+	METADATA_ENCODING_UNSPECIFIED MetadataEncoding = 0
+	// METADATA_ENCODING_STRING - ABI encoded string, as defined by the ERC20 standard.
+	METADATA_ENCODING_STRING MetadataEncoding = 1
+	// METADATA_ENCODING_BYTES32 - null padded bytes32, as returned by the tokens
+	// deployed before the ERC20 standard (e.g. MKR).
+	METADATA_ENCODING_BYTES32 MetadataEncoding = 2
+	// METADATA_ENCODING_RAW - return data that couldn't be decoded.
+	METADATA_ENCODING_RAW MetadataEncoding = 3
+)
+
+var MetadataEncoding_name = map[int32]string{
+	0: "METADATA_ENCODING_UNSPECIFIED",
+	1: "METADATA_ENCODING_STRING",
+	2: "METADATA_ENCODING_BYTES32",
+	3: "METADATA_ENCODING_RAW",
+}
+
+var MetadataEncoding_value = map[string]int32{
+	"METADATA_ENCODING_UNSPECIFIED": 0,
+	"METADATA_ENCODING_STRING":      1,
+	"METADATA_ENCODING_BYTES32":     2,
+	"METADATA_ENCODING_RAW":         3,
+}
+
+func (x MetadataEncoding) String() string {
+	return proto.EnumName(MetadataEncoding_name, int32(x))
+}
+
+func (MetadataEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+
 // TokenPair defines an instance that records a pairing consisting of a native
 // Cosmos Coin and an ERC20 token address.
 type TokenPair struct {
@@ -68,7 +107,7 @@ type TokenPair struct {
 	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 	// enabled defines the token mapping enable status
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	// contract_owner is an enum specifying the type of ERC20 owner (0 invalid, 1 ModuleAccount, 2 external address)
+	// contract_owner is the an ENUM specifying the type of ERC20 owner (0 invalid, 1 ModuleAccount, 2 external address)
 	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=evmos.erc20.v1.Owner" json:"contract_owner,omitempty"`
 }
 
@@ -138,6 +177,130 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+// ERC20Metadata defines the metadata returned by the ERC20 contract of a token
+// pair.
+type ERC20Metadata struct {
+	// erc20_address is the hex address of ERC20 contract token
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// name of the token, decoded from the return data of name()
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// symbol of the token, decoded from the return data of symbol()
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// decimals of the token
+	Decimals uint32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// raw_name is the return data of name(), if it is not an ABI encoded string
+	RawName []byte `protobuf:"bytes,5,opt,name=raw_name,json=rawName,proto3" json:"raw_name,omitempty"`
+	// name_encoding is the encoding of the return data of name()
+	NameEncoding MetadataEncoding `protobuf:"varint,6,opt,name=name_encoding,json=nameEncoding,proto3,enum=evmos.erc20.v1.MetadataEncoding" json:"name_encoding,omitempty"`
+	// raw_symbol is the return data of symbol(), if it is not an ABI encoded string
+	RawSymbol []byte `protobuf:"bytes,7,opt,name=raw_symbol,json=rawSymbol,proto3" json:"raw_symbol,omitempty"`
+	// symbol_encoding is the encoding of the return data of symbol()
+	SymbolEncoding MetadataEncoding `protobuf:"varint,8,opt,name=symbol_encoding,json=symbolEncoding,proto3,enum=evmos.erc20.v1.MetadataEncoding" json:"symbol_encoding,omitempty"`
+	// height is the block height at which the metadata was retrieved
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ERC20Metadata) Reset()         { *m = ERC20Metadata{} }
+func (m *ERC20Metadata) String() string { return proto.CompactTextString(m) }
+func (*ERC20Metadata) ProtoMessage()    {}
+func (*ERC20Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+
+func (m *ERC20Metadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ERC20Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Metadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ERC20Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Metadata.Merge(m, src)
+}
+
+func (m *ERC20Metadata) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ERC20Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Metadata proto.InternalMessageInfo
+
+func (m *ERC20Metadata) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *ERC20Metadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ERC20Metadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ERC20Metadata) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *ERC20Metadata) GetRawName() []byte {
+	if m != nil {
+		return m.RawName
+	}
+	return nil
+}
+
+func (m *ERC20Metadata) GetNameEncoding() MetadataEncoding {
+	if m != nil {
+		return m.NameEncoding
+	}
+	return METADATA_ENCODING_UNSPECIFIED
+}
+
+func (m *ERC20Metadata) GetRawSymbol() []byte {
+	if m != nil {
+		return m.RawSymbol
+	}
+	return nil
+}
+
+func (m *ERC20Metadata) GetSymbolEncoding() MetadataEncoding {
+	if m != nil {
+		return m.SymbolEncoding
+	}
+	return METADATA_ENCODING_UNSPECIFIED
+}
+
+func (m *ERC20Metadata) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Deprecated: RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin. We're keeping it to remove the existing proposals from
 // store. After that, remove this message.
@@ -154,7 +317,7 @@ func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{1}
+	return fileDescriptor_668d5dc537f45142, []int{2}
 }
 
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
@@ -221,7 +384,7 @@ func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{2}
+	return fileDescriptor_668d5dc537f45142, []int{3}
 }
 
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
@@ -277,7 +440,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{3}
+	return fileDescriptor_668d5dc537f45142, []int{4}
 }
 
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
@@ -348,7 +511,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{4}
+	return fileDescriptor_668d5dc537f45142, []int{5}
 }
 
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
@@ -405,7 +568,9 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 
 func init() {
 	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterEnum("evmos.erc20.v1.MetadataEncoding", MetadataEncoding_name, MetadataEncoding_value)
 	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
+	proto.RegisterType((*ERC20Metadata)(nil), "evmos.erc20.v1.ERC20Metadata")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*ProposalMetadata)(nil), "evmos.erc20.v1.ProposalMetadata")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
@@ -415,39 +580,52 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
 
 var fileDescriptor_668d5dc537f45142 = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xce, 0x74, 0xb7, 0xda, 0x9d, 0xb6, 0x21, 0x0e, 0xbb, 0x10, 0x16, 0x9a, 0x86, 0x15, 0x24,
-	0x78, 0x48, 0x9a, 0xf5, 0x22, 0x22, 0x48, 0x77, 0x1b, 0xa1, 0xd2, 0xee, 0x2e, 0xe9, 0x16, 0xc5,
-	0xcb, 0x32, 0x49, 0x86, 0x18, 0xba, 0x9b, 0x59, 0x66, 0xc6, 0xa8, 0x07, 0xef, 0x1e, 0xbd, 0x78,
-	0x17, 0xf4, 0x8f, 0xe9, 0xb1, 0x47, 0x4f, 0x22, 0xbb, 0x17, 0xff, 0x0c, 0xc9, 0x4c, 0x22, 0xd6,
-	0x63, 0x7b, 0x19, 0xde, 0xf7, 0xbd, 0x1f, 0xbc, 0xf7, 0xbd, 0x79, 0xb0, 0x4b, 0x8a, 0x05, 0xe5,
-	0x1e, 0x61, 0x71, 0xff, 0xc0, 0x2b, 0x7c, 0x65, 0xb8, 0x4b, 0x46, 0x05, 0x45, 0xba, 0xf4, 0xb9,
-	0x8a, 0x2a, 0xfc, 0xae, 0x15, 0x53, 0x5e, 0x06, 0x47, 0x38, 0xbf, 0xf0, 0x0a, 0x3f, 0x22, 0x02,
-	0xfb, 0x12, 0xa8, 0xf8, 0x6e, 0x3b, 0xa5, 0x29, 0x95, 0xa6, 0x57, 0x5a, 0x8a, 0xed, 0x7d, 0x07,
-	0xb0, 0x35, 0xa5, 0x17, 0x24, 0x9f, 0xe0, 0x8c, 0xa1, 0xfb, 0x70, 0x57, 0xd6, 0x9b, 0xe1, 0x24,
-	0x61, 0x84, 0x73, 0x13, 0xd8, 0xc0, 0x69, 0x85, 0x3b, 0x92, 0x3c, 0x54, 0x1c, 0x6a, 0xc3, 0xcd,
-	0x84, 0xe4, 0x74, 0x61, 0x6e, 0x48, 0xa7, 0x02, 0xc8, 0x84, 0x77, 0x49, 0x8e, 0xa3, 0x39, 0x49,
-	0xcc, 0x86, 0x0d, 0x9c, 0xad, 0xb0, 0x86, 0xe8, 0x29, 0xd4, 0x63, 0x9a, 0x0b, 0x86, 0x63, 0x31,
-	0xa3, 0xef, 0x72, 0xc2, 0xcc, 0xa6, 0x0d, 0x1c, 0xbd, 0xdf, 0x71, 0xaf, 0x4f, 0xe0, 0x8e, 0x4b,
-	0x67, 0xb8, 0x5b, 0x07, 0x4b, 0xf8, 0xa4, 0xf9, 0xfb, 0xeb, 0x3e, 0xe8, 0x7d, 0x01, 0xb0, 0x1d,
-	0x92, 0x34, 0xe3, 0x82, 0xb0, 0x21, 0xcd, 0xf2, 0x09, 0xa3, 0x4b, 0xca, 0xf1, 0xbc, 0x6c, 0x46,
-	0x64, 0x62, 0x4e, 0xaa, 0x4e, 0x15, 0x40, 0x36, 0xdc, 0x4e, 0x08, 0x8f, 0x59, 0xb6, 0x14, 0x19,
-	0xcd, 0xab, 0x46, 0xff, 0xa5, 0xd0, 0x33, 0xb8, 0xb5, 0x20, 0x02, 0x27, 0x58, 0x60, 0xb3, 0x61,
-	0x37, 0x9c, 0xed, 0xfe, 0x9e, 0xab, 0x04, 0x74, 0xa5, 0x66, 0x95, 0x80, 0xee, 0x69, 0x15, 0x34,
-	0x68, 0x5e, 0xfe, 0xdc, 0xd7, 0xc2, 0xbf, 0x49, 0xb2, 0x2f, 0xad, 0x77, 0x06, 0x8d, 0xba, 0x95,
-	0x3a, 0xf2, 0x5a, 0x69, 0x70, 0x83, 0xd2, 0xbd, 0x8f, 0xb0, 0x53, 0xcf, 0x1a, 0x84, 0xc3, 0xfe,
-	0xc1, 0xad, 0x87, 0x7d, 0x00, 0x75, 0x29, 0x72, 0xb5, 0x55, 0xc2, 0xe5, 0xc8, 0xad, 0xf0, 0x3f,
-	0xb6, 0x9a, 0x89, 0xc3, 0xbd, 0x29, 0x4d, 0xd3, 0x39, 0x91, 0xff, 0x62, 0x48, 0xf3, 0x82, 0x30,
-	0x9e, 0xd1, 0xdb, 0x6b, 0x5e, 0xe6, 0x95, 0x25, 0xe5, 0x07, 0x29, 0xf3, 0x4a, 0xa0, 0x16, 0xfc,
-	0xf0, 0x05, 0xdc, 0x94, 0xfb, 0x46, 0x1d, 0x78, 0x6f, 0xfc, 0x72, 0x14, 0x84, 0xb3, 0xf3, 0xd1,
-	0xd9, 0x24, 0x18, 0x1e, 0x3f, 0x3f, 0x0e, 0x8e, 0x0c, 0x0d, 0x19, 0x70, 0x47, 0xd1, 0xa7, 0xe3,
-	0xa3, 0xf3, 0x93, 0xc0, 0x00, 0x08, 0x41, 0x5d, 0x31, 0xc1, 0xab, 0x69, 0x10, 0x8e, 0x0e, 0x4f,
-	0x8c, 0x8d, 0x6e, 0xf3, 0xd3, 0x37, 0x4b, 0x1b, 0x0c, 0x2e, 0x57, 0x16, 0xb8, 0x5a, 0x59, 0xe0,
-	0xd7, 0xca, 0x02, 0x9f, 0xd7, 0x96, 0x76, 0xb5, 0xb6, 0xb4, 0x1f, 0x6b, 0x4b, 0x7b, 0xed, 0xa4,
-	0x99, 0x78, 0xf3, 0x36, 0x72, 0x63, 0xba, 0xf0, 0xaa, 0xd3, 0x92, 0x6f, 0xe1, 0x3f, 0xf6, 0xde,
-	0x57, 0x67, 0x26, 0x3e, 0x2c, 0x09, 0x8f, 0xee, 0xc8, 0xf3, 0x78, 0xf4, 0x27, 0x00, 0x00, 0xff,
-	0xff, 0x19, 0x11, 0xfb, 0x31, 0x82, 0x03, 0x00, 0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6f, 0x12, 0x41,
+	0x14, 0x66, 0x80, 0x52, 0x78, 0x05, 0x5c, 0x27, 0xa5, 0xd9, 0x12, 0xd9, 0xae, 0x98, 0x18, 0xd2,
+	0x03, 0x14, 0x7a, 0xd2, 0x98, 0x18, 0x7e, 0xac, 0x0d, 0xa6, 0x85, 0x66, 0xa0, 0xa9, 0x7a, 0x21,
+	0xcb, 0xee, 0x64, 0xbb, 0x29, 0xec, 0x90, 0xdd, 0x15, 0xec, 0xc1, 0xbb, 0x47, 0x13, 0xe3, 0xdd,
+	0x44, 0xff, 0x98, 0x1e, 0x7b, 0xf4, 0x64, 0x9a, 0xf6, 0xe2, 0x9f, 0x61, 0x76, 0x66, 0xc1, 0x4a,
+	0x2f, 0x8d, 0xbd, 0x6c, 0xe6, 0xfb, 0xde, 0xaf, 0xef, 0xed, 0x7b, 0x33, 0x90, 0xa7, 0xd3, 0x31,
+	0xf3, 0x2a, 0xd4, 0x35, 0x6a, 0x3b, 0x95, 0x69, 0x55, 0x1c, 0xca, 0x13, 0x97, 0xf9, 0x0c, 0x67,
+	0xb9, 0xad, 0x2c, 0xa8, 0x69, 0x35, 0xaf, 0x18, 0xcc, 0x0b, 0x9c, 0x87, 0xba, 0x73, 0x5a, 0x99,
+	0x56, 0x87, 0xd4, 0xd7, 0xab, 0x1c, 0x08, 0xff, 0xfc, 0xba, 0xc5, 0x2c, 0xc6, 0x8f, 0x95, 0xe0,
+	0x24, 0xd8, 0xe2, 0x0f, 0x04, 0xa9, 0x3e, 0x3b, 0xa5, 0xce, 0xa1, 0x6e, 0xbb, 0xf8, 0x09, 0x64,
+	0x78, 0xbe, 0x81, 0x6e, 0x9a, 0x2e, 0xf5, 0x3c, 0x19, 0xa9, 0xa8, 0x94, 0x22, 0x69, 0x4e, 0xd6,
+	0x05, 0x87, 0xd7, 0x61, 0xc5, 0xa4, 0x0e, 0x1b, 0xcb, 0x51, 0x6e, 0x14, 0x00, 0xcb, 0xb0, 0x4a,
+	0x1d, 0x7d, 0x38, 0xa2, 0xa6, 0x1c, 0x53, 0x51, 0x29, 0x49, 0xe6, 0x10, 0xbf, 0x80, 0xac, 0xc1,
+	0x1c, 0xdf, 0xd5, 0x0d, 0x7f, 0xc0, 0x66, 0x0e, 0x75, 0xe5, 0xb8, 0x8a, 0x4a, 0xd9, 0x5a, 0xae,
+	0xfc, 0x6f, 0x07, 0xe5, 0x6e, 0x60, 0x24, 0x99, 0xb9, 0x33, 0x87, 0xcf, 0xe3, 0xbf, 0xbf, 0x6d,
+	0xa1, 0xe2, 0x65, 0x14, 0x32, 0x1a, 0x69, 0xd6, 0x76, 0x0e, 0xa8, 0xaf, 0x9b, 0xba, 0xaf, 0xdf,
+	0x4d, 0x2a, 0x86, 0xb8, 0xa3, 0x8f, 0x69, 0xa8, 0x94, 0x9f, 0xf1, 0x06, 0x24, 0xbc, 0xb3, 0xf1,
+	0x90, 0x8d, 0xb8, 0xce, 0x14, 0x09, 0x11, 0xce, 0x43, 0xd2, 0xa4, 0x86, 0x3d, 0xd6, 0x47, 0x1e,
+	0x17, 0x98, 0x21, 0x0b, 0x8c, 0x37, 0x21, 0xe9, 0xea, 0xb3, 0x01, 0xcf, 0xb5, 0xa2, 0xa2, 0x52,
+	0x9a, 0xac, 0xba, 0xfa, 0xac, 0x13, 0xa4, 0xd3, 0x20, 0x13, 0xd0, 0x03, 0xea, 0x18, 0xcc, 0xb4,
+	0x1d, 0x4b, 0x4e, 0xf0, 0xe6, 0xd4, 0xe5, 0xe6, 0xe6, 0xc2, 0xb5, 0xd0, 0x8f, 0xa4, 0x83, 0xb0,
+	0x39, 0xc2, 0x05, 0x80, 0xa0, 0x42, 0xa8, 0x6c, 0x95, 0xd7, 0x48, 0xb9, 0xfa, 0xac, 0x27, 0xc4,
+	0xb5, 0xe1, 0x81, 0x30, 0xfd, 0xad, 0x93, 0xbc, 0x63, 0x9d, 0xac, 0x08, 0x5c, 0x54, 0xda, 0x80,
+	0xc4, 0x09, 0xb5, 0xad, 0x13, 0x5f, 0x4e, 0xa9, 0xa8, 0x14, 0x23, 0x21, 0x2a, 0x7e, 0x45, 0xb0,
+	0x4e, 0xa8, 0x65, 0x7b, 0x3e, 0x75, 0x9b, 0xcc, 0x76, 0x0e, 0x5d, 0x36, 0x61, 0x9e, 0x3e, 0x0a,
+	0xe6, 0xed, 0xdb, 0xfe, 0x88, 0x86, 0x7f, 0x58, 0x00, 0xac, 0xc2, 0x9a, 0x49, 0x3d, 0xc3, 0xb5,
+	0x27, 0xbe, 0xcd, 0x9c, 0xf0, 0x0f, 0xdf, 0xa4, 0xf0, 0x4b, 0x48, 0x8e, 0x43, 0x31, 0x72, 0x4c,
+	0x8d, 0x95, 0xd6, 0x6a, 0x85, 0xb2, 0xd8, 0xd1, 0x32, 0x5f, 0xcb, 0x70, 0x47, 0x17, 0x8a, 0x1b,
+	0xf1, 0xf3, 0x5f, 0x5b, 0x11, 0xb2, 0x08, 0xe2, 0xa3, 0x8f, 0x14, 0x7b, 0x20, 0xcd, 0xa5, 0x2c,
+	0x86, 0x7f, 0x33, 0x35, 0xfa, 0x8f, 0xd4, 0xc5, 0x8f, 0x90, 0x9b, 0xf7, 0xca, 0xd7, 0xea, 0xde,
+	0xcd, 0x3e, 0x85, 0x2c, 0x1f, 0x41, 0xb8, 0x8d, 0xd4, 0xe3, 0x2d, 0xa7, 0xc8, 0x12, 0x1b, 0xf6,
+	0xe4, 0x41, 0xa1, 0xcf, 0x2c, 0x6b, 0x44, 0xf9, 0xd5, 0x6b, 0x32, 0x67, 0x4a, 0x5d, 0xcf, 0x66,
+	0xf7, 0xff, 0xe7, 0x41, 0x5c, 0x90, 0x32, 0xdc, 0x6d, 0x01, 0xc4, 0x1d, 0xda, 0x7e, 0x0d, 0x2b,
+	0xfc, 0x4a, 0xe1, 0x1c, 0x3c, 0xec, 0x1e, 0x77, 0x34, 0x32, 0x38, 0xea, 0xf4, 0x0e, 0xb5, 0x66,
+	0xfb, 0x55, 0x5b, 0x6b, 0x49, 0x11, 0x2c, 0x41, 0x5a, 0xd0, 0x07, 0xdd, 0xd6, 0xd1, 0xbe, 0x26,
+	0x21, 0x8c, 0x21, 0x2b, 0x18, 0xed, 0x4d, 0x5f, 0x23, 0x9d, 0xfa, 0xbe, 0x14, 0xcd, 0xc7, 0x3f,
+	0x7d, 0x57, 0x22, 0xdb, 0x5f, 0x10, 0x48, 0xcb, 0x9b, 0x86, 0x1f, 0x43, 0xe1, 0x40, 0xeb, 0xd7,
+	0x5b, 0xf5, 0x7e, 0x7d, 0xa0, 0x75, 0x9a, 0xdd, 0x56, 0xbb, 0xb3, 0xb7, 0x54, 0xe3, 0x11, 0xc8,
+	0xb7, 0x5d, 0x7a, 0x7d, 0xd2, 0xee, 0xec, 0x49, 0x08, 0x17, 0x60, 0xf3, 0xb6, 0xb5, 0xf1, 0xb6,
+	0xaf, 0xf5, 0x76, 0x6b, 0x52, 0x14, 0x6f, 0x42, 0xee, 0xb6, 0x99, 0xd4, 0x8f, 0xa5, 0x98, 0x50,
+	0xd5, 0x68, 0x9c, 0x5f, 0x29, 0xe8, 0xe2, 0x4a, 0x41, 0x97, 0x57, 0x0a, 0xfa, 0x7c, 0xad, 0x44,
+	0x2e, 0xae, 0x95, 0xc8, 0xcf, 0x6b, 0x25, 0xf2, 0xae, 0x64, 0xd9, 0xfe, 0xc9, 0xfb, 0x61, 0xd9,
+	0x60, 0xe3, 0x4a, 0xf8, 0xa6, 0xf2, 0xef, 0xb4, 0xfa, 0xac, 0xf2, 0x21, 0x7c, 0x5f, 0xfd, 0xb3,
+	0x09, 0xf5, 0x86, 0x09, 0xfe, 0x2e, 0xee, 0xfe, 0x19, 0x00, 0x47, 0xc1, 0x43, 0x5f, 0x7b, 0x05,
+	0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -567,6 +745,84 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ERC20Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SymbolEncoding != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.SymbolEncoding))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RawSymbol) > 0 {
+		i -= len(m.RawSymbol)
+		copy(dAtA[i:], m.RawSymbol)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.RawSymbol)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NameEncoding != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.NameEncoding))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RawName) > 0 {
+		i -= len(m.RawName)
+		copy(dAtA[i:], m.RawName)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.RawName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -780,6 +1036,47 @@ func (m *TokenPair) Size() (n int) {
 	return n
 }
 
+func (m *ERC20Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovErc20(uint64(m.Decimals))
+	}
+	l = len(m.RawName)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.NameEncoding != 0 {
+		n += 1 + sovErc20(uint64(m.NameEncoding))
+	}
+	l = len(m.RawSymbol)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.SymbolEncoding != 0 {
+		n += 1 + sovErc20(uint64(m.SymbolEncoding))
+	}
+	if m.Height != 0 {
+		n += 1 + sovErc20(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *RegisterCoinProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1024,6 +1321,297 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *ERC20Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawName", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawName = append(m.RawName[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawName == nil {
+				m.RawName = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameEncoding", wireType)
+			}
+			m.NameEncoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NameEncoding |= MetadataEncoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawSymbol", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawSymbol = append(m.RawSymbol[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawSymbol == nil {
+				m.RawSymbol = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolEncoding", wireType)
+			}
+			m.SymbolEncoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SymbolEncoding |= MetadataEncoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -24,4 +24,10 @@ var (
 	ErrInvalidIBC               = errorsmod.Register(ModuleName, 14, "invalid IBC transaction")
 	ErrTokenPairOwnedByModule   = errorsmod.Register(ModuleName, 15, "token pair owned by module")
 	ErrNativeConversionDisabled = errorsmod.Register(ModuleName, 16, "native coins manual conversion is disabled")
+	ErrIncompatibleMetadata     = errorsmod.Register(ModuleName, 17, "incompatible ERC20 token metadata")
+	ErrInsufficientBacking      = errorsmod.Register(ModuleName, 18, "insufficient ERC20 backing of the token pair supply")
+	ErrPermitNotSupported       = errorsmod.Register(ModuleName, 19, "ERC20 contract does not support EIP-2612 permit")
+	ErrInvalidIBCHook           = errorsmod.Register(ModuleName, 20, "invalid IBC hook memo")
+	ErrIBCHookDisabled          = errorsmod.Register(ModuleName, 21, "IBC hook EVM calls are disabled")
+	ErrIBCHookCallFailed        = errorsmod.Register(ModuleName, 22, "IBC hook EVM call failed")
 )
@@ -11,15 +11,29 @@ import (
 
 // erc20 events
 const (
-	EventTypeConvertERC20           = "convert_erc20"
-	EventTypeRegisterERC20          = "register_erc20"
-	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
-	EventTypeRegisterERC20Extension = "register_erc20_extension"
+	EventTypeConvertERC20            = "convert_erc20"
+	EventTypeRegisterERC20           = "register_erc20"
+	EventTypeToggleTokenConversion   = "toggle_token_conversion" // #nosec
+	EventTypeRegisterERC20Extension  = "register_erc20_extension"
+	EventTypeUpdateTokenPairContract = "update_token_pair_contract"
+	EventTypeIBCHook                 = "ibc_hook"
+	EventTypeIBCCallback             = "ibc_callback"
+	EventTypeSweepDust               = "sweep_dust"
 
 	AttributeCoinSourceChannel = "source_channel"
 	AttributeKeyCosmosCoin     = "cosmos_coin"
 	AttributeKeyERC20Token     = "erc20_token" // #nosec
+	AttributeKeyOldERC20Token  = "old_erc20_token"
 	AttributeKeyReceiver       = "receiver"
+	AttributeKeySupply         = "supply"
+	AttributeKeyModuleBalance  = "module_balance"
+	AttributeKeyHookSender     = "hook_sender"
+	AttributeKeyHookContract   = "hook_contract"
+	AttributeKeyHookGasUsed    = "hook_gas_used"
+	AttributeKeyHookIBCSends   = "hook_ibc_sends"
+	AttributeKeyPacketSequence = "packet_sequence"
+	AttributeKeyPacketSuccess  = "packet_success"
+	AttributeKeyCallbackError  = "callback_error"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
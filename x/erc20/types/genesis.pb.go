@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -88,6 +89,18 @@ type Params struct {
 	// dynamic_precompiles defines the slice of hex addresses of the
 	// active precompiles that are used to interact with Bank coins as ERC20s
 	DynamicPrecompiles []string `protobuf:"bytes,4,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// ibc_hook_gas_cap defines the gas limit of the EVM calls triggered by the
+	// memo of incoming ICS-20 transfers and of the callbacks notifying contracts
+	// of the result of the packets they sent. A value of zero disables them.
+	IbcHookGasCap uint64 `protobuf:"varint,5,opt,name=ibc_hook_gas_cap,json=ibcHookGasCap,proto3" json:"ibc_hook_gas_cap,omitempty"`
+	// dust_sweep_epoch_identifier defines the epoch at the end of which the
+	// surplus of the ERC20 balances of the module account over the supply of the
+	// native ERC20 token pairs is swept to the community pool. An empty
+	// identifier disables the sweep.
+	DustSweepEpochIdentifier string `protobuf:"bytes,6,opt,name=dust_sweep_epoch_identifier,json=dustSweepEpochIdentifier,proto3" json:"dust_sweep_epoch_identifier,omitempty"`
+	// dust_sweep_threshold defines the minimum surplus of a token pair that is
+	// swept, so that the dust below it is left to the module account.
+	DustSweepThreshold cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=dust_sweep_threshold,json=dustSweepThreshold,proto3,customtype=cosmossdk.io/math.Int" json:"dust_sweep_threshold"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -144,6 +157,20 @@ func (m *Params) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetIbcHookGasCap() uint64 {
+	if m != nil {
+		return m.IbcHookGasCap
+	}
+	return 0
+}
+
+func (m *Params) GetDustSweepEpochIdentifier() string {
+	if m != nil {
+		return m.DustSweepEpochIdentifier
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
@@ -152,27 +179,35 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x14, 0xc7, 0x9b, 0x75, 0x8c, 0x99, 0x0e, 0xd1, 0x28, 0x32, 0x87, 0xd4, 0xb9, 0x53, 0x2f, 0x36,
-	0x6e, 0x7a, 0xf0, 0x28, 0x03, 0x11, 0x3c, 0x8d, 0xe9, 0xc9, 0xcb, 0xc8, 0xea, 0xa3, 0x06, 0xd7,
-	0x26, 0x24, 0xb1, 0xb8, 0x2f, 0xe0, 0x59, 0xbf, 0xd5, 0x8e, 0x3b, 0x7a, 0x12, 0xd9, 0xbe, 0x88,
-	0x2c, 0xa9, 0xe8, 0x76, 0x29, 0x8f, 0xff, 0xef, 0xf7, 0x7f, 0x0d, 0x0f, 0x1f, 0x41, 0x91, 0x09,
-	0x4d, 0x41, 0x25, 0xbd, 0x33, 0x5a, 0x74, 0x69, 0x0a, 0x39, 0x68, 0xae, 0x63, 0xa9, 0x84, 0x11,
-	0x64, 0xdb, 0xd2, 0xd8, 0xd2, 0xb8, 0xe8, 0xb6, 0x5a, 0x1b, 0xb6, 0x03, 0xd6, 0x6d, 0xed, 0xa7,
-	0x22, 0x15, 0x76, 0xa4, 0xab, 0xc9, 0xa5, 0x9d, 0x37, 0x84, 0x1b, 0x37, 0x6e, 0xe7, 0x9d, 0x61,
-	0x06, 0xc8, 0x05, 0xae, 0x49, 0xa6, 0x58, 0xa6, 0x9b, 0xa8, 0x8d, 0xa2, 0xa0, 0x77, 0x10, 0xaf,
-	0xff, 0x23, 0x1e, 0x58, 0xda, 0xaf, 0xce, 0xbe, 0x8e, 0xbd, 0x61, 0xe9, 0x92, 0x2b, 0x1c, 0x18,
-	0xf1, 0x0c, 0xf9, 0x48, 0x32, 0xae, 0x74, 0xb3, 0xd2, 0xf6, 0xa3, 0xa0, 0x77, 0xb8, 0x59, 0xbd,
-	0x5f, 0x29, 0x03, 0xc6, 0x55, 0xd9, 0xc6, 0xe6, 0x37, 0xd0, 0x9d, 0x0f, 0x84, 0x6b, 0x6e, 0x35,
-	0x39, 0xc1, 0x0d, 0xc8, 0xd9, 0x78, 0x02, 0x23, 0xdb, 0xb4, 0x0f, 0xa9, 0x0f, 0x03, 0x97, 0x5d,
-	0xaf, 0x22, 0x72, 0x8a, 0x49, 0xce, 0x0c, 0x2f, 0x60, 0x24, 0x15, 0x24, 0x22, 0x93, 0x7c, 0x02,
-	0xba, 0xe9, 0xb7, 0xfd, 0x68, 0x6b, 0xb8, 0xeb, 0xc8, 0xe0, 0x0f, 0x10, 0x8a, 0xf7, 0x1e, 0xa7,
-	0x39, 0xcb, 0x78, 0xb2, 0xe6, 0x57, 0xad, 0x4f, 0x4a, 0xf4, 0xaf, 0x70, 0x5b, 0xad, 0x57, 0x76,
-	0xfc, 0x7e, 0x7f, 0xb6, 0x08, 0xd1, 0x7c, 0x11, 0xa2, 0xef, 0x45, 0x88, 0xde, 0x97, 0xa1, 0x37,
-	0x5f, 0x86, 0xde, 0xe7, 0x32, 0xf4, 0x1e, 0xa2, 0x94, 0x9b, 0xa7, 0x97, 0x71, 0x9c, 0x88, 0x8c,
-	0x96, 0x37, 0xb7, 0xdf, 0xa2, 0x7b, 0x49, 0x5f, 0xcb, 0xfb, 0x9b, 0xa9, 0x04, 0x3d, 0xae, 0xd9,
-	0x3b, 0x9f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xa3, 0xe9, 0x91, 0xc9, 0x01, 0x00, 0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x24, 0x84, 0x76, 0x53, 0x50, 0x59, 0x0a, 0x32, 0x01, 0x5c, 0xd3, 0x0b, 0xbe,
+	0x60, 0x93, 0xc0, 0x85, 0x03, 0x12, 0x0a, 0xaa, 0x4a, 0xb9, 0x10, 0xb9, 0x3d, 0x71, 0xb1, 0xd6,
+	0xeb, 0xc1, 0x5e, 0x25, 0xf6, 0xac, 0xbc, 0x5b, 0x43, 0x5f, 0x80, 0x33, 0x8f, 0x55, 0x71, 0xea,
+	0x11, 0x71, 0xa8, 0x50, 0xf2, 0x22, 0xc8, 0x6b, 0xf7, 0x5f, 0x2e, 0xd6, 0xe8, 0xfb, 0xfd, 0xbe,
+	0xb1, 0x65, 0x0d, 0x79, 0x06, 0x55, 0x8e, 0x2a, 0x80, 0x92, 0x4f, 0x5e, 0x07, 0xd5, 0x38, 0x48,
+	0xa1, 0x00, 0x25, 0x94, 0x2f, 0x4b, 0xd4, 0x48, 0xef, 0x1b, 0xea, 0x1b, 0xea, 0x57, 0xe3, 0xd1,
+	0x68, 0xcd, 0x6e, 0x80, 0x71, 0x47, 0x3b, 0x29, 0xa6, 0x68, 0xc6, 0xa0, 0x9e, 0x9a, 0x74, 0xef,
+	0xa7, 0x45, 0xb6, 0x0e, 0x9a, 0x9d, 0x47, 0x9a, 0x69, 0xa0, 0x6f, 0xc9, 0x40, 0xb2, 0x92, 0xe5,
+	0xca, 0xb6, 0x5c, 0xcb, 0x1b, 0x4e, 0x1e, 0xfb, 0xb7, 0xdf, 0xe1, 0xcf, 0x0c, 0x9d, 0xf6, 0xcf,
+	0x2e, 0x76, 0x3b, 0x61, 0xeb, 0xd2, 0x0f, 0x64, 0xa8, 0x71, 0x0e, 0x45, 0x24, 0x99, 0x28, 0x95,
+	0xdd, 0x75, 0x7b, 0xde, 0x70, 0xf2, 0x64, 0xbd, 0x7a, 0x5c, 0x2b, 0x33, 0x26, 0xca, 0xb6, 0x4d,
+	0xf4, 0x65, 0xa0, 0xf6, 0x7e, 0x77, 0xc9, 0xa0, 0x59, 0x4d, 0x5f, 0x90, 0x2d, 0x28, 0x58, 0xbc,
+	0x80, 0xc8, 0x34, 0xcd, 0x87, 0x6c, 0x84, 0xc3, 0x26, 0xdb, 0xaf, 0x23, 0xfa, 0x8a, 0xd0, 0x82,
+	0x69, 0x51, 0x41, 0x24, 0x4b, 0xe0, 0x98, 0x4b, 0xb1, 0x00, 0x65, 0xf7, 0xdc, 0x9e, 0xb7, 0x19,
+	0x3e, 0x68, 0xc8, 0xec, 0x1a, 0xd0, 0x80, 0x3c, 0x4c, 0x4e, 0x0b, 0x96, 0x0b, 0x7e, 0xcb, 0xef,
+	0x1b, 0x9f, 0xb6, 0xe8, 0x66, 0xe1, 0x25, 0xd9, 0x16, 0x31, 0x8f, 0x32, 0xc4, 0x79, 0x94, 0x32,
+	0x15, 0x71, 0x26, 0xed, 0x3b, 0xae, 0xe5, 0xf5, 0xc3, 0x7b, 0x22, 0xe6, 0x9f, 0x10, 0xe7, 0x07,
+	0x4c, 0x7d, 0x64, 0x92, 0xbe, 0x27, 0x4f, 0x93, 0x13, 0xa5, 0x23, 0xf5, 0x1d, 0x40, 0x46, 0x20,
+	0x91, 0x67, 0x91, 0x48, 0xa0, 0xd0, 0xe2, 0x9b, 0x80, 0xd2, 0x1e, 0xb8, 0x96, 0xb7, 0x19, 0xda,
+	0xb5, 0x72, 0x54, 0x1b, 0xfb, 0xb5, 0x70, 0x78, 0xc5, 0xe9, 0x17, 0xb2, 0x73, 0xa3, 0xae, 0xb3,
+	0x12, 0x54, 0x86, 0x8b, 0xc4, 0xbe, 0x5b, 0xf7, 0xa6, 0xcf, 0xeb, 0xbf, 0xf4, 0xf7, 0x62, 0xf7,
+	0x11, 0x47, 0x95, 0xa3, 0x52, 0xc9, 0xdc, 0x17, 0x18, 0xe4, 0x4c, 0x67, 0xfe, 0x61, 0xa1, 0x43,
+	0x7a, 0xb5, 0xf6, 0xf8, 0xb2, 0xf8, 0xb9, 0xbf, 0xd1, 0xdd, 0xee, 0x4d, 0xa7, 0x67, 0x4b, 0xc7,
+	0x3a, 0x5f, 0x3a, 0xd6, 0xbf, 0xa5, 0x63, 0xfd, 0x5a, 0x39, 0x9d, 0xf3, 0x95, 0xd3, 0xf9, 0xb3,
+	0x72, 0x3a, 0x5f, 0xbd, 0x54, 0xe8, 0xec, 0x24, 0xf6, 0x39, 0xe6, 0x41, 0x7b, 0x2c, 0xe6, 0x59,
+	0x8d, 0xdf, 0x05, 0x3f, 0xda, 0xc3, 0xd1, 0xa7, 0x12, 0x54, 0x3c, 0x30, 0x07, 0xf2, 0xe6, 0xff,
+	0x00, 0x00, 0xad, 0x29, 0xe2, 0x82, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -242,6 +277,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.DustSweepThreshold.Size()
+		i -= size
+		if _, err := m.DustSweepThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DustSweepEpochIdentifier) > 0 {
+		i -= len(m.DustSweepEpochIdentifier)
+		copy(dAtA[i:], m.DustSweepEpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DustSweepEpochIdentifier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.IbcHookGasCap != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IbcHookGasCap))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -322,6 +379,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.IbcHookGasCap != 0 {
+		n += 1 + sovGenesis(uint64(m.IbcHookGasCap))
+	}
+	l = len(m.DustSweepEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.DustSweepThreshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -561,6 +627,91 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcHookGasCap", wireType)
+			}
+			m.IbcHookGasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcHookGasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustSweepEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustSweepEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustSweepThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DustSweepThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,64 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// IBCCallbackMethod is the method called on the contracts that sent an
+	// ICS-20 transfer once the packet is acknowledged or timed out.
+	IBCCallbackMethod = "onPacketResult"
+	// IBCCallbackPruneLimit is the maximum number of callbacks of a channel
+	// checked for orphans when a new callback is registered on it.
+	IBCCallbackPruneLimit = 10
+)
+
+// ibcCallbackABIJSON is the interface of the contracts notified of the
+// result of their ICS-20 transfers.
+const ibcCallbackABIJSON = `[
+	{"type":"function","name":"onPacketResult","stateMutability":"nonpayable","inputs":[{"name":"sequence","type":"uint64"},{"name":"success","type":"bool"},{"name":"ack","type":"bytes"}],"outputs":[]}
+]`
+
+// IBCCallbackABI is the parsed IBC callback interface
+var IBCCallbackABI abi.ABI
+
+func init() {
+	var err error
+	if IBCCallbackABI, err = abi.JSON(strings.NewReader(ibcCallbackABIJSON)); err != nil {
+		panic(err)
+	}
+}
+
+// IBCCallback defines the contract to notify of the result of an outgoing
+// ICS-20 packet.
+type IBCCallback struct {
+	// Contract is the contract that sent the transfer
+	Contract common.Address
+	// GasLimit is the gas limit of the callback call
+	GasLimit uint64
+}
+
+// Bytes returns the store encoding of the callback
+func (c IBCCallback) Bytes() []byte {
+	return binary.BigEndian.AppendUint64(c.Contract.Bytes(), c.GasLimit)
+}
+
+// IBCCallbackFromBytes decodes a callback from its store encoding
+func IBCCallbackFromBytes(bz []byte) (IBCCallback, error) {
+	if len(bz) != common.AddressLength+8 {
+		return IBCCallback{}, fmt.Errorf("invalid IBC callback length %d", len(bz))
+	}
+
+	return IBCCallback{
+		Contract: common.BytesToAddress(bz[:common.AddressLength]),
+		GasLimit: binary.BigEndian.Uint64(bz[common.AddressLength:]),
+	}, nil
+}
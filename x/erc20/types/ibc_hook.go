@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// IBCHookMemoKey is the key of the ICS-20 memo JSON object that holds the
+	// EVM call to execute once the transferred funds are credited.
+	IBCHookMemoKey = "evm"
+	// IBCHookSenderPrefix is the address derivation prefix of the accounts
+	// that receive the funds and execute the IBC hook calls.
+	IBCHookSenderPrefix = ModuleName + "-ibc-hook"
+)
+
+// IBCHook defines an EVM call requested by the memo of an incoming ICS-20
+// transfer.
+type IBCHook struct {
+	// Sender is the account derived from the packet sender that receives the
+	// funds and executes the call
+	Sender sdk.AccAddress
+	// Contract is the called contract
+	Contract common.Address
+	// Data is the call input
+	Data []byte
+}
+
+// ibcHookMemo is the JSON representation of the IBC hook memo:
+//
+//	{"evm": {"contract": "0x...", "data": "0x..."}}
+type ibcHookMemo struct {
+	Contract string `json:"contract"`
+	Data     string `json:"data"`
+}
+
+// ParseIBCHookMemo returns the EVM call of an ICS-20 memo. It returns nil if the
+// memo is not a JSON object or it doesn't contain the IBCHookMemoKey, so that
+// transfers with unrelated memos are processed as usual.
+func ParseIBCHookMemo(memo string) (*IBCHook, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil
+	}
+
+	raw, found := fields[IBCHookMemoKey]
+	if !found {
+		return nil, nil
+	}
+
+	var hookMemo ibcHookMemo
+	if err := json.Unmarshal(raw, &hookMemo); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidIBCHook, err.Error())
+	}
+
+	if !common.IsHexAddress(hookMemo.Contract) {
+		return nil, errorsmod.Wrapf(ErrInvalidIBCHook, "invalid contract address %q", hookMemo.Contract)
+	}
+
+	var data []byte
+	if hookMemo.Data != "" {
+		var err error
+		if data, err = hexutil.Decode(hookMemo.Data); err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidIBCHook, "invalid call data: %s", err)
+		}
+	}
+
+	return &IBCHook{
+		Contract: common.HexToAddress(hookMemo.Contract),
+		Data:     data,
+	}, nil
+}
+
+// DeriveIBCHookSender returns the account that executes the IBC hook calls of
+// the given counterparty sender on the given destination channel.
+//
+// The address is the truncated ADR-028 hash of the channel and the sender so that
+// a sender of a different chain can't impersonate it.
+func DeriveIBCHookSender(channel, sender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Hash(IBCHookSenderPrefix, []byte(channel+"/"+sender))[:common.AddressLength])
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIBCHookMemo(t *testing.T) {
+	contract := common.HexToAddress("0x1D54EcB8583Ca25895c512A8308389fFD581F9c9")
+
+	testCases := []struct {
+		name        string
+		memo        string
+		expHook     *types.IBCHook
+		errContains string
+	}{
+		{"empty memo", "", nil, ""},
+		{"plain text memo", "thanks for the tokens", nil, ""},
+		{"JSON memo without evm key", `{"wasm":{"contract":"osmo1"}}`, nil, ""},
+		{
+			"valid hook",
+			`{"evm":{"contract":"0x1D54EcB8583Ca25895c512A8308389fFD581F9c9","data":"0xa9059cbb"}}`,
+			&types.IBCHook{Contract: contract, Data: []byte{0xa9, 0x05, 0x9c, 0xbb}},
+			"",
+		},
+		{
+			"valid hook without data",
+			`{"evm":{"contract":"0x1D54EcB8583Ca25895c512A8308389fFD581F9c9"}}`,
+			&types.IBCHook{Contract: contract},
+			"",
+		},
+		{"fail - malformed hook", `{"evm":"0x1D54EcB8583Ca25895c512A8308389fFD581F9c9"}`, nil, "invalid IBC hook memo"},
+		{"fail - invalid contract", `{"evm":{"contract":"evmos1","data":"0x"}}`, nil, "invalid contract address"},
+		{"fail - invalid data", `{"evm":{"contract":"0x1D54EcB8583Ca25895c512A8308389fFD581F9c9","data":"a9059cbb"}}`, nil, "invalid call data"},
+	}
+
+	for _, tc := range testCases {
+		hook, err := types.ParseIBCHookMemo(tc.memo)
+		if tc.errContains != "" {
+			require.ErrorContains(t, err, tc.errContains, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expHook, hook, tc.name)
+	}
+}
+
+func TestDeriveIBCHookSender(t *testing.T) {
+	sender := "osmo1qql8ag4cluz6r4dz28p3w00dnc9w8ueuhnecd2"
+
+	addr := types.DeriveIBCHookSender("channel-0", sender)
+	require.Len(t, addr, common.AddressLength)
+	require.Equal(t, addr, types.DeriveIBCHookSender("channel-0", sender))
+	require.NotEqual(t, addr, types.DeriveIBCHookSender("channel-1", sender))
+	require.NotEqual(t, addr, types.DeriveIBCHookSender("channel-0", "osmo1lw2jvqdc3n5l0hcmq4jtwmu6zgd7dyyj3lz4gf"))
+}
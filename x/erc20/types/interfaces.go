@@ -30,6 +30,18 @@ type StakingKeeper interface {
 	BondDenom(ctx sdk.Context) string
 }
 
+// ChannelKeeper defines the expected IBC channel keeper interface used to
+// prune the IBC callbacks of packets that are no longer pending.
+type ChannelKeeper interface {
+	GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte
+}
+
+// DistributionKeeper defines the expected distribution keeper interface used
+// to sweep the surplus of the module held ERC20 tokens to the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // EVMKeeper defines the expected EVM keeper interface used on erc20
 type EVMKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
@@ -4,6 +4,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -33,6 +34,8 @@ const (
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
 	prefixSTRv2Addresses
+	prefixIBCCallback
+	prefixERC20Metadata
 )
 
 // KVStore key prefixes
@@ -41,4 +44,17 @@ var (
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
 	KeyPrefixSTRv2Addresses   = []byte{prefixSTRv2Addresses}
+	KeyPrefixIBCCallback      = []byte{prefixIBCCallback}
+	KeyPrefixERC20Metadata    = []byte{prefixERC20Metadata}
 )
+
+// IBCCallbackChannelKey returns the key prefix of the IBC callbacks of the
+// packets sent on the given port and channel.
+func IBCCallbackChannelKey(portID, channelID string) []byte {
+	return append(KeyPrefixIBCCallback, []byte(portID+"/"+channelID+"/")...)
+}
+
+// IBCCallbackKey returns the key of the IBC callback of the given packet.
+func IBCCallbackKey(portID, channelID string, sequence uint64) []byte {
+	return append(IBCCallbackChannelKey(portID, channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// ERC20MetadataCallGasLimit is the gas limit of each call retrieving the
+	// metadata of an ERC20 contract.
+	ERC20MetadataCallGasLimit = 100_000
+	// ERC20MetadataQueryLimit is the maximum number of ERC20 contracts whose
+	// metadata is retrieved from the EVM by a query, if it isn't cached.
+	ERC20MetadataQueryLimit = 10
+)
+
+var metadataStringArgs abi.Arguments
+
+func init() {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	metadataStringArgs = abi.Arguments{{Type: stringType}}
+}
+
+// DecodeERC20MetadataString decodes the return data of the name() or symbol()
+// method of an ERC20 contract. The return data is also returned as raw bytes if
+// it isn't an ABI encoded string, e.g. for the tokens returning a bytes32.
+func DecodeERC20MetadataString(ret []byte) (string, []byte, MetadataEncoding) {
+	if len(ret) == 0 {
+		return "", nil, METADATA_ENCODING_UNSPECIFIED
+	}
+
+	if unpacked, err := metadataStringArgs.Unpack(ret); err == nil && len(unpacked) == 1 {
+		if value, ok := unpacked[0].(string); ok && utf8.ValidString(value) {
+			return value, nil, METADATA_ENCODING_STRING
+		}
+	}
+
+	if len(ret) == 32 {
+		value := bytes.TrimRight(ret, "\x00")
+		if utf8.Valid(value) && !bytes.Contains(value, []byte{0}) {
+			return string(value), ret, METADATA_ENCODING_BYTES32
+		}
+	}
+
+	return "", ret, METADATA_ENCODING_RAW
+}
+
+// DecodeERC20MetadataDecimals decodes the return data of the decimals() method
+// of an ERC20 contract, which is either an uint8 or an uint256 word. It returns
+// false if the value isn't a valid number of decimals.
+func DecodeERC20MetadataDecimals(ret []byte) (uint32, bool) {
+	if len(ret) < 32 {
+		return 0, false
+	}
+
+	decimals := new(big.Int).SetBytes(ret[:32])
+	if !decimals.IsUint64() || decimals.Uint64() > 255 {
+		return 0, false
+	}
+
+	return uint32(decimals.Uint64()), true
+}
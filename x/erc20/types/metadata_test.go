@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/contracts"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeERC20MetadataString(t *testing.T) {
+	abiString, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Methods["name"].Outputs.Pack("Coin Token")
+	require.NoError(t, err)
+
+	bytes32 := common.RightPadBytes([]byte("MKR"), 32)
+	invalidBytes32 := common.RightPadBytes([]byte{'M', 0, 'K'}, 32)
+
+	testCases := []struct {
+		name        string
+		ret         []byte
+		expValue    string
+		expRaw      []byte
+		expEncoding types.MetadataEncoding
+	}{
+		{"empty return data", nil, "", nil, types.METADATA_ENCODING_UNSPECIFIED},
+		{"ABI encoded string", abiString, "Coin Token", nil, types.METADATA_ENCODING_STRING},
+		{"bytes32", bytes32, "MKR", bytes32, types.METADATA_ENCODING_BYTES32},
+		{"bytes32 with inner null bytes", invalidBytes32, "", invalidBytes32, types.METADATA_ENCODING_RAW},
+		{"bytes32 with invalid UTF-8", common.RightPadBytes([]byte{0xff}, 32), "", common.RightPadBytes([]byte{0xff}, 32), types.METADATA_ENCODING_RAW},
+		{"unexpected length", []byte{0x01, 0x02}, "", []byte{0x01, 0x02}, types.METADATA_ENCODING_RAW},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, raw, encoding := types.DecodeERC20MetadataString(tc.ret)
+			require.Equal(t, tc.expValue, value)
+			require.Equal(t, tc.expRaw, raw)
+			require.Equal(t, tc.expEncoding, encoding)
+		})
+	}
+}
+
+func TestDecodeERC20MetadataDecimals(t *testing.T) {
+	testCases := []struct {
+		name        string
+		ret         []byte
+		expDecimals uint32
+		expOk       bool
+	}{
+		{"uint8", common.LeftPadBytes([]byte{18}, 32), 18, true},
+		{"uint256 word", common.LeftPadBytes(big.NewInt(6).Bytes(), 32), 6, true},
+		{"overflow", common.LeftPadBytes(big.NewInt(256).Bytes(), 32), 0, false},
+		{"short return data", []byte{18}, 0, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decimals, ok := types.DecodeERC20MetadataDecimals(tc.ret)
+			require.Equal(t, tc.expOk, ok)
+			require.Equal(t, tc.expDecimals, decimals)
+		})
+	}
+}
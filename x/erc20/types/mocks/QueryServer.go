@@ -104,6 +104,36 @@ func (_m *QueryServer) TokenPairs(_a0 context.Context, _a1 *types.QueryTokenPair
 	return r0, r1
 }
 
+// WrappedNativeToken provides a mock function with given fields: _a0, _a1
+func (_m *QueryServer) WrappedNativeToken(_a0 context.Context, _a1 *types.QueryWrappedNativeTokenRequest) (*types.QueryWrappedNativeTokenResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WrappedNativeToken")
+	}
+
+	var r0 *types.QueryWrappedNativeTokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryWrappedNativeTokenRequest) (*types.QueryWrappedNativeTokenResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryWrappedNativeTokenRequest) *types.QueryWrappedNativeTokenResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryWrappedNativeTokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryWrappedNativeTokenRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewQueryServer creates a new instance of QueryServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQueryServer(t interface {
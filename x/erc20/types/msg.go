@@ -10,11 +10,14 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v19/types"
 )
 
 var (
 	_ sdk.Msg = &MsgConvertERC20{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgUpdateTokenPairContract{}
 )
 
 const (
@@ -52,6 +55,17 @@ func (msg MsgConvertERC20) ValidateBasic() error {
 	if !common.IsHexAddress(msg.Sender) {
 		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
 	}
+	if msg.Permit != nil {
+		if err := msg.Permit.ValidateBasic(); err != nil {
+			return err
+		}
+		if msg.Permit.Value.LT(msg.Amount) {
+			return errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest,
+				"permit value %s is lower than the conversion amount %s", msg.Permit.Value, msg.Amount,
+			)
+		}
+	}
 	return nil
 }
 
@@ -85,3 +99,35 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgUpdateTokenPairContract message.
+func (m *MsgUpdateTokenPairContract) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateTokenPairContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := evmostypes.ValidateAddress(m.Token); err != nil {
+		if err := sdk.ValidateDenom(m.Token); err != nil {
+			return err
+		}
+	}
+
+	if err := evmostypes.ValidateNonZeroAddress(m.NewContractAddress); err != nil {
+		return errorsmod.Wrap(err, "new ERC20 contract address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateTokenPairContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
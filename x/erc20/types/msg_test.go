@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	stdmath "math"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -121,7 +122,7 @@ func (suite *MsgsTestSuite) TestMsgConvertERC20() {
 	}
 
 	for i, tc := range testCases {
-		tx := types.MsgConvertERC20{tc.contract, tc.amount, tc.receiver, tc.sender}
+		tx := types.MsgConvertERC20{tc.contract, tc.amount, tc.receiver, tc.sender, nil}
 		err := tx.ValidateBasic()
 
 		if tc.expectPass {
@@ -132,6 +133,80 @@ func (suite *MsgsTestSuite) TestMsgConvertERC20() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgConvertERC20Permit() {
+	validPermit := func() *types.ERC20Permit {
+		return &types.ERC20Permit{
+			Value:    math.NewInt(100),
+			Deadline: 1_000_000,
+			V:        27,
+			R:        make([]byte, 32),
+			S:        make([]byte, 32),
+		}
+	}
+
+	testCases := []struct {
+		msg        string
+		malleate   func(permit *types.ERC20Permit)
+		expectPass bool
+	}{
+		{
+			"permit value lower than amount",
+			func(permit *types.ERC20Permit) { permit.Value = math.NewInt(99) },
+			false,
+		},
+		{
+			"nil permit value",
+			func(permit *types.ERC20Permit) { permit.Value = math.Int{} },
+			false,
+		},
+		{
+			"invalid v value",
+			func(permit *types.ERC20Permit) { permit.V = 256 },
+			false,
+		},
+		{
+			"invalid r length",
+			func(permit *types.ERC20Permit) { permit.R = []byte{1} },
+			false,
+		},
+		{
+			"invalid s length",
+			func(permit *types.ERC20Permit) { permit.S = nil },
+			false,
+		},
+		{
+			"deadline overflow",
+			func(permit *types.ERC20Permit) { permit.Deadline = stdmath.MaxUint64 },
+			false,
+		},
+		{
+			"msg convert erc20 with permit - pass",
+			func(*types.ERC20Permit) {},
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		permit := validPermit()
+		tc.malleate(permit)
+
+		tx := types.NewMsgConvertERC20(
+			math.NewInt(100),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+			utiltx.GenerateAddress(),
+			utiltx.GenerateAddress(),
+		)
+		tx.Permit = permit
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
 func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 	testCases := []struct {
 		name      string
@@ -167,3 +242,80 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgUpdateTokenPairContractValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contract := utiltx.GenerateAddress().String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateTokenPairContract
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpdateTokenPairContract{
+				Authority:          "invalid",
+				Token:              utiltx.GenerateAddress().String(),
+				NewContractAddress: contract,
+			},
+			false,
+		},
+		{
+			"fail - invalid token",
+			&types.MsgUpdateTokenPairContract{
+				Authority:          authority,
+				Token:              "(invalid)",
+				NewContractAddress: contract,
+			},
+			false,
+		},
+		{
+			"fail - invalid new contract address",
+			&types.MsgUpdateTokenPairContract{
+				Authority:          authority,
+				Token:              utiltx.GenerateAddress().String(),
+				NewContractAddress: "0x",
+			},
+			false,
+		},
+		{
+			"fail - zero new contract address",
+			&types.MsgUpdateTokenPairContract{
+				Authority:          authority,
+				Token:              utiltx.GenerateAddress().String(),
+				NewContractAddress: common.Address{}.String(),
+			},
+			false,
+		},
+		{
+			"pass - token as contract address",
+			&types.MsgUpdateTokenPairContract{
+				Authority:          authority,
+				Token:              utiltx.GenerateAddress().String(),
+				NewContractAddress: contract,
+			},
+			true,
+		},
+		{
+			"pass - token as denom",
+			&types.MsgUpdateTokenPairContract{
+				Authority:          authority,
+				Token:              types.CreateDenom(utiltx.GenerateAddress().String()),
+				NewContractAddress: contract,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
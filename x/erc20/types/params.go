@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"slices"
 
+	"cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v19/types"
+	epochstypes "github.com/evmos/evmos/v19/x/epochs/types"
 )
 
 const (
@@ -24,11 +26,17 @@ var (
 	ParamStoreKeyEnableErc20        = []byte("EnableErc20")
 	ParamStoreKeyDynamicPrecompiles = []byte("DynamicPrecompiles")
 	ParamStoreKeyNativePrecompiles  = []byte("NativePrecompiles")
+	ParamStoreKeyIBCHookGasCap      = []byte("IBCHookGasCap")
+	ParamStoreKeyDustSweepEpoch     = []byte("DustSweepEpochIdentifier")
+	ParamStoreKeyDustSweepThreshold = []byte("DustSweepThreshold")
 	// DefaultNativePrecompiles defines the default precompiles for the wrapped native coin
 	// NOTE: If you modify this, make sure you modify it on the local_node genesis script as well
 	DefaultNativePrecompiles = []string{WEVMOSContractMainnet}
 	// DefaultDynamicPrecompiles defines the default active dynamic precompiles
 	DefaultDynamicPrecompiles []string
+	// DefaultIBCHookGasCap defines the default gas limit of the EVM calls triggered
+	// by the memo of incoming ICS-20 transfers
+	DefaultIBCHookGasCap uint64 = 1_000_000
 )
 
 // NewParams creates a new Params object
@@ -43,6 +51,7 @@ func NewParams(
 		EnableErc20:        enableErc20,
 		NativePrecompiles:  nativePrecompiles,
 		DynamicPrecompiles: dynamicPrecompiles,
+		DustSweepThreshold: math.ZeroInt(),
 	}
 }
 
@@ -51,6 +60,8 @@ func DefaultParams() Params {
 		EnableErc20:        true,
 		NativePrecompiles:  DefaultNativePrecompiles,
 		DynamicPrecompiles: DefaultDynamicPrecompiles,
+		IbcHookGasCap:      DefaultIBCHookGasCap,
+		DustSweepThreshold: math.ZeroInt(),
 	}
 }
 
@@ -80,7 +91,20 @@ func (p Params) Validate() error {
 
 	combined := dpAddrs
 	combined = append(combined, npAddrs...)
-	return validatePrecompilesUniqueness(combined)
+	if err := validatePrecompilesUniqueness(combined); err != nil {
+		return err
+	}
+
+	if p.DustSweepEpochIdentifier != "" {
+		if err := epochstypes.ValidateEpochIdentifierString(p.DustSweepEpochIdentifier); err != nil {
+			return fmt.Errorf("invalid dust sweep epoch identifier: %w", err)
+		}
+	}
+
+	if !p.DustSweepThreshold.IsNil() && p.DustSweepThreshold.IsNegative() {
+		return fmt.Errorf("dust sweep threshold cannot be negative: %s", p.DustSweepThreshold)
+	}
+	return nil
 }
 
 // ValidatePrecompiles checks if the precompile addresses are valid and unique.
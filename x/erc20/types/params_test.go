@@ -4,7 +4,9 @@ import (
 	"slices"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
+	epochstypes "github.com/evmos/evmos/v19/x/epochs/types"
 	"github.com/evmos/evmos/v19/x/erc20/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -131,6 +133,37 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			true,
 			"precompiles need to be sorted",
 		},
+		{
+			"valid dust sweep",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.DustSweepEpochIdentifier = epochstypes.WeekEpochID
+				params.DustSweepThreshold = math.NewInt(1000)
+				return params
+			},
+			false,
+			"",
+		},
+		{
+			"invalid dust sweep epoch identifier",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.DustSweepEpochIdentifier = " "
+				return params
+			},
+			true,
+			"invalid dust sweep epoch identifier",
+		},
+		{
+			"negative dust sweep threshold",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.DustSweepThreshold = math.NewInt(-1)
+				return params
+			},
+			true,
+			"dust sweep threshold cannot be negative",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,48 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"math"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// erc20PermitABIJSON is the subset of the EIP-2612 interface used by the
+// module to convert tokens with a permit instead of a prior allowance.
+const erc20PermitABIJSON = `[
+	{"type":"function","name":"DOMAIN_SEPARATOR","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"function","name":"permit","stateMutability":"nonpayable","inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"},{"name":"value","type":"uint256"},{"name":"deadline","type":"uint256"},{"name":"v","type":"uint8"},{"name":"r","type":"bytes32"},{"name":"s","type":"bytes32"}],"outputs":[]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+// ERC20PermitABI is the parsed EIP-2612 permit interface
+var ERC20PermitABI abi.ABI
+
+func init() {
+	var err error
+	if ERC20PermitABI, err = abi.JSON(strings.NewReader(erc20PermitABIJSON)); err != nil {
+		panic(err)
+	}
+}
+
+// ValidateBasic performs a stateless validation of the permit arguments
+func (p ERC20Permit) ValidateBasic() error {
+	if p.Value.IsNil() || p.Value.IsNegative() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid permit value %s", p.Value)
+	}
+	if p.Deadline > math.MaxInt64 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid permit deadline %d", p.Deadline)
+	}
+	if p.V > math.MaxUint8 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid permit signature v value %d", p.V)
+	}
+	if len(p.R) != 32 || len(p.S) != 32 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "permit signature r and s values must be 32 bytes")
+	}
+	return nil
+}
@@ -84,6 +84,9 @@ type QueryTokenPairsResponse struct {
 	TokenPairs []TokenPair `protobuf:"bytes,1,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// metadata of the ERC20 contracts of the token pairs, omitting the contracts
+	// whose metadata is neither cached nor retrieved within the query limit
+	Metadata []ERC20Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *QueryTokenPairsResponse) Reset()         { *m = QueryTokenPairsResponse{} }
@@ -133,6 +136,13 @@ func (m *QueryTokenPairsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryTokenPairsResponse) GetMetadata() []ERC20Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // QueryTokenPairRequest is the request type for the Query/TokenPair RPC method.
 type QueryTokenPairRequest struct {
 	// token identifier can be either the hex contract address of the ERC20 or the
@@ -185,6 +195,8 @@ func (m *QueryTokenPairRequest) GetToken() string {
 type QueryTokenPairResponse struct {
 	// token_pairs returns the info about a registered token pair for the erc20 module
 	TokenPair TokenPair `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair"`
+	// metadata of the ERC20 contract of the token pair, if available
+	Metadata *ERC20Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *QueryTokenPairResponse) Reset()         { *m = QueryTokenPairResponse{} }
@@ -227,6 +239,13 @@ func (m *QueryTokenPairResponse) GetTokenPair() TokenPair {
 	return TokenPair{}
 }
 
+func (m *QueryTokenPairResponse) GetMetadata() *ERC20Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -311,6 +330,92 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryWrappedNativeTokenRequest is the request type for the
+// Query/WrappedNativeToken RPC method.
+type QueryWrappedNativeTokenRequest struct {
+}
+
+func (m *QueryWrappedNativeTokenRequest) Reset()         { *m = QueryWrappedNativeTokenRequest{} }
+func (m *QueryWrappedNativeTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWrappedNativeTokenRequest) ProtoMessage()    {}
+func (*QueryWrappedNativeTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{6}
+}
+func (m *QueryWrappedNativeTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrappedNativeTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrappedNativeTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrappedNativeTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrappedNativeTokenRequest.Merge(m, src)
+}
+func (m *QueryWrappedNativeTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrappedNativeTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrappedNativeTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrappedNativeTokenRequest proto.InternalMessageInfo
+
+// QueryWrappedNativeTokenResponse is the response type for the
+// Query/WrappedNativeToken RPC method.
+type QueryWrappedNativeTokenResponse struct {
+	// token_pair is the token pair of the native precompile that wraps the EVM
+	// denomination, whose ERC20 address is the wrapped native token address
+	TokenPair TokenPair `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair"`
+}
+
+func (m *QueryWrappedNativeTokenResponse) Reset()         { *m = QueryWrappedNativeTokenResponse{} }
+func (m *QueryWrappedNativeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWrappedNativeTokenResponse) ProtoMessage()    {}
+func (*QueryWrappedNativeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{7}
+}
+func (m *QueryWrappedNativeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrappedNativeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrappedNativeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrappedNativeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrappedNativeTokenResponse.Merge(m, src)
+}
+func (m *QueryWrappedNativeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrappedNativeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrappedNativeTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrappedNativeTokenResponse proto.InternalMessageInfo
+
+func (m *QueryWrappedNativeTokenResponse) GetTokenPair() TokenPair {
+	if m != nil {
+		return m.TokenPair
+	}
+	return TokenPair{}
+}
+
 func init() {
 	proto.RegisterType((*QueryTokenPairsRequest)(nil), "evmos.erc20.v1.QueryTokenPairsRequest")
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "evmos.erc20.v1.QueryTokenPairsResponse")
@@ -318,44 +423,53 @@ func init() {
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "evmos.erc20.v1.QueryTokenPairResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.erc20.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryWrappedNativeTokenRequest)(nil), "evmos.erc20.v1.QueryWrappedNativeTokenRequest")
+	proto.RegisterType((*QueryWrappedNativeTokenResponse)(nil), "evmos.erc20.v1.QueryWrappedNativeTokenResponse")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/query.proto", fileDescriptor_fba814bce17cabdf) }
 
 var fileDescriptor_fba814bce17cabdf = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xef, 0xec, 0xba, 0x85, 0xbe, 0x82, 0x87, 0xb1, 0xd6, 0x1a, 0x35, 0x2e, 0x29, 0xdb, 0x2d,
-	0x8a, 0x33, 0xa6, 0x7a, 0xf0, 0x24, 0xd2, 0x83, 0x1e, 0xbc, 0xd4, 0xe2, 0x41, 0xbc, 0xe8, 0xb4,
-	0x0c, 0x31, 0x68, 0x33, 0x69, 0x66, 0x1a, 0x5c, 0xc4, 0xcb, 0x5e, 0xc4, 0x9b, 0xe0, 0x57, 0xf0,
-	0xc3, 0xec, 0x71, 0xc1, 0x8b, 0x27, 0x91, 0xd6, 0x0f, 0x22, 0x99, 0x99, 0xa4, 0x9b, 0x58, 0xda,
-	0x4b, 0x49, 0xde, 0x7b, 0xbf, 0x7f, 0xef, 0xa5, 0xe0, 0xf0, 0x74, 0x26, 0x24, 0xe5, 0xc9, 0x74,
-	0x70, 0x9f, 0xa6, 0x3e, 0x9d, 0x2f, 0x78, 0x72, 0x42, 0xe2, 0x44, 0x28, 0x81, 0x2f, 0xeb, 0x1e,
-	0xd1, 0x3d, 0x92, 0xfa, 0xce, 0x9d, 0xa9, 0x90, 0xd9, 0xf0, 0x84, 0x49, 0x6e, 0x06, 0x69, 0xea,
-	0x4f, 0xb8, 0x62, 0x3e, 0x8d, 0x59, 0x10, 0x46, 0x4c, 0x85, 0x22, 0x32, 0x58, 0xa7, 0xca, 0x6b,
-	0x48, 0x4c, 0xef, 0x66, 0xa5, 0x17, 0xf0, 0x88, 0xcb, 0x50, 0xda, 0x6e, 0x2b, 0x10, 0x81, 0xd0,
-	0x8f, 0x34, 0x7b, 0xca, 0x31, 0x81, 0x10, 0xc1, 0x07, 0x4e, 0x59, 0x1c, 0x52, 0x16, 0x45, 0x42,
-	0x69, 0x31, 0x8b, 0xf1, 0xde, 0x42, 0xfb, 0x45, 0xe6, 0xe7, 0xa5, 0x78, 0xcf, 0xa3, 0x11, 0x0b,
-	0x13, 0x39, 0xe6, 0xf3, 0x05, 0x97, 0x0a, 0x3f, 0x05, 0x58, 0x7b, 0xeb, 0xa0, 0x43, 0xd4, 0x6f,
-	0x0e, 0x7a, 0xc4, 0x04, 0x21, 0x59, 0x10, 0x62, 0x12, 0xdb, 0x20, 0x64, 0xc4, 0x02, 0x6e, 0xb1,
-	0xe3, 0x0b, 0x48, 0xef, 0x07, 0x82, 0x6b, 0xff, 0x49, 0xc8, 0x58, 0x44, 0x92, 0xe3, 0x27, 0xd0,
-	0x54, 0x59, 0xf5, 0x4d, 0x9c, 0x95, 0x3b, 0xe8, 0x70, 0xbf, 0xdf, 0x1c, 0x5c, 0x27, 0xe5, 0xed,
-	0x91, 0x02, 0x38, 0xbc, 0x74, 0xf6, 0xfb, 0x76, 0x6d, 0x0c, 0xaa, 0x60, 0xc2, 0xcf, 0x4a, 0x2e,
-	0xf7, 0xb4, 0xcb, 0xe3, 0x9d, 0x2e, 0x8d, 0x7c, 0xc9, 0xe6, 0x3d, 0xb8, 0x5a, 0x76, 0x99, 0xef,
-	0xa1, 0x05, 0x07, 0x5a, 0x4f, 0xaf, 0xa0, 0x31, 0x36, 0x2f, 0xde, 0xab, 0xea, 0xde, 0x8a, 0x4c,
-	0x8f, 0x01, 0xd6, 0x99, 0xec, 0xde, 0x76, 0x46, 0x6a, 0x14, 0x91, 0xbc, 0x16, 0x60, 0xcd, 0x3c,
-	0x62, 0x09, 0x9b, 0xe5, 0xd7, 0xf0, 0x9e, 0xc3, 0x95, 0x52, 0xd5, 0x8a, 0x3d, 0x84, 0x7a, 0xac,
-	0x2b, 0x56, 0xa8, 0x5d, 0x15, 0x32, 0xf3, 0x56, 0xc5, 0xce, 0x0e, 0xbe, 0xee, 0xc3, 0x81, 0x66,
-	0xc3, 0xa7, 0x08, 0x60, 0x7d, 0x17, 0xdc, 0xab, 0xc2, 0x37, 0x7f, 0x1b, 0xce, 0xf1, 0xce, 0x39,
-	0xe3, 0xcf, 0xeb, 0x9e, 0xfe, 0xfc, 0xfb, 0x7d, 0xef, 0x16, 0xbe, 0x41, 0x2b, 0x5f, 0xee, 0x85,
-	0xb3, 0xe3, 0x2f, 0x08, 0x1a, 0x05, 0x16, 0x1f, 0x6d, 0xe7, 0xce, 0x2d, 0xf4, 0x76, 0x8d, 0x59,
-	0x07, 0x77, 0xb5, 0x83, 0x23, 0xdc, 0xdd, 0xe2, 0x80, 0x7e, 0xd2, 0x2f, 0x9f, 0xf1, 0x1c, 0xea,
-	0x66, 0x61, 0xd8, 0xdb, 0x48, 0x5f, 0xba, 0x89, 0xd3, 0xdd, 0x3a, 0x63, 0xf5, 0x5d, 0xad, 0xdf,
-	0xc1, 0xed, 0xaa, 0xbe, 0xb9, 0xc5, 0x70, 0x78, 0xb6, 0x74, 0xd1, 0xf9, 0xd2, 0x45, 0x7f, 0x96,
-	0x2e, 0xfa, 0xb6, 0x72, 0x6b, 0xe7, 0x2b, 0xb7, 0xf6, 0x6b, 0xe5, 0xd6, 0x5e, 0xf7, 0x83, 0x50,
-	0xbd, 0x5b, 0x4c, 0xc8, 0x54, 0xcc, 0x72, 0xac, 0xfe, 0x4d, 0xfd, 0x47, 0xf4, 0xa3, 0xe5, 0x51,
-	0x27, 0x31, 0x97, 0x93, 0xba, 0xfe, 0x2f, 0x3f, 0xf8, 0x17, 0x00, 0x00, 0xff, 0xff, 0x26, 0xc6,
-	0x0f, 0x5b, 0x93, 0x04, 0x00, 0x00,
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0x7e, 0x44, 0x64, 0x22, 0x71, 0x58, 0x42, 0x08, 0x86, 0xba, 0x91, 0x43, 0xd3,
+	0x88, 0x0f, 0x6f, 0x13, 0xb8, 0xf4, 0x02, 0x28, 0x08, 0x38, 0x20, 0x50, 0x88, 0x2a, 0x21, 0x71,
+	0x09, 0x9b, 0x74, 0x65, 0x2c, 0x88, 0xd7, 0xb1, 0x37, 0x86, 0x0a, 0x71, 0xe9, 0x85, 0x2b, 0x52,
+	0x1f, 0x81, 0x07, 0xe0, 0x35, 0x7a, 0xac, 0xc4, 0x85, 0x13, 0x42, 0x09, 0xbc, 0x07, 0xf2, 0xee,
+	0xda, 0x49, 0xdc, 0x34, 0xe1, 0xd0, 0x4b, 0x64, 0xef, 0xcc, 0xfc, 0xe7, 0x37, 0x7f, 0xcf, 0x06,
+	0x74, 0x1a, 0xf6, 0x59, 0x80, 0xa9, 0xdf, 0x6b, 0xec, 0xe0, 0xb0, 0x8e, 0x07, 0x43, 0xea, 0x1f,
+	0x58, 0x9e, 0xcf, 0x38, 0x43, 0x17, 0x45, 0xcc, 0x12, 0x31, 0x2b, 0xac, 0xeb, 0x37, 0x7b, 0x2c,
+	0x88, 0x92, 0xbb, 0x24, 0xa0, 0x32, 0x11, 0x87, 0xf5, 0x2e, 0xe5, 0xa4, 0x8e, 0x3d, 0x62, 0x3b,
+	0x2e, 0xe1, 0x0e, 0x73, 0x65, 0xad, 0x9e, 0xd6, 0x95, 0x22, 0x32, 0x76, 0x3d, 0x15, 0xb3, 0xa9,
+	0x4b, 0x03, 0x27, 0x50, 0xd1, 0x82, 0xcd, 0x6c, 0x26, 0x1e, 0x71, 0xf4, 0x14, 0xd7, 0xd8, 0x8c,
+	0xd9, 0xef, 0x29, 0x26, 0x9e, 0x83, 0x89, 0xeb, 0x32, 0x2e, 0x9a, 0xa9, 0x1a, 0xf3, 0x0d, 0x14,
+	0x5f, 0x46, 0x3c, 0x7b, 0xec, 0x1d, 0x75, 0x5b, 0xc4, 0xf1, 0x83, 0x36, 0x1d, 0x0c, 0x69, 0xc0,
+	0xd1, 0x13, 0x80, 0x09, 0x5b, 0x49, 0x2b, 0x6b, 0xb5, 0x7c, 0xa3, 0x6a, 0xc9, 0x41, 0xac, 0x68,
+	0x10, 0x4b, 0x4e, 0xac, 0x06, 0xb1, 0x5a, 0xc4, 0xa6, 0xaa, 0xb6, 0x3d, 0x55, 0x69, 0xfe, 0xd5,
+	0xe0, 0xca, 0xa9, 0x16, 0x81, 0xc7, 0xdc, 0x80, 0xa2, 0x87, 0x90, 0xe7, 0xd1, 0x69, 0xc7, 0x8b,
+	0x8e, 0x4b, 0x5a, 0x79, 0xb5, 0x96, 0x6f, 0x5c, 0xb5, 0x66, 0xdd, 0xb3, 0x92, 0xc2, 0xe6, 0xda,
+	0xf1, 0xaf, 0xcd, 0x4c, 0x1b, 0x78, 0xa2, 0x84, 0x9e, 0xce, 0x50, 0xae, 0x08, 0xca, 0xed, 0xa5,
+	0x94, 0xb2, 0xfd, 0x34, 0x26, 0x7a, 0x00, 0x17, 0xfa, 0x94, 0x93, 0x7d, 0xc2, 0x49, 0x69, 0x55,
+	0x70, 0x6c, 0xa4, 0x39, 0x1e, 0xb7, 0x1f, 0x35, 0x76, 0x9e, 0xab, 0x24, 0xc5, 0x92, 0x14, 0x99,
+	0x77, 0xe0, 0xf2, 0xec, 0x98, 0xb1, 0x91, 0x05, 0x58, 0x17, 0xc0, 0xc2, 0xc3, 0x5c, 0x5b, 0xbe,
+	0x98, 0x47, 0x5a, 0xda, 0xf9, 0xc4, 0x95, 0xfb, 0x00, 0x13, 0x57, 0x94, 0xf3, 0x4b, 0x4d, 0xc9,
+	0x25, 0xa6, 0xa0, 0xdd, 0xa9, 0x51, 0xa4, 0x23, 0x8b, 0x47, 0x99, 0x1a, 0xa2, 0x00, 0x48, 0x40,
+	0xb5, 0x88, 0x4f, 0xfa, 0xf1, 0x2a, 0x98, 0xcf, 0xe0, 0xd2, 0xcc, 0xa9, 0xe2, 0xbc, 0x07, 0x59,
+	0x4f, 0x9c, 0x28, 0xc6, 0x62, 0xba, 0x8b, 0xcc, 0x57, 0x80, 0x2a, 0xd7, 0x2c, 0x83, 0x21, 0xc4,
+	0x5e, 0xf9, 0xc4, 0xf3, 0xe8, 0xfe, 0x0b, 0xc2, 0x9d, 0x90, 0x8a, 0x69, 0xe2, 0x76, 0x04, 0x36,
+	0xcf, 0xcc, 0x38, 0x1f, 0x8b, 0x1a, 0xdf, 0xd7, 0x60, 0x5d, 0xf4, 0x40, 0x87, 0x1a, 0xc0, 0xde,
+	0x64, 0x9f, 0xaa, 0x69, 0x91, 0xf9, 0xb7, 0x43, 0xdf, 0x5e, 0x9a, 0x27, 0x49, 0xcd, 0xca, 0xe1,
+	0x8f, 0x3f, 0x47, 0x2b, 0x1b, 0xe8, 0x1a, 0x4e, 0xdd, 0xdd, 0xa9, 0xc5, 0x47, 0x5f, 0x34, 0xc8,
+	0x25, 0xb5, 0x68, 0x6b, 0xb1, 0x76, 0x8c, 0x50, 0x5d, 0x96, 0xa6, 0x08, 0x6e, 0x09, 0x82, 0x2d,
+	0x54, 0x59, 0x40, 0x80, 0x3f, 0x89, 0x97, 0xcf, 0x68, 0x00, 0x59, 0xf9, 0xd5, 0x90, 0x39, 0x57,
+	0x7e, 0x66, 0x31, 0xf4, 0xca, 0xc2, 0x1c, 0xd5, 0xdf, 0x10, 0xfd, 0x4b, 0xa8, 0x98, 0xee, 0x2f,
+	0x17, 0x02, 0x7d, 0xd3, 0x00, 0x9d, 0xfe, 0xd4, 0xc8, 0x9a, 0xab, 0x7d, 0xe6, 0xd6, 0xe8, 0xf8,
+	0xbf, 0xf3, 0x15, 0xd7, 0x6d, 0xc1, 0x55, 0x45, 0x37, 0xd2, 0x5c, 0x1f, 0x64, 0x4d, 0xc7, 0x15,
+	0x45, 0x1d, 0xe1, 0x4c, 0xb3, 0x79, 0x3c, 0x32, 0xb4, 0x93, 0x91, 0xa1, 0xfd, 0x1e, 0x19, 0xda,
+	0xd7, 0xb1, 0x91, 0x39, 0x19, 0x1b, 0x99, 0x9f, 0x63, 0x23, 0xf3, 0xba, 0x66, 0x3b, 0xfc, 0xed,
+	0xb0, 0x6b, 0xf5, 0x58, 0x3f, 0x56, 0x12, 0xbf, 0x61, 0x7d, 0x17, 0x7f, 0x54, 0xaa, 0xfc, 0xc0,
+	0xa3, 0x41, 0x37, 0x2b, 0xfe, 0x73, 0xef, 0xfe, 0x1b, 0x00, 0x2a, 0xc0, 0xe7, 0x4f, 0x3b, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -376,6 +490,8 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// WrappedNativeToken retrieves the token pair of the wrapped native coin
+	WrappedNativeToken(ctx context.Context, in *QueryWrappedNativeTokenRequest, opts ...grpc.CallOption) (*QueryWrappedNativeTokenResponse, error)
 }
 
 type queryClient struct {
@@ -413,6 +529,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) WrappedNativeToken(ctx context.Context, in *QueryWrappedNativeTokenRequest, opts ...grpc.CallOption) (*QueryWrappedNativeTokenResponse, error) {
+	out := new(QueryWrappedNativeTokenResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/WrappedNativeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TokenPairs retrieves registered token pairs
@@ -421,6 +546,8 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// WrappedNativeToken retrieves the token pair of the wrapped native coin
+	WrappedNativeToken(context.Context, *QueryWrappedNativeTokenRequest) (*QueryWrappedNativeTokenResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -436,6 +563,9 @@ func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenP
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) WrappedNativeToken(ctx context.Context, req *QueryWrappedNativeTokenRequest) (*QueryWrappedNativeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrappedNativeToken not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -495,6 +625,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_WrappedNativeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWrappedNativeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WrappedNativeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/WrappedNativeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WrappedNativeToken(ctx, req.(*QueryWrappedNativeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.erc20.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -511,6 +659,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "WrappedNativeToken",
+			Handler:    _Query_WrappedNativeToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/erc20/v1/query.proto",
@@ -571,6 +723,20 @@ func (m *QueryTokenPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -650,6 +816,18 @@ func (m *QueryTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -719,6 +897,62 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryWrappedNativeTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWrappedNativeTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWrappedNativeTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWrappedNativeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWrappedNativeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWrappedNativeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -759,6 +993,12 @@ func (m *QueryTokenPairsResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -783,6 +1023,10 @@ func (m *QueryTokenPairResponse) Size() (n int) {
 	_ = l
 	l = m.TokenPair.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -806,6 +1050,26 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryWrappedNativeTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWrappedNativeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -997,6 +1261,40 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, ERC20Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1162,6 +1460,42 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ERC20Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1316,6 +1650,139 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryWrappedNativeTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWrappedNativeTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWrappedNativeTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWrappedNativeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWrappedNativeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWrappedNativeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -44,6 +44,10 @@ type MsgConvertERC20 struct {
 	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// sender is the hex address from the owner of the given ERC20 tokens
 	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// permit is an optional EIP-2612 permit signed by the sender. If set, the
+	// sender approves the module account within the conversion, so no prior
+	// allowance is needed.
+	Permit *ERC20Permit `protobuf:"bytes,5,opt,name=permit,proto3" json:"permit,omitempty"`
 }
 
 func (m *MsgConvertERC20) Reset()         { *m = MsgConvertERC20{} }
@@ -100,6 +104,89 @@ func (m *MsgConvertERC20) GetSender() string {
 	return ""
 }
 
+func (m *MsgConvertERC20) GetPermit() *ERC20Permit {
+	if m != nil {
+		return m.Permit
+	}
+	return nil
+}
+
+// ERC20Permit defines the arguments of an EIP-2612 permit call from the token
+// owner to the erc20 module account.
+type ERC20Permit struct {
+	// value is the allowance granted to the module account
+	Value cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value"`
+	// deadline is the unix timestamp after which the permit is invalid
+	Deadline uint64 `protobuf:"varint,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// v is the recovery id of the permit signature
+	V uint32 `protobuf:"varint,3,opt,name=v,proto3" json:"v,omitempty"`
+	// r is the first 32 bytes of the permit signature
+	R []byte `protobuf:"bytes,4,opt,name=r,proto3" json:"r,omitempty"`
+	// s is the second 32 bytes of the permit signature
+	S []byte `protobuf:"bytes,5,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *ERC20Permit) Reset()         { *m = ERC20Permit{} }
+func (m *ERC20Permit) String() string { return proto.CompactTextString(m) }
+func (*ERC20Permit) ProtoMessage()    {}
+func (*ERC20Permit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{1}
+}
+func (m *ERC20Permit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Permit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Permit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20Permit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Permit.Merge(m, src)
+}
+func (m *ERC20Permit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Permit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Permit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Permit proto.InternalMessageInfo
+
+func (m *ERC20Permit) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *ERC20Permit) GetV() uint32 {
+	if m != nil {
+		return m.V
+	}
+	return 0
+}
+
+func (m *ERC20Permit) GetR() []byte {
+	if m != nil {
+		return m.R
+	}
+	return nil
+}
+
+func (m *ERC20Permit) GetS() []byte {
+	if m != nil {
+		return m.S
+	}
+	return nil
+}
+
 // MsgConvertERC20Response returns no fields
 type MsgConvertERC20Response struct {
 }
@@ -108,7 +195,7 @@ func (m *MsgConvertERC20Response) Reset()         { *m = MsgConvertERC20Response
 func (m *MsgConvertERC20Response) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertERC20Response) ProtoMessage()    {}
 func (*MsgConvertERC20Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8926fc6cb676914, []int{1}
+	return fileDescriptor_f8926fc6cb676914, []int{2}
 }
 func (m *MsgConvertERC20Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,7 +239,7 @@ func (m *MsgConvertCoin) Reset()         { *m = MsgConvertCoin{} }
 func (m *MsgConvertCoin) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertCoin) ProtoMessage()    {}
 func (*MsgConvertCoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8926fc6cb676914, []int{2}
+	return fileDescriptor_f8926fc6cb676914, []int{3}
 }
 func (m *MsgConvertCoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +297,7 @@ func (m *MsgConvertCoinResponse) Reset()         { *m = MsgConvertCoinResponse{}
 func (m *MsgConvertCoinResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertCoinResponse) ProtoMessage()    {}
 func (*MsgConvertCoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8926fc6cb676914, []int{3}
+	return fileDescriptor_f8926fc6cb676914, []int{4}
 }
 func (m *MsgConvertCoinResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,7 +340,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8926fc6cb676914, []int{4}
+	return fileDescriptor_f8926fc6cb676914, []int{5}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,7 +393,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8926fc6cb676914, []int{5}
+	return fileDescriptor_f8926fc6cb676914, []int{6}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -335,53 +422,172 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateTokenPairContract is the Msg/UpdateTokenPairContract request type
+// for migrating a token pair of an ERC20 origin to a new ERC20 contract, e.g.
+// after a proxy upgrade that changed the contract address.
+type MsgUpdateTokenPairContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the currently
+	// registered ERC20 or the Cosmos base denomination of the token pair
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// new_contract_address is the hex address of the ERC20 contract the token
+	// pair is re-pointed to
+	NewContractAddress string `protobuf:"bytes,3,opt,name=new_contract_address,json=newContractAddress,proto3" json:"new_contract_address,omitempty"`
+}
+
+func (m *MsgUpdateTokenPairContract) Reset()         { *m = MsgUpdateTokenPairContract{} }
+func (m *MsgUpdateTokenPairContract) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTokenPairContract) ProtoMessage()    {}
+func (*MsgUpdateTokenPairContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{7}
+}
+func (m *MsgUpdateTokenPairContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTokenPairContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTokenPairContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTokenPairContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTokenPairContract.Merge(m, src)
+}
+func (m *MsgUpdateTokenPairContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTokenPairContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTokenPairContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTokenPairContract proto.InternalMessageInfo
+
+func (m *MsgUpdateTokenPairContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateTokenPairContract) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *MsgUpdateTokenPairContract) GetNewContractAddress() string {
+	if m != nil {
+		return m.NewContractAddress
+	}
+	return ""
+}
+
+// MsgUpdateTokenPairContractResponse defines the response structure for executing a
+// MsgUpdateTokenPairContract message.
+type MsgUpdateTokenPairContractResponse struct {
+}
+
+func (m *MsgUpdateTokenPairContractResponse) Reset()         { *m = MsgUpdateTokenPairContractResponse{} }
+func (m *MsgUpdateTokenPairContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTokenPairContractResponse) ProtoMessage()    {}
+func (*MsgUpdateTokenPairContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{8}
+}
+func (m *MsgUpdateTokenPairContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTokenPairContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTokenPairContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTokenPairContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTokenPairContractResponse.Merge(m, src)
+}
+func (m *MsgUpdateTokenPairContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTokenPairContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTokenPairContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTokenPairContractResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertERC20)(nil), "evmos.erc20.v1.MsgConvertERC20")
+	proto.RegisterType((*ERC20Permit)(nil), "evmos.erc20.v1.ERC20Permit")
 	proto.RegisterType((*MsgConvertERC20Response)(nil), "evmos.erc20.v1.MsgConvertERC20Response")
 	proto.RegisterType((*MsgConvertCoin)(nil), "evmos.erc20.v1.MsgConvertCoin")
 	proto.RegisterType((*MsgConvertCoinResponse)(nil), "evmos.erc20.v1.MsgConvertCoinResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.erc20.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.erc20.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateTokenPairContract)(nil), "evmos.erc20.v1.MsgUpdateTokenPairContract")
+	proto.RegisterType((*MsgUpdateTokenPairContractResponse)(nil), "evmos.erc20.v1.MsgUpdateTokenPairContractResponse")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/tx.proto", fileDescriptor_f8926fc6cb676914) }
 
 var fileDescriptor_f8926fc6cb676914 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x8a, 0x13, 0x31,
-	0x18, 0xc7, 0x3b, 0xdb, 0x52, 0xdc, 0xec, 0xd2, 0x95, 0xb0, 0xb6, 0xd3, 0xa2, 0xd3, 0xa5, 0x17,
-	0xab, 0x60, 0xb2, 0xed, 0xaa, 0x88, 0x37, 0x5b, 0x3c, 0x78, 0x58, 0x90, 0x11, 0x41, 0xbc, 0x94,
-	0x74, 0x1a, 0xd2, 0x41, 0x27, 0x19, 0x92, 0x74, 0xd8, 0x5e, 0xfb, 0x02, 0x0a, 0x3e, 0x84, 0x57,
-	0x0f, 0x3e, 0xc4, 0x1e, 0x17, 0xbd, 0x88, 0x87, 0x45, 0xda, 0x05, 0x5f, 0x43, 0x26, 0x93, 0xb6,
-	0x3b, 0xb5, 0xe2, 0xa5, 0xf4, 0xcb, 0xff, 0xff, 0x7d, 0xf9, 0xcd, 0x3f, 0x09, 0xa8, 0xd1, 0x24,
-	0x12, 0x0a, 0x53, 0x19, 0x74, 0x8f, 0x71, 0xd2, 0xc1, 0xfa, 0x0c, 0xc5, 0x52, 0x68, 0x01, 0x2b,
-	0x46, 0x40, 0x46, 0x40, 0x49, 0xa7, 0xe1, 0x05, 0x42, 0xa5, 0xce, 0x21, 0x51, 0x14, 0x27, 0x9d,
-	0x21, 0xd5, 0xa4, 0x83, 0x03, 0x11, 0xf2, 0xcc, 0xdf, 0xa8, 0x59, 0x3d, 0x52, 0x2c, 0x9d, 0x13,
-	0x29, 0x66, 0x85, 0x7a, 0x26, 0x0c, 0x4c, 0x85, 0xb3, 0xc2, 0x4a, 0xb7, 0x37, 0x36, 0x67, 0x94,
-	0x53, 0x15, 0x2e, 0xd5, 0x43, 0x26, 0x98, 0xc8, 0xba, 0xd2, 0x7f, 0xcb, 0x1e, 0x26, 0x04, 0x7b,
-	0x4f, 0x31, 0x89, 0x43, 0x4c, 0x38, 0x17, 0x9a, 0xe8, 0x50, 0x70, 0xdb, 0xd3, 0xfa, 0xec, 0x80,
-	0x83, 0x53, 0xc5, 0xfa, 0x82, 0x27, 0x54, 0xea, 0xe7, 0x7e, 0xbf, 0x7b, 0x0c, 0xef, 0x81, 0x9b,
-	0x81, 0xe0, 0x5a, 0x92, 0x40, 0x0f, 0xc8, 0x68, 0x24, 0xa9, 0x52, 0xae, 0x73, 0xe4, 0xb4, 0x77,
-	0xfd, 0x83, 0xe5, 0xfa, 0xb3, 0x6c, 0x19, 0x3e, 0x02, 0x65, 0x12, 0x89, 0x09, 0xd7, 0xee, 0x4e,
-	0x6a, 0xe8, 0xdd, 0x39, 0xbf, 0x6c, 0x16, 0x7e, 0x5e, 0x36, 0x6f, 0x65, 0xd8, 0x6a, 0xf4, 0x0e,
-	0x85, 0x02, 0x47, 0x44, 0x8f, 0xd1, 0x0b, 0xae, 0x7d, 0x6b, 0x86, 0x0d, 0x70, 0x43, 0xd2, 0x80,
-	0x86, 0x09, 0x95, 0x6e, 0xd1, 0x4c, 0x5e, 0xd5, 0xb0, 0x0a, 0xca, 0x8a, 0xf2, 0x11, 0x95, 0x6e,
-	0xc9, 0x28, 0xb6, 0x6a, 0xd5, 0x41, 0x6d, 0x03, 0xd4, 0xa7, 0x2a, 0x16, 0x5c, 0xd1, 0xd6, 0x14,
-	0x54, 0xd6, 0x52, 0x5f, 0x84, 0x1c, 0x9e, 0x80, 0x52, 0x1a, 0xb5, 0xc1, 0xde, 0xeb, 0xd6, 0x91,
-	0x4d, 0x31, 0x3d, 0x0b, 0x64, 0xcf, 0x02, 0xa5, 0xc6, 0x5e, 0x29, 0x05, 0xf6, 0x8d, 0x39, 0x47,
-	0xb5, 0xf3, 0x4f, 0xaa, 0x62, 0x8e, 0xca, 0x05, 0xd5, 0xfc, 0xd6, 0x2b, 0xa8, 0x0f, 0x59, 0xb2,
-	0xaf, 0xe3, 0x11, 0xd1, 0xf4, 0x25, 0x91, 0x24, 0x52, 0xf0, 0x31, 0xd8, 0x25, 0x13, 0x3d, 0x16,
-	0x32, 0xd4, 0xd3, 0x2c, 0xd2, 0x9e, 0xfb, 0xed, 0xeb, 0x83, 0x43, 0x8b, 0x67, 0x53, 0x7d, 0xa5,
-	0x65, 0xc8, 0x99, 0xbf, 0xb6, 0xc2, 0x87, 0xa0, 0x1c, 0x9b, 0x09, 0x86, 0x6b, 0xaf, 0x5b, 0x45,
-	0xf9, 0xcb, 0x86, 0xb2, 0xf9, 0xf6, 0x6b, 0xac, 0xf7, 0x69, 0x65, 0xf6, 0xfb, 0xcb, 0xfd, 0xf5,
-	0x14, 0x9b, 0xe0, 0x75, 0xa0, 0x25, 0x6c, 0xf7, 0xca, 0x01, 0xc5, 0x53, 0xc5, 0xe0, 0xcc, 0x01,
-	0xfb, 0xb9, 0xbb, 0xd0, 0xdc, 0xdc, 0x69, 0xe3, 0x0c, 0x1a, 0x77, 0xff, 0x63, 0x58, 0xe5, 0xd1,
-	0x9e, 0x7d, 0xbf, 0xfa, 0xb4, 0xd3, 0x82, 0x47, 0xf8, 0xaf, 0x17, 0x84, 0x83, 0xac, 0x61, 0x60,
-	0xd6, 0xe0, 0x1b, 0xb0, 0x9f, 0x4b, 0x6d, 0x1b, 0xc3, 0x75, 0xc3, 0x56, 0x86, 0x6d, 0x9f, 0xd9,
-	0xeb, 0x9d, 0xcf, 0x3d, 0xe7, 0x62, 0xee, 0x39, 0xbf, 0xe6, 0x9e, 0xf3, 0x71, 0xe1, 0x15, 0x2e,
-	0x16, 0x5e, 0xe1, 0xc7, 0xc2, 0x2b, 0xbc, 0x6d, 0xb3, 0x50, 0x8f, 0x27, 0x43, 0x14, 0x88, 0x68,
-	0xc9, 0x67, 0x7e, 0x93, 0xce, 0x13, 0x7c, 0x66, 0x59, 0xf5, 0x34, 0xa6, 0x6a, 0x58, 0x36, 0x0f,
-	0xe7, 0xe4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x59, 0x1a, 0x04, 0x09, 0x04, 0x00, 0x00,
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6b, 0x13, 0x4d,
+	0x1c, 0xce, 0xa4, 0x69, 0x78, 0x3b, 0xc9, 0xdb, 0xbe, 0x0c, 0x79, 0x9b, 0x6d, 0xd4, 0xb4, 0x04,
+	0xa1, 0xb1, 0xe0, 0x6e, 0xb3, 0x51, 0x41, 0x6f, 0x26, 0x78, 0xf0, 0x50, 0x28, 0xab, 0x82, 0x78,
+	0x09, 0x93, 0xdd, 0x61, 0xbb, 0xb4, 0x3b, 0xb3, 0xcc, 0x4c, 0xb6, 0xcd, 0xb5, 0x27, 0x6f, 0x0a,
+	0x7e, 0x0d, 0x0f, 0x1e, 0xfc, 0x10, 0x3d, 0x16, 0xbd, 0x88, 0x48, 0x95, 0x56, 0xf0, 0x6b, 0xc8,
+	0xce, 0xce, 0xa6, 0xf9, 0x63, 0x68, 0xf1, 0x12, 0xf2, 0x9b, 0xe7, 0x99, 0xdf, 0x3c, 0xbf, 0x67,
+	0x9f, 0x19, 0x58, 0x25, 0x71, 0xc8, 0x84, 0x45, 0xb8, 0x6b, 0x6f, 0x5b, 0x71, 0xcb, 0x92, 0x47,
+	0x66, 0xc4, 0x99, 0x64, 0x68, 0x59, 0x01, 0xa6, 0x02, 0xcc, 0xb8, 0x55, 0xab, 0xbb, 0x4c, 0x24,
+	0xcc, 0x3e, 0x16, 0xc4, 0x8a, 0x5b, 0x7d, 0x22, 0x71, 0xcb, 0x72, 0x59, 0x40, 0x53, 0x7e, 0xad,
+	0xaa, 0xf1, 0x50, 0xf8, 0x49, 0x9f, 0x50, 0xf8, 0x1a, 0x58, 0x4b, 0x81, 0x9e, 0xaa, 0xac, 0xb4,
+	0xd0, 0xd0, 0xcd, 0xa9, 0xc3, 0x7d, 0x42, 0x89, 0x08, 0x32, 0xb4, 0xe2, 0x33, 0x9f, 0xa5, 0xbb,
+	0x92, 0x7f, 0xd9, 0x1e, 0x9f, 0x31, 0xff, 0x80, 0x58, 0x38, 0x0a, 0x2c, 0x4c, 0x29, 0x93, 0x58,
+	0x06, 0x8c, 0xea, 0x3d, 0x8d, 0x6f, 0x00, 0xae, 0xec, 0x08, 0xbf, 0xcb, 0x68, 0x4c, 0xb8, 0x7c,
+	0xe2, 0x74, 0xed, 0x6d, 0x74, 0x07, 0xfe, 0xe7, 0x32, 0x2a, 0x39, 0x76, 0x65, 0x0f, 0x7b, 0x1e,
+	0x27, 0x42, 0x18, 0x60, 0x03, 0x34, 0x97, 0x9c, 0x95, 0x6c, 0xfd, 0x71, 0xba, 0x8c, 0xee, 0xc3,
+	0x22, 0x0e, 0xd9, 0x80, 0x4a, 0x23, 0x9f, 0x10, 0x3a, 0xb7, 0x4e, 0xce, 0xd6, 0x73, 0x5f, 0xcf,
+	0xd6, 0xff, 0x4f, 0x65, 0x0b, 0x6f, 0xdf, 0x0c, 0x98, 0x15, 0x62, 0xb9, 0x67, 0x3e, 0xa5, 0xd2,
+	0xd1, 0x64, 0x54, 0x83, 0xff, 0x70, 0xe2, 0x92, 0x20, 0x26, 0xdc, 0x58, 0x50, 0x9d, 0x47, 0x35,
+	0x5a, 0x85, 0x45, 0x41, 0xa8, 0x47, 0xb8, 0x51, 0x50, 0x88, 0xae, 0x50, 0x1b, 0x16, 0x23, 0xc2,
+	0xc3, 0x40, 0x1a, 0x8b, 0x1b, 0xa0, 0x59, 0xb2, 0x6f, 0x98, 0x93, 0x86, 0x9b, 0x4a, 0xfc, 0xae,
+	0xa2, 0x38, 0x9a, 0xda, 0x78, 0x0d, 0x60, 0x69, 0x6c, 0x1d, 0xb5, 0xe1, 0x62, 0x8c, 0x0f, 0x06,
+	0xc4, 0x00, 0xd7, 0x91, 0x9b, 0x72, 0x13, 0xb5, 0x1e, 0xc1, 0xde, 0x41, 0x40, 0x89, 0x1a, 0xb3,
+	0xe0, 0x8c, 0x6a, 0x54, 0x86, 0x20, 0x56, 0x23, 0xfc, 0xeb, 0x80, 0x38, 0xa9, 0x52, 0xd9, 0x65,
+	0x07, 0xf0, 0xa4, 0x12, 0x4a, 0x6c, 0xd9, 0x01, 0xa2, 0xb1, 0x06, 0xab, 0x53, 0x46, 0x3b, 0x44,
+	0x44, 0x8c, 0x0a, 0xd2, 0x18, 0xc2, 0xe5, 0x4b, 0xa8, 0xcb, 0x02, 0x8a, 0xda, 0xb0, 0x90, 0x44,
+	0x45, 0xc9, 0x2c, 0xd9, 0x6b, 0xa6, 0x4e, 0x41, 0x92, 0x25, 0x53, 0x67, 0xc9, 0x4c, 0x88, 0x9d,
+	0x42, 0x32, 0x81, 0xa3, 0xc8, 0x13, 0xae, 0xe6, 0xe7, 0xba, 0xba, 0x30, 0xee, 0x6a, 0xc3, 0x80,
+	0xab, 0x93, 0x47, 0x8f, 0x44, 0xbd, 0x49, 0x93, 0xf1, 0x22, 0xf2, 0xb0, 0x24, 0xbb, 0x98, 0xe3,
+	0x50, 0xa0, 0x07, 0x70, 0x09, 0x0f, 0xe4, 0x1e, 0xe3, 0x81, 0x1c, 0x6a, 0x0b, 0x8d, 0x4f, 0x1f,
+	0xef, 0x56, 0xb4, 0x3c, 0x9d, 0x8a, 0x67, 0x92, 0x07, 0xd4, 0x77, 0x2e, 0xa9, 0xe8, 0x1e, 0x2c,
+	0x46, 0xaa, 0x83, 0xd2, 0x55, 0xb2, 0x57, 0xa7, 0xbf, 0x5d, 0xda, 0x5f, 0x4f, 0xa3, 0xb9, 0x8f,
+	0x96, 0x8f, 0x7f, 0x7d, 0xd8, 0xba, 0xec, 0xa2, 0x1d, 0x1c, 0x17, 0x34, 0x12, 0xfb, 0x1e, 0xc0,
+	0xda, 0x08, 0x7b, 0xce, 0xf6, 0x09, 0xdd, 0xc5, 0x01, 0xef, 0xea, 0xb4, 0xfe, 0xb5, 0xee, 0x0a,
+	0x5c, 0x94, 0x49, 0x33, 0x6d, 0x67, 0x5a, 0xa0, 0x6d, 0x58, 0xa1, 0xe4, 0xb0, 0x37, 0x73, 0x47,
+	0x52, 0x67, 0x11, 0x25, 0x87, 0xdd, 0xc9, 0x6b, 0x32, 0x33, 0xc9, 0x6d, 0xd8, 0x98, 0xaf, 0x36,
+	0x1b, 0xca, 0xfe, 0x9e, 0x87, 0x0b, 0x3b, 0xc2, 0x47, 0xc7, 0x00, 0x96, 0x27, 0x2e, 0xe8, 0xfa,
+	0xb4, 0x7d, 0x53, 0xc1, 0xaa, 0x6d, 0x5e, 0x41, 0x18, 0xf9, 0xd6, 0x3c, 0xfe, 0xfc, 0xf3, 0x5d,
+	0xbe, 0x81, 0x36, 0xac, 0x99, 0x67, 0xcd, 0x72, 0xd3, 0x0d, 0x3d, 0xb5, 0x86, 0x5e, 0xc2, 0xf2,
+	0x44, 0x14, 0xfe, 0xa4, 0x61, 0x9c, 0x50, 0xdb, 0xbc, 0x82, 0x90, 0x69, 0x40, 0x43, 0x58, 0x9d,
+	0xf7, 0xdd, 0xb6, 0xe6, 0xf6, 0x98, 0xe1, 0xd6, 0xec, 0xeb, 0x73, 0xb3, 0xa3, 0x3b, 0x9d, 0x93,
+	0xf3, 0x3a, 0x38, 0x3d, 0xaf, 0x83, 0x1f, 0xe7, 0x75, 0xf0, 0xf6, 0xa2, 0x9e, 0x3b, 0xbd, 0xa8,
+	0xe7, 0xbe, 0x5c, 0xd4, 0x73, 0xaf, 0x9a, 0x7e, 0x20, 0xf7, 0x06, 0x7d, 0xd3, 0x65, 0x61, 0x66,
+	0x8d, 0xfa, 0x8d, 0x5b, 0x0f, 0xad, 0x23, 0x6d, 0x93, 0x1c, 0x46, 0x44, 0xf4, 0x8b, 0xea, 0x21,
+	0x6d, 0xff, 0x1e, 0x00, 0xbb, 0xff, 0xb3, 0xf5, 0x19, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -402,6 +608,10 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateTokenPairContract defines a governance operation for re-pointing a
+	// token pair of an ERC20 origin to a new ERC20 contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairContract(ctx context.Context, in *MsgUpdateTokenPairContract, opts ...grpc.CallOption) (*MsgUpdateTokenPairContractResponse, error)
 }
 
 type msgClient struct {
@@ -430,6 +640,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateTokenPairContract(ctx context.Context, in *MsgUpdateTokenPairContract, opts ...grpc.CallOption) (*MsgUpdateTokenPairContractResponse, error) {
+	out := new(MsgUpdateTokenPairContractResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/UpdateTokenPairContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
@@ -438,6 +657,10 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateTokenPairContract defines a governance operation for re-pointing a
+	// token pair of an ERC20 origin to a new ERC20 contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairContract(context.Context, *MsgUpdateTokenPairContract) (*MsgUpdateTokenPairContractResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -450,6 +673,9 @@ func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvert
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateTokenPairContract(ctx context.Context, req *MsgUpdateTokenPairContract) (*MsgUpdateTokenPairContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTokenPairContract not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -491,6 +717,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateTokenPairContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTokenPairContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTokenPairContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/UpdateTokenPairContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTokenPairContract(ctx, req.(*MsgUpdateTokenPairContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.erc20.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -503,6 +747,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateTokenPairContract",
+			Handler:    _Msg_UpdateTokenPairContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/erc20/v1/tx.proto",
@@ -528,6 +776,18 @@ func (m *MsgConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Permit != nil {
+		{
+			size, err := m.Permit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -562,6 +822,63 @@ func (m *MsgConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ERC20Permit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Permit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Permit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.V != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.V))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgConvertERC20Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -718,6 +1035,73 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateTokenPairContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTokenPairContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTokenPairContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewContractAddress) > 0 {
+		i -= len(m.NewContractAddress)
+		copy(dAtA[i:], m.NewContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTokenPairContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTokenPairContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTokenPairContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -749,6 +1133,35 @@ func (m *MsgConvertERC20) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Permit != nil {
+		l = m.Permit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20Permit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	if m.V != 0 {
+		n += 1 + sovTx(uint64(m.V))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -813,11 +1226,41 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgUpdateTokenPairContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTokenPairContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -978,6 +1421,232 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permit == nil {
+				m.Permit = &ERC20Permit{}
+			}
+			if err := m.Permit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Permit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Permit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Permit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			m.V = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.V |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1411,6 +2080,202 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateTokenPairContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTokenPairContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTokenPairContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTokenPairContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTokenPairContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTokenPairContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,130 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package cli
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v19/types"
+
+	"github.com/evmos/evmos/v19/x/erc721/types"
+)
+
+// NewTxCmd returns a root CLI command handler for erc721 transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "erc721 subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewConvertNFTCmd(),
+		NewConvertERC721Cmd(),
+	)
+	return txCmd
+}
+
+// NewConvertNFTCmd returns a CLI command handler for converting an x/nft NFT
+func NewConvertNFTCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-nft CLASS_ID NFT_ID [RECEIVER_HEX]",
+		Short: "Convert an NFT to an ERC721 token. When the receiver [optional] is omitted, the ERC721 token is minted to the sender.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			sender := cliCtx.GetFromAddress()
+
+			if len(args) == 3 {
+				receiver = args[2]
+				if err := evmostypes.ValidateAddress(receiver); err != nil {
+					return fmt.Errorf("invalid receiver hex address %w", err)
+				}
+			} else {
+				receiver = common.BytesToAddress(sender).Hex()
+			}
+
+			msg := &types.MsgConvertNFT{
+				ClassId:  args[0],
+				NftId:    args[1],
+				Receiver: receiver,
+				Sender:   sender.String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC721Cmd returns a CLI command handler for converting an ERC721
+// token
+func NewConvertERC721Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc721 CONTRACT_ADDRESS TOKEN_ID [RECEIVER]",
+		Short: "Convert an ERC721 token to an NFT. When the receiver [optional] is omitted, the NFT is transferred to the sender.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := evmostypes.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC721 contract address %w", err)
+			}
+
+			tokenID, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid token id %s", args[1])
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 3 {
+				receiver, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgConvertERC721{
+				ContractAddress: contract,
+				TokenId:         tokenID,
+				Receiver:        receiver.String(),
+				Sender:          from.Hex(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
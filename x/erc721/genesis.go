@@ -0,0 +1,53 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc721
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/x/erc721/keeper"
+	"github.com/evmos/evmos/v19/x/erc721/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	data types.GenesisState,
+) {
+	// Ensure erc721 module account is set on genesis
+	if acc := ak.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		// NOTE: shouldn't occur
+		panic("the erc721 module account has not been set")
+	}
+
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(errorsmod.Wrapf(err, "error setting params"))
+	}
+
+	for _, pair := range data.TokenPairs {
+		k.SetToken(ctx, pair)
+	}
+
+	for _, mapping := range data.TokenMappings {
+		pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, mapping.ClassId))
+		if !found {
+			panic(fmt.Errorf("token pair not found for class %s", mapping.ClassId))
+		}
+		k.SetTokenMapping(ctx, pair.GetERC721Contract(), mapping.NftId)
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:        k.GetParams(ctx),
+		TokenPairs:    k.GetTokenPairs(ctx),
+		TokenMappings: k.GetTokenMappings(ctx),
+	}
+}
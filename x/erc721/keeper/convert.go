@@ -0,0 +1,167 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/x/erc721/types"
+)
+
+// ConversionEnabled checks that:
+//   - the global parameter for erc721 conversion is enabled
+//   - conversion is enabled for the given (erc721,class) token pair
+func (k Keeper) ConversionEnabled(
+	ctx sdk.Context,
+	token string,
+) (types.TokenPair, error) {
+	if !k.IsERC721Enabled(ctx) {
+		return types.TokenPair{}, errorsmod.Wrap(
+			types.ErrERC721Disabled, "module is currently disabled by governance",
+		)
+	}
+
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if !pair.Enabled {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrERC721TokenPairDisabled, "converting token '%s' is not enabled by governance", token,
+		)
+	}
+
+	return pair, nil
+}
+
+// convertNFTIntoERC721 handles the conversion of an NFT of a token pair:
+//   - check that the sender owns the NFT
+//   - escrow the NFT on module account
+//   - mint the ERC721 token with the NFT URI to the receiver
+//   - check that the receiver owns the ERC721 token
+func (k Keeper) convertNFTIntoERC721(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	nftID string,
+	sender sdk.AccAddress,
+	receiver common.Address,
+) (*big.Int, error) {
+	token, found := k.nftKeeper.GetNFT(ctx, pair.ClassId, nftID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenMappingNotFound, "nft %s/%s not found", pair.ClassId, nftID)
+	}
+
+	if owner := k.nftKeeper.GetOwner(ctx, pair.ClassId, nftID); !owner.Equals(sender) {
+		return nil, errorsmod.Wrapf(
+			types.ErrUnauthorized, "nft %s/%s is owned by %s, not %s", pair.ClassId, nftID, owner, sender,
+		)
+	}
+
+	// Escrow the NFT on module account
+	moduleAddr := sdk.AccAddress(types.ModuleAddress.Bytes())
+	if err := k.nftKeeper.Transfer(ctx, pair.ClassId, nftID, moduleAddr); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to escrow nft")
+	}
+
+	contract := pair.GetERC721Contract()
+	tokenID := types.TokenID(nftID)
+	if err := k.mintERC721(ctx, contract, receiver, tokenID, token.Uri); err != nil {
+		return nil, err
+	}
+	k.SetTokenMapping(ctx, contract, nftID)
+
+	// Check the ownership of the ERC721 token after the mint
+	owner, err := k.OwnerOf(ctx, contract, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if owner != receiver {
+		return nil, errorsmod.Wrapf(
+			types.ErrOwnershipInvariance, "invalid token owner - expected: %s, actual: %s", receiver, owner,
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertNFT,
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.Hex()),
+			sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyNFTID, nftID),
+			sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+			sdk.NewAttribute(types.AttributeKeyTokenID, tokenID.String()),
+		),
+	)
+
+	return tokenID, nil
+}
+
+// convertERC721IntoNFT handles the conversion of an ERC721 token of a token
+// pair held by the given owner:
+//   - check that the owner owns the ERC721 token
+//   - burn the ERC721 token
+//   - unescrow the NFT and send it to the receiver
+func (k Keeper) convertERC721IntoNFT(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	tokenID *big.Int,
+	owner common.Address,
+	receiver sdk.AccAddress,
+) (string, error) {
+	contract := pair.GetERC721Contract()
+	nftID, found := k.GetTokenMapping(ctx, contract, tokenID)
+	if !found {
+		return "", errorsmod.Wrapf(
+			types.ErrTokenMappingNotFound, "token id %s of contract %s", tokenID, pair.Erc721Address,
+		)
+	}
+
+	tokenOwner, err := k.OwnerOf(ctx, contract, tokenID)
+	if err != nil {
+		return "", err
+	}
+	if tokenOwner != owner {
+		return "", errorsmod.Wrapf(
+			types.ErrUnauthorized, "token id %s is owned by %s, not %s", tokenID, tokenOwner, owner,
+		)
+	}
+
+	if err := k.burnERC721(ctx, contract, tokenID); err != nil {
+		return "", err
+	}
+	k.DeleteTokenMapping(ctx, contract, tokenID)
+
+	// Unescrow the NFT and send it to the receiver
+	if err := k.nftKeeper.Transfer(ctx, pair.ClassId, nftID, receiver); err != nil {
+		return "", errorsmod.Wrap(err, "failed to unescrow nft")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertERC721,
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.Hex()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyNFTID, nftID),
+			sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+			sdk.NewAttribute(types.AttributeKeyTokenID, tokenID.String()),
+		),
+	)
+
+	return nftID, nil
+}
@@ -0,0 +1,127 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v19/contracts"
+	"github.com/evmos/evmos/v19/x/erc721/types"
+)
+
+// DeployERC721Contract creates and deploys an ERC721 contract on the EVM with
+// the erc721 module account as owner, named after the given x/nft class.
+func (k Keeper) DeployERC721Contract(
+	ctx sdk.Context,
+	class nft.Class,
+) (common.Address, error) {
+	ctorArgs, err := contracts.ERC721MinterBurnerContract.ABI.Pack(
+		"",
+		class.Name,
+		class.Symbol,
+	)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(types.ErrABIPack, "class metadata is invalid %s: %s", class.Id, err.Error())
+	}
+
+	data := make([]byte, len(contracts.ERC721MinterBurnerContract.Bin)+len(ctorArgs))
+	copy(data[:len(contracts.ERC721MinterBurnerContract.Bin)], contracts.ERC721MinterBurnerContract.Bin)
+	copy(data[len(contracts.ERC721MinterBurnerContract.Bin):], ctorArgs)
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	contractAddr := crypto.CreateAddress(types.ModuleAddress, nonce)
+	_, err = k.evmKeeper.CallEVMWithData(ctx, types.ModuleAddress, nil, data, true)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(err, "failed to deploy contract for %s", class.Id)
+	}
+
+	return contractAddr, nil
+}
+
+// OwnerOf queries the owner of a token of the given ERC721 contract
+func (k Keeper) OwnerOf(
+	ctx sdk.Context,
+	contract common.Address,
+	tokenID *big.Int,
+) (common.Address, error) {
+	erc721 := contracts.ERC721MinterBurnerContract.ABI
+	res, err := k.evmKeeper.CallEVM(ctx, erc721, types.ModuleAddress, contract, false, "ownerOf", tokenID)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	unpacked, err := erc721.Unpack("ownerOf", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return common.Address{}, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack owner")
+	}
+
+	owner, ok := unpacked[0].(common.Address)
+	if !ok {
+		return common.Address{}, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack owner")
+	}
+
+	return owner, nil
+}
+
+// TokenURI queries the URI of a token of the given ERC721 contract
+func (k Keeper) TokenURI(
+	ctx sdk.Context,
+	contract common.Address,
+	tokenID *big.Int,
+) (string, error) {
+	erc721 := contracts.ERC721MinterBurnerContract.ABI
+	res, err := k.evmKeeper.CallEVM(ctx, erc721, types.ModuleAddress, contract, false, "tokenURI", tokenID)
+	if err != nil {
+		return "", err
+	}
+
+	unpacked, err := erc721.Unpack("tokenURI", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return "", errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack token URI")
+	}
+
+	uri, ok := unpacked[0].(string)
+	if !ok {
+		return "", errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack token URI")
+	}
+
+	return uri, nil
+}
+
+// mintERC721 mints the token with the given URI to the receiver
+func (k Keeper) mintERC721(
+	ctx sdk.Context,
+	contract, receiver common.Address,
+	tokenID *big.Int,
+	uri string,
+) error {
+	erc721 := contracts.ERC721MinterBurnerContract.ABI
+	if _, err := k.evmKeeper.CallEVM(ctx, erc721, types.ModuleAddress, contract, true, "mint", receiver, tokenID, uri); err != nil {
+		return errorsmod.Wrap(err, "failed to mint ERC721 token")
+	}
+	return nil
+}
+
+// burnERC721 burns the token, whatever its owner
+func (k Keeper) burnERC721(
+	ctx sdk.Context,
+	contract common.Address,
+	tokenID *big.Int,
+) error {
+	erc721 := contracts.ERC721MinterBurnerContract.ABI
+	if _, err := k.evmKeeper.CallEVM(ctx, erc721, types.ModuleAddress, contract, true, "burn", tokenID); err != nil {
+		return errorsmod.Wrap(err, "failed to burn ERC721 token")
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/x/erc721"
+	"github.com/evmos/evmos/v19/x/erc721/types"
+)
+
+func (suite *KeeperTestSuite) TestExportImportGenesis() {
+	suite.SetupTest()
+	pair := suite.registerClass()
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	res, err := suite.app.Erc721Keeper.ConvertNFT(suite.ctx, types.NewMsgConvertNFT(classID, nftID, suite.address, sender))
+	suite.Require().NoError(err)
+
+	genState := erc721.ExportGenesis(suite.ctx, suite.app.Erc721Keeper)
+	suite.Require().Equal(types.DefaultParams(), genState.Params)
+	suite.Require().Equal([]types.TokenPair{pair}, genState.TokenPairs)
+	suite.Require().Equal([]types.TokenMapping{{ClassId: classID, NftId: nftID}}, genState.TokenMappings)
+	suite.Require().NoError(genState.Validate())
+
+	suite.SetupTest()
+	erc721.InitGenesis(suite.ctx, suite.app.Erc721Keeper, suite.app.AccountKeeper, *genState)
+
+	suite.Require().True(suite.app.Erc721Keeper.IsClassRegistered(suite.ctx, classID))
+	mapped, found := suite.app.Erc721Keeper.GetTokenMapping(suite.ctx, pair.GetERC721Contract(), res.TokenId.BigInt())
+	suite.Require().True(found)
+	suite.Require().Equal(nftID, mapped)
+}
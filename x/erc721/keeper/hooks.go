@@ -0,0 +1,109 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v19/contracts"
+	"github.com/evmos/evmos/v19/x/erc721/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for erc721 keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the EVM hooks of the erc721 keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. It converts the ERC721
+// tokens of the registered contracts transferred to the module address into
+// the x/nft NFTs they represent, which are sent to the previous owners of the
+// tokens. A failing conversion, including one running out of gas, returns an
+// error wrapping ErrPostTxProcessing, which reverts the transaction so that the
+// tokens are not left at the module address.
+func (h Hooks) PostTxProcessing(
+	ctx sdk.Context,
+	_ core.Message,
+	receipt *ethtypes.Receipt,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(evmtypes.ErrPostTxProcessing, "out of gas converting ERC721 tokens in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	erc721 := contracts.ERC721MinterBurnerContract.ABI
+	transferEvent := erc721.Events[types.ERC721EventTransfer]
+
+	for _, log := range receipt.Logs {
+		// Note: the ERC721 `Transfer` event contains 4 topics (id, from, to,
+		// tokenId), while the ERC20 one with the same id only has 3
+		if len(log.Topics) != 4 || log.Topics[0] != transferEvent.ID {
+			continue
+		}
+
+		// Check that the tokens are sent to module address
+		to := log.Topics[2]
+		if to.Big().Cmp(new(big.Int).SetBytes(types.ModuleAddress.Bytes())) != 0 {
+			continue
+		}
+
+		// the params are only read once a transfer to the module address is
+		// found, as the gas consumed by the hooks is charged to the tx
+		if !h.k.IsERC721Enabled(ctx) {
+			// no error is returned to avoid reverting the changes of the other
+			// hooks
+			return nil
+		}
+
+		// Check that the contract is a registered token pair
+		id := h.k.GetERC721Map(ctx, log.Address)
+		if len(id) == 0 {
+			continue
+		}
+
+		pair, found := h.k.GetTokenPair(ctx, id)
+		if !found {
+			continue
+		}
+
+		// Check that conversion for the pair is enabled
+		if !pair.Enabled {
+			// continue to allow transfers for the ERC721 in case the token pair
+			// is disabled
+			h.k.Logger(ctx).Debug(
+				"ERC721 token -> NFT conversion is disabled for pair",
+				"class", pair.ClassId, "contract", pair.Erc721Address,
+			)
+			continue
+		}
+
+		from := log.Topics[1]
+		tokenID := log.Topics[3].Big()
+		if _, err := h.k.convertERC721IntoNFT(ctx, pair, tokenID, types.ModuleAddress, from.Bytes()[12:]); err != nil {
+			return errorsmod.Wrapf(
+				evmtypes.ErrPostTxProcessing, "failed to convert token id %s of contract %s: %s",
+				tokenID, pair.Erc721Address, err,
+			)
+		}
+	}
+
+	return nil
+}
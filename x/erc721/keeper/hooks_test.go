@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v19/contracts"
+	"github.com/evmos/evmos/v19/x/erc721/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestPostTxProcessing() {
+	testCases := []struct {
+		name       string
+		malleate   func(pair types.TokenPair)
+		gasLimit   uint64
+		expErr     bool
+		expRelease bool
+	}{
+		{"token sent to the module address is converted", func(types.TokenPair) {}, 0, false, true},
+		{
+			"disabled pair",
+			func(pair types.TokenPair) {
+				_, err := suite.app.Erc721Keeper.ToggleTokenConversion(suite.ctx, pair.ClassId)
+				suite.Require().NoError(err)
+			},
+			0,
+			false,
+			false,
+		},
+		{
+			"disabled module",
+			func(types.TokenPair) {
+				suite.Require().NoError(suite.app.Erc721Keeper.SetParams(suite.ctx, types.NewParams(false)))
+			},
+			0,
+			false,
+			false,
+		},
+		{
+			// the error reverts the transfer to the module address
+			"failing conversion",
+			func(pair types.TokenPair) {
+				suite.app.Erc721Keeper.DeleteTokenMapping(suite.ctx, pair.GetERC721Contract(), types.TokenID(nftID))
+			},
+			0,
+			true,
+			false,
+		},
+		{
+			"conversion out of gas",
+			func(types.TokenPair) {},
+			1000,
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			pair := suite.registerClass()
+			contract := pair.GetERC721Contract()
+			sender := sdk.AccAddress(suite.address.Bytes())
+
+			res, err := suite.app.Erc721Keeper.ConvertNFT(suite.ctx, types.NewMsgConvertNFT(classID, nftID, suite.address, sender))
+			suite.Require().NoError(err)
+			tokenID := res.TokenId.BigInt()
+
+			tc.malleate(pair)
+
+			// the holder sends the ERC721 token to the module address
+			evmRes, err := suite.app.EvmKeeper.CallEVM(
+				suite.ctx, contracts.ERC721MinterBurnerContract.ABI, suite.address, contract, true,
+				"transferFrom", suite.address, types.ModuleAddress, tokenID,
+			)
+			suite.Require().NoError(err)
+
+			ctx := suite.ctx
+			if tc.gasLimit != 0 {
+				ctx = ctx.WithGasMeter(sdk.NewGasMeter(tc.gasLimit))
+			}
+
+			receipt := &ethtypes.Receipt{Logs: evmtypes.LogsToEthereum(evmRes.Logs)}
+			err = suite.app.Erc721Keeper.Hooks().PostTxProcessing(ctx, nil, receipt)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, evmtypes.ErrPostTxProcessing)
+				return
+			}
+			suite.Require().NoError(err)
+
+			_, found := suite.app.Erc721Keeper.GetTokenMapping(suite.ctx, contract, tokenID)
+			if tc.expRelease {
+				suite.Require().Equal(sender, suite.app.NFTKeeper.GetOwner(suite.ctx, classID, nftID))
+				suite.Require().False(found)
+				_, err = suite.app.Erc721Keeper.OwnerOf(suite.ctx, contract, tokenID)
+				suite.Require().Error(err)
+			} else {
+				suite.Require().Equal(sdk.AccAddress(types.ModuleAddress.Bytes()), suite.app.NFTKeeper.GetOwner(suite.ctx, classID, nftID))
+				suite.Require().True(found)
+				owner, err := suite.app.Erc721Keeper.OwnerOf(suite.ctx, contract, tokenID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.ModuleAddress, owner)
+			}
+		})
+	}
+}
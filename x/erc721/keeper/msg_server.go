@@ -0,0 +1,127 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/x/erc721/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// ConvertNFT converts an x/nft NFT into its ERC721 token representation
+func (k Keeper) ConvertNFT(
+	goCtx context.Context,
+	msg *types.MsgConvertNFT,
+) (*types.MsgConvertNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	receiver := common.HexToAddress(msg.Receiver)
+
+	pair, err := k.ConversionEnabled(ctx, msg.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID, err := k.convertNFTIntoERC721(ctx, pair, msg.NftId, sender, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertNFTResponse{
+		Erc721Address: pair.Erc721Address,
+		TokenId:       math.NewIntFromBigInt(tokenID),
+	}, nil
+}
+
+// ConvertERC721 converts an ERC721 token into the x/nft NFT it represents
+func (k Keeper) ConvertERC721(
+	goCtx context.Context,
+	msg *types.MsgConvertERC721,
+) (*types.MsgConvertERC721Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+	sender := common.HexToAddress(msg.Sender)
+
+	pair, err := k.ConversionEnabled(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	nftID, err := k.convertERC721IntoNFT(ctx, pair, msg.TokenId.BigInt(), sender, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertERC721Response{
+		ClassId: pair.ClassId,
+		NftId:   nftID,
+	}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the parameters in the keeper only if the requested authority
+// is the Cosmos SDK governance module account
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RegisterClass implements the gRPC MsgServer interface. After a successful governance vote
+// it deploys an ERC721 contract for the x/nft class and registers the token
+// pair only if the requested authority is the Cosmos SDK governance module account
+func (k Keeper) RegisterClass(goCtx context.Context, req *types.MsgRegisterClass) (*types.MsgRegisterClassResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsERC721Enabled(ctx) {
+		return nil, errorsmod.Wrap(
+			types.ErrERC721Disabled, "registration is currently disabled by governance",
+		)
+	}
+
+	pair, err := k.RegisterNFTClass(ctx, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterClassResponse{Erc721Address: pair.Erc721Address}, nil
+}
+
+// ToggleConversion implements the gRPC MsgServer interface. After a successful governance vote
+// it toggles the conversions of the token pair only if the requested authority
+// is the Cosmos SDK governance module account
+func (k Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleConversion) (*types.MsgToggleConversionResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.ToggleTokenConversion(ctx, req.Token); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgToggleConversionResponse{}, nil
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/erc721/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterClass() {
+	suite.SetupTest()
+	suite.mintNFT()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// only governance can register a class
+	_, err := suite.app.Erc721Keeper.RegisterClass(suite.ctx, &types.MsgRegisterClass{Authority: suite.address.Hex(), ClassId: classID})
+	suite.Require().Error(err)
+
+	_, err = suite.app.Erc721Keeper.RegisterClass(suite.ctx, &types.MsgRegisterClass{Authority: authority, ClassId: "unknown"})
+	suite.Require().Error(err)
+
+	res, err := suite.app.Erc721Keeper.RegisterClass(suite.ctx, &types.MsgRegisterClass{Authority: authority, ClassId: classID})
+	suite.Require().NoError(err)
+
+	_, err = suite.app.Erc721Keeper.RegisterClass(suite.ctx, &types.MsgRegisterClass{Authority: authority, ClassId: classID})
+	suite.Require().ErrorIs(err, types.ErrTokenPairAlreadyExists)
+
+	queryRes, err := suite.queryClient.TokenPair(suite.ctx, &types.QueryTokenPairRequest{Token: classID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Erc721Address, queryRes.TokenPair.Erc721Address)
+	suite.Require().True(queryRes.TokenPair.Enabled)
+
+	queryRes, err = suite.queryClient.TokenPair(suite.ctx, &types.QueryTokenPairRequest{Token: res.Erc721Address})
+	suite.Require().NoError(err)
+	suite.Require().Equal(classID, queryRes.TokenPair.ClassId)
+
+	pairsRes, err := suite.queryClient.TokenPairs(suite.ctx, &types.QueryTokenPairsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenPair{queryRes.TokenPair}, pairsRes.TokenPairs)
+}
+
+func (suite *KeeperTestSuite) TestConvertRoundTrip() {
+	suite.SetupTest()
+	pair := suite.registerClass()
+	contract := pair.GetERC721Contract()
+	sender := sdk.AccAddress(suite.address.Bytes())
+	receiver := utiltx.GenerateAddress()
+
+	res, err := suite.app.Erc721Keeper.ConvertNFT(suite.ctx, types.NewMsgConvertNFT(classID, nftID, receiver, sender))
+	suite.Require().NoError(err)
+	suite.Require().Equal(pair.Erc721Address, res.Erc721Address)
+	suite.Require().Equal(types.TokenID(nftID), res.TokenId.BigInt())
+
+	// the NFT is escrowed and the ERC721 token carries its URI
+	suite.Require().Equal(sdk.AccAddress(types.ModuleAddress.Bytes()), suite.app.NFTKeeper.GetOwner(suite.ctx, classID, nftID))
+	owner, err := suite.app.Erc721Keeper.OwnerOf(suite.ctx, contract, res.TokenId.BigInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(receiver, owner)
+	uri, err := suite.app.Erc721Keeper.TokenURI(suite.ctx, contract, res.TokenId.BigInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(nftURI, uri)
+
+	// the NFT can't be converted twice
+	_, err = suite.app.Erc721Keeper.ConvertNFT(suite.ctx, types.NewMsgConvertNFT(classID, nftID, receiver, sender))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// only the token owner can convert it back
+	_, err = suite.app.Erc721Keeper.ConvertERC721(suite.ctx, types.NewMsgConvertERC721(res.TokenId, sender, contract, suite.address))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, receiver.Bytes())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	backRes, err := suite.app.Erc721Keeper.ConvertERC721(suite.ctx, types.NewMsgConvertERC721(res.TokenId, sender, contract, receiver))
+	suite.Require().NoError(err)
+	suite.Require().Equal(classID, backRes.ClassId)
+	suite.Require().Equal(nftID, backRes.NftId)
+
+	suite.Require().Equal(sender, suite.app.NFTKeeper.GetOwner(suite.ctx, classID, nftID))
+	token, found := suite.app.NFTKeeper.GetNFT(suite.ctx, classID, nftID)
+	suite.Require().True(found)
+	suite.Require().Equal(nftURI, token.Uri)
+	_, found = suite.app.Erc721Keeper.GetTokenMapping(suite.ctx, contract, res.TokenId.BigInt())
+	suite.Require().False(found)
+
+	// the burnt token no longer exists
+	_, err = suite.app.Erc721Keeper.OwnerOf(suite.ctx, contract, res.TokenId.BigInt())
+	suite.Require().Error(err)
+	_, err = suite.app.Erc721Keeper.ConvertERC721(suite.ctx, types.NewMsgConvertERC721(res.TokenId, sender, contract, receiver))
+	suite.Require().ErrorIs(err, types.ErrTokenMappingNotFound)
+}
+
+func (suite *KeeperTestSuite) TestConvertDisabled() {
+	suite.SetupTest()
+	pair := suite.registerClass()
+	sender := sdk.AccAddress(suite.address.Bytes())
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msg := types.NewMsgConvertNFT(classID, nftID, suite.address, sender)
+
+	_, err := suite.app.Erc721Keeper.ConvertNFT(suite.ctx, types.NewMsgConvertNFT("unknown", nftID, suite.address, sender))
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	// the conversions of the pair are disabled
+	_, err = suite.app.Erc721Keeper.ToggleConversion(suite.ctx, &types.MsgToggleConversion{Authority: sender.String(), Token: classID})
+	suite.Require().Error(err)
+	_, err = suite.app.Erc721Keeper.ToggleConversion(suite.ctx, &types.MsgToggleConversion{Authority: authority, Token: pair.Erc721Address})
+	suite.Require().NoError(err)
+
+	_, err = suite.app.Erc721Keeper.ConvertNFT(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrERC721TokenPairDisabled)
+
+	_, err = suite.app.Erc721Keeper.ToggleConversion(suite.ctx, &types.MsgToggleConversion{Authority: authority, Token: classID})
+	suite.Require().NoError(err)
+
+	// all the conversions are disabled
+	params := types.NewParams(false)
+	_, err = suite.app.Erc721Keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: sender.String(), Params: params})
+	suite.Require().Error(err)
+	_, err = suite.app.Erc721Keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	suite.Require().NoError(err)
+
+	paramsRes, err := suite.queryClient.Params(suite.ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, paramsRes.Params)
+
+	_, err = suite.app.Erc721Keeper.ConvertNFT(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrERC721Disabled)
+	_, err = suite.app.Erc721Keeper.ConvertERC721(suite.ctx, types.NewMsgConvertERC721(
+		math.NewIntFromBigInt(big.NewInt(1)), sender, pair.GetERC721Contract(), suite.address,
+	))
+	suite.Require().ErrorIs(err, types.ErrERC721Disabled)
+}
@@ -0,0 +1,81 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/evmos/evmos/v19/x/erc721/types"
+)
+
+// RegisterNFTClass deploys an ERC721 contract owned by the module for the
+// x/nft class and registers the token pair between them
+func (k Keeper) RegisterNFTClass(
+	ctx sdk.Context,
+	classID string,
+) (*types.TokenPair, error) {
+	if k.IsClassRegistered(ctx, classID) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "nft class already registered: %s", classID,
+		)
+	}
+
+	class, found := k.nftKeeper.GetClass(ctx, classID)
+	if !found {
+		return nil, errorsmod.Wrapf(nft.ErrClassNotExists, "class %s", classID)
+	}
+
+	contract, err := k.DeployERC721Contract(ctx, class)
+	if err != nil {
+		return nil, err
+	}
+
+	pair := types.NewTokenPair(contract, classID)
+	k.SetToken(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterClass,
+			sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+		),
+	)
+
+	return &pair, nil
+}
+
+// ToggleTokenConversion toggles conversion for a given token pair
+func (k Keeper) ToggleTokenConversion(
+	ctx sdk.Context,
+	token string,
+) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	pair.Enabled = !pair.Enabled
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeToggleTokenConversion,
+			sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+		),
+	)
+
+	return pair, nil
+}
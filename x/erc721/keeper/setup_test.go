@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/erc721/types"
+)
+
+const (
+	classID = "kitties"
+	nftID   = "kitty-1"
+	nftURI  = "ipfs://kitty-1"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.Evmos
+	queryClient types.QueryClient
+
+	// owner of the x/nft NFT of the tests
+	address common.Address
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	chainID := utils.TestnetChainID + "-1"
+	suite.app = app.Setup(false, nil, chainID)
+
+	// the EVM calls need a proposer from the validator set
+	header := testutil.NewHeader(1, time.Now().UTC(), chainID, sdk.ConsAddress{}, nil, nil)
+	validators := suite.app.StakingKeeper.GetValidators(suite.app.NewContext(false, header), 1)
+	suite.Require().Len(validators, 1)
+	consAddr, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+
+	header.ProposerAddress = consAddr
+	suite.ctx = suite.app.NewContext(false, header)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.Erc721Keeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	// the account sends the EVM calls converting its ERC721 tokens
+	suite.address = utiltx.GenerateAddress()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, suite.address.Bytes())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+}
+
+// mintNFT saves the x/nft class of the tests and mints an NFT of it to the
+// test address.
+func (suite *KeeperTestSuite) mintNFT() {
+	err := suite.app.NFTKeeper.SaveClass(suite.ctx, nft.Class{Id: classID, Name: "Kitties", Symbol: "KIT"})
+	suite.Require().NoError(err)
+
+	err = suite.app.NFTKeeper.Mint(suite.ctx, nft.NFT{ClassId: classID, Id: nftID, Uri: nftURI}, suite.address.Bytes())
+	suite.Require().NoError(err)
+}
+
+// registerClass mints the NFT of the tests and registers its class.
+func (suite *KeeperTestSuite) registerClass() types.TokenPair {
+	suite.mintNFT()
+
+	pair, err := suite.app.Erc721Keeper.RegisterNFTClass(suite.ctx, classID)
+	suite.Require().NoError(err)
+	return *pair
+}
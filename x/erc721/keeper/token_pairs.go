@@ -0,0 +1,171 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/x/erc721/types"
+)
+
+// SetToken stores a token pair, class map and erc721 map.
+func (k Keeper) SetToken(ctx sdk.Context, pair types.TokenPair) {
+	k.SetTokenPair(ctx, pair)
+	k.SetClassMap(ctx, pair.ClassId, pair.GetID())
+	k.SetERC721Map(ctx, pair.GetERC721Contract(), pair.GetID())
+}
+
+// GetTokenPairs gets all registered token pairs.
+func (k Keeper) GetTokenPairs(ctx sdk.Context) []types.TokenPair {
+	tokenPairs := []types.TokenPair{}
+
+	k.IterateTokenPairs(ctx, func(tokenPair types.TokenPair) (stop bool) {
+		tokenPairs = append(tokenPairs, tokenPair)
+		return false
+	})
+
+	return tokenPairs
+}
+
+// IterateTokenPairs iterates over all the stored token pairs.
+func (k Keeper) IterateTokenPairs(ctx sdk.Context, cb func(tokenPair types.TokenPair) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixTokenPair)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenPair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &tokenPair)
+
+		if cb(tokenPair) {
+			break
+		}
+	}
+}
+
+// GetTokenPairID returns the pair id for the specified token. Hex address or
+// class id can be used as token argument. If the token is not registered empty
+// bytes are returned.
+func (k Keeper) GetTokenPairID(ctx sdk.Context, token string) []byte {
+	if common.IsHexAddress(token) {
+		addr := common.HexToAddress(token)
+		return k.GetERC721Map(ctx, addr)
+	}
+	return k.GetClassMap(ctx, token)
+}
+
+// GetTokenPair gets a registered token pair from the identifier.
+func (k Keeper) GetTokenPair(ctx sdk.Context, id []byte) (types.TokenPair, bool) {
+	if id == nil {
+		return types.TokenPair{}, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	var tokenPair types.TokenPair
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &tokenPair)
+	return tokenPair, true
+}
+
+// SetTokenPair stores a token pair.
+func (k Keeper) SetTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	key := tokenPair.GetID()
+	bz := k.cdc.MustMarshal(&tokenPair)
+	store.Set(key, bz)
+}
+
+// GetERC721Map returns the token pair id for the given address.
+func (k Keeper) GetERC721Map(ctx sdk.Context, erc721 common.Address) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC721)
+	return store.Get(erc721.Bytes())
+}
+
+// GetClassMap returns the token pair id for the given class id.
+func (k Keeper) GetClassMap(ctx sdk.Context, classID string) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByClass)
+	return store.Get([]byte(classID))
+}
+
+// SetERC721Map sets the token pair id for the given address.
+func (k Keeper) SetERC721Map(ctx sdk.Context, erc721 common.Address, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC721)
+	store.Set(erc721.Bytes(), id)
+}
+
+// SetClassMap sets the token pair id for the class id.
+func (k Keeper) SetClassMap(ctx sdk.Context, classID string, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByClass)
+	store.Set([]byte(classID), id)
+}
+
+// IsERC721Registered check if registered ERC721 token is registered.
+func (k Keeper) IsERC721Registered(ctx sdk.Context, erc721 common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC721)
+	return store.Has(erc721.Bytes())
+}
+
+// IsClassRegistered check if registered nft class is registered.
+func (k Keeper) IsClassRegistered(ctx sdk.Context, classID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByClass)
+	return store.Has([]byte(classID))
+}
+
+// GetTokenMapping returns the id of the NFT represented by the given token id
+// of the ERC721 contract.
+func (k Keeper) GetTokenMapping(ctx sdk.Context, erc721 common.Address, tokenID *big.Int) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TokenMappingKey(erc721, common.BigToHash(tokenID)))
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetTokenMapping stores the id of the NFT represented by its token id on the
+// ERC721 contract.
+func (k Keeper) SetTokenMapping(ctx sdk.Context, erc721 common.Address, nftID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TokenMappingKey(erc721, common.BigToHash(types.TokenID(nftID))), []byte(nftID))
+}
+
+// DeleteTokenMapping removes the id of the NFT represented by the given token
+// id of the ERC721 contract.
+func (k Keeper) DeleteTokenMapping(ctx sdk.Context, erc721 common.Address, tokenID *big.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TokenMappingKey(erc721, common.BigToHash(tokenID)))
+}
+
+// GetTokenMappings returns the NFTs currently converted to ERC721 tokens.
+func (k Keeper) GetTokenMappings(ctx sdk.Context) []types.TokenMapping {
+	mappings := []types.TokenMapping{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixTokenMapping)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contract := common.BytesToAddress(iterator.Key()[len(types.KeyPrefixTokenMapping) : len(types.KeyPrefixTokenMapping)+common.AddressLength])
+		pair, found := k.GetTokenPair(ctx, k.GetERC721Map(ctx, contract))
+		if !found {
+			continue
+		}
+
+		mappings = append(mappings, types.TokenMapping{
+			ClassId: pair.ClassId,
+			NftId:   string(iterator.Value()),
+		})
+	}
+
+	return mappings
+}
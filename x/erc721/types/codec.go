@@ -0,0 +1,59 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+	// ModuleCdc references the global erc721 module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	convertNFTName       = "evmos/erc721/MsgConvertNFT"
+	convertERC721Name    = "evmos/erc721/MsgConvertERC721"
+	updateParamsName     = "evmos/erc721/MsgUpdateParams"
+	registerClassName    = "evmos/erc721/MsgRegisterClass"
+	toggleConversionName = "evmos/erc721/MsgToggleConversion"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgConvertNFT{},
+		&MsgConvertERC721{},
+		&MsgUpdateParams{},
+		&MsgRegisterClass{},
+		&MsgToggleConversion{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec required for EIP-712
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgConvertNFT{}, convertNFTName, nil)
+	cdc.RegisterConcrete(&MsgConvertERC721{}, convertERC721Name, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgRegisterClass{}, registerClassName, nil)
+	cdc.RegisterConcrete(&MsgToggleConversion{}, toggleConversionName, nil)
+}
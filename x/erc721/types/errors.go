@@ -0,0 +1,22 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrERC721Disabled          = errorsmod.Register(ModuleName, 2, "erc721 module is disabled")
+	ErrTokenPairNotFound       = errorsmod.Register(ModuleName, 3, "token pair not found")
+	ErrTokenPairAlreadyExists  = errorsmod.Register(ModuleName, 4, "token pair already exists")
+	ErrERC721TokenPairDisabled = errorsmod.Register(ModuleName, 5, "erc721 token pair is disabled")
+	ErrABIPack                 = errorsmod.Register(ModuleName, 6, "contract ABI pack failed")
+	ErrABIUnpack               = errorsmod.Register(ModuleName, 7, "contract ABI unpack failed")
+	ErrEVMCall                 = errorsmod.Register(ModuleName, 8, "EVM call unexpected error")
+	ErrUnauthorized            = errorsmod.Register(ModuleName, 9, "sender is not the owner of the token")
+	ErrTokenMappingNotFound    = errorsmod.Register(ModuleName, 10, "token mapping not found")
+	ErrOwnershipInvariance     = errorsmod.Register(ModuleName, 11, "post conversion ownership invariant failed")
+)
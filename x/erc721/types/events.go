@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// erc721 events
+const (
+	EventTypeConvertNFT            = "convert_nft"
+	EventTypeConvertERC721         = "convert_erc721"
+	EventTypeRegisterClass         = "register_class"
+	EventTypeToggleTokenConversion = "toggle_token_conversion" // #nosec
+
+	AttributeKeyClassID     = "class_id"
+	AttributeKeyNFTID       = "nft_id"
+	AttributeKeyERC721Token = "erc721_token"
+	AttributeKeyTokenID     = "token_id"
+	AttributeKeyReceiver    = "receiver"
+)
+
+// ERC721EventTransfer is the name of the Transfer event of the ERC721 contract
+const ERC721EventTransfer = "Transfer"
@@ -0,0 +1,65 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, pairs []TokenPair, mappings []TokenMapping) GenesisState {
+	return GenesisState{
+		Params:        params,
+		TokenPairs:    pairs,
+		TokenMappings: mappings,
+	}
+}
+
+// DefaultGenesisState sets default erc721 genesis state with default params
+// and no token pairs.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenERC721 := make(map[string]bool)
+	seenClass := make(map[string]bool)
+
+	for _, pair := range gs.TokenPairs {
+		if seenERC721[pair.Erc721Address] {
+			return fmt.Errorf("token ERC721 contract duplicated on genesis '%s'", pair.Erc721Address)
+		}
+		if seenClass[pair.ClassId] {
+			return fmt.Errorf("nft class duplicated on genesis: '%s'", pair.ClassId)
+		}
+
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+
+		seenERC721[pair.Erc721Address] = true
+		seenClass[pair.ClassId] = true
+	}
+
+	seenMapping := make(map[TokenMapping]bool)
+	for _, mapping := range gs.TokenMappings {
+		if !seenClass[mapping.ClassId] {
+			return fmt.Errorf("token mapping of unregistered nft class '%s'", mapping.ClassId)
+		}
+		if mapping.NftId == "" {
+			return fmt.Errorf("empty nft id in token mapping of class '%s'", mapping.ClassId)
+		}
+		if seenMapping[mapping] {
+			return fmt.Errorf("token mapping duplicated on genesis: '%s/%s'", mapping.ClassId, mapping.NftId)
+		}
+		seenMapping[mapping] = true
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params on genesis: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// AccountKeeper defines the expected interface needed to retrieve account info.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+	GetSequence(ctx sdk.Context, addr sdk.AccAddress) (uint64, error)
+}
+
+// NFTKeeper defines the expected interface needed to escrow and release the
+// x/nft NFTs.
+type NFTKeeper interface {
+	GetClass(ctx sdk.Context, classID string) (nft.Class, bool)
+	HasClass(ctx sdk.Context, classID string) bool
+	GetNFT(ctx sdk.Context, classID, nftID string) (nft.NFT, bool)
+	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
+	Transfer(ctx sdk.Context, classID string, nftID string, receiver sdk.AccAddress) error
+}
+
+// EVMKeeper defines the expected EVM keeper interface used on erc721
+type EVMKeeper interface {
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	CallEVM(ctx sdk.Context, abi abi.ABI, from, contract common.Address, commit bool, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+	CallEVMWithData(ctx sdk.Context, from common.Address, contract *common.Address, data []byte, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
+}
@@ -0,0 +1,52 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "erc721"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the native module address for ERC-721
+var ModuleAddress common.Address
+
+func init() {
+	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
+}
+
+// prefix bytes for the ERC-721 persistent store
+const (
+	prefixParams = iota + 1
+	prefixTokenPair
+	prefixTokenPairByERC721
+	prefixTokenPairByClass
+	prefixTokenMapping
+)
+
+// KVStore key prefixes
+var (
+	ParamsKey                  = []byte{prefixParams}
+	KeyPrefixTokenPair         = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC721 = []byte{prefixTokenPairByERC721}
+	KeyPrefixTokenPairByClass  = []byte{prefixTokenPairByClass}
+	KeyPrefixTokenMapping      = []byte{prefixTokenMapping}
+)
+
+// TokenMappingKey returns the key of the x/nft identifier of the given token
+// id of an ERC721 contract.
+func TokenMappingKey(contract common.Address, tokenID common.Hash) []byte {
+	return append(append(KeyPrefixTokenMapping, contract.Bytes()...), tokenID.Bytes()...)
+}
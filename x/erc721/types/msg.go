@@ -0,0 +1,181 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v19/types"
+)
+
+var (
+	_ sdk.Msg = &MsgConvertNFT{}
+	_ sdk.Msg = &MsgConvertERC721{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRegisterClass{}
+	_ sdk.Msg = &MsgToggleConversion{}
+)
+
+const (
+	TypeMsgConvertNFT    = "convert_nft"
+	TypeMsgConvertERC721 = "convert_ERC721"
+)
+
+// NewMsgConvertNFT creates a new instance of MsgConvertNFT
+func NewMsgConvertNFT(classID, nftID string, receiver common.Address, sender sdk.AccAddress) *MsgConvertNFT { //nolint: interfacer
+	return &MsgConvertNFT{
+		ClassId:  classID,
+		NftId:    nftID,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertNFT) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertNFT) Type() string { return TypeMsgConvertNFT }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertNFT) ValidateBasic() error {
+	if msg.ClassId == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "class id cannot be empty")
+	}
+	if msg.NftId == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "nft id cannot be empty")
+	}
+	if err := evmostypes.ValidateNonZeroAddress(msg.Receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver hex address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConvertNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertNFT) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertERC721 creates a new instance of MsgConvertERC721
+func NewMsgConvertERC721(tokenID math.Int, receiver sdk.AccAddress, contract, sender common.Address) *MsgConvertERC721 { //nolint: interfacer
+	return &MsgConvertERC721{
+		ContractAddress: contract.String(),
+		TokenId:         tokenID,
+		Receiver:        receiver.String(),
+		Sender:          sender.Hex(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertERC721) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertERC721) Type() string { return TypeMsgConvertERC721 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC721) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if msg.TokenId.IsNil() || msg.TokenId.IsNegative() || msg.TokenId.BigInt().BitLen() > 256 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid ERC721 token id %s", msg.TokenId)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+	if !common.IsHexAddress(msg.Sender) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConvertERC721) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertERC721) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRegisterClass message.
+func (m *MsgRegisterClass) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterClass) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.ClassId == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "class id cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterClass) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgToggleConversion message.
+func (m *MsgToggleConversion) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgToggleConversion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.Token == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "token cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgToggleConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgConvertValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(common.HexToAddress("0xf").Bytes())
+	receiver := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		expPass bool
+	}{
+		{"convert nft", NewMsgConvertNFT("kitties", "kitty-1", receiver, sender), true},
+		{"convert nft - empty class", NewMsgConvertNFT("", "kitty-1", receiver, sender), false},
+		{"convert nft - empty nft id", NewMsgConvertNFT("kitties", "", receiver, sender), false},
+		{"convert nft - zero receiver", NewMsgConvertNFT("kitties", "kitty-1", common.Address{}, sender), false},
+		{"convert erc721", NewMsgConvertERC721(math.NewInt(1), sender, receiver, receiver), true},
+		{"convert erc721 - negative token id", NewMsgConvertERC721(math.NewInt(-1), sender, receiver, receiver), false},
+		{"convert erc721 - invalid receiver", &MsgConvertERC721{ContractAddress: receiver.Hex(), TokenId: math.NewInt(1), Receiver: "invalid", Sender: receiver.Hex()}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	pair := NewTokenPair(common.HexToAddress("0x1111111111111111111111111111111111111111"), "kitties")
+	otherPair := NewTokenPair(common.HexToAddress("0x2222222222222222222222222222222222222222"), "kitties")
+	mapping := TokenMapping{ClassId: "kitties", NftId: "kitty-1"}
+
+	testCases := []struct {
+		name     string
+		genState GenesisState
+		expPass  bool
+	}{
+		{"default", *DefaultGenesisState(), true},
+		{"pair and mapping", NewGenesisState(DefaultParams(), []TokenPair{pair}, []TokenMapping{mapping}), true},
+		{"duplicated pair", NewGenesisState(DefaultParams(), []TokenPair{pair, pair}, nil), false},
+		{"duplicated class", NewGenesisState(DefaultParams(), []TokenPair{pair, otherPair}, nil), false},
+		{"invalid pair", NewGenesisState(DefaultParams(), []TokenPair{{Erc721Address: "0x", ClassId: "kitties"}}, nil), false},
+		{"unregistered class mapping", NewGenesisState(DefaultParams(), nil, []TokenMapping{mapping}), false},
+		{"empty nft id", NewGenesisState(DefaultParams(), []TokenPair{pair}, []TokenMapping{{ClassId: "kitties"}}), false},
+		{"duplicated mapping", NewGenesisState(DefaultParams(), []TokenPair{pair}, []TokenMapping{mapping, mapping}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
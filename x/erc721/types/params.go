@@ -0,0 +1,26 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// DefaultEnableERC721 enables the conversions of the NFTs by default
+var DefaultEnableERC721 = true
+
+// NewParams creates a new Params object
+func NewParams(enableERC721 bool) Params {
+	return Params{
+		EnableErc721: enableERC721,
+	}
+}
+
+// DefaultParams returns the default erc721 module params
+func DefaultParams() Params {
+	return Params{
+		EnableErc721: DefaultEnableERC721,
+	}
+}
+
+// Validate performs a stateless validation of the erc721 params
+func (p Params) Validate() error {
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmostypes "github.com/evmos/evmos/v19/types"
+)
+
+// NewTokenPair returns an instance of TokenPair
+func NewTokenPair(erc721Address common.Address, classID string) TokenPair {
+	return TokenPair{
+		Erc721Address: erc721Address.String(),
+		ClassId:       classID,
+		Enabled:       true,
+	}
+}
+
+// GetID returns the SHA256 hash of the ERC721 address and class id
+func (tp TokenPair) GetID() []byte {
+	id := tp.Erc721Address + "|" + tp.ClassId
+	return tmhash.Sum([]byte(id))
+}
+
+// GetERC721Contract casts the hex string address of the ERC721 to common.Address
+func (tp TokenPair) GetERC721Contract() common.Address {
+	return common.HexToAddress(tp.Erc721Address)
+}
+
+// Validate performs a stateless validation of a TokenPair
+func (tp TokenPair) Validate() error {
+	if tp.ClassId == "" {
+		return fmt.Errorf("class id cannot be empty")
+	}
+
+	return evmostypes.ValidateNonZeroAddress(tp.Erc721Address)
+}
+
+// TokenID returns the ERC721 token id representing the NFT of the given id,
+// which is the Keccak-256 hash of the NFT id.
+func TokenID(nftID string) *big.Int {
+	return new(big.Int).SetBytes(crypto.Keccak256([]byte(nftID)))
+}
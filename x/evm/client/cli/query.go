@@ -3,6 +3,8 @@
 package cli
 
 import (
+	"strconv"
+
 	rpctypes "github.com/evmos/evmos/v19/rpc/types"
 	"github.com/spf13/cobra"
 
@@ -23,13 +25,59 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		GetAccountCmd(),
 		GetStorageCmd(),
 		GetCodeCmd(),
+		GetStorageDiffCmd(),
+		GetStorageRangeCmd(),
 		GetParamsCmd(),
+		GetAccessPolicyCmd(),
+		GetOpcodeGasOverridesCmd(),
+		GetTopContractsByGasCmd(),
+		GetPerContractGasCmd(),
+		GetContractGasStatsCmd(),
+		GetGasPriceFloorCmd(),
 	)
 	return cmd
 }
 
+// GetAccountCmd queries the balance, nonce, code hash and type of an account
+func GetAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account ADDRESS",
+		Short: "Gets the balance, nonce, code hash and type of an account",
+		Long:  "Gets the balance, nonce, code hash and type of an account. If the height is not provided, it will use the latest height from context.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAccountInfoRequest{
+				Address: address,
+			}
+
+			res, err := queryClient.AccountInfo(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetStorageCmd queries a key in an accounts storage
 func GetStorageCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -107,6 +155,112 @@ func GetCodeCmd() *cobra.Command {
 	return cmd
 }
 
+// GetStorageDiffCmd queries the storage changes of a contract between two heights
+func GetStorageDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-diff ADDRESS HEIGHT_A HEIGHT_B",
+		Short: "Gets the storage changes of a contract between two heights",
+		Long:  "Gets the storage slots of a contract that were added, removed or changed between two heights. Both heights must not be pruned.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			heightA, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			heightB, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryStorageDiffRequest{
+				Address:    address,
+				HeightA:    heightA,
+				HeightB:    heightB,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.StorageDiff(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "storage-diff")
+	return cmd
+}
+
+// GetStorageRangeCmd queries the storage of a contract at a height
+func GetStorageRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-range ADDRESS HEIGHT",
+		Short: "Gets the storage of a contract at a height",
+		Long:  "Gets the storage slots of a contract at a height in ascending key order, starting from the page key. The height must not be pruned.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryStorageRangeRequest{
+				Address:    address,
+				Height:     height,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.StorageRange(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "storage-range")
+	return cmd
+}
+
 // GetParamsCmd queries the fee market params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -134,3 +288,224 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetAccessPolicyCmd queries the access policy restricting the contract
+// deployments and calls
+func GetAccessPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access-policy",
+		Short: "Get the evm access policy",
+		Long:  "Get the addresses allowed or denied to deploy contracts and the contracts allowed or denied to be called.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccessPolicy(cmd.Context(), &types.QueryAccessPolicyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetOpcodeGasOverridesCmd queries the opcode gas overrides applied at the current height
+func GetOpcodeGasOverridesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opcode-gas-overrides",
+		Short: "Get the opcode gas overrides",
+		Long:  "Get the constant gas costs of the opcodes that are overridden at the current height, together with the activation height of the overrides.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OpcodeGasOverrides(cmd.Context(), &types.QueryOpcodeGasOverridesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTopContractsByGasCmd queries the contracts called in an epoch by descending gas used
+func GetTopContractsByGasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-contracts-by-gas EPOCH",
+		Short: "Gets the contracts called in an epoch by descending gas used",
+		Long:  "Gets the contracts called by the Ethereum txs of a contract gas epoch, sorted by descending gas used, together with the current epoch.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTopContractsByGasRequest{
+				Epoch:      epoch,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TopContractsByGas(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "top-contracts-by-gas")
+	return cmd
+}
+
+// GetPerContractGasCmd queries the gas used by the txs calling a contract in an epoch
+func GetPerContractGasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-gas ADDRESS EPOCH",
+		Short: "Gets the gas used by the txs calling a contract in an epoch",
+		Long:  "Gets the gas used by the Ethereum txs calling a contract in a contract gas epoch, together with the current epoch.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPerContractGasRequest{
+				Address: address,
+				Epoch:   epoch,
+			}
+
+			res, err := queryClient.PerContractGas(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetContractGasStatsCmd queries the execution statistics of the txs calling a contract in an epoch
+func GetContractGasStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-gas-stats ADDRESS EPOCH",
+		Short: "Gets the gas used, calls and reverts of the txs calling a contract in an epoch",
+		Long:  "Gets the gas used, the number of calls, the number of reverted calls and the revert rate of the Ethereum txs calling a contract in a contract gas epoch, together with the current epoch.", //nolint:lll
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryContractGasStatsRequest{
+				Address: address,
+				Epoch:   epoch,
+			}
+
+			res, err := queryClient.ContractGasStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetGasPriceFloorCmd queries the gas price floor of the node and the fee recommended for an amount of gas
+func GetGasPriceFloorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-price-floor GAS",
+		Short: "Gets the gas price floor of the node and the fee recommended for an amount of gas",
+		Long: `Gets the minimum gas price of the Ethereum txs accepted by the queried node, which is the highest of the base fee, the global minimum gas price and the node minimum gas price in the EVM denomination, together with the fee paying the given amount of gas at that price.
+The base fee and the global minimum gas price are enforced by consensus, while the minimum gas prices of the node are local to the queried node and can differ between nodes.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			gas, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GasPriceFloor(cmd.Context(), &types.QueryGasPriceFloorRequest{Gas: gas})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
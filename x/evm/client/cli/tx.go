@@ -10,6 +10,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -27,7 +30,10 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewMigrateAccountCmd(),
+	)
 	return cmd
 }
 
@@ -111,3 +117,73 @@ func NewRawTxCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewMigrateAccountCmd returns a CLI command to migrate an account controlled by
+// a Cosmos secp256k1 key to an account controlled by an Ethereum key. The
+// migration is signed by both keys from the keyring, while the transaction is
+// signed and paid for by the --from account.
+func NewMigrateAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-account FROM_KEY TO_KEY",
+		Short: "Migrate the state of an account controlled by a secp256k1 key to an account controlled by an eth_secp256k1 key",
+		Long: `Migrate the balances, delegations, unbonding delegations, redelegations, vesting schedule and authz grants
+of the account of the secp256k1 key FROM_KEY to the account of the eth_secp256k1 key TO_KEY.
+The transaction is signed and paid for by the --from account, which can be the destination account.`,
+		Example: fmt.Sprintf("%s tx %s migrate-account cosmos-key eth-key --from eth-key", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := keyAddress(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			to, err := keyAddress(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			fromSig, fromPubKey, err := clientCtx.Keyring.Sign(args[0], types.MigrateAccountSignBytes(clientCtx.ChainID, from, to))
+			if err != nil {
+				return errors.Wrap(err, "failed to sign the migration with the key of the migrated account")
+			}
+
+			// NOTE: the eth_secp256k1 keys sign 32 bytes digests as is
+			toSig, _, err := clientCtx.Keyring.Sign(args[1], types.MigrateAccountEthSignHash(clientCtx.ChainID, from, to))
+			if err != nil {
+				return errors.Wrap(err, "failed to sign the migration with the key of the destination account")
+			}
+
+			msg := &types.MsgMigrateAccount{
+				Sender:        clientCtx.GetFromAddress().String(),
+				FromAddress:   from.String(),
+				FromPubKey:    fromPubKey.Bytes(),
+				FromSignature: fromSig,
+				ToAddress:     to.String(),
+				ToSignature:   toSig,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// keyAddress returns the address of the given key of the keyring.
+func keyAddress(clientCtx client.Context, name string) (sdk.AccAddress, error) {
+	record, err := clientCtx.Keyring.Key(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find key %s", name)
+	}
+	return record.GetAddress()
+}
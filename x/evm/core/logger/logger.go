@@ -1,11 +1,14 @@
 package logger
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
+	"sort"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -19,6 +22,10 @@ import (
 	"github.com/holiman/uint256"
 )
 
+// ErrTraceOutputTooLarge is returned when the output of a trace exceeds the
+// limits set in the logger configuration.
+var ErrTraceOutputTooLarge = errors.New("trace output too large")
+
 // Storage represents a contract's storage.
 type Storage map[common.Hash]common.Hash
 
@@ -39,6 +46,8 @@ type Config struct {
 	EnableReturnData bool // enable return data capture
 	Debug            bool // print output during capture end
 	Limit            int  // maximum length of output, but zero means unlimited
+	MaxStructLogs    int  // maximum number of struct logs before the trace fails, zero means unlimited
+	MaxResultBytes   int  // maximum size of the result before the trace fails, zero means unlimited
 	// Chain overrides, can be used to execute a trace using future fork rules
 	Overrides *params.ChainConfig `json:"overrides,omitempty"`
 }
@@ -100,6 +109,8 @@ type StructLogger struct {
 	err      error
 	gasLimit uint64
 	usedGas  uint64
+	// estimated size of the formatted struct logs
+	resultSize int
 
 	interrupt uint32 // Atomic flag to signal execution interruption
 	reason    error  // Textual reason for the interruption
@@ -122,6 +133,7 @@ func (l *StructLogger) Reset() {
 	l.output = make([]byte, 0)
 	l.logs = l.logs[:0]
 	l.err = nil
+	l.resultSize = 0
 }
 
 // CaptureStart implements the EVMLogger interface to initialize the tracing operation.
@@ -142,6 +154,12 @@ func (l *StructLogger) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, s
 	if l.cfg.Limit != 0 && l.cfg.Limit <= len(l.logs) {
 		return
 	}
+	// fail the trace when it exceeds the configured number of logs
+	if l.cfg.MaxStructLogs != 0 && l.cfg.MaxStructLogs <= len(l.logs) {
+		l.Stop(fmt.Errorf("%w: more than %d struct logs", ErrTraceOutputTooLarge, l.cfg.MaxStructLogs))
+		l.env.Cancel()
+		return
+	}
 
 	memory := scope.Memory
 	stack := scope.Stack
@@ -196,6 +214,28 @@ func (l *StructLogger) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, s
 	// create a new snapshot of the EVM.
 	log := StructLog{pc, op, gas, cost, mem, memory.Len(), stck, rdata, storage, depth, l.env.StateDB.GetRefund(), err}
 	l.logs = append(l.logs, log)
+
+	// fail the trace as soon as the formatted logs would exceed the configured
+	// size, instead of formatting them to find out
+	l.resultSize += log.formattedSize()
+	if l.cfg.MaxResultBytes != 0 && l.cfg.MaxResultBytes < l.resultSize {
+		l.Stop(fmt.Errorf("%w: more than %d bytes", ErrTraceOutputTooLarge, l.cfg.MaxResultBytes))
+		l.env.Cancel()
+	}
+}
+
+// formattedSize returns an upper bound of the size of the log once formatted
+// as a StructLogRes and marshaled to JSON.
+func (s *StructLog) formattedSize() int {
+	const (
+		baseSize    = 160 // fixed fields and their keys
+		wordSize    = 70  // hex encoded 32 bytes word with its quotes and separator
+		storageSize = 2 * wordSize
+	)
+	return baseSize + len(s.ErrorString()) +
+		len(s.Stack)*wordSize +
+		(len(s.Memory)+31)/32*wordSize +
+		len(s.Storage)*storageSize
 }
 
 // CaptureFault implements the EVMLogger interface to trace an execution fault
@@ -233,12 +273,19 @@ func (l *StructLogger) GetResult() (json.RawMessage, error) {
 	if failed && l.err != vm.ErrExecutionReverted {
 		returnVal = ""
 	}
-	return json.Marshal(&ExecutionResult{
+	result, err := json.Marshal(&ExecutionResult{
 		Gas:         l.usedGas,
 		Failed:      failed,
 		ReturnValue: returnVal,
 		StructLogs:  formatLogs(l.StructLogs()),
 	})
+	if err != nil {
+		return nil, err
+	}
+	if l.cfg.MaxResultBytes != 0 && l.cfg.MaxResultBytes < len(result) {
+		return nil, fmt.Errorf("%w: %d bytes, limit %d", ErrTraceOutputTooLarge, len(result), l.cfg.MaxResultBytes)
+	}
+	return result, nil
 }
 
 // Stop terminates execution of the tracer at the first opportune moment.
@@ -285,8 +332,8 @@ func WriteTrace(writer io.Writer, logs []StructLog) {
 		}
 		if len(log.Storage) > 0 {
 			fmt.Fprintln(writer, "Storage:")
-			for h, item := range log.Storage {
-				fmt.Fprintf(writer, "%x: %x\n", h, item)
+			for _, h := range sortedStorageKeys(log.Storage) {
+				fmt.Fprintf(writer, "%x: %x\n", h, log.Storage[h])
 			}
 		}
 		if len(log.ReturnData) > 0 {
@@ -297,6 +344,19 @@ func WriteTrace(writer io.Writer, logs []StructLog) {
 	}
 }
 
+// sortedStorageKeys returns the slots of the given storage in ascending order,
+// so that the storage is written deterministically.
+func sortedStorageKeys(storage Storage) []common.Hash {
+	keys := make([]common.Hash, 0, len(storage))
+	for key := range storage {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i][:], keys[j][:]) < 0
+	})
+	return keys
+}
+
 // WriteLogs writes vm logs in a readable format to the given writer
 func WriteLogs(writer io.Writer, logs []*types.Log) {
 	for _, log := range logs {
@@ -437,6 +497,7 @@ func formatLogs(logs []StructLog) []StructLogRes {
 			formatted[index].Memory = &memory
 		}
 		if trace.Storage != nil {
+			// NOTE: the storage slots are marshaled in sorted order by encoding/json
 			storage := make(map[string]string)
 			for i, storageValue := range trace.Storage {
 				storage[fmt.Sprintf("%x", i)] = fmt.Sprintf("%x", storageValue)
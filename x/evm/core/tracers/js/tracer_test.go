@@ -50,6 +50,11 @@ type dummyStatedb struct {
 
 func (*dummyStatedb) GetRefund() uint64                       { return 1337 }
 func (*dummyStatedb) GetBalance(addr common.Address) *big.Int { return new(big.Int) }
+func (*dummyStatedb) GetTransientState(common.Address, common.Hash) common.Hash {
+	return common.Hash{}
+}
+func (*dummyStatedb) SetTransientState(common.Address, common.Hash, common.Hash) {}
+func (*dummyStatedb) MaxLogsExceeded() bool                                      { return false }
 
 type vmContext struct {
 	blockCtx vm.BlockContext
@@ -116,3 +116,15 @@ func codeBitmapInternal(code, bits bitvec) bitvec {
 	}
 	return bits
 }
+
+// findBannedOpCode returns the first banned opcode of the given code. The push
+// data is skipped, so that the constants matching a banned opcode are allowed.
+func findBannedOpCode(code []byte, banned map[OpCode]struct{}) (OpCode, bool) {
+	bits := codeBitmap(code)
+	for pc, b := range code {
+		if _, ok := banned[OpCode(b)]; ok && bits.codeSegment(uint64(pc)) {
+			return OpCode(b), true
+		}
+	}
+	return STOP, false
+}
@@ -266,7 +266,13 @@ func (evm *EVM) RunPrecompiledContract(
 	value *big.Int,
 	readOnly bool,
 ) (ret []byte, remainingGas uint64, err error) {
-	return runPrecompiledContract(evm, p, caller, input, suppliedGas, value, readOnly)
+	ret, remainingGas, err = runPrecompiledContract(evm, p, caller, input, suppliedGas, value, readOnly)
+	// the logs emitted by the stateful precompiles count towards the maximum
+	// number of logs of the transaction
+	if err == nil && evm.StateDB.MaxLogsExceeded() {
+		err = ErrMaxLogsExceeded
+	}
+	return ret, remainingGas, err
 }
 
 func runPrecompiledContract(
@@ -36,6 +36,9 @@ var (
 	ErrGasUintOverflow          = errors.New("gas uint64 overflow")
 	ErrInvalidCode              = errors.New("invalid code: must not begin with 0xef")
 	ErrNonceUintOverflow        = errors.New("nonce uint64 overflow")
+	ErrAddressBlocked           = errors.New("value transfer from or to a blocked address")
+	ErrModuleAccountRecipient   = errors.New("value transfer to a module account")
+	ErrMaxLogsExceeded          = errors.New("max logs per transaction exceeded")
 
 	// errStopToken is an internal token indicating interpreter loop termination,
 	// never returned to outside callers.
@@ -70,3 +73,11 @@ type ErrInvalidOpCode struct {
 }
 
 func (e *ErrInvalidOpCode) Error() string { return fmt.Sprintf("invalid opcode: %s", e.opcode) }
+
+// ErrBannedOpCode wraps an evm error when the code of a created contract
+// contains a banned opcode.
+type ErrBannedOpCode struct {
+	opcode OpCode
+}
+
+func (e *ErrBannedOpCode) Error() string { return fmt.Sprintf("banned opcode: %s", e.opcode) }
@@ -23,6 +23,8 @@ type (
 	CanTransferFunc func(StateDB, common.Address, *big.Int) bool
 	// TransferFunc is the signature of a transfer function
 	TransferFunc func(StateDB, common.Address, common.Address, *big.Int)
+	// IsBlockedFunc returns whether an address is blocked from value transfers
+	IsBlockedFunc func(common.Address) bool
 	// GetHashFunc returns the n'th block hash in the blockchain
 	// and is used by the BLOCKHASH EVM op code.
 	GetHashFunc func(uint64) common.Hash
@@ -36,6 +38,12 @@ type BlockContext struct {
 	CanTransfer CanTransferFunc
 	// Transfer transfers ether from one account to the other
 	Transfer TransferFunc
+	// IsBlocked returns whether the address cannot send or receive ether.
+	// It is nil when no address is blocked.
+	IsBlocked IsBlockedFunc
+	// IsBlockedRecipient returns whether the address is a module account that
+	// cannot receive ether. It is nil when no module account is blocked.
+	IsBlockedRecipient IsBlockedFunc
 	// GetHash returns the hash corresponding to n
 	GetHash GetHashFunc
 
@@ -171,6 +179,22 @@ func (evm *EVM) WithInterpreter(interpreter Interpreter) {
 	evm.interpreter = interpreter
 }
 
+// checkTransferBlocked returns an error if a non-zero value transfer involves a
+// blocked address or credits a blocked module account. It is a no-op when no
+// address is blocked.
+func (evm *EVM) checkTransferBlocked(from, to common.Address, value *big.Int) error {
+	if value == nil || value.Sign() == 0 {
+		return nil
+	}
+	if evm.Context.IsBlocked != nil && (evm.Context.IsBlocked(from) || evm.Context.IsBlocked(to)) {
+		return ErrAddressBlocked
+	}
+	if evm.Context.IsBlockedRecipient != nil && evm.Context.IsBlockedRecipient(to) {
+		return ErrModuleAccountRecipient
+	}
+	return nil
+}
+
 // Call executes the contract associated with the addr with the given input as
 // parameters. It also handles any necessary value transfer required and takes
 // the necessary steps to create accounts and reverses the state in case of an
@@ -188,6 +212,10 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	if value.Sign() != 0 && !evm.Context.CanTransfer(evm.StateDB, caller.Address(), value) {
 		return nil, gas, ErrInsufficientBalance
 	}
+	// Fail if the value is transferred from or to a blocked address
+	if err := evm.checkTransferBlocked(caller.Address(), addr, value); err != nil {
+		return nil, gas, err
+	}
 
 	snapshot := evm.StateDB.Snapshot()
 	p, isPrecompile := evm.Precompile(addr)
@@ -441,6 +469,9 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 	if !evm.Context.CanTransfer(evm.StateDB, caller.Address(), value) {
 		return nil, common.Address{}, gas, ErrInsufficientBalance
 	}
+	if err := evm.checkTransferBlocked(caller.Address(), address, value); err != nil {
+		return nil, common.Address{}, gas, err
+	}
 	nonce := evm.StateDB.GetNonce(caller.Address())
 	if nonce+1 < nonce {
 		return nil, common.Address{}, gas, ErrNonceUintOverflow
@@ -491,6 +522,13 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 		err = ErrInvalidCode
 	}
 
+	// Reject code containing a banned opcode, skipped when no opcode is banned.
+	if err == nil && len(evm.Config.BannedOpCodes) > 0 {
+		if op, found := findBannedOpCode(ret, evm.Config.BannedOpCodes); found {
+			err = &ErrBannedOpCode{opcode: op}
+		}
+	}
+
 	// if the contract creation ran successfully and no errors were returned
 	// calculate the gas required to store the code. If the code could not
 	// be stored due to not enough gas set an error and let it be handled
@@ -17,6 +17,7 @@
 package vm
 
 import (
+	"errors"
 	"math"
 	"math/big"
 	"testing"
@@ -91,7 +92,7 @@ func TestEIP2200(t *testing.T) {
 			CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
 			Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
 		}
-		vmenv := NewEVM(vmctx, TxContext{}, statedb, params.AllEthashProtocolChanges, Config{ExtraEips: []string{"ethereum_2200"}})
+		vmenv := NewEVM(vmctx, TxContext{}, newTestStateDB(statedb), params.AllEthashProtocolChanges, Config{ExtraEips: []string{"ethereum_2200"}})
 
 		_, gas, err := vmenv.Call(AccountRef(common.Address{}), address, nil, tt.gaspool, new(big.Int))
 		if err != tt.failure {
@@ -105,3 +106,49 @@ func TestEIP2200(t *testing.T) {
 		}
 	}
 }
+
+var eip1153Tests = []struct {
+	input   string
+	cancun  bool
+	used    uint64
+	invalid bool
+}{
+	{"0x600160005d", true, 106, false},        // TSTORE
+	{"0x60005c", true, 103, false},            // TLOAD
+	{"0x600160005d60005c", true, 209, false},  // TSTORE, TLOAD
+	{"0x600160005d", false, 100000, true},     // TSTORE before Cancun
+	{"0x60005c", false, 100000, true},         // TLOAD before Cancun
+	{"0x600160005d600054", true, 2209, false}, // TSTORE does not write to the storage (cold SLOAD)
+}
+
+func TestEIP1153(t *testing.T) {
+	for i, tt := range eip1153Tests {
+		address := common.BytesToAddress([]byte("contract"))
+
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		statedb.CreateAccount(address)
+		statedb.SetCode(address, hexutil.MustDecode(tt.input))
+		statedb.Finalise(true)
+
+		chainConfig := *params.AllEthashProtocolChanges
+		if tt.cancun {
+			chainConfig.CancunBlock = big.NewInt(0)
+		}
+
+		vmctx := BlockContext{
+			CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+			Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+			BlockNumber: big.NewInt(0),
+		}
+		vmenv := NewEVM(vmctx, TxContext{}, newTestStateDB(statedb), &chainConfig, Config{})
+
+		_, gas, err := vmenv.Call(AccountRef(common.Address{}), address, nil, 100000, new(big.Int))
+		var invalidOpErr *ErrInvalidOpCode
+		if invalid := errors.As(err, &invalidOpErr); invalid != tt.invalid {
+			t.Errorf("test %d: failure mismatch: have %v, want invalid opcode %v", i, err, tt.invalid)
+		}
+		if used := 100000 - gas; used != tt.used {
+			t.Errorf("test %d: gas used mismatch: have %v, want %v", i, used, tt.used)
+		}
+	}
+}
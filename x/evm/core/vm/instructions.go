@@ -837,6 +837,9 @@ func opSelfdestruct(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext
 	}
 	beneficiary := scope.Stack.Pop()
 	balance := interpreter.evm.StateDB.GetBalance(scope.Contract.Address())
+	if err := interpreter.evm.checkTransferBlocked(scope.Contract.Address(), beneficiary.Bytes20(), balance); err != nil {
+		return nil, err
+	}
 	interpreter.evm.StateDB.AddBalance(beneficiary.Bytes20(), balance)
 	interpreter.evm.StateDB.Suicide(scope.Contract.Address())
 	if interpreter.cfg.Debug {
@@ -871,6 +874,9 @@ func makeLog(size int) executionFunc {
 			// core/state doesn't know the current block number.
 			BlockNumber: interpreter.evm.Context.BlockNumber.Uint64(),
 		})
+		if interpreter.evm.StateDB.MaxLogsExceeded() {
+			return nil, ErrMaxLogsExceeded
+		}
 
 		return nil, nil
 	}
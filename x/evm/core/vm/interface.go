@@ -47,6 +47,9 @@ type StateDB interface {
 	GetState(common.Address, common.Hash) common.Hash
 	SetState(common.Address, common.Hash, common.Hash)
 
+	GetTransientState(addr common.Address, key common.Hash) common.Hash
+	SetTransientState(addr common.Address, key, value common.Hash)
+
 	Suicide(common.Address) bool
 	HasSuicided(common.Address) bool
 
@@ -71,6 +74,9 @@ type StateDB interface {
 	Snapshot() int
 
 	AddLog(*types.Log)
+	// MaxLogsExceeded returns true once a log was rejected for exceeding the
+	// maximum number of logs of the transaction.
+	MaxLogsExceeded() bool
 	AddPreimage(common.Hash, []byte)
 
 	ForEachStorage(common.Address, func(common.Hash, common.Hash) bool) error
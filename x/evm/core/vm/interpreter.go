@@ -34,6 +34,9 @@ type Config struct {
 	JumpTable *JumpTable // EVM instruction table, automatically populated if unset
 
 	ExtraEips []string // Additional EIPS that are to be enabled
+
+	OpCodeGasOverrides map[OpCode]uint64   // Constant gas costs replacing the ones of the jump table
+	BannedOpCodes      map[OpCode]struct{} // Opcodes rejected in the code of the created contracts
 }
 
 // ScopeContext contains the things that are per-call, such as stack and memory,
@@ -71,6 +74,13 @@ func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 	// If jump table was not initialised we set the default one.
 	if cfg.JumpTable == nil {
 		cfg.JumpTable = DefaultJumpTable(evm.chainRules)
+		// NOTE: the Cancun rules are not exported by the go-ethereum fork, so the fork
+		// is checked on the chain config. Only EIP-1153 is enabled from the Cancun set.
+		if evm.chainConfig.IsCancun(evm.Context.BlockNumber) {
+			copy := CopyJumpTable(cfg.JumpTable)
+			enable1153(copy)
+			cfg.JumpTable = copy
+		}
 		for i, eip := range cfg.ExtraEips {
 			// Deep-copy jumptable to prevent modification of opcodes in other tables
 			copy := CopyJumpTable(cfg.JumpTable)
@@ -83,6 +93,17 @@ func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 		}
 	}
 
+	if len(cfg.OpCodeGasOverrides) > 0 {
+		// Deep-copy jumptable to prevent modification of opcodes in other tables
+		copy := CopyJumpTable(cfg.JumpTable)
+		for op, gas := range cfg.OpCodeGasOverrides {
+			if copy[op] != nil {
+				copy[op].SetConstantGas(gas)
+			}
+		}
+		cfg.JumpTable = copy
+	}
+
 	return &EVMInterpreter{
 		evm: evm,
 		cfg: cfg,
@@ -229,6 +250,11 @@ func (in *EVMInterpreter) Run(contract *Contract, input []byte, readOnly bool) (
 	if err == errStopToken {
 		err = nil // clear stop token error
 	}
+	// fail the callers which recovered from the failure of a sub call, as the
+	// whole transaction fails once its maximum number of logs is exceeded
+	if err == nil && in.evm.StateDB.MaxLogsExceeded() {
+		err = ErrMaxLogsExceeded
+	}
 
 	return res, err
 }
@@ -0,0 +1,36 @@
+package vm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// testStateDB extends the go-ethereum StateDB, which does not implement
+// EIP-1153, with an in-memory transient storage. It doesn't limit the number
+// of logs.
+type testStateDB struct {
+	*state.StateDB
+	transientStorage map[common.Address]map[common.Hash]common.Hash
+}
+
+func newTestStateDB(db *state.StateDB) *testStateDB {
+	return &testStateDB{
+		StateDB:          db,
+		transientStorage: make(map[common.Address]map[common.Hash]common.Hash),
+	}
+}
+
+func (s *testStateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transientStorage[addr][key]
+}
+
+func (s *testStateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	if _, ok := s.transientStorage[addr]; !ok {
+		s.transientStorage[addr] = make(map[common.Hash]common.Hash)
+	}
+	s.transientStorage[addr][key] = value
+}
+
+func (s *testStateDB) MaxLogsExceeded() bool {
+	return false
+}
@@ -28,6 +28,10 @@ func InitGenesis(
 		panic(fmt.Errorf("error setting params %s", err))
 	}
 
+	if err := k.SetAccessPolicy(ctx, data.AccessPolicy); err != nil {
+		panic(fmt.Errorf("error setting access policy %s", err))
+	}
+
 	// ensure evm module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the EVM module account has not been set")
@@ -79,7 +83,8 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:     ethGenAccounts,
+		Params:       k.GetParams(ctx),
+		AccessPolicy: k.GetAccessPolicy(ctx),
 	}
 }
@@ -2,6 +2,8 @@ package evm_test
 
 import (
 	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -240,4 +242,60 @@ func TestExportGenesis(t *testing.T) {
 	}
 	require.Contains(t, genAddresses, contractAddr.Hex(), "expected contract 1 address in exported genesis")
 	require.Contains(t, genAddresses, contractAddr2.Hex(), "expected contract 2 address in exported genesis")
+
+	// the exported accounts are in their normalized form
+	require.NoError(t, genState.Validate(), "expected the exported genesis to be valid")
+	normalized, err := genState.Normalize()
+	require.NoError(t, err)
+	require.Equal(t, *genState, normalized)
+}
+
+func TestGenesisNormalizationRoundTrip(t *testing.T) {
+	readGenesis := func(name string) types.GenesisState {
+		bz, err := os.ReadFile(filepath.Join("testdata", name))
+		require.NoError(t, err)
+
+		var genState types.GenesisState
+		types.ModuleCdc.MustUnmarshalJSON(bz, &genState)
+		genState.Params = types.DefaultParams()
+		return genState
+	}
+
+	normalized := readGenesis("genesis_accounts_normalized.json")
+	require.NoError(t, normalized.Validate())
+
+	for _, name := range []string{"genesis_accounts_lower.json", "genesis_accounts_upper.json"} {
+		t.Run(name, func(t *testing.T) {
+			genState := readGenesis(name)
+			require.Error(t, genState.Validate(), "expected the messy genesis to be rejected")
+
+			// the genesis files holding identical states are normalized identically
+			genState, err := genState.Normalize()
+			require.NoError(t, err)
+			require.NoError(t, genState.Validate())
+			require.Equal(t, normalized, genState)
+
+			ts := SetupTest()
+			ctx := ts.network.GetContext()
+			for _, account := range genState.Accounts {
+				acc := ts.network.App.AccountKeeper.NewAccountWithAddress(ctx, common.HexToAddress(account.Address).Bytes())
+				ts.network.App.AccountKeeper.SetAccount(ctx, acc)
+			}
+			evm.InitGenesis(ctx, ts.network.App.EvmKeeper, ts.network.App.AccountKeeper, genState)
+
+			// the exported genesis holds the normalized accounts
+			exported := evm.ExportGenesis(ctx, ts.network.App.EvmKeeper)
+			require.NoError(t, exported.Validate())
+
+			var exportedAccounts []types.GenesisAccount
+			for _, account := range exported.Accounts {
+				for _, expAccount := range normalized.Accounts {
+					if account.Address == expAccount.Address {
+						exportedAccounts = append(exportedAccounts, account)
+					}
+				}
+			}
+			require.Equal(t, normalized.Accounts, exportedAccounts)
+		})
+	}
 }
@@ -5,14 +5,23 @@ package keeper
 import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmostypes "github.com/evmos/evmos/v19/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper, and stores
+// the hash of the block for the BLOCKHASH opcode.
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	k.WithChainID(ctx)
+
+	hash, err := k.headerHash(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("failed to compute the block hash", "error", err)
+		return
+	}
+	k.SetBlockHash(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), uint64(ctx.BlockHeight()), hash)
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
@@ -22,8 +31,32 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Valida
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 
-	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
+	// the bloom is computed from the block logs ordered by tx and emission
+	// order, and checked against the bloom accumulated during the block. A
+	// mismatch is also reported by the block-bloom invariant.
+	bloom, err := k.CheckBlockBloom(infCtx)
+	if err != nil {
+		k.Logger(ctx).Error("inconsistent block bloom, using the bloom computed from the block logs", "error", err)
+		telemetry.IncrCounter(1, metricKeyBlockBloomMismatch...)
+	}
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
+	if pruned := k.PruneQueuedStorage(infCtx); pruned > 0 {
+		k.Logger(ctx).Debug("pruned storage of self-destructed contracts", "slots", pruned)
+	}
+
+	if pruned := k.PruneContractGas(infCtx); pruned > 0 {
+		k.Logger(ctx).Debug("pruned contract gas usage of expired epochs", "entries", pruned)
+	}
+
+	if pruned := k.PruneBlockHashes(infCtx); pruned > 0 {
+		k.Logger(ctx).Debug("pruned block hashes beyond the retention", "hashes", pruned)
+	}
+
+	if evmostypes.IsTelemetryEnabled() {
+		telemetry.SetGauge(float32(k.GetTxIndexTransient(infCtx)), metricKeyBlockTxs...)
+		k.emitContractGasMetrics(infCtx)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
@@ -1,7 +1,15 @@
 package keeper_test
 
 import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/keeper"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
@@ -16,3 +24,131 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 	suite.Require().Equal(1, len(em.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
 }
+
+func (suite *KeeperTestSuite) TestBlockBloomDeterministic() {
+	newLog := func(txIndex, index uint64) *evmtypes.Log {
+		return &evmtypes.Log{
+			Address: utiltx.GenerateAddress().Hex(),
+			Topics:  []string{common.BytesToHash([]byte{byte(index)}).Hex()},
+			TxIndex: txIndex,
+			Index:   index,
+		}
+	}
+
+	txLogs := [][]*evmtypes.Log{
+		{newLog(0, 0), newLog(0, 1)},
+		{newLog(1, 2)},
+		{newLog(2, 3), newLog(2, 4), newLog(2, 5)},
+	}
+
+	testCases := []struct {
+		name  string
+		order []int
+	}{
+		{"txs set in block order", []int{0, 1, 2}},
+		{"txs set in reverse order", []int{2, 1, 0}},
+		{"txs set in mixed order", []int{1, 2, 0}},
+		{"tx logs set twice", []int{1, 0, 2, 1}},
+	}
+
+	var expBloom ethtypes.Bloom
+	for i, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			for _, txIndex := range tc.order {
+				// set the logs of the tx in reverse emission order
+				logs := make([]*evmtypes.Log, 0, len(txLogs[txIndex]))
+				for j := len(txLogs[txIndex]) - 1; j >= 0; j-- {
+					logs = append(logs, txLogs[txIndex][j])
+				}
+				suite.app.EvmKeeper.SetTxLogsTransient(suite.ctx, uint64(txIndex), logs)
+			}
+
+			logs := suite.app.EvmKeeper.GetBlockLogsTransient(suite.ctx)
+			suite.Require().Len(logs, 6)
+			for j, log := range logs {
+				suite.Require().Equal(uint64(j), log.Index)
+			}
+
+			bloom := suite.app.EvmKeeper.ComputeBlockBloom(suite.ctx)
+			if i == 0 {
+				expBloom = bloom
+			}
+			suite.Require().Equal(expBloom, bloom)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCheckBlockBloom() {
+	suite.SetupTest() // reset
+
+	log := &evmtypes.Log{
+		Address: utiltx.GenerateAddress().Hex(),
+		Topics:  []string{common.BytesToHash([]byte{1}).Hex()},
+	}
+	suite.app.EvmKeeper.SetTxLogsTransient(suite.ctx, 0, []*evmtypes.Log{log})
+
+	// the accumulated bloom doesn't include the log
+	bloom, err := suite.app.EvmKeeper.CheckBlockBloom(suite.ctx)
+	suite.Require().ErrorIs(err, evmtypes.ErrInvalidState)
+	suite.Require().Equal(ethtypes.BytesToBloom(ethtypes.LogsBloom(evmtypes.LogsToEthereum([]*evmtypes.Log{log}))), bloom)
+
+	suite.app.EvmKeeper.SetBlockBloomTransient(suite.ctx, new(big.Int).SetBytes(bloom.Bytes()))
+	_, err = suite.app.EvmKeeper.CheckBlockBloom(suite.ctx)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestBlockBloomWithHookLogs() {
+	suite.SetupTest() // reset
+
+	// the emitter logs a single topic on every call
+	emitter := utiltx.GenerateAddress()
+	vmdb := suite.StateDB()
+	vmdb.SetCode(emitter, common.FromHex("0x60aa60006000a100"))
+	suite.Require().NoError(vmdb.Commit())
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.Commit()
+
+	logHook := &logHook{
+		evmKeeper: suite.app.EvmKeeper,
+		from:      suite.address,
+		emitter:   emitter,
+	}
+	revertHook := &mintHook{bankKeeper: suite.app.BankKeeper}
+	suite.app.EvmKeeper.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(logHook, revertHook))
+
+	// the hooks of the first transfer succeed and the ones of the second
+	// transfer revert it. The gas limit leaves enough gas to the log hook to
+	// estimate and commit its call.
+	gasLimit := uint64(5_000_000)
+	to := utiltx.GenerateAddress()
+	rsp, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), suite.hookTransferTx(contractAddr, to, big.NewInt(10), gasLimit))
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.VmError)
+	suite.Require().Len(rsp.Logs, 1)
+
+	revertHook.err = errorsmod.Wrap(evmtypes.ErrPostTxProcessing, "hook failed")
+	rsp, err = suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), suite.hookTransferTx(contractAddr, to, big.NewInt(10), gasLimit))
+	suite.Require().NoError(err)
+	suite.Require().Contains(rsp.VmError, evmtypes.ErrPostTxProcessing.Error())
+	suite.Require().Empty(rsp.Logs)
+
+	// the hooks emitted logs on both transfers
+	suite.Require().Len(logHook.logs, 2)
+	suite.Require().Equal(emitter.Hex(), logHook.logs[0].Address)
+
+	// the block logs only hold the logs of the committed transfer, and the
+	// accumulated bloom matches them
+	logs := suite.app.EvmKeeper.GetBlockLogsTransient(suite.ctx)
+	suite.Require().Len(logs, 1)
+	suite.Require().Equal(contractAddr.Hex(), logs[0].Address)
+
+	bloom, err := suite.app.EvmKeeper.CheckBlockBloom(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(ethtypes.BloomLookup(bloom, contractAddr))
+
+	_, broken := keeper.BlockBloomInvariant(suite.app.EvmKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
@@ -0,0 +1,130 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"bytes"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// GetAccessPolicy returns the access policy with the addresses of each list
+// sorted by their bytes.
+func (k Keeper) GetAccessPolicy(ctx sdk.Context) (policy types.AccessPolicy) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixAccessPolicy)
+	if len(bz) == 0 {
+		return policy
+	}
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy
+}
+
+// SetAccessPolicy validates the given access policy and replaces the current
+// one with it.
+func (k Keeper) SetAccessPolicy(ctx sdk.Context, policy types.AccessPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	policy = types.AccessPolicy{
+		DeployerAllowlist: sortedAddresses(policy.DeployerAllowlist),
+		DeployerDenylist:  sortedAddresses(policy.DeployerDenylist),
+		ContractAllowlist: sortedAddresses(policy.ContractAllowlist),
+		ContractDenylist:  sortedAddresses(policy.ContractDenylist),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if policy.Size() == 0 {
+		store.Delete(types.KeyPrefixAccessPolicy)
+		return nil
+	}
+	store.Set(types.KeyPrefixAccessPolicy, k.cdc.MustMarshal(&policy))
+	return nil
+}
+
+// GetAccessPolicyLists loads the access policy to be enforced on a
+// transaction. The read is not charged.
+func (k Keeper) GetAccessPolicyLists(ctx sdk.Context) *types.AccessPolicyLists {
+	return types.NewAccessPolicyLists(k.GetAccessPolicy(ctx.WithKVGasConfig(storetypes.GasConfig{})))
+}
+
+// CheckAccessPolicy returns an error if the given access policy doesn't allow
+// the sender to deploy a contract, when the recipient is nil, or to call the
+// given recipient.
+func (k Keeper) CheckAccessPolicy(ctx sdk.Context, lists *types.AccessPolicyLists, sender common.Address, to *common.Address) error {
+	if to == nil {
+		if !lists.CanDeploy(sender, sender) {
+			return errorsmod.Wrapf(types.ErrAccessPolicyViolation, "address %s cannot deploy contracts", sender)
+		}
+		return nil
+	}
+
+	if !lists.RestrictsContracts() {
+		return nil
+	}
+	if !lists.CanCall(*to, k.IsContract(ctx, *to)) {
+		return errorsmod.Wrapf(types.ErrAccessPolicyViolation, "contract %s cannot be called", to)
+	}
+	return nil
+}
+
+// getAccessPolicyCreateHook returns a CreateHook enforcing the deployer lists
+// of the access policy on the given signer and on the caller of each
+// creation, or nil if the deployers are not restricted.
+func getAccessPolicyCreateHook(lists *types.AccessPolicyLists, signer common.Address) types.CreateHook {
+	if !lists.RestrictsDeployers() {
+		return nil
+	}
+
+	return func(_ *vm.EVM, caller common.Address) error {
+		if !lists.CanDeploy(signer, caller) {
+			return errorsmod.Wrapf(types.ErrAccessPolicyViolation, "address %s cannot deploy contracts", caller)
+		}
+		return nil
+	}
+}
+
+// getAccessPolicyCallHook returns a CallHook enforcing the contract lists of
+// the access policy on the recipient of each call, or nil if the contracts are
+// not restricted.
+func getAccessPolicyCallHook(lists *types.AccessPolicyLists) types.CallHook {
+	if !lists.RestrictsContracts() {
+		return nil
+	}
+
+	return func(evm *vm.EVM, _, recipient common.Address) error {
+		if !lists.CanCall(recipient, evm.StateDB.GetCodeSize(recipient) > 0) {
+			return errorsmod.Wrapf(types.ErrAccessPolicyViolation, "contract %s cannot be called", recipient)
+		}
+		return nil
+	}
+}
+
+// sortedAddresses returns the given hex addresses checksummed and sorted by
+// their bytes.
+func sortedAddresses(hexAddresses []string) []string {
+	if len(hexAddresses) == 0 {
+		return nil
+	}
+
+	addresses := make([]common.Address, len(hexAddresses))
+	for i, address := range hexAddresses {
+		addresses[i] = common.HexToAddress(address)
+	}
+	slices.SortFunc(addresses, func(a, b common.Address) int {
+		return bytes.Compare(a.Bytes(), b.Bytes())
+	})
+
+	sorted := make([]string, len(addresses))
+	for i, address := range addresses {
+		sorted[i] = address.Hex()
+	}
+	return sorted
+}
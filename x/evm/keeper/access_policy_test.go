@@ -0,0 +1,309 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v19/server/config"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// factoryCode is the runtime code of a contract that creates an empty
+// contract and returns its address, or zero if the creation failed.
+var factoryCode = common.FromHex("600060006000f060005260206000f3")
+
+func (suite *KeeperTestSuite) TestSetAccessPolicy() {
+	suite.SetupTest()
+	addrs := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+	if bytes.Compare(addrs[0].Bytes(), addrs[1].Bytes()) > 0 {
+		addrs[0], addrs[1] = addrs[1], addrs[0]
+	}
+
+	suite.Require().Equal(types.AccessPolicy{}, suite.app.EvmKeeper.GetAccessPolicy(suite.ctx))
+
+	policy := types.AccessPolicy{
+		DeployerAllowlist: []string{addrs[1].Hex(), addrs[0].Hex()},
+		ContractDenylist:  []string{addrs[0].Hex()},
+	}
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccessPolicy(suite.ctx, policy))
+
+	// the addresses are returned sorted
+	suite.Require().Equal(types.AccessPolicy{
+		DeployerAllowlist: []string{addrs[0].Hex(), addrs[1].Hex()},
+		ContractDenylist:  []string{addrs[0].Hex()},
+	}, suite.app.EvmKeeper.GetAccessPolicy(suite.ctx))
+
+	// the policy is replaced as a whole
+	policy = types.AccessPolicy{DeployerDenylist: []string{addrs[1].Hex()}}
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccessPolicy(suite.ctx, policy))
+	suite.Require().Equal(policy, suite.app.EvmKeeper.GetAccessPolicy(suite.ctx))
+
+	res, err := suite.queryClient.AccessPolicy(suite.ctx, &types.QueryAccessPolicyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(policy, res.Policy)
+
+	// an invalid policy is rejected
+	policy = types.AccessPolicy{ContractAllowlist: []string{addrs[0].Hex()}, ContractDenylist: []string{addrs[0].Hex()}}
+	suite.Require().Error(suite.app.EvmKeeper.SetAccessPolicy(suite.ctx, policy))
+}
+
+func (suite *KeeperTestSuite) TestCheckAccessPolicy() {
+	var (
+		sender   = utiltx.GenerateAddress()
+		other    = utiltx.GenerateAddress()
+		contract = utiltx.GenerateAddress()
+		eoa      = utiltx.GenerateAddress()
+	)
+
+	testCases := []struct {
+		name   string
+		policy types.AccessPolicy
+		to     *common.Address
+		expErr bool
+	}{
+		{"pass - create without policy", types.AccessPolicy{}, nil, false},
+		{"pass - call without policy", types.AccessPolicy{}, &contract, false},
+		{"pass - allowed deployer", types.AccessPolicy{DeployerAllowlist: []string{sender.Hex()}}, nil, false},
+		{"fail - deployer not allowed", types.AccessPolicy{DeployerAllowlist: []string{other.Hex()}}, nil, true},
+		{"fail - denied deployer", types.AccessPolicy{DeployerDenylist: []string{sender.Hex()}}, nil, true},
+		{"pass - call by a denied deployer", types.AccessPolicy{DeployerDenylist: []string{sender.Hex()}}, &contract, false},
+		{"pass - allowed contract", types.AccessPolicy{ContractAllowlist: []string{contract.Hex()}}, &contract, false},
+		{"fail - contract not allowed", types.AccessPolicy{ContractAllowlist: []string{other.Hex()}}, &contract, true},
+		{"fail - denied contract", types.AccessPolicy{ContractDenylist: []string{contract.Hex()}}, &contract, true},
+		{"pass - transfer to an account without code", types.AccessPolicy{ContractAllowlist: []string{other.Hex()}}, &eoa, false},
+		{"fail - transfer to a denied account", types.AccessPolicy{ContractDenylist: []string{eoa.Hex()}}, &eoa, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			vmdb.SetCode(contract, counterCode)
+			suite.Require().NoError(vmdb.Commit())
+			suite.Require().NoError(suite.app.EvmKeeper.SetAccessPolicy(suite.ctx, tc.policy))
+
+			lists := suite.app.EvmKeeper.GetAccessPolicyLists(suite.ctx)
+			err := suite.app.EvmKeeper.CheckAccessPolicy(suite.ctx, lists, sender, tc.to)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrAccessPolicyViolation)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAccessPolicyEnforcement() {
+	var (
+		counter = utiltx.GenerateAddress()
+		proxy   = utiltx.GenerateAddress()
+		factory = utiltx.GenerateAddress()
+	)
+
+	ethCall := func(args types.TransactionArgs) *types.MsgEthereumTxResponse {
+		argsBz, err := json.Marshal(&args)
+		suite.Require().NoError(err)
+
+		res, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: argsBz, GasCap: config.DefaultGasCap})
+		suite.Require().NoError(err)
+		return res
+	}
+	call := func(to common.Address) *types.MsgEthereumTxResponse {
+		return ethCall(types.TransactionArgs{From: &suite.address, To: &to})
+	}
+	create := func() *types.MsgEthereumTxResponse {
+		return ethCall(types.TransactionArgs{From: &suite.address, Input: (*hexutil.Bytes)(&counterCode)})
+	}
+
+	testCases := []struct {
+		name      string
+		policy    types.AccessPolicy
+		postCheck func()
+	}{
+		{
+			"no policy",
+			types.AccessPolicy{},
+			func() {
+				suite.Require().Equal(common.LeftPadBytes([]byte{1}, 32), call(proxy).Ret)
+				suite.Require().NotEqual(make([]byte, 32), call(factory).Ret)
+				suite.Require().False(create().Failed())
+			},
+		},
+		{
+			"denied contract called directly and through a proxy",
+			types.AccessPolicy{ContractDenylist: []string{counter.Hex()}},
+			func() {
+				suite.Require().Contains(call(counter).VmError, types.ErrAccessPolicyViolation.Error())
+				suite.Require().Equal(make([]byte, 32), call(proxy).Ret)
+			},
+		},
+		{
+			"contract allowlist",
+			types.AccessPolicy{ContractAllowlist: []string{proxy.Hex()}},
+			func() {
+				suite.Require().Contains(call(counter).VmError, types.ErrAccessPolicyViolation.Error())
+				suite.Require().Equal(make([]byte, 32), call(proxy).Ret)
+			},
+		},
+		{
+			"denied factory",
+			types.AccessPolicy{DeployerDenylist: []string{factory.Hex()}},
+			func() {
+				suite.Require().Equal(make([]byte, 32), call(factory).Ret)
+				suite.Require().False(create().Failed())
+			},
+		},
+		{
+			"denied signer",
+			types.AccessPolicy{DeployerDenylist: []string{suite.address.Hex()}},
+			func() {
+				suite.Require().Contains(create().VmError, types.ErrAccessPolicyViolation.Error())
+				suite.Require().Equal(make([]byte, 32), call(factory).Ret)
+			},
+		},
+		{
+			"allowed signer",
+			types.AccessPolicy{DeployerAllowlist: []string{suite.address.Hex()}},
+			func() {
+				suite.Require().False(create().Failed())
+				suite.Require().NotEqual(make([]byte, 32), call(factory).Ret)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			vmdb.SetCode(counter, counterCode)
+			vmdb.SetCode(proxy, proxyCode(counter))
+			vmdb.SetCode(factory, factoryCode)
+			suite.Require().NoError(vmdb.Commit())
+			suite.Require().NoError(suite.app.EvmKeeper.SetAccessPolicy(suite.ctx, tc.policy))
+
+			tc.postCheck()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAccessPolicyWithAccessControl() {
+	var (
+		counter = utiltx.GenerateAddress()
+		other   = utiltx.GenerateAddress()
+	)
+
+	ethCall := func(args types.TransactionArgs) *types.MsgEthereumTxResponse {
+		argsBz, err := json.Marshal(&args)
+		suite.Require().NoError(err)
+
+		res, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: argsBz, GasCap: config.DefaultGasCap})
+		suite.Require().NoError(err)
+		return res
+	}
+	permissioned := func(address common.Address) types.AccessControlType {
+		return types.AccessControlType{AccessType: types.AccessTypePermissioned, AccessControlList: []string{address.Hex()}}
+	}
+
+	// the access control params and the access policy are both enforced, so an
+	// operation allowed by one of them is rejected if the other denies it
+	testCases := []struct {
+		name          string
+		accessControl types.AccessControl
+		policy        types.AccessPolicy
+		create        bool
+		expErr        string
+	}{
+		{
+			"deployer allowed by both",
+			types.AccessControl{Create: permissioned(suite.address)},
+			types.AccessPolicy{DeployerAllowlist: []string{suite.address.Hex()}},
+			true,
+			"",
+		},
+		{
+			"deployer allowed by the policy but not by the access control",
+			types.AccessControl{Create: permissioned(other)},
+			types.AccessPolicy{DeployerAllowlist: []string{suite.address.Hex()}},
+			true,
+			"does not have permission to deploy contracts",
+		},
+		{
+			"deployer allowed by the access control but denied by the policy",
+			types.AccessControl{Create: permissioned(suite.address)},
+			types.AccessPolicy{DeployerDenylist: []string{suite.address.Hex()}},
+			true,
+			types.ErrAccessPolicyViolation.Error(),
+		},
+		{
+			"call allowed by both",
+			types.AccessControl{Call: permissioned(suite.address)},
+			types.AccessPolicy{ContractAllowlist: []string{counter.Hex()}},
+			false,
+			"",
+		},
+		{
+			"contract allowed by the policy but caller not allowed by the access control",
+			types.AccessControl{Call: permissioned(other)},
+			types.AccessPolicy{ContractAllowlist: []string{counter.Hex()}},
+			false,
+			"does not have permission to perform a call",
+		},
+		{
+			"caller allowed by the access control but contract denied by the policy",
+			types.AccessControl{Call: permissioned(suite.address)},
+			types.AccessPolicy{ContractDenylist: []string{counter.Hex()}},
+			false,
+			types.ErrAccessPolicyViolation.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			vmdb.SetCode(counter, counterCode)
+			suite.Require().NoError(vmdb.Commit())
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.AccessControl = tc.accessControl
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+			suite.Require().NoError(suite.app.EvmKeeper.SetAccessPolicy(suite.ctx, tc.policy))
+
+			args := types.TransactionArgs{From: &suite.address, To: &counter}
+			if tc.create {
+				args = types.TransactionArgs{From: &suite.address, Input: (*hexutil.Bytes)(&counterCode)}
+			}
+			res := ethCall(args)
+			if tc.expErr == "" {
+				suite.Require().False(res.Failed(), res.VmError)
+			} else {
+				suite.Require().Contains(res.VmError, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateAccessPolicy() {
+	suite.SetupTest()
+	policy := types.AccessPolicy{DeployerAllowlist: []string{suite.address.Hex()}}
+
+	_, err := suite.app.EvmKeeper.UpdateAccessPolicy(suite.ctx, &types.MsgUpdateAccessPolicy{
+		Authority: suite.address.String(),
+		Policy:    policy,
+	})
+	suite.Require().ErrorContains(err, "invalid authority")
+	suite.Require().Equal(types.AccessPolicy{}, suite.app.EvmKeeper.GetAccessPolicy(suite.ctx))
+
+	_, err = suite.app.EvmKeeper.UpdateAccessPolicy(suite.ctx, &types.MsgUpdateAccessPolicy{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Policy:    policy,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(policy, suite.app.EvmKeeper.GetAccessPolicy(suite.ctx))
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// deployCode returns the creation code deploying the given runtime code.
+func deployCode(runtimeCode []byte) []byte {
+	size := byte(len(runtimeCode))
+	// PUSH1 size PUSH1 12 PUSH1 0 CODECOPY PUSH1 size PUSH1 0 RETURN
+	initCode := []byte{0x60, size, 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, size, 0x60, 0x00, 0xf3}
+	return append(initCode, runtimeCode...)
+}
+
+func (suite *KeeperTestSuite) TestBannedOpcodes() {
+	testCases := []struct {
+		name        string
+		banned      []string
+		runtimeCode []byte
+		expErr      string
+	}{
+		{
+			"no banned opcode",
+			nil,
+			common.FromHex("6000ff"), // PUSH1 0 SELFDESTRUCT
+			"",
+		},
+		{
+			"banned opcode in push data",
+			[]string{"SELFDESTRUCT", "DELEGATECALL"},
+			common.FromHex("60ff61f4ff5000"), // PUSH1 0xff PUSH2 0xf4ff POP STOP
+			"",
+		},
+		{
+			"banned opcode in push32 data",
+			[]string{"SELFDESTRUCT"},
+			append(append([]byte{0x7f}, bytes.Repeat([]byte{0xff}, 32)...), 0x50), // PUSH32 0xff..ff POP
+			"",
+		},
+		{
+			"banned selfdestruct",
+			[]string{"SELFDESTRUCT"},
+			common.FromHex("60ff5060006000ff"), // PUSH1 0xff POP PUSH1 0 PUSH1 0 SELFDESTRUCT
+			"banned opcode: SELFDESTRUCT",
+		},
+		{
+			"banned delegatecall",
+			[]string{"SELFDESTRUCT", "DELEGATECALL"},
+			common.FromHex("600060006000600060006000f400"), // DELEGATECALL STOP
+			"banned opcode: DELEGATECALL",
+		},
+		{
+			"banned opcode in truncated push data",
+			[]string{"SELFDESTRUCT"},
+			common.FromHex("61ff"), // PUSH2 with a single data byte
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.BannedOpcodes = tc.banned
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			contractAddr := crypto.CreateAddress(suite.address, nonce)
+			res := suite.applyCreateMessage(nonce, deployCode(tc.runtimeCode))
+
+			if tc.expErr == "" {
+				suite.Require().False(res.Failed(), res.VmError)
+				suite.Require().Equal(tc.runtimeCode, suite.app.EvmKeeper.GetCode(suite.ctx, suite.StateDB().GetCodeHash(contractAddr)))
+			} else {
+				suite.Require().True(res.Failed())
+				suite.Require().Equal(tc.expErr, res.VmError)
+				suite.Require().Empty(suite.StateDB().GetCode(contractAddr))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBannedOpcodesExistingContracts() {
+	suite.SetupTest()
+
+	// the contract deployed before the ban can still self-destruct
+	contractAddr := utiltx.GenerateAddress()
+	vmdb := suite.StateDB()
+	vmdb.SetCode(contractAddr, common.FromHex("6000ff")) // PUSH1 0 SELFDESTRUCT
+	suite.Require().NoError(vmdb.Commit())
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.BannedOpcodes = []string{"SELFDESTRUCT"}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	res := suite.applyValueMessage(contractAddr, 0, nil)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	// the factory contracts cannot create contracts with a banned opcode either
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	factoryAddr := crypto.CreateAddress(suite.address, nonce)
+	childCode := deployCode(common.FromHex("6000ff"))
+	// store the child creation code in memory, CREATE it and store its address in the slot 0
+	factoryCode := append(
+		[]byte{0x7f}, common.RightPadBytes(childCode, 32)...,
+	)
+	factoryCode = append(factoryCode, common.FromHex("600052")...) // PUSH1 0 MSTORE
+	factoryCode = append(factoryCode, 0x60, byte(len(childCode)))  // PUSH1 size
+	factoryCode = append(factoryCode, common.FromHex("60006000f060005500")...)
+	res = suite.applyCreateMessage(nonce, factoryCode)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, factoryAddr, common.Hash{}))
+	suite.Require().Empty(suite.StateDB().GetCode(crypto.CreateAddress(factoryAddr, 1)))
+}
+
+func (suite *KeeperTestSuite) applyCreateMessage(nonce uint64, data []byte) *types.MsgEthereumTxResponse {
+	msg := ethtypes.NewMessage(
+		suite.address, nil, nonce, big.NewInt(0), 1_000_000,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true,
+	)
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	return res
+}
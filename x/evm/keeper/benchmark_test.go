@@ -205,3 +205,16 @@ func BenchmarkMessageCall(b *testing.B) {
 		require.False(b, rsp.Failed())
 	}
 }
+
+func BenchmarkAddContractGas(b *testing.B) {
+	suite := KeeperTestSuite{}
+	suite.SetupTestWithT(b)
+	contract := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		suite.app.EvmKeeper.AddContractGas(suite.ctx, 1, contract, 21000)
+	}
+}
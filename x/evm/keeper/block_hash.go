@@ -0,0 +1,70 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// GetBlockHash returns the hash of the block at the given height, if it is
+// still retained in the store.
+func (k Keeper) GetBlockHash(ctx sdk.Context, height uint64) (common.Hash, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockHash)
+	bz := store.Get(sdk.Uint64ToBigEndian(height))
+	if len(bz) == 0 {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(bz), true
+}
+
+// SetBlockHash stores the hash of the block at the given height.
+func (k Keeper) SetBlockHash(ctx sdk.Context, height uint64, hash common.Hash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockHash)
+	store.Set(sdk.Uint64ToBigEndian(height), hash.Bytes())
+}
+
+// PruneBlockHashes deletes the hashes of the blocks that are no longer
+// retained, up to the current one. It returns the number of deleted hashes.
+func (k Keeper) PruneBlockHashes(ctx sdk.Context) int {
+	retained := k.GetParams(ctx).RetainedBlockHashes()
+	height := uint64(ctx.BlockHeight())
+	if height < retained {
+		return 0
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockHash)
+
+	// collect the keys first, as the store must not be written to while an
+	// iterator is open over it
+	var keys [][]byte
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(height+1-retained))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return len(keys)
+}
+
+// headerHash returns the hash of the header of the current block. It is
+// computed from the header when the context doesn't hold it (e.g. on CheckTx).
+func (k Keeper) headerHash(ctx sdk.Context) (common.Hash, error) {
+	if headerHash := ctx.HeaderHash(); len(headerHash) != 0 {
+		return common.BytesToHash(headerHash), nil
+	}
+
+	contextBlockHeader := ctx.BlockHeader()
+	header, err := tmtypes.HeaderFromProto(&contextBlockHeader)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(header.Hash()), nil
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// blockHashCode returns the hash of the block at the height given as calldata.
+//
+// PUSH1 0 CALLDATALOAD BLOCKHASH PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+var blockHashCode = common.FromHex("6000354060005260206000f3")
+
+func (suite *KeeperTestSuite) TestBlockHashWindow() {
+	suite.SetupTest()
+
+	// disable the historical info of the staking module, so that the hashes
+	// can only be read from the retained ones
+	stakingParams := suite.app.StakingKeeper.GetParams(suite.ctx)
+	stakingParams.HistoricalEntries = 0
+	suite.Require().NoError(suite.app.StakingKeeper.SetParams(suite.ctx, stakingParams))
+
+	contract := utiltx.GenerateAddress()
+	vmdb := suite.StateDB()
+	vmdb.SetCode(contract, blockHashCode)
+	suite.Require().NoError(vmdb.Commit())
+
+	blockHashes := make(map[int64]common.Hash)
+	for i := 0; i < 300; i++ {
+		suite.Commit()
+
+		header := suite.ctx.BlockHeader()
+		tmHeader, err := tmtypes.HeaderFromProto(&header)
+		suite.Require().NoError(err)
+		blockHashes[suite.ctx.BlockHeight()] = common.BytesToHash(tmHeader.Hash())
+	}
+
+	height := suite.ctx.BlockHeight()
+	blockHash := func(height int64) common.Hash {
+		res := suite.applyValueMessage(contract, 0, common.LeftPadBytes(big.NewInt(height).Bytes(), 32))
+		suite.Require().False(res.Failed(), res.VmError)
+		return common.BytesToHash(res.Ret)
+	}
+
+	testCases := []struct {
+		name    string
+		height  int64
+		expHash common.Hash
+	}{
+		{"previous block", height - 1, blockHashes[height-1]},
+		{"255 blocks ago", height - 255, blockHashes[height-255]},
+		{"256 blocks ago", height - 256, blockHashes[height-256]},
+		{"257 blocks ago", height - 257, common.Hash{}},
+		{"current block", height, common.Hash{}},
+		{"future block", height + 1, common.Hash{}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.expHash, blockHash(tc.height))
+		})
+	}
+
+	// the hashes beyond the retention are pruned
+	_, found := suite.app.EvmKeeper.GetBlockHash(suite.ctx, uint64(height-256))
+	suite.Require().True(found)
+	_, found = suite.app.EvmKeeper.GetBlockHash(suite.ctx, uint64(height-257))
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestPruneBlockHashes() {
+	suite.SetupTest()
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.BlockHashRetention = types.BlockHashWindow + 10
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	height := uint64(suite.ctx.BlockHeight()) + 1000
+	for h := uint64(1); h <= height; h++ {
+		suite.app.EvmKeeper.SetBlockHash(suite.ctx, h, common.BytesToHash(big.NewInt(int64(h)).Bytes()))
+	}
+
+	ctx := suite.ctx.WithBlockHeight(int64(height))
+	suite.Require().Equal(int(height-params.BlockHashRetention), suite.app.EvmKeeper.PruneBlockHashes(ctx))
+	suite.Require().Zero(suite.app.EvmKeeper.PruneBlockHashes(ctx))
+
+	_, found := suite.app.EvmKeeper.GetBlockHash(ctx, height-params.BlockHashRetention)
+	suite.Require().False(found)
+	hash, found := suite.app.EvmKeeper.GetBlockHash(ctx, height-params.BlockHashRetention+1)
+	suite.Require().True(found)
+	suite.Require().Equal(common.BytesToHash(big.NewInt(int64(height-params.BlockHashRetention+1)).Bytes()), hash)
+}
@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// IsBlockedAddress returns true if the given address is in the blocked
+// addresses of the params.
+func (k Keeper) IsBlockedAddress(ctx sdk.Context, addr common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockedAddress)
+	return store.Has(addr.Bytes())
+}
+
+// setBlockedAddresses replaces the index of the blocked addresses with the
+// given ones. The index is maintained along with the params, so its store
+// accesses are not charged, keeping the params updates at the same cost when
+// no address is blocked.
+func (k Keeper) setBlockedAddresses(ctx sdk.Context, addresses []string) {
+	k.setAddressIndex(ctx, types.KeyPrefixBlockedAddress, addresses)
+}
+
+// setAddressIndex replaces the addresses indexed under the given prefix with
+// the given ones, without charging the store accesses.
+func (k Keeper) setAddressIndex(ctx sdk.Context, keyPrefix []byte, addresses []string) {
+	store := prefix.NewStore(
+		ctx.WithKVGasConfig(storetypes.GasConfig{}).KVStore(k.storeKey),
+		keyPrefix,
+	)
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, address := range addresses {
+		store.Set(common.HexToAddress(address).Bytes(), []byte{1})
+	}
+}
+
+// isBlockedFn returns the function used by the EVM to check if an address is
+// blocked from value transfers, or nil if no address is blocked so that the
+// check is skipped entirely.
+//
+// The lookups are not charged as they are part of the value transfers, whose
+// gas costs are already covered by the EVM.
+func (k Keeper) isBlockedFn(ctx sdk.Context, params types.Params) vm.IsBlockedFunc {
+	if len(params.BlockedAddresses) == 0 {
+		return nil
+	}
+
+	store := prefix.NewStore(
+		ctx.WithKVGasConfig(storetypes.GasConfig{}).KVStore(k.storeKey),
+		types.KeyPrefixBlockedAddress,
+	)
+	return func(addr common.Address) bool {
+		return store.Has(addr.Bytes())
+	}
+}
+
+// IsBlockedModuleAccount returns true if the given address is a module account
+// that cannot receive value transfers.
+func (k Keeper) IsBlockedModuleAccount(addr common.Address) bool {
+	_, found := k.blockedModuleAccounts[addr]
+	return found
+}
+
+// isBlockedRecipientFn returns the function used by the EVM to check if an
+// address is a module account that cannot receive value transfers, or nil if
+// no module account is blocked.
+func (k Keeper) isBlockedRecipientFn() vm.IsBlockedFunc {
+	if len(k.blockedModuleAccounts) == 0 {
+		return nil
+	}
+	return k.IsBlockedModuleAccount
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	"github.com/evmos/evmos/v19/x/evm/types"
+	faucettypes "github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+// forwarderCode is the runtime code of a contract that forwards the value of
+// the call to the address given in the calldata, and stores the success of the
+// forwarding call in the slot 0.
+var forwarderCode = common.FromHex("6000600060006000346000355af160005500")
+
+// destructorCode is the runtime code of a contract that self-destructs to the
+// address given in the calldata.
+var destructorCode = common.FromHex("600035ff")
+
+func (suite *KeeperTestSuite) setBlockedAddresses(addresses ...common.Address) {
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.BlockedAddresses = make([]string, len(addresses))
+	for i, addr := range addresses {
+		params.BlockedAddresses[i] = addr.Hex()
+	}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+}
+
+func (suite *KeeperTestSuite) applyValueMessage(to common.Address, value int64, data []byte) *types.MsgEthereumTxResponse {
+	msg := ethtypes.NewMessage(
+		suite.address, &to, 0, big.NewInt(value), 100_000,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true,
+	)
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	return res
+}
+
+func (suite *KeeperTestSuite) TestBlockedAddresses() {
+	suite.SetupTest()
+
+	blocked := utiltx.GenerateAddress()
+	recipient := utiltx.GenerateAddress()
+	forwarder := utiltx.GenerateAddress()
+
+	vmdb := suite.StateDB()
+	vmdb.AddBalance(suite.address, big.NewInt(1_000_000))
+	vmdb.SetCode(forwarder, forwarderCode)
+	suite.Require().NoError(vmdb.Commit())
+
+	suite.setBlockedAddresses(blocked)
+	suite.Require().True(suite.app.EvmKeeper.IsBlockedAddress(suite.ctx, blocked))
+	suite.Require().False(suite.app.EvmKeeper.IsBlockedAddress(suite.ctx, recipient))
+
+	// the internal transfer to the blocked address reverts the inner call only
+	res := suite.applyValueMessage(forwarder, 1000, common.LeftPadBytes(blocked.Bytes(), 32))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, forwarder, common.Hash{}))
+	suite.Require().Zero(suite.StateDB().GetBalance(blocked).Sign())
+	suite.Require().Equal(big.NewInt(1000), suite.StateDB().GetBalance(forwarder))
+
+	// the internal transfer to any other address succeeds
+	res = suite.applyValueMessage(forwarder, 1000, common.LeftPadBytes(recipient.Bytes(), 32))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)), suite.app.EvmKeeper.GetState(suite.ctx, forwarder, common.Hash{}))
+	suite.Require().Equal(big.NewInt(1000), suite.StateDB().GetBalance(recipient))
+
+	// the top level transfer to the blocked address fails
+	res = suite.applyValueMessage(blocked, 1000, nil)
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(vm.ErrAddressBlocked.Error(), res.VmError)
+
+	// the zero value calls to the blocked address are allowed
+	res = suite.applyValueMessage(blocked, 0, nil)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	// unblocking the address removes it from the index
+	suite.setBlockedAddresses()
+	suite.Require().False(suite.app.EvmKeeper.IsBlockedAddress(suite.ctx, blocked))
+
+	res = suite.applyValueMessage(blocked, 1000, nil)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(big.NewInt(1000), suite.StateDB().GetBalance(blocked))
+}
+
+func (suite *KeeperTestSuite) TestBlockedModuleAccounts() {
+	suite.SetupTest()
+
+	feeCollector := common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	faucet := common.BytesToAddress(authtypes.NewModuleAddress(faucettypes.ModuleName))
+	forwarder := utiltx.GenerateAddress()
+	destructor := utiltx.GenerateAddress()
+
+	suite.Require().True(suite.app.EvmKeeper.IsBlockedModuleAccount(feeCollector))
+	suite.Require().False(suite.app.EvmKeeper.IsBlockedModuleAccount(faucet))
+
+	vmdb := suite.StateDB()
+	vmdb.AddBalance(suite.address, big.NewInt(1_000_000))
+	vmdb.SetCode(forwarder, forwarderCode)
+	vmdb.SetCode(destructor, destructorCode)
+	vmdb.AddBalance(destructor, big.NewInt(1000))
+	suite.Require().NoError(vmdb.Commit())
+
+	feeCollectorBalance := suite.StateDB().GetBalance(feeCollector)
+
+	// the top level transfer to a blocked module account fails
+	res := suite.applyValueMessage(feeCollector, 1000, nil)
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(vm.ErrModuleAccountRecipient.Error(), res.VmError)
+
+	// the zero value calls to a blocked module account are allowed
+	res = suite.applyValueMessage(feeCollector, 0, nil)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	// the internal transfer to a blocked module account reverts the inner call only
+	res = suite.applyValueMessage(forwarder, 1000, common.LeftPadBytes(feeCollector.Bytes(), 32))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, forwarder, common.Hash{}))
+	suite.Require().Equal(big.NewInt(1000), suite.StateDB().GetBalance(forwarder))
+
+	// the self-destruct to a blocked module account fails
+	res = suite.applyValueMessage(destructor, 0, common.LeftPadBytes(feeCollector.Bytes(), 32))
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(vm.ErrModuleAccountRecipient.Error(), res.VmError)
+	suite.Require().Equal(destructorCode, suite.StateDB().GetCode(destructor))
+	suite.Require().Equal(big.NewInt(1000), suite.StateDB().GetBalance(destructor))
+
+	suite.Require().Equal(feeCollectorBalance, suite.StateDB().GetBalance(feeCollector))
+
+	// the allowed module accounts can receive value transfers
+	res = suite.applyValueMessage(faucet, 1000, nil)
+	suite.Require().False(res.Failed(), res.VmError)
+	res = suite.applyValueMessage(forwarder, 1000, common.LeftPadBytes(faucet.Bytes(), 32))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)), suite.app.EvmKeeper.GetState(suite.ctx, forwarder, common.Hash{}))
+	res = suite.applyValueMessage(destructor, 0, common.LeftPadBytes(faucet.Bytes(), 32))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(big.NewInt(3000), suite.StateDB().GetBalance(faucet))
+}
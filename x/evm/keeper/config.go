@@ -27,10 +27,11 @@ func (k *Keeper) EVMConfig(ctx sdk.Context, proposerAddress sdk.ConsAddress, cha
 
 	baseFee := k.GetBaseFee(ctx, ethCfg)
 	return &statedb.EVMConfig{
-		Params:      params,
-		ChainConfig: ethCfg,
-		CoinBase:    coinbase,
-		BaseFee:     baseFee,
+		Params:       params,
+		ChainConfig:  ethCfg,
+		CoinBase:     coinbase,
+		BaseFee:      baseFee,
+		AccessPolicy: k.GetAccessPolicyLists(ctx),
 	}, nil
 }
 
@@ -62,5 +63,8 @@ func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig
 		Tracer:    tracer,
 		NoBaseFee: noBaseFee,
 		ExtraEips: cfg.Params.EIPs(),
+
+		OpCodeGasOverrides: cfg.Params.OpCodeGasOverrides(ctx.BlockHeight()),
+		BannedOpCodes:      cfg.Params.BannedOpCodes(),
 	}
 }
@@ -0,0 +1,175 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// GetContractGas returns the gas used by the txs calling the given contract in
+// the given epoch.
+func (k *Keeper) GetContractGas(ctx sdk.Context, epoch uint64, addr common.Address) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.ContractGasKey(epoch, addr))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// AddContractGas adds the given gas to the gas used by the txs calling the
+// given contract in the given epoch, and updates its position in the gas used
+// index of the epoch.
+func (k *Keeper) AddContractGas(ctx sdk.Context, epoch uint64, addr common.Address, gasUsed uint64) {
+	if gasUsed == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	prevGasUsed := k.GetContractGas(ctx, epoch, addr)
+	if prevGasUsed != 0 {
+		store.Delete(types.ContractGasIndexKey(epoch, prevGasUsed, addr))
+	}
+
+	total := prevGasUsed + gasUsed
+	if total < prevGasUsed {
+		// saturate instead of wrapping around on overflow
+		total = ^uint64(0)
+	}
+
+	store.Set(types.ContractGasKey(epoch, addr), sdk.Uint64ToBigEndian(total))
+	store.Set(types.ContractGasIndexKey(epoch, total, addr), []byte{1})
+}
+
+// GetContractCalls returns the number of txs calling the given contract in the
+// given epoch, and the number of those whose execution reverted.
+func (k *Keeper) GetContractCalls(ctx sdk.Context, epoch uint64, addr common.Address) (calls, reverts uint64) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ContractCallsKey(epoch, addr))
+	if len(bz) != 16 {
+		return 0, 0
+	}
+	return sdk.BigEndianToUint64(bz[:8]), sdk.BigEndianToUint64(bz[8:])
+}
+
+// AddContractCall counts a tx calling the given contract in the given epoch,
+// as reverted if its execution failed.
+func (k *Keeper) AddContractCall(ctx sdk.Context, epoch uint64, addr common.Address, reverted bool) {
+	calls, reverts := k.GetContractCalls(ctx, epoch, addr)
+	calls++
+	if reverted {
+		reverts++
+	}
+
+	bz := append(sdk.Uint64ToBigEndian(calls), sdk.Uint64ToBigEndian(reverts)...)
+	ctx.KVStore(k.storeKey).Set(types.ContractCallsKey(epoch, addr), bz)
+}
+
+// GetContractGasStats returns the execution statistics of the txs calling the
+// given contract in the given epoch.
+func (k *Keeper) GetContractGasStats(ctx sdk.Context, epoch uint64, addr common.Address) types.ContractGasStats {
+	calls, reverts := k.GetContractCalls(ctx, epoch, addr)
+
+	stats := types.ContractGasStats{
+		Address:    addr.Hex(),
+		GasUsed:    k.GetContractGas(ctx, epoch, addr),
+		Calls:      calls,
+		Reverts:    reverts,
+		RevertRate: sdkmath.LegacyZeroDec(),
+	}
+	if calls > 0 {
+		stats.RevertRate = sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(reverts)).
+			QuoInt(sdkmath.NewIntFromUint64(calls))
+	}
+	return stats
+}
+
+// accountContractGas adds the gas used by a tx to the gas used by the contract
+// it calls in the current epoch, and counts the call and whether it reverted,
+// if the contract gas accounting is enabled. Only the contract called by the
+// tx is accounted, not the ones it calls in turn, and contract creations are
+// ignored.
+//
+// The accounting runs after the EVM execution, so the store accesses it
+// performs are not charged to the tx.
+func (k *Keeper) accountContractGas(ctx sdk.Context, params types.Params, msg core.Message, gasUsed uint64, reverted bool) {
+	epoch, enabled := params.ContractGasEpoch(ctx.BlockHeight())
+	if !enabled || msg.To() == nil || !k.IsContract(ctx, *msg.To()) {
+		return
+	}
+
+	k.AddContractGas(ctx, epoch, *msg.To(), gasUsed)
+	k.AddContractCall(ctx, epoch, *msg.To(), reverted)
+}
+
+// emitContractGasMetrics sets the gauges of the gas used, calls and reverts of
+// the top contracts by gas used of the current epoch. Only the top contracts
+// are reported to bound the number of series.
+func (k *Keeper) emitContractGasMetrics(ctx sdk.Context) {
+	epoch, enabled := k.GetParams(ctx).ContractGasEpoch(ctx.BlockHeight())
+	if !enabled {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractGasIndexEpochPrefix(epoch))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for n := 0; n < contractGasMetricsTopN && iterator.Valid(); n++ {
+		key := iterator.Key()
+		iterator.Next()
+		if len(key) != 8+common.AddressLength {
+			continue
+		}
+
+		addr := common.BytesToAddress(key[8:])
+		calls, reverts := k.GetContractCalls(ctx, epoch, addr)
+		labels := []metrics.Label{telemetry.NewLabel("contract", addr.Hex())}
+
+		telemetry.SetGaugeWithLabels(metricKeyContractGasUsed, float32(^sdk.BigEndianToUint64(key[:8])), labels)
+		telemetry.SetGaugeWithLabels(metricKeyContractCalls, float32(calls), labels)
+		telemetry.SetGaugeWithLabels(metricKeyContractReverts, float32(reverts), labels)
+	}
+}
+
+// PruneContractGas deletes the contract gas usage of the epochs that are no
+// longer retained. It only runs on the first block of an epoch and returns the
+// number of deleted entries.
+func (k *Keeper) PruneContractGas(ctx sdk.Context) int {
+	params := k.GetParams(ctx)
+	epoch, enabled := params.ContractGasEpoch(ctx.BlockHeight())
+	if !enabled || uint64(ctx.BlockHeight())%params.ContractGasEpochBlocks != 0 || epoch < params.ContractGasRetainedEpochs {
+		return 0
+	}
+
+	// the epochs before the first retained one are deleted
+	firstRetained := epoch + 1 - params.ContractGasRetainedEpochs
+
+	deleted := 0
+	for _, keyPrefix := range [][]byte{types.KeyPrefixContractGas, types.KeyPrefixContractGasIndex, types.KeyPrefixContractCalls} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+		// collect the keys first, as the store must not be written to while
+		// an iterator is open over it
+		var keys [][]byte
+		iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(firstRetained))
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+		deleted += len(keys)
+	}
+
+	return deleted
+}
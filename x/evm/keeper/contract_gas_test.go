@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/keeper/testdata"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// enableContractGas enables the contract gas accounting with epochs of the
+// given number of blocks, keeping two epochs.
+func (suite *KeeperTestSuite) enableContractGas(epochBlocks uint64) {
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.ContractGasEpochBlocks = epochBlocks
+	params.ContractGasRetainedEpochs = 2
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+}
+
+// sendEthTx sends an Ethereum tx from the suite account and returns its result.
+func (suite *KeeperTestSuite) sendEthTx(to *common.Address, amount *big.Int, input []byte) *types.MsgEthereumTxResponse {
+	chainID := suite.app.EvmKeeper.ChainID()
+
+	msg := types.NewTx(&types.EvmTxArgs{
+		ChainID:  chainID,
+		Nonce:    suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		To:       to,
+		Amount:   amount,
+		GasLimit: 100_000,
+		Input:    input,
+	})
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	rsp, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	return rsp
+}
+
+func (suite *KeeperTestSuite) TestContractGasAccounting() {
+	suite.SetupTest()
+	suite.enableContractGas(10)
+	suite.ctx = suite.ctx.WithBlockHeight(20)
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	suite.Require().NoError(err)
+
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	tokenA := suite.DeployTestContract(suite.T(), suite.address, supply)
+	tokenB := suite.DeployTestContract(suite.T(), suite.address, supply)
+
+	transfer, err := erc20Contract.ABI.Pack("transfer", utiltx.GenerateAddress(), big.NewInt(1000))
+	suite.Require().NoError(err)
+	overTransfer, err := erc20Contract.ABI.Pack("transfer", utiltx.GenerateAddress(), new(big.Int).Add(supply, common.Big1))
+	suite.Require().NoError(err)
+
+	// the contract creations are not accounted
+	suite.Require().Zero(suite.app.EvmKeeper.GetContractGas(suite.ctx, 2, tokenA))
+
+	var expGasA, expGasB uint64
+	for i := 0; i < 3; i++ {
+		expGasA += suite.sendEthTx(&tokenA, nil, transfer).GasUsed
+	}
+	expGasB += suite.sendEthTx(&tokenB, nil, transfer).GasUsed
+
+	// failed txs are accounted with the gas used reported on their receipt
+	rsp := suite.sendEthTx(&tokenB, nil, overTransfer)
+	suite.Require().True(rsp.Failed())
+	expGasB += rsp.GasUsed
+
+	// transfers to accounts without code are not accounted
+	eoa := utiltx.GenerateAddress()
+	suite.sendEthTx(&eoa, big.NewInt(1), nil)
+
+	suite.Require().Equal(expGasA, suite.app.EvmKeeper.GetContractGas(suite.ctx, 2, tokenA))
+	suite.Require().Equal(expGasB, suite.app.EvmKeeper.GetContractGas(suite.ctx, 2, tokenB))
+	suite.Require().Zero(suite.app.EvmKeeper.GetContractGas(suite.ctx, 2, eoa))
+
+	// the txs of the next epoch are accumulated separately
+	suite.ctx = suite.ctx.WithBlockHeight(30)
+	expGasNext := suite.sendEthTx(&tokenB, nil, transfer).GasUsed
+	suite.Require().Equal(expGasB, suite.app.EvmKeeper.GetContractGas(suite.ctx, 2, tokenB))
+	suite.Require().Equal(expGasNext, suite.app.EvmKeeper.GetContractGas(suite.ctx, 3, tokenB))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	res, err := suite.app.EvmKeeper.TopContractsByGas(ctx, &types.QueryTopContractsByGasRequest{Epoch: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), res.CurrentEpoch)
+
+	expContracts := []types.ContractGas{
+		{Address: tokenA.Hex(), GasUsed: expGasA},
+		{Address: tokenB.Hex(), GasUsed: expGasB},
+	}
+	if expGasB > expGasA {
+		expContracts[0], expContracts[1] = expContracts[1], expContracts[0]
+	}
+	suite.Require().Equal(expContracts, res.Contracts)
+
+	res, err = suite.app.EvmKeeper.TopContractsByGas(ctx, &types.QueryTopContractsByGasRequest{
+		Epoch:      2,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expContracts[:1], res.Contracts)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	perContractRes, err := suite.app.EvmKeeper.PerContractGas(ctx, &types.QueryPerContractGasRequest{Address: tokenB.Hex(), Epoch: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expGasNext, perContractRes.GasUsed)
+	suite.Require().Equal(uint64(3), perContractRes.CurrentEpoch)
+
+	_, err = suite.app.EvmKeeper.PerContractGas(ctx, &types.QueryPerContractGasRequest{Address: "invalid", Epoch: 3})
+	suite.Require().Error(err)
+
+	// the calls are counted with the reverted ones
+	statsRes, err := suite.app.EvmKeeper.ContractGasStats(ctx, &types.QueryContractGasStatsRequest{Address: tokenB.Hex(), Epoch: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), statsRes.CurrentEpoch)
+	suite.Require().Equal(types.ContractGasStats{
+		Address:    tokenB.Hex(),
+		GasUsed:    expGasB,
+		Calls:      2,
+		Reverts:    1,
+		RevertRate: sdkmath.LegacyNewDecWithPrec(5, 1),
+	}, statsRes.Stats)
+
+	stats := suite.app.EvmKeeper.GetContractGasStats(suite.ctx, 2, tokenA)
+	suite.Require().Equal(uint64(3), stats.Calls)
+	suite.Require().Zero(stats.Reverts)
+	suite.Require().True(stats.RevertRate.IsZero())
+
+	stats = suite.app.EvmKeeper.GetContractGasStats(suite.ctx, 2, eoa)
+	suite.Require().Zero(stats.Calls)
+	suite.Require().True(stats.RevertRate.IsZero())
+
+	_, err = suite.app.EvmKeeper.ContractGasStats(ctx, &types.QueryContractGasStatsRequest{Address: "invalid", Epoch: 3})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestContractGasDisabled() {
+	suite.SetupTest()
+
+	contract := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.sendEthTx(&contract, nil, nil)
+
+	res, err := suite.app.EvmKeeper.TopContractsByGas(sdk.WrapSDKContext(suite.ctx), &types.QueryTopContractsByGasRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Contracts)
+}
+
+func (suite *KeeperTestSuite) TestPruneContractGas() {
+	suite.SetupTest()
+	suite.enableContractGas(10)
+
+	contract := utiltx.GenerateAddress()
+	for epoch := uint64(0); epoch < 4; epoch++ {
+		suite.app.EvmKeeper.AddContractGas(suite.ctx, epoch, contract, 100+epoch)
+	}
+	suite.app.EvmKeeper.AddContractGas(suite.ctx, 1, contract, 50)
+	suite.Require().Equal(uint64(151), suite.app.EvmKeeper.GetContractGas(suite.ctx, 1, contract))
+	suite.app.EvmKeeper.AddContractCall(suite.ctx, 1, contract, true)
+	suite.app.EvmKeeper.AddContractCall(suite.ctx, 3, contract, false)
+
+	// only the first block of an epoch prunes the expired epochs
+	suite.Require().Zero(suite.app.EvmKeeper.PruneContractGas(suite.ctx.WithBlockHeight(31)))
+
+	// epochs 2 and 3 are retained, each epoch has a gas entry and an index
+	// entry, and the epoch 1 has a calls entry
+	suite.Require().Equal(5, suite.app.EvmKeeper.PruneContractGas(suite.ctx.WithBlockHeight(30)))
+	suite.Require().Zero(suite.app.EvmKeeper.GetContractGas(suite.ctx, 0, contract))
+	suite.Require().Zero(suite.app.EvmKeeper.GetContractGas(suite.ctx, 1, contract))
+	suite.Require().Equal(uint64(102), suite.app.EvmKeeper.GetContractGas(suite.ctx, 2, contract))
+	suite.Require().Equal(uint64(103), suite.app.EvmKeeper.GetContractGas(suite.ctx, 3, contract))
+	calls, _ := suite.app.EvmKeeper.GetContractCalls(suite.ctx, 1, contract)
+	suite.Require().Zero(calls)
+	calls, _ = suite.app.EvmKeeper.GetContractCalls(suite.ctx, 3, contract)
+	suite.Require().Equal(uint64(1), calls)
+
+	res, err := suite.app.EvmKeeper.TopContractsByGas(sdk.WrapSDKContext(suite.ctx), &types.QueryTopContractsByGasRequest{Epoch: 1})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Contracts)
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v19/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// balanceChanges returns the net change of the balance of each account in the
+// given denom, from the coin_spent and coin_received events.
+func (suite *KeeperTestSuite) balanceChanges(events sdk.Events, denom string) map[string]sdkmath.Int {
+	changes := make(map[string]sdkmath.Int)
+	for _, event := range events {
+		var addrKey string
+		sign := int64(1)
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey, sign = banktypes.AttributeKeySpender, -1
+		case banktypes.EventTypeCoinReceived:
+			addrKey = banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		var addr string
+		var amount sdk.Coins
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case addrKey:
+				addr = attr.Value
+			case sdk.AttributeKeyAmount:
+				coins, err := sdk.ParseCoinsNormalized(attr.Value)
+				suite.Require().NoError(err)
+				amount = coins
+			}
+		}
+
+		change, found := changes[addr]
+		if !found {
+			change = sdkmath.ZeroInt()
+		}
+		changes[addr] = change.Add(amount.AmountOf(denom).MulRaw(sign))
+	}
+	return changes
+}
+
+func (suite *KeeperTestSuite) TestSponsoredEthereumTx() {
+	var (
+		value    = big.NewInt(1000)
+		gasLimit = uint64(100_000)
+		gasPrice = big.NewInt(1_000_000_000)
+		fees     = new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), gasPrice)
+	)
+
+	testCases := []struct {
+		name      string
+		sponsored bool
+	}{
+		{"fees paid by the sender", false},
+		{"fees paid by a fee payer", true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+			priv, err := ethsecp256k1.GenerateKey()
+			suite.Require().NoError(err)
+			sender := common.BytesToAddress(priv.PubKey().Address())
+			to := utiltx.GenerateAddress()
+
+			feePayer := sender
+			if tc.sponsored {
+				feePayer = utiltx.GenerateAddress()
+			}
+
+			// the sender only holds the value of the tx when it is sponsored
+			senderFunds := new(big.Int).Set(value)
+			if !tc.sponsored {
+				senderFunds.Add(senderFunds, fees)
+			}
+			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender.Bytes(), sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(senderFunds))))
+			suite.Require().NoError(err)
+			if tc.sponsored {
+				err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, feePayer.Bytes(), sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(fees))))
+				suite.Require().NoError(err)
+			}
+
+			msg := types.NewTx(&types.EvmTxArgs{
+				ChainID:  suite.app.EvmKeeper.ChainID(),
+				Nonce:    0,
+				To:       &to,
+				Amount:   value,
+				GasLimit: gasLimit,
+				GasPrice: gasPrice,
+			})
+			msg.From = sender.Hex()
+			suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), utiltx.NewSigner(priv)))
+
+			feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			accounts := []sdk.AccAddress{sender.Bytes(), feePayer.Bytes(), to.Bytes(), feeCollector}
+			balancesBefore := make(map[string]sdkmath.Int)
+			for _, acc := range accounts {
+				balancesBefore[acc.String()] = suite.app.BankKeeper.GetBalance(suite.ctx, acc, denom).Amount
+			}
+
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+
+			// deduct the fees as the ante handler does, from the fee payer
+			err = suite.app.EvmKeeper.DeductTxCostsFromUserBalance(ctx, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(fees))), feePayer)
+			suite.Require().NoError(err)
+			if tc.sponsored {
+				suite.app.EvmKeeper.SetTxFeePayerTransient(ctx, msg.AsTransaction().Hash(), feePayer)
+			}
+
+			res, err := suite.app.EvmKeeper.EthereumTx(ctx, msg)
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+			// leave some gas to refund
+			suite.Require().Less(res.GasUsed, gasLimit)
+
+			// the fee payer is only attributed when it is not the sender
+			var feePayerAttr string
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeEthereumTx {
+					continue
+				}
+				for _, attr := range event.Attributes {
+					if attr.Key == types.AttributeKeyFeePayer {
+						feePayerAttr = attr.Value
+					}
+				}
+			}
+			if tc.sponsored {
+				suite.Require().Equal(feePayer.Hex(), feePayerAttr)
+			} else {
+				suite.Require().Empty(feePayerAttr)
+			}
+
+			// every movement of the events is reflected on the balances
+			changes := suite.balanceChanges(ctx.EventManager().Events(), denom)
+			for addr, change := range changes {
+				acc := sdk.MustAccAddressFromBech32(addr)
+				before, found := balancesBefore[addr]
+				if !found {
+					before = sdkmath.ZeroInt()
+				}
+				balance := suite.app.BankKeeper.GetBalance(ctx, acc, denom).Amount
+				suite.Require().Equal(before.Add(change).String(), balance.String(), "account %s", addr)
+			}
+
+			// the fee payer only pays the gas used, the leftover gas is refunded to it
+			gasCost := sdkmath.NewIntFromBigInt(new(big.Int).Mul(new(big.Int).SetUint64(res.GasUsed), gasPrice))
+			valueInt := sdkmath.NewIntFromBigInt(value)
+			expChanges := map[string]sdkmath.Int{
+				sdk.AccAddress(to.Bytes()).String(): valueInt,
+				feeCollector.String():               gasCost,
+			}
+			if tc.sponsored {
+				expChanges[sdk.AccAddress(sender.Bytes()).String()] = valueInt.Neg()
+				expChanges[sdk.AccAddress(feePayer.Bytes()).String()] = gasCost.Neg()
+			} else {
+				expChanges[sdk.AccAddress(sender.Bytes()).String()] = valueInt.Add(gasCost).Neg()
+			}
+			for addr, expChange := range expChanges {
+				suite.Require().Equal(expChange.String(), changes[addr].String(), "account %s", addr)
+			}
+
+			// the other accounts, i.e. the EVM module account minting and burning
+			// the transferred value, are left unchanged
+			for addr, change := range changes {
+				if _, found := expChanges[addr]; !found {
+					suite.Require().True(change.IsZero(), "account %s", addr)
+				}
+			}
+		})
+	}
+}
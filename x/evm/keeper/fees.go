@@ -42,6 +42,22 @@ func CheckSenderBalance(
 	return nil
 }
 
+// CheckSponsoredSenderBalance validates that the sender of a tx whose fees are
+// paid by a paymaster has enough funds for the value of the tx.
+func CheckSponsoredSenderBalance(
+	balance sdkmath.Int,
+	txData types.TxData,
+) error {
+	value := txData.GetValue()
+	if balance.IsNegative() || balance.BigInt().Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"sender balance < tx value (%s < %s)", balance, value,
+		)
+	}
+	return nil
+}
+
 // DeductTxCostsFromUserBalance deducts the fees from the user balance. Returns an
 // error if the specified sender address does not exist or the account balance is not sufficient.
 func (k *Keeper) DeductTxCostsFromUserBalance(
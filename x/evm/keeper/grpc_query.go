@@ -3,6 +3,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -17,13 +18,17 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 
@@ -63,6 +68,33 @@ func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*typ
 	}, nil
 }
 
+// AccountInfo implements the Query/AccountInfo gRPC method
+func (k Keeper) AccountInfo(c context.Context, req *types.QueryAccountInfoRequest) (*types.QueryAccountInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument, err.Error(),
+		)
+	}
+
+	addr := common.HexToAddress(req.Address)
+
+	ctx := sdk.UnwrapSDKContext(c)
+	acct := k.GetAccountOrEmpty(ctx, addr)
+	accountType, isModuleAccount := k.GetAccountType(ctx, addr)
+
+	return &types.QueryAccountInfoResponse{
+		Balance:         acct.Balance.String(),
+		CodeHash:        common.BytesToHash(acct.CodeHash).Hex(),
+		Nonce:           acct.Nonce,
+		AccountType:     accountType,
+		IsModuleAccount: isModuleAccount,
+	}, nil
+}
+
 func (k Keeper) CosmosAccount(c context.Context, req *types.QueryCosmosAccountRequest) (*types.QueryCosmosAccountResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -175,6 +207,212 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}, nil
 }
 
+// StorageDiff implements the Query/StorageDiff gRPC method. It walks the storage
+// of the contract at both heights in ascending key order and returns the slots
+// that were added, removed or changed.
+func (k Keeper) StorageDiff(_ context.Context, req *types.QueryStorageDiffRequest) (*types.QueryStorageDiffResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	if req.HeightA <= 0 || req.HeightB <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "heights must be positive: %d, %d", req.HeightA, req.HeightB)
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	address := common.HexToAddress(req.Address)
+
+	storeA, err := k.storageAtHeight(address, req.HeightA)
+	if err != nil {
+		return nil, err
+	}
+
+	storeB, err := k.storageAtHeight(address, req.HeightB)
+	if err != nil {
+		return nil, err
+	}
+
+	iterA := storeA.Iterator(pageReq.Key, nil)
+	defer iterA.Close()
+
+	iterB := storeB.Iterator(pageReq.Key, nil)
+	defer iterB.Close()
+
+	var (
+		diffs   []types.StorageDiff
+		nextKey []byte
+		count   uint64
+	)
+
+	for iterA.Valid() || iterB.Valid() {
+		key, diff, changed := nextStorageDiff(iterA, iterB)
+		if !changed {
+			continue
+		}
+
+		count++
+		switch {
+		case count <= pageReq.Offset:
+			continue
+		case uint64(len(diffs)) < limit:
+			diffs = append(diffs, diff)
+			continue
+		case nextKey == nil:
+			nextKey = key
+		}
+
+		if !pageReq.CountTotal {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if pageReq.CountTotal {
+		pageRes.Total = count
+	}
+
+	return &types.QueryStorageDiffResponse{
+		Diffs:      diffs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// maxStorageRangeLimit is the maximum number of storage slots returned by a
+// single Query/StorageRange call.
+const maxStorageRangeLimit = 1000
+
+// StorageRange implements the Query/StorageRange gRPC method. It reads the
+// storage of the contract from the versioned multistore, so any height that is
+// not pruned can be queried regardless of the height of the query context.
+func (k Keeper) StorageRange(_ context.Context, req *types.QueryStorageRangeRequest) (*types.QueryStorageRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height must be positive: %d", req.Height)
+	}
+
+	pageReq := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pageReq = *req.Pagination
+	}
+
+	if pageReq.Limit == 0 || pageReq.Limit > maxStorageRangeLimit {
+		pageReq.Limit = maxStorageRangeLimit
+	}
+
+	store, err := k.storageAtHeight(common.HexToAddress(req.Address), req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// slots holding the empty hash are unset
+	var storage []types.State
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key, value []byte, accumulate bool) (bool, error) {
+		state := common.BytesToHash(value)
+		if state == (common.Hash{}) {
+			return false, nil
+		}
+
+		if accumulate {
+			storage = append(storage, types.NewState(common.BytesToHash(key), state))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryStorageRangeResponse{
+		Storage:    storage,
+		Pagination: pageRes,
+	}, nil
+}
+
+// storageAtHeight returns the storage of the given contract as committed at the
+// given height.
+func (k Keeper) storageAtHeight(address common.Address, height int64) (storetypes.KVStore, error) {
+	if k.versionedStore == nil {
+		return nil, status.Error(codes.Unavailable, "historical state is not available")
+	}
+
+	cms, err := k.versionedStore.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "state at height %d is not available: %s", height, err.Error())
+	}
+
+	return prefix.NewStore(cms.GetKVStore(k.storeKey), types.AddressStoragePrefix(address)), nil
+}
+
+// nextStorageDiff advances the storage iterators of both heights past the lowest
+// storage key and returns the change of that slot. Slots holding the empty hash
+// are considered unset. The returned flag is false if the slot holds the same
+// value at both heights.
+func nextStorageDiff(iterA, iterB storetypes.Iterator) (key []byte, diff types.StorageDiff, changed bool) {
+	var before, after common.Hash
+
+	switch {
+	case !iterB.Valid() || (iterA.Valid() && bytes.Compare(iterA.Key(), iterB.Key()) < 0):
+		key = common.CopyBytes(iterA.Key())
+		before = common.BytesToHash(iterA.Value())
+		iterA.Next()
+	case !iterA.Valid() || bytes.Compare(iterA.Key(), iterB.Key()) > 0:
+		key = common.CopyBytes(iterB.Key())
+		after = common.BytesToHash(iterB.Value())
+		iterB.Next()
+	default:
+		key = common.CopyBytes(iterA.Key())
+		before, after = common.BytesToHash(iterA.Value()), common.BytesToHash(iterB.Value())
+		iterA.Next()
+		iterB.Next()
+	}
+
+	switch {
+	case before == after:
+		return key, diff, false
+	case before == (common.Hash{}):
+		diff.Type = types.StorageDiffTypeAdded
+	case after == (common.Hash{}):
+		diff.Type = types.StorageDiffTypeRemoved
+	default:
+		diff.Type = types.StorageDiffTypeChanged
+	}
+
+	diff.Key = common.BytesToHash(key).Hex()
+	diff.Before = before.Hex()
+	diff.After = after.Hex()
+
+	return key, diff, true
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
@@ -213,6 +451,15 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	}, nil
 }
 
+// AccessPolicy implements the Query/AccessPolicy gRPC method
+func (k Keeper) AccessPolicy(c context.Context, _ *types.QueryAccessPolicyRequest) (*types.QueryAccessPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAccessPolicyResponse{
+		Policy: k.GetAccessPolicy(ctx),
+	}, nil
+}
+
 // EthCall implements eth_call rpc api.
 func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.MsgEthereumTxResponse, error) {
 	if req == nil {
@@ -230,24 +477,33 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+
+	ctx, overrides, err := applyBlockOverrides(ctx, req.BlockOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = k.applyStateOverrides(ctx, req.StateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if overrides.BaseFee != nil {
+		cfg.BaseFee = overrides.BaseFee.ToInt()
+	}
 
-	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
-	args.Nonce = (*hexutil.Uint64)(&nonce)
-
-	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	msg, err := k.newCallMessage(ctx, cfg, args, req.GasCap, req.DefaultBaseFee)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 
-	// pass false to not commit StateDB
-	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
+	res, err := k.simulateMessage(ctx, msg, args.TxType(), cfg, txConfig)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -255,6 +511,233 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	return res, nil
 }
 
+// EthCallMany implements the eth_callMany rpc api. The calls of the bundles are
+// executed in order on a cache of the state, so that each call sees the state
+// changes of the previous ones, and the cache is discarded.
+func (k Keeper) EthCallMany(c context.Context, req *types.EthCallManyRequest) (*types.EthCallManyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bundles := make([][]types.TransactionArgs, len(req.Bundles))
+	for i, bundle := range req.Bundles {
+		if err := json.Unmarshal(bundle.Args, &bundles[i]); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid args of bundle %d: %s", i, err)
+		}
+	}
+
+	// the state overrides are applied on a cache context, which also holds the
+	// state changes of the calls
+	ctx, err = k.applyStateOverrides(ctx, req.StateOverrides)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.StateOverrides) == 0 {
+		ctx, _ = ctx.CacheContext()
+	}
+
+	res := &types.EthCallManyResponse{Bundles: make([]types.EthCallBundleResult, len(req.Bundles))}
+	for i, bundle := range req.Bundles {
+		bundleCtx, overrides, err := applyBlockOverrides(ctx, bundle.BlockOverrides)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := k.EVMConfig(bundleCtx, GetProposerAddress(bundleCtx, req.ProposerAddress), chainID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if overrides.BaseFee != nil {
+			cfg.BaseFee = overrides.BaseFee.ToInt()
+		}
+
+		results := make([]types.EthCallResult, len(bundles[i]))
+		for j, args := range bundles[i] {
+			msg, err := k.newCallMessage(bundleCtx, cfg, args, req.GasCap, req.DefaultBaseFee)
+			if err != nil {
+				return nil, status.Errorf(status.Code(err), "call %d of bundle %d: %s", j, i, status.Convert(err).Message())
+			}
+
+			results[j], err = k.applyCall(bundleCtx, msg, cfg, req.CreateAccessList)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "call %d of bundle %d: %s", j, i, err)
+			}
+		}
+		res.Bundles[i].Results = results
+	}
+
+	return res, nil
+}
+
+// applyCall applies a call of an EthCallMany request, committing its state
+// changes to the given context. The nonce of the sender is increased as it
+// would be by the ante handler. If requested, the access list of the call is
+// generated by tracing it until the touched accounts and slots are stable, as
+// the eth_createAccessList rpc api of go-ethereum does.
+func (k Keeper) applyCall(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *statedb.EVMConfig,
+	createAccessList bool,
+) (types.EthCallResult, error) {
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	var (
+		rsp        *types.MsgEthereumTxResponse
+		accessList ethtypes.AccessList
+		write      func()
+		err        error
+	)
+
+	if !createAccessList {
+		rsp, err = k.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+		if err != nil {
+			return types.EthCallResult{}, err
+		}
+	} else {
+		// the sender, the recipient and the precompiles are warm without being
+		// part of the access list
+		to := crypto.CreateAddress(msg.From(), msg.Nonce())
+		if msg.To() != nil {
+			to = *msg.To()
+		}
+		rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+		precompiles := append(
+			append([]common.Address{}, vm.DefaultActivePrecompiles(rules)...),
+			cfg.Params.GetActiveStaticPrecompilesAddrs()...,
+		)
+
+		prevTracer := logger.NewAccessListTracer(msg.AccessList(), msg.From(), to, precompiles)
+		for {
+			accessList = prevTracer.AccessList()
+			msg = ethtypes.NewMessage(
+				msg.From(),
+				msg.To(),
+				msg.Nonce(),
+				msg.Value(),
+				msg.Gas(),
+				msg.GasPrice(),
+				msg.GasFeeCap(),
+				msg.GasTipCap(),
+				msg.Data(),
+				accessList,
+				msg.IsFake(),
+			)
+
+			// each iteration is applied on its own cache, the one of the final
+			// iteration is written
+			var tmpCtx sdk.Context
+			tmpCtx, write = ctx.CacheContext()
+
+			tracer := logger.NewAccessListTracer(accessList, msg.From(), to, precompiles)
+			rsp, err = k.ApplyMessageWithConfig(tmpCtx, msg, tracer, true, cfg, txConfig)
+			if err != nil {
+				return types.EthCallResult{}, err
+			}
+			if tracer.Equal(prevTracer) {
+				break
+			}
+			prevTracer = tracer
+		}
+		write()
+	}
+
+	// the nonce is only increased by the state transition for contract creations
+	if msg.To() != nil {
+		acct := k.GetAccountOrEmpty(ctx, msg.From())
+		acct.Nonce = msg.Nonce() + 1
+		if err := k.SetAccount(ctx, msg.From(), acct); err != nil {
+			return types.EthCallResult{}, err
+		}
+	}
+
+	return types.EthCallResult{
+		Ret:        rsp.Ret,
+		GasUsed:    rsp.GasUsed,
+		VmError:    rsp.VmError,
+		AccessList: types.NewAccessList(&accessList),
+	}, nil
+}
+
+// applyBlockOverrides decodes the given json block overrides and applies them
+// on the context. The base fee override is applied to the EVM configuration by
+// the caller.
+func applyBlockOverrides(ctx sdk.Context, bz []byte) (sdk.Context, types.BlockOverrides, error) {
+	var overrides types.BlockOverrides
+	if len(bz) == 0 {
+		return ctx, overrides, nil
+	}
+
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return ctx, overrides, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := overrides.Validate(); err != nil {
+		return ctx, overrides, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return overrides.Apply(ctx), overrides, nil
+}
+
+// newCallMessage converts the args of a call to a message for the given EVM
+// configuration, with the current nonce of the sender.
+func (k Keeper) newCallMessage(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	args types.TransactionArgs,
+	gasCap uint64,
+	defaultBaseFee bool,
+) (core.Message, error) {
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	balance := k.GetBalance(ctx, from)
+
+	// price the call with the base fee when no fee is specified, capping the
+	// default gas limit to what the sender can afford
+	if defaultBaseFee && cfg.BaseFee != nil && cfg.BaseFee.Sign() > 0 && !args.HasFees() {
+		args.MaxFeePerGas = (*hexutil.Big)(cfg.BaseFee)
+
+		if args.Gas == nil {
+			allowance := new(big.Int).Set(balance)
+			if args.Value != nil {
+				allowance.Sub(allowance, args.Value.ToInt())
+			}
+			allowance.Quo(allowance, cfg.BaseFee)
+			if allowance.Sign() > 0 && allowance.IsUint64() && (gasCap == 0 || allowance.Uint64() < gasCap) {
+				gas := allowance.Uint64()
+				args.Gas = (*hexutil.Uint64)(&gas)
+			}
+		}
+	}
+
+	msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// check that the sender can pay for the gas and value as the state
+	// transition does when the call is priced
+	if msg.GasFeeCap().Sign() > 0 {
+		cost := new(big.Int).Mul(msg.GasFeeCap(), new(big.Int).SetUint64(msg.Gas()))
+		cost.Add(cost, msg.Value())
+		if balance.Cmp(cost) < 0 {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"%s: address %s have %s want %s", core.ErrInsufficientFunds, from, balance, cost,
+			)
+		}
+	}
+
+	return msg, nil
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
 	return k.EstimateGasInternal(c, req, types.RPC)
@@ -287,6 +770,11 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	ctx, err = k.applyStateOverrides(ctx, req.StateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -374,8 +862,13 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 			gasMeter := evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas())
 			tmpCtx = evmante.BuildEvmExecutionCtx(tmpCtx).WithGasMeter(gasMeter)
 		}
-		// pass false to not commit StateDB
-		rsp, err = k.ApplyMessageWithConfig(tmpCtx, msg, nil, false, cfg, txConfig)
+		// the internal calls don't run the post tx hooks, unlike the txs
+		if fromType == types.RPC {
+			rsp, err = k.simulateMessage(tmpCtx, msg, args.TxType(), cfg, txConfig)
+		} else {
+			// pass false to not commit StateDB
+			rsp, err = k.ApplyMessageWithConfig(tmpCtx, msg, nil, false, cfg, txConfig)
+		}
 		if err != nil {
 			if errors.Is(err, core.ErrIntrinsicGas) {
 				return true, nil, nil // Special case, raise gas limit
@@ -460,26 +953,11 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 
-	// gas used at this point corresponds to GetProposerAddress & CalculateBaseFee
-	// need to reset gas meter per transaction to be consistent with tx execution
-	// and avoid stacking the gas used of every predecessor in the same gas meter
-
 	for i, tx := range req.Predecessors {
 		ethTx := tx.AsTransaction()
-		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
-		if err != nil {
-			continue
-		}
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
-		// reset gas meter for each transaction
-		ctx = evmante.BuildEvmExecutionCtx(ctx).
-			WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
-		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
-		if err != nil {
-			continue
-		}
-		txConfig.LogIndex += uint(len(rsp.Logs))
+		txConfig.LogIndex = k.replayTx(ctx, cfg, txConfig, signer, ethTx)
 	}
 
 	tx := req.Msg.AsTransaction()
@@ -506,7 +984,8 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}
 
 	return &types.QueryTraceTxResponse{
-		Data: resultData,
+		Data:        resultData,
+		ContentType: types.TraceContentTypeJSON,
 	}, nil
 }
 
@@ -522,6 +1001,11 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
 	}
 
+	start, end, pageRes, err := traceBlockPage(req.Pagination, len(req.Txs))
+	if err != nil {
+		return nil, err
+	}
+
 	// get the context of block beginning
 	contextHeight := req.BlockNumber
 	if contextHeight < 1 {
@@ -556,16 +1040,22 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}
 
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
-	txsLength := len(req.Txs)
-	results := make([]*types.TxTraceResult, 0, txsLength)
+	results := make([]*types.TxTraceResult, 0, end-start)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 
-	for i, tx := range req.Txs {
-		result := types.TxTraceResult{}
+	for i, tx := range req.Txs[:end] {
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
+
+		// the txs before the page are only executed to trace the page on top of them
+		if i < start {
+			txConfig.LogIndex = k.replayTx(ctx, cfg, txConfig, signer, ethTx)
+			continue
+		}
+
+		result := types.TxTraceResult{}
 		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
 		if err != nil {
 			result.Error = err.Error()
@@ -581,11 +1071,157 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := k.checkTraceResultSize(resultData); err != nil {
+		return nil, err
+	}
+
 	return &types.QueryTraceBlockResponse{
-		Data: resultData,
+		Data:        resultData,
+		ContentType: types.TraceContentTypeJSON,
+		Pagination:  pageRes,
 	}, nil
 }
 
+// traceBlockPage returns the range of the txs traced by a TraceBlock query and
+// its page response. All the txs are traced when no page is requested.
+func traceBlockPage(pageReq *query.PageRequest, txsLength int) (start, end int, pageRes *query.PageResponse, err error) {
+	if pageReq == nil {
+		return 0, txsLength, nil, nil
+	}
+
+	if pageReq.Reverse {
+		return 0, 0, nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return 0, 0, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	// the key is the big endian index of the first tx of the page
+	offset := pageReq.Offset
+	if len(pageReq.Key) > 0 {
+		if len(pageReq.Key) != 8 {
+			return 0, 0, nil, status.Errorf(codes.InvalidArgument, "invalid pagination key: %x", pageReq.Key)
+		}
+		offset = sdk.BigEndianToUint64(pageReq.Key)
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	total := uint64(txsLength)
+	if offset > total {
+		offset = total
+	}
+
+	last := total
+	if limit < total-offset {
+		last = offset + limit
+	}
+
+	pageRes = &query.PageResponse{}
+	if last < total {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(last)
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+
+	return int(offset), int(last), pageRes, nil
+}
+
+// TraceCall configures a new tracer according to the provided configuration,
+// and executes the given call on the state of the queried height without
+// committing it. The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	result, _, err := k.traceMessage(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceMessage
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data:        resultData,
+		ContentType: types.TraceContentTypeJSON,
+	}, nil
+}
+
+// replayTx executes a tx without tracing it and commits its state changes, so
+// that the txs following it are traced on top of them. It returns the log index
+// of the next tx. The txs that fail to execute are skipped.
+func (k *Keeper) replayTx(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	signer ethtypes.Signer,
+	tx *ethtypes.Transaction,
+) uint {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return txConfig.LogIndex
+	}
+
+	// reset the gas meter for each transaction to be consistent with the tx
+	// execution and avoid stacking the gas used of every tx in the same meter
+	ctx = evmante.BuildEvmExecutionCtx(ctx).
+		WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
+	rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
+	if err != nil {
+		return txConfig.LogIndex
+	}
+
+	return txConfig.LogIndex + uint(len(rsp.Logs))
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
@@ -596,6 +1232,24 @@ func (k *Keeper) traceTx(
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMessage(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// traceMessage do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMessage(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -604,10 +1258,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -624,6 +1274,8 @@ func (k *Keeper) traceTx(
 		EnableReturnData: traceConfig.EnableReturnData,
 		Debug:            traceConfig.Debug,
 		Limit:            int(traceConfig.Limit),
+		MaxStructLogs:    k.traceMaxStructLogs,
+		MaxResultBytes:   k.traceMaxResultBytes,
 		Overrides:        overrides,
 	}
 
@@ -667,15 +1319,35 @@ func (k *Keeper) traceTx(
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
 
-	var result interface{}
-	result, err = tracer.GetResult()
+	rawResult, err := tracer.GetResult()
 	if err != nil {
+		if errors.Is(err, logger.ErrTraceOutputTooLarge) {
+			return nil, 0, status.Error(codes.ResourceExhausted, err.Error())
+		}
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
 
+	// the output of the custom tracers is only bounded once they are done
+	if err := k.checkTraceResultSize(rawResult); err != nil {
+		return nil, 0, err
+	}
+
+	var result interface{} = rawResult
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
 }
 
+// checkTraceResultSize returns an error if the given trace result exceeds the
+// maximum result size.
+func (k *Keeper) checkTraceResultSize(result []byte) error {
+	if k.traceMaxResultBytes == 0 || len(result) <= k.traceMaxResultBytes {
+		return nil
+	}
+	return status.Errorf(
+		codes.ResourceExhausted,
+		"%s: %d bytes, limit %d", logger.ErrTraceOutputTooLarge, len(result), k.traceMaxResultBytes,
+	)
+}
+
 // BaseFee implements the Query/BaseFee gRPC method
 func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -693,6 +1365,130 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// OpcodeGasOverrides implements the Query/OpcodeGasOverrides gRPC method
+func (k Keeper) OpcodeGasOverrides(c context.Context, _ *types.QueryOpcodeGasOverridesRequest) (*types.QueryOpcodeGasOverridesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	res := &types.QueryOpcodeGasOverridesResponse{
+		Overrides:        []types.OpcodeGasOverride{},
+		ActivationHeight: params.OpcodeGasOverridesActivationHeight,
+	}
+	if params.OpcodeGasOverridesActive(ctx.BlockHeight()) {
+		res.Overrides = params.OpcodeGasOverrides
+	}
+
+	return res, nil
+}
+
+// TopContractsByGas implements the Query/TopContractsByGas gRPC method
+func (k Keeper) TopContractsByGas(c context.Context, req *types.QueryTopContractsByGasRequest) (*types.QueryTopContractsByGasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	currentEpoch, _ := k.GetParams(ctx).ContractGasEpoch(ctx.BlockHeight())
+
+	contracts := []types.ContractGas{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractGasIndexEpochPrefix(req.Epoch))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		if len(key) != 8+common.AddressLength {
+			return fmt.Errorf("invalid contract gas index key: %x", key)
+		}
+
+		contracts = append(contracts, types.ContractGas{
+			Address: common.BytesToAddress(key[8:]).Hex(),
+			GasUsed: ^sdk.BigEndianToUint64(key[:8]),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopContractsByGasResponse{
+		Contracts:    contracts,
+		Pagination:   pageRes,
+		CurrentEpoch: currentEpoch,
+	}, nil
+}
+
+// PerContractGas implements the Query/PerContractGas gRPC method
+func (k Keeper) PerContractGas(c context.Context, req *types.QueryPerContractGasRequest) (*types.QueryPerContractGasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	currentEpoch, _ := k.GetParams(ctx).ContractGasEpoch(ctx.BlockHeight())
+
+	return &types.QueryPerContractGasResponse{
+		GasUsed:      k.GetContractGas(ctx, req.Epoch, common.HexToAddress(req.Address)),
+		CurrentEpoch: currentEpoch,
+	}, nil
+}
+
+// ContractGasStats implements the Query/ContractGasStats gRPC method
+func (k Keeper) ContractGasStats(c context.Context, req *types.QueryContractGasStatsRequest) (*types.QueryContractGasStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	currentEpoch, _ := k.GetParams(ctx).ContractGasEpoch(ctx.BlockHeight())
+
+	return &types.QueryContractGasStatsResponse{
+		Stats:        k.GetContractGasStats(ctx, req.Epoch, common.HexToAddress(req.Address)),
+		CurrentEpoch: currentEpoch,
+	}, nil
+}
+
+// GasPriceFloor implements the Query/GasPriceFloor gRPC method. The minimum
+// gas prices of the node are read from the query context, which baseapp sets
+// from the node configuration.
+func (k Keeper) GasPriceFloor(c context.Context, req *types.QueryGasPriceFloorRequest) (*types.QueryGasPriceFloorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	ethCfg := params.ChainConfig.EthereumConfig(k.eip155ChainID)
+
+	res := &types.QueryGasPriceFloorResponse{
+		MinGasPrice:      k.feeMarketKeeper.GetParams(ctx).MinGasPrice,
+		NodeMinGasPrices: ctx.MinGasPrices(),
+	}
+
+	res.GasPriceFloor = sdkmath.LegacyMaxDec(res.MinGasPrice, res.NodeMinGasPrices.AmountOf(params.EvmDenom))
+	if baseFee := k.GetBaseFee(ctx, ethCfg); baseFee != nil {
+		aux := sdkmath.NewIntFromBigInt(baseFee)
+		res.BaseFee = &aux
+		res.GasPriceFloor = sdkmath.LegacyMaxDec(res.GasPriceFloor, sdkmath.LegacyNewDecFromInt(aux))
+	}
+
+	fee := res.GasPriceFloor.MulInt(sdkmath.NewIntFromUint64(req.Gas)).Ceil().TruncateInt()
+	res.RecommendedFee = sdk.NewCoin(params.EvmDenom, fee)
+
+	return res, nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
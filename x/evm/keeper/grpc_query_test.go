@@ -8,10 +8,14 @@ import (
 	"github.com/evmos/evmos/v19/x/evm/keeper/testdata"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	ethlogger "github.com/evmos/evmos/v19/x/evm/core/logger"
@@ -21,16 +25,19 @@ import (
 	utiltx "github.com/evmos/evmos/v19/testutil/tx"
 	"github.com/evmos/evmos/v19/x/evm/statedb"
 	"github.com/evmos/evmos/v19/x/evm/types"
+	vestingtypes "github.com/evmos/evmos/v19/x/vesting/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Not valid Ethereum address
 const invalidAddress = "0x0000"
 
 // expGasConsumed is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee)
-const expGasConsumed = 7475
+const expGasConsumed = 7655
 
 // expGasConsumedWithFeeMkt is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) with enabled feemarket
-const expGasConsumedWithFeeMkt = 7469
+const expGasConsumedWithFeeMkt = 7649
 
 func (suite *KeeperTestSuite) TestQueryAccount() {
 	var (
@@ -99,6 +106,130 @@ func (suite *KeeperTestSuite) TestQueryAccount() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryAccountInfo() {
+	var req *types.QueryAccountInfoRequest
+
+	emptyCodeHash := common.BytesToHash(crypto.Keccak256(nil)).Hex()
+
+	testCases := []struct {
+		msg         string
+		malleate    func()
+		expPass     bool
+		expType     types.AccountType
+		expIsModule bool
+	}{
+		{
+			"fail - invalid address",
+			func() {
+				req = &types.QueryAccountInfoRequest{Address: invalidAddress}
+			},
+			false,
+			types.AccountTypeUnspecified,
+			false,
+		},
+		{
+			"pass - account does not exist",
+			func() {
+				req = &types.QueryAccountInfoRequest{Address: utiltx.GenerateAddress().String()}
+			},
+			true,
+			types.AccountTypeUnspecified,
+			false,
+		},
+		{
+			"pass - base account without public key",
+			func() {
+				addr := utiltx.GenerateAddress()
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+				req = &types.QueryAccountInfoRequest{Address: addr.String()}
+			},
+			true,
+			types.AccountTypeBase,
+			false,
+		},
+		{
+			"pass - externally owned account",
+			func() {
+				acc := suite.app.AccountKeeper.GetAccount(suite.ctx, suite.address.Bytes())
+				suite.Require().NoError(acc.SetPubKey(suite.priv.PubKey()))
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+				req = &types.QueryAccountInfoRequest{Address: suite.address.String()}
+			},
+			true,
+			types.AccountTypeEOA,
+			false,
+		},
+		{
+			"pass - contract account",
+			func() {
+				addr := utiltx.GenerateAddress()
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+				suite.app.EvmKeeper.SetCodeHash(suite.ctx, addr.Bytes(), crypto.Keccak256([]byte("code")))
+				req = &types.QueryAccountInfoRequest{Address: addr.String()}
+			},
+			true,
+			types.AccountTypeContract,
+			false,
+		},
+		{
+			"pass - vesting account",
+			func() {
+				addr := utiltx.GenerateAddress()
+				baseAcc := authtypes.NewBaseAccountWithAddress(addr.Bytes())
+				acc := vestingtypes.NewClawbackVestingAccount(baseAcc, suite.address.Bytes(), nil, suite.ctx.BlockTime(), nil, nil)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccount(suite.ctx, acc))
+				req = &types.QueryAccountInfoRequest{Address: addr.String()}
+			},
+			true,
+			types.AccountTypeVesting,
+			false,
+		},
+		{
+			"pass - blocked module address without account",
+			func() {
+				addr := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+				req = &types.QueryAccountInfoRequest{Address: addr.String()}
+			},
+			true,
+			types.AccountTypeModule,
+			false,
+		},
+		{
+			"pass - module account",
+			func() {
+				acc := suite.app.AccountKeeper.GetModuleAccount(suite.ctx, types.ModuleName)
+				req = &types.QueryAccountInfoRequest{Address: common.BytesToAddress(acc.GetAddress()).String()}
+			},
+			true,
+			types.AccountTypeModule,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.AccountInfo(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expType, res.AccountType)
+				suite.Require().Equal(tc.expIsModule, res.IsModuleAccount)
+				if tc.expType != types.AccountTypeContract {
+					suite.Require().Equal(emptyCodeHash, res.CodeHash)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryCosmosAccount() {
 	var (
 		req        *types.QueryCosmosAccountRequest
@@ -295,6 +426,295 @@ func (suite *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryStorageDiff() {
+	suite.SetupTest()
+
+	var (
+		contract = utiltx.GenerateAddress()
+		slot     = func(i int64) common.Hash { return common.BigToHash(big.NewInt(i)) }
+		one      = common.BytesToHash([]byte("one"))
+		two      = common.BytesToHash([]byte("two"))
+		zero     = common.Hash{}
+	)
+
+	// commitState sets the given slots of the contract and commits a new block
+	commitState := func(slots map[int64]common.Hash) int64 {
+		vmdb := suite.StateDB()
+		for i, value := range slots {
+			vmdb.SetState(contract, slot(i), value)
+		}
+		suite.Require().NoError(vmdb.Commit())
+		suite.Commit()
+		return suite.app.LastBlockHeight()
+	}
+
+	heightA := commitState(map[int64]common.Hash{1: one, 2: one, 3: one})
+	heightB := commitState(map[int64]common.Hash{1: two, 2: zero, 4: one})
+	heightC := commitState(map[int64]common.Hash{1: one})
+
+	expDiffs := []types.StorageDiff{
+		{Key: slot(1).Hex(), Before: one.Hex(), After: two.Hex(), Type: types.StorageDiffTypeChanged},
+		{Key: slot(2).Hex(), Before: one.Hex(), After: zero.Hex(), Type: types.StorageDiffTypeRemoved},
+		{Key: slot(4).Hex(), Before: zero.Hex(), After: one.Hex(), Type: types.StorageDiffTypeAdded},
+	}
+
+	testCases := []struct {
+		msg      string
+		req      *types.QueryStorageDiffRequest
+		expDiffs []types.StorageDiff
+		expPass  bool
+	}{
+		{
+			"fail - invalid address",
+			&types.QueryStorageDiffRequest{Address: invalidAddress, HeightA: heightA, HeightB: heightB},
+			nil,
+			false,
+		},
+		{
+			"fail - non positive height",
+			&types.QueryStorageDiffRequest{Address: contract.Hex(), HeightA: 0, HeightB: heightB},
+			nil,
+			false,
+		},
+		{
+			"fail - future height",
+			&types.QueryStorageDiffRequest{Address: contract.Hex(), HeightA: heightA, HeightB: heightC + 10},
+			nil,
+			false,
+		},
+		{
+			"fail - both key and offset",
+			&types.QueryStorageDiffRequest{
+				Address: contract.Hex(), HeightA: heightA, HeightB: heightB,
+				Pagination: &query.PageRequest{Key: slot(1).Bytes(), Offset: 1},
+			},
+			nil,
+			false,
+		},
+		{
+			"success - added, removed and changed slots",
+			&types.QueryStorageDiffRequest{Address: contract.Hex(), HeightA: heightA, HeightB: heightB},
+			expDiffs,
+			true,
+		},
+		{
+			"success - reverted slot is unchanged",
+			&types.QueryStorageDiffRequest{Address: contract.Hex(), HeightA: heightA, HeightB: heightC},
+			expDiffs[1:],
+			true,
+		},
+		{
+			"success - reversed heights",
+			&types.QueryStorageDiffRequest{Address: contract.Hex(), HeightA: heightB, HeightB: heightA},
+			[]types.StorageDiff{
+				{Key: slot(1).Hex(), Before: two.Hex(), After: one.Hex(), Type: types.StorageDiffTypeChanged},
+				{Key: slot(2).Hex(), Before: zero.Hex(), After: one.Hex(), Type: types.StorageDiffTypeAdded},
+				{Key: slot(4).Hex(), Before: one.Hex(), After: zero.Hex(), Type: types.StorageDiffTypeRemoved},
+			},
+			true,
+		},
+		{
+			"success - same height",
+			&types.QueryStorageDiffRequest{Address: contract.Hex(), HeightA: heightB, HeightB: heightB},
+			nil,
+			true,
+		},
+		{
+			"success - offset",
+			&types.QueryStorageDiffRequest{
+				Address: contract.Hex(), HeightA: heightA, HeightB: heightB,
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			expDiffs[1:2],
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := suite.queryClient.StorageDiff(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expDiffs, res.Diffs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	suite.Run("success - paginate by key", func() {
+		var (
+			diffs   []types.StorageDiff
+			nextKey []byte
+		)
+
+		for {
+			res, err := suite.queryClient.StorageDiff(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageDiffRequest{
+				Address: contract.Hex(), HeightA: heightA, HeightB: heightB,
+				Pagination: &query.PageRequest{Key: nextKey, Limit: 1, CountTotal: true},
+			})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Diffs, 1)
+
+			diffs = append(diffs, res.Diffs...)
+			nextKey = res.Pagination.NextKey
+			if nextKey == nil {
+				break
+			}
+		}
+
+		suite.Require().Equal(expDiffs, diffs)
+	})
+
+	suite.Run("fail - pruned height", func() {
+		cms, ok := suite.app.CommitMultiStore().(*rootmulti.Store)
+		suite.Require().True(ok)
+		suite.Require().NoError(cms.PruneStores(false, []int64{heightA}))
+
+		_, err := suite.queryClient.StorageDiff(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageDiffRequest{
+			Address: contract.Hex(), HeightA: heightA, HeightB: heightB,
+		})
+		suite.Require().ErrorContains(err, "pruned")
+	})
+}
+
+func (suite *KeeperTestSuite) TestQueryStorageRange() {
+	suite.SetupTest()
+
+	var (
+		contract = utiltx.GenerateAddress()
+		slot     = func(i int64) common.Hash { return common.BigToHash(big.NewInt(i)) }
+		value    = func(i int64) common.Hash { return common.BigToHash(big.NewInt(i + 1000)) }
+	)
+
+	// slots 0 to 299 are set at height A, then slots 0 to 99 are cleared,
+	// slots 100 to 199 are changed and slots 300 to 349 are added at height B
+	expStorageA := make(map[common.Hash]common.Hash)
+	vmdb := suite.StateDB()
+	for i := int64(0); i < 300; i++ {
+		vmdb.SetState(contract, slot(i), value(i))
+		expStorageA[slot(i)] = value(i)
+	}
+	suite.Require().NoError(vmdb.Commit())
+	suite.Commit()
+	heightA := suite.app.LastBlockHeight()
+
+	expStorageB := make(map[common.Hash]common.Hash)
+	vmdb = suite.StateDB()
+	for i := int64(0); i < 350; i++ {
+		switch {
+		case i < 100:
+			vmdb.SetState(contract, slot(i), common.Hash{})
+		case i < 200:
+			vmdb.SetState(contract, slot(i), value(-i))
+			expStorageB[slot(i)] = value(-i)
+		case i < 300:
+			expStorageB[slot(i)] = value(i)
+		default:
+			vmdb.SetState(contract, slot(i), value(i))
+			expStorageB[slot(i)] = value(i)
+		}
+	}
+	suite.Require().NoError(vmdb.Commit())
+	suite.Commit()
+	heightB := suite.app.LastBlockHeight()
+
+	// storageRange pages through the storage of the contract at the given height
+	storageRange := func(height int64, limit uint64) map[common.Hash]common.Hash {
+		var (
+			storage = make(map[common.Hash]common.Hash)
+			nextKey []byte
+			lastKey common.Hash
+		)
+
+		for {
+			res, err := suite.queryClient.StorageRange(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeRequest{
+				Address: contract.Hex(), Height: height,
+				Pagination: &query.PageRequest{Key: nextKey, Limit: limit},
+			})
+			suite.Require().NoError(err)
+			suite.Require().LessOrEqual(uint64(len(res.Storage)), limit)
+
+			for _, state := range res.Storage {
+				key := common.HexToHash(state.Key)
+				suite.Require().Less(lastKey.Big().Cmp(key.Big()), 1, "keys must be in ascending order")
+				lastKey = key
+				storage[key] = common.HexToHash(state.Value)
+			}
+
+			nextKey = res.Pagination.NextKey
+			if nextKey == nil {
+				return storage
+			}
+		}
+	}
+
+	storageA := storageRange(heightA, 64)
+	suite.Require().Equal(expStorageA, storageA)
+
+	storageB := storageRange(heightB, 64)
+	suite.Require().Equal(expStorageB, storageB)
+
+	// the diff of the pages matches the changes committed at height B
+	var cleared, changed, added int
+	for key, valueA := range storageA {
+		valueB, found := storageB[key]
+		switch {
+		case !found:
+			cleared++
+		case valueA != valueB:
+			changed++
+		}
+	}
+	for key := range storageB {
+		if _, found := storageA[key]; !found {
+			added++
+		}
+	}
+	suite.Require().Equal([]int{100, 100, 50}, []int{cleared, changed, added})
+
+	suite.Run("success - limit is capped", func() {
+		res, err := suite.queryClient.StorageRange(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeRequest{
+			Address: contract.Hex(), Height: heightA,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Storage, 300)
+		suite.Require().Nil(res.Pagination.NextKey)
+	})
+
+	suite.Run("fail - invalid address", func() {
+		_, err := suite.queryClient.StorageRange(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeRequest{
+			Address: invalidAddress, Height: heightA,
+		})
+		suite.Require().Error(err)
+	})
+
+	suite.Run("fail - non positive height", func() {
+		_, err := suite.queryClient.StorageRange(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeRequest{
+			Address: contract.Hex(),
+		})
+		suite.Require().ErrorContains(err, "height must be positive")
+	})
+
+	suite.Run("fail - future height", func() {
+		_, err := suite.queryClient.StorageRange(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeRequest{
+			Address: contract.Hex(), Height: heightB + 10,
+		})
+		suite.Require().ErrorContains(err, "not available")
+	})
+
+	suite.Run("fail - pruned height", func() {
+		cms, ok := suite.app.CommitMultiStore().(*rootmulti.Store)
+		suite.Require().True(ok)
+		suite.Require().NoError(cms.PruneStores(false, []int64{heightA}))
+
+		_, err := suite.queryClient.StorageRange(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeRequest{
+			Address: contract.Hex(), Height: heightA,
+		})
+		suite.Require().ErrorContains(err, "pruned")
+	})
+}
+
 func (suite *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -417,6 +837,26 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().Equal(expParams, res.Params)
 }
 
+func (suite *KeeperTestSuite) TestQueryOpcodeGasOverrides() {
+	overrides := []types.OpcodeGasOverride{{Opcode: "SLOAD", Gas: 800}}
+	height := suite.ctx.BlockHeight()
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.OpcodeGasOverrides = overrides
+	params.OpcodeGasOverridesActivationHeight = height + 1
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	res, err := suite.app.EvmKeeper.OpcodeGasOverrides(sdk.WrapSDKContext(suite.ctx), &types.QueryOpcodeGasOverridesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Overrides)
+	suite.Require().Equal(height+1, res.ActivationHeight)
+
+	res, err = suite.app.EvmKeeper.OpcodeGasOverrides(sdk.WrapSDKContext(suite.ctx.WithBlockHeight(height+1)), &types.QueryOpcodeGasOverridesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(overrides, res.Overrides)
+	suite.Require().Equal(height+1, res.ActivationHeight)
+}
+
 func (suite *KeeperTestSuite) TestQueryValidatorAccount() {
 	var (
 		req        *types.QueryValidatorAccountRequest
@@ -954,7 +1394,7 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 			},
 			expPass:       true,
 			traceResponse: "{\"gas\":34828,\"failed\":false,\"returnValue\":\"0000000000000000000000000000000000000000000000000000000000000001\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\",\"gas\":",
-			expFinalGas:   27140, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
+			expFinalGas:   28640, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
 		},
 		{
 			msg: "invalid chain id",
@@ -1017,6 +1457,134 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceTxOutputLimits() {
+	var (
+		maxStructLogs  int
+		maxResultBytes int
+		traceConfig    *types.TraceConfig
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		postCheck func(result ethlogger.ExecutionResult)
+	}{
+		{
+			msg:      "pass - no limits",
+			malleate: func() {},
+			expPass:  true,
+			postCheck: func(result ethlogger.ExecutionResult) {
+				suite.Require().Greater(len(result.StructLogs), 1000)
+			},
+		},
+		{
+			msg: "pass - within the limits",
+			malleate: func() {
+				maxStructLogs = 1_000_000
+				maxResultBytes = 100_000_000
+			},
+			expPass: true,
+		},
+		{
+			msg: "fail - struct logs limit exceeded",
+			malleate: func() {
+				maxStructLogs = 1000
+			},
+			expPass: false,
+		},
+		{
+			msg: "fail - result size limit exceeded",
+			malleate: func() {
+				maxResultBytes = 100_000
+			},
+			expPass: false,
+		},
+		{
+			msg: "fail - result size limit exceeded by a javascript tracer",
+			malleate: func() {
+				maxResultBytes = 10_000
+				traceConfig = &types.TraceConfig{
+					Tracer: "{data: [], fault: function(log) {}, step: function(log) { this.data.push(log.getPC()); }, result: function() { return this.data; }}",
+				}
+			},
+			expPass: false,
+		},
+		{
+			msg: "pass - memory enabled and stack and storage disabled",
+			malleate: func() {
+				traceConfig = &types.TraceConfig{
+					EnableMemory:   true,
+					DisableStack:   true,
+					DisableStorage: true,
+				}
+			},
+			expPass: true,
+			postCheck: func(result ethlogger.ExecutionResult) {
+				hasMemory := false
+				for _, log := range result.StructLogs {
+					suite.Require().Nil(log.Stack)
+					suite.Require().Nil(log.Storage)
+					hasMemory = hasMemory || (log.Memory != nil && len(*log.Memory) > 0)
+				}
+				suite.Require().True(hasMemory)
+			},
+		},
+	}
+
+	messageCallContract, err := testdata.LoadMessageCallContract()
+	suite.Require().NoError(err)
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			maxStructLogs, maxResultBytes, traceConfig = 0, 0, nil
+
+			contractAddr := suite.DeployTestMessageCall(suite.T())
+			suite.Commit()
+
+			// the loop of the contract produces a large number of struct logs
+			input, err := messageCallContract.ABI.Pack("benchmarkMessageCall", big.NewInt(100))
+			suite.Require().NoError(err)
+			txMsg := types.NewTx(&types.EvmTxArgs{
+				ChainID:  suite.app.EvmKeeper.ChainID(),
+				Nonce:    suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+				To:       &contractAddr,
+				Amount:   big.NewInt(0),
+				GasLimit: 25_000_000,
+				GasPrice: big.NewInt(1),
+				Input:    input,
+			})
+			txMsg.From = suite.address.Hex()
+			err = txMsg.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), suite.signer)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+			suite.app.EvmKeeper.WithTraceLimits(maxStructLogs, maxResultBytes)
+
+			res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+				Msg:         txMsg,
+				TraceConfig: traceConfig,
+			})
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Equal(codes.ResourceExhausted, status.Code(err))
+				suite.Require().Contains(err.Error(), "trace output too large")
+				return
+			}
+
+			suite.Require().NoError(err)
+			var result ethlogger.ExecutionResult
+			suite.Require().NoError(json.Unmarshal(res.Data, &result))
+			suite.Require().False(result.Failed)
+			if tc.postCheck != nil {
+				tc.postCheck(result)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceBlock() {
 	var (
 		txs         []*types.MsgEthereumTx
@@ -1201,6 +1769,250 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceBlockPagination() {
+	suite.SetupTest()
+
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+
+	// the transfers of the block leave increasing balances in storage, so that
+	// each tx is traced on top of the state changes of the previous ones
+	var txs []*types.MsgEthereumTx
+	for i := 0; i < 3; i++ {
+		txs = append(txs, suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdkmath.NewIntWithDecimal(1, 18).BigInt()))
+	}
+	suite.Commit()
+
+	// the traced txs are committed to the query context, which is discarded
+	// after each query as it is by the gRPC server
+	traceBlock := func(pageReq *query.PageRequest) (*types.QueryTraceBlockResponse, []json.RawMessage, error) {
+		ctx, _ := suite.ctx.CacheContext()
+		res, err := suite.app.EvmKeeper.TraceBlock(sdk.WrapSDKContext(ctx), &types.QueryTraceBlockRequest{
+			Txs:        txs,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		suite.Require().Equal(types.TraceContentTypeJSON, res.ContentType)
+		var results []json.RawMessage
+		suite.Require().NoError(json.Unmarshal(res.Data, &results))
+		return res, results, nil
+	}
+
+	res, all, err := traceBlock(nil)
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.Pagination)
+	suite.Require().Len(all, 3)
+	suite.Require().NotEqual(string(all[0]), string(all[1]))
+
+	testCases := []struct {
+		name       string
+		pageReq    *query.PageRequest
+		expResults []json.RawMessage
+		expNextKey []byte
+		expTotal   uint64
+		expErr     string
+	}{
+		{
+			"first page",
+			&query.PageRequest{Limit: 2, CountTotal: true},
+			all[:2],
+			sdk.Uint64ToBigEndian(2),
+			3,
+			"",
+		},
+		{
+			"page by offset",
+			&query.PageRequest{Offset: 1, Limit: 1},
+			all[1:2],
+			sdk.Uint64ToBigEndian(2),
+			0,
+			"",
+		},
+		{
+			"last page by key",
+			&query.PageRequest{Key: sdk.Uint64ToBigEndian(2), Limit: 2},
+			all[2:],
+			nil,
+			0,
+			"",
+		},
+		{
+			"default limit",
+			&query.PageRequest{Offset: 1},
+			all[1:],
+			nil,
+			0,
+			"",
+		},
+		{
+			"offset past the txs",
+			&query.PageRequest{Offset: 5, CountTotal: true},
+			[]json.RawMessage{},
+			nil,
+			3,
+			"",
+		},
+		{
+			"both offset and key",
+			&query.PageRequest{Key: sdk.Uint64ToBigEndian(1), Offset: 1},
+			nil,
+			nil,
+			0,
+			"either offset or key is expected, got both",
+		},
+		{
+			"invalid key",
+			&query.PageRequest{Key: []byte{1}},
+			nil,
+			nil,
+			0,
+			"invalid pagination key",
+		},
+		{
+			"reverse",
+			&query.PageRequest{Reverse: true},
+			nil,
+			nil,
+			0,
+			"reverse pagination is not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, results, err := traceBlock(tc.pageReq)
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(results, len(tc.expResults))
+			for i := range results {
+				suite.Require().Equal(string(tc.expResults[i]), string(results[i]))
+			}
+			suite.Require().Equal(tc.expNextKey, res.Pagination.NextKey)
+			suite.Require().Equal(tc.expTotal, res.Pagination.Total)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	var (
+		args        []byte
+		traceConfig *types.TraceConfig
+	)
+
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	suite.Require().NoError(err)
+	input, err := erc20Contract.ABI.Pack("transfer", recipient, big.NewInt(1000))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg       string
+		malleate  func(contractAddr common.Address)
+		expErr    codes.Code
+		postCheck func(data []byte)
+	}{
+		{
+			msg:      "pass - default struct logger",
+			malleate: func(common.Address) {},
+			postCheck: func(data []byte) {
+				var result ethlogger.ExecutionResult
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.Require().False(result.Failed)
+				suite.Require().NotEmpty(result.StructLogs)
+			},
+		},
+		{
+			msg: "pass - javascript tracer",
+			malleate: func(common.Address) {
+				traceConfig = &types.TraceConfig{
+					Tracer: "{data: [], fault: function(log) {}, step: function(log) { if(log.op.toString() == \"SSTORE\") this.data.push(log.op.toString()); }, result: function() { return this.data; }}",
+				}
+			},
+			postCheck: func(data []byte) {
+				suite.Require().Equal(`["SSTORE","SSTORE"]`, string(data))
+			},
+		},
+		{
+			msg: "fail - negative limit",
+			malleate: func(common.Address) {
+				traceConfig = &types.TraceConfig{Limit: -1}
+			},
+			expErr: codes.InvalidArgument,
+		},
+		{
+			msg: "fail - invalid args",
+			malleate: func(common.Address) {
+				args = []byte("invalid args")
+			},
+			expErr: codes.InvalidArgument,
+		},
+		{
+			msg: "fail - invalid tracer",
+			malleate: func(common.Address) {
+				traceConfig = &types.TraceConfig{Tracer: "invalid_tracer"}
+			},
+			expErr: codes.Internal,
+		},
+		{
+			msg: "fail - struct logs limit exceeded",
+			malleate: func(common.Address) {
+				suite.app.EvmKeeper.WithTraceLimits(10, 0)
+			},
+			expErr: codes.ResourceExhausted,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			traceConfig = nil
+
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+
+			args, err = json.Marshal(&types.TransactionArgs{
+				From: &suite.address,
+				To:   &contractAddr,
+				Data: (*hexutil.Bytes)(&input),
+			})
+			suite.Require().NoError(err)
+
+			tc.malleate(contractAddr)
+			defer suite.app.EvmKeeper.WithTraceLimits(0, 0)
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			res, err := suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceCallRequest{
+				Args:        args,
+				GasCap:      config.DefaultGasCap,
+				TraceConfig: traceConfig,
+			})
+
+			if tc.expErr != codes.OK {
+				suite.Require().Error(err)
+				suite.Require().Equal(tc.expErr, status.Code(err))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.TraceContentTypeJSON, res.ContentType)
+			tc.postCheck(res.Data)
+
+			// the traced call is not committed
+			suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+			suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, contractAddr, crypto.Keccak256Hash(common.LeftPadBytes(recipient.Bytes(), 32), common.LeftPadBytes(nil, 32))))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestNonceInQuery() {
 	address := utiltx.GenerateAddress()
 	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, address))
@@ -1312,6 +2124,84 @@ func (suite *KeeperTestSuite) TestQueryBaseFee() {
 	suite.enableLondonHF = true
 }
 
+func (suite *KeeperTestSuite) TestQueryGasPriceFloor() {
+	testCases := []struct {
+		name            string
+		malleate        func()
+		enableFeemarket bool
+		expFloor        sdkmath.LegacyDec
+		expFee          sdkmath.Int
+	}{
+		{
+			"base fee above the node min gas price",
+			func() {
+				suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(100))
+				suite.ctx = suite.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(types.DefaultEVMDenom, sdkmath.NewInt(10))))
+			},
+			true,
+			sdkmath.LegacyNewDec(100),
+			sdkmath.NewInt(2_100_000),
+		},
+		{
+			"node min gas price above the base fee",
+			func() {
+				suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(1))
+				suite.ctx = suite.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.DefaultEVMDenom, sdkmath.LegacyMustNewDecFromStr("2.5"))))
+			},
+			true,
+			sdkmath.LegacyMustNewDecFromStr("2.5"),
+			sdkmath.NewInt(52_500),
+		},
+		{
+			"node min gas price of another denom",
+			func() {
+				suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(1))
+				suite.ctx = suite.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdkmath.NewInt(10))))
+			},
+			true,
+			sdkmath.LegacyOneDec(),
+			sdkmath.NewInt(21_000),
+		},
+		{
+			"consensus min gas price above the base fee",
+			func() {
+				suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(1))
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.MinGasPrice = sdkmath.LegacyNewDec(3)
+				suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+			},
+			true,
+			sdkmath.LegacyNewDec(3),
+			sdkmath.NewInt(63_000),
+		},
+		{
+			"zero base fee when the fee market is disabled",
+			func() {
+				suite.ctx = suite.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(types.DefaultEVMDenom, sdkmath.NewInt(10))))
+			},
+			false,
+			sdkmath.LegacyNewDec(10),
+			sdkmath.NewInt(210_000),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = tc.enableFeemarket
+			suite.SetupTest()
+
+			tc.malleate()
+
+			res, err := suite.app.EvmKeeper.GasPriceFloor(suite.ctx, &types.QueryGasPriceFloorRequest{Gas: 21_000})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFloor, res.GasPriceFloor)
+			suite.Require().Equal(sdk.NewCoin(types.DefaultEVMDenom, tc.expFee), res.RecommendedFee)
+			suite.Require().Equal(suite.ctx.MinGasPrices(), res.NodeMinGasPrices)
+			suite.Require().NotNil(res.BaseFee)
+		})
+	}
+	suite.enableFeemarket = false
+}
+
 func (suite *KeeperTestSuite) TestEthCall() {
 	var req *types.EthCallRequest
 
@@ -1418,6 +2308,374 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+// baseFeeBranchCode is the runtime code of a contract that reverts if the block
+// base fee is zero and returns the gas price of the call otherwise.
+var baseFeeBranchCode = common.FromHex("4815600e573a60005260206000f35b60006000fd")
+
+// blockInfoCode is the runtime code of a contract that returns the block number
+// and timestamp.
+var blockInfoCode = common.FromHex("436000524260205260406000f3")
+
+func (suite *KeeperTestSuite) TestEthCallFeeDefaultAndBlockOverrides() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	var (
+		branchContract = utiltx.GenerateAddress()
+		infoContract   = utiltx.GenerateAddress()
+		unfunded       = utiltx.GenerateAddress()
+		lowFunded      = utiltx.GenerateAddress()
+		gas            = hexutil.Uint64(100_000)
+		baseFee        = suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx)
+	)
+	suite.Require().Positive(baseFee.Sign())
+
+	vmdb := suite.StateDB()
+	vmdb.SetCode(branchContract, baseFeeBranchCode)
+	vmdb.SetCode(infoContract, blockInfoCode)
+	vmdb.AddBalance(suite.address, sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	vmdb.AddBalance(lowFunded, new(big.Int).Mul(baseFee, big.NewInt(int64(gas))))
+	suite.Require().NoError(vmdb.Commit())
+
+	newRequest := func(args types.TransactionArgs, overrides *types.BlockOverrides, defaultBaseFee bool) *types.EthCallRequest {
+		argsBz, err := json.Marshal(&args)
+		suite.Require().NoError(err)
+
+		req := &types.EthCallRequest{Args: argsBz, GasCap: config.DefaultGasCap, DefaultBaseFee: defaultBaseFee}
+		if overrides != nil {
+			req.BlockOverrides, err = json.Marshal(overrides)
+			suite.Require().NoError(err)
+		}
+		return req
+	}
+
+	testCases := []struct {
+		name   string
+		req    *types.EthCallRequest
+		expRet []byte
+		expErr string
+	}{
+		{
+			"pass - omitted fees default to zero",
+			newRequest(types.TransactionArgs{From: &suite.address, To: &branchContract}, nil, false),
+			common.LeftPadBytes(nil, 32),
+			"",
+		},
+		{
+			"pass - omitted fees default to the base fee",
+			newRequest(types.TransactionArgs{From: &suite.address, To: &branchContract}, nil, true),
+			common.LeftPadBytes(baseFee.Bytes(), 32),
+			"",
+		},
+		{
+			"pass - base fee override",
+			newRequest(types.TransactionArgs{From: &suite.address, To: &branchContract}, &types.BlockOverrides{BaseFee: (*hexutil.Big)(big.NewInt(7))}, true),
+			common.LeftPadBytes([]byte{7}, 32),
+			"",
+		},
+		{
+			"pass - explicit gas price is kept",
+			newRequest(types.TransactionArgs{From: &suite.address, To: &branchContract, GasPrice: (*hexutil.Big)(big.NewInt(3))}, nil, true),
+			common.LeftPadBytes([]byte{3}, 32),
+			"",
+		},
+		{
+			"fail - zero base fee override takes the other branch",
+			newRequest(types.TransactionArgs{From: &suite.address, To: &branchContract}, &types.BlockOverrides{BaseFee: (*hexutil.Big)(big.NewInt(0))}, true),
+			nil,
+			vm.ErrExecutionReverted.Error(),
+		},
+		{
+			"fail - sender cannot pay for the base fee",
+			newRequest(types.TransactionArgs{From: &unfunded, To: &branchContract, Gas: &gas}, nil, true),
+			nil,
+			"insufficient funds",
+		},
+		{
+			"pass - default gas limit is capped to the sender balance",
+			newRequest(types.TransactionArgs{From: &lowFunded, To: &branchContract}, nil, true),
+			common.LeftPadBytes(baseFee.Bytes(), 32),
+			"",
+		},
+		{
+			"pass - unfunded sender with omitted fees defaulting to zero",
+			newRequest(types.TransactionArgs{From: &unfunded, To: &branchContract, Gas: &gas}, nil, false),
+			common.LeftPadBytes(nil, 32),
+			"",
+		},
+		{
+			"pass - block number and time overrides",
+			newRequest(types.TransactionArgs{To: &infoContract}, &types.BlockOverrides{
+				Number: (*hexutil.Big)(big.NewInt(1000)),
+				Time:   (*hexutil.Uint64)(&gas),
+			}, false),
+			append(common.LeftPadBytes(big.NewInt(1000).Bytes(), 32), common.LeftPadBytes(big.NewInt(100_000).Bytes(), 32)...),
+			"",
+		},
+		{
+			"fail - invalid block number override",
+			newRequest(types.TransactionArgs{To: &infoContract}, &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(0))}, false),
+			nil,
+			"block number override",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.EthCall(suite.ctx, tc.req)
+			switch {
+			case tc.expErr == "":
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.VmError)
+				suite.Require().Equal(tc.expRet, res.Ret)
+			case err != nil:
+				suite.Require().ErrorContains(err, tc.expErr)
+			default:
+				suite.Require().Equal(tc.expErr, res.VmError)
+			}
+		})
+	}
+}
+
+// slotReaderCode is the runtime code of a contract that returns its storage
+// slot zero.
+var slotReaderCode = common.FromHex("60005460005260206000f3")
+
+// counterCode is the runtime code of a contract that increments its storage
+// slot zero and returns the new value.
+var counterCode = common.FromHex("6000546001018060005560005260206000f3")
+
+// callerBalanceCode is the runtime code of a contract that returns the balance
+// of the caller.
+var callerBalanceCode = common.FromHex("333160005260206000f3")
+
+// proxyCode returns the runtime code of a contract that calls the given
+// contract and returns the first 32 bytes it returns.
+func proxyCode(target common.Address) []byte {
+	code := common.FromHex("6020600060006000600073")
+	code = append(code, target.Bytes()...)
+	return append(code, common.FromHex("5af15060206000f3")...)
+}
+
+func (suite *KeeperTestSuite) TestEthCallStateOverrides() {
+	var (
+		reader   = utiltx.GenerateAddress()
+		counter  = utiltx.GenerateAddress()
+		fresh    = utiltx.GenerateAddress()
+		balancer = utiltx.GenerateAddress()
+		unfunded = utiltx.GenerateAddress()
+		five     = common.BigToHash(big.NewInt(5))
+		one      = common.BigToHash(big.NewInt(1))
+		balance  = (*hexutil.Big)(big.NewInt(42))
+	)
+
+	vmdb := suite.StateDB()
+	vmdb.SetCode(reader, slotReaderCode)
+	vmdb.SetState(reader, common.Hash{}, five)
+	vmdb.SetCode(counter, counterCode)
+	vmdb.SetState(counter, common.Hash{}, five)
+	vmdb.SetCode(balancer, callerBalanceCode)
+	suite.Require().NoError(vmdb.Commit())
+
+	newRequest := func(args types.TransactionArgs, overrides types.StateOverride) *types.EthCallRequest {
+		argsBz, err := json.Marshal(&args)
+		suite.Require().NoError(err)
+		overridesBz, err := json.Marshal(overrides)
+		suite.Require().NoError(err)
+		return &types.EthCallRequest{Args: argsBz, GasCap: config.DefaultGasCap, StateOverrides: overridesBz}
+	}
+
+	codeOverride := hexutil.Bytes(counterCode)
+	testCases := []struct {
+		name   string
+		req    *types.EthCallRequest
+		expRet []byte
+		expErr string
+	}{
+		{
+			"pass - no overrides",
+			newRequest(types.TransactionArgs{From: &suite.address, To: &counter}, nil),
+			common.LeftPadBytes([]byte{6}, 32),
+			"",
+		},
+		{
+			"pass - code override",
+			newRequest(types.TransactionArgs{From: &suite.address, To: &fresh}, types.StateOverride{
+				fresh: {Code: &codeOverride},
+			}),
+			common.LeftPadBytes([]byte{1}, 32),
+			"",
+		},
+		{
+			"pass - state diff override",
+			newRequest(types.TransactionArgs{From: &suite.address, To: &counter}, types.StateOverride{
+				counter: {StateDiff: &map[common.Hash]common.Hash{{}: one}},
+			}),
+			common.LeftPadBytes([]byte{2}, 32),
+			"",
+		},
+		{
+			"pass - state override replaces the storage",
+			newRequest(types.TransactionArgs{From: &suite.address, To: &reader}, types.StateOverride{
+				reader: {State: &map[common.Hash]common.Hash{one: one}},
+			}),
+			common.LeftPadBytes(nil, 32),
+			"",
+		},
+		{
+			"pass - balance override",
+			newRequest(types.TransactionArgs{From: &unfunded, To: &balancer}, types.StateOverride{
+				unfunded: {Balance: &balance},
+			}),
+			common.LeftPadBytes([]byte{42}, 32),
+			"",
+		},
+		{
+			"fail - both state and state diff",
+			newRequest(types.TransactionArgs{From: &suite.address, To: &reader}, types.StateOverride{
+				reader: {State: &map[common.Hash]common.Hash{}, StateDiff: &map[common.Hash]common.Hash{}},
+			}),
+			nil,
+			"both 'state' and 'stateDiff'",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.EthCall(suite.ctx, tc.req)
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(tc.expRet, res.Ret)
+
+			// the overrides are not written to the store
+			suite.Require().Equal(five, suite.app.EvmKeeper.GetState(suite.ctx, reader, common.Hash{}))
+			suite.Require().Equal(five, suite.app.EvmKeeper.GetState(suite.ctx, counter, common.Hash{}))
+			suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, fresh))
+			suite.Require().Zero(suite.app.EvmKeeper.GetBalance(suite.ctx, unfunded).Sign())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEthCallMany() {
+	var (
+		counter = utiltx.GenerateAddress()
+		proxy   = utiltx.GenerateAddress()
+		number  = (*hexutil.Big)(big.NewInt(1000))
+	)
+
+	vmdb := suite.StateDB()
+	vmdb.SetCode(counter, counterCode)
+	vmdb.SetCode(proxy, proxyCode(counter))
+	suite.Require().NoError(vmdb.Commit())
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+
+	newBundle := func(overrides *types.BlockOverrides, args ...types.TransactionArgs) types.EthCallBundle {
+		argsBz, err := json.Marshal(args)
+		suite.Require().NoError(err)
+
+		bundle := types.EthCallBundle{Args: argsBz}
+		if overrides != nil {
+			bundle.BlockOverrides, err = json.Marshal(overrides)
+			suite.Require().NoError(err)
+		}
+		return bundle
+	}
+	call := func(to common.Address) types.TransactionArgs {
+		return types.TransactionArgs{From: &suite.address, To: &to}
+	}
+
+	testCases := []struct {
+		name       string
+		req        *types.EthCallManyRequest
+		expRets    [][]byte
+		expAccList types.AccessList
+		expErr     string
+	}{
+		{
+			"pass - calls are applied on the state of the previous ones",
+			&types.EthCallManyRequest{
+				Bundles: []types.EthCallBundle{
+					newBundle(nil, call(counter), call(proxy)),
+					newBundle(&types.BlockOverrides{Number: number}, call(counter)),
+				},
+				GasCap: config.DefaultGasCap,
+			},
+			[][]byte{{1}, {2}, {3}},
+			nil,
+			"",
+		},
+		{
+			"pass - access list generation",
+			&types.EthCallManyRequest{
+				Bundles:          []types.EthCallBundle{newBundle(nil, call(proxy))},
+				GasCap:           config.DefaultGasCap,
+				CreateAccessList: true,
+			},
+			[][]byte{{1}},
+			types.AccessList{{Address: counter.Hex(), StorageKeys: []string{common.Hash{}.Hex()}}},
+			"",
+		},
+		{
+			"fail - invalid args",
+			&types.EthCallManyRequest{
+				Bundles: []types.EthCallBundle{{Args: []byte("invalid args")}},
+				GasCap:  config.DefaultGasCap,
+			},
+			nil,
+			nil,
+			"invalid args of bundle 0",
+		},
+		{
+			"fail - invalid block overrides",
+			&types.EthCallManyRequest{
+				Bundles: []types.EthCallBundle{newBundle(&types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(0))}, call(counter))},
+				GasCap:  config.DefaultGasCap,
+			},
+			nil,
+			nil,
+			"block number override",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.EthCallMany(suite.ctx, tc.req)
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var rets [][]byte
+			for _, bundle := range res.Bundles {
+				for _, result := range bundle.Results {
+					suite.Require().Empty(result.VmError)
+					suite.Require().Positive(result.GasUsed)
+					rets = append(rets, result.Ret)
+				}
+			}
+			suite.Require().Len(rets, len(tc.expRets))
+			for i, ret := range tc.expRets {
+				suite.Require().Equal(common.LeftPadBytes(ret, 32), rets[i])
+			}
+
+			if tc.expAccList != nil {
+				suite.Require().Equal(tc.expAccList, types.AccessList(res.Bundles[0].Results[0].AccessList))
+			}
+
+			// the state changes of the calls are discarded
+			suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, counter, common.Hash{}))
+			suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -0,0 +1,67 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+var _ types.EvmHooks = MultiEvmHooks{}
+
+// MultiEvmHooks combines multiple evm hooks, all hook functions are run in array sequence
+type MultiEvmHooks []types.EvmHooks
+
+// NewMultiEvmHooks combine multiple evm hooks
+func NewMultiEvmHooks(hooks ...types.EvmHooks) MultiEvmHooks {
+	return hooks
+}
+
+// PostTxProcessing delegate the call to underlying hooks
+func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	for i := range mh {
+		if err := mh[i].PostTxProcessing(ctx, msg, receipt); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
+// PostTxProcessing runs the hooks on a cache context with a gas meter limited
+// to the given gas, and returns the gas they consumed. The changes of the hooks
+// are only committed if all of them succeed. A failing hook, including one
+// running out of gas, doesn't affect the outcome of the transaction unless its
+// error wraps ErrPostTxProcessing. A hook running out of gas consumes the whole
+// given gas.
+func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt, gasLimit uint64) (gasUsed uint64, err error) {
+	if k.hooks == nil {
+		return 0, nil
+	}
+
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	hooksCtx, commit := ctx.CacheContext()
+	hooksCtx = hooksCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			gasUsed = gasLimit
+			err = errorsmod.Wrapf(errortypes.ErrOutOfGas, "out of gas in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	if err := k.hooks.PostTxProcessing(hooksCtx, msg, receipt); err != nil {
+		return gasMeter.GasConsumedToLimit(), err
+	}
+
+	commit()
+	return gasMeter.GasConsumedToLimit(), nil
+}
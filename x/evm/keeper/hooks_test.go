@@ -0,0 +1,334 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v19/rpc/backend"
+	"github.com/evmos/evmos/v19/server/config"
+	"github.com/evmos/evmos/v19/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/keeper"
+	"github.com/evmos/evmos/v19/x/evm/keeper/testdata"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// hookTransferGasLimit is the gas limit of the transfers run with the test
+// hooks, which leaves gas to the hooks once the transfer is executed.
+const hookTransferGasLimit = 200_000
+
+// mintHook mints coins to the EVM module account on every call and records the
+// receipts it is called with and the gas consumed by the minting. If gas is not
+// zero, it consumes that amount of gas after minting, then it returns err.
+type mintHook struct {
+	bankKeeper types.BankKeeper
+	coins      sdk.Coins
+	gas        uint64
+	err        error
+	receipts   []*ethtypes.Receipt
+	mintGas    uint64
+}
+
+func (h *mintHook) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	h.receipts = append(h.receipts, receipt)
+	if err := h.bankKeeper.MintCoins(ctx, types.ModuleName, h.coins); err != nil {
+		return err
+	}
+	h.mintGas = ctx.GasMeter().GasConsumed()
+
+	ctx.GasMeter().ConsumeGas(h.gas, "test hook")
+	return h.err
+}
+
+// gasHook consumes a fixed amount of gas and reverts the tx when it runs out
+// of gas, as the hooks converting tokens received by a module do.
+type gasHook struct {
+	gas uint64
+}
+
+func (h gasHook) PostTxProcessing(ctx sdk.Context, _ core.Message, _ *ethtypes.Receipt) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errorsmod.Wrapf(types.ErrPostTxProcessing, "out of gas in test hook: %v", r)
+		}
+	}()
+
+	ctx.GasMeter().ConsumeGas(h.gas, "test hook")
+	return nil
+}
+
+// logHook calls a contract emitting a log from the given account on every
+// call, as the hooks calling contracts on the EVM do, and records the logs of
+// each call.
+type logHook struct {
+	evmKeeper *keeper.Keeper
+	from      common.Address
+	emitter   common.Address
+	logs      []*types.Log
+}
+
+func (h *logHook) PostTxProcessing(ctx sdk.Context, _ core.Message, _ *ethtypes.Receipt) error {
+	res, err := h.evmKeeper.CallEVMWithData(ctx, h.from, &h.emitter, nil, true)
+	if err != nil {
+		return err
+	}
+	h.logs = append(h.logs, res.Logs...)
+	return nil
+}
+
+// hookTransferData returns the input of a transfer of the given amount of the
+// test ERC20 token to the given address.
+func (suite *KeeperTestSuite) hookTransferData(to common.Address, amount *big.Int) []byte {
+	erc20Contract, err := testdata.LoadERC20Contract()
+	suite.Require().NoError(err)
+	transferData, err := erc20Contract.ABI.Pack("transfer", to, amount)
+	suite.Require().NoError(err)
+	return transferData
+}
+
+// hookTransferTx returns a signed transfer of the given amount of the test
+// ERC20 token to the given address, with the given gas limit.
+func (suite *KeeperTestSuite) hookTransferTx(contractAddr, to common.Address, amount *big.Int, gasLimit uint64) *types.MsgEthereumTx {
+	transferData := suite.hookTransferData(to, amount)
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	tx := types.NewTx(&types.EvmTxArgs{
+		ChainID:  chainID,
+		Nonce:    suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		To:       &contractAddr,
+		GasLimit: gasLimit,
+		Input:    transferData,
+	})
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+	return tx
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessing() {
+	testCases := []struct {
+		name        string
+		gas         uint64
+		err         error
+		active      bool
+		expMinted   bool
+		expReceipt  bool
+		expReverted bool
+	}{
+		{
+			"hook succeeds",
+			0,
+			nil,
+			true,
+			true,
+			true,
+			false,
+		},
+		{
+			// the hook consumes more than the gas left by the transfer
+			"gas-starved hook",
+			10_000_000,
+			nil,
+			true,
+			false,
+			true,
+			false,
+		},
+		{
+			"failing hook",
+			0,
+			errors.New("hook failed"),
+			true,
+			false,
+			true,
+			false,
+		},
+		{
+			"hook reverting the transfer",
+			0,
+			errorsmod.Wrap(types.ErrPostTxProcessing, "hook failed"),
+			true,
+			false,
+			true,
+			true,
+		},
+		{
+			"hooks not active yet",
+			0,
+			nil,
+			false,
+			false,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			denom := suite.EvmDenom()
+			hook := &mintHook{
+				bankKeeper: suite.app.BankKeeper,
+				coins:      sdk.NewCoins(sdk.NewInt64Coin(denom, 100)),
+				gas:        tc.gas,
+				err:        tc.err,
+			}
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+			suite.Commit()
+			suite.app.EvmKeeper.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(hook))
+
+			if !tc.active {
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				params.PostTxHooksActivationHeight = suite.ctx.BlockHeight() + 1
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+			}
+
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom)
+			to := utiltx.GenerateAddress()
+			tx := suite.hookTransferTx(contractAddr, to, big.NewInt(10), hookTransferGasLimit)
+			rsp, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+			suite.Require().NoError(err)
+
+			// the transfer and its logs are committed unless a hook reverts it
+			erc20Contract, err := testdata.LoadERC20Contract()
+			suite.Require().NoError(err)
+			balance, err := suite.app.EvmKeeper.CallEVM(suite.ctx, erc20Contract.ABI, suite.address, contractAddr, false, "balanceOf", to)
+			suite.Require().NoError(err)
+
+			logs := suite.app.EvmKeeper.GetBlockLogsTransient(suite.ctx)
+			if tc.expReverted {
+				suite.Require().Contains(rsp.VmError, types.ErrPostTxProcessing.Error())
+				suite.Require().Empty(rsp.Logs)
+				suite.Require().Zero(new(big.Int).SetBytes(balance.Ret).Sign())
+				suite.Require().Empty(logs)
+			} else {
+				suite.Require().Empty(rsp.VmError)
+				suite.Require().Equal(big.NewInt(10), new(big.Int).SetBytes(balance.Ret))
+				suite.Require().Len(logs, 1)
+				suite.Require().Equal(tx.Hash, logs[0].TxHash)
+				suite.Require().Equal(contractAddr.Hex(), logs[0].Address)
+			}
+
+			if !tc.expReceipt {
+				suite.Require().Empty(hook.receipts)
+				suite.Require().Equal(supply, suite.app.BankKeeper.GetSupply(suite.ctx, denom))
+				return
+			}
+
+			// the hook is called with the receipt of the transfer
+			receipt := hook.receipts[len(hook.receipts)-1]
+			suite.Require().Equal(ethtypes.ReceiptStatusSuccessful, receipt.Status)
+			suite.Require().Equal(tx.AsTransaction().Hash(), receipt.TxHash)
+			suite.Require().Len(receipt.Logs, 1)
+
+			// the gas consumed by the hooks is charged to the transfer, up to its
+			// gas limit
+			expGasUsed := receipt.GasUsed + hook.mintGas
+			if expGasUsed+tc.gas > hookTransferGasLimit {
+				expGasUsed = hookTransferGasLimit
+			}
+			suite.Require().Equal(expGasUsed, rsp.GasUsed)
+			suite.Require().Equal(expGasUsed, suite.app.EvmKeeper.GetTransientGasUsed(suite.ctx))
+
+			// the changes of a failing hook are skipped
+			expSupply := supply
+			if tc.expMinted {
+				expSupply = supply.Add(hook.coins[0])
+			}
+			suite.Require().Equal(expSupply, suite.app.BankKeeper.GetSupply(suite.ctx, denom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessingGasStarvedHookLogs() {
+	suite.SetupTest()
+
+	hook := &mintHook{
+		bankKeeper: suite.app.BankKeeper,
+		coins:      sdk.NewCoins(sdk.NewInt64Coin(suite.EvmDenom(), 100)),
+		gas:        10_000_000,
+	}
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.Commit()
+	suite.app.EvmKeeper.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(hook))
+
+	to := utiltx.GenerateAddress()
+	res, err := testutil.DeliverEthTx(suite.app, nil, suite.hookTransferTx(contractAddr, to, big.NewInt(10), hookTransferGasLimit))
+	suite.Require().NoError(err)
+	suite.Require().Len(hook.receipts, 1)
+
+	// the logs of the transfer are served by eth_getLogs despite the hook
+	// running out of gas
+	logs, err := backend.TxLogsFromEvents(res.Events, 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(logs, 1)
+	suite.Require().Equal(contractAddr, logs[0].Address)
+	suite.Require().Equal(common.BytesToHash(to.Bytes()), logs[0].Topics[2])
+	suite.Require().Equal(uint64(hookTransferGasLimit), uint64(res.GasUsed))
+}
+
+func (suite *KeeperTestSuite) TestEstimateGasWithHooks() {
+	suite.SetupTest()
+
+	hook := gasHook{gas: 50_000}
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.Commit()
+	suite.app.EvmKeeper.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(hook))
+
+	to := utiltx.GenerateAddress()
+	input := hexutil.Bytes(suite.hookTransferData(to, big.NewInt(10)))
+	args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contractAddr, Input: &input})
+	suite.Require().NoError(err)
+	req := &types.EthCallRequest{
+		Args:            args,
+		GasCap:          config.DefaultGasCap,
+		ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+	}
+
+	// the estimation accounts for the gas consumed by the hook
+	estimate, err := suite.app.EvmKeeper.EstimateGas(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Greater(estimate.Gas, hook.gas)
+
+	// a call with one gas less than the estimation leaves the hook out of gas,
+	// and fails as the tx would
+	lowGas := hexutil.Uint64(estimate.Gas - 1)
+	lowArgs, err := json.Marshal(&types.TransactionArgs{
+		From:  &suite.address,
+		To:    &contractAddr,
+		Input: &input,
+		Gas:   &lowGas,
+	})
+	suite.Require().NoError(err)
+	req.Args = lowArgs
+	call, err := suite.app.EvmKeeper.EthCall(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Contains(call.VmError, types.ErrPostTxProcessing.Error())
+
+	// the tx sent with one gas less reverts the transfer
+	tx := suite.hookTransferTx(contractAddr, to, big.NewInt(10), estimate.Gas-1)
+	rsp, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+	suite.Require().NoError(err)
+	suite.Require().Contains(rsp.VmError, types.ErrPostTxProcessing.Error())
+
+	// the tx sent with the estimated gas succeeds
+	tx = suite.hookTransferTx(contractAddr, to, big.NewInt(10), estimate.Gas)
+	rsp, err = suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.VmError)
+	suite.Require().Equal(estimate.Gas, rsp.GasUsed)
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	suite.Require().NoError(err)
+	balance, err := suite.app.EvmKeeper.CallEVM(suite.ctx, erc20Contract.ABI, suite.address, contractAddr, false, "balanceOf", to)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(10), new(big.Int).SetBytes(balance.Ret))
+}
@@ -0,0 +1,32 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// RegisterInvariants registers the evm module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "block-bloom", BlockBloomInvariant(k))
+}
+
+// BlockBloomInvariant checks that the bloom filter accumulated during the
+// current block matches the bloom recomputed from the logs of its txs.
+func BlockBloomInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		_, err := k.CheckBlockBloom(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+		broken := err != nil
+
+		msg := fmt.Sprintf("\tblock bloom of height %d is consistent with the block logs\n", ctx.BlockHeight())
+		if broken {
+			msg = fmt.Sprintf("\t%s\n", err)
+		}
+		return sdk.FormatInvariant(types.ModuleName, "block-bloom", msg), broken
+	}
+}
@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/keeper"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestBlockBloomInvariant() {
+	suite.SetupTest()
+
+	invariant := keeper.BlockBloomInvariant(suite.app.EvmKeeper)
+	_, broken := invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// the logs of a tx not accumulated on the block bloom
+	log := &types.Log{
+		Address: utiltx.GenerateAddress().Hex(),
+		Topics:  []string{common.BytesToHash([]byte{1}).Hex()},
+	}
+	suite.app.EvmKeeper.SetTxLogsTransient(suite.ctx, 0, []*types.Log{log})
+
+	msg, broken := invariant(suite.ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "block bloom mismatch")
+
+	bloom := ethtypes.BytesToBloom(ethtypes.LogsBloom(types.LogsToEthereum([]*types.Log{log})))
+	suite.app.EvmKeeper.SetBlockBloomTransient(suite.ctx, new(big.Int).SetBytes(bloom.Bytes()))
+	_, broken = invariant(suite.ctx)
+	suite.Require().False(broken)
+}
@@ -4,6 +4,7 @@ package keeper
 
 import (
 	"math/big"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -47,6 +48,8 @@ type Keeper struct {
 	bankKeeper types.BankKeeper
 	// access historical headers for EVM state transition execution
 	stakingKeeper types.StakingKeeper
+	// move the authz grants of migrated accounts
+	authzKeeper types.AuthzKeeper
 	// fetch EIP1559 base fee and parameters
 	feeMarketKeeper types.FeeMarketKeeper
 	// erc20Keeper interface needed to instantiate erc20 precompiles
@@ -58,6 +61,10 @@ type Keeper struct {
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
+	// limits of the output of the TraceTx and TraceBlock queries, zero means unlimited
+	traceMaxStructLogs  int
+	traceMaxResultBytes int
+
 	// Legacy subspace
 	ss paramstypes.Subspace
 
@@ -65,6 +72,15 @@ type Keeper struct {
 	// Some these precompiled contracts might not be active depending on the EVM
 	// parameters.
 	precompiles map[common.Address]vm.PrecompiledContract
+
+	// versionedStore gives access to the committed state at previous heights.
+	versionedStore types.VersionedMultiStore
+
+	// module accounts that cannot receive value transfers
+	blockedModuleAccounts map[common.Address]struct{}
+
+	// EVM Hooks for tx post-processing
+	hooks types.EvmHooks
 }
 
 // NewKeeper generates new evm module keeper
@@ -75,6 +91,7 @@ func NewKeeper(
 	ak types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	sk types.StakingKeeper,
+	authzKeeper types.AuthzKeeper,
 	fmk types.FeeMarketKeeper,
 	erc20Keeper types.Erc20Keeper,
 	tracer string,
@@ -97,6 +114,7 @@ func NewKeeper(
 		accountKeeper:   ak,
 		bankKeeper:      bankKeeper,
 		stakingKeeper:   sk,
+		authzKeeper:     authzKeeper,
 		feeMarketKeeper: fmk,
 		storeKey:        storeKey,
 		transientKey:    transientKey,
@@ -106,6 +124,50 @@ func NewKeeper(
 	}
 }
 
+// WithVersionedMultiStore sets the multistore used to load the committed state
+// at previous heights.
+func (k *Keeper) WithVersionedMultiStore(ms types.VersionedMultiStore) *Keeper {
+	k.versionedStore = ms
+	return k
+}
+
+// SetHooks sets the hooks run after the processing of the EVM transactions.
+func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set evm hooks twice")
+	}
+
+	k.hooks = eh
+	return k
+}
+
+// CleanHooks resets the hooks set on the keeper. It is only meant to be used
+// in tests.
+func (k *Keeper) CleanHooks() *Keeper {
+	k.hooks = nil
+	return k
+}
+
+// WithTraceLimits sets the maximum number of struct logs and the maximum size
+// in bytes of the result of the TraceTx and TraceBlock queries. A trace that
+// exceeds them fails instead of being returned. Zero means unlimited.
+func (k *Keeper) WithTraceLimits(maxStructLogs, maxResultBytes int) *Keeper {
+	k.traceMaxStructLogs = maxStructLogs
+	k.traceMaxResultBytes = maxResultBytes
+	return k
+}
+
+// WithBlockedModuleAccounts sets the module accounts that cannot receive value
+// transfers, as their funds are only expected to change through their own
+// module logic.
+func (k *Keeper) WithBlockedModuleAccounts(addrs []common.Address) *Keeper {
+	k.blockedModuleAccounts = make(map[common.Address]struct{}, len(addrs))
+	for _, addr := range addrs {
+		k.blockedModuleAccounts[addr] = struct{}{}
+	}
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
@@ -213,6 +275,90 @@ func (k Keeper) SetLogSizeTransient(ctx sdk.Context, logSize uint64) {
 	store.Set(types.KeyPrefixTransientLogSize, sdk.Uint64ToBigEndian(logSize))
 }
 
+// SetTxLogsTransient sets the logs emitted by the transaction with the given
+// index on the current block. Setting the logs of the same transaction index
+// again overrides the previous value, so each transaction is only accounted
+// once.
+func (k Keeper) SetTxLogsTransient(ctx sdk.Context, txIndex uint64, logs []*types.Log) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxLogs)
+	txLogs := types.TransactionLogs{Logs: logs}
+	store.Set(sdk.Uint64ToBigEndian(txIndex), k.cdc.MustMarshal(&txLogs))
+}
+
+// GetBlockLogsTransient returns the logs emitted on the current block, ordered by
+// transaction index and then by emission order. Logs with an index that was
+// already collected are skipped.
+func (k Keeper) GetBlockLogsTransient(ctx sdk.Context) []*types.Log {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxLogs)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var logs []*types.Log
+	seen := make(map[uint64]struct{})
+	for ; iterator.Valid(); iterator.Next() {
+		var txLogs types.TransactionLogs
+		k.cdc.MustUnmarshal(iterator.Value(), &txLogs)
+
+		sort.SliceStable(txLogs.Logs, func(i, j int) bool {
+			return txLogs.Logs[i].Index < txLogs.Logs[j].Index
+		})
+
+		for _, log := range txLogs.Logs {
+			if _, found := seen[log.Index]; found {
+				continue
+			}
+			seen[log.Index] = struct{}{}
+			logs = append(logs, log)
+		}
+	}
+
+	return logs
+}
+
+// ComputeBlockBloom computes the bloom filter of the current block from the
+// ordered list of logs emitted on it.
+func (k Keeper) ComputeBlockBloom(ctx sdk.Context) ethtypes.Bloom {
+	logs := types.LogsToEthereum(k.GetBlockLogsTransient(ctx))
+	return ethtypes.BytesToBloom(ethtypes.LogsBloom(logs))
+}
+
+// CheckBlockBloom recomputes the bloom filter of the current block from its
+// logs and returns an error if it doesn't match the block bloom accumulated
+// on the transient store. The recomputed bloom is always returned.
+func (k Keeper) CheckBlockBloom(ctx sdk.Context) (ethtypes.Bloom, error) {
+	bloom := k.ComputeBlockBloom(ctx)
+	stored := ethtypes.BytesToBloom(k.GetBlockBloomTransient(ctx).Bytes())
+	if stored != bloom {
+		return bloom, errorsmod.Wrapf(
+			types.ErrInvalidState,
+			"block bloom mismatch at height %d: stored %x, computed %x", ctx.BlockHeight(), stored.Bytes(), bloom.Bytes(),
+		)
+	}
+
+	return bloom, nil
+}
+
+// SetTxFeePayerTransient records the account paying the fees of the EVM tx
+// with the given hash on the current block, when it is not the sender of the
+// tx. The leftover gas of the tx is refunded to it.
+func (k Keeper) SetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash, feePayer common.Address) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	store.Set(txHash.Bytes(), feePayer.Bytes())
+}
+
+// GetTxFeePayerTransient returns the account paying the fees of the EVM tx with
+// the given hash on the current block, and false if the fees are paid by the
+// sender of the tx.
+func (k Keeper) GetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash) (common.Address, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
 // ----------------------------------------------------------------------------
 // Storage
 // ----------------------------------------------------------------------------
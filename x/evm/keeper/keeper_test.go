@@ -41,6 +41,18 @@ func (suite *KeeperTestSuite) TestWithChainID() {
 			9000,
 			false,
 		},
+		{
+			"success - numeric chain ID",
+			"5151",
+			5151,
+			false,
+		},
+		{
+			"fail - numeric chain ID with epoch",
+			"5151-1",
+			0,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+var (
+	// logEmitterCode emits as many LOG0 as the number passed as calldata
+	logEmitterCode = common.FromHex("6000355b801560155760006000a0600190036003565b00")
+	// logBombCode emits LOG0 in an endless loop
+	logBombCode = common.FromHex("5b60006000a0600056")
+)
+
+// callerCode returns the code calling the given contract with all the gas
+// left, ignoring the result of the call.
+func callerCode(callee common.Address) []byte {
+	// PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH20 callee GAS CALL POP STOP
+	code := append(common.FromHex("60006000600060006000"), 0x73)
+	code = append(code, callee.Bytes()...)
+	return append(code, common.FromHex("5af15000")...)
+}
+
+func (suite *KeeperTestSuite) TestMaxLogsPerTx() {
+	emitter := utiltx.GenerateAddress()
+	bomb := utiltx.GenerateAddress()
+	caller := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name    string
+		maxLogs uint64
+		to      common.Address
+		logs    int64
+		expErr  error
+		expLogs int
+	}{
+		{"unlimited logs", 0, emitter, 100, nil, 100},
+		{"logs up to the limit", 10, emitter, 10, nil, 10},
+		{"logs above the limit", 10, emitter, 11, vm.ErrMaxLogsExceeded, 0},
+		{"log bomb without limit", 0, bomb, 0, vm.ErrOutOfGas, 0},
+		{"log bomb", 100, bomb, 0, vm.ErrMaxLogsExceeded, 0},
+		{"failed log bomb call without limit", 0, caller, 0, nil, 0},
+		{"failed log bomb call", 100, caller, 0, vm.ErrMaxLogsExceeded, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			vmdb := suite.StateDB()
+			vmdb.SetCode(emitter, logEmitterCode)
+			vmdb.SetCode(bomb, logBombCode)
+			vmdb.SetCode(caller, callerCode(bomb))
+			suite.Require().NoError(vmdb.Commit())
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.MaxLogsPerTx = tc.maxLogs
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			res := suite.applyValueMessage(tc.to, 0, common.BigToHash(big.NewInt(tc.logs)).Bytes())
+			suite.Require().Len(res.Logs, tc.expLogs)
+
+			if tc.expErr == nil {
+				suite.Require().False(res.Failed(), res.VmError)
+				return
+			}
+
+			// the failed tx consumes all its gas
+			suite.Require().Equal(tc.expErr.Error(), res.VmError)
+			suite.Require().Equal(uint64(100_000), res.GasUsed)
+		})
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v19/x/evm/types"
+	vestingtypes "github.com/evmos/evmos/v19/x/vesting/types"
+)
+
+// GetMigratedAccount returns the destination of the migration of the given
+// account, if it has been migrated.
+func (k Keeper) GetMigratedAccount(ctx sdk.Context, addr sdk.AccAddress) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMigratedAccount)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// setMigratedAccount marks the given account as migrated to the given
+// destination.
+func (k Keeper) setMigratedAccount(ctx sdk.Context, from, to sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMigratedAccount)
+	store.Set(from.Bytes(), to.Bytes())
+}
+
+// verifyMigrationSignatures checks that the migration of the given account to
+// the given destination has been signed by the secp256k1 key of the migrated
+// account, and by the Ethereum key of the destination account.
+func (k Keeper) verifyMigrationSignatures(ctx sdk.Context, msg *types.MsgMigrateAccount, from, to sdk.AccAddress) error {
+	sigVerifyCost := k.accountKeeper.GetParams(ctx).SigVerifyCostSecp256k1
+
+	ctx.GasMeter().ConsumeGas(sigVerifyCost, "migrate account: verify secp256k1 signature")
+	pubKey := &secp256k1.PubKey{Key: msg.FromPubKey}
+	if !pubKey.VerifySignature(types.MigrateAccountSignBytes(ctx.ChainID(), from, to), msg.FromSignature) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid signature of the migrated account %s", from)
+	}
+
+	ctx.GasMeter().ConsumeGas(sigVerifyCost, "migrate account: verify eth_secp256k1 signature")
+	sig := bytes.Clone(msg.ToSignature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27 // Transform yellow paper V from 27/28 to 0/1
+	}
+
+	signerPubKey, err := crypto.SigToPub(types.MigrateAccountEthSignHash(ctx.ChainID(), from, to), sig)
+	if err != nil || !bytes.Equal(crypto.PubkeyToAddress(*signerPubKey).Bytes(), to.Bytes()) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid signature of the destination account %s", to)
+	}
+
+	return nil
+}
+
+// validateMigration checks that the given account can be migrated to the given
+// destination. Module accounts and contracts can't be migrated nor be the
+// destination of a migration, and an account can only be migrated once. The
+// destination can't be a vesting account nor hold any staking position, so
+// that the ones of the migrated account are moved as is.
+func (k Keeper) validateMigration(ctx sdk.Context, from, to sdk.AccAddress) error {
+	if _, migrated := k.GetMigratedAccount(ctx, from); migrated {
+		return errorsmod.Wrapf(types.ErrInvalidMigration, "account %s has already been migrated", from)
+	}
+
+	if _, migrated := k.GetMigratedAccount(ctx, to); migrated {
+		return errorsmod.Wrapf(types.ErrInvalidMigration, "destination account %s has been migrated", to)
+	}
+
+	fromAcc := k.accountKeeper.GetAccount(ctx, from)
+	if fromAcc == nil {
+		return errorsmod.Wrapf(types.ErrInvalidMigration, "account %s does not exist", from)
+	}
+
+	for _, addr := range []sdk.AccAddress{from, to} {
+		if acc := k.accountKeeper.GetAccount(ctx, addr); acc != nil {
+			if _, isModuleAccount := acc.(authtypes.ModuleAccountI); isModuleAccount {
+				return errorsmod.Wrapf(types.ErrInvalidMigration, "account %s is a module account", addr)
+			}
+		}
+
+		if k.bankKeeper.BlockedAddr(addr) {
+			return errorsmod.Wrapf(types.ErrInvalidMigration, "account %s is not allowed to receive funds", addr)
+		}
+
+		if k.IsContract(ctx, common.BytesToAddress(addr)) {
+			return errorsmod.Wrapf(types.ErrInvalidMigration, "account %s is a contract", addr)
+		}
+	}
+
+	if vestingAcc, isVesting := fromAcc.(vestexported.VestingAccount); isVesting {
+		if _, ok := vestingAcc.(*vestingtypes.ClawbackVestingAccount); !ok {
+			return errorsmod.Wrapf(types.ErrInvalidMigration, "unsupported vesting account type %T", vestingAcc)
+		}
+	}
+
+	if _, isVesting := k.accountKeeper.GetAccount(ctx, to).(vestexported.VestingAccount); isVesting {
+		return errorsmod.Wrapf(types.ErrInvalidMigration, "destination account %s is a vesting account", to)
+	}
+
+	if len(k.stakingKeeper.GetAllDelegatorDelegations(ctx, to)) > 0 ||
+		len(k.stakingKeeper.GetUnbondingDelegations(ctx, to, 1)) > 0 ||
+		len(k.stakingKeeper.GetRedelegations(ctx, to, 1)) > 0 {
+		return errorsmod.Wrapf(types.ErrInvalidMigration, "destination account %s has staking positions", to)
+	}
+
+	return nil
+}
+
+// migrateAccount moves the delegations, unbonding delegations, redelegations,
+// vesting schedule, balances and authz grants of the given account to the
+// given destination, and marks the account as migrated.
+//
+// CONTRACT: the migration must have been validated with validateMigration.
+func (k Keeper) migrateAccount(ctx sdk.Context, from, to sdk.AccAddress) error {
+	// NOTE: the delegations are moved first, as their rewards are withdrawn to
+	// the migrated account before its balances are moved.
+	if err := k.migrateDelegations(ctx, from, to); err != nil {
+		return errorsmod.Wrap(err, "failed to migrate delegations")
+	}
+
+	k.migrateUnbondingDelegations(ctx, from, to)
+	k.migrateRedelegations(ctx, from, to)
+
+	if err := k.migrateBalances(ctx, from, to); err != nil {
+		return errorsmod.Wrap(err, "failed to migrate balances")
+	}
+
+	if err := k.migrateGrants(ctx, from, to); err != nil {
+		return errorsmod.Wrap(err, "failed to migrate authz grants")
+	}
+
+	k.setMigratedAccount(ctx, from, to)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMigrateAccount,
+			sdk.NewAttribute(types.AttributeKeyMigratedFrom, from.String()),
+			sdk.NewAttribute(types.AttributeKeyMigratedTo, to.String()),
+		),
+	)
+
+	return nil
+}
+
+// migrateDelegations moves the delegations of the given account to the same
+// validators, with the same shares. The staking hooks are called as for a
+// regular delegation, so that the rewards of the migrated delegations are
+// withdrawn and the rewards of the new ones are tracked.
+func (k Keeper) migrateDelegations(ctx sdk.Context, from, to sdk.AccAddress) error {
+	hooks := k.stakingKeeper.Hooks()
+
+	for _, delegation := range k.stakingKeeper.GetAllDelegatorDelegations(ctx, from) {
+		valAddr := delegation.GetValidatorAddr()
+
+		if err := hooks.BeforeDelegationSharesModified(ctx, from, valAddr); err != nil {
+			return err
+		}
+
+		if err := k.stakingKeeper.RemoveDelegation(ctx, delegation); err != nil {
+			return err
+		}
+
+		if err := hooks.BeforeDelegationCreated(ctx, to, valAddr); err != nil {
+			return err
+		}
+
+		k.stakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(to, valAddr, delegation.Shares))
+
+		if err := hooks.AfterDelegationModified(ctx, to, valAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateUnbondingDelegations moves the unbonding delegations of the given
+// account, so that their entries are paid out to the destination account once
+// they mature.
+func (k Keeper) migrateUnbondingDelegations(ctx sdk.Context, from, to sdk.AccAddress) {
+	for _, ubd := range k.stakingKeeper.GetUnbondingDelegations(ctx, from, math.MaxUint16) {
+		k.stakingKeeper.RemoveUnbondingDelegation(ctx, ubd)
+
+		// NOTE: the queue entries of the migrated account are skipped once
+		// they mature, as its unbonding delegations no longer exist.
+		ubd.DelegatorAddress = to.String()
+		k.stakingKeeper.SetUnbondingDelegation(ctx, ubd)
+		for _, entry := range ubd.Entries {
+			k.stakingKeeper.SetUnbondingDelegationByUnbondingID(ctx, ubd, entry.UnbondingId)
+			k.stakingKeeper.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
+		}
+	}
+}
+
+// migrateRedelegations moves the redelegations of the given account, so that
+// the destination account is slashed in its place for the infractions of the
+// source validators.
+func (k Keeper) migrateRedelegations(ctx sdk.Context, from, to sdk.AccAddress) {
+	for _, red := range k.stakingKeeper.GetRedelegations(ctx, from, math.MaxUint16) {
+		k.stakingKeeper.RemoveRedelegation(ctx, red)
+
+		red.DelegatorAddress = to.String()
+		k.stakingKeeper.SetRedelegation(ctx, red)
+		for _, entry := range red.Entries {
+			k.stakingKeeper.SetRedelegationByUnbondingID(ctx, red, entry.UnbondingId)
+			k.stakingKeeper.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
+		}
+	}
+}
+
+// migrateBalances sends all the balances of the given account to the
+// destination account. The vesting schedule of a clawback vesting account is
+// moved along with its locked coins, and the migrated account is converted to
+// a base account.
+func (k Keeper) migrateBalances(ctx sdk.Context, from, to sdk.AccAddress) error {
+	fromAcc := k.accountKeeper.GetAccount(ctx, from)
+	vestingAcc, isVesting := fromAcc.(*vestingtypes.ClawbackVestingAccount)
+	if isVesting {
+		// the vesting schedule is removed first, so that the locked coins can
+		// be sent
+		k.accountKeeper.SetAccount(ctx, vestingAcc.BaseAccount)
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, from)
+	if !balances.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, from, to, balances); err != nil {
+			return err
+		}
+	}
+
+	if !isVesting {
+		return nil
+	}
+
+	toAcc := k.accountKeeper.GetAccount(ctx, to)
+	if toAcc == nil {
+		toAcc = k.accountKeeper.NewAccountWithAddress(ctx, to)
+	}
+
+	baseVestingAcc := *vestingAcc.BaseVestingAccount
+	baseVestingAcc.BaseAccount = authtypes.NewBaseAccount(to, toAcc.GetPubKey(), toAcc.GetAccountNumber(), toAcc.GetSequence())
+	migratedAcc := *vestingAcc
+	migratedAcc.BaseVestingAccount = &baseVestingAcc
+	k.accountKeeper.SetAccount(ctx, &migratedAcc)
+
+	return nil
+}
+
+// migrateGrants moves the authz grants issued by or to the given account to
+// the destination account. Expired grants, and grants between the migrated
+// account and its destination are dropped.
+func (k Keeper) migrateGrants(ctx sdk.Context, from, to sdk.AccAddress) error {
+	type accountGrant struct {
+		granter, grantee sdk.AccAddress
+		grant            authz.Grant
+	}
+
+	var grants []accountGrant
+	k.authzKeeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant authz.Grant) bool {
+		if granter.Equals(from) || grantee.Equals(from) {
+			grants = append(grants, accountGrant{granter: granter, grantee: grantee, grant: grant})
+		}
+		return false
+	})
+
+	for _, g := range grants {
+		authorization, err := g.grant.GetAuthorization()
+		if err != nil {
+			return err
+		}
+
+		if err := k.authzKeeper.DeleteGrant(ctx, g.grantee, g.granter, authorization.MsgTypeURL()); err != nil {
+			return err
+		}
+
+		granter, grantee := g.granter, g.grantee
+		if granter.Equals(from) {
+			granter = to
+		}
+		if grantee.Equals(from) {
+			grantee = to
+		}
+
+		expired := g.grant.Expiration != nil && !g.grant.Expiration.After(ctx.BlockTime())
+		if expired || granter.Equals(grantee) {
+			continue
+		}
+
+		if err := k.authzKeeper.SaveGrant(ctx, grantee, granter, authorization, g.grant.Expiration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,340 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v19/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/evm/types"
+	vestingtypes "github.com/evmos/evmos/v19/x/vesting/types"
+)
+
+// migrateAccountMsg returns the message migrating the account of the given
+// secp256k1 key to the account of the given Ethereum key, signed by both keys.
+func (suite *KeeperTestSuite) migrateAccountMsg(fromPriv *secp256k1.PrivKey, toPriv *ethsecp256k1.PrivKey) *types.MsgMigrateAccount {
+	from := sdk.AccAddress(fromPriv.PubKey().Address())
+	to := sdk.AccAddress(toPriv.PubKey().Address())
+
+	fromSig, err := fromPriv.Sign(types.MigrateAccountSignBytes(suite.ctx.ChainID(), from, to))
+	suite.Require().NoError(err)
+	toSig, err := toPriv.Sign(types.MigrateAccountEthSignHash(suite.ctx.ChainID(), from, to))
+	suite.Require().NoError(err)
+
+	return &types.MsgMigrateAccount{
+		Sender:        sdk.AccAddress(suite.address.Bytes()).String(),
+		FromAddress:   from.String(),
+		FromPubKey:    fromPriv.PubKey().Bytes(),
+		FromSignature: fromSig,
+		ToAddress:     to.String(),
+		ToSignature:   toSig,
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateAccount() {
+	var (
+		fromPriv *secp256k1.PrivKey
+		toPriv   *ethsecp256k1.PrivKey
+		msg      *types.MsgMigrateAccount
+	)
+
+	balance := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000), sdk.NewInt64Coin("ibc/usdc", 10))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   string
+	}{
+		{
+			"pass - balances are migrated",
+			func() {},
+			"",
+		},
+		{
+			"pass - destination signature with a personal_sign recovery id",
+			func() {
+				msg.ToSignature[crypto.RecoveryIDOffset] += 27
+			},
+			"",
+		},
+		{
+			"pass - destination account with balances",
+			func() {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sdk.AccAddress(toPriv.PubKey().Address()), balance)
+				suite.Require().NoError(err)
+			},
+			"",
+		},
+		{
+			"fail - invalid signature of the migrated account",
+			func() {
+				msg.FromSignature = suite.migrateAccountMsg(secp256k1.GenPrivKey(), toPriv).FromSignature
+			},
+			"invalid signature of the migrated account",
+		},
+		{
+			"fail - invalid signature of the destination account",
+			func() {
+				_, otherPriv := utiltx.NewAddrKey()
+				msg.ToSignature = suite.migrateAccountMsg(fromPriv, otherPriv).ToSignature
+			},
+			"invalid signature of the destination account",
+		},
+		{
+			"fail - migrated account does not exist",
+			func() {
+				suite.app.AccountKeeper.RemoveAccount(suite.ctx, suite.app.AccountKeeper.GetAccount(suite.ctx, sdk.AccAddress(fromPriv.PubKey().Address())))
+			},
+			"does not exist",
+		},
+		{
+			"fail - account already migrated",
+			func() {
+				_, err := suite.app.EvmKeeper.MigrateAccount(suite.ctx, msg)
+				suite.Require().NoError(err)
+
+				_, otherPriv := utiltx.NewAddrKey()
+				msg = suite.migrateAccountMsg(fromPriv, otherPriv)
+			},
+			"has already been migrated",
+		},
+		{
+			"fail - migrated account is a module account",
+			func() {
+				from := sdk.AccAddress(fromPriv.PubKey().Address())
+				acc := suite.app.AccountKeeper.GetAccount(suite.ctx, from)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewModuleAccount(authtypes.NewBaseAccount(from, nil, acc.GetAccountNumber(), 0), "test"))
+			},
+			"is a module account",
+		},
+		{
+			"fail - destination account is a contract",
+			func() {
+				to := toPriv.PubKey().Address()
+				suite.app.EvmKeeper.SetCodeHash(suite.ctx, to, crypto.Keccak256([]byte("code")))
+			},
+			"is a contract",
+		},
+		{
+			"fail - destination account is a vesting account",
+			func() {
+				to := sdk.AccAddress(toPriv.PubKey().Address())
+				acc := vestingtypes.NewClawbackVestingAccount(authtypes.NewBaseAccountWithAddress(to), suite.address.Bytes(), nil, suite.ctx.BlockTime(), nil, nil)
+				acc.AccountNumber = suite.app.AccountKeeper.NextAccountNumber(suite.ctx)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			"is a vesting account",
+		},
+		{
+			"fail - destination account with delegations",
+			func() {
+				to := sdk.AccAddress(toPriv.PubKey().Address())
+				_, err := testutil.PrepareAccountsForDelegationRewards(suite.T(), suite.ctx, suite.app, to, math.ZeroInt(), math.NewInt(1e18))
+				suite.Require().NoError(err)
+			},
+			"has staking positions",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			fromPriv = secp256k1.GenPrivKey()
+			_, toPriv = utiltx.NewAddrKey()
+			from := sdk.AccAddress(fromPriv.PubKey().Address())
+			to := sdk.AccAddress(toPriv.PubKey().Address())
+
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, from, balance)
+			suite.Require().NoError(err)
+
+			msg = suite.migrateAccountMsg(fromPriv, toPriv)
+			suite.Require().NoError(msg.ValidateBasic())
+			tc.malleate()
+
+			toBalanceBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, to)
+			_, err = suite.app.EvmKeeper.MigrateAccount(suite.ctx, msg)
+			if tc.expErr != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, from).IsZero())
+			suite.Require().Equal(toBalanceBefore.Add(balance...), suite.app.BankKeeper.GetAllBalances(suite.ctx, to))
+
+			migratedTo, migrated := suite.app.EvmKeeper.GetMigratedAccount(suite.ctx, from)
+			suite.Require().True(migrated)
+			suite.Require().Equal(to, migratedTo)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateAccountStakingPositions() {
+	suite.SetupTest()
+
+	fromPriv := secp256k1.GenPrivKey()
+	_, toPriv := utiltx.NewAddrKey()
+	from := sdk.AccAddress(fromPriv.PubKey().Address())
+	to := sdk.AccAddress(toPriv.PubKey().Address())
+
+	stake := math.NewInt(1e18)
+	ctx, err := testutil.PrepareAccountsForDelegationRewards(suite.T(), suite.ctx, suite.app, from, math.NewInt(1000), stake, stake)
+	suite.Require().NoError(err)
+	suite.ctx = ctx
+
+	delegations := suite.app.StakingKeeper.GetAllDelegatorDelegations(suite.ctx, from)
+	suite.Require().Len(delegations, 2)
+	val1, val2 := delegations[0].GetValidatorAddr(), delegations[1].GetValidatorAddr()
+
+	// unbond and redelegate a quarter of the stake each
+	unbondingCompletion, err := suite.app.StakingKeeper.Undelegate(suite.ctx, from, val1, math.LegacyNewDecFromInt(stake.QuoRaw(4)))
+	suite.Require().NoError(err)
+	redelegationCompletion, err := suite.app.StakingKeeper.BeginRedelegation(suite.ctx, from, val2, val1, math.LegacyNewDecFromInt(stake.QuoRaw(4)))
+	suite.Require().NoError(err)
+
+	delegations = suite.app.StakingKeeper.GetAllDelegatorDelegations(suite.ctx, from)
+	rewards, err := testutil.GetTotalDelegationRewards(suite.ctx, suite.app.DistrKeeper, from)
+	suite.Require().NoError(err)
+	fromBalance := suite.app.BankKeeper.GetBalance(suite.ctx, from, utils.BaseDenom)
+
+	_, err = suite.app.EvmKeeper.MigrateAccount(suite.ctx, suite.migrateAccountMsg(fromPriv, toPriv))
+	suite.Require().NoError(err)
+
+	// the delegations are moved to the same validators with the same shares
+	suite.Require().Empty(suite.app.StakingKeeper.GetAllDelegatorDelegations(suite.ctx, from))
+	for _, delegation := range delegations {
+		migrated, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, to, delegation.GetValidatorAddr())
+		suite.Require().True(found)
+		suite.Require().Equal(delegation.Shares, migrated.Shares)
+	}
+
+	// the outstanding rewards are withdrawn before the balances are migrated
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, from, utils.BaseDenom).IsZero())
+	expBalance := fromBalance.Amount.Add(rewards.AmountOf(utils.BaseDenom).TruncateInt())
+	suite.Require().Equal(expBalance, suite.app.BankKeeper.GetBalance(suite.ctx, to, utils.BaseDenom).Amount)
+	toRewards, err := testutil.GetTotalDelegationRewards(suite.ctx, suite.app.DistrKeeper, to)
+	suite.Require().NoError(err)
+	suite.Require().True(toRewards.IsZero())
+
+	// the unbonding delegations and redelegations are moved with their entries
+	suite.Require().Empty(suite.app.StakingKeeper.GetUnbondingDelegations(suite.ctx, from, 10))
+	suite.Require().Empty(suite.app.StakingKeeper.GetRedelegations(suite.ctx, from, 10))
+	ubds := suite.app.StakingKeeper.GetUnbondingDelegations(suite.ctx, to, 10)
+	suite.Require().Len(ubds, 1)
+	suite.Require().Len(suite.app.StakingKeeper.GetRedelegations(suite.ctx, to, 10), 1)
+
+	ubdByID, found := suite.app.StakingKeeper.GetUnbondingDelegationByUnbondingID(suite.ctx, ubds[0].Entries[0].UnbondingId)
+	suite.Require().True(found)
+	suite.Require().Equal(to.String(), ubdByID.DelegatorAddress)
+
+	// the matured entries are paid out to the destination account
+	completion := unbondingCompletion
+	if redelegationCompletion.After(completion) {
+		completion = redelegationCompletion
+	}
+	suite.ctx = suite.ctx.WithBlockTime(completion.Add(time.Second))
+	staking.EndBlocker(suite.ctx, suite.app.StakingKeeper.Keeper)
+
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, from, utils.BaseDenom).IsZero())
+	suite.Require().Equal(expBalance.Add(stake.QuoRaw(4)), suite.app.BankKeeper.GetBalance(suite.ctx, to, utils.BaseDenom).Amount)
+	suite.Require().Empty(suite.app.StakingKeeper.GetUnbondingDelegations(suite.ctx, to, 10))
+	suite.Require().Empty(suite.app.StakingKeeper.GetRedelegations(suite.ctx, to, 10))
+}
+
+func (suite *KeeperTestSuite) TestMigrateAccountVestingSchedule() {
+	suite.SetupTest()
+
+	fromPriv := secp256k1.GenPrivKey()
+	_, toPriv := utiltx.NewAddrKey()
+	from := sdk.AccAddress(fromPriv.PubKey().Address())
+	to := sdk.AccAddress(toPriv.PubKey().Address())
+	funder := sdk.AccAddress(suite.address.Bytes())
+
+	vesting := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000))
+	periods := sdkvesting.Periods{{Length: 100_000, Amount: vesting}}
+	vestingAcc := vestingtypes.NewClawbackVestingAccount(
+		authtypes.NewBaseAccountWithAddress(from), funder, vesting, suite.ctx.BlockTime(), periods, periods,
+	)
+	vestingAcc.AccountNumber = suite.app.AccountKeeper.NextAccountNumber(suite.ctx)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, vestingAcc)
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, from, vesting)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.EvmKeeper.MigrateAccount(suite.ctx, suite.migrateAccountMsg(fromPriv, toPriv))
+	suite.Require().NoError(err)
+
+	// the migrated account is converted to a base account
+	fromAcc := suite.app.AccountKeeper.GetAccount(suite.ctx, from)
+	suite.Require().IsType(&authtypes.BaseAccount{}, fromAcc)
+	suite.Require().Equal(vestingAcc.AccountNumber, fromAcc.GetAccountNumber())
+
+	// the schedule and the locked coins are moved to the destination account
+	toAcc, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, to).(*vestingtypes.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(to.String(), toAcc.Address)
+	suite.Require().NotEqual(vestingAcc.AccountNumber, toAcc.AccountNumber)
+	suite.Require().Equal(vestingAcc.FunderAddress, toAcc.FunderAddress)
+	suite.Require().Equal(vestingAcc.OriginalVesting, toAcc.OriginalVesting)
+	suite.Require().Equal(vestingAcc.StartTime, toAcc.StartTime)
+	suite.Require().Equal(vestingAcc.LockupPeriods, toAcc.LockupPeriods)
+	suite.Require().Equal(vestingAcc.VestingPeriods, toAcc.VestingPeriods)
+	suite.Require().Equal(vesting, suite.app.BankKeeper.GetAllBalances(suite.ctx, to))
+	suite.Require().True(suite.app.BankKeeper.SpendableCoins(suite.ctx, to).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestMigrateAccountGrants() {
+	suite.SetupTest()
+
+	fromPriv := secp256k1.GenPrivKey()
+	_, toPriv := utiltx.NewAddrKey()
+	from := sdk.AccAddress(fromPriv.PubKey().Address())
+	to := sdk.AccAddress(toPriv.PubKey().Address())
+	grantee, _ := utiltx.NewAccAddressAndKey()
+	granter, _ := utiltx.NewAccAddressAndKey()
+
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, from, sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000)))
+	suite.Require().NoError(err)
+
+	sendAuthorization := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+	expiresSoon := suite.ctx.BlockTime().Add(time.Minute)
+
+	// grants issued by the migrated account
+	suite.Require().NoError(suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee, from, sendAuthorization, &expiration))
+	suite.Require().NoError(suite.app.AuthzKeeper.SaveGrant(suite.ctx, to, from, sendAuthorization, nil))
+	suite.Require().NoError(suite.app.AuthzKeeper.SaveGrant(suite.ctx, granter, from, sendAuthorization, &expiresSoon))
+	// grant issued to the migrated account
+	suite.Require().NoError(suite.app.AuthzKeeper.SaveGrant(suite.ctx, from, granter, sendAuthorization, nil))
+
+	suite.ctx = suite.ctx.WithBlockTime(expiresSoon.Add(time.Second))
+	_, err = suite.app.EvmKeeper.MigrateAccount(suite.ctx, suite.migrateAccountMsg(fromPriv, toPriv))
+	suite.Require().NoError(err)
+
+	// no grant references the migrated account anymore
+	var grants [][2]sdk.AccAddress
+	suite.app.AuthzKeeper.IterateGrants(suite.ctx, func(granterAddr, granteeAddr sdk.AccAddress, _ authz.Grant) bool {
+		grants = append(grants, [2]sdk.AccAddress{granterAddr, granteeAddr})
+		return false
+	})
+	suite.Require().ElementsMatch([][2]sdk.AccAddress{{to, grantee}, {granter, to}}, grants)
+
+	authorization, exp := suite.app.AuthzKeeper.GetAuthorization(suite.ctx, grantee, to, sendAuthorization.MsgTypeURL())
+	suite.Require().Equal(sendAuthorization, authorization)
+	suite.Require().Equal(expiration, *exp)
+
+	authorization, exp = suite.app.AuthzKeeper.GetAuthorization(suite.ctx, to, granter, sendAuthorization.MsgTypeURL())
+	suite.Require().Equal(sendAuthorization, authorization)
+	suite.Require().Nil(exp)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v19/x/evm/types"
 )
@@ -44,7 +45,15 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
-	response, err := k.ApplyTransaction(ctx, tx)
+	// unsigned transactions are only accepted on simulations, where the
+	// sender is taken from the message instead of the signature
+	var from *common.Address
+	if types.IsSimulation(ctx) && sender != "" && msg.IsUnsigned() {
+		addr := common.HexToAddress(sender)
+		from = &addr
+	}
+
+	response, err := k.applyTransaction(ctx, tx, from)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
@@ -97,6 +106,11 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, to.Hex()))
 	}
 
+	// attribute the fees and the gas refund to the account paying them
+	if feePayer, found := k.GetTxFeePayerTransient(ctx, tx.Hash()); found && feePayer != common.HexToAddress(sender) {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyFeePayer, feePayer.Hex()))
+	}
+
 	if response.Failed() {
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, response.VmError))
 	}
@@ -147,3 +161,74 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// MigrateAccount implements the gRPC MsgServer interface. It moves the state of
+// an account controlled by a Cosmos secp256k1 key to an account controlled by
+// an Ethereum key, once the migration has been signed by both keys.
+func (k *Keeper) MigrateAccount(goCtx context.Context, msg *types.MsgMigrateAccount) (*types.MsgMigrateAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid from address")
+	}
+
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if err := k.verifyMigrationSignatures(ctx, msg, from, to); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateMigration(ctx, from, to); err != nil {
+		return nil, err
+	}
+
+	if err := k.migrateAccount(ctx, from, to); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateAccountResponse{}, nil
+}
+
+// DeploySystemContract implements the gRPC MsgServer interface. When a
+// DeploySystemContract proposal passes, it installs the contract code at the
+// requested address. The deployment can only be performed if the requested
+// authority is the Cosmos SDK governance module account.
+func (k *Keeper) DeploySystemContract(goCtx context.Context, req *types.MsgDeploySystemContract) (*types.MsgDeploySystemContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr := common.HexToAddress(req.Address)
+
+	if err := k.validateSystemContract(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	if err := k.deploySystemContract(ctx, addr, req.Code, req.Label); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeploySystemContractResponse{}, nil
+}
+
+// UpdateAccessPolicy implements the gRPC MsgServer interface. When an
+// UpdateAccessPolicy proposal passes, it replaces the access policy restricting
+// the contract deployments and calls. The update can only be performed if the
+// requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) UpdateAccessPolicy(goCtx context.Context, req *types.MsgUpdateAccessPolicy) (*types.MsgUpdateAccessPolicyResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetAccessPolicy(ctx, req.Policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateAccessPolicyResponse{}, nil
+}
@@ -1,13 +1,19 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"math/big"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v19/server/config"
+	"github.com/evmos/evmos/v19/x/evm/keeper/testdata"
 	"github.com/evmos/evmos/v19/x/evm/statedb"
 	"github.com/evmos/evmos/v19/x/evm/types"
 )
@@ -109,3 +115,121 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSimulateEthereumTx() {
+	suite.SetupTest()
+
+	recipient := common.BigToAddress(big.NewInt(0xbeef))
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+
+	vmdb := suite.StateDB()
+	vmdb.AddBalance(suite.address, big.NewInt(1e18))
+	suite.Require().NoError(vmdb.Commit())
+
+	// commit twice so that the check state header defines the block proposer
+	suite.Commit()
+	suite.Commit()
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	suite.Require().NoError(err)
+	transferData, err := erc20Contract.ABI.Pack("transfer", recipient, big.NewInt(10))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		to     *common.Address
+		amount *big.Int
+		data   []byte
+		sign   bool
+		nonce  func(uint64) uint64
+	}{
+		{
+			"signed transfer",
+			&recipient,
+			big.NewInt(100),
+			nil,
+			true,
+			func(nonce uint64) uint64 { return nonce },
+		},
+		{
+			"unsigned transfer",
+			&recipient,
+			big.NewInt(100),
+			nil,
+			false,
+			func(nonce uint64) uint64 { return nonce },
+		},
+		{
+			"unsigned transfer with a future nonce",
+			&recipient,
+			big.NewInt(100),
+			nil,
+			false,
+			func(nonce uint64) uint64 { return nonce + 10 },
+		},
+		{
+			"unsigned contract call",
+			&contractAddr,
+			nil,
+			transferData,
+			false,
+			func(nonce uint64) uint64 { return nonce },
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			args, err := json.Marshal(&types.TransactionArgs{
+				From:  &suite.address,
+				To:    tc.to,
+				Value: (*hexutil.Big)(tc.amount),
+				Data:  (*hexutil.Bytes)(&tc.data),
+			})
+			suite.Require().NoError(err)
+
+			estimate, err := suite.queryClient.EstimateGas(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+				Args:            args,
+				GasCap:          config.DefaultGasCap,
+				ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+			})
+			suite.Require().NoError(err)
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := types.NewTx(&types.EvmTxArgs{
+				ChainID:   suite.app.EvmKeeper.ChainID(),
+				Nonce:     tc.nonce(nonce),
+				To:        tc.to,
+				Amount:    tc.amount,
+				GasLimit:  1_000_000,
+				GasFeeCap: big.NewInt(1),
+				GasTipCap: big.NewInt(1),
+				Input:     tc.data,
+				Accesses:  &ethtypes.AccessList{},
+			})
+			msg.From = suite.address.Hex()
+			if tc.sign {
+				suite.Require().NoError(msg.Sign(suite.ethSigner, suite.signer))
+			}
+
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			tx, err := msg.BuildTx(txBuilder, suite.EvmDenom())
+			suite.Require().NoError(err)
+
+			if !tc.sign {
+				// unsigned transactions define the sender on the from field
+				msg.From = suite.address.Hex()
+				suite.Require().NoError(txBuilder.SetMsgs(msg))
+				tx = txBuilder.GetTx()
+			}
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+			suite.Require().NoError(err)
+
+			gasInfo, _, err := suite.app.Simulate(txBytes)
+			suite.Require().NoError(err)
+			suite.Require().Equal(estimate.Gas, gasInfo.GasUsed)
+
+			// the simulation does not modify the state
+			suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+		})
+	}
+}
@@ -41,6 +41,8 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	}
 
 	store.Set(types.KeyPrefixParams, bz)
+	k.setBlockedAddresses(ctx, params.BlockedAddresses)
+	k.setAddressIndex(ctx, types.KeyPrefixPaymaster, params.Paymasters)
 	return nil
 }
 
@@ -0,0 +1,113 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v19/x/evm/statedb"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// IsPaymaster returns true if the given address is in the paymasters of the
+// params.
+func (k Keeper) IsPaymaster(ctx sdk.Context, addr common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPaymaster)
+	return store.Has(addr.Bytes())
+}
+
+// GetTxPaymaster returns the paymaster sponsoring the fees of the given
+// transaction, if it designates one of the paymasters of the params as the
+// first entry of its access list. An error is returned if the designated
+// paymaster doesn't accept to sponsor the transaction for the given maximum
+// fees.
+func (k *Keeper) GetTxPaymaster(
+	ctx sdk.Context,
+	sender common.Address,
+	tx *ethtypes.Transaction,
+	maxCost *big.Int,
+) (common.Address, bool, error) {
+	paymaster, found := types.DesignatedPaymaster(tx.AccessList())
+	if !found || !k.IsPaymaster(ctx, paymaster) {
+		return common.Address{}, false, nil
+	}
+
+	if err := k.validatePaymaster(ctx, paymaster, sender, tx, maxCost); err != nil {
+		return common.Address{}, false, err
+	}
+	return paymaster, true, nil
+}
+
+// validatePaymaster calls the validation method of the paymaster for the
+// given transaction, on a cached context that is discarded so that the
+// validation can't modify the state. The call is bounded by the paymaster
+// validation gas limit, and isn't charged to the transaction.
+func (k *Keeper) validatePaymaster(
+	ctx sdk.Context,
+	paymaster, sender common.Address,
+	tx *ethtypes.Transaction,
+	maxCost *big.Int,
+) error {
+	var to common.Address
+	if tx.To() != nil {
+		to = *tx.To()
+	}
+
+	data, err := types.PaymasterABI.Pack(types.PaymasterValidationMethod, sender, to, tx.Value(), tx.Data(), maxCost)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrABIPack, err.Error())
+	}
+
+	msg := ethtypes.NewMessage(
+		common.BytesToAddress(k.accountKeeper.GetModuleAddress(types.ModuleName)),
+		&paymaster,
+		0,                                 // nonce
+		big.NewInt(0),                     // amount
+		types.PaymasterValidationGasLimit, // gasLimit
+		big.NewInt(0),                     // gasFeeCap
+		big.NewInt(0),                     // gasTipCap
+		big.NewInt(0),                     // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		true,                  // isFake
+	)
+
+	// NOTE: the coinbase isn't resolved, as the block proposer isn't known
+	// when checking the transactions for the mempool
+	params := k.GetParams(ctx)
+	ethCfg := params.ChainConfig.EthereumConfig(k.eip155ChainID)
+	cfg := &statedb.EVMConfig{
+		Params:      params,
+		ChainConfig: ethCfg,
+		CoinBase:    common.Address{},
+		BaseFee:     k.GetBaseFee(ctx, ethCfg),
+	}
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	res, err := k.ApplyMessageWithConfig(cacheCtx, msg, types.NewNoOpTracer(), false, cfg, txConfig)
+	if err != nil {
+		return err
+	}
+	if res.Failed() {
+		return errorsmod.Wrapf(types.ErrPaymasterRejected, "paymaster %s validation failed: %s", paymaster, res.VmError)
+	}
+
+	out, err := types.PaymasterABI.Unpack(types.PaymasterValidationMethod, res.Ret)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrPaymasterRejected, "invalid paymaster %s validation result: %s", paymaster, err)
+	}
+	if accepted, ok := out[0].(bool); !ok || !accepted {
+		return errorsmod.Wrapf(types.ErrPaymasterRejected, "paymaster %s declined to sponsor the transaction", paymaster)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+)
+
+func (suite *KeeperTestSuite) TestGetTxPaymaster() {
+	suite.SetupTest()
+
+	// the paymaster writes to its storage before accepting any tx
+	paymaster := utiltx.GenerateAddress()
+	db := suite.StateDB()
+	db.SetCode(paymaster, common.FromHex("0x6001600055600160005260206000f3"))
+	suite.Require().NoError(db.Commit())
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.Paymasters = []string{paymaster.Hex()}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	suite.Require().True(suite.app.EvmKeeper.IsPaymaster(suite.ctx, paymaster))
+
+	sender, to := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	newTx := func(accessList ethtypes.AccessList) *ethtypes.Transaction {
+		return ethtypes.NewTx(&ethtypes.DynamicFeeTx{To: &to, Gas: 21000, AccessList: accessList})
+	}
+
+	// the txs not designating a paymaster are paid by their sender
+	_, sponsored, err := suite.app.EvmKeeper.GetTxPaymaster(suite.ctx, sender, newTx(nil), big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().False(sponsored)
+
+	_, sponsored, err = suite.app.EvmKeeper.GetTxPaymaster(suite.ctx, sender, newTx(ethtypes.AccessList{{Address: to}, {Address: paymaster}}), big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().False(sponsored)
+
+	found, sponsored, err := suite.app.EvmKeeper.GetTxPaymaster(suite.ctx, sender, newTx(ethtypes.AccessList{{Address: paymaster}}), big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().True(sponsored)
+	suite.Require().Equal(paymaster, found)
+
+	// the state changes of the validation are discarded
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, paymaster, common.Hash{}))
+
+	// the paymasters are indexed along with the params
+	params.Paymasters = nil
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	suite.Require().False(suite.app.EvmKeeper.IsPaymaster(suite.ctx, paymaster))
+
+	_, sponsored, err = suite.app.EvmKeeper.GetTxPaymaster(suite.ctx, sender, newTx(ethtypes.AccessList{{Address: paymaster}}), big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().False(sponsored)
+}
@@ -0,0 +1,95 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// applyStateOverrides decodes the given json state overrides and applies them
+// on a cache of the context, which is returned. The store of the given context
+// is left unchanged.
+func (k *Keeper) applyStateOverrides(ctx sdk.Context, bz []byte) (sdk.Context, error) {
+	if len(bz) == 0 {
+		return ctx, nil
+	}
+
+	var overrides types.StateOverride
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return ctx, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := overrides.Validate(); err != nil {
+		return ctx, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, _ = ctx.CacheContext()
+	for addr, account := range overrides {
+		if err := k.overrideAccount(ctx, addr, account); err != nil {
+			return ctx, status.Errorf(codes.Internal, "failed to override account %s: %s", addr.Hex(), err)
+		}
+	}
+	return ctx, nil
+}
+
+// overrideAccount sets the overridden fields of an account in the store. An
+// overridden state replaces the whole storage of the account, while a state
+// diff only replaces the given slots.
+func (k *Keeper) overrideAccount(ctx sdk.Context, addr common.Address, account types.OverrideAccount) error {
+	acct := k.GetAccountOrEmpty(ctx, addr)
+	if account.Nonce != nil {
+		acct.Nonce = uint64(*account.Nonce)
+	}
+	if account.Code != nil {
+		code := *account.Code
+		acct.CodeHash = crypto.Keccak256(code)
+		if len(code) > 0 {
+			k.SetCode(ctx, acct.CodeHash, code)
+		}
+	}
+	if account.Balance != nil {
+		acct.Balance = new(big.Int)
+		if *account.Balance != nil {
+			acct.Balance = (*account.Balance).ToInt()
+		}
+	}
+	if err := k.SetAccount(ctx, addr, acct); err != nil {
+		return err
+	}
+
+	if account.State != nil {
+		var keys []common.Hash
+		k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+			keys = append(keys, key)
+			return true
+		})
+		for _, key := range keys {
+			k.DeleteState(ctx, addr, key)
+		}
+		k.overrideStorage(ctx, addr, *account.State)
+	}
+	if account.StateDiff != nil {
+		k.overrideStorage(ctx, addr, *account.StateDiff)
+	}
+	return nil
+}
+
+// overrideStorage sets the given storage slots of an account, deleting the
+// slots set to zero as the StateDB does on commit.
+func (k *Keeper) overrideStorage(ctx sdk.Context, addr common.Address, slots map[common.Hash]common.Hash) {
+	for key, value := range slots {
+		if value == (common.Hash{}) {
+			k.DeleteState(ctx, addr, key)
+			continue
+		}
+		k.SetState(ctx, addr, key, value.Bytes())
+	}
+}
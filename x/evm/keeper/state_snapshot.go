@@ -0,0 +1,98 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"fmt"
+	"io"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// ExportStateSnapshot streams the EVM contracts of the given context, with
+// their nonce, code and storage slots, to the given writer in the state
+// snapshot format (see types.StateSnapshotWriter). It returns the checksum of
+// the snapshot.
+func (k *Keeper) ExportStateSnapshot(ctx sdk.Context, w io.Writer) (common.Hash, error) {
+	sw, err := types.NewStateSnapshotWriter(w, ctx.BlockHeight())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	k.IterateContracts(ctx, func(address common.Address, codeHash common.Hash) (stop bool) {
+		err = sw.WriteAccount(types.StateSnapshotAccount{
+			Address:  address,
+			Nonce:    k.GetNonce(ctx, address),
+			CodeHash: codeHash,
+			Code:     k.GetCode(ctx, codeHash),
+		})
+		if err != nil {
+			return true
+		}
+
+		k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+			err = sw.WriteSlot(key, value)
+			return err == nil
+		})
+		return err != nil
+	})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	return sw.Close()
+}
+
+// ImportStateSnapshot reconstructs the EVM contracts of the given state
+// snapshot in the store, replacing the storage of the contracts already set.
+// The store is only updated if the whole snapshot is valid. It returns the
+// height of the snapshot state.
+func (k *Keeper) ImportStateSnapshot(ctx sdk.Context, r io.Reader) (int64, error) {
+	sr, err := types.NewStateSnapshotReader(r)
+	if err != nil {
+		return 0, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	onAccount := func(account types.StateSnapshotAccount) error {
+		if len(account.Code) > 0 {
+			k.SetCode(cacheCtx, account.CodeHash.Bytes(), account.Code)
+		} else if !types.IsEmptyCodeHash(account.CodeHash.Bytes()) && len(k.GetCode(cacheCtx, account.CodeHash)) == 0 {
+			return fmt.Errorf("code not found for account %s with code hash %s", account.Address, account.CodeHash)
+		}
+
+		acct := k.GetAccountOrEmpty(cacheCtx, account.Address)
+		acct.Nonce = account.Nonce
+		acct.CodeHash = account.CodeHash.Bytes()
+		if err := k.SetAccount(cacheCtx, account.Address, acct); err != nil {
+			return err
+		}
+
+		// the imported storage replaces the current storage of the account
+		var keys []common.Hash
+		k.ForEachStorage(cacheCtx, account.Address, func(key, _ common.Hash) bool {
+			keys = append(keys, key)
+			return true
+		})
+		for _, key := range keys {
+			k.DeleteState(cacheCtx, account.Address, key)
+		}
+		return nil
+	}
+
+	onSlot := func(address common.Address, key, value common.Hash) error {
+		k.SetState(cacheCtx, address, key, value.Bytes())
+		return nil
+	}
+
+	if err := sr.Read(onAccount, onSlot); err != nil {
+		return 0, fmt.Errorf("failed to import snapshot: %w", err)
+	}
+
+	writeCache()
+	return sr.Height(), nil
+}
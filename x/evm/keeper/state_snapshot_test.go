@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestStateSnapshotExportImport() {
+	suite.SetupTest()
+
+	contracts := []common.Address{suite.setupStorageContract(3), suite.setupStorageContract(2)}
+	expStorage := make(map[common.Address]types.Storage, len(contracts))
+	expNonces := make(map[common.Address]uint64, len(contracts))
+	for _, addr := range contracts {
+		expStorage[addr] = suite.app.EvmKeeper.GetAccountStorage(suite.ctx, addr)
+		expNonces[addr] = suite.app.EvmKeeper.GetNonce(suite.ctx, addr)
+	}
+
+	var buf bytes.Buffer
+	checksum, err := suite.app.EvmKeeper.ExportStateSnapshot(suite.ctx, &buf)
+	suite.Require().NoError(err)
+	snapshot := buf.Bytes()
+
+	// diverge from the exported state
+	first := contracts[0]
+	suite.app.EvmKeeper.DeleteState(suite.ctx, first, common.BigToHash(common.Big1))
+	suite.app.EvmKeeper.SetState(suite.ctx, first, common.HexToHash("0xff"), []byte{2})
+	suite.app.EvmKeeper.DeleteCodeHash(suite.ctx, contracts[1])
+	suite.app.EvmKeeper.DeleteCode(suite.ctx, crypto.Keccak256([]byte("code")))
+
+	// an invalid snapshot doesn't update the store
+	_, err = suite.app.EvmKeeper.ImportStateSnapshot(suite.ctx, bytes.NewReader(snapshot[:len(snapshot)-1]))
+	suite.Require().ErrorContains(err, "failed to read snapshot checksum")
+	suite.Require().Len(suite.app.EvmKeeper.GetAccountStorage(suite.ctx, first), 3)
+	suite.Require().Equal(common.BytesToHash(types.EmptyCodeHash), suite.app.EvmKeeper.GetCodeHash(suite.ctx, contracts[1]))
+
+	height, err := suite.app.EvmKeeper.ImportStateSnapshot(suite.ctx, bytes.NewReader(snapshot))
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.BlockHeight(), height)
+
+	codeHash := crypto.Keccak256Hash([]byte("code"))
+	suite.Require().Equal([]byte("code"), suite.app.EvmKeeper.GetCode(suite.ctx, codeHash))
+	for _, addr := range contracts {
+		suite.Require().Equal(codeHash, suite.app.EvmKeeper.GetCodeHash(suite.ctx, addr))
+		suite.Require().Equal(expStorage[addr], suite.app.EvmKeeper.GetAccountStorage(suite.ctx, addr))
+		suite.Require().Equal(expNonces[addr], suite.app.EvmKeeper.GetNonce(suite.ctx, addr))
+	}
+
+	// the imported state exports to the same snapshot
+	buf.Reset()
+	reexported, err := suite.app.EvmKeeper.ExportStateSnapshot(suite.ctx, &buf)
+	suite.Require().NoError(err)
+	suite.Require().Equal(checksum, reexported)
+}
@@ -3,12 +3,16 @@
 package keeper
 
 import (
+	"errors"
 	"math/big"
+	"time"
 
 	tmtypes "github.com/cometbft/cometbft/types"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	evmostypes "github.com/evmos/evmos/v19/types"
@@ -18,6 +22,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	evmoscore "github.com/evmos/evmos/v19/x/evm/core/core"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
@@ -40,16 +45,18 @@ func (k *Keeper) NewEVM(
 	stateDB vm.StateDB,
 ) *vm.EVM {
 	blockCtx := vm.BlockContext{
-		CanTransfer: evmoscore.CanTransfer,
-		Transfer:    evmoscore.Transfer,
-		GetHash:     k.GetHashFn(ctx),
-		Coinbase:    cfg.CoinBase,
-		GasLimit:    evmostypes.BlockGasLimit(ctx),
-		BlockNumber: big.NewInt(ctx.BlockHeight()),
-		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
-		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
-		BaseFee:     cfg.BaseFee,
-		Random:      nil, // not supported
+		CanTransfer:        evmoscore.CanTransfer,
+		Transfer:           evmoscore.Transfer,
+		IsBlocked:          k.isBlockedFn(ctx, cfg.Params),
+		IsBlockedRecipient: k.isBlockedRecipientFn(),
+		GetHash:            k.GetHashFn(ctx),
+		Coinbase:           cfg.CoinBase,
+		GasLimit:           evmostypes.BlockGasLimit(ctx),
+		BlockNumber:        big.NewInt(ctx.BlockHeight()),
+		Time:               big.NewInt(ctx.BlockHeader().Time.Unix()),
+		Difficulty:         big.NewInt(0), // unused. Only required in PoW context
+		BaseFee:            cfg.BaseFee,
+		Random:             nil, // not supported
 	}
 
 	txCtx := evmoscore.NewEVMTxContext(msg)
@@ -70,13 +77,27 @@ func (k *Keeper) NewEVM(
 		accessControl.GetCallHook(signer),
 		k.GetPrecompilesCallHook(ctx),
 	)
+
+	// the access policy is enforced in addition to the access control params
+	accessPolicy := cfg.AccessPolicy
+	if accessPolicy == nil {
+		accessPolicy = k.GetAccessPolicyLists(ctx)
+	}
+	if createHook := getAccessPolicyCreateHook(accessPolicy, signer); createHook != nil {
+		evmHooks.AddCreateHooks(createHook)
+	}
+	if callHook := getAccessPolicyCallHook(accessPolicy); callHook != nil {
+		evmHooks.AddCallHooks(callHook)
+	}
 	return vm.NewEVMWithHooks(evmHooks, blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
 }
 
-// GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
-//  1. The requested height matches the current height from context (and thus same epoch number)
-//  2. The requested height is from an previous height from the same chain epoch
-//  3. The requested height is from a height greater than the latest one
+// GetHashFn implements vm.GetHashFunc for Ethermint with the Ethereum
+// semantics: the hashes of the current and future blocks and of the blocks
+// beyond the BLOCKHASH window are zero. The hashes of the blocks within the
+// window are read from the block hashes retained by the keeper, falling back
+// to the historical info of the staking module for the blocks committed
+// before the hashes were retained.
 func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 	return func(height uint64) common.Hash {
 		h, err := evmostypes.SafeInt64(height)
@@ -85,47 +106,27 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 			return common.Hash{}
 		}
 
-		switch {
-		case ctx.BlockHeight() == h:
-			// Case 1: The requested height matches the one from the context so we can retrieve the header
-			// hash directly from the context.
-			// Note: The headerHash is only set at begin block, it will be nil in case of a query context
-			headerHash := ctx.HeaderHash()
-			if len(headerHash) != 0 {
-				return common.BytesToHash(headerHash)
-			}
-
-			// only recompute the hash if not set (eg: checkTxState)
-			contextBlockHeader := ctx.BlockHeader()
-			header, err := tmtypes.HeaderFromProto(&contextBlockHeader)
-			if err != nil {
-				k.Logger(ctx).Error("failed to cast tendermint header from proto", "error", err)
-				return common.Hash{}
-			}
-
-			headerHash = header.Hash()
-			return common.BytesToHash(headerHash)
-
-		case ctx.BlockHeight() > h:
-			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
-			// current chain epoch. This only applies if the current height is greater than the requested height.
-			histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, h)
-			if !found {
-				k.Logger(ctx).Debug("historical info not found", "height", h)
-				return common.Hash{}
-			}
-
-			header, err := tmtypes.HeaderFromProto(&histInfo.Header)
-			if err != nil {
-				k.Logger(ctx).Error("failed to cast tendermint header from proto", "error", err)
-				return common.Hash{}
-			}
-
-			return common.BytesToHash(header.Hash())
-		default:
-			// Case 3: heights greater than the current one returns an empty hash.
+		if h >= ctx.BlockHeight() || uint64(ctx.BlockHeight()-h) > types.BlockHashWindow {
 			return common.Hash{}
 		}
+
+		if hash, found := k.GetBlockHash(ctx, height); found {
+			return hash
+		}
+
+		histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, h)
+		if !found {
+			k.Logger(ctx).Debug("historical info not found", "height", h)
+			return common.Hash{}
+		}
+
+		header, err := tmtypes.HeaderFromProto(&histInfo.Header)
+		if err != nil {
+			k.Logger(ctx).Error("failed to cast tendermint header from proto", "error", err)
+			return common.Hash{}
+		}
+
+		return common.BytesToHash(header.Hash())
 	}
 }
 
@@ -147,6 +148,14 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
+	return k.applyTransaction(ctx, tx, nil)
+}
+
+// applyTransaction applies the transaction as described on ApplyTransaction.
+// If the sender is not nil, it is used as the sender of the transaction
+// instead of recovering it from the signature, which is only allowed on the
+// simulations of unsigned transactions.
+func (k *Keeper) applyTransaction(ctx sdk.Context, tx *ethtypes.Transaction, sender *common.Address) (*types.MsgEthereumTxResponse, error) {
 	var bloom *big.Int
 
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
@@ -155,18 +164,25 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	}
 	txConfig := k.TxConfig(ctx, tx.Hash())
 
-	// get the signer according to the chain rules from the config and block height
-	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
+	var msg core.Message
+	if sender != nil {
+		msg = types.NewUnsignedMessage(tx, *sender, cfg.BaseFee)
+	} else {
+		// get the signer according to the chain rules from the config and block height
+		signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+		msg, err = tx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
+		}
 	}
 
-	// Create a cache context to revert state. The cache context is only committed when both tx and hooks executed successfully.
+	// Create a cache context to revert state. The cache context is only committed when the tx executed successfully.
 	// Didn't use `Snapshot` because the context stack has exponential complexity on certain operations,
 	// thus restricted to be used only inside `ApplyMessage`.
 	tmpCtx, commit := ctx.CacheContext()
 
+	start := time.Now()
+
 	// pass true to commit the StateDB
 	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
 	if err != nil {
@@ -176,8 +192,25 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
 
+	if evmostypes.IsTelemetryEnabled() {
+		metrics.MeasureSinceWithLabels(metricKeyTxExecution, start.UTC(), txTypeLabels(tx))
+	}
+
+	// assign the block log indexes in emission order, starting from the
+	// logs emitted by the previous txs on the block
+	for i, log := range res.Logs {
+		log.TxIndex = uint64(txConfig.TxIndex)
+		log.Index = uint64(txConfig.LogIndex) + uint64(i)
+	}
+
 	logs := types.LogsToEthereum(res.Logs)
 
+	// run the hooks on the cache context once the EVM execution succeeded
+	if !res.Failed() && cfg.Params.PostTxHooksActive(ctx.BlockHeight()) {
+		k.runPostTxHooks(tmpCtx, msg, tx.Type(), res, txConfig)
+		logs = types.LogsToEthereum(res.Logs)
+	}
+
 	// Compute block bloom filter
 	if len(logs) > 0 {
 		bloom = k.GetBlockBloomTransient(ctx)
@@ -189,13 +222,20 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
+	feePayer, found := k.GetTxFeePayerTransient(ctx, txConfig.TxHash)
+	if !found {
+		feePayer = msg.From()
 	}
+	if err = k.RefundGas(ctx, msg, feePayer, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to fee payer %s", feePayer)
+	}
+
+	k.accountContractGas(ctx, cfg.Params, msg, res.GasUsed, res.Failed())
 
 	if len(logs) > 0 {
 		// Update transient block bloom filter
 		k.SetBlockBloomTransient(ctx, bloom)
+		k.SetTxLogsTransient(ctx, uint64(txConfig.TxIndex), res.Logs)
 		k.SetLogSizeTransient(ctx, uint64(txConfig.LogIndex)+uint64(len(logs)))
 	}
 
@@ -206,11 +246,84 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
 	}
 
+	// record the priority fee paid for the fee history of the block
+	k.feeMarketKeeper.AddTransientTxReward(ctx, tx.EffectiveGasTipValue(cfg.BaseFee), res.GasUsed)
+
 	// reset the gas meter for current cosmos transaction
 	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
 	return res, nil
 }
 
+// runPostTxHooks runs the post tx hooks of a successfully applied message on
+// the given context. A failing hook only skips the changes of the hooks, unless
+// its error wraps ErrPostTxProcessing, which fails the response and drops its
+// logs. The hooks are metered within the gas left by the message, and the gas
+// they consume is added to the response.
+func (k *Keeper) runPostTxHooks(
+	ctx sdk.Context,
+	msg core.Message,
+	txType uint8,
+	res *types.MsgEthereumTxResponse,
+	txConfig statedb.TxConfig,
+) {
+	logs := types.LogsToEthereum(res.Logs)
+	receipt := &ethtypes.Receipt{
+		Type:              txType,
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: k.GetTransientGasUsed(ctx) + res.GasUsed,
+		Bloom:             ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
+		Logs:              logs,
+		TxHash:            txConfig.TxHash,
+		GasUsed:           res.GasUsed,
+		BlockHash:         txConfig.BlockHash,
+		BlockNumber:       big.NewInt(ctx.BlockHeight()),
+		TransactionIndex:  txConfig.TxIndex,
+	}
+	if msg.To() == nil {
+		receipt.ContractAddress = crypto.CreateAddress(msg.From(), msg.Nonce())
+	}
+
+	hooksGasUsed, err := k.PostTxProcessing(ctx, msg, receipt, msg.Gas()-res.GasUsed)
+	if err != nil {
+		k.Logger(ctx).Error("tx post processing failed", "hash", txConfig.TxHash.Hex(), "error", err.Error())
+	}
+	if errors.Is(err, types.ErrPostTxProcessing) {
+		// the logs are dropped along with the state changes of the failed tx
+		res.VmError = err.Error()
+		res.Logs = nil
+	}
+	res.GasUsed += hooksGasUsed
+}
+
+// simulateMessage applies the message for the eth_call and eth_estimateGas
+// queries without persisting its state changes. When the post tx hooks are
+// active, the message is applied on a discarded cache context and the hooks
+// run on the gas it left, as they do on the tx execution, so that the gas they
+// consume is estimated and a call fails when the tx would.
+func (k *Keeper) simulateMessage(
+	ctx sdk.Context,
+	msg core.Message,
+	txType uint8,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	if k.hooks == nil || !cfg.Params.PostTxHooksActive(ctx.BlockHeight()) {
+		// pass false to not commit StateDB
+		return k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
+	}
+
+	// the hooks read the state changes of the message, so the StateDB is
+	// committed to a cache context that is discarded afterwards
+	tmpCtx, _ := ctx.CacheContext()
+	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	if err != nil || res.Failed() {
+		return res, err
+	}
+
+	k.runPostTxHooks(tmpCtx, msg, txType, res, txConfig)
+	return res, nil
+}
+
 // ApplyMessage calls ApplyMessageWithConfig with an empty TxConfig.
 func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*types.MsgEthereumTxResponse, error) {
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
@@ -273,6 +386,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
 	)
 
+	txConfig.MaxLogs = cfg.Params.MaxLogsPerTx
 	stateDB := statedb.New(ctx, k, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
@@ -344,13 +458,26 @@ func (k *Keeper) ApplyMessageWithConfig(
 	var vmError string
 	if vmErr != nil {
 		vmError = vmErr.Error()
+
+		if commit && evmostypes.IsTelemetryEnabled() {
+			telemetry.IncrCounterWithLabels(
+				metricKeyVMError,
+				1,
+				[]metrics.Label{telemetry.NewLabel("error", vmErrorLabel(vmErr))},
+			)
+		}
 	}
 
 	// The dirty states in `StateDB` is either committed or discarded after return
 	if commit {
+		start := time.Now()
 		if err := stateDB.Commit(); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
+
+		if evmostypes.IsTelemetryEnabled() {
+			telemetry.MeasureSince(start, metricKeyStateCommit...)
+		}
 	}
 
 	// calculate a minimum amount of gas to be charged to sender if GasLimit
@@ -358,6 +485,11 @@ func (k *Keeper) ApplyMessageWithConfig(
 	// for more info https://github.com/evmos/ethermint/issues/1085
 	gasLimit := math.LegacyNewDec(int64(msg.Gas()))
 	minGasMultiplier := k.GetMinGasMultiplier(ctx)
+	if types.IsSimulation(ctx) {
+		// report the gas actually used on simulations, as the gas limit of the
+		// simulated transaction is only an upper bound
+		minGasMultiplier = math.LegacyZeroDec()
+	}
 	minimumGasUsed := gasLimit.Mul(minGasMultiplier)
 
 	if !minimumGasUsed.TruncateInt().IsUint64() {
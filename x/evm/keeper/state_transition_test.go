@@ -7,7 +7,6 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto/tmhash"
-	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -35,30 +34,20 @@ func (suite *KeeperTestSuite) TestGetHashFn() {
 		expHash  common.Hash
 	}{
 		{
-			"case 1.1: context hash cached",
+			"case 1.1: current height, context hash cached",
 			uint64(suite.ctx.BlockHeight()),
 			func() {
 				suite.ctx = suite.ctx.WithHeaderHash(tmhash.Sum([]byte("header")))
 			},
-			common.BytesToHash(tmhash.Sum([]byte("header"))),
-		},
-		{
-			"case 1.2: failed to cast Tendermint header",
-			uint64(suite.ctx.BlockHeight()),
-			func() {
-				header := tmproto.Header{}
-				header.Height = suite.ctx.BlockHeight()
-				suite.ctx = suite.ctx.WithBlockHeader(header)
-			},
 			common.Hash{},
 		},
 		{
-			"case 1.3: hash calculated from Tendermint header",
+			"case 1.2: current height, hash retained",
 			uint64(suite.ctx.BlockHeight()),
 			func() {
-				suite.ctx = suite.ctx.WithBlockHeader(header)
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, uint64(suite.ctx.BlockHeight()), common.BytesToHash(hash))
 			},
-			common.BytesToHash(hash),
+			common.Hash{},
 		},
 		{
 			"case 2.1: height lower than current one, hist info not found",
@@ -89,6 +78,24 @@ func (suite *KeeperTestSuite) TestGetHashFn() {
 			},
 			common.BytesToHash(hash),
 		},
+		{
+			"case 2.4: height lower than current one, hash retained",
+			1,
+			func() {
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 1, common.BytesToHash(hash))
+				suite.ctx = suite.ctx.WithBlockHeight(10)
+			},
+			common.BytesToHash(hash),
+		},
+		{
+			"case 2.5: height beyond the BLOCKHASH window",
+			1,
+			func() {
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 1, common.BytesToHash(hash))
+				suite.ctx = suite.ctx.WithBlockHeight(1 + int64(evmtypes.BlockHashWindow) + 1)
+			},
+			common.Hash{},
+		},
 		{
 			"case 3: height greater than current one",
 			200,
@@ -448,7 +455,7 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 			refund := keeper.GasToRefund(vmdb.GetRefund(), gasUsed, tc.refundQuotient)
 			suite.Require().Equal(tc.expGasRefund, refund)
 
-			err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, refund, evmtypes.DefaultEVMDenom)
+			err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, m.From(), refund, evmtypes.DefaultEVMDenom)
 			if tc.noError {
 				suite.Require().NoError(err)
 			} else {
@@ -763,3 +770,45 @@ func (suite *KeeperTestSuite) TestGetProposerAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestApplyMessageOpcodeGasOverrides() {
+	suite.SetupTest()
+
+	// PUSH1 0x00 SLOAD POP PUSH1 0x01 SLOAD POP PUSH1 0x02 SLOAD POP
+	// PUSH1 0x01 PUSH1 0x00 SSTORE PUSH1 0x01 PUSH1 0x01 SSTORE STOP
+	code := common.FromHex("0x6000545060015450600254506001600055600160015500")
+	contract := utiltx.GenerateAddress()
+
+	vmdb := suite.StateDB()
+	vmdb.SetCode(contract, code)
+	suite.Require().NoError(vmdb.Commit())
+
+	gasUsed := func(ctx sdk.Context) uint64 {
+		nonce := suite.app.EvmKeeper.GetNonce(ctx, suite.address)
+		msg := ethtypes.NewMessage(suite.address, &contract, nonce, big.NewInt(0), 100_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true)
+
+		res, err := suite.app.EvmKeeper.ApplyMessage(ctx, msg, nil, false)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+		return res.GasUsed
+	}
+
+	height := suite.ctx.BlockHeight()
+	defaultGasUsed := gasUsed(suite.ctx)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.OpcodeGasOverrides = []evmtypes.OpcodeGasOverride{
+		{Opcode: "SLOAD", Gas: 1_000},
+		{Opcode: "SSTORE", Gas: 3_000},
+	}
+	params.OpcodeGasOverridesActivationHeight = height + 1
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	// the overrides are not applied before the activation height
+	suite.Require().Equal(defaultGasUsed, gasUsed(suite.ctx))
+
+	// both opcodes have no constant gas in the default jump table, so the
+	// overrides are charged on top of the default dynamic costs
+	expGasUsed := defaultGasUsed + 3*1_000 + 2*3_000
+	suite.Require().Equal(expGasUsed, gasUsed(suite.ctx.WithBlockHeight(height+1)))
+}
@@ -9,7 +9,6 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
-	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v19/x/evm/statedb"
@@ -18,6 +17,10 @@ import (
 
 var _ statedb.Keeper = &Keeper{}
 
+// storageDeleteBatchSize is the maximum number of storage slots collected from
+// the store iterator before they are deleted when clearing a contract storage.
+const storageDeleteBatchSize = 10_000
+
 // ----------------------------------------------------------------------------
 // StateDB Keeper implementation
 // ----------------------------------------------------------------------------
@@ -88,12 +91,13 @@ func (k *Keeper) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
 	return store.Get(codeHash.Bytes())
 }
 
-// ForEachStorage iterate contract storage, callback return false to break early
+// ForEachStorage iterates over the contract storage in ascending key order,
+// callback return false to break early. The slots are streamed from the
+// store iterator, so the storage is never loaded into memory as a whole.
 func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
-	store := ctx.KVStore(k.storeKey)
-	prefix := types.AddressStoragePrefix(addr)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
 
-	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -144,6 +148,11 @@ func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *big.In
 func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error {
 	// update account
 	acct := k.accountKeeper.GetAccount(ctx, addr.Bytes())
+	if acct == nil || !types.IsEmptyCodeHash(account.CodeHash) {
+		// the storage of a contract previously self-destructed at the address
+		// must not be inherited by a new account or contract
+		k.clearQueuedStorage(ctx, addr)
+	}
 	if acct == nil {
 		acct = k.accountKeeper.NewAccountWithAddress(ctx, addr.Bytes())
 	}
@@ -268,11 +277,9 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 		return err
 	}
 
-	// clear storage
-	k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
-		k.DeleteState(ctx, addr, key)
-		return true
-	})
+	// clear storage, the slots left over the per tx limit are deleted at the
+	// end of the following blocks
+	k.pruneStorage(ctx, addr)
 
 	// clear code hash
 	k.DeleteCodeHash(ctx, addr)
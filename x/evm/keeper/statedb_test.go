@@ -1,13 +1,21 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
+	"runtime"
 	"testing"
 
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -950,6 +958,68 @@ func (suite *KeeperTestSuite) AddSlotToAccessList() {
 // 	}
 // }
 
+func (suite *KeeperTestSuite) TestForEachStorageStreaming() {
+	slots := 1_000_000
+	if testing.Short() {
+		slots = 10_000
+	}
+
+	// mount the EVM store on a plain database so that the synthetic contract
+	// storage is not buffered in a cache store
+	storeKey := suite.app.GetKey(types.StoreKey)
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeDB, nil)
+	suite.Require().NoError(cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	addr := utiltx.GenerateAddress()
+	storage := prefix.NewStore(ctx.KVStore(storeKey), types.AddressStoragePrefix(addr))
+	for i := 0; i < slots; i++ {
+		key := common.BigToHash(big.NewInt(int64(i)))
+		storage.Set(key.Bytes(), common.BigToHash(big.NewInt(int64(i+1))).Bytes())
+	}
+
+	var (
+		before, during runtime.MemStats
+		count          int
+		prev           common.Hash
+	)
+
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	suite.app.EvmKeeper.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+		if count > 0 {
+			suite.Require().Equal(-1, bytes.Compare(prev.Bytes(), key.Bytes()), "keys must be in ascending order")
+		}
+		suite.Require().Equal(common.BigToHash(new(big.Int).Add(key.Big(), big.NewInt(1))), value)
+
+		if count == slots/2 {
+			runtime.GC()
+			runtime.ReadMemStats(&during)
+		}
+
+		prev = key
+		count++
+		return true
+	})
+	suite.Require().Equal(slots, count)
+
+	// the slots are streamed, so the live heap does not grow with the storage size
+	if during.HeapAlloc > before.HeapAlloc {
+		suite.Require().Less(during.HeapAlloc-before.HeapAlloc, uint64(16<<20))
+	}
+
+	// the iteration stops as soon as the callback returns false
+	count = 0
+	suite.app.EvmKeeper.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+		suite.Require().Equal(common.BigToHash(big.NewInt(int64(count))), key)
+		count++
+		return count < 10
+	})
+	suite.Require().Equal(10, count)
+}
+
 func (suite *KeeperTestSuite) TestSetBalance() {
 	amount := big.NewInt(-10)
 
@@ -1058,3 +1128,88 @@ func (suite *KeeperTestSuite) TestDeleteAccount() {
 		})
 	}
 }
+
+// transientGuardCode is the runtime code of a contract protected by a transient
+// reentrancy guard. The first calldata word selects the mode of the call:
+//   - 0: enter and release the guard
+//   - 1: enter the guard and call itself with mode 0, storing the call success
+//     in slot 1 and 1 in slot 0
+//   - 2, 5: enter the guard and call itself with mode + 1, storing the call
+//     success in slot 1 and the transient slot 1 in slot 2
+//   - 3: write 42 to the transient slot 1 and revert, bypassing the guard
+//   - 4: enter the guard without releasing it
+//   - 6: write 42 to the transient slot 1, bypassing the guard
+var transientGuardCode = common.FromHex(
+	"600035806003146045578060061460505760005c603f57600160005d806001146059578060021460775780600514607757806004146057575b600060005d005b60006000fd5b602a60015d60006000fd5b602a60015d005b005b600060005260006000602060006000305af160015560016000556038565b8060010160005260006000602060006000305af160015560015c6002556001600055603856",
+)
+
+func (suite *KeeperTestSuite) TestTransientStorageReentrancyGuard() {
+	var contract common.Address
+
+	call := func(mode byte) error {
+		_, err := suite.app.EvmKeeper.CallEVMWithData(suite.ctx, suite.address, &contract, common.LeftPadBytes([]byte{mode}, 32), true)
+		return err
+	}
+	slot := func(n int64) common.Hash {
+		return suite.app.EvmKeeper.GetState(suite.ctx, contract, common.BigToHash(big.NewInt(n)))
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expSlots map[int64]int64
+	}{
+		{
+			"pass - guard blocks a reentrant call",
+			func() { suite.Require().NoError(call(1)) },
+			map[int64]int64{0: 1, 1: 0},
+		},
+		{
+			"pass - transient write of a reverted nested call is rolled back",
+			func() { suite.Require().NoError(call(2)) },
+			map[int64]int64{0: 1, 1: 0, 2: 0},
+		},
+		{
+			"pass - transient write of a nested call is visible to the caller",
+			func() { suite.Require().NoError(call(5)) },
+			map[int64]int64{0: 1, 1: 1, 2: 42},
+		},
+		{
+			"pass - transient storage is cleared between transactions",
+			func() {
+				suite.Require().NoError(call(4))
+				suite.Require().NoError(call(0))
+			},
+			nil,
+		},
+		{
+			"fail - transient storage opcodes are invalid before the Cancun block",
+			func() {
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				params.ChainConfig.CancunBlock = nil
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+				err := call(0)
+				suite.Require().ErrorContains(err, "invalid opcode: TLOAD")
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			contract = utiltx.GenerateAddress()
+			vmdb := suite.StateDB()
+			vmdb.SetCode(contract, transientGuardCode)
+			suite.Require().NoError(vmdb.Commit())
+
+			tc.malleate()
+
+			for n, expValue := range tc.expSlots {
+				suite.Require().Equal(common.BigToHash(big.NewInt(expValue)), slot(n), "unexpected value on slot %d", n)
+			}
+		})
+	}
+}
@@ -72,6 +72,7 @@ func NewAvailableStaticPrecompiles(
 		transferKeeper,
 		channelKeeper,
 		authzKeeper,
+		erc20Keeper,
 	)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate ICS20 precompile: %w", err))
@@ -0,0 +1,200 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+const (
+	// storagePruneTxLimit is the maximum number of storage slots of a
+	// self-destructed contract deleted by the destroying tx. The remaining
+	// slots are queued and deleted at the end of the following blocks.
+	storagePruneTxLimit = 1_000
+	// storagePruneBlockLimit is the maximum number of queued storage slots
+	// deleted at the end of a block, to keep the block time predictable.
+	storagePruneBlockLimit = 5_000
+)
+
+// OrphanedStorage defines the storage left under an address that has no
+// account or no code.
+type OrphanedStorage struct {
+	Address common.Address `json:"address"`
+	Slots   uint64         `json:"slots"`
+	Queued  bool           `json:"queued"`
+}
+
+// IsStoragePruneQueued returns true if the storage of the given self-destructed
+// contract is queued for deletion.
+func (k *Keeper) IsStoragePruneQueued(ctx sdk.Context, addr common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStoragePruneQueue)
+	return store.Has(addr.Bytes())
+}
+
+// QueueStoragePrune queues the storage of the given address for deletion at
+// the end of the following blocks.
+func (k *Keeper) QueueStoragePrune(ctx sdk.Context, addr common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStoragePruneQueue)
+	store.Set(addr.Bytes(), []byte{1})
+}
+
+// dequeueStoragePrune removes the given address from the storage prune queue.
+func (k *Keeper) dequeueStoragePrune(ctx sdk.Context, addr common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStoragePruneQueue)
+	store.Delete(addr.Bytes())
+}
+
+// firstQueuedStoragePrune returns the first address of the storage prune
+// queue, in ascending order, and false if the queue is empty.
+func (k *Keeper) firstQueuedStoragePrune(ctx sdk.Context) (common.Address, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStoragePruneQueue)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(iterator.Key()), true
+}
+
+// deleteStorage deletes up to limit storage slots of the given address, in
+// ascending key order. It returns the number of deleted slots and true if the
+// storage is fully deleted.
+func (k *Keeper) deleteStorage(ctx sdk.Context, addr common.Address, limit int) (int, bool) {
+	deleted := 0
+	for deleted < limit {
+		batchSize := min(limit-deleted, storageDeleteBatchSize)
+
+		// collect the keys first, as the store must not be written to while
+		// an iterator is open over it
+		keys := make([]common.Hash, 0, batchSize)
+		k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+			keys = append(keys, key)
+			return len(keys) < batchSize
+		})
+
+		for _, key := range keys {
+			k.DeleteState(ctx, addr, key)
+		}
+
+		deleted += len(keys)
+		if len(keys) < batchSize {
+			return deleted, true
+		}
+	}
+
+	// the limit is reached, check if any slot is left
+	empty := true
+	k.ForEachStorage(ctx, addr, func(common.Hash, common.Hash) bool {
+		empty = false
+		return false
+	})
+	return deleted, empty
+}
+
+// pruneStorage deletes the storage of a self-destructed contract up to the
+// per tx limit, and queues the remaining slots for deletion.
+func (k *Keeper) pruneStorage(ctx sdk.Context, addr common.Address) {
+	if _, done := k.deleteStorage(ctx, addr, storagePruneTxLimit); !done {
+		k.QueueStoragePrune(ctx, addr)
+	}
+}
+
+// clearQueuedStorage deletes the remaining storage of an address queued for
+// pruning. It is called when an account is created again at the address, so
+// that the new account doesn't inherit the storage of the destroyed contract.
+func (k *Keeper) clearQueuedStorage(ctx sdk.Context, addr common.Address) {
+	if !k.IsStoragePruneQueued(ctx, addr) {
+		return
+	}
+
+	k.deleteStorage(ctx, addr, math.MaxInt)
+	k.dequeueStoragePrune(ctx, addr)
+}
+
+// PruneQueuedStorage deletes up to the per block limit of storage slots of the
+// queued self-destructed contracts, in ascending address and key order so that
+// the deletion is deterministic. It returns the number of deleted slots.
+func (k *Keeper) PruneQueuedStorage(ctx sdk.Context) int {
+	deleted := 0
+	for deleted < storagePruneBlockLimit {
+		addr, found := k.firstQueuedStoragePrune(ctx)
+		if !found {
+			break
+		}
+
+		n, done := k.deleteStorage(ctx, addr, storagePruneBlockLimit-deleted)
+		deleted += n
+		if !done {
+			break
+		}
+		k.dequeueStoragePrune(ctx, addr)
+	}
+
+	return deleted
+}
+
+// GetOrphanedStorage scans the EVM storage for slots stored under addresses
+// that have no account or no code, e.g. the storage of self-destructed
+// contracts that is not fully deleted yet.
+func (k *Keeper) GetOrphanedStorage(ctx sdk.Context) []OrphanedStorage {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStorage)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var (
+		orphaned []OrphanedStorage
+		last     *common.Address
+		isOrphan bool
+	)
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if len(key) < common.AddressLength {
+			continue
+		}
+
+		// the slots of an address are contiguous, so the address is only
+		// checked on its first slot
+		addr := common.BytesToAddress(key[:common.AddressLength])
+		if last == nil || addr != *last {
+			last = &addr
+			isOrphan = !k.IsContract(ctx, addr) || k.accountKeeper.GetAccount(ctx, addr.Bytes()) == nil
+			if isOrphan {
+				orphaned = append(orphaned, OrphanedStorage{
+					Address: addr,
+					Queued:  k.IsStoragePruneQueued(ctx, addr),
+				})
+			}
+		}
+
+		if isOrphan {
+			orphaned[len(orphaned)-1].Slots++
+		}
+	}
+
+	return orphaned
+}
+
+// QueueOrphanedStorage queues the orphaned storage found in the EVM store for
+// deletion at the end of the following blocks, e.g. in an upgrade handler to
+// prune the storage left by the contracts self-destructed before the storage
+// prune queue. It returns the number of queued addresses.
+func (k *Keeper) QueueOrphanedStorage(ctx sdk.Context) int {
+	queued := 0
+	for _, orphaned := range k.GetOrphanedStorage(ctx) {
+		if !orphaned.Queued {
+			k.QueueStoragePrune(ctx, orphaned.Address)
+			queued++
+		}
+	}
+	return queued
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/keeper"
+)
+
+// setupStorageContract sets a contract at a new address with the given number
+// of storage slots.
+func (suite *KeeperTestSuite) setupStorageContract(slots int) common.Address {
+	addr := utiltx.GenerateAddress()
+
+	db := suite.StateDB()
+	db.SetCode(addr, []byte("code"))
+	suite.Require().NoError(db.Commit())
+
+	for i := 0; i < slots; i++ {
+		key := common.BigToHash(big.NewInt(int64(i) + 1))
+		suite.app.EvmKeeper.SetState(suite.ctx, addr, key, []byte{1})
+	}
+	return addr
+}
+
+func (suite *KeeperTestSuite) storageSlots(addr common.Address) int {
+	return len(suite.app.EvmKeeper.GetAccountStorage(suite.ctx, addr))
+}
+
+func (suite *KeeperTestSuite) TestPruneSelfDestructedStorage() {
+	suite.SetupTest()
+
+	addr := suite.setupStorageContract(10_000)
+	suite.Require().Equal(10_000, suite.storageSlots(addr))
+
+	db := suite.StateDB()
+	suite.Require().True(db.Suicide(addr))
+	suite.Require().NoError(db.Commit())
+
+	// the destroying tx deletes up to the per tx limit
+	suite.Require().Equal(9_000, suite.storageSlots(addr))
+	suite.Require().True(suite.app.EvmKeeper.IsStoragePruneQueued(suite.ctx, addr))
+
+	orphaned := suite.app.EvmKeeper.GetOrphanedStorage(suite.ctx)
+	suite.Require().Equal([]keeper.OrphanedStorage{{Address: addr, Slots: 9_000, Queued: true}}, orphaned)
+
+	// the remaining slots are deleted at the end of the following blocks
+	suite.app.EvmKeeper.EndBlock(suite.ctx, abci.RequestEndBlock{})
+	suite.Require().Equal(4_000, suite.storageSlots(addr))
+	suite.Require().True(suite.app.EvmKeeper.IsStoragePruneQueued(suite.ctx, addr))
+
+	suite.Require().Equal(4_000, suite.app.EvmKeeper.PruneQueuedStorage(suite.ctx))
+	suite.Require().Zero(suite.storageSlots(addr))
+	suite.Require().False(suite.app.EvmKeeper.IsStoragePruneQueued(suite.ctx, addr))
+	suite.Require().Empty(suite.app.EvmKeeper.GetOrphanedStorage(suite.ctx))
+
+	suite.Require().Zero(suite.app.EvmKeeper.PruneQueuedStorage(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestPruneQueuedStorageOrder() {
+	suite.SetupTest()
+
+	addrs := []common.Address{suite.setupStorageContract(4_000), suite.setupStorageContract(4_000)}
+	for _, addr := range addrs {
+		db := suite.StateDB()
+		suite.Require().True(db.Suicide(addr))
+		suite.Require().NoError(db.Commit())
+	}
+
+	first, second := addrs[0], addrs[1]
+	if first.Hex() > second.Hex() {
+		first, second = second, first
+	}
+
+	// the queue is pruned in ascending address order, up to the block limit
+	suite.Require().Equal(5_000, suite.app.EvmKeeper.PruneQueuedStorage(suite.ctx))
+	suite.Require().Zero(suite.storageSlots(first))
+	suite.Require().False(suite.app.EvmKeeper.IsStoragePruneQueued(suite.ctx, first))
+	suite.Require().Equal(1_000, suite.storageSlots(second))
+	suite.Require().True(suite.app.EvmKeeper.IsStoragePruneQueued(suite.ctx, second))
+}
+
+func (suite *KeeperTestSuite) TestRecreatedContractStorage() {
+	suite.SetupTest()
+
+	addr := suite.setupStorageContract(2_000)
+	oldKey := common.BigToHash(common.Big1)
+
+	db := suite.StateDB()
+	suite.Require().True(db.Suicide(addr))
+	suite.Require().NoError(db.Commit())
+	suite.Require().True(suite.app.EvmKeeper.IsStoragePruneQueued(suite.ctx, addr))
+
+	// a contract created again at the address doesn't see the old storage
+	newKey := crypto.Keccak256Hash([]byte("key"))
+	db = suite.StateDB()
+	db.CreateAccount(addr)
+	db.SetCode(addr, []byte("new code"))
+	suite.Require().Equal(common.Hash{}, db.GetState(addr, oldKey))
+	db.SetState(addr, newKey, common.BigToHash(common.Big2))
+	suite.Require().NoError(db.Commit())
+
+	suite.Require().False(suite.app.EvmKeeper.IsStoragePruneQueued(suite.ctx, addr))
+	suite.Require().Equal(1, suite.storageSlots(addr))
+	suite.Require().Equal(common.BigToHash(common.Big2), suite.app.EvmKeeper.GetState(suite.ctx, addr, newKey))
+}
+
+func (suite *KeeperTestSuite) TestQueueOrphanedStorage() {
+	suite.SetupTest()
+
+	// storage without account, e.g. left by a contract destroyed before the
+	// storage prune queue
+	addr := utiltx.GenerateAddress()
+	for i := int64(0); i < 10; i++ {
+		suite.app.EvmKeeper.SetState(suite.ctx, addr, common.BigToHash(big.NewInt(i)), []byte{1})
+	}
+
+	contract := suite.setupStorageContract(10)
+
+	suite.Require().Equal([]keeper.OrphanedStorage{{Address: addr, Slots: 10}}, suite.app.EvmKeeper.GetOrphanedStorage(suite.ctx))
+	suite.Require().Equal(1, suite.app.EvmKeeper.QueueOrphanedStorage(suite.ctx))
+	suite.Require().Equal(10, suite.app.EvmKeeper.PruneQueuedStorage(suite.ctx))
+
+	suite.Require().Empty(suite.app.EvmKeeper.GetOrphanedStorage(suite.ctx))
+	suite.Require().Equal(10, suite.storageSlots(contract))
+}
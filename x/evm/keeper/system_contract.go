@@ -0,0 +1,127 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// GetSystemContract returns the provenance of the contract installed through
+// governance at the given address, if any.
+func (k Keeper) GetSystemContract(ctx sdk.Context, addr common.Address) (types.SystemContract, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSystemContract)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return types.SystemContract{}, false
+	}
+
+	var contract types.SystemContract
+	k.cdc.MustUnmarshal(bz, &contract)
+	return contract, true
+}
+
+// GetSystemContracts returns the provenance of all the contracts installed
+// through governance.
+func (k Keeper) GetSystemContracts(ctx sdk.Context) []types.SystemContract {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSystemContract)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var contracts []types.SystemContract
+	for ; iterator.Valid(); iterator.Next() {
+		var contract types.SystemContract
+		k.cdc.MustUnmarshal(iterator.Value(), &contract)
+		contracts = append(contracts, contract)
+	}
+
+	return contracts
+}
+
+// setSystemContract records the provenance of a contract installed through
+// governance.
+func (k Keeper) setSystemContract(ctx sdk.Context, addr common.Address, contract types.SystemContract) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSystemContract)
+	store.Set(addr.Bytes(), k.cdc.MustMarshal(&contract))
+}
+
+// validateSystemContract checks that a system contract can be installed at the
+// given address. The address can't hold a contract already, nor be the
+// address of a precompile or a module account.
+func (k *Keeper) validateSystemContract(ctx sdk.Context, addr common.Address) error {
+	if k.IsContract(ctx, addr) {
+		return errorsmod.Wrapf(types.ErrInvalidSystemContract, "address %s is already a contract", addr)
+	}
+
+	// NOTE: the inactive static precompiles are checked too, as they can be
+	// enabled later on
+	_, isStaticPrecompile := k.precompiles[addr]
+	if isStaticPrecompile || slices.Contains(vm.PrecompiledAddressesBerlin, addr) {
+		return errorsmod.Wrapf(types.ErrInvalidSystemContract, "address %s is a precompile", addr)
+	}
+
+	_, isERC20Precompile, err := k.erc20Keeper.GetERC20PrecompileInstance(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if isERC20Precompile {
+		return errorsmod.Wrapf(types.ErrInvalidSystemContract, "address %s is an ERC20 precompile", addr)
+	}
+
+	if acc := k.accountKeeper.GetAccount(ctx, addr.Bytes()); acc != nil {
+		if _, isModuleAccount := acc.(authtypes.ModuleAccountI); isModuleAccount {
+			return errorsmod.Wrapf(types.ErrInvalidSystemContract, "address %s is a module account", addr)
+		}
+	}
+
+	return nil
+}
+
+// deploySystemContract installs the given code at the given address and
+// records its provenance. The balance of an existing account at the address is
+// kept, and its nonce is set to 1 as for the contracts created by the EVM
+// (EIP-161).
+//
+// CONTRACT: the address must have been validated with validateSystemContract.
+func (k *Keeper) deploySystemContract(ctx sdk.Context, addr common.Address, code []byte, label string) error {
+	codeHash := crypto.Keccak256Hash(code)
+
+	account := k.GetAccountOrEmpty(ctx, addr)
+	account.CodeHash = codeHash.Bytes()
+	if account.Nonce == 0 {
+		account.Nonce = 1
+	}
+
+	k.SetCode(ctx, codeHash.Bytes(), code)
+	if err := k.SetAccount(ctx, addr, account); err != nil {
+		return err
+	}
+
+	k.setSystemContract(ctx, addr, types.SystemContract{
+		Address:  addr.Hex(),
+		CodeHash: codeHash.Hex(),
+		Label:    label,
+		Height:   ctx.BlockHeight(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeploySystemContract,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, addr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash.Hex()),
+			sdk.NewAttribute(types.AttributeKeyLabel, label),
+		),
+	)
+
+	return nil
+}
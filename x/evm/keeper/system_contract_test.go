@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v19/server/config"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// multicall3Address is the canonical address of Multicall3 on the chains it is
+// deployed to.
+var multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// multicall3Code is the runtime code of a contract implementing the
+// getChainId, getBlockNumber and getEthBalance views of Multicall3.
+var multicall3Code = common.FromHex(
+	"60003560e01c80633408e47014602857806342cbb15c14602d5780634d2301cc14603257600080fd" +
+		"5b46603a565b43603a565b60043531603a565b60005260206000f3",
+)
+
+const multicall3ABI = `[
+	{"type":"function","name":"getChainId","stateMutability":"view","inputs":[],"outputs":[{"name":"chainid","type":"uint256"}]},
+	{"type":"function","name":"getBlockNumber","stateMutability":"view","inputs":[],"outputs":[{"name":"blockNumber","type":"uint256"}]},
+	{"type":"function","name":"getEthBalance","stateMutability":"view","inputs":[{"name":"addr","type":"address"}],"outputs":[{"name":"balance","type":"uint256"}]}
+]`
+
+func (suite *KeeperTestSuite) deploySystemContractMsg(addr common.Address, code []byte) *types.MsgDeploySystemContract {
+	return &types.MsgDeploySystemContract{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Address:   addr.Hex(),
+		Code:      code,
+		Label:     "Multicall3",
+	}
+}
+
+// ethCall calls the given method of the contract at the given address through
+// the EthCall query and unpacks its single output.
+func (suite *KeeperTestSuite) ethCall(contractABI abi.ABI, addr common.Address, method string, args ...interface{}) *big.Int {
+	input, err := contractABI.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	callArgs, err := json.Marshal(&types.TransactionArgs{To: &addr, Input: (*hexutil.Bytes)(&input)})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: callArgs, GasCap: config.DefaultGasCap})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	out, err := contractABI.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	return out[0].(*big.Int)
+}
+
+func (suite *KeeperTestSuite) TestDeploySystemContract() {
+	suite.SetupTest()
+
+	contractABI, err := abi.JSON(strings.NewReader(multicall3ABI))
+	suite.Require().NoError(err)
+
+	// the balance of an existing account is kept
+	vmdb := suite.StateDB()
+	vmdb.AddBalance(multicall3Address, big.NewInt(1000))
+	suite.Require().NoError(vmdb.Commit())
+
+	_, err = suite.app.EvmKeeper.DeploySystemContract(suite.ctx, &types.MsgDeploySystemContract{
+		Authority: suite.address.String(),
+		Address:   multicall3Address.Hex(),
+		Code:      multicall3Code,
+	})
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	_, err = suite.app.EvmKeeper.DeploySystemContract(suite.ctx, suite.deploySystemContractMsg(multicall3Address, multicall3Code))
+	suite.Require().NoError(err)
+
+	codeHash := crypto.Keccak256Hash(multicall3Code)
+	suite.Require().True(suite.app.EvmKeeper.IsContract(suite.ctx, multicall3Address))
+	suite.Require().Equal(codeHash, suite.app.EvmKeeper.GetCodeHash(suite.ctx, multicall3Address))
+	suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetNonce(suite.ctx, multicall3Address))
+
+	contract, found := suite.app.EvmKeeper.GetSystemContract(suite.ctx, multicall3Address)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SystemContract{
+		Address:  multicall3Address.Hex(),
+		CodeHash: codeHash.Hex(),
+		Label:    "Multicall3",
+		Height:   suite.ctx.BlockHeight(),
+	}, contract)
+	suite.Require().Equal([]types.SystemContract{contract}, suite.app.EvmKeeper.GetSystemContracts(suite.ctx))
+
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeDeploySystemContract, events[len(events)-1].Type)
+
+	// the contract is called at its canonical address
+	suite.Require().Equal(suite.app.EvmKeeper.ChainID(), suite.ethCall(contractABI, multicall3Address, "getChainId"))
+	suite.Require().Equal(big.NewInt(suite.ctx.BlockHeight()), suite.ethCall(contractABI, multicall3Address, "getBlockNumber"))
+	suite.Require().Equal(big.NewInt(1000), suite.ethCall(contractABI, multicall3Address, "getEthBalance", multicall3Address))
+
+	// the contract can't be replaced
+	_, err = suite.app.EvmKeeper.DeploySystemContract(suite.ctx, suite.deploySystemContractMsg(multicall3Address, multicall3Code))
+	suite.Require().ErrorContains(err, "already a contract")
+}
+
+func (suite *KeeperTestSuite) TestDeploySystemContractInvalidAddress() {
+	testCases := []struct {
+		name        string
+		address     common.Address
+		errContains string
+	}{
+		{
+			"fail - precompile",
+			common.BytesToAddress([]byte{0x01}),
+			"is a precompile",
+		},
+		{
+			"fail - static precompile",
+			common.HexToAddress(types.AvailableStaticPrecompiles[0]),
+			"is a precompile",
+		},
+		{
+			"fail - module account",
+			common.BytesToAddress(authtypes.NewModuleAddress(govtypes.ModuleName)),
+			"is a module account",
+		},
+		{
+			"pass - new account",
+			utiltx.GenerateAddress(),
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			_, err := suite.app.EvmKeeper.DeploySystemContract(suite.ctx, suite.deploySystemContractMsg(tc.address, multicall3Code))
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				suite.Require().False(suite.app.EvmKeeper.IsContract(suite.ctx, tc.address))
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
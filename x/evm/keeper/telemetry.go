@@ -0,0 +1,88 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// Metric keys emitted by the EVM keeper.
+var (
+	metricKeyTxExecution = []string{types.ModuleName, "tx", "execution"}
+	metricKeyStateCommit = []string{types.ModuleName, "state", "commit"}
+	metricKeyVMError     = []string{types.ModuleName, "vm_error", "total"}
+	metricKeyBlockTxs    = []string{types.ModuleName, "block", "txs"}
+
+	metricKeyBlockBloomMismatch = []string{types.ModuleName, "block", "bloom_mismatch"}
+
+	metricKeyContractGasUsed = []string{types.ModuleName, "contract", "gas_used"}
+	metricKeyContractCalls   = []string{types.ModuleName, "contract", "calls"}
+	metricKeyContractReverts = []string{types.ModuleName, "contract", "reverts"}
+)
+
+// contractGasMetricsTopN is the number of top contracts by gas used of the
+// current epoch whose execution metrics are reported.
+const contractGasMetricsTopN = 10
+
+// vmErrors maps the sentinel VM errors to the label values of the VM error
+// counter.
+var vmErrors = []struct {
+	err   error
+	label string
+}{
+	{vm.ErrExecutionReverted, "execution_reverted"},
+	{vm.ErrOutOfGas, "out_of_gas"},
+	{vm.ErrCodeStoreOutOfGas, "code_store_out_of_gas"},
+	{vm.ErrDepth, "max_call_depth"},
+	{vm.ErrInsufficientBalance, "insufficient_balance"},
+	{vm.ErrContractAddressCollision, "contract_address_collision"},
+	{vm.ErrMaxCodeSizeExceeded, "max_code_size_exceeded"},
+	{vm.ErrInvalidJump, "invalid_jump"},
+	{vm.ErrWriteProtection, "write_protection"},
+	{vm.ErrReturnDataOutOfBounds, "return_data_out_of_bounds"},
+	{vm.ErrGasUintOverflow, "gas_uint_overflow"},
+	{vm.ErrInvalidCode, "invalid_code"},
+	{vm.ErrNonceUintOverflow, "nonce_uint_overflow"},
+	{vm.ErrMaxLogsExceeded, "max_logs_exceeded"},
+}
+
+// txTypeLabels returns the telemetry labels of the given transaction.
+func txTypeLabels(tx *ethtypes.Transaction) []metrics.Label {
+	return []metrics.Label{telemetry.NewLabel("tx_type", strconv.Itoa(int(tx.Type())))}
+}
+
+// vmErrorLabel returns the label value for the given VM error. The error
+// message is not used as label, as it may contain values that would make the
+// number of series unbounded.
+func vmErrorLabel(err error) string {
+	for _, vmErr := range vmErrors {
+		if errors.Is(err, vmErr.err) {
+			return vmErr.label
+		}
+	}
+
+	var (
+		stackUnderflow *vm.ErrStackUnderflow
+		stackOverflow  *vm.ErrStackOverflow
+		invalidOpCode  *vm.ErrInvalidOpCode
+	)
+
+	switch {
+	case errors.As(err, &stackUnderflow):
+		return "stack_underflow"
+	case errors.As(err, &stackOverflow):
+		return "stack_overflow"
+	case errors.As(err, &invalidOpCode):
+		return "invalid_opcode"
+	default:
+		return "other"
+	}
+}
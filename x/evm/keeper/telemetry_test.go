@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmostypes "github.com/evmos/evmos/v19/types"
+)
+
+func (suite *KeeperTestSuite) TestTelemetryMetrics() {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("evmos")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+
+	_, err := metrics.NewGlobal(cfg, sink)
+	suite.Require().NoError(err)
+	evmostypes.SetTelemetryEnabled(true)
+
+	defer func() {
+		evmostypes.SetTelemetryEnabled(false)
+		_, err := metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+		suite.Require().NoError(err)
+	}()
+
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	// contract that always reverts
+	reverter := common.BigToAddress(big.NewInt(0xbeef))
+	vmdb := suite.StateDB()
+	vmdb.SetCode(reverter, common.FromHex("60006000fd"))
+	suite.Require().NoError(vmdb.Commit())
+
+	suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+
+	msg := ethtypes.NewMessage(
+		suite.address, &reverter, 0, big.NewInt(0), 100_000,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true,
+	)
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+
+	suite.ctx = suite.ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	suite.Commit()
+
+	series := make(map[string]bool)
+	for _, interval := range sink.Data() {
+		interval.RLock()
+		for key := range interval.Gauges {
+			series[key] = true
+		}
+		for key := range interval.Counters {
+			series[key] = true
+		}
+		for key := range interval.Samples {
+			series[key] = true
+		}
+		interval.RUnlock()
+	}
+
+	for _, key := range []string{
+		"evmos.evm.tx.execution;tx_type=2",
+		"evmos.evm.state.commit",
+		"evmos.evm.vm_error.total;error=execution_reverted",
+		"evmos.evm.block.txs",
+		"evmos.feemarket.base_fee",
+		"evmos.feemarket.block_gas_wanted",
+		"evmos.feemarket.block_gas_used",
+	} {
+		suite.Require().True(series[key], "missing series %s, got %v", key, series)
+	}
+}
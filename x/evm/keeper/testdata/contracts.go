@@ -13,5 +13,5 @@ func LoadERC20Contract() (evmtypes.CompiledContract, error) {
 }
 
 func LoadMessageCallContract() (evmtypes.CompiledContract, error) {
-	return contractutils.LegacyLoadContractFromJSONFile("MessageCallContract.json")
+	return contractutils.LegacyLoadContractFromJSONFile("TestMessageCall.json")
 }
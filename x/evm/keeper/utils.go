@@ -6,7 +6,10 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v19/x/evm/types"
 )
 
@@ -15,3 +18,32 @@ func (k *Keeper) IsContract(ctx sdk.Context, addr common.Address) bool {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeHash)
 	return store.Has(addr.Bytes())
 }
+
+// GetAccountType classifies the account of the given address from its auth
+// account type and code, and returns whether the address is the address of a
+// module account. Module accounts and addresses blocked from receiving funds
+// take precedence over the other classifications.
+func (k *Keeper) GetAccountType(ctx sdk.Context, addr common.Address) (types.AccountType, bool) {
+	cosmosAddr := sdk.AccAddress(addr.Bytes())
+	acct := k.accountKeeper.GetAccount(ctx, cosmosAddr)
+	_, isModuleAccount := acct.(authtypes.ModuleAccountI)
+
+	switch {
+	case isModuleAccount || k.bankKeeper.BlockedAddr(cosmosAddr):
+		return types.AccountTypeModule, isModuleAccount
+	case acct == nil:
+		return types.AccountTypeUnspecified, false
+	case k.IsContract(ctx, addr):
+		return types.AccountTypeContract, false
+	}
+
+	if _, ok := acct.(vestingexported.VestingAccount); ok {
+		return types.AccountTypeVesting, false
+	}
+
+	if _, ok := acct.GetPubKey().(*ethsecp256k1.PubKey); ok {
+		return types.AccountTypeEOA, false
+	}
+
+	return types.AccountTypeBase, false
+}
@@ -70,7 +70,11 @@ func MigrateStore(
 		params.ExtraEIPs = append(params.ExtraEIPs, eipName)
 	}
 
-	if err := params.Validate(); err != nil {
+	// the precompile gas ratio is introduced after this version and set by the
+	// next migration, so the params are validated with its default value
+	validated := params
+	validated.PrecompileGasRatio = types.DefaultPrecompileGasRatio
+	if err := validated.Validate(); err != nil {
 		return err
 	}
 
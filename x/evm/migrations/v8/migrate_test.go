@@ -0,0 +1,76 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v8_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/encoding"
+	v8 "github.com/evmos/evmos/v19/x/evm/migrations/v8"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// lastV7ParamsField is the number of the last field of the params stored at
+// the consensus version 7.
+const lastV7ParamsField = 10
+
+// stripV8Fields returns the given encoded params without the fields introduced
+// after the consensus version 7, as stored by the chains at that version.
+func stripV8Fields(t *testing.T, bz []byte) []byte {
+	var stripped []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+
+		if num <= lastV7ParamsField {
+			stripped = append(stripped, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+	return stripped
+}
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	// Initialize the store
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_storekey")
+	ctx := testutil.DefaultContext(storeKey, tKey).WithBlockHeight(100)
+	kvStore := ctx.KVStore(storeKey)
+
+	// Create a pre migration environment with the params as stored at the
+	// consensus version 7
+	paramsV8 := types.DefaultParams()
+	kvStore.Set(types.KeyPrefixParams, stripV8Fields(t, cdc.MustMarshal(&paramsV8)))
+
+	var paramsV7 types.Params
+	cdc.MustUnmarshal(kvStore.Get(types.KeyPrefixParams), &paramsV7)
+	require.True(t, paramsV7.PrecompileGasRatio.IsNil())
+	require.Error(t, paramsV7.Validate())
+
+	err := v8.MigrateStore(ctx, storeKey, cdc)
+	require.NoError(t, err)
+
+	var params types.Params
+	cdc.MustUnmarshal(kvStore.Get(types.KeyPrefixParams), &params)
+
+	expParams := paramsV7
+	expParams.PrecompileGasRatio = types.DefaultPrecompileGasRatio
+	expParams.MaxTxBytes = types.DefaultMaxTxBytes
+	expParams.MaxCalldataBytes = types.DefaultMaxCalldataBytes
+	expParams.MaxLogsPerTx = types.DefaultMaxLogsPerTx
+	expParams.PostTxHooksActivationHeight = ctx.BlockHeight()
+	require.Equal(t, expParams, params)
+	require.NoError(t, params.Validate())
+}
@@ -16,6 +16,7 @@ type TxConfig struct {
 	TxHash    common.Hash // hash of current tx
 	TxIndex   uint        // the index of current transaction
 	LogIndex  uint        // the index of next log within current block
+	MaxLogs   uint64      // the maximum number of logs of current tx, zero for no limit
 }
 
 // NewTxConfig returns a TxConfig
@@ -46,4 +47,7 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// AccessPolicy is the access policy loaded for the transaction. It is
+	// loaded by NewEVM if nil.
+	AccessPolicy *types.AccessPolicyLists
 }
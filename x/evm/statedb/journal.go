@@ -143,6 +143,10 @@ type (
 		multiStore sdk.CacheMultiStore
 		events     sdk.Events
 	}
+	transientStorageChange struct {
+		account       *common.Address
+		key, prevalue common.Hash
+	}
 )
 
 var (
@@ -158,18 +162,11 @@ var (
 	_ JournalEntry = accessListAddAccountChange{}
 	_ JournalEntry = accessListAddSlotChange{}
 	_ JournalEntry = precompileCallChange{}
+	_ JournalEntry = transientStorageChange{}
 )
 
 func (pc precompileCallChange) Revert(s *StateDB) {
-	// rollback multi store from cache ctx to the previous
-	// state stored in the snapshot
-	s.cacheCtx = s.cacheCtx.WithMultiStore(pc.multiStore)
-	s.writeCache = func() {
-		// rollback the events to the ones snapshot
-		// on the snapshot
-		s.ctx.EventManager().EmitEvents(pc.events)
-		pc.multiStore.Write()
-	}
+	s.RevertMultiStore(pc.multiStore, pc.events)
 }
 
 func (pc precompileCallChange) Dirtied() *common.Address {
@@ -236,6 +233,14 @@ func (ch storageChange) Dirtied() *common.Address {
 	return ch.account
 }
 
+func (ch transientStorageChange) Revert(s *StateDB) {
+	s.setTransientState(*ch.account, ch.key, ch.prevalue)
+}
+
+func (ch transientStorageChange) Dirtied() *common.Address {
+	return nil
+}
+
 func (ch refundChange) Revert(s *StateDB) {
 	s.refund = ch.prev
 }
@@ -51,10 +51,14 @@ func (k MockKeeper) GetCode(_ sdk.Context, codeHash common.Hash) []byte {
 	return k.codes[codeHash]
 }
 
+func (k MockKeeper) GetParams(_ sdk.Context) types.Params {
+	return types.DefaultParams()
+}
+
 func (k MockKeeper) ForEachStorage(_ sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
 	if acct, ok := k.accounts[addr]; ok {
-		for k, v := range acct.states {
-			if !cb(k, v) {
+		for _, k := range acct.states.SortedKeys() {
+			if !cb(k, acct.states[k]) {
 				return
 			}
 		}
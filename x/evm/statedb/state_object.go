@@ -64,6 +64,11 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	// created is true if the object was created in the current transaction,
+	// in which case its committed storage is empty. The keeper may still hold
+	// the storage of a contract self-destructed at the same address that is
+	// not fully pruned yet.
+	created bool
 }
 
 // newObject creates a state object.
@@ -218,6 +223,9 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.created {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
@@ -56,10 +56,17 @@ type StateDB struct {
 
 	// Per-transaction logs
 	logs []*ethtypes.Log
+	// maxLogsExceeded is set once a log is rejected for exceeding the maximum
+	// number of logs of the transaction. It is not reverted with the journal,
+	// so that the whole transaction fails.
+	maxLogsExceeded bool
 
 	// Per-transaction access list
 	accessList *accessList
 
+	// Per-transaction transient storage
+	transientStorage transientStorage
+
 	// The count of calls to precompiles
 	precompileCallsCounter uint8
 }
@@ -73,6 +80,8 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 		journal:      newJournal(),
 		accessList:   newAccessList(),
 
+		transientStorage: newTransientStorage(),
+
 		txConfig: txConfig,
 	}
 }
@@ -114,6 +123,21 @@ func (s *StateDB) MultiStoreSnapshot() sdk.CacheMultiStore {
 	return snapshot
 }
 
+// RevertMultiStore rolls back the cache ctx multi store and events to the
+// given snapshot, discarding all the changes performed on the cache ctx
+// after the snapshot was taken.
+func (s *StateDB) RevertMultiStore(cms sdk.CacheMultiStore, events sdk.Events) {
+	// rollback multi store from cache ctx to the previous
+	// state stored in the snapshot
+	s.cacheCtx = s.cacheCtx.WithMultiStore(cms)
+	s.writeCache = func() {
+		// rollback the events to the ones snapshot
+		// on the snapshot
+		s.ctx.EventManager().EmitEvents(events)
+		cms.Write()
+	}
+}
+
 // cache creates the stateDB cache context
 func (s *StateDB) cache() error {
 	if s.ctx.MultiStore() == nil {
@@ -123,8 +147,14 @@ func (s *StateDB) cache() error {
 	return nil
 }
 
-// AddLog adds a log, called by evm.
+// AddLog adds a log, called by evm. The log is rejected once the maximum
+// number of logs of the transaction is reached.
 func (s *StateDB) AddLog(log *ethtypes.Log) {
+	if s.txConfig.MaxLogs > 0 && uint64(len(s.logs)) >= s.txConfig.MaxLogs {
+		s.maxLogsExceeded = true
+		return
+	}
+
 	s.journal.append(addLogChange{})
 
 	log.TxHash = s.txConfig.TxHash
@@ -134,6 +164,12 @@ func (s *StateDB) AddLog(log *ethtypes.Log) {
 	s.logs = append(s.logs, log)
 }
 
+// MaxLogsExceeded returns true once a log was rejected for exceeding the
+// maximum number of logs of the transaction.
+func (s *StateDB) MaxLogsExceeded() bool {
+	return s.maxLogsExceeded
+}
+
 // Logs returns the logs of current transaction.
 func (s *StateDB) Logs() []*ethtypes.Log {
 	return s.logs
@@ -285,6 +321,7 @@ func (s *StateDB) createObject(addr common.Address) (newobj, prev *stateObject)
 	prev = s.getStateObject(addr)
 
 	newobj = newObject(s, addr, Account{})
+	newobj.created = true
 	if prev == nil {
 		s.journal.append(createObjectChange{account: &addr})
 	} else {
@@ -314,20 +351,19 @@ func (s *StateDB) CreateAccount(addr common.Address) {
 	}
 }
 
-// ForEachStorage iterate the contract storage, the iteration order is not defined.
+// ForEachStorage iterates over the committed contract storage in ascending key
+// order, overriding the values of the slots modified in the current transaction.
+// The callback returns false to break early.
 func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.Hash) bool) error {
 	so := s.getStateObject(addr)
-	if so == nil {
+	if so == nil || so.created {
 		return nil
 	}
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
-		if value, dirty := so.dirtyStorage[key]; dirty {
-			return cb(key, value)
-		}
-		if len(value) > 0 {
-			return cb(key, value)
+		if dirtyValue, dirty := so.dirtyStorage[key]; dirty {
+			return cb(key, dirtyValue)
 		}
-		return true
+		return cb(key, value)
 	})
 	return nil
 }
@@ -396,6 +432,35 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetTransientState sets transient storage for a given account. It
+// adds the change to the journal so that it can be rolled back
+// to its previous value if there is a revert.
+func (s *StateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	prev := s.GetTransientState(addr, key)
+	if prev == value {
+		return
+	}
+
+	s.journal.append(transientStorageChange{
+		account:  &addr,
+		key:      key,
+		prevalue: prev,
+	})
+
+	s.setTransientState(addr, key, value)
+}
+
+// setTransientState is a lower level setter for transient storage. It
+// is called during a revert to prevent modifications to the journal.
+func (s *StateDB) setTransientState(addr common.Address, key, value common.Hash) {
+	s.transientStorage.Set(addr, key, value)
+}
+
+// GetTransientState gets transient storage for a given account.
+func (s *StateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transientStorage.Get(addr, key)
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -424,9 +489,12 @@ func (s *StateDB) Suicide(addr common.Address) bool {
 // - Add destination to access list (2929)
 // - Add precompiles to access list (2929)
 // - Add the contents of the optional tx access list (2930)
+// - Reset the transient storage (1153)
 //
 // This method should only be called if Yolov3/Berlin/2929+2930 is applicable at the current number.
 func (s *StateDB) PrepareAccessList(sender common.Address, dst *common.Address, precompiles []common.Address, list ethtypes.AccessList) {
+	s.transientStorage = newTransientStorage()
+
 	s.AddAddressToAccessList(sender)
 	if dst != nil {
 		s.AddAddressToAccessList(*dst)
@@ -510,7 +578,15 @@ func (s *StateDB) Commit() error {
 	if s.writeCache != nil {
 		s.writeCache()
 	}
-	return s.commitWithCtx(s.ctx)
+	if err := s.commitWithCtx(s.ctx); err != nil {
+		return err
+	}
+
+	// the storage of the created objects is committed to the keeper
+	for _, obj := range s.stateObjects {
+		obj.created = false
+	}
+	return nil
 }
 
 // CommitWithCacheCtx writes the dirty states to keeper using the cacheCtx.
@@ -466,6 +466,48 @@ func (suite *StateDBTestSuite) TestAccessList() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	testCases := []struct {
+		name     string
+		malleate func(vm.StateDB)
+	}{
+		{"set and get", func(db vm.StateDB) {
+			suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+			db.SetTransientState(address, key, value1)
+			suite.Require().Equal(value1, db.GetTransientState(address, key))
+			// transient storage is scoped by address
+			suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+			// transient storage is not written to the contract storage
+			suite.Require().Equal(common.Hash{}, db.GetState(address, key))
+		}},
+		{"revert to snapshot", func(db vm.StateDB) {
+			db.SetTransientState(address, key, value1)
+			rev := db.Snapshot()
+			db.SetTransientState(address, key, value2)
+			db.SetTransientState(address2, key, value2)
+			suite.Require().Equal(value2, db.GetTransientState(address, key))
+
+			db.RevertToSnapshot(rev)
+			suite.Require().Equal(value1, db.GetTransientState(address, key))
+			suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+		}},
+		{"reset by prepare access list", func(db vm.StateDB) {
+			db.SetTransientState(address, key, value1)
+			db.PrepareAccessList(address, &address2, vm.PrecompiledAddressesBerlin, nil)
+			suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+		}},
+	}
+
+	for _, tc := range testCases {
+		db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
+		tc.malleate(db)
+	}
+}
+
 func (suite *StateDBTestSuite) TestLog() {
 	txHash := common.BytesToHash([]byte("tx"))
 	// use a non-default tx config
@@ -504,6 +546,29 @@ func (suite *StateDBTestSuite) TestLog() {
 	suite.Require().Equal(2, len(db.Logs()))
 	expecedLog.Index++
 	suite.Require().Equal(expecedLog, db.Logs()[1])
+	suite.Require().False(db.MaxLogsExceeded())
+}
+
+func (suite *StateDBTestSuite) TestMaxLogs() {
+	txConfig := emptyTxConfig
+	txConfig.MaxLogs = 2
+	db := statedb.New(sdk.Context{}, NewMockKeeper(), txConfig)
+	snapshot := db.Snapshot()
+
+	for i := 0; i < 2; i++ {
+		db.AddLog(&ethtypes.Log{Address: address})
+		suite.Require().False(db.MaxLogsExceeded())
+	}
+
+	// the log above the limit is rejected
+	db.AddLog(&ethtypes.Log{Address: address})
+	suite.Require().True(db.MaxLogsExceeded())
+	suite.Require().Len(db.Logs(), 2)
+
+	// the limit remains exceeded once the logs are reverted
+	db.RevertToSnapshot(snapshot)
+	suite.Require().Empty(db.Logs())
+	suite.Require().True(db.MaxLogsExceeded())
 }
 
 func (suite *StateDBTestSuite) TestRefund() {
@@ -0,0 +1,123 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Validate checks that the lists of the access policy only hold distinct hex
+// addresses and that no address is both allowed and denied.
+func (p AccessPolicy) Validate() error {
+	if err := validateAddresses("deployer allowlist", p.DeployerAllowlist); err != nil {
+		return err
+	}
+	if err := validateAddresses("deployer denylist", p.DeployerDenylist); err != nil {
+		return err
+	}
+	if err := validateAddresses("contract allowlist", p.ContractAllowlist); err != nil {
+		return err
+	}
+	if err := validateAddresses("contract denylist", p.ContractDenylist); err != nil {
+		return err
+	}
+
+	if err := validateDisjoint("deployer", p.DeployerAllowlist, p.DeployerDenylist); err != nil {
+		return err
+	}
+	return validateDisjoint("contract", p.ContractAllowlist, p.ContractDenylist)
+}
+
+// validateDisjoint returns an error if an address is both in the given
+// allowlist and denylist.
+func validateDisjoint(kind string, allowlist, denylist []string) error {
+	allowed := make(map[common.Address]struct{}, len(allowlist))
+	for _, address := range allowlist {
+		allowed[common.HexToAddress(address)] = struct{}{}
+	}
+
+	for _, address := range denylist {
+		if _, ok := allowed[common.HexToAddress(address)]; ok {
+			return fmt.Errorf("%s address %s is both allowed and denied", kind, address)
+		}
+	}
+	return nil
+}
+
+// AccessPolicyLists holds the lists of an access policy indexed by address, so
+// that the policy is loaded from the store once per transaction.
+//
+// The access policy complements the AccessControl params, which restrict the
+// callers allowed to create or call contracts, while the policy restricts the
+// deployers and the called contracts. Both are enforced and neither takes
+// precedence: an operation is rejected as soon as one of them denies it, so an
+// address allowed by the policy is still subject to the AccessControl params,
+// and the other way around.
+type AccessPolicyLists struct {
+	deployerAllowlist map[common.Address]struct{}
+	deployerDenylist  map[common.Address]struct{}
+	contractAllowlist map[common.Address]struct{}
+	contractDenylist  map[common.Address]struct{}
+}
+
+// NewAccessPolicyLists indexes the lists of the given access policy.
+func NewAccessPolicyLists(policy AccessPolicy) *AccessPolicyLists {
+	return &AccessPolicyLists{
+		deployerAllowlist: addressSet(policy.DeployerAllowlist),
+		deployerDenylist:  addressSet(policy.DeployerDenylist),
+		contractAllowlist: addressSet(policy.ContractAllowlist),
+		contractDenylist:  addressSet(policy.ContractDenylist),
+	}
+}
+
+// RestrictsDeployers returns true if the policy restricts the deployers.
+func (l *AccessPolicyLists) RestrictsDeployers() bool {
+	return len(l.deployerAllowlist) > 0 || len(l.deployerDenylist) > 0
+}
+
+// RestrictsContracts returns true if the policy restricts the called contracts.
+func (l *AccessPolicyLists) RestrictsContracts() bool {
+	return len(l.contractAllowlist) > 0 || len(l.contractDenylist) > 0
+}
+
+// CanDeploy returns true if neither the signer nor the caller is denied and,
+// if the allowlist is not empty, one of them is allowed.
+func (l *AccessPolicyLists) CanDeploy(signer, caller common.Address) bool {
+	if contains(l.deployerDenylist, signer) || contains(l.deployerDenylist, caller) {
+		return false
+	}
+	if len(l.deployerAllowlist) == 0 {
+		return true
+	}
+	return contains(l.deployerAllowlist, signer) || contains(l.deployerAllowlist, caller)
+}
+
+// CanCall returns true if the recipient is not denied and, if it is a contract
+// and the allowlist is not empty, it is allowed. The allowlist doesn't apply
+// to the accounts without code so that the value transfers are not restricted.
+func (l *AccessPolicyLists) CanCall(recipient common.Address, isContract bool) bool {
+	if contains(l.contractDenylist, recipient) {
+		return false
+	}
+	if !isContract || len(l.contractAllowlist) == 0 {
+		return true
+	}
+	return contains(l.contractAllowlist, recipient)
+}
+
+// addressSet returns the set of the given hex addresses.
+func addressSet(addresses []string) map[common.Address]struct{} {
+	set := make(map[common.Address]struct{}, len(addresses))
+	for _, address := range addresses {
+		set[common.HexToAddress(address)] = struct{}{}
+	}
+	return set
+}
+
+// contains returns true if the given set holds the address.
+func contains(set map[common.Address]struct{}, address common.Address) bool {
+	_, ok := set[address]
+	return ok
+}
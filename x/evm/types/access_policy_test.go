@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func TestAccessPolicyValidate(t *testing.T) {
+	addr := utiltx.GenerateAddress().Hex()
+	other := utiltx.GenerateAddress().Hex()
+
+	testCases := []struct {
+		name     string
+		policy   types.AccessPolicy
+		expError bool
+	}{
+		{"empty", types.AccessPolicy{}, false},
+		{
+			"valid",
+			types.AccessPolicy{
+				DeployerAllowlist: []string{addr},
+				DeployerDenylist:  []string{other},
+				ContractAllowlist: []string{other},
+				ContractDenylist:  []string{addr},
+			},
+			false,
+		},
+		{"invalid address", types.AccessPolicy{DeployerDenylist: []string{"0x1234"}}, true},
+		{"duplicate address", types.AccessPolicy{ContractAllowlist: []string{addr, strings.ToLower(addr)}}, true},
+		{"allowed and denied deployer", types.AccessPolicy{DeployerAllowlist: []string{addr}, DeployerDenylist: []string{addr}}, true},
+		{"allowed and denied contract", types.AccessPolicy{ContractAllowlist: []string{addr}, ContractDenylist: []string{addr}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.policy.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockOverrides represents the header fields to override when executing a
+// message call using JSON-RPC.
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.11.0/internal/ethapi/api.go
+type BlockOverrides struct {
+	Number  *hexutil.Big    `json:"number"`
+	Time    *hexutil.Uint64 `json:"time"`
+	BaseFee *hexutil.Big    `json:"baseFee"`
+}
+
+// Validate performs a stateless validation of the block overrides.
+func (o BlockOverrides) Validate() error {
+	if o.Number != nil {
+		number := o.Number.ToInt()
+		if !number.IsInt64() || number.Sign() <= 0 {
+			return errors.New("block number override must be a positive 64-bit integer")
+		}
+	}
+
+	if o.Time != nil && uint64(*o.Time) > math.MaxInt64 {
+		return errors.New("block time override overflows int64")
+	}
+
+	if o.BaseFee != nil && o.BaseFee.ToInt().Sign() < 0 {
+		return errors.New("base fee override cannot be negative")
+	}
+
+	return nil
+}
+
+// Apply overrides the block number and time of the context. The base fee
+// override is applied to the EVM configuration by the caller.
+func (o BlockOverrides) Apply(ctx sdk.Context) sdk.Context {
+	if o.Number != nil {
+		ctx = ctx.WithBlockHeight(o.Number.ToInt().Int64())
+	}
+
+	if o.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*o.Time), 0).UTC())
+	}
+
+	return ctx
+}
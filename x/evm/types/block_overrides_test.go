@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func TestBlockOverridesValidate(t *testing.T) {
+	maxTime := hexutil.Uint64(math.MaxUint64)
+
+	testCases := []struct {
+		name      string
+		overrides types.BlockOverrides
+		expError  bool
+	}{
+		{"empty", types.BlockOverrides{}, false},
+		{"valid", types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(10)), BaseFee: (*hexutil.Big)(big.NewInt(0))}, false},
+		{"zero number", types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(0))}, true},
+		{"number overflow", types.BlockOverrides{Number: (*hexutil.Big)(new(big.Int).Lsh(big.NewInt(1), 64))}, true},
+		{"time overflow", types.BlockOverrides{Time: &maxTime}, true},
+		{"negative base fee", types.BlockOverrides{BaseFee: (*hexutil.Big)(big.NewInt(-1))}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.overrides.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestBlockOverridesApply(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeight(5).WithBlockTime(time.Unix(10, 0))
+
+	require.Equal(t, ctx.BlockHeight(), types.BlockOverrides{}.Apply(ctx).BlockHeight())
+
+	blockTime := hexutil.Uint64(20)
+	ctx = types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(7)), Time: &blockTime}.Apply(ctx)
+	require.Equal(t, int64(7), ctx.BlockHeight())
+	require.Equal(t, int64(20), ctx.BlockTime().Unix())
+}
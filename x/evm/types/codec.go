@@ -25,7 +25,11 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	ethereumTxName           = "ethermint/MsgEthereumTx"
+	updateParamsName         = "ethermint/MsgUpdateParams"
+	migrateAccountName       = "ethermint/MsgMigrateAccount"
+	deploySystemContractName = "ethermint/MsgDeploySystemContract"
+	updateAccessPolicyName   = "ethermint/MsgUpdateAccessPolicy"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,6 +48,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgMigrateAccount{},
+		&MsgDeploySystemContract{},
+		&MsgUpdateAccessPolicy{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -90,5 +97,9 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgEthereumTx{}, ethereumTxName, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgMigrateAccount{}, migrateAccountName, nil)
+	cdc.RegisterConcrete(&MsgDeploySystemContract{}, deploySystemContractName, nil)
+	cdc.RegisterConcrete(&MsgUpdateAccessPolicy{}, updateAccessPolicyName, nil)
 }
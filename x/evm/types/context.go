@@ -0,0 +1,25 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// simulationContextKey is the context key that flags the execution of a
+// transaction simulation.
+type simulationContextKey struct{}
+
+// WithSimulation returns a copy of the context flagged as the execution of a
+// transaction simulation. It is set by the AnteHandler so that the EVM message
+// execution can tell a simulation apart from a CheckTx.
+func WithSimulation(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(simulationContextKey{}, true)
+}
+
+// IsSimulation returns true if the context is flagged as the execution of a
+// transaction simulation.
+func IsSimulation(ctx sdk.Context) bool {
+	simulate, _ := ctx.Value(simulationContextKey{}).(bool)
+	return simulate
+}
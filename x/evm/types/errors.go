@@ -32,6 +32,14 @@ const (
 	codeErrInactivePrecompile
 	codeErrABIPack
 	codeErrABIUnpack
+	codeErrUnprotectedTx
+	codeErrOversizedData
+	codeErrMaxInitCodeSizeExceeded
+	codeErrInvalidMigration
+	codeErrInvalidSystemContract
+	codeErrPaymasterRejected
+	codeErrAccessPolicyViolation
+	codeErrPostTxProcessing
 )
 
 var (
@@ -88,6 +96,38 @@ var (
 
 	// ErrABIUnpack returns an error if the contract ABI unpacking fails
 	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
+
+	// ErrUnprotectedTx returns an error if a non EIP-155 signed transaction is submitted while
+	// the AllowUnprotectedTxs parameter is disabled
+	ErrUnprotectedTx = errorsmod.Register(ModuleName, codeErrUnprotectedTx, "unprotected transactions not allowed")
+
+	// ErrOversizedData returns an error if the transaction or its data exceed the size limits
+	// defined on the EVM parameters
+	ErrOversizedData = errorsmod.Register(ModuleName, codeErrOversizedData, "oversized data")
+
+	// ErrMaxInitCodeSizeExceeded returns an error if the init code of a contract creation
+	// exceeds the EIP-3860 limit
+	ErrMaxInitCodeSizeExceeded = errorsmod.Register(ModuleName, codeErrMaxInitCodeSizeExceeded, "max initcode size exceeded")
+
+	// ErrInvalidMigration returns an error if an account can't be migrated to
+	// an Ethereum account
+	ErrInvalidMigration = errorsmod.Register(ModuleName, codeErrInvalidMigration, "invalid account migration")
+
+	// ErrInvalidSystemContract returns an error if a system contract can't be
+	// installed at the given address
+	ErrInvalidSystemContract = errorsmod.Register(ModuleName, codeErrInvalidSystemContract, "invalid system contract")
+
+	// ErrPaymasterRejected returns an error if a paymaster doesn't sponsor the
+	// fees of a transaction designating it
+	ErrPaymasterRejected = errorsmod.Register(ModuleName, codeErrPaymasterRejected, "paymaster rejected the transaction")
+
+	// ErrAccessPolicyViolation returns an error if a contract deployment or
+	// call is not allowed by the access policy
+	ErrAccessPolicyViolation = errorsmod.Register(ModuleName, codeErrAccessPolicyViolation, "access policy violation")
+
+	// ErrPostTxProcessing returns an error if a hook fails to process a
+	// transaction and requires it to be reverted
+	ErrPostTxProcessing = errorsmod.Register(ModuleName, codeErrPostTxProcessing, "failed to execute post processing")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -8,6 +8,9 @@ const (
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
 
+	EventTypeMigrateAccount       = "migrate_account"
+	EventTypeDeploySystemContract = "deploy_system_contract"
+
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
 	AttributeKeyTxHash          = "txHash"
@@ -16,10 +19,16 @@ const (
 	AttributeKeyTxGasUsed       = "txGasUsed"
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
+	// account paying the fees of the tx, if it is not the sender
+	AttributeKeyFeePayer = "fee_payer"
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeyMigratedFrom     = "from"
+	AttributeKeyMigratedTo       = "to"
+	AttributeKeyCodeHash         = "code_hash"
+	AttributeKeyLabel            = "label"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
@@ -288,10 +288,10 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/events.proto", fileDescriptor_432e0d592184bde3) }
 
 var fileDescriptor_432e0d592184bde3 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
+	// 362 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x51, 0x4d, 0x6b, 0xdb, 0x40,
 	0x14, 0xb4, 0x6a, 0x5b, 0xae, 0xb7, 0x2d, 0x2d, 0x4b, 0x69, 0x55, 0x68, 0x85, 0x11, 0x34, 0xc9,
-	0x49, 0xc2, 0xe4, 0x92, 0x63, 0x30, 0x38, 0xe4, 0x90, 0x5c, 0x82, 0x42, 0x20, 0x17, 0x21, 0x5b,
+	0x49, 0xc2, 0xe4, 0x94, 0x5b, 0x30, 0x38, 0xe4, 0x90, 0x5c, 0x82, 0x42, 0x20, 0x17, 0x21, 0x5b,
 	0x2f, 0x5a, 0x11, 0xad, 0x56, 0x68, 0x9f, 0xc4, 0xfa, 0x5f, 0xe4, 0x67, 0x05, 0x72, 0xf1, 0x31,
 	0xc7, 0x60, 0xff, 0x91, 0xb0, 0xab, 0x4d, 0x72, 0x59, 0x76, 0x66, 0xde, 0x07, 0xf3, 0x86, 0xfc,
 	0x03, 0x64, 0xd0, 0xf0, 0xa2, 0xc2, 0x08, 0x3a, 0x1e, 0x75, 0xf3, 0x08, 0x3a, 0xa8, 0x50, 0x86,
@@ -311,7 +311,7 @@ var fileDescriptor_432e0d592184bde3 = []byte{
 	0x7d, 0xdc, 0xf9, 0xce, 0x76, 0xe7, 0x3b, 0x2f, 0x3b, 0xdf, 0x79, 0xd8, 0xfb, 0x83, 0xed, 0xde,
 	0x1f, 0x3c, 0xef, 0xfd, 0xc1, 0xed, 0x41, 0x5e, 0x20, 0x6b, 0x57, 0xe1, 0x5a, 0x70, 0x1d, 0xa1,
 	0x90, 0xf6, 0xed, 0xe6, 0x27, 0x91, 0x32, 0xa1, 0xea, 0xbd, 0x72, 0xe5, 0x9a, 0x44, 0x8f, 0x5f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x28, 0x90, 0xca, 0xca, 0xf2, 0x01, 0x00, 0x00,
+	0x07, 0x00, 0xb6, 0x13, 0x10, 0x55, 0xf2, 0x01, 0x00, 0x00,
 }
 
 func (m *EventEthereumTx) Marshal() (dAtA []byte, err error) {
@@ -79,6 +79,55 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the precompiled
 	// contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,10,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// precompile_gas_ratio defines the ratio used to convert the Cosmos gas consumed
+	// during a stateful precompile call into EVM gas
+	PrecompileGasRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=precompile_gas_ratio,json=precompileGasRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"precompile_gas_ratio"`
+	// max_tx_bytes defines the maximum size in bytes of an encoded transaction
+	// containing Ethereum messages. Zero disables the limit.
+	MaxTxBytes uint64 `protobuf:"varint,12,opt,name=max_tx_bytes,json=maxTxBytes,proto3" json:"max_tx_bytes,omitempty"`
+	// max_calldata_bytes defines the maximum size in bytes of the data of an
+	// Ethereum transaction. Zero disables the limit.
+	MaxCalldataBytes uint64 `protobuf:"varint,13,opt,name=max_calldata_bytes,json=maxCalldataBytes,proto3" json:"max_calldata_bytes,omitempty"`
+	// opcode_gas_overrides defines the constant gas costs that replace the
+	// default ones of the listed opcodes once the activation height is reached
+	OpcodeGasOverrides []OpcodeGasOverride `protobuf:"bytes,14,rep,name=opcode_gas_overrides,json=opcodeGasOverrides,proto3" json:"opcode_gas_overrides"`
+	// opcode_gas_overrides_activation_height defines the block height from which
+	// the opcode gas overrides are applied
+	OpcodeGasOverridesActivationHeight int64 `protobuf:"varint,15,opt,name=opcode_gas_overrides_activation_height,json=opcodeGasOverridesActivationHeight,proto3" json:"opcode_gas_overrides_activation_height,omitempty"`
+	// contract_gas_epoch_blocks defines the number of blocks of the epochs in
+	// which the gas used by the Ethereum txs is accumulated per called contract.
+	// Zero disables the contract gas accounting.
+	ContractGasEpochBlocks uint64 `protobuf:"varint,16,opt,name=contract_gas_epoch_blocks,json=contractGasEpochBlocks,proto3" json:"contract_gas_epoch_blocks,omitempty"`
+	// contract_gas_retained_epochs defines the number of epochs, including the
+	// current one, for which the contract gas usage is kept
+	ContractGasRetainedEpochs uint64 `protobuf:"varint,17,opt,name=contract_gas_retained_epochs,json=contractGasRetainedEpochs,proto3" json:"contract_gas_retained_epochs,omitempty"`
+	// blocked_addresses defines the hex addresses that cannot send or receive
+	// value in the EVM. The calls transferring value from or to them fail with
+	// an error, while the reads and the zero-value calls are still allowed.
+	BlockedAddresses []string `protobuf:"bytes,18,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty"`
+	// banned_opcodes defines the names of the opcodes (e.g. SELFDESTRUCT) that
+	// cannot be part of the runtime code of the contracts created from now on.
+	// The push data is skipped when scanning the code, while the existing
+	// contracts are left unaffected.
+	BannedOpcodes []string `protobuf:"bytes,19,rep,name=banned_opcodes,json=bannedOpcodes,proto3" json:"banned_opcodes,omitempty"`
+	// block_hash_retention defines the number of recent block hashes kept in
+	// the store for the BLOCKHASH opcode. It must cover the 256 blocks window
+	// of Ethereum, and zero uses that window.
+	BlockHashRetention uint64 `protobuf:"varint,20,opt,name=block_hash_retention,json=blockHashRetention,proto3" json:"block_hash_retention,omitempty"`
+	// max_logs_per_tx defines the maximum number of logs emitted by an Ethereum
+	// transaction, including the logs of the precompiles. The transaction fails
+	// and consumes all its gas once the limit is exceeded. Zero disables the
+	// limit.
+	MaxLogsPerTx uint64 `protobuf:"varint,21,opt,name=max_logs_per_tx,json=maxLogsPerTx,proto3" json:"max_logs_per_tx,omitempty"`
+	// paymasters defines the hex addresses of the contracts that can sponsor
+	// the fees of the Ethereum transactions designating them as the first entry
+	// of their access list, once they accept to through their
+	// validatePaymasterUserOp method.
+	Paymasters []string `protobuf:"bytes,22,rep,name=paymasters,proto3" json:"paymasters,omitempty"`
+	// post_tx_hooks_activation_height defines the block height from which the
+	// module hooks run after the successful Ethereum transactions, with the gas
+	// they consume charged to the transactions. Zero disables the hooks.
+	PostTxHooksActivationHeight int64 `protobuf:"varint,23,opt,name=post_tx_hooks_activation_height,json=postTxHooksActivationHeight,proto3" json:"post_tx_hooks_activation_height,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -168,6 +217,152 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetMaxTxBytes() uint64 {
+	if m != nil {
+		return m.MaxTxBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCalldataBytes() uint64 {
+	if m != nil {
+		return m.MaxCalldataBytes
+	}
+	return 0
+}
+
+func (m *Params) GetOpcodeGasOverrides() []OpcodeGasOverride {
+	if m != nil {
+		return m.OpcodeGasOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetOpcodeGasOverridesActivationHeight() int64 {
+	if m != nil {
+		return m.OpcodeGasOverridesActivationHeight
+	}
+	return 0
+}
+
+func (m *Params) GetContractGasEpochBlocks() uint64 {
+	if m != nil {
+		return m.ContractGasEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetContractGasRetainedEpochs() uint64 {
+	if m != nil {
+		return m.ContractGasRetainedEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetBannedOpcodes() []string {
+	if m != nil {
+		return m.BannedOpcodes
+	}
+	return nil
+}
+
+func (m *Params) GetBlockHashRetention() uint64 {
+	if m != nil {
+		return m.BlockHashRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLogsPerTx() uint64 {
+	if m != nil {
+		return m.MaxLogsPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetPaymasters() []string {
+	if m != nil {
+		return m.Paymasters
+	}
+	return nil
+}
+
+func (m *Params) GetPostTxHooksActivationHeight() int64 {
+	if m != nil {
+		return m.PostTxHooksActivationHeight
+	}
+	return 0
+}
+
+// OpcodeGasOverride defines the constant gas cost charged for an EVM opcode
+// instead of its default cost. Dynamic costs (e.g. memory expansion or cold
+// storage access) are still charged on top of it.
+type OpcodeGasOverride struct {
+	// opcode is the name of the opcode (e.g. SLOAD)
+	Opcode string `protobuf:"bytes,1,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	// gas is the constant gas cost of the opcode
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *OpcodeGasOverride) Reset()         { *m = OpcodeGasOverride{} }
+func (m *OpcodeGasOverride) String() string { return proto.CompactTextString(m) }
+func (*OpcodeGasOverride) ProtoMessage()    {}
+func (*OpcodeGasOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+
+func (m *OpcodeGasOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *OpcodeGasOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpcodeGasOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *OpcodeGasOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpcodeGasOverride.Merge(m, src)
+}
+
+func (m *OpcodeGasOverride) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *OpcodeGasOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpcodeGasOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpcodeGasOverride proto.InternalMessageInfo
+
+func (m *OpcodeGasOverride) GetOpcode() string {
+	if m != nil {
+		return m.Opcode
+	}
+	return ""
+}
+
+func (m *OpcodeGasOverride) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -181,7 +376,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
 }
 
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
@@ -244,7 +439,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
 }
 
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
@@ -292,6 +487,90 @@ func (m *AccessControlType) GetAccessControlList() []string {
 	return nil
 }
 
+// AccessPolicy defines the addresses allowed or denied to deploy contracts and
+// the contracts allowed or denied to be called, on top of the access control of
+// the params. An empty allowlist doesn't restrict the operation.
+type AccessPolicy struct {
+	// deployer_allowlist defines the hex addresses that are the only ones allowed
+	// to deploy contracts, as signer or caller of the creation, if not empty
+	DeployerAllowlist []string `protobuf:"bytes,1,rep,name=deployer_allowlist,json=deployerAllowlist,proto3" json:"deployer_allowlist,omitempty"`
+	// deployer_denylist defines the hex addresses that can't deploy contracts, as
+	// signer or caller of the creation
+	DeployerDenylist []string `protobuf:"bytes,2,rep,name=deployer_denylist,json=deployerDenylist,proto3" json:"deployer_denylist,omitempty"`
+	// contract_allowlist defines the hex addresses of the only contracts that can
+	// be called, if not empty
+	ContractAllowlist []string `protobuf:"bytes,3,rep,name=contract_allowlist,json=contractAllowlist,proto3" json:"contract_allowlist,omitempty"`
+	// contract_denylist defines the hex addresses of the contracts that can't be
+	// called
+	ContractDenylist []string `protobuf:"bytes,4,rep,name=contract_denylist,json=contractDenylist,proto3" json:"contract_denylist,omitempty"`
+}
+
+func (m *AccessPolicy) Reset()         { *m = AccessPolicy{} }
+func (m *AccessPolicy) String() string { return proto.CompactTextString(m) }
+func (*AccessPolicy) ProtoMessage()    {}
+func (*AccessPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+}
+
+func (m *AccessPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AccessPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AccessPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessPolicy.Merge(m, src)
+}
+
+func (m *AccessPolicy) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AccessPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessPolicy proto.InternalMessageInfo
+
+func (m *AccessPolicy) GetDeployerAllowlist() []string {
+	if m != nil {
+		return m.DeployerAllowlist
+	}
+	return nil
+}
+
+func (m *AccessPolicy) GetDeployerDenylist() []string {
+	if m != nil {
+		return m.DeployerDenylist
+	}
+	return nil
+}
+
+func (m *AccessPolicy) GetContractAllowlist() []string {
+	if m != nil {
+		return m.ContractAllowlist
+	}
+	return nil
+}
+
+func (m *AccessPolicy) GetContractDenylist() []string {
+	if m != nil {
+		return m.ContractDenylist
+	}
+	return nil
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -340,7 +619,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
@@ -400,7 +679,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 
 func (m *State) XXX_Unmarshal(b []byte) error {
@@ -462,7 +741,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
@@ -543,7 +822,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 
 func (m *Log) XXX_Unmarshal(b []byte) error {
@@ -663,7 +942,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
@@ -709,7 +988,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{8}
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
 }
 
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
@@ -774,7 +1053,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{9}
+	return fileDescriptor_d21ecc92c8c8583e, []int{11}
 }
 
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
@@ -885,11 +1164,92 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+// SystemContract is the provenance of a contract installed through a
+// MsgDeploySystemContract governance message.
+type SystemContract struct {
+	// address is the hex address of the contract.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// code_hash is the hex hash of the installed code.
+	CodeHash string `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// label is the human readable description of the contract.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// height is the block height at which the contract was installed.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SystemContract) Reset()         { *m = SystemContract{} }
+func (m *SystemContract) String() string { return proto.CompactTextString(m) }
+func (*SystemContract) ProtoMessage()    {}
+func (*SystemContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{12}
+}
+
+func (m *SystemContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SystemContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SystemContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SystemContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemContract.Merge(m, src)
+}
+
+func (m *SystemContract) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SystemContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemContract proto.InternalMessageInfo
+
+func (m *SystemContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SystemContract) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *SystemContract) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *SystemContract) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ethermint.evm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*OpcodeGasOverride)(nil), "ethermint.evm.v1.OpcodeGasOverride")
 	proto.RegisterType((*AccessControl)(nil), "ethermint.evm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "ethermint.evm.v1.AccessControlType")
+	proto.RegisterType((*AccessPolicy)(nil), "ethermint.evm.v1.AccessPolicy")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
@@ -897,130 +1257,161 @@ func init() {
 	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+	proto.RegisterType((*SystemContract)(nil), "ethermint.evm.v1.SystemContract")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1882 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x5b, 0x6f, 0xe3, 0xc6,
-	0x15, 0xb6, 0x6c, 0xda, 0xa6, 0x46, 0xb2, 0xc4, 0x1d, 0xcb, 0x8e, 0xa2, 0xa4, 0xa6, 0xcb, 0x16,
-	0xc5, 0xb6, 0x48, 0xec, 0xb5, 0x37, 0x6e, 0x8d, 0xa4, 0x37, 0xcb, 0x56, 0x5a, 0xbb, 0xde, 0x8d,
-	0x31, 0x72, 0x5a, 0xa4, 0x68, 0x40, 0x8c, 0xc8, 0x09, 0xc5, 0x98, 0xe4, 0x08, 0x9c, 0x91, 0x56,
-	0xea, 0x2f, 0x08, 0xb6, 0x2f, 0xed, 0x0f, 0x58, 0x20, 0x40, 0xff, 0x48, 0x1f, 0x83, 0x3e, 0xe5,
-	0xb1, 0x28, 0x50, 0xb6, 0xd0, 0xbe, 0xf9, 0xd1, 0xef, 0x05, 0x8a, 0xb9, 0xe8, 0x6a, 0xc7, 0x71,
-	0x5f, 0xec, 0x39, 0xb7, 0xef, 0x3b, 0xe7, 0xcc, 0x21, 0x67, 0x28, 0x50, 0x23, 0xbc, 0x4d, 0xd2,
-	0x38, 0x4c, 0xf8, 0x2e, 0xe9, 0xc5, 0xbb, 0xbd, 0x3d, 0xf1, 0x6f, 0xa7, 0x93, 0x52, 0x4e, 0xa1,
-	0x35, 0xb6, 0xed, 0x08, 0x65, 0x6f, 0xaf, 0x56, 0x09, 0x68, 0x40, 0xa5, 0x71, 0x57, 0xac, 0x94,
-	0x9f, 0xf3, 0xef, 0x25, 0xb0, 0x72, 0x81, 0x53, 0x1c, 0x33, 0xb8, 0x07, 0xf2, 0xa4, 0x17, 0xbb,
-	0x3e, 0x49, 0x68, 0x5c, 0xcd, 0x6d, 0xe7, 0x1e, 0xe7, 0xeb, 0x95, 0x9b, 0xcc, 0xb6, 0x06, 0x38,
-	0x8e, 0xde, 0x77, 0xc6, 0x26, 0x07, 0x99, 0xa4, 0x17, 0x9f, 0x88, 0x25, 0x3c, 0x02, 0x80, 0xf4,
-	0x79, 0x8a, 0x5d, 0x12, 0x76, 0x58, 0xd5, 0xd8, 0x5e, 0x7a, 0x9c, 0xaf, 0x3b, 0xc3, 0xcc, 0xce,
-	0x37, 0x84, 0xb6, 0x71, 0x7a, 0xc1, 0x6e, 0x32, 0xfb, 0x91, 0x06, 0x18, 0x3b, 0x3a, 0x28, 0x2f,
-	0x85, 0x46, 0xd8, 0x61, 0xf0, 0x53, 0x50, 0xf4, 0xda, 0x38, 0x4c, 0x5c, 0x8f, 0x26, 0x9f, 0x85,
-	0x41, 0x75, 0x79, 0x3b, 0xf7, 0xb8, 0xb0, 0xff, 0x9d, 0x9d, 0xf9, 0xfc, 0x77, 0x8e, 0x85, 0xd7,
-	0xb1, 0x74, 0xaa, 0xbf, 0xf5, 0x55, 0x66, 0x2f, 0xdc, 0x64, 0xf6, 0xba, 0x82, 0x9e, 0x06, 0x70,
-	0x50, 0xc1, 0x9b, 0x78, 0xc2, 0x7d, 0xb0, 0x81, 0xa3, 0x88, 0xbe, 0x70, 0xbb, 0x89, 0x28, 0x98,
-	0x78, 0x9c, 0xf8, 0x2e, 0xef, 0xb3, 0xea, 0xca, 0x76, 0xee, 0xb1, 0x89, 0xd6, 0xa5, 0xf1, 0xe3,
-	0x89, 0xed, 0xb2, 0xcf, 0xe0, 0xbb, 0x00, 0x62, 0x8f, 0x87, 0x3d, 0xe2, 0x76, 0x52, 0xe2, 0xd1,
-	0xb8, 0x13, 0x46, 0x84, 0x55, 0x57, 0x45, 0x75, 0xe8, 0x91, 0xb2, 0x5c, 0x4c, 0x0c, 0x70, 0x1f,
-	0x14, 0x45, 0x73, 0xbc, 0x36, 0x4e, 0x12, 0x12, 0xb1, 0xaa, 0x29, 0xdb, 0x50, 0x1e, 0x66, 0x76,
-	0xa1, 0xf1, 0xdb, 0x67, 0xc7, 0x5a, 0x8d, 0x0a, 0xa4, 0x17, 0x8f, 0x04, 0xf8, 0x29, 0x28, 0x61,
-	0xcf, 0x23, 0x8c, 0x89, 0xac, 0x79, 0x4a, 0xa3, 0x6a, 0x5e, 0xd6, 0x6d, 0xdf, 0xae, 0xfb, 0x48,
-	0xfa, 0x1d, 0x2b, 0xb7, 0xfa, 0x86, 0xa8, 0x7c, 0x98, 0xd9, 0x6b, 0x33, 0x6a, 0xb4, 0x86, 0xa7,
-	0xc5, 0x33, 0xc3, 0x5c, 0xb4, 0x96, 0xce, 0x0c, 0x73, 0xc9, 0x32, 0x9c, 0xbf, 0xe4, 0xc0, 0xac,
-	0x33, 0x3c, 0x02, 0x2b, 0x5e, 0x4a, 0x30, 0x27, 0x72, 0x97, 0x0b, 0xfb, 0xdf, 0xfb, 0x16, 0xd2,
-	0xcb, 0x41, 0x87, 0xd4, 0x0d, 0x41, 0x8c, 0x74, 0x20, 0xfc, 0x19, 0x30, 0x3c, 0x1c, 0x45, 0xd5,
-	0xc5, 0xff, 0x17, 0x40, 0x86, 0x39, 0xff, 0xca, 0x81, 0x47, 0xb7, 0x3c, 0xa0, 0x07, 0x0a, 0xba,
-	0x29, 0x7c, 0xd0, 0x51, 0xc9, 0x95, 0xf6, 0xdf, 0xfe, 0x26, 0x6c, 0x09, 0xfa, 0xfd, 0x61, 0x66,
-	0x83, 0x89, 0x7c, 0x93, 0xd9, 0x50, 0x8d, 0xc4, 0x14, 0x90, 0x83, 0x00, 0x1e, 0x7b, 0x40, 0x0f,
-	0xac, 0xcf, 0x76, 0xde, 0x8d, 0x42, 0xc6, 0xab, 0x8b, 0x72, 0xd3, 0x9e, 0x0e, 0x33, 0x7b, 0x36,
-	0xb1, 0xf3, 0x90, 0xf1, 0x9b, 0xcc, 0xae, 0xcd, 0xa0, 0x4e, 0x47, 0x3a, 0x62, 0x24, 0xe6, 0x02,
-	0x9c, 0xff, 0x96, 0x40, 0x61, 0x6a, 0x5e, 0xe1, 0x1f, 0x40, 0xb9, 0x4d, 0x63, 0xc2, 0x38, 0xc1,
-	0xbe, 0xdb, 0x8a, 0xa8, 0x77, 0xa5, 0x1f, 0xb0, 0xa7, 0xff, 0xcc, 0xec, 0x0d, 0x8f, 0xb2, 0x98,
-	0x32, 0xe6, 0x5f, 0xed, 0x84, 0x74, 0x37, 0xc6, 0xbc, 0xbd, 0x73, 0x9a, 0x08, 0xd2, 0x4d, 0x45,
-	0x3a, 0x17, 0xe9, 0xa0, 0xd2, 0x58, 0x53, 0x17, 0x0a, 0xd8, 0x06, 0x25, 0x1f, 0x53, 0xf7, 0x33,
-	0x9a, 0x5e, 0x69, 0xf0, 0x45, 0x09, 0x5e, 0xff, 0x46, 0xf0, 0x61, 0x66, 0x17, 0x4f, 0x8e, 0x3e,
-	0xfa, 0x90, 0xa6, 0x57, 0x12, 0xe2, 0x26, 0xb3, 0x37, 0x14, 0xd9, 0x2c, 0x90, 0x83, 0x8a, 0x3e,
-	0xa6, 0x63, 0x37, 0xf8, 0x3b, 0x60, 0x8d, 0x1d, 0x58, 0xb7, 0xd3, 0xa1, 0x29, 0xaf, 0x2e, 0x89,
-	0x07, 0xa9, 0xfe, 0xee, 0x30, 0xb3, 0x4b, 0x1a, 0xb2, 0xa9, 0x2c, 0x37, 0x99, 0xfd, 0xc6, 0x1c,
-	0xa8, 0x8e, 0x71, 0x50, 0x49, 0xc3, 0x6a, 0x57, 0xd8, 0x02, 0x45, 0x12, 0x76, 0xf6, 0x0e, 0x9e,
-	0xe8, 0x02, 0x0c, 0x59, 0xc0, 0x2f, 0xee, 0x2b, 0xa0, 0xd0, 0x38, 0xbd, 0xd8, 0x3b, 0x78, 0x32,
-	0xca, 0x5f, 0xbf, 0x0a, 0xa6, 0x51, 0x1c, 0x54, 0x50, 0xa2, 0x4a, 0xfe, 0x14, 0x68, 0xd1, 0x6d,
-	0x63, 0xd6, 0x96, 0x2f, 0x9a, 0x7c, 0xfd, 0xb1, 0x18, 0x20, 0x85, 0xf4, 0x6b, 0xcc, 0xda, 0x93,
-	0xae, 0xb7, 0x06, 0x7f, 0xc4, 0x09, 0x0f, 0xbb, 0xf1, 0x08, 0x0b, 0xa8, 0x60, 0xe1, 0x35, 0x4e,
-	0xf7, 0x40, 0xa7, 0xbb, 0xf2, 0xd0, 0x74, 0x0f, 0xee, 0x4a, 0xf7, 0x60, 0x36, 0x5d, 0xe5, 0x33,
-	0xe6, 0x38, 0xd4, 0x1c, 0xab, 0x0f, 0xe5, 0x38, 0xbc, 0x8b, 0xe3, 0x70, 0x96, 0x43, 0xf9, 0x88,
-	0xb9, 0x9c, 0xab, 0xb3, 0x6a, 0x3e, 0x78, 0x2e, 0x6f, 0x75, 0xa8, 0x34, 0xd6, 0x28, 0xf4, 0x2b,
-	0x50, 0xf1, 0x68, 0xc2, 0xb8, 0xd0, 0x25, 0xb4, 0x13, 0x11, 0x4d, 0x91, 0x97, 0x14, 0x87, 0xf7,
-	0x51, 0xbc, 0xa5, 0x5f, 0xec, 0x77, 0x84, 0x3b, 0x68, 0x7d, 0x56, 0xad, 0xc8, 0x5c, 0x60, 0x75,
-	0x08, 0x27, 0x29, 0x6b, 0x75, 0xd3, 0x40, 0x13, 0x01, 0x49, 0xf4, 0xde, 0x7d, 0x44, 0x7a, 0x42,
-	0xe7, 0x43, 0x1d, 0x54, 0x9e, 0xa8, 0x14, 0xc1, 0x27, 0xa0, 0x14, 0x0a, 0xd6, 0x56, 0x37, 0xd2,
-	0xf0, 0x05, 0x09, 0xbf, 0x7f, 0x1f, 0xbc, 0x7e, 0xaa, 0x66, 0x03, 0x1d, 0xb4, 0x36, 0x52, 0x28,
-	0x68, 0x1f, 0xc0, 0xb8, 0x1b, 0xa6, 0x6e, 0x10, 0x61, 0x2f, 0x24, 0xa9, 0x86, 0x2f, 0x4a, 0xf8,
-	0x1f, 0xdf, 0x07, 0xff, 0xa6, 0x82, 0xbf, 0x1d, 0xec, 0x20, 0x4b, 0x28, 0x7f, 0xa5, 0x74, 0x8a,
-	0xa5, 0x09, 0x8a, 0x2d, 0x92, 0x46, 0x61, 0xa2, 0xf1, 0xd7, 0x24, 0xfe, 0x93, 0xfb, 0xf0, 0xf5,
-	0x04, 0x4d, 0x87, 0x39, 0xa8, 0xa0, 0xc4, 0x31, 0x68, 0x44, 0x13, 0x9f, 0x8e, 0x40, 0x1f, 0x3d,
-	0x18, 0x74, 0x3a, 0xcc, 0x41, 0x05, 0x25, 0x2a, 0xd0, 0x00, 0xac, 0xe3, 0x34, 0xa5, 0x2f, 0xe6,
-	0x1a, 0x02, 0x25, 0xf6, 0x4f, 0xee, 0xc3, 0x1e, 0xbd, 0xa7, 0x6f, 0x47, 0x8b, 0xf7, 0xb4, 0xd0,
-	0xce, 0xb4, 0xc4, 0x07, 0x30, 0x48, 0xf1, 0x60, 0x8e, 0xa7, 0xf2, 0xe0, 0xc6, 0xdf, 0x0e, 0x76,
-	0x90, 0x25, 0x94, 0x33, 0x2c, 0x9f, 0x83, 0x4a, 0x4c, 0xd2, 0x80, 0xb8, 0x09, 0xe1, 0xac, 0x13,
-	0x85, 0x5c, 0xf3, 0x6c, 0x3c, 0xf8, 0x39, 0xb8, 0x2b, 0xdc, 0x41, 0x50, 0xaa, 0x9f, 0x6b, 0xed,
-	0x78, 0x4a, 0x59, 0x1b, 0x27, 0x41, 0x1b, 0x87, 0x9a, 0x65, 0xf3, 0xc1, 0x53, 0x3a, 0x1b, 0xe8,
-	0xa0, 0xb5, 0x91, 0x62, 0xbc, 0xd5, 0x1e, 0x4e, 0xbc, 0xee, 0x68, 0xab, 0xdf, 0x78, 0xf0, 0x56,
-	0x4f, 0x87, 0x89, 0xfb, 0x99, 0x14, 0x25, 0xe8, 0x99, 0x61, 0x96, 0xac, 0xf2, 0x99, 0x61, 0x96,
-	0x2d, 0xeb, 0xcc, 0x30, 0x2d, 0xeb, 0xd1, 0x99, 0x61, 0xae, 0x5b, 0x15, 0xb4, 0x36, 0xa0, 0x11,
-	0x75, 0x7b, 0x4f, 0x55, 0x10, 0x2a, 0x90, 0x17, 0x98, 0xe9, 0x17, 0x0d, 0x2a, 0x79, 0x98, 0xe3,
-	0x68, 0xc0, 0x74, 0x23, 0x90, 0xa5, 0xda, 0x33, 0x75, 0x6c, 0xed, 0x82, 0xe5, 0x26, 0x17, 0xf7,
-	0x14, 0x0b, 0x2c, 0x5d, 0x91, 0x81, 0x3a, 0x6c, 0x91, 0x58, 0xc2, 0x0a, 0x58, 0xee, 0xe1, 0xa8,
-	0x4b, 0xd4, 0x19, 0x89, 0x94, 0xe0, 0x5c, 0x80, 0xf2, 0x65, 0x8a, 0x13, 0x26, 0x6e, 0x77, 0x34,
-	0x39, 0xa7, 0x01, 0x83, 0x10, 0x18, 0xf2, 0x9c, 0x50, 0xb1, 0x72, 0x0d, 0x7f, 0x08, 0x8c, 0x88,
-	0x06, 0x4c, 0xde, 0x16, 0x0a, 0xfb, 0x1b, 0xb7, 0xaf, 0x26, 0xe7, 0x34, 0x40, 0xd2, 0xc5, 0xf9,
-	0xfb, 0x22, 0x58, 0x3a, 0xa7, 0x01, 0xac, 0x82, 0x55, 0xec, 0xfb, 0x29, 0x61, 0x4c, 0x23, 0x8d,
-	0x44, 0xb8, 0x09, 0x56, 0x38, 0xed, 0x84, 0x9e, 0x82, 0xcb, 0x23, 0x2d, 0x09, 0x62, 0x1f, 0x73,
-	0x2c, 0x0f, 0xd6, 0x22, 0x92, 0x6b, 0x71, 0xc7, 0x94, 0x95, 0xb9, 0x49, 0x37, 0x6e, 0x91, 0x54,
-	0x9e, 0x8f, 0x46, 0xbd, 0x7c, 0x9d, 0xd9, 0x05, 0xa9, 0x7f, 0x2e, 0xd5, 0x68, 0x5a, 0x80, 0xef,
-	0x80, 0x55, 0xde, 0x9f, 0x3e, 0xeb, 0xd6, 0xaf, 0x33, 0xbb, 0xcc, 0x27, 0x65, 0x8a, 0xa3, 0x0c,
-	0xad, 0xf0, 0xbe, 0x3c, 0xd2, 0x76, 0x81, 0xc9, 0xfb, 0x6e, 0x98, 0xf8, 0xa4, 0x2f, 0x8f, 0x33,
-	0xa3, 0x5e, 0xb9, 0xce, 0x6c, 0x6b, 0xca, 0xfd, 0x54, 0xd8, 0xd0, 0x2a, 0xef, 0xcb, 0x05, 0x7c,
-	0x07, 0x00, 0x95, 0x92, 0x64, 0x50, 0xa7, 0xd3, 0xda, 0x75, 0x66, 0xe7, 0xa5, 0x56, 0x62, 0x4f,
-	0x96, 0xd0, 0x01, 0xcb, 0x0a, 0xdb, 0x94, 0xd8, 0xc5, 0xeb, 0xcc, 0x36, 0x23, 0x1a, 0x28, 0x4c,
-	0x65, 0x12, 0xad, 0x4a, 0x49, 0x4c, 0x7b, 0xc4, 0x97, 0x47, 0x84, 0x89, 0x46, 0xa2, 0xf3, 0xa7,
-	0x45, 0x60, 0x5e, 0xf6, 0x11, 0x61, 0xdd, 0x88, 0xc3, 0x0f, 0x81, 0x25, 0x2f, 0x60, 0xd8, 0xe3,
-	0xee, 0x4c, 0x6b, 0xeb, 0x6f, 0x4d, 0x5e, 0xe8, 0xf3, 0x1e, 0x0e, 0x2a, 0x8f, 0x54, 0x47, 0xba,
-	0xff, 0x15, 0xb0, 0xdc, 0x8a, 0x28, 0x8d, 0xe5, 0x24, 0x14, 0x91, 0x12, 0x20, 0x92, 0x5d, 0x93,
-	0xbb, 0xbc, 0x24, 0x2f, 0xb7, 0xdf, 0xbd, 0xbd, 0xcb, 0x73, 0xa3, 0x52, 0xdf, 0xd4, 0x9f, 0x23,
-	0x25, 0xc5, 0xad, 0xe3, 0x1d, 0xd1, 0x5b, 0x39, 0x4a, 0x16, 0x58, 0x4a, 0x09, 0x97, 0x9b, 0x56,
-	0x44, 0x62, 0x09, 0x6b, 0xc0, 0x4c, 0x49, 0x8f, 0xa4, 0x9c, 0xf8, 0x72, 0x73, 0x4c, 0x34, 0x96,
-	0xe1, 0x9b, 0xc0, 0x0c, 0x30, 0x73, 0xbb, 0x8c, 0xf8, 0x6a, 0x27, 0xd0, 0x6a, 0x80, 0xd9, 0xc7,
-	0x8c, 0xf8, 0xef, 0x1b, 0x5f, 0x7c, 0x69, 0x2f, 0x38, 0x18, 0x14, 0xf4, 0x95, 0xb7, 0xdb, 0x89,
-	0xc8, 0x3d, 0x13, 0xb6, 0x0f, 0x8a, 0x8c, 0xd3, 0x14, 0x07, 0xc4, 0xbd, 0x22, 0x03, 0x3d, 0x67,
-	0x6a, 0x6a, 0xb4, 0xfe, 0x37, 0x64, 0xc0, 0xd0, 0xb4, 0xa0, 0x29, 0xbe, 0x34, 0x40, 0xe1, 0x32,
-	0xc5, 0x1e, 0xd1, 0x17, 0x58, 0x31, 0xab, 0x42, 0x4c, 0x35, 0x85, 0x96, 0x04, 0x37, 0x0f, 0x63,
-	0x42, 0xbb, 0x5c, 0x3f, 0x4f, 0x23, 0x51, 0x44, 0xa4, 0x84, 0xf4, 0x89, 0x27, 0xdb, 0x68, 0x20,
-	0x2d, 0xc1, 0x03, 0xb0, 0xe6, 0x87, 0x0c, 0xb7, 0x22, 0xe2, 0x32, 0x8e, 0xbd, 0x2b, 0x55, 0x7e,
-	0xdd, 0xba, 0xce, 0xec, 0xa2, 0x36, 0x34, 0x85, 0x1e, 0xcd, 0x48, 0xf0, 0x03, 0x50, 0x9e, 0x84,
-	0xc9, 0x6c, 0xd5, 0x17, 0x5c, 0x1d, 0x5e, 0x67, 0x76, 0x69, 0xec, 0x2a, 0x2d, 0x68, 0x4e, 0x16,
-	0x3b, 0xed, 0x93, 0x56, 0x37, 0x90, 0xc3, 0x67, 0x22, 0x25, 0x08, 0x6d, 0x14, 0xc6, 0x21, 0x97,
-	0xc3, 0xb6, 0x8c, 0x94, 0x00, 0x3f, 0x00, 0x79, 0xda, 0x23, 0x69, 0x1a, 0xfa, 0x84, 0xc9, 0x0b,
-	0xc4, 0xb7, 0x7d, 0x8c, 0xa2, 0x89, 0xbf, 0x28, 0x8e, 0x24, 0x32, 0xc9, 0x98, 0xc4, 0x34, 0x1d,
-	0xc8, 0x2b, 0x82, 0x2e, 0x4e, 0x19, 0x9e, 0x49, 0x3d, 0x9a, 0x91, 0x60, 0x1d, 0x40, 0x1d, 0x96,
-	0x12, 0xde, 0x4d, 0x13, 0x57, 0x3e, 0xff, 0x45, 0x19, 0x2b, 0x9f, 0x42, 0x65, 0x45, 0xd2, 0x78,
-	0x82, 0x39, 0x46, 0xb7, 0x34, 0xf0, 0xe7, 0x00, 0xaa, 0x3d, 0x71, 0x3f, 0x67, 0x74, 0xfc, 0x35,
-	0xad, 0xce, 0x78, 0xc9, 0xaf, 0xac, 0x3a, 0x67, 0x4b, 0x49, 0x67, 0x8c, 0xea, 0x2a, 0xce, 0x0c,
-	0xd3, 0xb0, 0x96, 0xcf, 0x0c, 0x73, 0xd5, 0x32, 0xc7, 0xfd, 0xd3, 0x55, 0xa0, 0xf5, 0x91, 0x3c,
-	0x95, 0xde, 0x8f, 0xfe, 0x96, 0x03, 0x53, 0x5f, 0x5e, 0xf0, 0xa7, 0xa0, 0x76, 0x74, 0x7c, 0xdc,
-	0x68, 0x36, 0xdd, 0xcb, 0x4f, 0x2e, 0x1a, 0xee, 0x45, 0x03, 0x3d, 0x3b, 0x6d, 0x36, 0x4f, 0x3f,
-	0x7a, 0x7e, 0xde, 0x68, 0x36, 0xad, 0x85, 0xda, 0xdb, 0x2f, 0x5f, 0x6d, 0x57, 0x27, 0xfe, 0x17,
-	0xa2, 0x9f, 0x8c, 0x85, 0x34, 0x89, 0xc4, 0xa4, 0xbe, 0x07, 0x36, 0xa7, 0xa3, 0x51, 0xa3, 0x79,
-	0x89, 0x4e, 0x8f, 0x2f, 0x1b, 0x27, 0x56, 0xae, 0x56, 0x7d, 0xf9, 0x6a, 0xbb, 0x32, 0x89, 0x44,
-	0x84, 0xf1, 0x34, 0x14, 0xdf, 0xea, 0xf0, 0x10, 0x54, 0xef, 0xe6, 0x6c, 0x9c, 0x58, 0x8b, 0xb5,
-	0xda, 0xcb, 0x57, 0xdb, 0x9b, 0x77, 0x31, 0x12, 0xbf, 0x66, 0x7c, 0xf1, 0xd7, 0xad, 0x85, 0xfa,
-	0x2f, 0xbf, 0x1a, 0x6e, 0xe5, 0xbe, 0x1e, 0x6e, 0xe5, 0xfe, 0x33, 0xdc, 0xca, 0xfd, 0xf9, 0xf5,
-	0xd6, 0xc2, 0xd7, 0xaf, 0xb7, 0x16, 0xfe, 0xf1, 0x7a, 0x6b, 0xe1, 0xf7, 0x3f, 0x08, 0x42, 0xde,
-	0xee, 0xb6, 0x76, 0x3c, 0x1a, 0xef, 0x92, 0x5e, 0x4c, 0x99, 0xfe, 0xdb, 0xdb, 0x3b, 0xdc, 0xed,
-	0xcb, 0x5f, 0x5b, 0xc4, 0x97, 0x25, 0x6b, 0xad, 0xc8, 0x5f, 0x51, 0x9e, 0xfe, 0x2f, 0x00, 0x00,
-	0xff, 0xff, 0x92, 0x17, 0x5a, 0x71, 0x8b, 0x11, 0x00, 0x00,
+	// 2364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcb, 0x6f, 0xe3, 0xc6,
+	0x19, 0xb7, 0x6c, 0xda, 0x4b, 0x8d, 0x64, 0x89, 0x1e, 0xcb, 0x5e, 0xae, 0x37, 0x35, 0x5d, 0xa6,
+	0x09, 0xdc, 0x36, 0xb1, 0x77, 0xbd, 0x71, 0xbb, 0x49, 0x9a, 0xa6, 0x96, 0xad, 0x6c, 0xec, 0x3a,
+	0x59, 0x63, 0xe4, 0xb4, 0x48, 0xdb, 0x80, 0x18, 0x91, 0x13, 0x89, 0x31, 0xc9, 0x11, 0x38, 0x23,
+	0x45, 0xea, 0x5f, 0x10, 0xa4, 0x97, 0xf6, 0x0f, 0x08, 0x10, 0xa0, 0xff, 0x48, 0x4f, 0x45, 0xd0,
+	0x53, 0x8e, 0x6d, 0x80, 0x12, 0x85, 0xf7, 0xe6, 0xa3, 0xef, 0x05, 0x8a, 0x79, 0xe8, 0xed, 0x18,
+	0xee, 0x45, 0xe2, 0xf7, 0xfa, 0x7d, 0x8f, 0xf9, 0x38, 0xf3, 0x0d, 0xc1, 0x06, 0xe1, 0x2d, 0x92,
+	0xc6, 0x61, 0xc2, 0x77, 0x49, 0x37, 0xde, 0xed, 0x3e, 0x16, 0x7f, 0x3b, 0xed, 0x94, 0x72, 0x0a,
+	0xad, 0xa1, 0x6c, 0x47, 0x30, 0xbb, 0x8f, 0x37, 0x2a, 0x4d, 0xda, 0xa4, 0x52, 0xb8, 0x2b, 0x9e,
+	0x94, 0x9e, 0xfb, 0xaf, 0x3c, 0x58, 0x3a, 0xc3, 0x29, 0x8e, 0x19, 0x7c, 0x0c, 0xf2, 0xa4, 0x1b,
+	0x7b, 0x01, 0x49, 0x68, 0x6c, 0xe7, 0xb6, 0x72, 0xdb, 0xf9, 0x6a, 0xe5, 0x3a, 0x73, 0xac, 0x3e,
+	0x8e, 0xa3, 0xb7, 0xdc, 0xa1, 0xc8, 0x45, 0x26, 0xe9, 0xc6, 0x47, 0xe2, 0x11, 0x1e, 0x00, 0x40,
+	0x7a, 0x3c, 0xc5, 0x1e, 0x09, 0xdb, 0xcc, 0x36, 0xb6, 0x16, 0xb6, 0xf3, 0x55, 0xf7, 0x32, 0x73,
+	0xf2, 0x35, 0xc1, 0xad, 0x1d, 0x9f, 0xb1, 0xeb, 0xcc, 0x59, 0xd1, 0x00, 0x43, 0x45, 0x17, 0xe5,
+	0x25, 0x51, 0x0b, 0xdb, 0x0c, 0x7e, 0x02, 0x8a, 0x7e, 0x0b, 0x87, 0x89, 0xe7, 0xd3, 0xe4, 0xd3,
+	0xb0, 0x69, 0x2f, 0x6e, 0xe5, 0xb6, 0x0b, 0x7b, 0x3f, 0xd8, 0x99, 0x8e, 0x7f, 0xe7, 0x50, 0x68,
+	0x1d, 0x4a, 0xa5, 0xea, 0xc3, 0x6f, 0x32, 0x67, 0xee, 0x3a, 0x73, 0x56, 0x15, 0xf4, 0x38, 0x80,
+	0x8b, 0x0a, 0xfe, 0x48, 0x13, 0xee, 0x81, 0x35, 0x1c, 0x45, 0xf4, 0x73, 0xaf, 0x93, 0x88, 0x84,
+	0x89, 0xcf, 0x49, 0xe0, 0xf1, 0x1e, 0xb3, 0x97, 0xb6, 0x72, 0xdb, 0x26, 0x5a, 0x95, 0xc2, 0x8f,
+	0x46, 0xb2, 0xf3, 0x1e, 0x83, 0x7b, 0xa0, 0x28, 0xb2, 0xf5, 0x5b, 0x38, 0x49, 0x48, 0xc4, 0x6c,
+	0x53, 0xe6, 0x55, 0xbe, 0xcc, 0x9c, 0x42, 0xed, 0x37, 0x1f, 0x1c, 0x6a, 0x36, 0x2a, 0x90, 0x6e,
+	0x3c, 0x20, 0xe0, 0x27, 0xa0, 0x84, 0x7d, 0x9f, 0x30, 0x26, 0xc2, 0xe0, 0x29, 0x8d, 0xec, 0xbc,
+	0x4c, 0xc4, 0x99, 0x4d, 0xe4, 0x40, 0xea, 0x1d, 0x2a, 0xb5, 0xea, 0x9a, 0x48, 0xe5, 0x32, 0x73,
+	0x96, 0x27, 0xd8, 0x68, 0x19, 0x8f, 0x93, 0xf0, 0x2d, 0xf0, 0x00, 0xfb, 0x3c, 0xec, 0x12, 0x8f,
+	0x71, 0xcc, 0x43, 0xdf, 0x6b, 0xa7, 0xc4, 0xa7, 0x71, 0x3b, 0x8c, 0x08, 0xb3, 0x81, 0x88, 0x0f,
+	0xdd, 0x57, 0x0a, 0x75, 0x29, 0x3f, 0x1b, 0x89, 0xe1, 0x47, 0xa0, 0x32, 0xd2, 0xf6, 0x9a, 0x98,
+	0x79, 0x29, 0xe6, 0x21, 0xb5, 0x0b, 0x72, 0x89, 0x5f, 0x16, 0xfe, 0xbf, 0xcb, 0x9c, 0x87, 0x3e,
+	0x65, 0x31, 0x65, 0x2c, 0xb8, 0xd8, 0x09, 0xe9, 0x6e, 0x8c, 0x79, 0x6b, 0xe7, 0x94, 0x34, 0xb1,
+	0xdf, 0x3f, 0x22, 0x3e, 0x82, 0x23, 0x80, 0x67, 0x98, 0x21, 0x61, 0x0e, 0xb7, 0x40, 0x31, 0xc6,
+	0x3d, 0x8f, 0xf7, 0xbc, 0x46, 0x9f, 0x13, 0x66, 0x17, 0xb7, 0x72, 0xdb, 0x06, 0x02, 0x31, 0xee,
+	0x9d, 0xf7, 0xaa, 0x82, 0x03, 0x5f, 0x03, 0x50, 0x68, 0xf8, 0x38, 0x8a, 0x02, 0xcc, 0xb1, 0xd6,
+	0x5b, 0x96, 0x7a, 0x56, 0x8c, 0x7b, 0x87, 0x5a, 0xa0, 0xb4, 0x7f, 0x0f, 0x2a, 0xb4, 0xed, 0xd3,
+	0x40, 0x85, 0x48, 0xbb, 0x24, 0x4d, 0xc3, 0x80, 0x30, 0xbb, 0xb4, 0xb5, 0xb0, 0x5d, 0xd8, 0x7b,
+	0x79, 0xb6, 0x8e, 0xcf, 0xa5, 0xf6, 0x33, 0xcc, 0x9e, 0x6b, 0xdd, 0xaa, 0x21, 0x72, 0x41, 0x90,
+	0x4e, 0x0b, 0x18, 0x44, 0xe0, 0xd5, 0x9b, 0xc0, 0x3d, 0x59, 0x33, 0x91, 0x4e, 0xe2, 0xb5, 0x48,
+	0xd8, 0x6c, 0x71, 0xbb, 0xbc, 0x95, 0xdb, 0x5e, 0x40, 0xee, 0x2c, 0xc6, 0xc1, 0x50, 0xf5, 0x7d,
+	0xa9, 0x09, 0xdf, 0x04, 0x0f, 0xe4, 0x5a, 0x63, 0x9f, 0x4b, 0x54, 0xd2, 0xa6, 0x7e, 0xcb, 0x6b,
+	0x44, 0xd4, 0xbf, 0x60, 0xb6, 0x25, 0xb3, 0x5c, 0x1f, 0x28, 0x3c, 0xc3, 0xac, 0x26, 0xc4, 0x55,
+	0x29, 0x85, 0xef, 0x82, 0x97, 0x26, 0x4c, 0x53, 0xc2, 0x71, 0x98, 0x90, 0x40, 0x61, 0x30, 0x7b,
+	0x45, 0x5a, 0x3f, 0x18, 0xb3, 0x46, 0x5a, 0x43, 0xa2, 0x30, 0xf8, 0x53, 0xb0, 0x22, 0x1d, 0x91,
+	0xc0, 0xc3, 0x41, 0x90, 0x12, 0xc6, 0x08, 0xb3, 0xa1, 0xec, 0x03, 0x4b, 0x0b, 0x0e, 0x06, 0x7c,
+	0xf8, 0x0a, 0x28, 0x35, 0x44, 0x9b, 0x06, 0x9e, 0xca, 0x8a, 0xd9, 0xab, 0x52, 0x73, 0x59, 0x71,
+	0x55, 0x1d, 0x19, 0x7c, 0x04, 0x2a, 0xd2, 0xd4, 0x6b, 0x61, 0xd6, 0x12, 0x21, 0x91, 0x44, 0x64,
+	0x6b, 0x57, 0x64, 0x30, 0x50, 0xca, 0xde, 0xc7, 0xac, 0x85, 0x06, 0x12, 0xf8, 0x0a, 0x28, 0x8b,
+	0x05, 0x8e, 0x68, 0x93, 0x79, 0x6d, 0x92, 0x7a, 0xbc, 0x67, 0xaf, 0x49, 0x65, 0xd1, 0x19, 0xa7,
+	0xb4, 0xc9, 0xce, 0x48, 0x7a, 0xde, 0x83, 0x9b, 0x00, 0xb4, 0x71, 0x3f, 0xc6, 0x8c, 0x93, 0x94,
+	0xd9, 0xeb, 0xd2, 0xf7, 0x18, 0x07, 0x1e, 0x01, 0xa7, 0x4d, 0x19, 0x17, 0xad, 0xd4, 0xa2, 0xf4,
+	0xe2, 0xa6, 0x55, 0xb9, 0x2f, 0x57, 0xe5, 0xa1, 0x50, 0x3b, 0xef, 0xbd, 0x2f, 0x94, 0xa6, 0x97,
+	0xe3, 0xc4, 0x30, 0xe7, 0xad, 0x85, 0x13, 0xc3, 0x5c, 0xb0, 0x8c, 0x13, 0xc3, 0xbc, 0x67, 0x99,
+	0xee, 0x3b, 0x60, 0x65, 0xa6, 0x47, 0xe0, 0x3a, 0x58, 0x52, 0x55, 0x50, 0x5b, 0x1c, 0xd2, 0x14,
+	0xb4, 0xc0, 0x42, 0x13, 0x33, 0x7b, 0x5e, 0xc6, 0x2f, 0x1e, 0xdd, 0xbf, 0xe4, 0xc0, 0xe4, 0x4b,
+	0x09, 0x0f, 0xc0, 0x92, 0x9f, 0x12, 0xcc, 0x95, 0xed, 0x8d, 0x4d, 0x39, 0x61, 0x70, 0xde, 0x6f,
+	0x0f, 0x9a, 0x52, 0x1b, 0xc2, 0x77, 0x80, 0x21, 0xde, 0x07, 0x7b, 0xfe, 0xff, 0x05, 0x90, 0x66,
+	0xee, 0xbf, 0x73, 0x60, 0x65, 0x46, 0x03, 0xfa, 0xa0, 0xa0, 0x37, 0x1f, 0xde, 0x6f, 0xab, 0xe0,
+	0x4a, 0x7b, 0x2f, 0x7d, 0x1f, 0xb6, 0x04, 0xfd, 0xd1, 0x65, 0xe6, 0x80, 0x11, 0x7d, 0x9d, 0x39,
+	0x50, 0xed, 0xa5, 0x63, 0x40, 0x2e, 0x02, 0x78, 0xa8, 0x01, 0x7d, 0xb0, 0x3a, 0xb9, 0xc3, 0x79,
+	0x51, 0xc8, 0xb8, 0x3d, 0x2f, 0x37, 0xc7, 0x27, 0x97, 0x99, 0x33, 0x19, 0xd8, 0x69, 0xc8, 0xf8,
+	0x75, 0xe6, 0x6c, 0x4c, 0xa0, 0x8e, 0x5b, 0xba, 0x68, 0x05, 0x4f, 0x1b, 0xb8, 0x7f, 0xcf, 0x81,
+	0xa2, 0x82, 0x39, 0xa3, 0x51, 0xe8, 0xf7, 0xe1, 0xeb, 0x00, 0x06, 0xa4, 0x1d, 0xd1, 0x3e, 0x49,
+	0x3d, 0xb9, 0x57, 0x4b, 0xa7, 0x39, 0xd9, 0x43, 0x2b, 0x03, 0xc9, 0xc1, 0x40, 0x20, 0xde, 0x8b,
+	0xa1, 0x7a, 0x40, 0x92, 0xfe, 0x28, 0x44, 0x64, 0x0d, 0x04, 0x47, 0x9a, 0x2f, 0xb0, 0x87, 0x6f,
+	0xe1, 0x08, 0x7b, 0x41, 0x61, 0x0f, 0x24, 0x13, 0xd8, 0x43, 0xf5, 0x21, 0xb6, 0xa1, 0xb0, 0x07,
+	0x82, 0x01, 0xb6, 0xfb, 0xdf, 0x12, 0x28, 0x8c, 0x9d, 0x58, 0xf0, 0x0f, 0xa0, 0xdc, 0xa2, 0x31,
+	0x61, 0x9c, 0xe0, 0x40, 0xed, 0x11, 0xfa, 0x88, 0x7d, 0xf2, 0x5d, 0xe6, 0xac, 0xcd, 0xee, 0xbd,
+	0xc7, 0x89, 0xa8, 0xde, 0xba, 0xaa, 0xde, 0x94, 0xa5, 0x8b, 0x4a, 0x43, 0x8e, 0xdc, 0x50, 0x60,
+	0x0b, 0x94, 0x02, 0x4c, 0xbd, 0x4f, 0x69, 0x7a, 0xa1, 0xc1, 0xe7, 0x25, 0x78, 0xf5, 0x7b, 0xc1,
+	0x2f, 0x33, 0xa7, 0x78, 0x74, 0xf0, 0xfc, 0x3d, 0x9a, 0x5e, 0x48, 0x88, 0xeb, 0xcc, 0x59, 0x53,
+	0xce, 0x26, 0x81, 0x5c, 0x54, 0x0c, 0x30, 0x1d, 0xaa, 0xc1, 0xdf, 0x02, 0x6b, 0xa8, 0xc0, 0x3a,
+	0xed, 0x36, 0x4d, 0x45, 0xc5, 0x72, 0xdb, 0x66, 0xf5, 0xf5, 0xcb, 0xcc, 0x29, 0x69, 0xc8, 0xba,
+	0x92, 0x5c, 0x67, 0xce, 0xfd, 0x29, 0x50, 0x6d, 0xe3, 0xa2, 0x92, 0x86, 0xd5, 0xaa, 0xb0, 0x01,
+	0x8a, 0x24, 0x6c, 0x3f, 0xde, 0x7f, 0xa4, 0x13, 0x30, 0x64, 0x02, 0xef, 0xde, 0x96, 0x40, 0xa1,
+	0x76, 0x7c, 0xf6, 0x78, 0xff, 0xd1, 0x20, 0x7e, 0x3d, 0x0c, 0x8c, 0xa3, 0xb8, 0xa8, 0xa0, 0x48,
+	0x15, 0xfc, 0x31, 0xd0, 0xa4, 0xdc, 0xe2, 0xe4, 0xa8, 0x91, 0xaf, 0x6e, 0x8b, 0x37, 0x41, 0x21,
+	0x89, 0xdd, 0x6d, 0x54, 0xf5, 0x46, 0xff, 0x8f, 0x38, 0xe1, 0x61, 0x27, 0x1e, 0x60, 0x01, 0x65,
+	0x2c, 0xb4, 0x86, 0xe1, 0xee, 0xeb, 0x70, 0x97, 0xee, 0x1a, 0xee, 0xfe, 0x4d, 0xe1, 0xee, 0x4f,
+	0x86, 0xab, 0x74, 0x86, 0x3e, 0x9e, 0x6a, 0x1f, 0xf7, 0xee, 0xea, 0xe3, 0xe9, 0x4d, 0x3e, 0x9e,
+	0x4e, 0xfa, 0x50, 0x3a, 0xa2, 0x2f, 0xa7, 0xf2, 0xb4, 0xcd, 0x3b, 0xf7, 0xe5, 0x4c, 0x85, 0x4a,
+	0x43, 0x8e, 0x42, 0xbf, 0x00, 0x15, 0x9f, 0x26, 0x8c, 0x0b, 0x5e, 0x42, 0xdb, 0x11, 0xd1, 0x2e,
+	0xf2, 0xd2, 0xc5, 0xd3, 0xdb, 0x5c, 0x3c, 0x54, 0x2e, 0x6e, 0x32, 0x77, 0xd1, 0xea, 0x24, 0x5b,
+	0x39, 0xf3, 0x80, 0xd5, 0x26, 0xe2, 0x40, 0x69, 0x74, 0xd2, 0xa6, 0x76, 0x04, 0xa4, 0xa3, 0x37,
+	0x6e, 0x73, 0xa4, 0x3b, 0x74, 0xda, 0xd4, 0x45, 0xe5, 0x11, 0x4b, 0x39, 0xf8, 0x18, 0x94, 0x42,
+	0xe1, 0xb5, 0xd1, 0x89, 0x34, 0xbc, 0x1a, 0xa1, 0xf6, 0x6e, 0x83, 0xd7, 0x6f, 0xd5, 0xa4, 0xa1,
+	0x8b, 0x96, 0x07, 0x0c, 0x05, 0x1d, 0x00, 0x18, 0x77, 0xc2, 0xd4, 0x6b, 0x46, 0xd8, 0x0f, 0x49,
+	0xaa, 0xe1, 0x8b, 0x12, 0xfe, 0x67, 0xb7, 0xc1, 0x3f, 0x50, 0xf0, 0xb3, 0xc6, 0x2e, 0xb2, 0x04,
+	0xf3, 0x99, 0xe2, 0x29, 0x2f, 0x75, 0x50, 0x6c, 0x90, 0x34, 0x0a, 0x13, 0x8d, 0xbf, 0x2c, 0xf1,
+	0x1f, 0xdd, 0x86, 0xaf, 0x3b, 0x68, 0xdc, 0xcc, 0x45, 0x05, 0x45, 0x0e, 0x41, 0x23, 0x9a, 0x04,
+	0x74, 0x00, 0xba, 0x72, 0x67, 0xd0, 0x71, 0x33, 0x17, 0x15, 0x14, 0xa9, 0x40, 0x9b, 0x60, 0x15,
+	0xa7, 0x29, 0xfd, 0x7c, 0xaa, 0x20, 0x50, 0x62, 0xff, 0xfc, 0x36, 0xec, 0xc1, 0x81, 0x33, 0x6b,
+	0x2d, 0x0e, 0x1c, 0xc1, 0x9d, 0x28, 0x49, 0x00, 0x60, 0x33, 0xc5, 0xfd, 0x29, 0x3f, 0x95, 0x3b,
+	0x17, 0x7e, 0xd6, 0xd8, 0x45, 0x96, 0x60, 0x4e, 0x78, 0xf9, 0x0c, 0x54, 0x62, 0x92, 0x36, 0x89,
+	0x97, 0x10, 0xce, 0xda, 0x51, 0xc8, 0xb5, 0x9f, 0xb5, 0x3b, 0xbf, 0x07, 0x37, 0x99, 0xbb, 0x08,
+	0x4a, 0xf6, 0x87, 0x9a, 0x3b, 0xec, 0x52, 0xd6, 0xc2, 0x49, 0xb3, 0x85, 0x43, 0xed, 0x65, 0xfd,
+	0xce, 0x5d, 0x3a, 0x69, 0xe8, 0xa2, 0xe5, 0x01, 0x63, 0xb8, 0xd4, 0x3e, 0x4e, 0xfc, 0xce, 0x60,
+	0xa9, 0xef, 0xdf, 0x79, 0xa9, 0xc7, 0xcd, 0xc4, 0x0d, 0x4d, 0x92, 0x12, 0xf4, 0xc4, 0x30, 0x4b,
+	0x56, 0xf9, 0xc4, 0x30, 0xcb, 0x96, 0x75, 0x62, 0x98, 0x96, 0xb5, 0x72, 0x62, 0x98, 0xab, 0x56,
+	0x05, 0x2d, 0xf7, 0x69, 0x44, 0xbd, 0xee, 0x13, 0x65, 0x84, 0x0a, 0xe4, 0x73, 0xcc, 0xf4, 0x46,
+	0x83, 0x4a, 0x3e, 0xe6, 0x38, 0xea, 0x33, 0x5d, 0x08, 0x64, 0xa9, 0xf2, 0x8c, 0x1d, 0x5b, 0xbb,
+	0x60, 0x51, 0xdc, 0x84, 0xe4, 0x5c, 0x77, 0x41, 0xfa, 0x7a, 0xd8, 0x13, 0x8f, 0xb0, 0x02, 0x16,
+	0xbb, 0x38, 0xea, 0x10, 0x75, 0x46, 0x22, 0x45, 0xb8, 0x67, 0xa0, 0x7c, 0x9e, 0xe2, 0x84, 0x89,
+	0xd9, 0x93, 0x26, 0x62, 0x78, 0x85, 0x10, 0x18, 0xf2, 0x9c, 0x50, 0xb6, 0xf2, 0x19, 0xfe, 0x18,
+	0x18, 0x62, 0xdc, 0x95, 0x33, 0x45, 0x61, 0x6f, 0x6d, 0x76, 0xc6, 0x3a, 0xa5, 0x4d, 0x24, 0x55,
+	0xdc, 0x7f, 0xcc, 0x83, 0x85, 0x53, 0xda, 0x84, 0x36, 0xb8, 0xa7, 0x67, 0x74, 0x8d, 0x34, 0x20,
+	0xc5, 0x2c, 0xca, 0x69, 0x3b, 0xf4, 0x99, 0x1e, 0x51, 0x34, 0x25, 0x1c, 0x8b, 0x7b, 0x91, 0x3c,
+	0x58, 0x8b, 0x48, 0x3e, 0x8b, 0x4b, 0xa9, 0x9a, 0xce, 0x93, 0x4e, 0xdc, 0x20, 0xa9, 0x3c, 0x1f,
+	0x8d, 0x6a, 0xf9, 0x2a, 0x73, 0x0a, 0x92, 0xff, 0xa1, 0x64, 0xa3, 0x71, 0x02, 0xbe, 0x06, 0xee,
+	0x89, 0x99, 0x7a, 0x74, 0xd6, 0xad, 0x5e, 0x65, 0x4e, 0x99, 0x8f, 0xd2, 0x94, 0xe3, 0xfc, 0x12,
+	0xef, 0x89, 0x7f, 0xb8, 0x0b, 0x4c, 0xde, 0xf3, 0xc2, 0x24, 0x20, 0x3d, 0x79, 0x9c, 0x19, 0xd5,
+	0xca, 0x55, 0xe6, 0x58, 0x63, 0xea, 0xc7, 0x42, 0x86, 0xee, 0xf1, 0x9e, 0x7c, 0x80, 0xaf, 0x01,
+	0x30, 0xba, 0x30, 0xe8, 0xd3, 0x69, 0xf9, 0x2a, 0x73, 0xf2, 0xa3, 0xab, 0xc2, 0xe8, 0x11, 0xba,
+	0x60, 0x51, 0x61, 0x9b, 0x12, 0xbb, 0x78, 0x95, 0x39, 0x66, 0x44, 0x9b, 0x0a, 0x53, 0x89, 0x44,
+	0xa9, 0x52, 0x12, 0xd3, 0x2e, 0x09, 0xe4, 0x11, 0x61, 0xa2, 0x01, 0xe9, 0xfe, 0x69, 0x1e, 0x98,
+	0xe7, 0x3d, 0x44, 0x58, 0x27, 0xe2, 0xf0, 0x3d, 0x60, 0x8d, 0x06, 0xb7, 0xf1, 0xd2, 0x56, 0x1f,
+	0x8e, 0x36, 0xf4, 0x69, 0x0d, 0x17, 0x95, 0x87, 0x33, 0x9d, 0xae, 0x7f, 0x05, 0x2c, 0x36, 0x22,
+	0x4a, 0x63, 0xd9, 0x09, 0x45, 0xa4, 0x08, 0x88, 0x64, 0xd5, 0xe4, 0x2a, 0x2f, 0xc8, 0x29, 0xfd,
+	0x87, 0xb3, 0xab, 0x3c, 0xd5, 0x2a, 0xd5, 0x75, 0xfd, 0x41, 0xa2, 0xa4, 0x7c, 0x6b, 0x7b, 0x57,
+	0xd4, 0x56, 0xb6, 0x92, 0x05, 0x16, 0x52, 0xc2, 0xe5, 0xa2, 0x15, 0x91, 0x78, 0x84, 0x1b, 0xc0,
+	0x4c, 0x49, 0x97, 0xa4, 0x9c, 0x04, 0x72, 0x71, 0x4c, 0x34, 0xa4, 0xe1, 0x03, 0x60, 0x8a, 0x5b,
+	0x61, 0x87, 0x91, 0x40, 0xad, 0x04, 0xba, 0xd7, 0xc4, 0xec, 0x23, 0x46, 0x82, 0xb7, 0x8c, 0x2f,
+	0xbe, 0x76, 0xe6, 0x5c, 0x0c, 0x0a, 0x7a, 0x76, 0xef, 0xb4, 0x23, 0x72, 0x4b, 0x87, 0xed, 0x81,
+	0x22, 0xe3, 0x34, 0xc5, 0x4d, 0xe2, 0x5d, 0x90, 0xbe, 0xee, 0x33, 0xd5, 0x35, 0x9a, 0xff, 0x6b,
+	0xd2, 0x67, 0x68, 0x9c, 0xd0, 0x2e, 0xbe, 0x36, 0x40, 0xe1, 0x3c, 0xc5, 0x3e, 0xd1, 0x03, 0xac,
+	0xe8, 0x55, 0x41, 0xa6, 0x83, 0x7b, 0x93, 0xa2, 0x84, 0x6f, 0x1e, 0xc6, 0x84, 0x76, 0xb8, 0x7e,
+	0x9f, 0x06, 0xa4, 0xb0, 0x48, 0x09, 0xe9, 0x11, 0x5f, 0x96, 0xd1, 0x40, 0x9a, 0x82, 0xfb, 0x60,
+	0x39, 0x08, 0x19, 0x6e, 0x44, 0xf2, 0x63, 0x86, 0x7f, 0xa1, 0xd2, 0xaf, 0x5a, 0x57, 0x99, 0x53,
+	0xd4, 0x82, 0xba, 0xe0, 0xa3, 0x09, 0x0a, 0xbe, 0x0d, 0xca, 0x23, 0x33, 0x19, 0xad, 0xfa, 0x86,
+	0x53, 0x85, 0x57, 0x99, 0x53, 0x1a, 0xaa, 0x4a, 0x09, 0x9a, 0xa2, 0xc5, 0x4a, 0x07, 0xa4, 0xd1,
+	0x69, 0xca, 0xe6, 0x33, 0x91, 0x22, 0x04, 0x37, 0x0a, 0xe3, 0x90, 0xcb, 0x66, 0x5b, 0x44, 0x8a,
+	0x80, 0x6f, 0x83, 0xfc, 0xe8, 0xeb, 0x03, 0xb8, 0xc3, 0xe7, 0x28, 0x34, 0xd2, 0x17, 0xc9, 0x91,
+	0x44, 0x06, 0x19, 0x93, 0x98, 0xa6, 0x7d, 0xbb, 0x30, 0x4a, 0x4e, 0x09, 0x3e, 0x90, 0x7c, 0x34,
+	0x41, 0xc1, 0x2a, 0x80, 0xda, 0x2c, 0x25, 0xbc, 0x93, 0x26, 0x9e, 0x7c, 0xff, 0x8b, 0xd2, 0x56,
+	0xbe, 0x85, 0x4a, 0x8a, 0xa4, 0xf0, 0x08, 0x73, 0x8c, 0x66, 0x38, 0xf0, 0x97, 0x00, 0xaa, 0x35,
+	0xf1, 0x3e, 0x63, 0x74, 0xf8, 0x3d, 0x4d, 0x9d, 0xf1, 0xd2, 0xbf, 0x92, 0xea, 0x98, 0x2d, 0x45,
+	0x9d, 0x30, 0xaa, 0xb3, 0x38, 0x31, 0x4c, 0xc3, 0x5a, 0x54, 0x57, 0xe7, 0x61, 0xfd, 0x74, 0x16,
+	0x68, 0x75, 0x40, 0x8f, 0x85, 0xe7, 0x76, 0x40, 0xa9, 0xde, 0x67, 0x9c, 0xc4, 0x87, 0xfa, 0xbd,
+	0xba, 0xa5, 0x11, 0x1f, 0x82, 0xbc, 0xfc, 0xfc, 0x22, 0xb7, 0x0a, 0xd5, 0x28, 0xa6, 0x60, 0xc8,
+	0xad, 0x41, 0xac, 0x03, 0x6e, 0x90, 0x48, 0x36, 0x4a, 0x1e, 0x29, 0x42, 0xf4, 0x8f, 0xbe, 0xfd,
+	0x1b, 0xf2, 0xf6, 0xaf, 0xa9, 0x9f, 0xfc, 0x2d, 0x07, 0xc6, 0x6e, 0xae, 0xf0, 0x17, 0x60, 0xe3,
+	0xe0, 0xf0, 0xb0, 0x56, 0xaf, 0x7b, 0xe7, 0x1f, 0x9f, 0xd5, 0xbc, 0xb3, 0x1a, 0xfa, 0xe0, 0xb8,
+	0x5e, 0x3f, 0x7e, 0xfe, 0xe1, 0x69, 0xad, 0x5e, 0xb7, 0xe6, 0x36, 0x5e, 0xfa, 0xf2, 0xab, 0x2d,
+	0x7b, 0xa4, 0x7f, 0x26, 0x96, 0x91, 0xb1, 0x90, 0x26, 0x91, 0x88, 0xeb, 0x0d, 0xb0, 0x3e, 0x6e,
+	0x8d, 0x6a, 0xf5, 0x73, 0x74, 0x7c, 0x78, 0x5e, 0x3b, 0xb2, 0x72, 0x1b, 0xf6, 0x97, 0x5f, 0x6d,
+	0x55, 0x46, 0x96, 0x88, 0x30, 0x9e, 0x86, 0xe2, 0x23, 0x21, 0x7c, 0x0a, 0xec, 0x9b, 0x7d, 0xd6,
+	0x8e, 0xac, 0xf9, 0x8d, 0x8d, 0x2f, 0xbf, 0xda, 0x5a, 0xbf, 0xc9, 0x23, 0x09, 0x36, 0x8c, 0x2f,
+	0xfe, 0xba, 0x39, 0x57, 0xfd, 0xd5, 0x37, 0x97, 0x9b, 0xb9, 0x6f, 0x2f, 0x37, 0x73, 0xff, 0xb9,
+	0xdc, 0xcc, 0xfd, 0xf9, 0xc5, 0xe6, 0xdc, 0xb7, 0x2f, 0x36, 0xe7, 0xfe, 0xf9, 0x62, 0x73, 0xee,
+	0x77, 0xaf, 0x36, 0x43, 0xde, 0xea, 0x34, 0x76, 0x7c, 0x1a, 0x8b, 0xaf, 0xb9, 0x94, 0xe9, 0xdf,
+	0xee, 0xe3, 0x37, 0x77, 0x7b, 0xe2, 0x79, 0x57, 0xdc, 0xcc, 0x59, 0x63, 0x49, 0x7e, 0xbe, 0x7d,
+	0xf2, 0xbf, 0x01, 0x00, 0xd6, 0x18, 0x0b, 0xe3, 0x04, 0x16, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1043,50 +1434,157 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.ActiveStaticPrecompiles) > 0 {
-		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ActiveStaticPrecompiles[iNdEx])
-			copy(dAtA[i:], m.ActiveStaticPrecompiles[iNdEx])
-			i = encodeVarintEvm(dAtA, i, uint64(len(m.ActiveStaticPrecompiles[iNdEx])))
+	if m.PostTxHooksActivationHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.PostTxHooksActivationHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.Paymasters) > 0 {
+		for iNdEx := len(m.Paymasters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paymasters[iNdEx])
+			copy(dAtA[i:], m.Paymasters[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Paymasters[iNdEx])))
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
 		}
 	}
-	{
-		size, err := m.AccessControl.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.MaxLogsPerTx != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxLogsPerTx))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.BlockHashRetention != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockHashRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.BannedOpcodes) > 0 {
+		for iNdEx := len(m.BannedOpcodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BannedOpcodes[iNdEx])
+			copy(dAtA[i:], m.BannedOpcodes[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BannedOpcodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
-		i -= size
-		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.EVMChannels) > 0 {
-		for iNdEx := len(m.EVMChannels) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.EVMChannels[iNdEx])
-			copy(dAtA[i:], m.EVMChannels[iNdEx])
-			i = encodeVarintEvm(dAtA, i, uint64(len(m.EVMChannels[iNdEx])))
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if m.AllowUnprotectedTxs {
+	if m.ContractGasRetainedEpochs != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ContractGasRetainedEpochs))
 		i--
-		if m.AllowUnprotectedTxs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x88
 	}
-	{
-		size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
+	if m.ContractGasEpochBlocks != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ContractGasEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.OpcodeGasOverridesActivationHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.OpcodeGasOverridesActivationHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.OpcodeGasOverrides) > 0 {
+		for iNdEx := len(m.OpcodeGasOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OpcodeGasOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.MaxCalldataBytes != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxCalldataBytes))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxTxBytes != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxTxBytes))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.PrecompileGasRatio.Size()
+		i -= size
+		if _, err := m.PrecompileGasRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.ActiveStaticPrecompiles) > 0 {
+		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActiveStaticPrecompiles[iNdEx])
+			copy(dAtA[i:], m.ActiveStaticPrecompiles[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.ActiveStaticPrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.AccessControl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.EVMChannels) > 0 {
+		for iNdEx := len(m.EVMChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EVMChannels[iNdEx])
+			copy(dAtA[i:], m.EVMChannels[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.EVMChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.AllowUnprotectedTxs {
+		i--
+		if m.AllowUnprotectedTxs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
 		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
 	i--
@@ -1110,6 +1608,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OpcodeGasOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpcodeGasOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpcodeGasOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opcode) > 0 {
+		i -= len(m.Opcode)
+		copy(dAtA[i:], m.Opcode)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Opcode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccessControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1190,6 +1723,65 @@ func (m *AccessControlType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccessPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractDenylist) > 0 {
+		for iNdEx := len(m.ContractDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractDenylist[iNdEx])
+			copy(dAtA[i:], m.ContractDenylist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.ContractDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContractAllowlist) > 0 {
+		for iNdEx := len(m.ContractAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractAllowlist[iNdEx])
+			copy(dAtA[i:], m.ContractAllowlist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.ContractAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DeployerDenylist) > 0 {
+		for iNdEx := len(m.DeployerDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeployerDenylist[iNdEx])
+			copy(dAtA[i:], m.DeployerDenylist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.DeployerDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DeployerAllowlist) > 0 {
+		for iNdEx := len(m.DeployerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeployerAllowlist[iNdEx])
+			copy(dAtA[i:], m.DeployerAllowlist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.DeployerAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1836,6 +2428,55 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SystemContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SystemContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SystemContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvm(v)
 	base := offset
@@ -1883,6 +2524,72 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	l = m.PrecompileGasRatio.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if m.MaxTxBytes != 0 {
+		n += 1 + sovEvm(uint64(m.MaxTxBytes))
+	}
+	if m.MaxCalldataBytes != 0 {
+		n += 1 + sovEvm(uint64(m.MaxCalldataBytes))
+	}
+	if len(m.OpcodeGasOverrides) > 0 {
+		for _, e := range m.OpcodeGasOverrides {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.OpcodeGasOverridesActivationHeight != 0 {
+		n += 1 + sovEvm(uint64(m.OpcodeGasOverridesActivationHeight))
+	}
+	if m.ContractGasEpochBlocks != 0 {
+		n += 2 + sovEvm(uint64(m.ContractGasEpochBlocks))
+	}
+	if m.ContractGasRetainedEpochs != 0 {
+		n += 2 + sovEvm(uint64(m.ContractGasRetainedEpochs))
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 2 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.BannedOpcodes) > 0 {
+		for _, s := range m.BannedOpcodes {
+			l = len(s)
+			n += 2 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.BlockHashRetention != 0 {
+		n += 2 + sovEvm(uint64(m.BlockHashRetention))
+	}
+	if m.MaxLogsPerTx != 0 {
+		n += 2 + sovEvm(uint64(m.MaxLogsPerTx))
+	}
+	if len(m.Paymasters) > 0 {
+		for _, s := range m.Paymasters {
+			l = len(s)
+			n += 2 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.PostTxHooksActivationHeight != 0 {
+		n += 2 + sovEvm(uint64(m.PostTxHooksActivationHeight))
+	}
+	return n
+}
+
+func (m *OpcodeGasOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opcode)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovEvm(uint64(m.Gas))
+	}
 	return n
 }
 
@@ -1917,6 +2624,39 @@ func (m *AccessControlType) Size() (n int) {
 	return n
 }
 
+func (m *AccessPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeployerAllowlist) > 0 {
+		for _, s := range m.DeployerAllowlist {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.DeployerDenylist) > 0 {
+		for _, s := range m.DeployerDenylist {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.ContractAllowlist) > 0 {
+		for _, s := range m.ContractAllowlist {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.ContractDenylist) > 0 {
+		for _, s := range m.ContractDenylist {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ChainConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2174,6 +2914,30 @@ func (m *TraceConfig) Size() (n int) {
 	return n
 }
 
+func (m *SystemContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvm(uint64(m.Height))
+	}
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2425,17 +3189,435 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileGasRatio", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrecompileGasRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxBytes", wireType)
+			}
+			m.MaxTxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataBytes", wireType)
+			}
+			m.MaxCalldataBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCalldataBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpcodeGasOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpcodeGasOverrides = append(m.OpcodeGasOverrides, OpcodeGasOverride{})
+			if err := m.OpcodeGasOverrides[len(m.OpcodeGasOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpcodeGasOverridesActivationHeight", wireType)
+			}
+			m.OpcodeGasOverridesActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpcodeGasOverridesActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractGasEpochBlocks", wireType)
+			}
+			m.ContractGasEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractGasEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractGasRetainedEpochs", wireType)
+			}
+			m.ContractGasRetainedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractGasRetainedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedOpcodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BannedOpcodes = append(m.BannedOpcodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHashRetention", wireType)
+			}
+			m.BlockHashRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHashRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLogsPerTx", wireType)
+			}
+			m.MaxLogsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLogsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paymasters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paymasters = append(m.Paymasters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostTxHooksActivationHeight", wireType)
+			}
+			m.PostTxHooksActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostTxHooksActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *OpcodeGasOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpcodeGasOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpcodeGasOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -2508,12 +3690,115 @@ func (m *AccessControl) Unmarshal(dAtA []byte) error {
 			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AccessControlType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+			}
+			m.AccessType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessType |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2523,24 +3808,23 @@ func (m *AccessControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AccessControlList = append(m.AccessControlList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2564,7 +3848,7 @@ func (m *AccessControl) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AccessControlType) Unmarshal(dAtA []byte) error {
+func (m *AccessPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2587,17 +3871,17 @@ func (m *AccessControlType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccessPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAllowlist", wireType)
 			}
-			m.AccessType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2607,14 +3891,27 @@ func (m *AccessControlType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccessType |= AccessType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAllowlist = append(m.DeployerAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerDenylist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2642,7 +3939,71 @@ func (m *AccessControlType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessControlList = append(m.AccessControlList, string(dAtA[iNdEx:postIndex]))
+			m.DeployerDenylist = append(m.DeployerDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAllowlist = append(m.ContractAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractDenylist = append(m.ContractDenylist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4562,6 +5923,172 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *SystemContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SystemContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SystemContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,19 +3,164 @@
 package types
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"strings"
 
-	"github.com/evmos/evmos/v19/types"
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 )
 
-// Validate performs a basic validation of a GenesisAccount fields.
+// Validate performs a basic validation of a GenesisAccount fields. The
+// address must be in its normalized form, checksummed or lower-case, the
+// code a lower-case hex string without prefix and the storage keys and
+// values 0x-prefixed lower-case 32 bytes hashes.
 func (ga GenesisAccount) Validate() error {
-	if err := types.ValidateAddress(ga.Address); err != nil {
+	if err := ValidateGenesisAddress(ga.Address); err != nil {
+		return err
+	}
+	if err := ValidateGenesisCode(ga.Code); err != nil {
 		return err
 	}
 	return ga.Storage.Validate()
 }
 
+// Normalize returns the genesis account with its address checksummed, its
+// code in lower-case hex without prefix and its storage keys and values as
+// 0x-prefixed lower-case 32 bytes hashes, zero-padded on the left.
+func (ga GenesisAccount) Normalize() (GenesisAccount, error) {
+	address, err := NormalizeGenesisAddress(ga.Address)
+	if err != nil {
+		return ga, err
+	}
+
+	code, err := NormalizeGenesisCode(ga.Code)
+	if err != nil {
+		return ga, err
+	}
+
+	storage := make(Storage, len(ga.Storage))
+	for i, state := range ga.Storage {
+		if storage[i].Key, err = NormalizeGenesisHash(state.Key); err != nil {
+			return ga, errorsmod.Wrapf(err, "state key %d", i)
+		}
+		if storage[i].Value, err = NormalizeGenesisHash(state.Value); err != nil {
+			return ga, errorsmod.Wrapf(err, "state value %d", i)
+		}
+	}
+
+	return GenesisAccount{Address: address, Code: code, Storage: storage}, nil
+}
+
+// NormalizeGenesisAddress returns the checksummed form of the given hex
+// address, with or without 0x prefix. A mixed-case address must have a valid
+// EIP-55 checksum.
+func NormalizeGenesisAddress(address string) (string, error) {
+	hexAddress := address
+	if !has0xPrefix(hexAddress) {
+		hexAddress = "0x" + hexAddress
+	}
+
+	if !common.IsHexAddress(hexAddress) {
+		return "", errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress, "address '%s' is not a valid ethereum hex address",
+			address,
+		)
+	}
+
+	checksummed := common.HexToAddress(hexAddress).Hex()
+	if !isSingleCase(hexAddress[2:]) && hexAddress[2:] != checksummed[2:] {
+		return "", errorsmod.Wrapf(errortypes.ErrInvalidAddress, "address '%s' has an invalid checksum", address)
+	}
+
+	return checksummed, nil
+}
+
+// ValidateGenesisAddress returns an error if the given address is not a
+// 0x-prefixed hex address, either checksummed or lower-case.
+func ValidateGenesisAddress(address string) error {
+	checksummed, err := NormalizeGenesisAddress(address)
+	if err != nil {
+		return err
+	}
+
+	if address != checksummed && address != strings.ToLower(checksummed) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "address '%s' must be 0x-prefixed, checksummed or lower-case", address)
+	}
+	return nil
+}
+
+// NormalizeGenesisHash returns the 0x-prefixed lower-case form of the given
+// hex storage key or value, with or without 0x prefix, zero-padded on the left
+// to 32 bytes. An empty value is normalized to the zero hash.
+func NormalizeGenesisHash(hash string) (string, error) {
+	hexHash := strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X")
+	if len(hexHash)%2 == 1 {
+		hexHash = "0" + hexHash
+	}
+
+	bz, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return "", errorsmod.Wrapf(ErrInvalidState, "'%s' is not a valid hex string", hash)
+	}
+	if len(bz) > common.HashLength {
+		return "", errorsmod.Wrapf(ErrInvalidState, "'%s' is longer than %d bytes", hash, common.HashLength)
+	}
+
+	return common.BytesToHash(bz).Hex(), nil
+}
+
+// ValidateGenesisHash returns an error if the given storage key or value is
+// not a 0x-prefixed lower-case 32 bytes hash.
+func ValidateGenesisHash(hash string) error {
+	normalized, err := NormalizeGenesisHash(hash)
+	if err != nil {
+		return err
+	}
+
+	if hash != normalized {
+		return errorsmod.Wrapf(ErrInvalidState, "'%s' must be a 0x-prefixed lower-case 32 bytes hash", hash)
+	}
+	return nil
+}
+
+// NormalizeGenesisCode returns the lower-case hex form of the given code,
+// without 0x prefix.
+func NormalizeGenesisCode(code string) (string, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(code, "0x"), "0X"))
+	if err != nil {
+		return "", fmt.Errorf("code is not a valid hex string: %w", err)
+	}
+	return common.Bytes2Hex(bz), nil
+}
+
+// ValidateGenesisCode returns an error if the given code is not a lower-case
+// hex string without 0x prefix.
+func ValidateGenesisCode(code string) error {
+	normalized, err := NormalizeGenesisCode(code)
+	if err != nil {
+		return err
+	}
+
+	if code != normalized {
+		return errors.New("code must be a lower-case hex string without 0x prefix")
+	}
+	return nil
+}
+
+// has0xPrefix returns true if the given string starts with 0x or 0X.
+func has0xPrefix(s string) bool {
+	return len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X')
+}
+
+// isSingleCase returns true if the letters of the given hex string are all
+// lower-case or all upper-case.
+func isSingleCase(s string) bool {
+	return s == strings.ToLower(s) || s == strings.ToUpper(s)
+}
+
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
 // chain config values.
 func DefaultGenesisState() *GenesisState {
@@ -34,18 +179,40 @@ func NewGenesisState(params Params, accounts []GenesisAccount) *GenesisState {
 }
 
 // Validate performs basic genesis state validation returning an error upon any
-// failure.
+// failure. The accounts are compared by address regardless of their case.
 func (gs GenesisState) Validate() error {
-	seenAccounts := make(map[string]bool)
+	seenAccounts := make(map[common.Address]bool)
 	for _, acc := range gs.Accounts {
-		if seenAccounts[acc.Address] {
-			return fmt.Errorf("duplicated genesis account %s", acc.Address)
-		}
 		if err := acc.Validate(); err != nil {
 			return fmt.Errorf("invalid genesis account %s: %w", acc.Address, err)
 		}
-		seenAccounts[acc.Address] = true
+
+		address := common.HexToAddress(acc.Address)
+		if seenAccounts[address] {
+			return fmt.Errorf("duplicated genesis account %s", acc.Address)
+		}
+		seenAccounts[address] = true
+	}
+
+	if err := gs.AccessPolicy.Validate(); err != nil {
+		return fmt.Errorf("invalid access policy: %w", err)
 	}
 
 	return gs.Params.Validate()
 }
+
+// Normalize returns the genesis state with all its accounts normalized, so
+// that the genesis files holding identical states are identical.
+func (gs GenesisState) Normalize() (GenesisState, error) {
+	accounts := make([]GenesisAccount, len(gs.Accounts))
+	for i, acc := range gs.Accounts {
+		normalized, err := acc.Normalize()
+		if err != nil {
+			return gs, fmt.Errorf("invalid genesis account %s: %w", acc.Address, err)
+		}
+		accounts[i] = normalized
+	}
+
+	gs.Accounts = accounts
+	return gs, nil
+}
@@ -29,6 +29,8 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// access_policy defines the access policy of the contract deployments and calls.
+	AccessPolicy AccessPolicy `protobuf:"bytes,3,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +80,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAccessPolicy() AccessPolicy {
+	if m != nil {
+		return m.AccessPolicy
+	}
+	return AccessPolicy{}
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -152,26 +161,28 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbf, 0x4e, 0x83, 0x40,
-	0x18, 0xe7, 0x6c, 0xd3, 0xda, 0xab, 0x51, 0x73, 0x31, 0x91, 0x30, 0x5c, 0x49, 0x07, 0xc3, 0x74,
-	0x84, 0x9a, 0x18, 0x47, 0x65, 0x71, 0x35, 0x74, 0x73, 0xbb, 0xc2, 0x17, 0xca, 0x00, 0x47, 0xb8,
-	0x2b, 0xd1, 0xd5, 0xd1, 0xc9, 0xe7, 0xf0, 0x49, 0x3a, 0x76, 0x74, 0x52, 0x03, 0x2f, 0x62, 0x38,
-	0x68, 0x13, 0x65, 0xb9, 0x7c, 0x77, 0xbf, 0x7f, 0xdf, 0xfd, 0x30, 0x05, 0xb5, 0x86, 0x22, 0x4d,
-	0x32, 0xe5, 0x42, 0x99, 0xba, 0xa5, 0xe7, 0xc6, 0x90, 0x81, 0x4c, 0x24, 0xcb, 0x0b, 0xa1, 0x04,
-	0x39, 0x3f, 0xe0, 0x0c, 0xca, 0x94, 0x95, 0x9e, 0x65, 0xf5, 0x14, 0x0d, 0xa0, 0xd9, 0xd6, 0x45,
-	0x2c, 0x62, 0xa1, 0x47, 0xb7, 0x99, 0xda, 0xd7, 0xf9, 0x1b, 0xc2, 0x27, 0x0f, 0xad, 0xeb, 0x52,
-	0x71, 0x05, 0xc4, 0xc7, 0xc7, 0x3c, 0x0c, 0xc5, 0x26, 0x53, 0xd2, 0x44, 0xf6, 0xc0, 0x99, 0x2e,
-	0x6c, 0xf6, 0x3f, 0x87, 0x75, 0x8a, 0xfb, 0x96, 0xe8, 0x0f, 0xb7, 0x5f, 0x33, 0x23, 0x38, 0xe8,
-	0xc8, 0x0d, 0x1e, 0xe5, 0xbc, 0xe0, 0xa9, 0x34, 0x8f, 0x6c, 0xe4, 0x4c, 0x17, 0x66, 0xdf, 0xe1,
-	0x51, 0xe3, 0x9d, 0xb2, 0x63, 0xcf, 0x5f, 0x11, 0x3e, 0xfd, 0x6b, 0x4d, 0x4c, 0x3c, 0xe6, 0x51,
-	0x54, 0x80, 0x6c, 0xb6, 0x41, 0xce, 0x24, 0xd8, 0x5f, 0x09, 0xc1, 0xc3, 0x50, 0x44, 0xa0, 0x23,
-	0x26, 0x81, 0x9e, 0x89, 0x8f, 0xc7, 0x52, 0x89, 0x82, 0xc7, 0x60, 0x0e, 0xf4, 0xee, 0x97, 0xfd,
-	0x64, 0xfd, 0x4d, 0xff, 0xac, 0x09, 0xfe, 0xf8, 0x9e, 0x8d, 0x97, 0x2d, 0x3f, 0xd8, 0x0b, 0xfd,
-	0xbb, 0x6d, 0x45, 0xd1, 0xae, 0xa2, 0xe8, 0xa7, 0xa2, 0xe8, 0xbd, 0xa6, 0xc6, 0xae, 0xa6, 0xc6,
-	0x67, 0x4d, 0x8d, 0xa7, 0xab, 0x38, 0x51, 0xeb, 0xcd, 0x8a, 0x85, 0x22, 0x6d, 0x7a, 0x15, 0xb2,
-	0x3b, 0x4b, 0xef, 0xd6, 0x7d, 0xd6, 0x85, 0xab, 0x97, 0x1c, 0xe4, 0x6a, 0xa4, 0xab, 0xbd, 0xfe,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0x84, 0x47, 0xe2, 0x82, 0xc0, 0x01, 0x00, 0x00,
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcf, 0x6a, 0xfa, 0x40,
+	0x10, 0xc7, 0xb3, 0x3f, 0x45, 0x7f, 0xae, 0xf6, 0x0f, 0x4b, 0xa1, 0xc1, 0xc3, 0x2a, 0x1e, 0x8a,
+	0xa7, 0x2c, 0x5a, 0x28, 0xf4, 0x56, 0x73, 0x29, 0xbd, 0x49, 0xbc, 0xf5, 0x52, 0xd6, 0x75, 0x88,
+	0x81, 0xc6, 0x0d, 0xd9, 0x35, 0xd4, 0x6b, 0x9f, 0xa0, 0xcf, 0xd1, 0x27, 0xf1, 0xe8, 0xa5, 0xd0,
+	0x53, 0x5b, 0xf4, 0x45, 0xca, 0x6e, 0xa2, 0xb4, 0xcd, 0x25, 0x7c, 0x33, 0xf3, 0xfd, 0xcc, 0xcc,
+	0xce, 0x60, 0x0a, 0x7a, 0x0e, 0x69, 0x1c, 0x2d, 0x34, 0x83, 0x2c, 0x66, 0xd9, 0x80, 0x85, 0xb0,
+	0x00, 0x15, 0x29, 0x2f, 0x49, 0xa5, 0x96, 0xe4, 0xf4, 0x90, 0xf7, 0x20, 0x8b, 0xbd, 0x6c, 0xd0,
+	0x6e, 0x97, 0x08, 0x93, 0xb0, 0xee, 0xf6, 0x59, 0x28, 0x43, 0x69, 0x25, 0x33, 0x2a, 0x8f, 0xf6,
+	0xde, 0x10, 0x6e, 0xdd, 0xe6, 0x55, 0x27, 0x9a, 0x6b, 0x20, 0x3e, 0xfe, 0xcf, 0x85, 0x90, 0xcb,
+	0x85, 0x56, 0x2e, 0xea, 0x56, 0xfa, 0xcd, 0x61, 0xd7, 0xfb, 0xdb, 0xc7, 0x2b, 0x88, 0x51, 0x6e,
+	0xf4, 0xab, 0xeb, 0x8f, 0x8e, 0x13, 0x1c, 0x38, 0x72, 0x85, 0x6b, 0x09, 0x4f, 0x79, 0xac, 0xdc,
+	0x7f, 0x5d, 0xd4, 0x6f, 0x0e, 0xdd, 0x72, 0x85, 0xb1, 0xcd, 0x17, 0x64, 0xe1, 0x26, 0x77, 0xf8,
+	0x88, 0x0b, 0x01, 0x4a, 0x3d, 0x24, 0xf2, 0x31, 0x12, 0x2b, 0xb7, 0x62, 0x71, 0x5a, 0xc6, 0x47,
+	0xd6, 0x36, 0xb6, 0xae, 0xa2, 0x48, 0x8b, 0xff, 0x88, 0xf5, 0x9e, 0x11, 0x3e, 0xfe, 0x3d, 0x25,
+	0x71, 0x71, 0x9d, 0xcf, 0x66, 0x29, 0x28, 0xf3, 0x30, 0xd4, 0x6f, 0x04, 0xfb, 0x5f, 0x42, 0x70,
+	0x55, 0xc8, 0x19, 0xd8, 0x69, 0x1b, 0x81, 0xd5, 0xc4, 0xc7, 0x75, 0xa5, 0x65, 0xca, 0x43, 0x70,
+	0x2b, 0x76, 0x0d, 0xe7, 0xe5, 0x29, 0xec, 0xc6, 0xfc, 0x13, 0xd3, 0xfe, 0xf5, 0xb3, 0x53, 0x9f,
+	0xe4, 0xfe, 0x60, 0x0f, 0xfa, 0x37, 0xeb, 0x2d, 0x45, 0x9b, 0x2d, 0x45, 0x5f, 0x5b, 0x8a, 0x5e,
+	0x76, 0xd4, 0xd9, 0xec, 0xa8, 0xf3, 0xbe, 0xa3, 0xce, 0xfd, 0x45, 0x18, 0xe9, 0xf9, 0x72, 0xea,
+	0x09, 0x19, 0x9b, 0x13, 0x49, 0x55, 0x7c, 0xb3, 0xc1, 0x35, 0x7b, 0x32, 0x9a, 0xe9, 0x55, 0x02,
+	0x6a, 0x5a, 0xb3, 0x57, 0xba, 0xfc, 0x1e, 0x00, 0x8b, 0xeb, 0xe1, 0x37, 0x0b, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +205,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.AccessPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -297,6 +318,8 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.AccessPolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -425,6 +448,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -102,7 +103,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 
 						Code: suite.code,
 						Storage: Storage{
-							{Key: suite.hash.String()},
+							NewState(suite.hash, common.Hash{}),
 						},
 					},
 				},
@@ -211,6 +212,17 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid access policy",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AccessPolicy: AccessPolicy{
+					DeployerAllowlist: []string{suite.address},
+					DeployerDenylist:  []string{suite.address},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -223,3 +235,78 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *GenesisTestSuite) TestNormalizeGenesisEntries() {
+	const (
+		checksummed = "0xde0B295669a9FD93d5F28D9Ec85E40f4cb697BAe"
+		lower       = "0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae"
+		one         = "0x0000000000000000000000000000000000000000000000000000000000000001"
+	)
+
+	testCases := []struct {
+		name       string
+		normalize  func(string) (string, error)
+		validate   func(string) error
+		value      string
+		normalized string
+		expValid   bool
+	}{
+		{"checksummed address", NormalizeGenesisAddress, ValidateGenesisAddress, checksummed, checksummed, true},
+		{"lower-case address", NormalizeGenesisAddress, ValidateGenesisAddress, lower, checksummed, true},
+		{"upper-case address", NormalizeGenesisAddress, ValidateGenesisAddress, "0xDE0B295669A9FD93D5F28D9EC85E40F4CB697BAE", checksummed, false},
+		{"address without prefix", NormalizeGenesisAddress, ValidateGenesisAddress, lower[2:], checksummed, false},
+		{"address with invalid checksum", NormalizeGenesisAddress, ValidateGenesisAddress, "0xDe0B295669a9FD93d5F28D9Ec85E40f4cb697BAe", "", false},
+		{"short address", NormalizeGenesisAddress, ValidateGenesisAddress, "0x1234", "", false},
+		{"normalized hash", NormalizeGenesisHash, ValidateGenesisHash, one, one, true},
+		{"short hash", NormalizeGenesisHash, ValidateGenesisHash, "0x1", one, false},
+		{"hash without prefix", NormalizeGenesisHash, ValidateGenesisHash, one[2:], one, false},
+		{"upper-case hash", NormalizeGenesisHash, ValidateGenesisHash, "0x" + strings.Repeat("A", 64), "0x" + strings.Repeat("a", 64), false},
+		{"empty hash", NormalizeGenesisHash, ValidateGenesisHash, "", common.Hash{}.Hex(), false},
+		{"long hash", NormalizeGenesisHash, ValidateGenesisHash, one + "00", "", false},
+		{"invalid hash", NormalizeGenesisHash, ValidateGenesisHash, "0xzz", "", false},
+		{"normalized code", NormalizeGenesisCode, ValidateGenesisCode, "60ff", "60ff", true},
+		{"empty code", NormalizeGenesisCode, ValidateGenesisCode, "", "", true},
+		{"prefixed code", NormalizeGenesisCode, ValidateGenesisCode, "0x60ff", "60ff", false},
+		{"upper-case code", NormalizeGenesisCode, ValidateGenesisCode, "60FF", "60ff", false},
+		{"odd length code", NormalizeGenesisCode, ValidateGenesisCode, "60f", "", false},
+	}
+
+	for _, tc := range testCases {
+		normalized, err := tc.normalize(tc.value)
+		if tc.normalized != "" || tc.expValid {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(tc.normalized, normalized, tc.name)
+			suite.Require().NoError(tc.validate(normalized), tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+
+		if tc.expValid {
+			suite.Require().NoError(tc.validate(tc.value), tc.name)
+		} else {
+			suite.Require().Error(tc.validate(tc.value), tc.name)
+		}
+	}
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesisDuplicatedAddressCase() {
+	genState := DefaultGenesisState()
+	genState.Accounts = []GenesisAccount{
+		{Address: suite.address},
+		{Address: strings.ToLower(suite.address)},
+	}
+	suite.Require().ErrorContains(genState.Validate(), "duplicated genesis account")
+
+	genState.Accounts = []GenesisAccount{
+		{
+			Address: suite.address,
+			Storage: Storage{NewState(suite.hash, suite.hash), {Key: strings.ToUpper(suite.hash.Hex()[2:]), Value: "0x1"}},
+		},
+	}
+	suite.Require().Error(genState.Validate())
+
+	normalized, err := genState.Normalize()
+	suite.Require().NoError(err)
+	suite.Require().Equal(Storage{NewState(suite.hash, suite.hash), NewState(suite.hash, common.BigToHash(common.Big1))}, normalized.Accounts[0].Storage)
+	suite.Require().ErrorContains(normalized.Validate(), "duplicate state key")
+}
@@ -4,12 +4,17 @@ package types
 
 import (
 	"math/big"
+	"time"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 
@@ -31,15 +36,41 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	authtypes.BankKeeper
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	BlockedAddr(addr sdk.AccAddress) bool
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
-// StakingKeeper returns the historical headers kept in store.
+// StakingKeeper returns the historical headers kept in store and moves the
+// staking positions of migrated accounts.
 type StakingKeeper interface {
 	GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
 	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, found bool)
+
+	Hooks() stakingtypes.StakingHooks
+	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
+	SetDelegation(ctx sdk.Context, delegation stakingtypes.Delegation)
+	RemoveDelegation(ctx sdk.Context, delegation stakingtypes.Delegation) error
+	GetUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) []stakingtypes.UnbondingDelegation
+	SetUnbondingDelegation(ctx sdk.Context, ubd stakingtypes.UnbondingDelegation)
+	RemoveUnbondingDelegation(ctx sdk.Context, ubd stakingtypes.UnbondingDelegation)
+	SetUnbondingDelegationByUnbondingID(ctx sdk.Context, ubd stakingtypes.UnbondingDelegation, id uint64)
+	InsertUBDQueue(ctx sdk.Context, ubd stakingtypes.UnbondingDelegation, completionTime time.Time)
+	GetRedelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) []stakingtypes.Redelegation
+	SetRedelegation(ctx sdk.Context, red stakingtypes.Redelegation)
+	RemoveRedelegation(ctx sdk.Context, red stakingtypes.Redelegation)
+	SetRedelegationByUnbondingID(ctx sdk.Context, red stakingtypes.Redelegation, id uint64)
+	InsertRedelegationQueue(ctx sdk.Context, red stakingtypes.Redelegation, completionTime time.Time)
+}
+
+// AuthzKeeper defines the expected interface needed to move the grants of
+// migrated accounts.
+type AuthzKeeper interface {
+	IterateGrants(ctx sdk.Context, handler func(granterAddr, granteeAddr sdk.AccAddress, grant authz.Grant) bool)
+	SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error
+	DeleteGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) error
 }
 
 // FeeMarketKeeper
@@ -47,6 +78,7 @@ type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) *big.Int
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	CalculateBaseFee(ctx sdk.Context) *big.Int
+	AddTransientTxReward(ctx sdk.Context, reward *big.Int, gasUsed uint64)
 }
 
 // Erc20Keeper defines the expected interface needed to instantiate ERC20 precompiles.
@@ -54,6 +86,23 @@ type Erc20Keeper interface {
 	GetERC20PrecompileInstance(ctx sdk.Context, address common.Address) (contract vm.PrecompiledContract, found bool, err error)
 }
 
+// VersionedMultiStore defines the expected interface needed to load the committed
+// state at a given height.
+type VersionedMultiStore interface {
+	CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error)
+}
+
+// EvmHooks defines the hooks run after the processing of the EVM transactions.
+type EvmHooks interface {
+	// PostTxProcessing is called with the EVM state, logs and receipt of a
+	// successful transaction. A failing hook only reverts the changes of the
+	// hooks, unless its error wraps ErrPostTxProcessing, which reverts the
+	// transaction. The gas consumed by the hooks is charged to the
+	// transaction. The hooks also run on the eth_call and eth_estimateGas
+	// queries, so that the estimated gas covers them.
+	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
@@ -3,6 +3,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -29,6 +30,16 @@ const (
 	prefixStorage
 	prefixParams
 	prefixCodeHash
+	prefixMigratedAccount
+	prefixStoragePruneQueue
+	prefixContractGas
+	prefixContractGasIndex
+	prefixBlockedAddress
+	prefixSystemContract
+	prefixBlockHash
+	prefixPaymaster
+	prefixContractCalls
+	prefixAccessPolicy
 )
 
 // prefix bytes for the EVM transient store
@@ -37,6 +48,8 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientTxLogs
+	prefixTransientFeePayer
 )
 
 // KVStore key prefixes
@@ -45,6 +58,36 @@ var (
 	KeyPrefixStorage  = []byte{prefixStorage}
 	KeyPrefixParams   = []byte{prefixParams}
 	KeyPrefixCodeHash = []byte{prefixCodeHash}
+	// KeyPrefixMigratedAccount maps the accounts migrated to Ethereum accounts
+	// to their destination
+	KeyPrefixMigratedAccount = []byte{prefixMigratedAccount}
+	// KeyPrefixStoragePruneQueue holds the addresses of the self-destructed
+	// contracts whose storage is not fully deleted yet
+	KeyPrefixStoragePruneQueue = []byte{prefixStoragePruneQueue}
+	// KeyPrefixContractGas maps the contracts called in an epoch to the gas
+	// used by the txs calling them
+	KeyPrefixContractGas = []byte{prefixContractGas}
+	// KeyPrefixContractGasIndex indexes the contracts called in an epoch by
+	// descending gas used
+	KeyPrefixContractGasIndex = []byte{prefixContractGasIndex}
+	// KeyPrefixBlockedAddress indexes the blocked addresses of the params for
+	// constant time lookups
+	KeyPrefixBlockedAddress = []byte{prefixBlockedAddress}
+	// KeyPrefixSystemContract maps the contracts installed through governance
+	// to their provenance
+	KeyPrefixSystemContract = []byte{prefixSystemContract}
+	// KeyPrefixBlockHash maps the recent block heights to their hash for the
+	// BLOCKHASH opcode
+	KeyPrefixBlockHash = []byte{prefixBlockHash}
+	// KeyPrefixPaymaster indexes the paymasters of the params for constant
+	// time lookups
+	KeyPrefixPaymaster = []byte{prefixPaymaster}
+	// KeyPrefixContractCalls maps the contracts called in an epoch to the
+	// number of txs calling them and of those that reverted
+	KeyPrefixContractCalls = []byte{prefixContractCalls}
+	// KeyPrefixAccessPolicy stores the access policy restricting the contract
+	// deployments and calls
+	KeyPrefixAccessPolicy = []byte{prefixAccessPolicy}
 )
 
 // Transient Store key prefixes
@@ -53,6 +96,10 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientTxLogs  = []byte{prefixTransientTxLogs}
+	// KeyPrefixTransientFeePayer maps the hashes of the EVM txs of the block
+	// whose fees are not paid by their sender to the fee payer
+	KeyPrefixTransientFeePayer = []byte{prefixTransientFeePayer}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -64,3 +111,47 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// CodeHashKey defines the key under which the code hash of an account is
+// stored.
+func CodeHashKey(address common.Address) []byte {
+	return append(KeyPrefixCodeHash, address.Bytes()...)
+}
+
+// ContractGasEpochPrefix returns a prefix to iterate over the gas used by the
+// contracts called in the given epoch.
+func ContractGasEpochPrefix(epoch uint64) []byte {
+	return append(append([]byte{}, KeyPrefixContractGas...), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// ContractGasKey defines the key under which the gas used by the txs calling
+// a contract in an epoch is stored.
+func ContractGasKey(epoch uint64, address common.Address) []byte {
+	return append(ContractGasEpochPrefix(epoch), address.Bytes()...)
+}
+
+// ContractGasIndexEpochPrefix returns a prefix to iterate over the contracts
+// called in the given epoch by descending gas used.
+func ContractGasIndexEpochPrefix(epoch uint64) []byte {
+	return append(append([]byte{}, KeyPrefixContractGasIndex...), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// ContractCallsEpochPrefix returns a prefix to iterate over the calls of the
+// contracts called in the given epoch.
+func ContractCallsEpochPrefix(epoch uint64) []byte {
+	return append(append([]byte{}, KeyPrefixContractCalls...), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// ContractCallsKey defines the key under which the number of txs calling a
+// contract in an epoch, and of those that reverted, is stored.
+func ContractCallsKey(epoch uint64, address common.Address) []byte {
+	return append(ContractCallsEpochPrefix(epoch), address.Bytes()...)
+}
+
+// ContractGasIndexKey defines the key of a contract in the gas used index of
+// an epoch. The gas used is inverted so that the contracts that used the most
+// gas come first in ascending key order.
+func ContractGasIndexKey(epoch, gasUsed uint64, address common.Address) []byte {
+	key := append(ContractGasIndexEpochPrefix(epoch), sdk.Uint64ToBigEndian(^gasUsed)...)
+	return append(key, address.Bytes()...)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -21,9 +22,13 @@ import (
 
 	"github.com/evmos/evmos/v19/types"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 var (
@@ -31,6 +36,9 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgMigrateAccount{}
+	_ sdk.Msg    = &MsgDeploySystemContract{}
+	_ sdk.Msg    = &MsgUpdateAccessPolicy{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -41,6 +49,10 @@ const (
 	TypeMsgEthereumTx = "ethereum_tx"
 )
 
+// MaxInitCodeSize is the maximum size of the init code of a contract creation
+// as defined by EIP-3860.
+const MaxInitCodeSize = 2 * params.MaxCodeSize
+
 // NewTx returns a reference to a new Ethereum transaction message.
 func NewTx(
 	tx *EvmTxArgs,
@@ -182,6 +194,13 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 		return err
 	}
 
+	if txData.GetTo() == nil && len(txData.GetData()) > MaxInitCodeSize {
+		return errorsmod.Wrapf(
+			ErrMaxInitCodeSizeExceeded,
+			"init code size %d exceeds the limit %d", len(txData.GetData()), MaxInitCodeSize,
+		)
+	}
+
 	// Validate Hash field after validated txData to avoid panic
 	txHash := msg.AsTransaction().Hash().Hex()
 	if msg.Hash != txHash {
@@ -191,6 +210,49 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 	return nil
 }
 
+// ValidateReplayProtection returns an error if the transaction is not replay
+// protected (i.e. non EIP-155 signed) and unprotected transactions are not
+// allowed. It's not part of ValidateBasic since the AllowUnprotectedTxs value is
+// defined on the EVM module parameters.
+func (msg MsgEthereumTx) ValidateReplayProtection(allowUnprotectedTxs bool) error {
+	if allowUnprotectedTxs || msg.AsTransaction().Protected() {
+		return nil
+	}
+
+	return errorsmod.Wrap(
+		ErrUnprotectedTx,
+		"please EIP-155 sign your transaction to protect it against replay-attacks",
+	)
+}
+
+// ValidateCalldataSize returns an error if the size of the transaction data
+// exceeds the given limit. A zero limit disables the check. It's not part of
+// ValidateBasic since the MaxCalldataBytes value is defined on the EVM module
+// parameters.
+func ValidateCalldataSize(data []byte, maxCalldataBytes uint64) error {
+	if maxCalldataBytes == 0 || uint64(len(data)) <= maxCalldataBytes {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		ErrOversizedData,
+		"calldata size %d exceeds the limit %d", len(data), maxCalldataBytes,
+	)
+}
+
+// ValidateTxBytes returns an error if the size of the encoded transaction
+// exceeds the given limit. A zero limit disables the check.
+func ValidateTxBytes(size int, maxTxBytes uint64) error {
+	if maxTxBytes == 0 || uint64(size) <= maxTxBytes {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		ErrOversizedData,
+		"tx size %d exceeds the limit %d", size, maxTxBytes,
+	)
+}
+
 // GetMsgs returns a single MsgEthereumTx as an sdk.Msg.
 func (msg *MsgEthereumTx) GetMsgs() []sdk.Msg {
 	return []sdk.Msg{msg}
@@ -199,8 +261,13 @@ func (msg *MsgEthereumTx) GetMsgs() []sdk.Msg {
 // GetSigners returns the expected signers for an Ethereum transaction message.
 // For such a message, there should exist only a single 'signer'.
 //
-// NOTE: This method panics if 'Sign' hasn't been called first.
+// NOTE: This method panics if 'Sign' hasn't been called first, unless the
+// message is unsigned and sets the 'From' field, as on gas simulations.
 func (msg *MsgEthereumTx) GetSigners() []sdk.AccAddress {
+	if msg.From != "" && msg.IsUnsigned() {
+		return []sdk.AccAddress{common.HexToAddress(msg.From).Bytes()}
+	}
+
 	data, err := UnpackTxData(msg.Data)
 	if err != nil {
 		panic(err)
@@ -215,13 +282,15 @@ func (msg *MsgEthereumTx) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
-// GetSignBytes returns the Amino bytes of an Ethereum transaction message used
-// for signing.
+// GetSignBytes returns the Amino JSON bytes of the human-readable
+// representation of the Ethereum transaction, used when the message is wrapped
+// by a Cosmos tx.
 //
-// NOTE: This method cannot be used as a chain ID is needed to create valid bytes
-// to sign over. Use 'RLPSignBytes' instead.
+// NOTE: The Ethereum signature of the transaction doesn't commit to these
+// bytes, as a chain ID is needed to create valid bytes to sign over. Use
+// 'RLPSignBytes' instead.
 func (msg MsgEthereumTx) GetSignBytes() []byte {
-	panic("must use 'RLPSignBytes' with a chain ID to get the valid bytes to sign")
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
 }
 
 // Sign calculates a secp256k1 ECDSA signature and signs the transaction. It
@@ -305,6 +374,47 @@ func (msg MsgEthereumTx) AsMessage(signer ethtypes.Signer, baseFee *big.Int) (co
 	return msg.AsTransaction().AsMessage(signer, baseFee)
 }
 
+// IsUnsigned returns true if the signature values of the transaction are
+// either empty or zero.
+func (msg MsgEthereumTx) IsUnsigned() bool {
+	tx := msg.AsTransaction()
+	if tx == nil {
+		return false
+	}
+
+	v, r, s := tx.RawSignatureValues()
+	return isZeroOrNil(v) && isZeroOrNil(r) && isZeroOrNil(s)
+}
+
+// NewUnsignedMessage creates an Ethereum core.Message from the transaction
+// using the given sender instead of recovering it from the signature. It must
+// only be used on transaction simulations.
+func NewUnsignedMessage(tx *ethtypes.Transaction, from common.Address, baseFee *big.Int) core.Message {
+	gasPrice := tx.GasPrice()
+	if baseFee != nil {
+		gasPrice = math.BigMin(new(big.Int).Add(tx.GasTipCap(), baseFee), tx.GasFeeCap())
+	}
+
+	return ethtypes.NewMessage(
+		from,
+		tx.To(),
+		tx.Nonce(),
+		tx.Value(),
+		tx.Gas(),
+		gasPrice,
+		tx.GasFeeCap(),
+		tx.GasTipCap(),
+		tx.Data(),
+		tx.AccessList(),
+		false,
+	)
+}
+
+// isZeroOrNil returns true if the given value is either nil or zero.
+func isZeroOrNil(value *big.Int) bool {
+	return value == nil || value.Sign() == 0
+}
+
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (msg *MsgEthereumTx) GetSender(chainID *big.Int) (common.Address, error) {
 	signer := ethtypes.LatestSignerForChainID(chainID)
@@ -388,3 +498,140 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// MigrateAccountSignBytes returns the text of the migration of the given
+// account to the given destination account on the given chain, which is
+// signed by the keys of both accounts.
+func MigrateAccountSignBytes(chainID string, from, to sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf(
+		"Migrate account %s to %s (%s) on chain %s",
+		from, to, common.BytesToAddress(to), chainID,
+	))
+}
+
+// MigrateAccountEthSignHash returns the hash of the migration text signed by
+// the Ethereum key of the destination account, as computed by the
+// personal_sign JSON-RPC method.
+func MigrateAccountEthSignHash(chainID string, from, to sdk.AccAddress) []byte {
+	return accounts.TextHash(MigrateAccountSignBytes(chainID, from, to))
+}
+
+// GetSigners returns the expected signers for a MsgMigrateAccount message.
+func (m MsgMigrateAccount) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgMigrateAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	from, err := sdk.AccAddressFromBech32(m.FromAddress)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+
+	to, err := sdk.AccAddressFromBech32(m.ToAddress)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if from.Equals(to) {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "the account can't be migrated to itself")
+	}
+
+	if len(m.FromPubKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidPubKey,
+			"expected %d bytes secp256k1 public key, got %d", secp256k1.PubKeySize, len(m.FromPubKey),
+		)
+	}
+
+	pubKey := &secp256k1.PubKey{Key: m.FromPubKey}
+	if !from.Equals(sdk.AccAddress(pubKey.Address())) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "public key does not match the from address %s", from)
+	}
+
+	if len(m.FromSignature) == 0 {
+		return errorsmod.Wrap(errortypes.ErrNoSignatures, "missing signature of the migrated account")
+	}
+
+	if len(m.ToSignature) != crypto.SignatureLength {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"expected %d bytes signature of the destination account, got %d", crypto.SignatureLength, len(m.ToSignature),
+		)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgMigrateAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgDeploySystemContract message.
+func (m MsgDeploySystemContract) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeploySystemContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := types.ValidateNonZeroAddress(m.Address); err != nil {
+		return err
+	}
+
+	if len(m.Code) == 0 {
+		return errorsmod.Wrap(ErrInvalidSystemContract, "empty contract code")
+	}
+
+	if len(m.Code) > params.MaxCodeSize {
+		return errorsmod.Wrapf(
+			ErrInvalidSystemContract,
+			"contract code size %d exceeds the limit of %d bytes", len(m.Code), params.MaxCodeSize,
+		)
+	}
+
+	// the code of the contracts can't start with the EOF magic byte (EIP-3541)
+	if m.Code[0] == 0xEF {
+		return errorsmod.Wrap(ErrInvalidSystemContract, "contract code starting with the 0xEF byte")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeploySystemContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateAccessPolicy message.
+func (m MsgUpdateAccessPolicy) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateAccessPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Policy.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateAccessPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
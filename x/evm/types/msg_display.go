@@ -0,0 +1,219 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/jsonpb"
+	proto "github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// displayField is the JSON field of a MsgEthereumTx that holds its
+// human-readable expansion.
+const displayField = "display"
+
+// EthereumTxDisplay is the human-readable representation of an Ethereum
+// transaction, used to inspect the transaction wrapped by a MsgEthereumTx
+// (e.g. by the signing infrastructure auditing the transactions). It is for
+// display only: the Ethereum signature of the transaction commits to the raw
+// bytes and not to this representation.
+type EthereumTxDisplay struct {
+	// Type is the Ethereum transaction type
+	Type uint8 `json:"type"`
+	// Hash is the hex formatted hash of the transaction
+	Hash string `json:"hash"`
+	// From is the hex formatted address of the sender
+	From string `json:"from"`
+	// To is the hex formatted address of the recipient, empty on contract
+	// creations
+	To string `json:"to"`
+	// Nonce is the sender account nonce
+	Nonce uint64 `json:"nonce"`
+	// Value is the amount transferred, in wei
+	Value string `json:"value"`
+	// Data is the hex formatted data payload of the transaction
+	Data string `json:"data"`
+	// Gas is the gas limit of the transaction
+	Gas uint64 `json:"gas"`
+	// GasPrice is the gas price of the transaction, in wei. For dynamic fee
+	// transactions it is equal to the gas fee cap.
+	GasPrice string `json:"gas_price"`
+	// GasFeeCap is the maximum fee per gas, in wei
+	GasFeeCap string `json:"gas_fee_cap"`
+	// GasTipCap is the maximum priority fee per gas, in wei
+	GasTipCap string `json:"gas_tip_cap"`
+	// Fee is the maximum fee paid for the transaction, in wei
+	Fee string `json:"fee"`
+	// Raw is the hex formatted canonical encoding of the transaction
+	Raw string `json:"raw"`
+}
+
+// Display returns the human-readable representation of the Ethereum
+// transaction wrapped by the message.
+func (msg MsgEthereumTx) Display() (EthereumTxDisplay, error) {
+	if _, err := UnpackTxData(msg.Data); err != nil {
+		return EthereumTxDisplay{}, err
+	}
+
+	tx := msg.AsTransaction()
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return EthereumTxDisplay{}, errorsmod.Wrap(err, "failed to encode the Ethereum tx")
+	}
+
+	// the gas fee cap is the gas price of non dynamic fee txs
+	fee := new(big.Int).Mul(tx.GasFeeCap(), new(big.Int).SetUint64(tx.Gas()))
+
+	var to string
+	if tx.To() != nil {
+		to = tx.To().Hex()
+	}
+
+	return EthereumTxDisplay{
+		Type:      tx.Type(),
+		Hash:      tx.Hash().Hex(),
+		From:      msg.From,
+		To:        to,
+		Nonce:     tx.Nonce(),
+		Value:     tx.Value().String(),
+		Data:      hexutil.Encode(tx.Data()),
+		Gas:       tx.Gas(),
+		GasPrice:  tx.GasPrice().String(),
+		GasFeeCap: tx.GasFeeCap().String(),
+		GasTipCap: tx.GasTipCap().String(),
+		Fee:       fee.String(),
+		Raw:       hexutil.Encode(raw),
+	}, nil
+}
+
+// MarshalAmino returns the human-readable representation of the message, so
+// that the Amino JSON sign bytes and the EIP-712 typed data of a Cosmos tx
+// wrapping the message display the Ethereum transaction fields.
+func (msg MsgEthereumTx) MarshalAmino() (EthereumTxDisplay, error) {
+	return msg.Display()
+}
+
+// UnmarshalAmino decodes the message from the raw bytes of its human-readable
+// representation. It fails if the other fields don't match the raw bytes.
+func (msg *MsgEthereumTx) UnmarshalAmino(display EthereumTxDisplay) error {
+	raw, err := hexutil.Decode(display.Raw)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrTxDecode, "invalid raw Ethereum tx: %s", err)
+	}
+
+	var decoded MsgEthereumTx
+	if err := decoded.UnmarshalBinary(raw); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrTxDecode, "invalid raw Ethereum tx: %s", err)
+	}
+	decoded.From = display.From
+
+	expDisplay, err := decoded.Display()
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(expDisplay, display) {
+		return errorsmod.Wrap(errortypes.ErrTxDecode, "Ethereum tx fields don't match the raw Ethereum tx")
+	}
+
+	*msg = decoded
+	return nil
+}
+
+// msgEthereumTxJSON is used to encode a MsgEthereumTx to and from the
+// Protobuf JSON format without its human-readable expansion.
+type msgEthereumTxJSON MsgEthereumTx
+
+func (m *msgEthereumTxJSON) Reset()         { *m = msgEthereumTxJSON{} }
+func (m *msgEthereumTxJSON) String() string { return (*MsgEthereumTx)(m).String() }
+func (*msgEthereumTxJSON) ProtoMessage()    {}
+
+// MarshalJSONPB implements the jsonpb.JSONPBMarshaler interface. It adds the
+// human-readable representation of the Ethereum transaction to the Protobuf
+// JSON of the message (e.g. in the txs returned by the gRPC gateway), alongside
+// its fields. The expansion is omitted if the message can't be displayed.
+func (msg *MsgEthereumTx) MarshalJSONPB(m *jsonpb.Marshaler) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := m.Marshal(&buf, (*msgEthereumTxJSON)(msg)); err != nil {
+		return nil, err
+	}
+
+	// the tx data is not unpacked when the message is nested in an Any
+	displayMsg := *msg
+	if msg.Data != nil && msg.Data.GetCachedValue() == nil && m.AnyResolver != nil {
+		txData, err := resolveTxData(m.AnyResolver, msg.Data)
+		if err != nil {
+			return buf.Bytes(), nil
+		}
+		if displayMsg.Data, err = PackTxData(txData); err != nil {
+			return buf.Bytes(), nil
+		}
+	}
+
+	display, err := displayMsg.Display()
+	if err != nil {
+		return buf.Bytes(), nil
+	}
+
+	displayBz, err := json.Marshal(display)
+	if err != nil {
+		return nil, err
+	}
+
+	// append the expansion to the JSON object, after the message fields
+	bz := bytes.TrimRight(buf.Bytes(), " \n")
+	if len(bz) == 0 || bz[len(bz)-1] != '}' {
+		return nil, fmt.Errorf("invalid MsgEthereumTx JSON: %s", buf.String())
+	}
+	bz = bz[:len(bz)-1]
+	if !bytes.HasSuffix(bytes.TrimRight(bz, " \n"), []byte("{")) {
+		bz = append(bz, ',')
+	}
+	bz = append(bz, fmt.Sprintf("%q:%s}", displayField, displayBz)...)
+
+	return bz, nil
+}
+
+// UnmarshalJSONPB implements the jsonpb.JSONPBUnmarshaler interface. The
+// human-readable representation of the Ethereum transaction is ignored, as it
+// is derived from the message fields.
+func (msg *MsgEthereumTx) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return fmt.Errorf("failed to unmarshal MsgEthereumTx JSON: %w", err)
+	}
+	delete(fields, displayField)
+
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	return u.Unmarshal(bytes.NewReader(bz), (*msgEthereumTxJSON)(msg))
+}
+
+// resolveTxData decodes the tx data packed in the given Any using the resolver
+// of the JSON marshaler.
+func resolveTxData(resolver jsonpb.AnyResolver, anyTxData *codectypes.Any) (TxData, error) {
+	msg, err := resolver.Resolve(anyTxData.TypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(anyTxData.Value, msg); err != nil {
+		return nil, err
+	}
+
+	txData, ok := msg.(TxData)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnpackAny, "cannot unpack Any into TxData %T", msg)
+	}
+	return txData, nil
+}
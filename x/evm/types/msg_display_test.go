@@ -0,0 +1,177 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/encoding"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// newSignedMsgs returns signed messages for each Ethereum tx type, including
+// a contract creation.
+func (suite *MsgsTestSuite) newSignedMsgs() []*types.MsgEthereumTx {
+	txArgs := []*types.EvmTxArgs{
+		{
+			ChainID:  suite.chainID,
+			Nonce:    1,
+			To:       &suite.to,
+			Amount:   big.NewInt(10),
+			GasLimit: 21000,
+			GasPrice: big.NewInt(5),
+			Input:    []byte("test"),
+		},
+		{
+			ChainID:  suite.chainID,
+			Nonce:    2,
+			Amount:   big.NewInt(10),
+			GasLimit: 100000,
+			GasPrice: big.NewInt(5),
+			Input:    []byte("contract"),
+			Accesses: &ethtypes.AccessList{},
+		},
+		{
+			ChainID:   suite.chainID,
+			Nonce:     3,
+			To:        &suite.to,
+			Amount:    big.NewInt(10),
+			GasLimit:  21000,
+			GasFeeCap: big.NewInt(7),
+			GasTipCap: big.NewInt(2),
+			Accesses:  &ethtypes.AccessList{},
+		},
+	}
+
+	msgs := make([]*types.MsgEthereumTx, len(txArgs))
+	for i, args := range txArgs {
+		msg := types.NewTx(args)
+		msg.From = suite.from.Hex()
+		err := msg.Sign(ethtypes.LatestSignerForChainID(suite.chainID), suite.signer)
+		suite.Require().NoError(err)
+		msgs[i] = msg
+	}
+	return msgs
+}
+
+func (suite *MsgsTestSuite) TestMsgEthereumTx_Display() {
+	for _, msg := range suite.newSignedMsgs() {
+		tx := msg.AsTransaction()
+		display, err := msg.Display()
+		suite.Require().NoError(err)
+
+		raw, err := tx.MarshalBinary()
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(tx.Type(), display.Type)
+		suite.Require().Equal(msg.Hash, display.Hash)
+		suite.Require().Equal(suite.from.Hex(), display.From)
+		if tx.To() == nil {
+			suite.Require().Empty(display.To)
+		} else {
+			suite.Require().Equal(suite.to.Hex(), display.To)
+		}
+		suite.Require().Equal(tx.Nonce(), display.Nonce)
+		suite.Require().Equal("10", display.Value)
+		suite.Require().Equal(hexutil.Encode(tx.Data()), display.Data)
+		suite.Require().Equal(tx.Gas(), display.Gas)
+		suite.Require().Equal(msg.GetFee().String(), display.Fee)
+		suite.Require().Equal(hexutil.Encode(raw), display.Raw)
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgEthereumTx_JSONRoundTrip() {
+	txConfig := suite.clientCtx.TxConfig
+
+	for _, msg := range suite.newSignedMsgs() {
+		ethSigner := ethtypes.LatestSignerForChainID(suite.chainID)
+		signHash := ethSigner.Hash(msg.AsTransaction())
+
+		tx, err := msg.BuildTx(txConfig.NewTxBuilder(), types.DefaultEVMDenom)
+		suite.Require().NoError(err)
+		txBz, err := txConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		jsonBz, err := txConfig.TxJSONEncoder()(tx)
+		suite.Require().NoError(err)
+
+		var jsonTx struct {
+			Body struct {
+				Messages []struct {
+					Display types.EthereumTxDisplay `json:"display"`
+				} `json:"messages"`
+			} `json:"body"`
+		}
+		err = json.Unmarshal(jsonBz, &jsonTx)
+		suite.Require().NoError(err)
+		suite.Require().Len(jsonTx.Body.Messages, 1)
+
+		expDisplay, err := msg.Display()
+		suite.Require().NoError(err)
+		suite.Require().Equal(expDisplay, jsonTx.Body.Messages[0].Display)
+
+		// the display fields are ignored when decoding, the tx bytes and the
+		// Ethereum sign bytes are unchanged
+		decodedTx, err := txConfig.TxJSONDecoder()(jsonBz)
+		suite.Require().NoError(err)
+		decodedBz, err := txConfig.TxEncoder()(decodedTx)
+		suite.Require().NoError(err)
+		suite.Require().Equal(txBz, decodedBz)
+
+		decodedMsg, ok := decodedTx.GetMsgs()[0].(*types.MsgEthereumTx)
+		suite.Require().True(ok)
+		suite.Require().Equal(signHash, ethSigner.Hash(decodedMsg.AsTransaction()))
+		sender, err := decodedMsg.GetSender(suite.chainID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.from, sender)
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgEthereumTx_AminoRoundTrip() {
+	aminoCdc := encoding.MakeConfig(app.ModuleBasics).Amino
+
+	for _, msg := range suite.newSignedMsgs() {
+		expDisplay, err := msg.Display()
+		suite.Require().NoError(err)
+
+		var signDoc struct {
+			Type  string                 `json:"type"`
+			Value map[string]interface{} `json:"value"`
+		}
+		signBz := msg.GetSignBytes()
+		err = json.Unmarshal(signBz, &signDoc)
+		suite.Require().NoError(err)
+		suite.Require().Equal("ethermint/MsgEthereumTx", signDoc.Type)
+		suite.Require().Equal(expDisplay.From, signDoc.Value["from"])
+		suite.Require().Equal(expDisplay.To, signDoc.Value["to"])
+		suite.Require().Equal(expDisplay.Value, signDoc.Value["value"])
+		suite.Require().Equal(expDisplay.Data, signDoc.Value["data"])
+		suite.Require().Equal(expDisplay.Fee, signDoc.Value["fee"])
+		suite.Require().Equal(expDisplay.Raw, signDoc.Value["raw"])
+
+		var decoded sdk.Msg
+		err = aminoCdc.UnmarshalJSON(signBz, &decoded)
+		suite.Require().NoError(err)
+		decodedMsg, ok := decoded.(*types.MsgEthereumTx)
+		suite.Require().True(ok)
+
+		// the decoded message is the signed one
+		suite.Require().Equal(msg.Hash, decodedMsg.Hash)
+		suite.Require().Equal(msg.From, decodedMsg.From)
+		suite.Require().Equal(msg.AsTransaction().Hash(), decodedMsg.AsTransaction().Hash())
+		display, err := decodedMsg.Display()
+		suite.Require().NoError(err)
+		suite.Require().Equal(expDisplay, display)
+
+		// display fields that don't match the raw tx are rejected
+		tampered := expDisplay
+		tampered.Value = "1000"
+		tamperedBz := aminoCdc.MustMarshalJSON(tampered)
+		tamperedBz = []byte(`{"type":"ethermint/MsgEthereumTx","value":` + string(tamperedBz) + `}`)
+		err = aminoCdc.UnmarshalJSON(tamperedBz, &decoded)
+		suite.Require().Error(err)
+	}
+}
@@ -13,10 +13,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
 	utiltx "github.com/evmos/evmos/v19/testutil/tx"
 
@@ -71,7 +73,7 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_Constructor() {
 	// suite.Require().NotNil(msg.To())
 	suite.Require().Equal(msg.GetMsgs(), []sdk.Msg{msg})
 	suite.Require().Panics(func() { msg.GetSigners() })
-	suite.Require().Panics(func() { msg.GetSignBytes() })
+	suite.Require().NotPanics(func() { msg.GetSignBytes() })
 
 	evmTx2 := &types.EvmTxArgs{
 		Nonce:    0,
@@ -507,6 +509,34 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasicAdvanced() {
 			},
 			false,
 		},
+		{
+			"pass - init code at the EIP-3860 limit",
+			func() *types.MsgEthereumTx {
+				createTx := *evmTx
+				createTx.Input = make([]byte, types.MaxInitCodeSize)
+				return types.NewTx(&createTx)
+			},
+			true,
+		},
+		{
+			"fails - init code above the EIP-3860 limit",
+			func() *types.MsgEthereumTx {
+				createTx := *evmTx
+				createTx.Input = make([]byte, types.MaxInitCodeSize+1)
+				return types.NewTx(&createTx)
+			},
+			false,
+		},
+		{
+			"pass - call data above the init code limit",
+			func() *types.MsgEthereumTx {
+				callTx := *evmTx
+				callTx.To = &suite.to
+				callTx.Input = make([]byte, types.MaxInitCodeSize+1)
+				return types.NewTx(&callTx)
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -521,6 +551,50 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasicAdvanced() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateReplayProtection() {
+	testCases := []struct {
+		msg                 string
+		signer              ethtypes.Signer
+		allowUnprotectedTxs bool
+		expectPass          bool
+	}{
+		{"pass - protected tx", ethtypes.NewEIP155Signer(suite.chainID), false, true},
+		{"pass - unprotected tx allowed", ethtypes.HomesteadSigner{}, true, true},
+		{"fail - unprotected tx rejected", ethtypes.HomesteadSigner{}, false, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			msg := types.NewTx(&types.EvmTxArgs{
+				ChainID:  suite.chainID,
+				Nonce:    1,
+				Amount:   big.NewInt(10),
+				GasLimit: 100000,
+				GasPrice: big.NewInt(1),
+			})
+			msg.From = suite.from.Hex()
+			suite.Require().NoError(msg.Sign(tc.signer, suite.signer))
+
+			err := msg.ValidateReplayProtection(tc.allowUnprotectedTxs)
+			if tc.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrUnprotectedTx)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestValidateSizeLimits() {
+	suite.Require().NoError(types.ValidateCalldataSize(make([]byte, 10), 10))
+	suite.Require().NoError(types.ValidateCalldataSize(make([]byte, 11), 0))
+	suite.Require().ErrorIs(types.ValidateCalldataSize(make([]byte, 11), 10), types.ErrOversizedData)
+
+	suite.Require().NoError(types.ValidateTxBytes(10, 10))
+	suite.Require().NoError(types.ValidateTxBytes(11, 0))
+	suite.Require().ErrorIs(types.ValidateTxBytes(11, 10), types.ErrOversizedData)
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_Sign() {
 	testCases := []struct {
 		msg        string
@@ -882,6 +956,53 @@ func (suite *MsgsTestSuite) TestTransactionCoding() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgMigrateAccount_ValidateBasic() {
+	fromPriv := secp256k1.GenPrivKey()
+	from := sdk.AccAddress(fromPriv.PubKey().Address())
+	to := sdk.AccAddress(suite.from.Bytes())
+
+	testCases := []struct {
+		msg      string
+		malleate func(msg *types.MsgMigrateAccount)
+		expErr   string
+	}{
+		{"pass", func(*types.MsgMigrateAccount) {}, ""},
+		{"fail - invalid sender", func(msg *types.MsgMigrateAccount) { msg.Sender = invalidAddress }, "invalid sender address"},
+		{"fail - invalid from address", func(msg *types.MsgMigrateAccount) { msg.FromAddress = invalidAddress }, "invalid from address"},
+		{"fail - invalid to address", func(msg *types.MsgMigrateAccount) { msg.ToAddress = invalidAddress }, "invalid to address"},
+		{"fail - migration to itself", func(msg *types.MsgMigrateAccount) { msg.ToAddress = from.String() }, "can't be migrated to itself"},
+		{"fail - invalid public key", func(msg *types.MsgMigrateAccount) { msg.FromPubKey = msg.FromPubKey[1:] }, "secp256k1 public key"},
+		{
+			"fail - public key of another account",
+			func(msg *types.MsgMigrateAccount) { msg.FromPubKey = secp256k1.GenPrivKey().PubKey().Bytes() },
+			"public key does not match",
+		},
+		{"fail - missing from signature", func(msg *types.MsgMigrateAccount) { msg.FromSignature = nil }, "missing signature"},
+		{"fail - invalid to signature", func(msg *types.MsgMigrateAccount) { msg.ToSignature = msg.ToSignature[1:] }, "signature of the destination"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			msg := &types.MsgMigrateAccount{
+				Sender:        to.String(),
+				FromAddress:   from.String(),
+				FromPubKey:    fromPriv.PubKey().Bytes(),
+				FromSignature: make([]byte, 64),
+				ToAddress:     to.String(),
+				ToSignature:   make([]byte, crypto.SignatureLength),
+			}
+			tc.malleate(msg)
+
+			err := msg.ValidateBasic()
+			if tc.expErr == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func encodeDecodeBinary(tx *ethtypes.Transaction) (*types.MsgEthereumTx, error) {
 	data, err := tx.MarshalBinary()
 	if err != nil {
@@ -909,3 +1030,79 @@ func assertEqual(orig *ethtypes.Transaction, cpy *ethtypes.Transaction) error {
 	}
 	return nil
 }
+
+func (suite *MsgsTestSuite) TestMsgDeploySystemContract_ValidateBasic() {
+	testCases := []struct {
+		msg      string
+		malleate func(msg *types.MsgDeploySystemContract)
+		expErr   string
+	}{
+		{"pass", func(*types.MsgDeploySystemContract) {}, ""},
+		{"fail - invalid authority", func(msg *types.MsgDeploySystemContract) { msg.Authority = invalidAddress }, "invalid authority address"},
+		{"fail - invalid address", func(msg *types.MsgDeploySystemContract) { msg.Address = "0x1234" }, "not a valid ethereum hex address"},
+		{"fail - zero address", func(msg *types.MsgDeploySystemContract) { msg.Address = common.Address{}.Hex() }, "must not be zero"},
+		{"fail - empty code", func(msg *types.MsgDeploySystemContract) { msg.Code = nil }, "empty contract code"},
+		{
+			"fail - code exceeding the size limit",
+			func(msg *types.MsgDeploySystemContract) { msg.Code = make([]byte, params.MaxCodeSize+1) },
+			"exceeds the limit",
+		},
+		{"fail - EOF code", func(msg *types.MsgDeploySystemContract) { msg.Code = []byte{0xEF, 0x00} }, "0xEF byte"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			msg := &types.MsgDeploySystemContract{
+				Authority: sdk.AccAddress(suite.from.Bytes()).String(),
+				Address:   suite.to.Hex(),
+				Code:      []byte{0x60, 0x00},
+				Label:     "test",
+			}
+			tc.malleate(msg)
+
+			err := msg.ValidateBasic()
+			if tc.expErr == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateAccessPolicy_ValidateBasic() {
+	testCases := []struct {
+		msg      string
+		malleate func(msg *types.MsgUpdateAccessPolicy)
+		expErr   string
+	}{
+		{"pass", func(*types.MsgUpdateAccessPolicy) {}, ""},
+		{"pass - empty policy", func(msg *types.MsgUpdateAccessPolicy) { msg.Policy = types.AccessPolicy{} }, ""},
+		{"fail - invalid authority", func(msg *types.MsgUpdateAccessPolicy) { msg.Authority = invalidAddress }, "invalid authority address"},
+		{
+			"fail - invalid policy",
+			func(msg *types.MsgUpdateAccessPolicy) { msg.Policy.ContractDenylist = []string{suite.to.Hex()} },
+			"both allowed and denied",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			msg := &types.MsgUpdateAccessPolicy{
+				Authority: sdk.AccAddress(suite.from.Bytes()).String(),
+				Policy: types.AccessPolicy{
+					DeployerAllowlist: []string{suite.from.Hex()},
+					ContractAllowlist: []string{suite.to.Hex()},
+				},
+			}
+			tc.malleate(msg)
+
+			err := msg.ValidateBasic()
+			if tc.expErr == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"slices"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
@@ -19,11 +20,29 @@ import (
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 )
 
+// BlockHashWindow is the number of most recent blocks whose hash is returned
+// by the BLOCKHASH opcode in Ethereum.
+const BlockHashWindow uint64 = 256
+
 var (
 	// DefaultEVMDenom defines the default EVM denomination on Evmos
 	DefaultEVMDenom = utils.BaseDenom
 	// DefaultAllowUnprotectedTxs rejects all unprotected txs (i.e false)
 	DefaultAllowUnprotectedTxs = false
+	// DefaultPrecompileGasRatio charges one unit of EVM gas per unit of Cosmos gas
+	// consumed by the stateful precompiles
+	DefaultPrecompileGasRatio = math.LegacyOneDec()
+	// DefaultMaxTxBytes matches the default max_tx_bytes of the CometBFT mempool
+	DefaultMaxTxBytes uint64 = 1048576
+	// DefaultMaxCalldataBytes matches the transaction size limit of the geth txpool
+	DefaultMaxCalldataBytes uint64 = 131072
+	// DefaultBlockHashRetention retains the block hashes of the BLOCKHASH window
+	DefaultBlockHashRetention = BlockHashWindow
+	// DefaultMaxLogsPerTx bounds the logs of a transaction far above the ones
+	// emitted by the regular contracts
+	DefaultMaxLogsPerTx uint64 = 10000
+	// DefaultPostTxHooksActivationHeight runs the hooks from the first block
+	DefaultPostTxHooksActivationHeight int64 = 1
 	// DefaultStaticPrecompiles defines the default active precompiles
 	DefaultStaticPrecompiles = []string{
 		P256PrecompileAddress,         // P256 precompile
@@ -42,6 +61,20 @@ var (
 		"channel-31", // Cronos
 		"channel-83", // Kava
 	}
+	// RepriceableOpCodes defines the opcodes whose constant gas cost can be
+	// overridden through the opcode gas overrides param. These are the opcodes
+	// whose cost is dominated by the access to the state store.
+	RepriceableOpCodes = []vm.OpCode{
+		vm.BALANCE,
+		vm.EXTCODESIZE,
+		vm.EXTCODECOPY,
+		vm.EXTCODEHASH,
+		vm.SELFBALANCE,
+		vm.SLOAD,
+		vm.SSTORE,
+		vm.CREATE,
+		vm.CREATE2,
+	}
 	DefaultCreateAllowlistAddresses []string
 	DefaultCallAllowlistAddresses   []string
 	DefaultAccessControl            = AccessControl{
@@ -65,6 +98,17 @@ func NewParams(
 	activeStaticPrecompiles,
 	evmChannels []string,
 	accessControl AccessControl,
+	precompileGasRatio math.LegacyDec,
+	maxTxBytes, maxCalldataBytes uint64,
+	opcodeGasOverrides []OpcodeGasOverride,
+	opcodeGasOverridesActivationHeight int64,
+	contractGasEpochBlocks, contractGasRetainedEpochs uint64,
+	blockedAddresses []string,
+	bannedOpcodes []string,
+	blockHashRetention uint64,
+	maxLogsPerTx uint64,
+	paymasters []string,
+	postTxHooksActivationHeight int64,
 ) Params {
 	return Params{
 		EvmDenom:                evmDenom,
@@ -74,6 +118,25 @@ func NewParams(
 		ActiveStaticPrecompiles: activeStaticPrecompiles,
 		EVMChannels:             evmChannels,
 		AccessControl:           accessControl,
+		PrecompileGasRatio:      precompileGasRatio,
+		MaxTxBytes:              maxTxBytes,
+		MaxCalldataBytes:        maxCalldataBytes,
+
+		OpcodeGasOverrides:                 opcodeGasOverrides,
+		OpcodeGasOverridesActivationHeight: opcodeGasOverridesActivationHeight,
+
+		ContractGasEpochBlocks:    contractGasEpochBlocks,
+		ContractGasRetainedEpochs: contractGasRetainedEpochs,
+
+		BlockedAddresses: blockedAddresses,
+		BannedOpcodes:    bannedOpcodes,
+
+		BlockHashRetention: blockHashRetention,
+		MaxLogsPerTx:       maxLogsPerTx,
+
+		Paymasters: paymasters,
+
+		PostTxHooksActivationHeight: postTxHooksActivationHeight,
 	}
 }
 
@@ -87,6 +150,13 @@ func DefaultParams() Params {
 		ActiveStaticPrecompiles: DefaultStaticPrecompiles,
 		EVMChannels:             DefaultEVMChannels,
 		AccessControl:           DefaultAccessControl,
+		PrecompileGasRatio:      DefaultPrecompileGasRatio,
+		MaxTxBytes:              DefaultMaxTxBytes,
+		MaxCalldataBytes:        DefaultMaxCalldataBytes,
+		BlockHashRetention:      DefaultBlockHashRetention,
+		MaxLogsPerTx:            DefaultMaxLogsPerTx,
+
+		PostTxHooksActivationHeight: DefaultPostTxHooksActivationHeight,
 	}
 }
 
@@ -134,6 +204,42 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePrecompileGasRatio(p.PrecompileGasRatio); err != nil {
+		return err
+	}
+
+	if err := validateSizeLimits(p.MaxTxBytes, p.MaxCalldataBytes); err != nil {
+		return err
+	}
+
+	if err := validateOpcodeGasOverrides(p.OpcodeGasOverrides, p.OpcodeGasOverridesActivationHeight); err != nil {
+		return err
+	}
+
+	if err := validateContractGas(p.ContractGasEpochBlocks, p.ContractGasRetainedEpochs); err != nil {
+		return err
+	}
+
+	if err := validateAddresses("blocked", p.BlockedAddresses); err != nil {
+		return err
+	}
+
+	if err := validateAddresses("paymaster", p.Paymasters); err != nil {
+		return err
+	}
+
+	if err := validateBannedOpcodes(p.BannedOpcodes); err != nil {
+		return err
+	}
+
+	if p.PostTxHooksActivationHeight < 0 {
+		return fmt.Errorf("post tx hooks activation height cannot be negative: %d", p.PostTxHooksActivationHeight)
+	}
+
+	if p.BlockHashRetention != 0 && p.BlockHashRetention < BlockHashWindow {
+		return fmt.Errorf("block hash retention %d cannot be lower than %d", p.BlockHashRetention, BlockHashWindow)
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -154,6 +260,75 @@ func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
 	return precompiles
 }
 
+// GetPrecompileGasRatio returns the ratio used to convert the Cosmos gas consumed
+// by the stateful precompiles into EVM gas. Params stored before the ratio was
+// introduced fall back to the default ratio.
+func (p Params) GetPrecompileGasRatio() math.LegacyDec {
+	if p.PrecompileGasRatio.IsNil() {
+		return DefaultPrecompileGasRatio
+	}
+	return p.PrecompileGasRatio
+}
+
+// OpcodeGasOverridesActive returns true if the opcode gas overrides are applied
+// at the given block height.
+func (p Params) OpcodeGasOverridesActive(height int64) bool {
+	return len(p.OpcodeGasOverrides) > 0 && height >= p.OpcodeGasOverridesActivationHeight
+}
+
+// PostTxHooksActive returns true if the module hooks run after the Ethereum
+// transactions at the given block height. The params stored before the
+// activation height was introduced leave the hooks disabled.
+func (p Params) PostTxHooksActive(height int64) bool {
+	return p.PostTxHooksActivationHeight > 0 && height >= p.PostTxHooksActivationHeight
+}
+
+// OpCodeGasOverrides returns the constant gas costs of the opcodes that are
+// overridden at the given block height, keyed by opcode.
+func (p Params) OpCodeGasOverrides(height int64) map[vm.OpCode]uint64 {
+	if !p.OpcodeGasOverridesActive(height) {
+		return nil
+	}
+
+	overrides := make(map[vm.OpCode]uint64, len(p.OpcodeGasOverrides))
+	for _, override := range p.OpcodeGasOverrides {
+		overrides[vm.StringToOp(override.Opcode)] = override.Gas
+	}
+	return overrides
+}
+
+// BannedOpCodes returns the opcodes that cannot be part of the code of the
+// created contracts, or nil if no opcode is banned.
+func (p Params) BannedOpCodes() map[vm.OpCode]struct{} {
+	if len(p.BannedOpcodes) == 0 {
+		return nil
+	}
+
+	banned := make(map[vm.OpCode]struct{}, len(p.BannedOpcodes))
+	for _, opcode := range p.BannedOpcodes {
+		banned[vm.StringToOp(opcode)] = struct{}{}
+	}
+	return banned
+}
+
+// RetainedBlockHashes returns the number of recent block hashes kept in the
+// store, which is the BLOCKHASH window if the retention is not set.
+func (p Params) RetainedBlockHashes() uint64 {
+	if p.BlockHashRetention == 0 {
+		return BlockHashWindow
+	}
+	return p.BlockHashRetention
+}
+
+// ContractGasEpoch returns the contract gas epoch of the given block height
+// and false if the contract gas accounting is disabled.
+func (p Params) ContractGasEpoch(height int64) (uint64, bool) {
+	if p.ContractGasEpochBlocks == 0 || height < 0 {
+		return 0, false
+	}
+	return uint64(height) / p.ContractGasEpochBlocks, true
+}
+
 // IsEVMChannel returns true if the channel provided is in the list of
 // EVM channels
 func (p Params) IsEVMChannel(channel string) bool {
@@ -228,6 +403,89 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validatePrecompileGasRatio(i interface{}) error {
+	ratio, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid precompile gas ratio type: %T", i)
+	}
+
+	if ratio.IsNil() || !ratio.IsPositive() {
+		return fmt.Errorf("precompile gas ratio must be positive: %s", ratio)
+	}
+
+	return nil
+}
+
+func validateSizeLimits(maxTxBytes, maxCalldataBytes uint64) error {
+	if maxTxBytes != 0 && maxCalldataBytes > maxTxBytes {
+		return fmt.Errorf("max calldata bytes %d cannot exceed max tx bytes %d", maxCalldataBytes, maxTxBytes)
+	}
+
+	return nil
+}
+
+func validateOpcodeGasOverrides(overrides []OpcodeGasOverride, activationHeight int64) error {
+	if activationHeight < 0 {
+		return fmt.Errorf("opcode gas overrides activation height cannot be negative: %d", activationHeight)
+	}
+
+	seenOpCodes := make(map[vm.OpCode]struct{}, len(overrides))
+	for _, override := range overrides {
+		op := vm.StringToOp(override.Opcode)
+		if op.String() != override.Opcode || !slices.Contains(RepriceableOpCodes, op) {
+			return fmt.Errorf("opcode %q cannot be repriced, valid opcodes are: %s", override.Opcode, RepriceableOpCodes)
+		}
+
+		if _, ok := seenOpCodes[op]; ok {
+			return fmt.Errorf("duplicate opcode gas override: %s", override.Opcode)
+		}
+		seenOpCodes[op] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateContractGas(epochBlocks, retainedEpochs uint64) error {
+	if epochBlocks != 0 && retainedEpochs == 0 {
+		return fmt.Errorf("contract gas retained epochs must be positive when the accounting is enabled")
+	}
+	return nil
+}
+
+// validateAddresses checks that the given list of addresses only holds
+// distinct hex addresses, the kind of the addresses being used in the errors.
+func validateAddresses(kind string, addresses []string) error {
+	seenAddresses := make(map[common.Address]struct{})
+	for _, address := range addresses {
+		if err := types.ValidateAddress(address); err != nil {
+			return fmt.Errorf("invalid %s address %s", kind, address)
+		}
+
+		addr := common.HexToAddress(address)
+		if _, ok := seenAddresses[addr]; ok {
+			return fmt.Errorf("duplicate %s address %s", kind, address)
+		}
+		seenAddresses[addr] = struct{}{}
+	}
+	return nil
+}
+
+func validateBannedOpcodes(opcodes []string) error {
+	seenOpCodes := make(map[vm.OpCode]struct{}, len(opcodes))
+	for _, opcode := range opcodes {
+		op := vm.StringToOp(opcode)
+		if op.String() != opcode {
+			return fmt.Errorf("invalid banned opcode %q", opcode)
+		}
+
+		if _, ok := seenOpCodes[op]; ok {
+			return fmt.Errorf("duplicate banned opcode: %s", opcode)
+		}
+		seenOpCodes[op] = struct{}{}
+	}
+	return nil
+}
+
 func validateEIPs(i interface{}) error {
 	eips, ok := i.([]string)
 	if !ok {
@@ -3,7 +3,9 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
 
 	"github.com/stretchr/testify/require"
 )
@@ -25,7 +27,7 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			name:    "valid",
-			params:  NewParams(DefaultEVMDenom, false, DefaultChainConfig(), extraEips, nil, nil, DefaultAccessControl),
+			params:  NewParams(DefaultEVMDenom, false, DefaultChainConfig(), extraEips, nil, nil, DefaultAccessControl, DefaultPrecompileGasRatio, DefaultMaxTxBytes, DefaultMaxCalldataBytes, nil, 0, 0, 0, nil, nil, 0, 0, nil, 0),
 			expPass: true,
 		},
 		{
@@ -59,6 +61,221 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "non-positive precompile gas ratio",
+			params: func() Params {
+				params := DefaultParams()
+				params.PrecompileGasRatio = math.LegacyZeroDec()
+				return params
+			}(),
+			errContains: "precompile gas ratio must be positive",
+		},
+		{
+			name: "max calldata bytes above max tx bytes",
+			params: func() Params {
+				params := DefaultParams()
+				params.MaxCalldataBytes = params.MaxTxBytes + 1
+				return params
+			}(),
+			errContains: "max calldata bytes",
+		},
+		{
+			name: "unlimited tx bytes",
+			params: func() Params {
+				params := DefaultParams()
+				params.MaxTxBytes = 0
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "unlimited logs per tx",
+			params: func() Params {
+				params := DefaultParams()
+				params.MaxLogsPerTx = 0
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "valid opcode gas overrides",
+			params: func() Params {
+				params := DefaultParams()
+				params.OpcodeGasOverrides = []OpcodeGasOverride{{Opcode: "SLOAD", Gas: 800}, {Opcode: "SSTORE", Gas: 5000}}
+				params.OpcodeGasOverridesActivationHeight = 100
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "opcode gas override of a non repriceable opcode",
+			params: func() Params {
+				params := DefaultParams()
+				params.OpcodeGasOverrides = []OpcodeGasOverride{{Opcode: "ADD", Gas: 10}}
+				return params
+			}(),
+			errContains: "opcode \"ADD\" cannot be repriced",
+		},
+		{
+			name: "opcode gas override of an unknown opcode",
+			params: func() Params {
+				params := DefaultParams()
+				params.OpcodeGasOverrides = []OpcodeGasOverride{{Opcode: "sload", Gas: 800}}
+				return params
+			}(),
+			errContains: "opcode \"sload\" cannot be repriced",
+		},
+		{
+			name: "duplicate opcode gas overrides",
+			params: func() Params {
+				params := DefaultParams()
+				params.OpcodeGasOverrides = []OpcodeGasOverride{{Opcode: "SLOAD", Gas: 800}, {Opcode: "SLOAD", Gas: 900}}
+				return params
+			}(),
+			errContains: "duplicate opcode gas override: SLOAD",
+		},
+		{
+			name: "negative opcode gas overrides activation height",
+			params: func() Params {
+				params := DefaultParams()
+				params.OpcodeGasOverridesActivationHeight = -1
+				return params
+			}(),
+			errContains: "activation height cannot be negative",
+		},
+		{
+			name: "negative post tx hooks activation height",
+			params: func() Params {
+				params := DefaultParams()
+				params.PostTxHooksActivationHeight = -1
+				return params
+			}(),
+			errContains: "post tx hooks activation height cannot be negative",
+		},
+		{
+			name: "valid contract gas accounting",
+			params: func() Params {
+				params := DefaultParams()
+				params.ContractGasEpochBlocks = 100
+				params.ContractGasRetainedEpochs = 3
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "contract gas accounting without retained epochs",
+			params: func() Params {
+				params := DefaultParams()
+				params.ContractGasEpochBlocks = 100
+				return params
+			}(),
+			errContains: "contract gas retained epochs must be positive",
+		},
+		{
+			name: "valid blocked addresses",
+			params: func() Params {
+				params := DefaultParams()
+				params.BlockedAddresses = []string{"0x0000000000000000000000000000000000000001"}
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid blocked address",
+			params: func() Params {
+				params := DefaultParams()
+				params.BlockedAddresses = []string{"evmos1"}
+				return params
+			}(),
+			errContains: "invalid blocked address",
+		},
+		{
+			name: "duplicate blocked addresses",
+			params: func() Params {
+				params := DefaultParams()
+				params.BlockedAddresses = []string{
+					"0x000000000000000000000000000000000000000a",
+					"0x000000000000000000000000000000000000000A",
+				}
+				return params
+			}(),
+			errContains: "duplicate blocked address",
+		},
+		{
+			name: "valid paymasters",
+			params: func() Params {
+				params := DefaultParams()
+				params.Paymasters = []string{"0x0000000000000000000000000000000000000001"}
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid paymaster address",
+			params: func() Params {
+				params := DefaultParams()
+				params.Paymasters = []string{"0x1"}
+				return params
+			}(),
+			errContains: "invalid paymaster address",
+		},
+		{
+			name: "duplicate paymaster addresses",
+			params: func() Params {
+				params := DefaultParams()
+				params.Paymasters = []string{
+					"0x000000000000000000000000000000000000000a",
+					"0x000000000000000000000000000000000000000A",
+				}
+				return params
+			}(),
+			errContains: "duplicate paymaster address",
+		},
+		{
+			name: "valid banned opcodes",
+			params: func() Params {
+				params := DefaultParams()
+				params.BannedOpcodes = []string{"SELFDESTRUCT", "DELEGATECALL"}
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid banned opcode",
+			params: func() Params {
+				params := DefaultParams()
+				params.BannedOpcodes = []string{"SUICIDE"}
+				return params
+			}(),
+			errContains: "invalid banned opcode",
+		},
+		{
+			name: "duplicate banned opcodes",
+			params: func() Params {
+				params := DefaultParams()
+				params.BannedOpcodes = []string{"SELFDESTRUCT", "SELFDESTRUCT"}
+				return params
+			}(),
+			errContains: "duplicate banned opcode",
+		},
+		{
+			name: "block hash retention beyond the window",
+			params: func() Params {
+				params := DefaultParams()
+				params.BlockHashRetention = BlockHashWindow + 1
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "block hash retention below the window",
+			params: func() Params {
+				params := DefaultParams()
+				params.BlockHashRetention = BlockHashWindow - 1
+				return params
+			}(),
+			errContains: "block hash retention 255 cannot be lower than 256",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -87,12 +304,22 @@ func TestParamsValidate(t *testing.T) {
 
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []string{"ethereum_2929", "ethereum_1884", "ethereum_1344"}
-	params := NewParams("ara", false, DefaultChainConfig(), extraEips, nil, nil, DefaultAccessControl)
+	params := NewParams("ara", false, DefaultChainConfig(), extraEips, nil, nil, DefaultAccessControl, DefaultPrecompileGasRatio, DefaultMaxTxBytes, DefaultMaxCalldataBytes, nil, 0, 0, 0, nil, nil, 0, 0, nil, 0)
 	actual := params.EIPs()
 
 	require.Equal(t, []string{"ethereum_2929", "ethereum_1884", "ethereum_1344"}, actual)
 }
 
+func TestParamsGetPrecompileGasRatio(t *testing.T) {
+	params := DefaultParams()
+	params.PrecompileGasRatio = math.LegacyNewDecWithPrec(15, 1)
+	require.Equal(t, math.LegacyNewDecWithPrec(15, 1), params.GetPrecompileGasRatio())
+
+	// params stored before the ratio was introduced
+	params.PrecompileGasRatio = math.LegacyDec{}
+	require.Equal(t, DefaultPrecompileGasRatio, params.GetPrecompileGasRatio())
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateEVMDenom(false))
 	require.NoError(t, validateEVMDenom("inj"))
@@ -164,3 +391,44 @@ func TestIsLondon(t *testing.T) {
 		require.Equal(t, IsLondon(ethConfig, tc.height), tc.result)
 	}
 }
+
+func TestOpCodeGasOverrides(t *testing.T) {
+	params := DefaultParams()
+	require.Nil(t, params.OpCodeGasOverrides(1))
+
+	params.OpcodeGasOverrides = []OpcodeGasOverride{{Opcode: "SLOAD", Gas: 800}}
+	params.OpcodeGasOverridesActivationHeight = 10
+
+	require.False(t, params.OpcodeGasOverridesActive(9))
+	require.Nil(t, params.OpCodeGasOverrides(9))
+
+	require.True(t, params.OpcodeGasOverridesActive(10))
+	require.Equal(t, map[vm.OpCode]uint64{vm.SLOAD: 800}, params.OpCodeGasOverrides(10))
+}
+
+func TestPostTxHooksActive(t *testing.T) {
+	params := DefaultParams()
+	require.True(t, params.PostTxHooksActive(1))
+
+	params.PostTxHooksActivationHeight = 0
+	require.False(t, params.PostTxHooksActive(1))
+
+	params.PostTxHooksActivationHeight = 10
+	require.False(t, params.PostTxHooksActive(9))
+	require.True(t, params.PostTxHooksActive(10))
+}
+
+func TestContractGasEpoch(t *testing.T) {
+	params := DefaultParams()
+	_, enabled := params.ContractGasEpoch(10)
+	require.False(t, enabled)
+
+	params.ContractGasEpochBlocks = 10
+	params.ContractGasRetainedEpochs = 2
+
+	for height, expEpoch := range map[int64]uint64{0: 0, 9: 0, 10: 1, 25: 2} {
+		epoch, enabled := params.ContractGasEpoch(height)
+		require.True(t, enabled)
+		require.Equal(t, expEpoch, epoch)
+	}
+}
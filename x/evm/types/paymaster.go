@@ -0,0 +1,48 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// PaymasterValidationMethod is the method called on a paymaster to check
+	// if it sponsors the fees of a transaction.
+	PaymasterValidationMethod = "validatePaymasterUserOp"
+	// PaymasterValidationGasLimit is the gas limit of the paymaster validation
+	// calls, which are not charged to the transactions.
+	PaymasterValidationGasLimit = 100_000
+)
+
+// paymasterABIJSON is the interface of the paymasters. The validation is
+// given the sender, recipient (zero on contract creations), value and data of
+// the transaction, with the maximum fees the paymaster is charged.
+const paymasterABIJSON = `[
+	{"type":"function","name":"validatePaymasterUserOp","stateMutability":"view","inputs":[{"name":"sender","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},{"name":"maxCost","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+// PaymasterABI is the parsed paymaster interface
+var PaymasterABI abi.ABI
+
+func init() {
+	var err error
+	if PaymasterABI, err = abi.JSON(strings.NewReader(paymasterABIJSON)); err != nil {
+		panic(err)
+	}
+}
+
+// DesignatedPaymaster returns the address of the first entry of the given
+// access list, through which a transaction designates the paymaster of its
+// fees. The entry must not list any storage key.
+func DesignatedPaymaster(accessList ethtypes.AccessList) (common.Address, bool) {
+	if len(accessList) == 0 || len(accessList[0].StorageKeys) != 0 {
+		return common.Address{}, false
+	}
+	return accessList[0].Address, true
+}
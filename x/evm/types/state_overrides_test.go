@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func TestStateOverrideValidate(t *testing.T) {
+	addr := utiltx.GenerateAddress()
+	balance := (*hexutil.Big)(big.NewInt(1))
+	negBalance := (*hexutil.Big)(big.NewInt(-1))
+	slots := map[common.Hash]common.Hash{}
+
+	testCases := []struct {
+		name      string
+		overrides types.StateOverride
+		expError  bool
+	}{
+		{"empty", types.StateOverride{}, false},
+		{"valid", types.StateOverride{addr: {Balance: &balance, State: &slots}}, false},
+		{"state and state diff", types.StateOverride{addr: {State: &slots, StateDiff: &slots}}, true},
+		{"negative balance", types.StateOverride{addr: {Balance: &negBalance}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.overrides.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
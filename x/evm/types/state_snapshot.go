@@ -0,0 +1,327 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/sha3"
+)
+
+// StateSnapshotVersion is the version of the EVM state snapshot format.
+const StateSnapshotVersion byte = 1
+
+// maxSnapshotCodeSize bounds the size of the code read from a snapshot, well
+// above the max contract size.
+const maxSnapshotCodeSize = 1 << 20
+
+// stateSnapshotMagic prefixes the EVM state snapshots.
+var stateSnapshotMagic = []byte("EVMSNAP")
+
+// Record types of the EVM state snapshot format.
+const (
+	snapshotRecordEnd byte = iota
+	snapshotRecordAccount
+	snapshotRecordSlot
+)
+
+// StateSnapshotAccount is an EVM contract of a state snapshot.
+type StateSnapshotAccount struct {
+	Address  common.Address
+	Nonce    uint64
+	CodeHash common.Hash
+	// Code is only set on the first account with the code hash, the code is
+	// shared by the following ones.
+	Code []byte
+}
+
+// StateSnapshotWriter writes the EVM state in the snapshot format:
+//
+//	header:  "EVMSNAP" | version (1 byte) | height (uint64)
+//	account: 0x01 | address (20 bytes) | nonce (uvarint) | code hash (32 bytes) | code length (uvarint) | code
+//	slot:    0x02 | key (32 bytes) | value (32 bytes)
+//	end:     0x00 | keccak256 checksum of the preceding bytes (32 bytes)
+//
+// The storage slots belong to the account preceding them, and the code of a
+// code hash is only written once.
+type StateSnapshotWriter struct {
+	w       *bufio.Writer
+	hasher  hash.Hash
+	written map[common.Hash]struct{}
+	account bool
+}
+
+// NewStateSnapshotWriter returns a writer of the EVM state at the given
+// height, which writes the snapshot header.
+func NewStateSnapshotWriter(w io.Writer, height int64) (*StateSnapshotWriter, error) {
+	if height < 0 {
+		return nil, fmt.Errorf("invalid snapshot height %d", height)
+	}
+
+	hasher := sha3.NewLegacyKeccak256()
+	sw := &StateSnapshotWriter{
+		w:       bufio.NewWriter(io.MultiWriter(w, hasher)),
+		hasher:  hasher,
+		written: make(map[common.Hash]struct{}),
+	}
+
+	header := append([]byte{}, stateSnapshotMagic...)
+	header = append(header, StateSnapshotVersion)
+	header = binary.BigEndian.AppendUint64(header, uint64(height))
+	if _, err := sw.w.Write(header); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+// WriteAccount writes an account, whose storage slots are written next.
+func (sw *StateSnapshotWriter) WriteAccount(account StateSnapshotAccount) error {
+	code := account.Code
+	if _, ok := sw.written[account.CodeHash]; ok {
+		code = nil
+	} else if len(code) > 0 {
+		sw.written[account.CodeHash] = struct{}{}
+	}
+
+	record := []byte{snapshotRecordAccount}
+	record = append(record, account.Address.Bytes()...)
+	record = binary.AppendUvarint(record, account.Nonce)
+	record = append(record, account.CodeHash.Bytes()...)
+	record = binary.AppendUvarint(record, uint64(len(code)))
+	if _, err := sw.w.Write(record); err != nil {
+		return err
+	}
+	if _, err := sw.w.Write(code); err != nil {
+		return err
+	}
+
+	sw.account = true
+	return nil
+}
+
+// WriteSlot writes a storage slot of the last written account.
+func (sw *StateSnapshotWriter) WriteSlot(key, value common.Hash) error {
+	if !sw.account {
+		return errors.New("storage slot written before any account")
+	}
+
+	record := []byte{snapshotRecordSlot}
+	record = append(record, key.Bytes()...)
+	record = append(record, value.Bytes()...)
+	_, err := sw.w.Write(record)
+	return err
+}
+
+// Close ends the snapshot with its checksum, which is returned.
+func (sw *StateSnapshotWriter) Close() (common.Hash, error) {
+	if err := sw.w.WriteByte(snapshotRecordEnd); err != nil {
+		return common.Hash{}, err
+	}
+	if err := sw.w.Flush(); err != nil {
+		return common.Hash{}, err
+	}
+
+	checksum := common.BytesToHash(sw.hasher.Sum(nil))
+	// the checksum isn't part of the hashed bytes
+	if _, err := sw.w.Write(checksum.Bytes()); err != nil {
+		return common.Hash{}, err
+	}
+	return checksum, sw.w.Flush()
+}
+
+// StateSnapshotReader reads an EVM state snapshot written by a
+// StateSnapshotWriter.
+type StateSnapshotReader struct {
+	r        *bufio.Reader
+	hasher   hash.Hash
+	height   int64
+	checksum common.Hash
+}
+
+// NewStateSnapshotReader returns a reader of the given snapshot, which reads
+// the snapshot header.
+func NewStateSnapshotReader(r io.Reader) (*StateSnapshotReader, error) {
+	sr := &StateSnapshotReader{
+		r:      bufio.NewReader(r),
+		hasher: sha3.NewLegacyKeccak256(),
+	}
+
+	header := make([]byte, len(stateSnapshotMagic)+9)
+	if err := sr.read(header); err != nil {
+		return nil, fmt.Errorf("failed to read snapshot header: %w", err)
+	}
+	if !bytes.Equal(header[:len(stateSnapshotMagic)], stateSnapshotMagic) {
+		return nil, errors.New("not an EVM state snapshot")
+	}
+	if version := header[len(stateSnapshotMagic)]; version != StateSnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d, expected %d", version, StateSnapshotVersion)
+	}
+
+	height := binary.BigEndian.Uint64(header[len(stateSnapshotMagic)+1:])
+	if height > uint64(1<<63-1) {
+		return nil, fmt.Errorf("invalid snapshot height %d", height)
+	}
+	sr.height = int64(height)
+	return sr, nil
+}
+
+// Height returns the height of the snapshot state.
+func (sr *StateSnapshotReader) Height() int64 {
+	return sr.height
+}
+
+// Checksum returns the checksum of the snapshot once it has been read.
+func (sr *StateSnapshotReader) Checksum() common.Hash {
+	return sr.checksum
+}
+
+// Read reads the snapshot records up to its end, calling onAccount on the
+// accounts and onSlot on the storage slots of the last read account. It
+// returns an error if the snapshot is malformed or its checksum doesn't
+// match, which may only be detected once all the records have been read.
+func (sr *StateSnapshotReader) Read(
+	onAccount func(account StateSnapshotAccount) error,
+	onSlot func(address common.Address, key, value common.Hash) error,
+) error {
+	var (
+		address  common.Address
+		account  bool
+		record   = make([]byte, 2*common.HashLength)
+		recordTy = make([]byte, 1)
+	)
+
+	for {
+		if err := sr.read(recordTy); err != nil {
+			return fmt.Errorf("failed to read snapshot record: %w", err)
+		}
+
+		switch recordTy[0] {
+		case snapshotRecordEnd:
+			return sr.readChecksum()
+
+		case snapshotRecordAccount:
+			acc, err := sr.readAccount()
+			if err != nil {
+				return err
+			}
+			if err := onAccount(acc); err != nil {
+				return err
+			}
+			address, account = acc.Address, true
+
+		case snapshotRecordSlot:
+			if !account {
+				return errors.New("storage slot read before any account")
+			}
+			if err := sr.read(record); err != nil {
+				return fmt.Errorf("failed to read storage slot: %w", err)
+			}
+			key := common.BytesToHash(record[:common.HashLength])
+			value := common.BytesToHash(record[common.HashLength:])
+			if err := onSlot(address, key, value); err != nil {
+				return err
+			}
+
+		default:
+			return fmt.Errorf("unknown snapshot record type %d", recordTy[0])
+		}
+	}
+}
+
+// readAccount reads an account record, without its record type.
+func (sr *StateSnapshotReader) readAccount() (StateSnapshotAccount, error) {
+	var account StateSnapshotAccount
+
+	buf := make([]byte, common.HashLength)
+	if err := sr.read(buf[:common.AddressLength]); err != nil {
+		return account, fmt.Errorf("failed to read account address: %w", err)
+	}
+	account.Address = common.BytesToAddress(buf[:common.AddressLength])
+
+	nonce, err := sr.readUvarint()
+	if err != nil {
+		return account, fmt.Errorf("failed to read account nonce: %w", err)
+	}
+	account.Nonce = nonce
+
+	if err := sr.read(buf); err != nil {
+		return account, fmt.Errorf("failed to read account code hash: %w", err)
+	}
+	account.CodeHash = common.BytesToHash(buf)
+
+	codeSize, err := sr.readUvarint()
+	if err != nil {
+		return account, fmt.Errorf("failed to read account code size: %w", err)
+	}
+	if codeSize > maxSnapshotCodeSize {
+		return account, fmt.Errorf("code size %d of account %s exceeds %d", codeSize, account.Address, maxSnapshotCodeSize)
+	}
+	if codeSize == 0 {
+		return account, nil
+	}
+
+	account.Code = make([]byte, codeSize)
+	if err := sr.read(account.Code); err != nil {
+		return account, fmt.Errorf("failed to read account code: %w", err)
+	}
+	if codeHash := crypto.Keccak256Hash(account.Code); codeHash != account.CodeHash {
+		return account, fmt.Errorf("code hash mismatch for account %s: expected %s, got %s", account.Address, account.CodeHash, codeHash)
+	}
+	return account, nil
+}
+
+// readChecksum reads the checksum ending the snapshot and checks it against
+// the read bytes.
+func (sr *StateSnapshotReader) readChecksum() error {
+	checksum := common.BytesToHash(sr.hasher.Sum(nil))
+
+	expected := make([]byte, common.HashLength)
+	if _, err := io.ReadFull(sr.r, expected); err != nil {
+		return fmt.Errorf("failed to read snapshot checksum: %w", err)
+	}
+	if !bytes.Equal(expected, checksum.Bytes()) {
+		return fmt.Errorf("snapshot checksum mismatch: expected %s, got %s", common.BytesToHash(expected), checksum)
+	}
+
+	sr.checksum = checksum
+	return nil
+}
+
+// read fills buf from the snapshot, adding the bytes to the checksum.
+func (sr *StateSnapshotReader) read(buf []byte) error {
+	if _, err := io.ReadFull(sr.r, buf); err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	sr.hasher.Write(buf)
+	return nil
+}
+
+// readUvarint reads an unsigned varint from the snapshot, adding its bytes to
+// the checksum.
+func (sr *StateSnapshotReader) readUvarint() (uint64, error) {
+	return binary.ReadUvarint(snapshotByteReader{sr})
+}
+
+// snapshotByteReader reads the bytes of a snapshot one at a time.
+type snapshotByteReader struct {
+	sr *StateSnapshotReader
+}
+
+func (br snapshotByteReader) ReadByte() (byte, error) {
+	b := make([]byte, 1)
+	if err := br.sr.read(b); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
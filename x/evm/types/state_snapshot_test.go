@@ -0,0 +1,155 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+type snapshotSlot struct {
+	address    common.Address
+	key, value common.Hash
+}
+
+// readSnapshot reads all the records of the given snapshot.
+func readSnapshot(bz []byte) (*StateSnapshotReader, []StateSnapshotAccount, []snapshotSlot, error) {
+	sr, err := NewStateSnapshotReader(bytes.NewReader(bz))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var (
+		accounts []StateSnapshotAccount
+		slots    []snapshotSlot
+	)
+	err = sr.Read(
+		func(account StateSnapshotAccount) error {
+			accounts = append(accounts, account)
+			return nil
+		},
+		func(address common.Address, key, value common.Hash) error {
+			slots = append(slots, snapshotSlot{address, key, value})
+			return nil
+		},
+	)
+	return sr, accounts, slots, err
+}
+
+// writeTestSnapshot writes a snapshot of two contracts sharing the same code.
+func writeTestSnapshot(t *testing.T) ([]byte, common.Hash) {
+	code := []byte("contract code")
+	codeHash := crypto.Keccak256Hash(code)
+
+	var buf bytes.Buffer
+	sw, err := NewStateSnapshotWriter(&buf, 100)
+	require.NoError(t, err)
+
+	require.NoError(t, sw.WriteAccount(StateSnapshotAccount{Address: common.HexToAddress("0x1"), Nonce: 1, CodeHash: codeHash, Code: code}))
+	require.NoError(t, sw.WriteSlot(common.HexToHash("0x1"), common.HexToHash("0x2")))
+	require.NoError(t, sw.WriteSlot(common.HexToHash("0x3"), common.HexToHash("0x4")))
+	require.NoError(t, sw.WriteAccount(StateSnapshotAccount{Address: common.HexToAddress("0x2"), Nonce: 300, CodeHash: codeHash, Code: code}))
+	require.NoError(t, sw.WriteSlot(common.HexToHash("0x5"), common.HexToHash("0x6")))
+
+	checksum, err := sw.Close()
+	require.NoError(t, err)
+	return buf.Bytes(), checksum
+}
+
+func TestStateSnapshotRoundTrip(t *testing.T) {
+	bz, checksum := writeTestSnapshot(t)
+
+	sr, accounts, slots, err := readSnapshot(bz)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), sr.Height())
+	require.Equal(t, checksum, sr.Checksum())
+
+	code := []byte("contract code")
+	codeHash := crypto.Keccak256Hash(code)
+
+	// the shared code is only written once
+	require.Equal(t, []StateSnapshotAccount{
+		{Address: common.HexToAddress("0x1"), Nonce: 1, CodeHash: codeHash, Code: code},
+		{Address: common.HexToAddress("0x2"), Nonce: 300, CodeHash: codeHash},
+	}, accounts)
+	require.Equal(t, []snapshotSlot{
+		{common.HexToAddress("0x1"), common.HexToHash("0x1"), common.HexToHash("0x2")},
+		{common.HexToAddress("0x1"), common.HexToHash("0x3"), common.HexToHash("0x4")},
+		{common.HexToAddress("0x2"), common.HexToHash("0x5"), common.HexToHash("0x6")},
+	}, slots)
+}
+
+func TestStateSnapshotInvalid(t *testing.T) {
+	bz, _ := writeTestSnapshot(t)
+	headerSize := len(stateSnapshotMagic) + 9
+
+	testCases := []struct {
+		name        string
+		malleate    func([]byte) []byte
+		errContains string
+	}{
+		{
+			"not a snapshot",
+			func(bz []byte) []byte { return append([]byte("NOTSNAP"), bz[len(stateSnapshotMagic):]...) },
+			"not an EVM state snapshot",
+		},
+		{
+			"unsupported version",
+			func(bz []byte) []byte { bz[len(stateSnapshotMagic)] = 2; return bz },
+			"unsupported snapshot version 2",
+		},
+		{
+			"truncated header",
+			func(bz []byte) []byte { return bz[:headerSize-1] },
+			"failed to read snapshot header",
+		},
+		{
+			"truncated records",
+			func(bz []byte) []byte { return bz[:headerSize+30] },
+			"unexpected EOF",
+		},
+		{
+			"missing checksum",
+			func(bz []byte) []byte { return bz[:len(bz)-common.HashLength] },
+			"failed to read snapshot checksum",
+		},
+		{
+			"tampered storage",
+			func(bz []byte) []byte { bz[len(bz)-common.HashLength-2]++; return bz },
+			"snapshot checksum mismatch",
+		},
+		{
+			"tampered code",
+			func(bz []byte) []byte { bz[headerSize+1+20+1+32+1]++; return bz },
+			"code hash mismatch",
+		},
+		{
+			"unknown record",
+			func(bz []byte) []byte { bz[headerSize] = 9; return bz },
+			"unknown snapshot record type 9",
+		},
+		{
+			"slot before account",
+			func(bz []byte) []byte { bz[headerSize] = snapshotRecordSlot; return bz },
+			"storage slot read before any account",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, err := readSnapshot(tc.malleate(append([]byte{}, bz...)))
+			require.ErrorContains(t, err, tc.errContains)
+		})
+	}
+}
+
+func TestStateSnapshotWriterInvalid(t *testing.T) {
+	_, err := NewStateSnapshotWriter(&bytes.Buffer{}, -1)
+	require.Error(t, err)
+
+	sw, err := NewStateSnapshotWriter(&bytes.Buffer{}, 1)
+	require.NoError(t, err)
+	require.ErrorContains(t, sw.WriteSlot(common.Hash{}, common.Hash{}), "before any account")
+}
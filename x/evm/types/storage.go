@@ -49,13 +49,19 @@ func (s Storage) Copy() Storage {
 	return cpy
 }
 
-// Validate performs a basic validation of the State fields.
-// NOTE: state value can be empty
+// Validate performs a basic validation of the State fields. The key and the
+// value must be 0x-prefixed lower-case 32 bytes hashes.
 func (s State) Validate() error {
 	if strings.TrimSpace(s.Key) == "" {
 		return errorsmod.Wrap(ErrInvalidState, "state key hash cannot be blank")
 	}
 
+	if err := ValidateGenesisHash(s.Key); err != nil {
+		return errorsmod.Wrap(err, "invalid state key")
+	}
+	if err := ValidateGenesisHash(s.Value); err != nil {
+		return errorsmod.Wrap(err, "invalid state value")
+	}
 	return nil
 }
 
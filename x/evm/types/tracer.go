@@ -21,6 +21,10 @@ const (
 	TracerMarkdown   = "markdown"
 )
 
+// TraceContentTypeJSON is the content type of the results of the trace queries,
+// which hold the JSON output of the tracers.
+const TraceContentTypeJSON = "application/json"
+
 // NewTracer creates a new Logger tracer to collect execution traces from an
 // EVM transaction.
 func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height int64) vm.EVMLogger {
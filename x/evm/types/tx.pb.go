@@ -440,6 +440,350 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgMigrateAccount defines a Msg for moving the balances, delegations,
+// unbonding delegations, redelegations, vesting schedule and authz grants of
+// an account controlled by a Cosmos secp256k1 key to an account controlled by
+// an Ethereum eth_secp256k1 key. Both keys sign the migration, so that it can
+// be submitted and paid for by any sender.
+type MsgMigrateAccount struct {
+	// sender is the address of the account submitting the migration and paying
+	// its fees.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// from_address is the address of the migrated account.
+	FromAddress string `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// from_pub_key is the compressed secp256k1 public key of the migrated account.
+	FromPubKey []byte `protobuf:"bytes,3,opt,name=from_pub_key,json=fromPubKey,proto3" json:"from_pub_key,omitempty"`
+	// from_signature is the secp256k1 signature of the migration by the key of
+	// the migrated account.
+	FromSignature []byte `protobuf:"bytes,4,opt,name=from_signature,json=fromSignature,proto3" json:"from_signature,omitempty"`
+	// to_address is the address of the destination account.
+	ToAddress string `protobuf:"bytes,5,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// to_signature is the Ethereum personal_sign signature of the migration by
+	// the key of the destination account.
+	ToSignature []byte `protobuf:"bytes,6,opt,name=to_signature,json=toSignature,proto3" json:"to_signature,omitempty"`
+}
+
+func (m *MsgMigrateAccount) Reset()         { *m = MsgMigrateAccount{} }
+func (m *MsgMigrateAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAccount) ProtoMessage()    {}
+func (*MsgMigrateAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
+}
+func (m *MsgMigrateAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAccount.Merge(m, src)
+}
+func (m *MsgMigrateAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateAccount proto.InternalMessageInfo
+
+func (m *MsgMigrateAccount) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMigrateAccount) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgMigrateAccount) GetFromPubKey() []byte {
+	if m != nil {
+		return m.FromPubKey
+	}
+	return nil
+}
+
+func (m *MsgMigrateAccount) GetFromSignature() []byte {
+	if m != nil {
+		return m.FromSignature
+	}
+	return nil
+}
+
+func (m *MsgMigrateAccount) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgMigrateAccount) GetToSignature() []byte {
+	if m != nil {
+		return m.ToSignature
+	}
+	return nil
+}
+
+// MsgMigrateAccountResponse defines the response structure for executing a
+// MsgMigrateAccount message.
+type MsgMigrateAccountResponse struct {
+}
+
+func (m *MsgMigrateAccountResponse) Reset()         { *m = MsgMigrateAccountResponse{} }
+func (m *MsgMigrateAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAccountResponse) ProtoMessage()    {}
+func (*MsgMigrateAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+func (m *MsgMigrateAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAccountResponse.Merge(m, src)
+}
+func (m *MsgMigrateAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateAccountResponse proto.InternalMessageInfo
+
+// MsgDeploySystemContract defines a Msg for installing a contract code at a
+// given address, such as the canonical cross-chain contracts deployed on other
+// chains with presigned transactions that can't be replayed on this chain.
+type MsgDeploySystemContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the hex address of the contract.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// code is the runtime bytecode of the contract.
+	Code []byte `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// label is a human readable description of the contract (e.g. Multicall3).
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *MsgDeploySystemContract) Reset()         { *m = MsgDeploySystemContract{} }
+func (m *MsgDeploySystemContract) String() string { return proto.CompactTextString(m) }
+func (*MsgDeploySystemContract) ProtoMessage()    {}
+func (*MsgDeploySystemContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{10}
+}
+func (m *MsgDeploySystemContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeploySystemContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeploySystemContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeploySystemContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeploySystemContract.Merge(m, src)
+}
+func (m *MsgDeploySystemContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeploySystemContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeploySystemContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeploySystemContract proto.InternalMessageInfo
+
+func (m *MsgDeploySystemContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeploySystemContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgDeploySystemContract) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *MsgDeploySystemContract) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+// MsgDeploySystemContractResponse defines the response structure for executing
+// a MsgDeploySystemContract message.
+type MsgDeploySystemContractResponse struct {
+}
+
+func (m *MsgDeploySystemContractResponse) Reset()         { *m = MsgDeploySystemContractResponse{} }
+func (m *MsgDeploySystemContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeploySystemContractResponse) ProtoMessage()    {}
+func (*MsgDeploySystemContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{11}
+}
+func (m *MsgDeploySystemContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeploySystemContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeploySystemContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeploySystemContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeploySystemContractResponse.Merge(m, src)
+}
+func (m *MsgDeploySystemContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeploySystemContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeploySystemContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeploySystemContractResponse proto.InternalMessageInfo
+
+// MsgUpdateAccessPolicy defines a Msg for replacing the access policy of the
+// contract deployments and calls.
+type MsgUpdateAccessPolicy struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// policy defines the access policy to set.
+	// NOTE: All the lists must be supplied.
+	Policy AccessPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgUpdateAccessPolicy) Reset()         { *m = MsgUpdateAccessPolicy{} }
+func (m *MsgUpdateAccessPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAccessPolicy) ProtoMessage()    {}
+func (*MsgUpdateAccessPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{12}
+}
+func (m *MsgUpdateAccessPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAccessPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAccessPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAccessPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAccessPolicy.Merge(m, src)
+}
+func (m *MsgUpdateAccessPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAccessPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAccessPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAccessPolicy proto.InternalMessageInfo
+
+func (m *MsgUpdateAccessPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateAccessPolicy) GetPolicy() AccessPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return AccessPolicy{}
+}
+
+// MsgUpdateAccessPolicyResponse defines the response structure for executing a
+// MsgUpdateAccessPolicy message.
+type MsgUpdateAccessPolicyResponse struct {
+}
+
+func (m *MsgUpdateAccessPolicyResponse) Reset()         { *m = MsgUpdateAccessPolicyResponse{} }
+func (m *MsgUpdateAccessPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAccessPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{13}
+}
+func (m *MsgUpdateAccessPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAccessPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAccessPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAccessPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAccessPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateAccessPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAccessPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAccessPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAccessPolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "ethermint.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
@@ -449,73 +793,96 @@ func init() {
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgMigrateAccount)(nil), "ethermint.evm.v1.MsgMigrateAccount")
+	proto.RegisterType((*MsgMigrateAccountResponse)(nil), "ethermint.evm.v1.MsgMigrateAccountResponse")
+	proto.RegisterType((*MsgDeploySystemContract)(nil), "ethermint.evm.v1.MsgDeploySystemContract")
+	proto.RegisterType((*MsgDeploySystemContractResponse)(nil), "ethermint.evm.v1.MsgDeploySystemContractResponse")
+	proto.RegisterType((*MsgUpdateAccessPolicy)(nil), "ethermint.evm.v1.MsgUpdateAccessPolicy")
+	proto.RegisterType((*MsgUpdateAccessPolicyResponse)(nil), "ethermint.evm.v1.MsgUpdateAccessPolicyResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x13, 0xe7, 0xd7, 0x24, 0x2c, 0x2b, 0xab, 0xd5, 0x3a, 0x01, 0xe2, 0x6c, 0x90, 0x20,
-	0x8b, 0x54, 0x5b, 0x2d, 0xd2, 0x8a, 0xed, 0x89, 0x66, 0xdb, 0x45, 0x8b, 0x5a, 0xb1, 0x32, 0xd9,
-	0x0b, 0x20, 0x45, 0x53, 0x7b, 0x3a, 0x19, 0x11, 0x7b, 0x2c, 0xcf, 0xc4, 0x4a, 0x38, 0xee, 0x89,
-	0x1b, 0x20, 0xfe, 0x01, 0x0e, 0x9c, 0x38, 0x71, 0xd8, 0x33, 0xe7, 0x15, 0xa7, 0x15, 0x5c, 0x10,
-	0x07, 0x83, 0x52, 0x24, 0xa4, 0x1e, 0x39, 0x73, 0x40, 0x33, 0xe3, 0x34, 0xcd, 0x86, 0xb4, 0xb0,
-	0x12, 0xdc, 0xe6, 0xf9, 0x7d, 0xef, 0xc7, 0x7c, 0xdf, 0xf8, 0x3d, 0xd0, 0x40, 0x7c, 0x88, 0xe2,
-	0x80, 0x84, 0xdc, 0x41, 0x49, 0xe0, 0x24, 0xdb, 0x0e, 0x9f, 0xd8, 0x51, 0x4c, 0x39, 0x35, 0xae,
-	0x9f, 0xbb, 0x6c, 0x94, 0x04, 0x76, 0xb2, 0xdd, 0xbc, 0xe1, 0x51, 0x16, 0x50, 0xe6, 0x04, 0x0c,
-	0x0b, 0x64, 0xc0, 0xb0, 0x82, 0x36, 0x1b, 0xca, 0x31, 0x90, 0x96, 0xa3, 0x8c, 0xcc, 0xd5, 0x5c,
-	0x29, 0x20, 0x92, 0x29, 0xdf, 0x06, 0xa6, 0x98, 0xaa, 0x18, 0x71, 0xca, 0xbe, 0xbe, 0x8c, 0x29,
-	0xc5, 0x23, 0xe4, 0xc0, 0x88, 0x38, 0x30, 0x0c, 0x29, 0x87, 0x9c, 0xd0, 0x70, 0x9e, 0xaf, 0x91,
-	0x79, 0xa5, 0x75, 0x3c, 0x3e, 0x71, 0x60, 0x38, 0x55, 0xae, 0xce, 0x67, 0x1a, 0x78, 0xe1, 0x88,
-	0xe1, 0x03, 0x51, 0x10, 0x8d, 0x83, 0xfe, 0xc4, 0xe8, 0x02, 0xdd, 0x87, 0x1c, 0x9a, 0x5a, 0x5b,
-	0xeb, 0xd6, 0x76, 0x36, 0x6c, 0x15, 0x6b, 0xcf, 0x63, 0xed, 0xbd, 0x70, 0xea, 0x4a, 0x84, 0xd1,
-	0x00, 0x3a, 0x23, 0x9f, 0x20, 0x33, 0xdf, 0xd6, 0xba, 0x5a, 0xaf, 0x78, 0x96, 0x5a, 0xda, 0x96,
-	0x2b, 0x3f, 0x19, 0x16, 0xd0, 0x87, 0x90, 0x0d, 0xcd, 0x42, 0x5b, 0xeb, 0x56, 0x7b, 0xb5, 0x3f,
-	0x52, 0xab, 0x1c, 0x8f, 0xa2, 0xdd, 0xce, 0x56, 0xc7, 0x95, 0x0e, 0xc3, 0x00, 0xfa, 0x49, 0x4c,
-	0x03, 0x53, 0x17, 0x00, 0x57, 0x9e, 0x77, 0xf5, 0x4f, 0xbf, 0xb2, 0x72, 0x9d, 0x2f, 0xf2, 0xa0,
-	0x72, 0x88, 0x30, 0xf4, 0xa6, 0xfd, 0x89, 0xb1, 0x01, 0x8a, 0x21, 0x0d, 0x3d, 0x24, 0xbb, 0xd1,
-	0x5d, 0x65, 0x18, 0xb7, 0x41, 0x15, 0x43, 0xc1, 0x1c, 0xf1, 0x54, 0xf5, 0x6a, 0xaf, 0xf1, 0x73,
-	0x6a, 0x6d, 0x2a, 0x12, 0x99, 0xff, 0xb1, 0x4d, 0xa8, 0x13, 0x40, 0x3e, 0xb4, 0xef, 0x87, 0xdc,
-	0xad, 0x60, 0xc8, 0x1e, 0x08, 0xa8, 0xd1, 0x02, 0x05, 0x0c, 0x99, 0x6c, 0x4a, 0xef, 0xd5, 0x67,
-	0xa9, 0x55, 0x79, 0x07, 0xb2, 0x43, 0x12, 0x10, 0xee, 0x0a, 0x87, 0x71, 0x0d, 0xe4, 0x39, 0xcd,
-	0x5a, 0xca, 0x73, 0x6a, 0xdc, 0x01, 0xc5, 0x04, 0x8e, 0xc6, 0xc8, 0x2c, 0xca, 0x1a, 0xaf, 0xae,
-	0xad, 0x31, 0x4b, 0xad, 0xd2, 0x5e, 0x40, 0xc7, 0x21, 0x77, 0x55, 0x84, 0xb8, 0x9f, 0x64, 0xb1,
-	0xd4, 0xd6, 0xba, 0xf5, 0x8c, 0xaf, 0x3a, 0xd0, 0x12, 0xb3, 0x2c, 0x3f, 0x68, 0x89, 0xb0, 0x62,
-	0xb3, 0xa2, 0xac, 0x58, 0x58, 0xcc, 0xac, 0x2a, 0x8b, 0xed, 0x5e, 0x13, 0x4c, 0x7c, 0xff, 0x78,
-	0xab, 0xd4, 0x9f, 0xec, 0x43, 0x0e, 0x3b, 0xdf, 0x15, 0x40, 0x7d, 0xcf, 0xf3, 0x10, 0x63, 0x87,
-	0x84, 0xf1, 0xfe, 0xc4, 0x78, 0x17, 0x54, 0xbc, 0x21, 0x24, 0xe1, 0x80, 0xf8, 0x92, 0x9a, 0x6a,
-	0xcf, 0xb9, 0xac, 0xb9, 0xf2, 0x5d, 0x01, 0xbe, 0xbf, 0x7f, 0x96, 0x5a, 0x65, 0x4f, 0x1d, 0xdd,
-	0xec, 0xe0, 0x2f, 0x38, 0xce, 0xaf, 0xe5, 0xb8, 0xf0, 0xaf, 0x39, 0xd6, 0x2f, 0xe7, 0xb8, 0xb8,
-	0xca, 0x71, 0xe9, 0xb9, 0x39, 0x2e, 0x5f, 0xe0, 0xf8, 0x43, 0x50, 0x81, 0x92, 0x28, 0xc4, 0xcc,
-	0x4a, 0xbb, 0xd0, 0xad, 0xed, 0xbc, 0x62, 0x3f, 0xfb, 0x4f, 0xda, 0x8a, 0xca, 0xfe, 0x38, 0x1a,
-	0xa1, 0x5e, 0xfb, 0x49, 0x6a, 0xe5, 0xce, 0x52, 0x0b, 0xc0, 0x73, 0x7e, 0xbf, 0xf9, 0xc5, 0x02,
-	0x0b, 0xb6, 0xdd, 0xf3, 0x84, 0x4a, 0xc0, 0xea, 0x92, 0x80, 0x60, 0x49, 0xc0, 0xda, 0x3a, 0x01,
-	0xff, 0x2c, 0x80, 0xfa, 0xfe, 0x34, 0x84, 0x01, 0xf1, 0xee, 0x21, 0xf4, 0xbf, 0x08, 0x78, 0x07,
-	0xd4, 0x84, 0x80, 0x9c, 0x44, 0x03, 0x0f, 0x46, 0x57, 0x4b, 0x28, 0xe4, 0xee, 0x93, 0xe8, 0x2e,
-	0x8c, 0xe6, 0xa1, 0x27, 0x08, 0xc9, 0x50, 0xfd, 0x9f, 0x84, 0xde, 0x43, 0x48, 0x84, 0x66, 0xf2,
-	0x17, 0x2f, 0x97, 0xbf, 0xb4, 0x2a, 0x7f, 0xf9, 0xb9, 0xe5, 0xaf, 0xac, 0x91, 0xbf, 0xfa, 0x9f,
-	0xc8, 0x0f, 0x96, 0xe4, 0xaf, 0x2d, 0xc9, 0x5f, 0x5f, 0x27, 0x7f, 0x07, 0x34, 0x0f, 0x26, 0x1c,
-	0x85, 0x8c, 0xd0, 0xf0, 0xbd, 0x48, 0x8e, 0xe6, 0xc5, 0xc4, 0xcd, 0xe6, 0xde, 0xd7, 0x1a, 0xd8,
-	0x5c, 0x9a, 0xc4, 0x2e, 0x62, 0x11, 0x0d, 0x99, 0xbc, 0xa8, 0x1c, 0xa6, 0x9a, 0x9a, 0x95, 0x72,
-	0x7e, 0xde, 0x02, 0xfa, 0x88, 0x62, 0x66, 0xe6, 0xe5, 0x25, 0x37, 0x57, 0x2f, 0x79, 0x48, 0xb1,
-	0x2b, 0x21, 0xc6, 0x75, 0x50, 0x88, 0x11, 0x97, 0x0f, 0xa0, 0xee, 0x8a, 0xa3, 0xd1, 0x00, 0x95,
-	0x24, 0x18, 0xa0, 0x38, 0xa6, 0x71, 0x36, 0xed, 0xca, 0x49, 0x70, 0x20, 0x4c, 0xe1, 0x12, 0xd2,
-	0x8f, 0x19, 0xf2, 0x95, 0x88, 0x6e, 0x19, 0x43, 0xf6, 0x90, 0x21, 0x7f, 0x3e, 0x9e, 0x35, 0xf0,
-	0xe2, 0x11, 0xc3, 0x0f, 0x23, 0x1f, 0x72, 0xf4, 0x00, 0xc6, 0x30, 0x60, 0x62, 0x56, 0xc0, 0x31,
-	0x1f, 0xd2, 0x98, 0xf0, 0x69, 0xf6, 0x9a, 0xcd, 0x1f, 0x1e, 0x6f, 0x6d, 0x64, 0x4b, 0x6d, 0xcf,
-	0xf7, 0x63, 0xc4, 0xd8, 0xfb, 0x3c, 0x26, 0x21, 0x76, 0x17, 0x50, 0xe3, 0x36, 0x28, 0x45, 0x32,
-	0x83, 0x7c, 0xb9, 0xb5, 0x1d, 0x73, 0xf5, 0x1a, 0xaa, 0x42, 0x4f, 0x17, 0x32, 0xb9, 0x19, 0x7a,
-	0xf7, 0xda, 0xa3, 0xdf, 0xbf, 0x7d, 0x63, 0x91, 0xa7, 0xd3, 0x00, 0x37, 0x9e, 0x69, 0x69, 0xce,
-	0xdd, 0x4e, 0xaa, 0x81, 0xc2, 0x11, 0xc3, 0xc6, 0x14, 0x80, 0x0b, 0x3b, 0xce, 0x5a, 0x2d, 0xb4,
-	0x44, 0x7d, 0xf3, 0xf5, 0x2b, 0x00, 0xf3, 0xfc, 0x9d, 0x9b, 0x8f, 0x7e, 0xfc, 0xed, 0xcb, 0xfc,
-	0x4b, 0x9d, 0x86, 0x58, 0xd1, 0x94, 0x9d, 0xef, 0xeb, 0x0c, 0x39, 0xe0, 0x13, 0xe3, 0x23, 0x50,
-	0x5f, 0x62, 0xeb, 0xe6, 0xdf, 0xe6, 0xbe, 0x08, 0x69, 0xde, 0xba, 0x12, 0x32, 0x6f, 0xa0, 0xf7,
-	0xf6, 0x93, 0x59, 0x4b, 0x7b, 0x3a, 0x6b, 0x69, 0xbf, 0xce, 0x5a, 0xda, 0xe7, 0xa7, 0xad, 0xdc,
-	0xd3, 0xd3, 0x56, 0xee, 0xa7, 0xd3, 0x56, 0xee, 0x83, 0xd7, 0x30, 0xe1, 0xc3, 0xf1, 0xb1, 0xed,
-	0xd1, 0x60, 0xd1, 0x1c, 0x65, 0x4e, 0xb2, 0xfd, 0x96, 0x33, 0x91, 0x8d, 0xf2, 0x69, 0x84, 0xd8,
-	0x71, 0x49, 0xae, 0xf6, 0x37, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xee, 0x9c, 0xdd, 0x06, 0xd7,
-	0x08, 0x00, 0x00,
+	// 1241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6c, 0x1b, 0xc5,
+	0x17, 0xce, 0xda, 0xeb, 0x7f, 0xcf, 0x6e, 0x7e, 0xfd, 0xad, 0x52, 0x75, 0xed, 0x52, 0xaf, 0xe3,
+	0x0a, 0x9a, 0x82, 0xe2, 0x25, 0x41, 0x2a, 0x6a, 0xe0, 0x40, 0xdc, 0xb4, 0xa8, 0x90, 0x88, 0x68,
+	0x9b, 0x5e, 0x00, 0xc9, 0x1a, 0xaf, 0xa7, 0xeb, 0x55, 0xbd, 0x3b, 0xab, 0x9d, 0xb1, 0xe5, 0xe5,
+	0xd8, 0x13, 0x37, 0x40, 0x9c, 0x91, 0x38, 0x80, 0x84, 0x38, 0x71, 0xe8, 0x99, 0x73, 0xc5, 0xa9,
+	0x82, 0x0b, 0xe2, 0x60, 0x50, 0x8a, 0x84, 0xd4, 0x23, 0x67, 0x0e, 0x68, 0x66, 0xd6, 0x9b, 0xb8,
+	0xb6, 0xe3, 0x10, 0x09, 0x6e, 0xf3, 0x66, 0xbe, 0x37, 0xef, 0xbd, 0xef, 0x7b, 0xf3, 0xbc, 0x86,
+	0x32, 0x66, 0x5d, 0x1c, 0x7a, 0xae, 0xcf, 0x4c, 0x3c, 0xf0, 0xcc, 0xc1, 0x86, 0xc9, 0x86, 0x8d,
+	0x20, 0x24, 0x8c, 0x68, 0xe7, 0x93, 0xa3, 0x06, 0x1e, 0x78, 0x8d, 0xc1, 0x46, 0xe5, 0xa2, 0x4d,
+	0xa8, 0x47, 0xa8, 0xe9, 0x51, 0x87, 0x23, 0x3d, 0xea, 0x48, 0x68, 0xa5, 0x2c, 0x0f, 0x5a, 0xc2,
+	0x32, 0xa5, 0x11, 0x1f, 0x55, 0xa6, 0x02, 0xf0, 0xcb, 0xe4, 0xd9, 0x8a, 0x43, 0x1c, 0x22, 0x7d,
+	0xf8, 0x2a, 0xde, 0x7d, 0xc1, 0x21, 0xc4, 0xe9, 0x61, 0x13, 0x05, 0xae, 0x89, 0x7c, 0x9f, 0x30,
+	0xc4, 0x5c, 0xe2, 0x8f, 0xef, 0x2b, 0xc7, 0xa7, 0xc2, 0x6a, 0xf7, 0xef, 0x9b, 0xc8, 0x8f, 0xe4,
+	0x51, 0xfd, 0x13, 0x05, 0xce, 0xed, 0x51, 0xe7, 0x16, 0x0f, 0x88, 0xfb, 0xde, 0xc1, 0x50, 0x5b,
+	0x03, 0xb5, 0x83, 0x18, 0xd2, 0x95, 0x9a, 0xb2, 0x56, 0xdc, 0x5c, 0x69, 0x48, 0xdf, 0xc6, 0xd8,
+	0xb7, 0xb1, 0xed, 0x47, 0x96, 0x40, 0x68, 0x65, 0x50, 0xa9, 0xfb, 0x11, 0xd6, 0x53, 0x35, 0x65,
+	0x4d, 0x69, 0x66, 0x9e, 0x8d, 0x0c, 0x65, 0xdd, 0x12, 0x5b, 0x9a, 0x01, 0x6a, 0x17, 0xd1, 0xae,
+	0x9e, 0xae, 0x29, 0x6b, 0x85, 0x66, 0xf1, 0xcf, 0x91, 0x91, 0x0b, 0x7b, 0xc1, 0x56, 0x7d, 0xbd,
+	0x6e, 0x89, 0x03, 0x4d, 0x03, 0xf5, 0x7e, 0x48, 0x3c, 0x5d, 0xe5, 0x00, 0x4b, 0xac, 0xb7, 0xd4,
+	0x8f, 0xbf, 0x34, 0x96, 0xea, 0x9f, 0xa5, 0x20, 0xbf, 0x8b, 0x1d, 0x64, 0x47, 0x07, 0x43, 0x6d,
+	0x05, 0x32, 0x3e, 0xf1, 0x6d, 0x2c, 0xb2, 0x51, 0x2d, 0x69, 0x68, 0xd7, 0xa1, 0xe0, 0x20, 0xce,
+	0x9c, 0x6b, 0xcb, 0xe8, 0x85, 0x66, 0xf9, 0x97, 0x91, 0x71, 0x41, 0x92, 0x48, 0x3b, 0x0f, 0x1a,
+	0x2e, 0x31, 0x3d, 0xc4, 0xba, 0x8d, 0x3b, 0x3e, 0xb3, 0xf2, 0x0e, 0xa2, 0xfb, 0x1c, 0xaa, 0x55,
+	0x21, 0xed, 0x20, 0x2a, 0x92, 0x52, 0x9b, 0xa5, 0xc3, 0x91, 0x91, 0x7f, 0x1b, 0xd1, 0x5d, 0xd7,
+	0x73, 0x99, 0xc5, 0x0f, 0xb4, 0x65, 0x48, 0x31, 0x12, 0xa7, 0x94, 0x62, 0x44, 0xbb, 0x01, 0x99,
+	0x01, 0xea, 0xf5, 0xb1, 0x9e, 0x11, 0x31, 0xae, 0xcc, 0x8d, 0x71, 0x38, 0x32, 0xb2, 0xdb, 0x1e,
+	0xe9, 0xfb, 0xcc, 0x92, 0x1e, 0xbc, 0x3e, 0xc1, 0x62, 0xb6, 0xa6, 0xac, 0x95, 0x62, 0xbe, 0x4a,
+	0xa0, 0x0c, 0xf4, 0x9c, 0xd8, 0x50, 0x06, 0xdc, 0x0a, 0xf5, 0xbc, 0xb4, 0x42, 0x6e, 0x51, 0xbd,
+	0x20, 0x2d, 0xba, 0xb5, 0xcc, 0x99, 0xf8, 0xe1, 0xd1, 0x7a, 0xf6, 0x60, 0xb8, 0x83, 0x18, 0xaa,
+	0x7f, 0x9f, 0x86, 0xd2, 0xb6, 0x6d, 0x63, 0x4a, 0x77, 0x5d, 0xca, 0x0e, 0x86, 0xda, 0x3b, 0x90,
+	0xb7, 0xbb, 0xc8, 0xf5, 0x5b, 0x6e, 0x47, 0x50, 0x53, 0x68, 0x9a, 0x27, 0x25, 0x97, 0xbb, 0xc9,
+	0xc1, 0x77, 0x76, 0x9e, 0x8d, 0x8c, 0x9c, 0x2d, 0x97, 0x56, 0xbc, 0xe8, 0x1c, 0x71, 0x9c, 0x9a,
+	0xcb, 0x71, 0xfa, 0x1f, 0x73, 0xac, 0x9e, 0xcc, 0x71, 0x66, 0x9a, 0xe3, 0xec, 0x99, 0x39, 0xce,
+	0x1d, 0xe3, 0xf8, 0x03, 0xc8, 0x23, 0x41, 0x14, 0xa6, 0x7a, 0xbe, 0x96, 0x5e, 0x2b, 0x6e, 0x5e,
+	0x6e, 0x3c, 0xff, 0x26, 0x1b, 0x92, 0xca, 0x83, 0x7e, 0xd0, 0xc3, 0xcd, 0xda, 0xe3, 0x91, 0xb1,
+	0xf4, 0x6c, 0x64, 0x00, 0x4a, 0xf8, 0xfd, 0xf6, 0x57, 0x03, 0x8e, 0xd8, 0xb6, 0x92, 0x0b, 0xa5,
+	0x80, 0x85, 0x09, 0x01, 0x61, 0x42, 0xc0, 0xe2, 0x3c, 0x01, 0xff, 0x4a, 0x43, 0x69, 0x27, 0xf2,
+	0x91, 0xe7, 0xda, 0xb7, 0x31, 0xfe, 0x4f, 0x04, 0xbc, 0x01, 0x45, 0x2e, 0x20, 0x73, 0x83, 0x96,
+	0x8d, 0x82, 0xc5, 0x12, 0x72, 0xb9, 0x0f, 0xdc, 0xe0, 0x26, 0x0a, 0xc6, 0xae, 0xf7, 0x31, 0x16,
+	0xae, 0xea, 0x69, 0x5c, 0x6f, 0x63, 0xcc, 0x5d, 0x63, 0xf9, 0x33, 0x27, 0xcb, 0x9f, 0x9d, 0x96,
+	0x3f, 0x77, 0x66, 0xf9, 0xf3, 0x73, 0xe4, 0x2f, 0xfc, 0x2b, 0xf2, 0xc3, 0x84, 0xfc, 0xc5, 0x09,
+	0xf9, 0x4b, 0xf3, 0xe4, 0xaf, 0x43, 0xe5, 0xd6, 0x90, 0x61, 0x9f, 0xba, 0xc4, 0x7f, 0x2f, 0x10,
+	0xa3, 0xf9, 0x68, 0xe2, 0xc6, 0x73, 0xef, 0x2b, 0x05, 0x2e, 0x4c, 0x4c, 0x62, 0x0b, 0xd3, 0x80,
+	0xf8, 0x54, 0x14, 0x2a, 0x86, 0xa9, 0x22, 0x67, 0x25, 0x5f, 0x6b, 0xd7, 0x40, 0xed, 0x11, 0x87,
+	0xea, 0x29, 0x51, 0xe4, 0x85, 0xe9, 0x22, 0x77, 0x89, 0x63, 0x09, 0x88, 0x76, 0x1e, 0xd2, 0x21,
+	0x66, 0xa2, 0x01, 0x4a, 0x16, 0x5f, 0x6a, 0x65, 0xc8, 0x0f, 0xbc, 0x16, 0x0e, 0x43, 0x12, 0xc6,
+	0xd3, 0x2e, 0x37, 0xf0, 0x6e, 0x71, 0x93, 0x1f, 0x71, 0xe9, 0xfb, 0x14, 0x77, 0xa4, 0x88, 0x56,
+	0xce, 0x41, 0xf4, 0x1e, 0xc5, 0x9d, 0xf1, 0x78, 0x56, 0xe0, 0x7f, 0x7b, 0xd4, 0xb9, 0x17, 0x74,
+	0x10, 0xc3, 0xfb, 0x28, 0x44, 0x1e, 0xe5, 0xb3, 0x02, 0xf5, 0x59, 0x97, 0x84, 0x2e, 0x8b, 0xe2,
+	0x6e, 0xd6, 0x7f, 0x7c, 0xb4, 0xbe, 0x12, 0xff, 0xa8, 0x6d, 0x77, 0x3a, 0x21, 0xa6, 0xf4, 0x2e,
+	0x0b, 0x5d, 0xdf, 0xb1, 0x8e, 0xa0, 0xda, 0x75, 0xc8, 0x06, 0xe2, 0x06, 0xd1, 0xb9, 0xc5, 0x4d,
+	0x7d, 0xba, 0x0c, 0x19, 0xa1, 0xa9, 0x72, 0x99, 0xac, 0x18, 0xbd, 0xb5, 0xfc, 0xf0, 0x8f, 0xef,
+	0x5e, 0x3e, 0xba, 0xa7, 0x5e, 0x86, 0x8b, 0xcf, 0xa5, 0x34, 0xe6, 0xae, 0xfe, 0x28, 0x05, 0xff,
+	0xdf, 0xa3, 0xce, 0x9e, 0xeb, 0x84, 0x88, 0xe1, 0x6d, 0xdb, 0xe6, 0x1d, 0xa4, 0xbd, 0x0a, 0x59,
+	0x8a, 0xfd, 0x0e, 0x0e, 0x17, 0x66, 0x1b, 0xe3, 0xb4, 0x37, 0xa0, 0xc4, 0x7f, 0xa3, 0x5a, 0x48,
+	0x9e, 0xea, 0xa9, 0x05, 0x7e, 0x45, 0x8e, 0x8e, 0xb7, 0xb4, 0x5a, 0xec, 0x1c, 0xf4, 0xdb, 0xad,
+	0x07, 0x38, 0x8a, 0xa5, 0x00, 0xbe, 0xb7, 0xdf, 0x6f, 0xbf, 0x8b, 0x23, 0xed, 0x45, 0x58, 0x16,
+	0x08, 0xea, 0x3a, 0x3e, 0x62, 0xfd, 0x10, 0x0b, 0x5d, 0x4a, 0xd6, 0x39, 0xbe, 0x7b, 0x77, 0xbc,
+	0xa9, 0xbd, 0x0e, 0xc0, 0x48, 0x92, 0x43, 0x66, 0x11, 0xd3, 0x8c, 0x8c, 0x33, 0x58, 0x85, 0x12,
+	0x23, 0xc7, 0x6e, 0x97, 0x3f, 0x4b, 0x45, 0x46, 0x92, 0xbb, 0xb7, 0x8a, 0x9c, 0xd4, 0xb8, 0xdc,
+	0xfa, 0x25, 0x28, 0x4f, 0xb1, 0x96, 0x70, 0xfa, 0xb5, 0x22, 0xf8, 0xde, 0xc1, 0x41, 0x8f, 0x44,
+	0x77, 0x23, 0xca, 0xb0, 0x77, 0x93, 0xf8, 0x2c, 0x44, 0x36, 0x3b, 0x73, 0x2b, 0xe8, 0x90, 0x9b,
+	0xa0, 0xd6, 0x1a, 0x9b, 0xbc, 0xfb, 0x6d, 0xd2, 0xc1, 0x31, 0x69, 0x62, 0xcd, 0x27, 0x5e, 0x0f,
+	0xb5, 0x71, 0x2f, 0xee, 0x5e, 0x69, 0x4c, 0xb5, 0xc5, 0x2a, 0x18, 0x73, 0xd2, 0x4c, 0x4a, 0xf9,
+	0x42, 0x3e, 0x3a, 0xd9, 0x3a, 0xf2, 0xd1, 0xef, 0x93, 0x9e, 0x6b, 0x47, 0x67, 0x2e, 0xe4, 0x4d,
+	0xc8, 0x06, 0xe2, 0x86, 0xb8, 0xa7, 0xab, 0xf3, 0xe6, 0x8f, 0x8c, 0x93, 0x74, 0xb6, 0xb0, 0xa6,
+	0x4a, 0x30, 0xe0, 0xf2, 0xcc, 0xf4, 0xc6, 0x05, 0x6c, 0x7e, 0xa3, 0x42, 0x7a, 0x8f, 0x3a, 0x5a,
+	0x04, 0x70, 0xec, 0x1b, 0xce, 0x98, 0x0e, 0x3a, 0x31, 0x5a, 0x2a, 0x57, 0x17, 0x00, 0x12, 0x82,
+	0x56, 0x1f, 0xfe, 0xf4, 0xfb, 0xe7, 0xa9, 0x4b, 0xf5, 0x32, 0xff, 0x04, 0x25, 0x34, 0xf9, 0x1e,
+	0x8d, 0x91, 0x2d, 0x36, 0xd4, 0x3e, 0x84, 0xd2, 0xc4, 0x34, 0x58, 0x9d, 0x79, 0xf7, 0x71, 0x48,
+	0xe5, 0xda, 0x42, 0x48, 0x32, 0xfc, 0xda, 0xb0, 0xfc, 0xdc, 0xe3, 0xbd, 0x32, 0xd3, 0x79, 0x12,
+	0x54, 0x79, 0xe5, 0x14, 0xa0, 0x24, 0x06, 0x83, 0x95, 0x99, 0xcd, 0x3c, 0x3b, 0xcd, 0x59, 0xd0,
+	0xca, 0xc6, 0xa9, 0xa1, 0x49, 0x54, 0x1f, 0xb4, 0x19, 0x7d, 0x77, 0xf5, 0x04, 0x6a, 0x8e, 0x03,
+	0x2b, 0xe6, 0x29, 0x81, 0xe3, 0x78, 0xcd, 0xb7, 0x1e, 0x1f, 0x56, 0x95, 0x27, 0x87, 0x55, 0xe5,
+	0xb7, 0xc3, 0xaa, 0xf2, 0xe9, 0xd3, 0xea, 0xd2, 0x93, 0xa7, 0xd5, 0xa5, 0x9f, 0x9f, 0x56, 0x97,
+	0xde, 0x7f, 0xc9, 0x71, 0x59, 0xb7, 0xdf, 0x6e, 0xd8, 0xc4, 0x3b, 0x92, 0x99, 0x50, 0x73, 0xb0,
+	0x71, 0xc3, 0x1c, 0x0a, 0xc9, 0x59, 0x14, 0x60, 0xda, 0xce, 0x8a, 0x3f, 0x01, 0xaf, 0xfd, 0x3d,
+	0x00, 0xef, 0x1b, 0xea, 0xc0, 0x01, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -535,6 +902,18 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// MigrateAccount defines a method to move the state of an account controlled
+	// by a Cosmos secp256k1 key to an account controlled by an Ethereum
+	// eth_secp256k1 key, so that it can be used from the EVM.
+	MigrateAccount(ctx context.Context, in *MsgMigrateAccount, opts ...grpc.CallOption) (*MsgMigrateAccountResponse, error)
+	// DeploySystemContract defines a governance operation for installing a
+	// contract code at a given address, without going through a transaction.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	DeploySystemContract(ctx context.Context, in *MsgDeploySystemContract, opts ...grpc.CallOption) (*MsgDeploySystemContractResponse, error)
+	// UpdateAccessPolicy defines a governance operation for replacing the access
+	// policy of the contract deployments and calls.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateAccessPolicy(ctx context.Context, in *MsgUpdateAccessPolicy, opts ...grpc.CallOption) (*MsgUpdateAccessPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -563,6 +942,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) MigrateAccount(ctx context.Context, in *MsgMigrateAccount, opts ...grpc.CallOption) (*MsgMigrateAccountResponse, error) {
+	out := new(MsgMigrateAccountResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/MigrateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeploySystemContract(ctx context.Context, in *MsgDeploySystemContract, opts ...grpc.CallOption) (*MsgDeploySystemContractResponse, error) {
+	out := new(MsgDeploySystemContractResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/DeploySystemContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAccessPolicy(ctx context.Context, in *MsgUpdateAccessPolicy, opts ...grpc.CallOption) (*MsgUpdateAccessPolicyResponse, error) {
+	out := new(MsgUpdateAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/UpdateAccessPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -570,6 +976,18 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// MigrateAccount defines a method to move the state of an account controlled
+	// by a Cosmos secp256k1 key to an account controlled by an Ethereum
+	// eth_secp256k1 key, so that it can be used from the EVM.
+	MigrateAccount(context.Context, *MsgMigrateAccount) (*MsgMigrateAccountResponse, error)
+	// DeploySystemContract defines a governance operation for installing a
+	// contract code at a given address, without going through a transaction.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	DeploySystemContract(context.Context, *MsgDeploySystemContract) (*MsgDeploySystemContractResponse, error)
+	// UpdateAccessPolicy defines a governance operation for replacing the access
+	// policy of the contract deployments and calls.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateAccessPolicy(context.Context, *MsgUpdateAccessPolicy) (*MsgUpdateAccessPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -582,6 +1000,15 @@ func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumT
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) MigrateAccount(ctx context.Context, req *MsgMigrateAccount) (*MsgMigrateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAccount not implemented")
+}
+func (*UnimplementedMsgServer) DeploySystemContract(ctx context.Context, req *MsgDeploySystemContract) (*MsgDeploySystemContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploySystemContract not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAccessPolicy(ctx context.Context, req *MsgUpdateAccessPolicy) (*MsgUpdateAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccessPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -623,6 +1050,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/MigrateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAccount(ctx, req.(*MsgMigrateAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeploySystemContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeploySystemContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeploySystemContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/DeploySystemContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeploySystemContract(ctx, req.(*MsgDeploySystemContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAccessPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/UpdateAccessPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAccessPolicy(ctx, req.(*MsgUpdateAccessPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -635,7 +1116,19 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
-	},
+		{
+			MethodName: "MigrateAccount",
+			Handler:    _Msg_MigrateAccount_Handler,
+		},
+		{
+			MethodName: "DeploySystemContract",
+			Handler:    _Msg_DeploySystemContract_Handler,
+		},
+		{
+			MethodName: "UpdateAccessPolicy",
+			Handler:    _Msg_UpdateAccessPolicy_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
 }
@@ -1184,6 +1677,231 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMigrateAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToSignature) > 0 {
+		i -= len(m.ToSignature)
+		copy(dAtA[i:], m.ToSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToSignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FromSignature) > 0 {
+		i -= len(m.FromSignature)
+		copy(dAtA[i:], m.FromSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromPubKey) > 0 {
+		i -= len(m.FromPubKey)
+		copy(dAtA[i:], m.FromPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromPubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeploySystemContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeploySystemContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeploySystemContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeploySystemContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeploySystemContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeploySystemContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAccessPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAccessPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAccessPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAccessPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAccessPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAccessPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1435,56 +2153,156 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgMigrateAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgMigrateAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+
+func (m *MsgDeploySystemContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeploySystemContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAccessPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAccessPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1612,26 +2430,788 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v cosmossdk_io_math.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
@@ -1650,9 +3230,9 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1681,12 +3261,48 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasTipCap = &v
+			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasFeeCap = &v
+			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
@@ -1705,7 +3321,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
@@ -1737,7 +3353,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 			}
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1773,7 +3389,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -1807,7 +3423,41 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
@@ -1841,7 +3491,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.V = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
@@ -1875,7 +3525,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.R = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
@@ -1930,7 +3580,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1953,157 +3603,65 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v cosmossdk_io_math.Int
-			m.ChainID = &v
-			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v cosmossdk_io_math.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
-			}
-			m.GasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2131,49 +3689,11 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Amount = &v
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2200,14 +3720,14 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accesses = append(m.Accesses, AccessTuple{})
-			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2234,16 +3754,16 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
-			if m.V == nil {
-				m.V = []byte{}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2253,31 +3773,29 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
-			if m.R == nil {
-				m.R = []byte{}
-			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var byteLen int
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2287,26 +3805,11 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
-			if m.S == nil {
-				m.S = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2328,7 +3831,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2351,15 +3854,15 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2387,36 +3890,13 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.ChainID = &v
-			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2426,86 +3906,128 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.GasTipCap = &v
-			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.GasFeeCap = &v
-			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.GasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2533,11 +4055,11 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2565,15 +4087,11 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Amount = &v
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromPubKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2600,48 +4118,14 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Accesses = append(m.Accesses, AccessTuple{})
-			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FromPubKey = append(m.FromPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromPubKey == nil {
+				m.FromPubKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSignature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2668,16 +4152,16 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
-			if m.V == nil {
-				m.V = []byte{}
+			m.FromSignature = append(m.FromSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromSignature == nil {
+				m.FromSignature = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2687,29 +4171,27 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
-			if m.R == nil {
-				m.R = []byte{}
-			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSignature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2736,9 +4218,9 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
-			if m.S == nil {
-				m.S = []byte{}
+			m.ToSignature = append(m.ToSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToSignature == nil {
+				m.ToSignature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2762,7 +4244,7 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2785,10 +4267,10 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2812,7 +4294,7 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeploySystemContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2835,15 +4317,15 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeploySystemContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeploySystemContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2871,13 +4353,13 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2887,29 +4369,27 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2936,14 +4416,14 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2971,27 +4451,58 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.GasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeploySystemContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeploySystemContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeploySystemContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3013,7 +4524,7 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAccessPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3036,10 +4547,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAccessPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAccessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3076,7 +4587,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3103,7 +4614,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3128,7 +4639,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAccessPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3151,10 +4662,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAccessPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAccessPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
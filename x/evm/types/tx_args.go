@@ -248,3 +248,20 @@ func (args *TransactionArgs) GetData() []byte {
 	}
 	return nil
 }
+
+// TxType returns the type of the transaction built from the arguments.
+func (args *TransactionArgs) TxType() uint8 {
+	switch {
+	case args.MaxFeePerGas != nil:
+		return ethtypes.DynamicFeeTxType
+	case args.AccessList != nil:
+		return ethtypes.AccessListTxType
+	default:
+		return ethtypes.LegacyTxType
+	}
+}
+
+// HasFees returns true if any of the fee fields is set.
+func (args *TransactionArgs) HasFees() bool {
+	return args.GasPrice != nil || args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil
+}
@@ -287,3 +287,37 @@ func (suite *TxDataTestSuite) TestGetData() {
 		suite.Require().Equal(retrievedData, tc.expectedOutput)
 	}
 }
+
+func (suite *TxDataTestSuite) TestTxType() {
+	testCases := []struct {
+		name      string
+		txArgs    types.TransactionArgs
+		expTxType uint8
+	}{
+		{
+			"legacy tx",
+			types.TransactionArgs{
+				GasPrice: &suite.hexBigInt,
+			},
+			ethtypes.LegacyTxType,
+		},
+		{
+			"access list tx",
+			types.TransactionArgs{
+				AccessList: &ethtypes.AccessList{},
+			},
+			ethtypes.AccessListTxType,
+		},
+		{
+			"dynamic fee tx",
+			types.TransactionArgs{
+				MaxFeePerGas: &suite.hexBigInt,
+				AccessList:   &ethtypes.AccessList{},
+			},
+			ethtypes.DynamicFeeTxType,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expTxType, tc.txArgs.TxType(), tc.name)
+	}
+}
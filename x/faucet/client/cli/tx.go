@@ -0,0 +1,61 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+// NewTxCmd returns a root CLI command handler for faucet transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "faucet subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRequestFundsCmd(),
+	)
+	return txCmd
+}
+
+// NewRequestFundsCmd returns a CLI command handler for claiming funds from the
+// faucet
+func NewRequestFundsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-funds [ADDRESS]",
+		Short: "Claim funds from the faucet. The address can be given in bech32 or hex format. When the address [optional] is omitted, the funds are paid to the sender.",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address := clientCtx.GetFromAddress().String()
+			if len(args) == 1 {
+				address = args[0]
+			}
+
+			msg := types.NewMsgRequestFunds(clientCtx.GetFromAddress(), address)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,41 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package faucet
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/x/faucet/keeper"
+	"github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	data types.GenesisState,
+) {
+	// Ensure faucet module account is set on genesis
+	if acc := ak.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		panic("the faucet module account has not been set")
+	}
+
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(errorsmod.Wrapf(err, "error setting params"))
+	}
+
+	for _, claim := range data.Claims {
+		k.SetClaimHeight(ctx, sdk.MustAccAddressFromBech32(claim.Address), claim.Height)
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Claims: k.GetClaims(ctx),
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+// GetClaimHeight returns the block height of the last faucet claim of the
+// given address, and false if it never claimed.
+func (k Keeper) GetClaimHeight(ctx sdk.Context, addr sdk.AccAddress) (int64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaim)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetClaimHeight stores the block height of the last faucet claim of the given
+// address.
+func (k Keeper) SetClaimHeight(ctx sdk.Context, addr sdk.AccAddress, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaim)
+	store.Set(addr.Bytes(), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetClaims returns the last faucet claims of all the addresses.
+func (k Keeper) GetClaims(ctx sdk.Context) []types.Claim {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaim)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var claims []types.Claim
+	for ; iterator.Valid(); iterator.Next() {
+		claims = append(claims, types.Claim{
+			Address: sdk.AccAddress(iterator.Key()).String(),
+			Height:  int64(sdk.BigEndianToUint64(iterator.Value())),
+		})
+	}
+	return claims
+}
+
+// NextClaimHeight returns the first block height at which the given address
+// can claim from the faucet, along with the height of its last claim.
+func (k Keeper) NextClaimHeight(ctx sdk.Context, addr sdk.AccAddress) (next, last int64) {
+	last, found := k.GetClaimHeight(ctx, addr)
+	if !found {
+		return ctx.BlockHeight(), 0
+	}
+	return last + int64(k.GetParams(ctx).CooldownBlocks), last
+}
+
+// ValidateClaim returns an error if the given address can't claim from the
+// faucet at the current block, because the faucet is disabled, the cooldown of
+// the address has not elapsed or the faucet can't pay the amount.
+func (k Keeper) ValidateClaim(ctx sdk.Context, addr sdk.AccAddress) error {
+	params := k.GetParams(ctx)
+	if !params.EnableFaucet {
+		return types.ErrFaucetDisabled
+	}
+
+	if next, _ := k.NextClaimHeight(ctx, addr); ctx.BlockHeight() < next {
+		return errorsmod.Wrapf(
+			types.ErrCooldown,
+			"address %s can claim again at height %d (%d blocks left)", addr, next, next-ctx.BlockHeight(),
+		)
+	}
+
+	balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	if !balance.IsAllGTE(params.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientFunds, "faucet balance %s is lower than %s", balance, params.Amount)
+	}
+
+	return nil
+}
+
+// Claim pays the faucet amount to the given address and records the claim
+// height, returning the amount paid.
+func (k Keeper) Claim(ctx sdk.Context, sender, addr sdk.AccAddress) (sdk.Coins, error) {
+	if err := k.ValidateClaim(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	amount := k.GetParams(ctx).Amount
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amount); err != nil {
+		return nil, err
+	}
+	k.SetClaimHeight(ctx, addr, ctx.BlockHeight())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRequestFunds,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return amount, nil
+}
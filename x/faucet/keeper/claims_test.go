@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+func (suite *KeeperTestSuite) TestRequestFunds() {
+	suite.SetupTest()
+
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	addr := utiltx.GenerateAddress()
+	recipient := sdk.AccAddress(addr.Bytes())
+
+	// the faucet is disabled by default
+	_, err := suite.app.FaucetKeeper.RequestFunds(suite.ctx, types.NewMsgRequestFunds(sender, addr.Hex()))
+	suite.Require().ErrorIs(err, types.ErrFaucetDisabled)
+
+	params := suite.enableFaucet(10, types.DefaultAmount.MulInt(sdk.NewInt(2)))
+
+	// the hex and bech32 addresses are paid alike
+	res, err := suite.app.FaucetKeeper.RequestFunds(suite.ctx, types.NewMsgRequestFunds(sender, addr.Hex()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.Amount, res.Amount)
+	suite.Require().Equal(params.Amount, suite.app.BankKeeper.GetAllBalances(suite.ctx, recipient))
+
+	_, err = suite.app.FaucetKeeper.RequestFunds(suite.ctx, types.NewMsgRequestFunds(sender, recipient.String()))
+	suite.Require().ErrorIs(err, types.ErrCooldown)
+
+	cooldown, err := suite.queryClient.Cooldown(suite.ctx, &types.QueryCooldownRequest{Address: recipient.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryCooldownResponse{LastClaimHeight: 1, RemainingBlocks: 10, NextClaimHeight: 11}, cooldown)
+
+	// the cooldown is tracked per paid address
+	other := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	_, err = suite.app.FaucetKeeper.RequestFunds(suite.ctx, types.NewMsgRequestFunds(sender, other.String()))
+	suite.Require().NoError(err)
+
+	// the query client is bound to the setup context
+	suite.ctx = suite.ctx.WithBlockHeight(11)
+	cooldown, err = suite.app.FaucetKeeper.Cooldown(suite.ctx, &types.QueryCooldownRequest{Address: addr.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryCooldownResponse{LastClaimHeight: 1, RemainingBlocks: 0, NextClaimHeight: 11}, cooldown)
+
+	// the faucet has been drained by the two claims
+	_, err = suite.app.FaucetKeeper.RequestFunds(suite.ctx, types.NewMsgRequestFunds(sender, recipient.String()))
+	suite.Require().ErrorIs(err, types.ErrInsufficientFunds)
+
+	suite.Require().ElementsMatch([]types.Claim{
+		{Address: recipient.String(), Height: 1},
+		{Address: other.String(), Height: 1},
+	}, suite.app.FaucetKeeper.GetClaims(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestCooldownNeverClaimed() {
+	suite.SetupTest()
+
+	res, err := suite.queryClient.Cooldown(suite.ctx, &types.QueryCooldownRequest{Address: utiltx.GenerateAddress().Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryCooldownResponse{NextClaimHeight: suite.ctx.BlockHeight()}, res)
+
+	_, err = suite.queryClient.Cooldown(suite.ctx, &types.QueryCooldownRequest{Address: "invalid"})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/faucet"
+	"github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+func (suite *KeeperTestSuite) TestExportImportGenesis() {
+	suite.SetupTest()
+
+	params := suite.enableFaucet(10, types.DefaultAmount)
+	addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	suite.app.FaucetKeeper.SetClaimHeight(suite.ctx, addr, 5)
+
+	genState := faucet.ExportGenesis(suite.ctx, suite.app.FaucetKeeper)
+	suite.Require().Equal(params, genState.Params)
+	suite.Require().Equal([]types.Claim{{Address: addr.String(), Height: 5}}, genState.Claims)
+
+	suite.SetupTest()
+	faucet.InitGenesis(suite.ctx, suite.app.FaucetKeeper, suite.app.AccountKeeper, *genState)
+
+	height, found := suite.app.FaucetKeeper.GetClaimHeight(suite.ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(5), height)
+	suite.Require().Equal(params, suite.app.FaucetKeeper.GetParams(suite.ctx))
+}
@@ -0,0 +1,52 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the faucet module params
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Cooldown returns the remaining cooldown of an address before its next
+// faucet claim
+func (k Keeper) Cooldown(c context.Context, req *types.QueryCooldownRequest) (*types.QueryCooldownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := types.ParseAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	next, last := k.NextClaimHeight(ctx, addr)
+
+	var remaining uint64
+	if next > ctx.BlockHeight() {
+		remaining = uint64(next - ctx.BlockHeight())
+	} else {
+		next = ctx.BlockHeight()
+	}
+
+	return &types.QueryCooldownResponse{
+		LastClaimHeight: last,
+		RemainingBlocks: remaining,
+		NextClaimHeight: next,
+	}, nil
+}
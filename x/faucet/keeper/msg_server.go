@@ -0,0 +1,82 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RequestFunds implements the gRPC MsgServer interface. It pays the faucet
+// amount to the requested bech32 or hex address, if its cooldown has elapsed.
+func (k Keeper) RequestFunds(goCtx context.Context, req *types.MsgRequestFunds) (*types.MsgRequestFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := sdk.MustAccAddressFromBech32(req.Sender)
+	addr, err := types.ParseAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.Claim(ctx, sender, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestFundsResponse{Amount: amount}, nil
+}
+
+// UpdateParams defines a method for updating faucet params
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, errorsmod.Wrapf(err, "error setting params")
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// FundFaucet implements the gRPC MsgServer interface. It moves the given
+// amount from the community pool to the faucet module account. The transfer
+// can only be performed if the requested authority is the Cosmos SDK
+// governance module account.
+func (k Keeper) FundFaucet(goCtx context.Context, req *types.MsgFundFaucet) (*types.MsgFundFaucetResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: the distribution keeper can't distribute to a module account, so
+	// the community pool is debited and the funds moved between the modules.
+	feePool := k.distrKeeper.GetFeePool(ctx)
+	communityPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(req.Amount...))
+	if negative {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"community pool %s is lower than %s", feePool.CommunityPool, req.Amount,
+		)
+	}
+	feePool.CommunityPool = communityPool
+	k.distrKeeper.SetFeePool(ctx, feePool)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundFaucetResponse{}, nil
+}
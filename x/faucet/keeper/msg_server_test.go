@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v19/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	suite.SetupTest()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params := types.NewParams(true, sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 100)), 5, false)
+
+	_, err := suite.app.FaucetKeeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: "invalid", Params: params})
+	suite.Require().Error(err)
+
+	_, err = suite.app.FaucetKeeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.app.FaucetKeeper.GetParams(suite.ctx))
+
+	res, err := suite.queryClient.Params(suite.ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestFundFaucet() {
+	suite.SetupTest()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000))
+
+	funder := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, amount))
+	suite.Require().NoError(suite.app.DistrKeeper.FundCommunityPool(suite.ctx, amount, funder))
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+	// only governance can fund the faucet
+	_, err := suite.app.FaucetKeeper.FundFaucet(suite.ctx, &types.MsgFundFaucet{Authority: funder.String(), Amount: amount})
+	suite.Require().Error(err)
+
+	// the faucet can't be funded with more than the community pool
+	overAmount := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, communityPool.AmountOf(utils.BaseDenom).TruncateInt().AddRaw(1)))
+	_, err = suite.app.FaucetKeeper.FundFaucet(suite.ctx, &types.MsgFundFaucet{Authority: authority, Amount: overAmount})
+	suite.Require().Error(err)
+
+	_, err = suite.app.FaucetKeeper.FundFaucet(suite.ctx, &types.MsgFundFaucet{Authority: authority, Amount: amount})
+	suite.Require().NoError(err)
+
+	faucetAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(amount, suite.app.BankKeeper.GetAllBalances(suite.ctx, faucetAddr))
+	suite.Require().Equal(communityPool.Sub(sdk.NewDecCoinsFromCoins(amount...)), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+}
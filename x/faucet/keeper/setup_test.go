@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/testutil"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/faucet/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.Evmos
+	queryClient types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	chainID := utils.TestnetChainID + "-1"
+	suite.app = app.Setup(false, nil, chainID)
+
+	header := testutil.NewHeader(1, time.Now().UTC(), chainID, sdk.ConsAddress{}, nil, nil)
+	suite.ctx = suite.app.NewContext(false, header)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.FaucetKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+// enableFaucet enables the faucet with the given cooldown and funds it with
+// the given amount.
+func (suite *KeeperTestSuite) enableFaucet(cooldown uint64, funds sdk.Coins) types.Params {
+	params := types.DefaultParams()
+	params.EnableFaucet = true
+	params.CooldownBlocks = cooldown
+	suite.Require().NoError(suite.app.FaucetKeeper.SetParams(suite.ctx, params))
+	suite.Require().NoError(testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, funds))
+	return params
+}
@@ -0,0 +1,16 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrFaucetDisabled    = errorsmod.Register(ModuleName, 2, "faucet is disabled")
+	ErrCooldown          = errorsmod.Register(ModuleName, 3, "faucet cooldown has not elapsed")
+	ErrInsufficientFunds = errorsmod.Register(ModuleName, 4, "insufficient faucet funds")
+	ErrInvalidAddress    = errorsmod.Register(ModuleName, 5, "invalid faucet address")
+)
@@ -0,0 +1,14 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// faucet events
+const (
+	EventTypeRequestFunds = "request_funds"
+
+	AttributeKeySender  = "sender"
+	AttributeKeyAddress = "address"
+	AttributeKeyAmount  = "amount"
+	AttributeKeyHeight  = "height"
+)
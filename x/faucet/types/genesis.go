@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, claims []Claim) GenesisState {
+	return GenesisState{
+		Params: params,
+		Claims: claims,
+	}
+}
+
+// DefaultGenesisState sets default faucet genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, claim := range gs.Claims {
+		if _, err := sdk.AccAddressFromBech32(claim.Address); err != nil {
+			return fmt.Errorf("invalid faucet claim address %s: %w", claim.Address, err)
+		}
+		if claim.Height <= 0 {
+			return fmt.Errorf("invalid faucet claim height %d for address %s", claim.Height, claim.Address)
+		}
+		if seen[claim.Address] {
+			return fmt.Errorf("duplicated faucet claim for address %s", claim.Address)
+		}
+		seen[claim.Address] = true
+	}
+
+	return gs.Params.Validate()
+}
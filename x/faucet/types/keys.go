@@ -0,0 +1,28 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// constants
+const (
+	// ModuleName defines the module name
+	ModuleName = "faucet"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the faucet persistent store
+const (
+	prefixParams = iota + 1
+	prefixClaim
+)
+
+// KVStore key prefixes
+var (
+	ParamsKey      = []byte{prefixParams}
+	KeyPrefixClaim = []byte{prefixClaim}
+)
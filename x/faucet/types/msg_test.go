@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAddress(t *testing.T) {
+	hexAddr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	bech32Addr := sdk.AccAddress(hexAddr.Bytes()).String()
+
+	for _, address := range []string{hexAddr.Hex(), "0x1111111111111111111111111111111111111111", bech32Addr} {
+		addr, err := ParseAddress(address)
+		require.NoError(t, err, address)
+		require.Equal(t, hexAddr.Bytes(), addr.Bytes(), address)
+	}
+
+	for _, address := range []string{"", "0x1111", "evmos1invalid"} {
+		_, err := ParseAddress(address)
+		require.ErrorIs(t, err, ErrInvalidAddress, address)
+	}
+}
+
+func TestMsgRequestFundsValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(common.HexToAddress("0xf").Bytes())
+
+	testCases := []struct {
+		name    string
+		msg     *MsgRequestFunds
+		expPass bool
+	}{
+		{"hex address", NewMsgRequestFunds(sender, "0x1111111111111111111111111111111111111111"), true},
+		{"bech32 address", NewMsgRequestFunds(sender, sender.String()), true},
+		{"invalid address", NewMsgRequestFunds(sender, "0x1111"), false},
+		{"invalid sender", &MsgRequestFunds{Sender: "invalid", Address: sender.String()}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	addr := sdk.AccAddress(common.HexToAddress("0xf").Bytes()).String()
+	enabled := DefaultParams()
+	enabled.EnableFaucet = true
+	noAmount := enabled
+	noAmount.Amount = nil
+
+	testCases := []struct {
+		name     string
+		genState GenesisState
+		expPass  bool
+	}{
+		{"default", *DefaultGenesisState(), true},
+		{"enabled with claims", NewGenesisState(enabled, []Claim{{Address: addr, Height: 10}}), true},
+		{"enabled without amount", NewGenesisState(noAmount, nil), false},
+		{"invalid claim address", NewGenesisState(enabled, []Claim{{Address: "invalid", Height: 10}}), false},
+		{"invalid claim height", NewGenesisState(enabled, []Claim{{Address: addr}}), false},
+		{"duplicated claim", NewGenesisState(enabled, []Claim{{Address: addr, Height: 10}, {Address: addr, Height: 11}}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
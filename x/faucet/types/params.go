@@ -0,0 +1,63 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+var (
+	// DefaultEnableFaucet is disabled, as the faucet must only run on testnets
+	DefaultEnableFaucet = false
+	// DefaultAmount is 1 EVMOS
+	DefaultAmount = sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, math.NewIntWithDecimal(1, 18)))
+	// DefaultCooldownBlocks is roughly one day of blocks
+	DefaultCooldownBlocks uint64 = 43_200
+	// DefaultEnableFeeExemption allows the faucet claims of the accounts
+	// without balance to be fee-less
+	DefaultEnableFeeExemption = true
+)
+
+// NewParams creates a new Params object
+func NewParams(
+	enableFaucet bool,
+	amount sdk.Coins,
+	cooldownBlocks uint64,
+	enableFeeExemption bool,
+) Params {
+	return Params{
+		EnableFaucet:       enableFaucet,
+		Amount:             amount,
+		CooldownBlocks:     cooldownBlocks,
+		EnableFeeExemption: enableFeeExemption,
+	}
+}
+
+// DefaultParams returns the default faucet module params
+func DefaultParams() Params {
+	return Params{
+		EnableFaucet:       DefaultEnableFaucet,
+		Amount:             DefaultAmount,
+		CooldownBlocks:     DefaultCooldownBlocks,
+		EnableFeeExemption: DefaultEnableFeeExemption,
+	}
+}
+
+// Validate performs a stateless validation of the faucet params
+func (p Params) Validate() error {
+	if err := p.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid faucet amount: %w", err)
+	}
+
+	if p.EnableFaucet && p.Amount.IsZero() {
+		return fmt.Errorf("faucet amount cannot be zero while the faucet is enabled")
+	}
+
+	return nil
+}
@@ -25,6 +25,8 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetFeeConversionRatesCmd(),
+		GetFeeConversionPoolCmd(),
 	)
 	return cmd
 }
@@ -116,3 +118,58 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetFeeConversionRatesCmd queries the accepted alternate fee denominations
+// and their conversion rates
+func GetFeeConversionRatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-conversion-rates",
+		Short: "Get the accepted alternate fee denominations and their conversion rates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeConversionRates(cmd.Context(), &types.QueryFeeConversionRatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFeeConversionPoolCmd queries the balance of the fee conversion pool
+func GetFeeConversionPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-conversion-pool",
+		Short: "Get the address and balance of the fee conversion pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeConversionPool(cmd.Context(), &types.QueryFeeConversionPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -4,6 +4,7 @@ package feemarket
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -17,20 +18,35 @@ func InitGenesis(
 	k keeper.Keeper,
 	data types.GenesisState,
 ) []abci.ValidatorUpdate {
-	err := k.SetParams(ctx, data.Params)
+	params := data.Params
+	// the base fee of the last block before the export
+	if !data.BaseFee.IsNil() && data.BaseFee.IsPositive() {
+		params.BaseFee = data.BaseFee
+	}
+
+	err := k.SetParams(ctx, params)
 	if err != nil {
 		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
 	}
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
+	if params.GasWantedEmaWindow > 0 && !data.BlockGasEma.IsNil() && data.BlockGasEma.IsPositive() {
+		k.SetBlockGasWantedEMA(ctx, data.BlockGasEma)
+	}
 
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+	baseFee := math.ZeroInt()
+	if fee := k.GetBaseFee(ctx); fee != nil {
+		baseFee = math.NewIntFromBigInt(fee)
+	}
+
+	genState := types.NewGenesisState(k.GetParams(ctx), k.GetBlockGasWanted(ctx), baseFee)
+	if ema, ok := k.GetBlockGasWantedEMA(ctx); ok {
+		genState.BlockGasEma = ema
 	}
+	return genState
 }
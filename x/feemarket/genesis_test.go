@@ -0,0 +1,101 @@
+package feemarket_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/feemarket"
+	"github.com/evmos/evmos/v19/x/feemarket/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	const (
+		maxGas  = 10_000_000
+		chainID = utils.TestnetChainID + "-1"
+	)
+
+	// newBlockCtx returns the context of a block at the given height whose gas
+	// limit is maxGas
+	newBlockCtx := func(evmosApp *app.Evmos, height int64) sdk.Context {
+		return evmosApp.BaseApp.NewContext(false, tmproto.Header{
+			ChainID: chainID,
+			Height:  height,
+			Time:    time.Now().UTC(),
+		}).
+			WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: maxGas}}).
+			WithBlockGasMeter(sdk.NewGasMeter(maxGas))
+	}
+
+	// run a chain whose blocks are full, so that the base fee keeps rising
+	evmosApp := app.Setup(false, nil, chainID)
+	genesisBaseFee := evmosApp.FeeMarketKeeper.GetBaseFee(newBlockCtx(evmosApp, 1))
+
+	const lastHeight = 10
+	var ctx sdk.Context
+	for height := int64(2); height <= lastHeight; height++ {
+		ctx = newBlockCtx(evmosApp, height)
+		evmosApp.FeeMarketKeeper.BeginBlock(ctx, abci.RequestBeginBlock{})
+		evmosApp.FeeMarketKeeper.SetTransientBlockGasWanted(ctx, maxGas)
+		ctx.BlockGasMeter().ConsumeGas(maxGas, "full block")
+		evmosApp.FeeMarketKeeper.EndBlock(ctx, abci.RequestEndBlock{})
+	}
+
+	lastBaseFee := evmosApp.FeeMarketKeeper.GetBaseFee(ctx)
+	require.Equal(t, 1, lastBaseFee.Cmp(genesisBaseFee), "the base fee should have risen")
+
+	exported := feemarket.ExportGenesis(ctx, evmosApp.FeeMarketKeeper)
+	require.Equal(t, lastBaseFee, exported.BaseFee.BigInt())
+	require.Equal(t, uint64(maxGas), exported.BlockGas)
+
+	// the genesis state survives its JSON encoding
+	cdc := evmosApp.AppCodec()
+	var imported types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(cdc.MustMarshalJSON(exported), &imported))
+	require.Equal(t, lastBaseFee, imported.BaseFee.BigInt())
+	require.Equal(t, exported.BlockGas, imported.BlockGas)
+
+	// the first base fee computed by the restarted chain continues from the
+	// exported one, as if the chain had not been restarted
+	restartedApp := app.Setup(false, &imported, chainID)
+	restartedCtx := newBlockCtx(restartedApp, lastHeight+1)
+	require.Equal(t, lastBaseFee, restartedApp.FeeMarketKeeper.GetBaseFee(restartedCtx))
+
+	expBaseFee := evmosApp.FeeMarketKeeper.CalculateBaseFee(newBlockCtx(evmosApp, lastHeight+1))
+	require.Equal(t, expBaseFee, restartedApp.FeeMarketKeeper.CalculateBaseFee(restartedCtx))
+	require.Equal(t, 1, expBaseFee.Cmp(lastBaseFee))
+}
+
+func TestExportImportGenesisGasWantedEMA(t *testing.T) {
+	chainID := utils.TestnetChainID + "-1"
+
+	evmosApp := app.Setup(false, nil, chainID)
+	ctx := evmosApp.BaseApp.NewContext(false, tmproto.Header{ChainID: chainID, Height: 1})
+
+	params := evmosApp.FeeMarketKeeper.GetParams(ctx)
+	params.GasWantedEmaWindow = 10
+	require.NoError(t, evmosApp.FeeMarketKeeper.SetParams(ctx, params))
+	ema := sdkmath.LegacyMustNewDecFromStr("1234567.5")
+	evmosApp.FeeMarketKeeper.SetBlockGasWantedEMA(ctx, ema)
+
+	exported := feemarket.ExportGenesis(ctx, evmosApp.FeeMarketKeeper)
+	require.Equal(t, ema, exported.BlockGasEma)
+
+	cdc := evmosApp.AppCodec()
+	var imported types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(cdc.MustMarshalJSON(exported), &imported))
+
+	// the restarted chain keeps smoothing the block gas from the exported average
+	restartedApp := app.Setup(false, &imported, chainID)
+	restartedCtx := restartedApp.BaseApp.NewContext(false, tmproto.Header{ChainID: chainID, Height: 2})
+	restoredEMA, found := restartedApp.FeeMarketKeeper.GetBlockGasWantedEMA(restartedCtx)
+	require.True(t, found)
+	require.Equal(t, ema, restoredEMA)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	evmostypes "github.com/evmos/evmos/v19/types"
 	"github.com/evmos/evmos/v19/x/feemarket/types"
 
 	"cosmossdk.io/math"
@@ -23,6 +24,8 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	}
 
 	k.SetBaseFee(ctx, baseFee)
+	k.SetBlockBaseFee(ctx, ctx.BlockHeight(), baseFee)
+	k.setDenomBaseFees(ctx, baseFee)
 
 	defer func() {
 		telemetry.SetGauge(float32(baseFee.Int64()), "feemarket", "base_fee")
@@ -63,13 +66,27 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
 	// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
 	// this will be keep BaseFee protected from un-penalized manipulation
 	// more info here https://github.com/evmos/ethermint/pull/1105#discussion_r888798925
-	minGasMultiplier := k.GetParams(ctx).MinGasMultiplier
-	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(minGasMultiplier)
+	params := k.GetParams(ctx)
+	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(params.MinGasMultiplier)
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
+
+	// seed the gas wanted of the first EIP-1559 block to the gas target, so
+	// that the base fee of the next block remains unchanged
+	if params.SeedGasTargetAtActivation && !params.NoBaseFee && ctx.BlockHeight() == params.EnableHeight {
+		if gasTarget := params.BlockGasTarget(blockGasLimit(ctx)); gasTarget.IsUint64() {
+			updatedGasWanted = gasTarget.Uint64()
+		}
+	}
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
+	k.updateBlockGasWantedEMA(ctx, params, updatedGasWanted)
 
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
+
+		if evmostypes.IsTelemetryEnabled() {
+			telemetry.SetGauge(float32(gasWanted.Int64()), "feemarket", "block_gas_wanted")
+			telemetry.SetGauge(float32(gasUsed.Int64()), "feemarket", "block_gas_used")
+		}
 	}()
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -77,4 +94,43 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
 		sdk.NewAttribute("height", fmt.Sprintf("%d", ctx.BlockHeight())),
 		sdk.NewAttribute("amount", fmt.Sprintf("%d", updatedGasWanted)),
 	))
+
+	k.recordFeeHistory(ctx, params, gasUsed.Uint64())
+	k.emitFeeState(ctx, params, updatedGasWanted)
+}
+
+// updateBlockGasWantedEMA updates the exponential moving average of the block
+// gas wanted with the gas wanted of the current block. The average is deleted
+// if the gas wanted EMA window is disabled, so that it is seeded again once
+// re-enabled.
+func (k *Keeper) updateBlockGasWantedEMA(ctx sdk.Context, params types.Params, gasWanted uint64) {
+	if params.GasWantedEmaWindow == 0 {
+		k.DeleteBlockGasWantedEMA(ctx)
+		return
+	}
+
+	prevEMA, _ := k.GetBlockGasWantedEMA(ctx)
+	k.SetBlockGasWantedEMA(ctx, params.NextGasWantedEMA(prevEMA, gasWanted))
+}
+
+// emitFeeState emits the fee state of the block, streamed to the off-chain fee
+// oracles by the SubscribeFeeState gRPC method.
+func (k *Keeper) emitFeeState(ctx sdk.Context, params types.Params, gasWanted uint64) {
+	feeState := &types.EventFeeState{
+		Height:      ctx.BlockHeight(),
+		GasWanted:   gasWanted,
+		MinGasPrice: params.MinGasPrice.String(),
+	}
+
+	if baseFee := k.GetBaseFee(ctx); baseFee != nil {
+		feeState.BaseFee = baseFee.String()
+	}
+
+	if gasTarget := params.BlockGasTarget(blockGasLimit(ctx)); gasTarget.IsUint64() {
+		feeState.GasTarget = gasTarget.Uint64()
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(feeState); err != nil {
+		k.Logger(ctx).Error("failed to emit the fee state event", "error", err.Error())
+	}
 }
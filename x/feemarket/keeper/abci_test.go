@@ -2,9 +2,14 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
+	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
 )
 
 func (suite *KeeperTestSuite) TestEndBlock() {
@@ -46,3 +51,208 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestEndBlockFeeStateEvent() {
+	suite.SetupTest()
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.GasTarget = 4000000
+	params.MinGasPrice = sdk.NewDecWithPrec(5, 1)
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	suite.ctx = suite.ctx.
+		WithBlockHeight(7).
+		WithBlockGasMeter(storetypes.NewGasMeter(uint64(1000000000))).
+		WithEventManager(sdk.NewEventManager())
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(1000000000))
+	suite.app.FeeMarketKeeper.SetTransientBlockGasWanted(suite.ctx, 5000000)
+	suite.app.FeeMarketKeeper.EndBlock(suite.ctx, types.RequestEndBlock{Height: 7})
+
+	var feeState *feemarkettypes.EventFeeState
+	for _, event := range suite.ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		if state, ok := msg.(*feemarkettypes.EventFeeState); ok {
+			feeState = state
+		}
+	}
+
+	suite.Require().Equal(&feemarkettypes.EventFeeState{
+		Height:      7,
+		BaseFee:     "1000000000",
+		GasWanted:   suite.app.FeeMarketKeeper.GetBlockGasWanted(suite.ctx),
+		GasTarget:   4000000,
+		MinGasPrice: "0.500000000000000000",
+	}, feeState)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockRecordsFeeHistory() {
+	suite.SetupTest()
+
+	suite.ctx = suite.ctx.
+		WithBlockHeight(7).
+		WithBlockGasMeter(storetypes.NewGasMeter(uint64(1000000000))).
+		WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 10000000}})
+	suite.ctx.BlockGasMeter().ConsumeGas(2500000, "test")
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(1000000000))
+
+	// the rewards are sorted, and the gas used by equal rewards is summed
+	suite.app.FeeMarketKeeper.AddTransientTxReward(suite.ctx, big.NewInt(3000000000), 21000)
+	suite.app.FeeMarketKeeper.AddTransientTxReward(suite.ctx, big.NewInt(0), 50000)
+	suite.app.FeeMarketKeeper.AddTransientTxReward(suite.ctx, big.NewInt(3000000000), 42000)
+	suite.app.FeeMarketKeeper.AddTransientTxReward(suite.ctx, big.NewInt(-1), 10000)
+	suite.app.FeeMarketKeeper.EndBlock(suite.ctx, types.RequestEndBlock{Height: 7})
+
+	history, found := suite.app.FeeMarketKeeper.GetBlockFeeHistory(suite.ctx, 7)
+	suite.Require().True(found)
+	suite.Require().Equal(feemarkettypes.BlockFeeHistory{
+		Height:   7,
+		BaseFee:  math.NewInt(1000000000),
+		GasUsed:  2500000,
+		GasLimit: 10000000,
+		Rewards: []feemarkettypes.TxReward{
+			{Reward: math.ZeroInt(), GasUsed: 60000},
+			{Reward: math.NewInt(3000000000), GasUsed: 63000},
+		},
+	}, history)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockPersistsBaseFee() {
+	suite.SetupTest()
+
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, 5000000)
+	suite.app.FeeMarketKeeper.BeginBlock(suite.ctx, types.RequestBeginBlock{})
+
+	baseFee := suite.app.FeeMarketKeeper.GetBlockBaseFee(suite.ctx, 10)
+	suite.Require().NotNil(baseFee)
+	suite.Require().Equal(suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx), baseFee)
+
+	// change the params mid-chain, the base fee applied at the previous
+	// height must not change
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.ElasticityMultiplier = 4
+	params.BaseFeeChangeDenominator = 2
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	suite.ctx = suite.ctx.WithBlockHeight(11)
+	suite.app.FeeMarketKeeper.BeginBlock(suite.ctx, types.RequestBeginBlock{})
+
+	suite.Require().Equal(baseFee, suite.app.FeeMarketKeeper.GetBlockBaseFee(suite.ctx, 10))
+	suite.Require().NotEqual(baseFee, suite.app.FeeMarketKeeper.GetBlockBaseFee(suite.ctx, 11))
+
+	res, err := suite.queryClient.BlockBaseFee(suite.ctx.Context(), &feemarkettypes.QueryBlockBaseFeeRequest{Height: 10})
+	suite.Require().NoError(err)
+	suite.Require().Equal(baseFee, res.BaseFee.BigInt())
+
+	_, err = suite.queryClient.BlockBaseFee(suite.ctx.Context(), &feemarkettypes.QueryBlockBaseFeeRequest{Height: 9})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestBaseFeeAfterActivationUpgrade() {
+	const (
+		upgradeHeight = 10
+		maxGas        = 10_000_000
+		gasTarget     = 1_000_000
+		// gas used by the txs accepted into the mempool without base fee
+		activationGasUsed = 8_000_000
+	)
+
+	testCases := []struct {
+		name       string
+		seedTarget bool
+	}{
+		{"clamped base fee change", false},
+		{"gas target seeded at activation", true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			ctx := suite.ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: maxGas}})
+
+			// the chain runs without base fee before the upgrade
+			params := suite.app.FeeMarketKeeper.GetParams(ctx)
+			params.NoBaseFee = true
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(ctx, params))
+
+			// the upgrade enables the base fee at the upgrade height
+			ctx = ctx.WithBlockHeight(upgradeHeight)
+			params.NoBaseFee = false
+			params.EnableHeight = upgradeHeight
+			params.GasTarget = gasTarget
+			params.SeedGasTargetAtActivation = tc.seedTarget
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(ctx, params))
+
+			suite.app.FeeMarketKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+			baseFee := suite.app.FeeMarketKeeper.GetBaseFee(ctx)
+			suite.Require().Equal(params.BaseFee.BigInt(), baseFee)
+
+			meter := storetypes.NewGasMeter(maxGas)
+			meter.ConsumeGas(activationGasUsed, "txs")
+			ctx = ctx.WithBlockGasMeter(meter)
+			suite.app.FeeMarketKeeper.SetTransientBlockGasWanted(ctx, activationGasUsed)
+			suite.app.FeeMarketKeeper.EndBlock(ctx, types.RequestEndBlock{Height: upgradeHeight})
+
+			// the gas used by the activation block would raise the base fee by
+			// 7/8 without the transition guard
+			spike := suite.app.FeeMarketKeeper.GetParams(ctx).CalcBaseFee(activationGasUsed, big.NewInt(maxGas))
+			maxBaseFee := new(big.Int).Add(baseFee, new(big.Int).Div(baseFee, big.NewInt(int64(params.BaseFeeChangeDenominator))))
+			suite.Require().Equal(1, spike.Cmp(maxBaseFee))
+
+			ctx = ctx.WithBlockHeight(upgradeHeight + 1)
+			suite.app.FeeMarketKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+
+			if tc.seedTarget {
+				suite.Require().Equal(uint64(gasTarget), suite.app.FeeMarketKeeper.GetBlockGasWanted(ctx))
+				suite.Require().Equal(baseFee, suite.app.FeeMarketKeeper.GetBaseFee(ctx))
+			} else {
+				suite.Require().Equal(uint64(activationGasUsed), suite.app.FeeMarketKeeper.GetBlockGasWanted(ctx))
+				suite.Require().Equal(maxBaseFee, suite.app.FeeMarketKeeper.GetBaseFee(ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEndBlockGasWantedEMA() {
+	suite.SetupTest()
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.GasWantedEmaWindow = 3
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	endBlock := func(gasUsed uint64) {
+		meter := storetypes.NewGasMeter(uint64(1000000000))
+		meter.ConsumeGas(gasUsed, "txs")
+		suite.ctx = suite.ctx.WithBlockGasMeter(meter)
+		suite.app.FeeMarketKeeper.SetTransientBlockGasWanted(suite.ctx, 0)
+		suite.app.FeeMarketKeeper.EndBlock(suite.ctx, types.RequestEndBlock{})
+	}
+
+	for _, tc := range []struct {
+		gasUsed uint64
+		expEMA  int64
+	}{
+		{1000000, 1000000}, // seeded with the first block
+		{0, 500000},
+		{0, 250000},
+		{750000, 500000},
+	} {
+		endBlock(tc.gasUsed)
+		ema, found := suite.app.FeeMarketKeeper.GetBlockGasWantedEMA(suite.ctx)
+		suite.Require().True(found)
+		suite.Require().Equal(math.LegacyNewDec(tc.expEMA), ema)
+		suite.Require().Equal(tc.gasUsed, suite.app.FeeMarketKeeper.GetBlockGasWanted(suite.ctx))
+		suite.Require().Equal(uint64(tc.expEMA), suite.app.FeeMarketKeeper.GetParentGasWanted(suite.ctx, params))
+	}
+
+	// disabling the window deletes the average
+	params.GasWantedEmaWindow = 0
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+	endBlock(300000)
+	_, found := suite.app.FeeMarketKeeper.GetBlockGasWantedEMA(suite.ctx)
+	suite.Require().False(found)
+	suite.Require().Equal(uint64(300000), suite.app.FeeMarketKeeper.GetParentGasWanted(suite.ctx, params))
+}
@@ -7,7 +7,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 )
 
@@ -23,72 +22,42 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 		return nil
 	}
 
-	consParams := ctx.ConsensusParams()
-
 	// If the current block is the first EIP-1559 block, return the base fee
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
-	// governance).
+	// governance), capped to the max base fee.
 	if ctx.BlockHeight() == params.EnableHeight {
-		return params.BaseFee.BigInt()
+		return params.BoundBaseFee(params.BaseFee.BigInt())
 	}
 
 	// get the block gas used and the base fee values for the parent block.
-	// NOTE: this is not the parent's base fee but the current block's base fee,
-	// as it is retrieved from the transient store, which is committed to the
-	// persistent KVStore after EndBlock (ABCI Commit).
-	parentBaseFee := params.BaseFee.BigInt()
-	if parentBaseFee == nil {
+	if params.BaseFee.BigInt() == nil {
 		return nil
 	}
 
-	parentGasUsed := k.GetBlockGasWanted(ctx)
-
-	gasLimit := new(big.Int).SetUint64(math.MaxUint64)
-
-	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
-	if consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > -1 {
-		gasLimit = big.NewInt(consParams.Block.MaxGas)
-	}
-
-	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
-	// validation
-	parentGasTargetBig := new(big.Int).Div(gasLimit, new(big.Int).SetUint64(uint64(params.ElasticityMultiplier)))
-	if !parentGasTargetBig.IsUint64() {
-		return nil
+	// the moving average of the gas wanted smooths the base fee adjustments of
+	// a bursty block load, if enabled
+	parentGasUsed := k.GetParentGasWanted(ctx, params)
+	baseFee := params.CalcBaseFee(parentGasUsed, blockGasLimit(ctx))
+
+	// The gas wanted by the first EIP-1559 block was accumulated by transactions
+	// accepted into the mempool under the previous fee rules, so the change of
+	// the base fee of the following block is clamped to the standard maximum
+	// to prevent a one-block spike.
+	if ctx.BlockHeight() == params.EnableHeight+1 {
+		baseFee = params.ClampBaseFeeChange(baseFee)
 	}
 
-	parentGasTarget := parentGasTargetBig.Uint64()
-	baseFeeChangeDenominator := new(big.Int).SetUint64(uint64(params.BaseFeeChangeDenominator))
-
-	// If the parent gasUsed is the same as the target, the baseFee remains
-	// unchanged.
-	if parentGasUsed == parentGasTarget {
-		return new(big.Int).Set(parentBaseFee)
-	}
+	return baseFee
+}
 
-	if parentGasUsed > parentGasTarget {
-		// If the parent block used more gas than its target, the baseFee should
-		// increase.
-		gasUsedDelta := new(big.Int).SetUint64(parentGasUsed - parentGasTarget)
-		x := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
-		y := x.Div(x, parentGasTargetBig)
-		baseFeeDelta := math.BigMax(
-			x.Div(y, baseFeeChangeDenominator),
-			common.Big1,
-		)
+// blockGasLimit returns the block gas limit set by the consensus params MaxGas.
+func blockGasLimit(ctx sdk.Context) *big.Int {
+	consParams := ctx.ConsensusParams()
 
-		return x.Add(parentBaseFee, baseFeeDelta)
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+	if consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > -1 {
+		return big.NewInt(consParams.Block.MaxGas)
 	}
 
-	// Otherwise if the parent block used less gas than its target, the baseFee
-	// should decrease.
-	gasUsedDelta := new(big.Int).SetUint64(parentGasTarget - parentGasUsed)
-	x := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
-	y := x.Div(x, parentGasTargetBig)
-	baseFeeDelta := x.Div(y, baseFeeChangeDenominator)
-
-	// Set global min gas price as lower bound of the base fee, transactions below
-	// the min gas price don't even reach the mempool.
-	minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
-	return math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
+	return new(big.Int).SetUint64(math.MaxUint64)
 }
@@ -15,6 +15,7 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 		blockHeight          int64
 		parentBlockGasWanted uint64
 		minGasPrice          math.LegacyDec
+		gasTarget            uint64
 		expFee               *big.Int
 	}{
 		{
@@ -23,6 +24,7 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			0,
 			0,
 			math.LegacyZeroDec(),
+			0,
 			nil,
 		},
 		{
@@ -31,6 +33,7 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			0,
 			0,
 			math.LegacyZeroDec(),
+			0,
 			suite.app.FeeMarketKeeper.GetParams(suite.ctx).BaseFee.BigInt(),
 		},
 		{
@@ -39,6 +42,7 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			1,
 			50,
 			math.LegacyZeroDec(),
+			0,
 			suite.app.FeeMarketKeeper.GetParams(suite.ctx).BaseFee.BigInt(),
 		},
 		{
@@ -47,6 +51,7 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			1,
 			50,
 			math.LegacyNewDec(1500000000),
+			0,
 			suite.app.FeeMarketKeeper.GetParams(suite.ctx).BaseFee.BigInt(),
 		},
 		{
@@ -55,6 +60,7 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			1,
 			100,
 			math.LegacyZeroDec(),
+			0,
 			big.NewInt(1125000000),
 		},
 		{
@@ -63,6 +69,7 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			1,
 			100,
 			math.LegacyNewDec(1500000000),
+			0,
 			big.NewInt(1125000000),
 		},
 		{
@@ -71,6 +78,7 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			1,
 			25,
 			math.LegacyZeroDec(),
+			0,
 			big.NewInt(937500000),
 		},
 		{
@@ -79,8 +87,36 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			1,
 			25,
 			math.LegacyNewDec(1500000000),
+			0,
 			big.NewInt(1500000000),
 		},
+		{
+			"with BaseFee - parent block wanted the same gas as the explicit gas target",
+			false,
+			1,
+			25,
+			math.LegacyZeroDec(),
+			25,
+			suite.app.FeeMarketKeeper.GetParams(suite.ctx).BaseFee.BigInt(),
+		},
+		{
+			"with BaseFee - parent block wanted more gas than the explicit gas target",
+			false,
+			1,
+			50,
+			math.LegacyZeroDec(),
+			25,
+			big.NewInt(1125000000),
+		},
+		{
+			"with BaseFee - explicit gas target higher than the block max gas is capped",
+			false,
+			1,
+			50,
+			math.LegacyZeroDec(),
+			200,
+			big.NewInt(937500000),
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -89,6 +125,7 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
 			params.NoBaseFee = tc.NoBaseFee
 			params.MinGasPrice = tc.minGasPrice
+			params.GasTarget = tc.gasTarget
 			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
 			suite.Require().NoError(err)
 
@@ -115,3 +152,110 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCalculateBaseFeeBounds() {
+	testCases := []struct {
+		name                 string
+		blockHeight          int64
+		parentBlockGasWanted uint64
+		maxBaseFee           math.Int
+		maxChangeRate        math.LegacyDec
+		expFee               *big.Int
+	}{
+		{
+			"initial EIP-1559 block capped to the max base fee",
+			0,
+			0,
+			math.NewInt(900000000),
+			math.LegacyZeroDec(),
+			big.NewInt(900000000),
+		},
+		{
+			"increase bounded by the max change rate",
+			1,
+			100,
+			math.ZeroInt(),
+			math.LegacyNewDecWithPrec(5, 2),
+			big.NewInt(1050000000),
+		},
+		{
+			"decrease bounded by the max change rate",
+			1,
+			25,
+			math.ZeroInt(),
+			math.LegacyNewDecWithPrec(5, 2),
+			big.NewInt(950000000),
+		},
+		{
+			"increase capped to the max base fee",
+			1,
+			100,
+			math.NewInt(1100000000),
+			math.LegacyZeroDec(),
+			big.NewInt(1100000000),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.MaxBaseFee = tc.maxBaseFee
+			params.MaxBaseFeeChangeRate = tc.maxChangeRate
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			suite.ctx = suite.ctx.WithBlockHeight(tc.blockHeight)
+			suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, tc.parentBlockGasWanted)
+
+			blockParams := tmproto.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			suite.ctx = suite.ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &blockParams})
+
+			suite.Require().Equal(tc.expFee, suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCalculateBaseFeeGasWantedEMA() {
+	testCases := []struct {
+		name   string
+		window uint64
+		expFee *big.Int
+	}{
+		{
+			"full parent block raises the base fee without the average",
+			0,
+			big.NewInt(1125000000),
+		},
+		{
+			"average at the gas target keeps the base fee",
+			10,
+			big.NewInt(1000000000),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.GasWantedEmaWindow = tc.window
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			suite.ctx = suite.ctx.WithBlockHeight(1)
+			suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, 100)
+			suite.app.FeeMarketKeeper.SetBlockGasWantedEMA(suite.ctx, math.LegacyNewDec(50))
+
+			blockParams := tmproto.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			suite.ctx = suite.ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &blockParams})
+
+			suite.Require().Equal(tc.expFee, suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx))
+		})
+	}
+}
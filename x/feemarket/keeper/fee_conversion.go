@@ -0,0 +1,179 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/evmos/evmos/v19/x/feemarket/types"
+)
+
+// FeeConversionPoolAddress returns the address of the module account holding
+// the fee conversion pool.
+func (k Keeper) FeeConversionPoolAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+// GetFeeConversionPool returns the balance of the fee conversion pool.
+func (k Keeper) GetFeeConversionPool(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.FeeConversionPoolAddress())
+}
+
+// FeeConversionRate returns the conversion rate of the given alternate fee
+// denomination to the EVM denomination, and false if it is not accepted. The
+// denominations of the params are only accepted if registered in x/erc20, and
+// their rate is provided by the fee rate oracle if any, or by the params
+// otherwise.
+func (k Keeper) FeeConversionRate(ctx sdk.Context, denom string) (sdkmath.LegacyDec, bool) {
+	rate, found := k.GetParams(ctx).FeeConversionRate(denom)
+	if !found {
+		return sdkmath.LegacyDec{}, false
+	}
+
+	if k.erc20Keeper != nil && !k.erc20Keeper.IsDenomRegistered(ctx, denom) {
+		return sdkmath.LegacyDec{}, false
+	}
+
+	if k.feeRateOracle != nil {
+		oracleRate, found := k.feeRateOracle.FeeConversionRate(ctx, denom)
+		if found && types.ValidateFeeConversionRate(oracleRate) == nil {
+			return oracleRate, true
+		}
+	}
+
+	return rate, true
+}
+
+// ConvertFee swaps the given fee, paid in an accepted alternate denomination,
+// for its value in the given EVM denomination at the current conversion rate.
+// The alternate fee is sent from the payer to the fee conversion pool, and the
+// converted fee from the pool to the payer, so that it can be deducted as a
+// regular fee afterwards. It fails if the pool lacks the depth to cover the
+// converted fee.
+func (k Keeper) ConvertFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coin, evmDenom string) (sdk.Coin, error) {
+	rate, found := k.FeeConversionRate(ctx, fee.Denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "fee denom %s is not accepted", fee.Denom)
+	}
+
+	converted := sdk.NewCoin(evmDenom, rate.MulInt(fee.Amount).TruncateInt())
+	if !converted.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"fee %s converts to zero %s at rate %s", fee, evmDenom, rate,
+		)
+	}
+
+	poolBalance := k.bankKeeper.GetBalance(ctx, k.FeeConversionPoolAddress(), evmDenom)
+	if poolBalance.IsLT(converted) {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"fee conversion pool lacks depth; balance %s, required %s", poolBalance, converted,
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, sdk.Coins{fee}); err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "failed to send fee to the fee conversion pool")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, sdk.Coins{converted}); err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "failed to send converted fee from the fee conversion pool")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeConversion,
+			sdk.NewAttribute(types.AttributeKeyFeePayer, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyConvertedFee, converted.String()),
+			sdk.NewAttribute(types.AttributeKeyConversionRate, rate.String()),
+		),
+	)
+
+	return converted, nil
+}
+
+// GetDenomBaseFee returns the base fee of the current block converted into the
+// given alternate fee denomination. It returns nil if the denomination is not
+// accepted or the base fee is not enabled.
+func (k Keeper) GetDenomBaseFee(ctx sdk.Context, denom string) *big.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomBaseFeeKey(denom))
+	if bz == nil {
+		return nil
+	}
+
+	var baseFee sdkmath.Int
+	if err := baseFee.Unmarshal(bz); err != nil {
+		return nil
+	}
+	return baseFee.BigInt()
+}
+
+// CalculateDenomBaseFee calculates the base fee of the next block converted
+// into the given alternate fee denomination at the current rate. It returns nil
+// if the denomination is not accepted or the base fee is not enabled.
+func (k Keeper) CalculateDenomBaseFee(ctx sdk.Context, denom string) *big.Int {
+	baseFee := k.CalculateBaseFee(ctx)
+	if baseFee == nil {
+		return nil
+	}
+
+	rate, found := k.FeeConversionRate(ctx, denom)
+	if !found {
+		return nil
+	}
+	return convertBaseFee(baseFee, rate)
+}
+
+// setDenomBaseFees stores the given base fee converted into each accepted
+// alternate fee denomination, and deletes the base fees of the denominations
+// that are no longer accepted.
+func (k Keeper) setDenomBaseFees(ctx sdk.Context, baseFee *big.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomBaseFee)
+	iterator := store.Iterator(nil, nil)
+
+	var denoms [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, denom := range denoms {
+		store.Delete(denom)
+	}
+
+	for _, conversion := range k.GetParams(ctx).FeeConversions {
+		rate, found := k.FeeConversionRate(ctx, conversion.Denom)
+		if !found {
+			continue
+		}
+
+		bz, err := sdkmath.NewIntFromBigInt(convertBaseFee(baseFee, rate)).Marshal()
+		if err != nil {
+			// NOTE: marshaling an integer never fails
+			panic(err)
+		}
+		store.Set([]byte(conversion.Denom), bz)
+	}
+}
+
+// convertBaseFee returns the given base fee of the EVM denomination divided by
+// the conversion rate of an alternate fee denomination. It is rounded up, so
+// that a fee paid at the converted base fee always converts back to at least
+// the base fee.
+func convertBaseFee(baseFee *big.Int, rate sdkmath.LegacyDec) *big.Int {
+	// the rate is an integer scaled by the decimal precision
+	scaledRate := rate.BigInt()
+	converted := new(big.Int).Mul(baseFee, sdkmath.LegacyOneDec().BigInt())
+	converted.Add(converted, scaledRate)
+	converted.Sub(converted, big.NewInt(1))
+	return converted.Quo(converted, scaledRate)
+}
@@ -0,0 +1,347 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/evmos/evmos/v19/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	erc20types "github.com/evmos/evmos/v19/x/erc20/types"
+	"github.com/evmos/evmos/v19/x/feemarket/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const altDenom = "ibc/usdc"
+
+// setFeeConversion registers the alternate denom in x/erc20, accepts it at the
+// given rate and funds the fee conversion pool with the given amount of the
+// EVM denom.
+func (suite *KeeperTestSuite) setFeeConversion(rate math.LegacyDec, poolAmount int64) {
+	suite.app.Erc20Keeper.SetToken(suite.ctx, erc20types.NewTokenPair(utiltx.GenerateAddress(), altDenom, erc20types.OWNER_MODULE))
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.FeeConversions = []types.FeeConversion{{Denom: altDenom, Rate: rate}}
+	err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(err)
+
+	if poolAmount > 0 {
+		err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, poolAmount)))
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertFee() {
+	payer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	altFee := sdk.NewInt64Coin(altDenom, 100)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expConverts []int64
+		expErr      string
+	}{
+		{
+			"fail - denom not accepted",
+			func() {},
+			nil,
+			"is not accepted",
+		},
+		{
+			"fail - converted fee rounds to zero",
+			func() {
+				suite.setFeeConversion(math.LegacyNewDecWithPrec(1, 3), 1000)
+			},
+			nil,
+			"converts to zero",
+		},
+		{
+			"fail - pool lacks depth",
+			func() {
+				suite.setFeeConversion(math.LegacyNewDec(2), 199)
+			},
+			nil,
+			"lacks depth",
+		},
+		{
+			"pass - pool exhausted after the first conversion",
+			func() {
+				suite.setFeeConversion(math.LegacyNewDec(2), 300)
+			},
+			[]int64{200},
+			"lacks depth",
+		},
+		{
+			"pass - rate updated mid-block applies to the following conversions",
+			func() {
+				suite.setFeeConversion(math.LegacyNewDec(2), 1000)
+			},
+			[]int64{200, 300},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, payer, sdk.NewCoins(sdk.NewInt64Coin(altDenom, 1000)))
+			suite.Require().NoError(err)
+
+			for i, expConverted := range tc.expConverts {
+				if i == 1 {
+					_, err := suite.app.FeeMarketKeeper.UpdateFeeConversionRate(suite.ctx, &types.MsgUpdateFeeConversionRate{
+						Sender: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+						Denom:  altDenom,
+						Rate:   math.LegacyNewDec(3),
+					})
+					suite.Require().NoError(err)
+				}
+
+				suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+				converted, err := suite.app.FeeMarketKeeper.ConvertFee(suite.ctx, payer, altFee, suite.denom)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewInt64Coin(suite.denom, expConverted), converted)
+
+				events := suite.ctx.EventManager().Events()
+				suite.Require().Equal(types.EventTypeFeeConversion, events[len(events)-1].Type)
+			}
+
+			if tc.expErr == "" {
+				return
+			}
+
+			balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, payer)
+			_, err = suite.app.FeeMarketKeeper.ConvertFee(suite.ctx, payer, altFee, suite.denom)
+			suite.Require().Error(err)
+			suite.Require().Contains(err.Error(), tc.expErr)
+			suite.Require().Equal(balancesBefore, suite.app.BankKeeper.GetAllBalances(suite.ctx, payer))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateFeeConversionRate() {
+	oracle := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		request *types.MsgUpdateFeeConversionRate
+		expErr  bool
+	}{
+		{
+			"fail - sender is neither the authority nor the oracle",
+			&types.MsgUpdateFeeConversionRate{Sender: sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(), Denom: altDenom, Rate: math.LegacyNewDec(3)},
+			true,
+		},
+		{
+			"fail - denom not accepted",
+			&types.MsgUpdateFeeConversionRate{Sender: authority, Denom: "ibc/other", Rate: math.LegacyNewDec(3)},
+			true,
+		},
+		{
+			"pass - updated by the authority",
+			&types.MsgUpdateFeeConversionRate{Sender: authority, Denom: altDenom, Rate: math.LegacyNewDec(3)},
+			false,
+		},
+		{
+			"pass - updated by the oracle",
+			&types.MsgUpdateFeeConversionRate{Sender: oracle, Denom: altDenom, Rate: math.LegacyNewDec(3)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setFeeConversion(math.LegacyNewDec(2), 0)
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.FeeConversionOracle = oracle
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			_, err = suite.app.FeeMarketKeeper.UpdateFeeConversionRate(suite.ctx, tc.request)
+			rate, found := suite.app.FeeMarketKeeper.GetParams(suite.ctx).FeeConversionRate(altDenom)
+			suite.Require().True(found)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Equal(math.LegacyNewDec(2), rate)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.request.Rate, rate)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFundAndDrainFeeConversionPool() {
+	suite.SetupTest()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1000))
+
+	funder := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, amount)
+	suite.Require().NoError(err)
+	err = suite.app.DistrKeeper.FundCommunityPool(suite.ctx, amount, funder)
+	suite.Require().NoError(err)
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+	// only governance can fund the pool
+	_, err = suite.app.FeeMarketKeeper.FundFeeConversionPool(suite.ctx, &types.MsgFundFeeConversionPool{Authority: funder.String(), Amount: amount})
+	suite.Require().Error(err)
+
+	// the pool can't be funded with more than the community pool
+	overAmount := sdk.NewCoins(sdk.NewCoin(suite.denom, communityPool.AmountOf(suite.denom).TruncateInt().AddRaw(1)))
+	_, err = suite.app.FeeMarketKeeper.FundFeeConversionPool(suite.ctx, &types.MsgFundFeeConversionPool{Authority: authority, Amount: overAmount})
+	suite.Require().Error(err)
+
+	_, err = suite.app.FeeMarketKeeper.FundFeeConversionPool(suite.ctx, &types.MsgFundFeeConversionPool{Authority: authority, Amount: amount})
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount, suite.app.FeeMarketKeeper.GetFeeConversionPool(suite.ctx))
+	suite.Require().Equal(communityPool.Sub(sdk.NewDecCoinsFromCoins(amount...)), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+
+	res, err := suite.queryClient.FeeConversionPool(suite.ctx, &types.QueryFeeConversionPoolRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.FeeMarketKeeper.FeeConversionPoolAddress().String(), res.Address)
+	suite.Require().Equal(amount, res.Balance)
+
+	// only governance can drain the pool
+	_, err = suite.app.FeeMarketKeeper.DrainFeeConversionPool(suite.ctx, &types.MsgDrainFeeConversionPool{Authority: funder.String(), Amount: amount})
+	suite.Require().Error(err)
+
+	_, err = suite.app.FeeMarketKeeper.DrainFeeConversionPool(suite.ctx, &types.MsgDrainFeeConversionPool{Authority: authority, Amount: amount})
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.FeeMarketKeeper.GetFeeConversionPool(suite.ctx).IsZero())
+	suite.Require().Equal(communityPool, suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeConversionRates() {
+	suite.SetupTest()
+
+	res, err := suite.queryClient.FeeConversionRates(suite.ctx, &types.QueryFeeConversionRatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.FeeConversions)
+
+	suite.setFeeConversion(math.LegacyNewDec(2), 0)
+
+	res, err = suite.queryClient.FeeConversionRates(suite.ctx, &types.QueryFeeConversionRatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FeeConversion{{Denom: altDenom, Rate: math.LegacyNewDec(2)}}, res.FeeConversions)
+}
+
+// feeRateOracle is a fee rate oracle with fixed rates.
+type feeRateOracle map[string]math.LegacyDec
+
+func (o feeRateOracle) FeeConversionRate(_ sdk.Context, denom string) (math.LegacyDec, bool) {
+	rate, found := o[denom]
+	return rate, found
+}
+
+func (suite *KeeperTestSuite) TestFeeConversionRate() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expFound bool
+		expRate  math.LegacyDec
+	}{
+		{
+			"fail - denom not accepted",
+			func() {},
+			false,
+			math.LegacyDec{},
+		},
+		{
+			"fail - denom not registered in x/erc20",
+			func() {
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.FeeConversions = []types.FeeConversion{{Denom: altDenom, Rate: math.LegacyNewDec(2)}}
+				err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			false,
+			math.LegacyDec{},
+		},
+		{
+			"pass - rate of the params",
+			func() {
+				suite.setFeeConversion(math.LegacyNewDec(2), 0)
+			},
+			true,
+			math.LegacyNewDec(2),
+		},
+		{
+			"pass - rate of the oracle",
+			func() {
+				suite.setFeeConversion(math.LegacyNewDec(2), 0)
+				suite.app.FeeMarketKeeper = suite.app.FeeMarketKeeper.WithFeeRateOracle(feeRateOracle{altDenom: math.LegacyNewDec(5)})
+			},
+			true,
+			math.LegacyNewDec(5),
+		},
+		{
+			"pass - invalid rate of the oracle falls back to the params",
+			func() {
+				suite.setFeeConversion(math.LegacyNewDec(2), 0)
+				suite.app.FeeMarketKeeper = suite.app.FeeMarketKeeper.WithFeeRateOracle(feeRateOracle{altDenom: math.LegacyZeroDec()})
+			},
+			true,
+			math.LegacyNewDec(2),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			rate, found := suite.app.FeeMarketKeeper.FeeConversionRate(suite.ctx, altDenom)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(tc.expRate, rate)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDenomBaseFee() {
+	suite.SetupTest()
+	suite.setFeeConversion(math.LegacyNewDec(3), 0)
+
+	suite.app.FeeMarketKeeper.BeginBlock(suite.ctx, abcitypes.RequestBeginBlock{})
+	baseFee := suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx)
+	suite.Require().NotNil(baseFee)
+
+	// the converted base fee is rounded up
+	convert := func(baseFee *big.Int) *big.Int {
+		return new(big.Int).Quo(new(big.Int).Add(baseFee, big.NewInt(2)), big.NewInt(3))
+	}
+	expBaseFee := convert(baseFee)
+	suite.Require().Equal(expBaseFee, suite.app.FeeMarketKeeper.GetDenomBaseFee(suite.ctx, altDenom))
+
+	nextBaseFee := suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx)
+	suite.Require().Equal(convert(nextBaseFee), suite.app.FeeMarketKeeper.CalculateDenomBaseFee(suite.ctx, altDenom))
+
+	res, err := suite.queryClient.BaseFee(suite.ctx, &types.QueryBaseFeeRequest{Denom: altDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewIntFromBigInt(expBaseFee), *res.BaseFee)
+
+	_, err = suite.queryClient.BaseFee(suite.ctx, &types.QueryBaseFeeRequest{Denom: "ibc/other"})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	// the base fee of a denom is deleted once it is no longer accepted
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.FeeConversions = nil
+	err = suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(err)
+
+	suite.app.FeeMarketKeeper.BeginBlock(suite.ctx, abcitypes.RequestBeginBlock{})
+	suite.Require().Nil(suite.app.FeeMarketKeeper.GetDenomBaseFee(suite.ctx, altDenom))
+	suite.Require().Nil(suite.app.FeeMarketKeeper.CalculateDenomBaseFee(suite.ctx, altDenom))
+}
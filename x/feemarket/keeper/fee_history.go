@@ -0,0 +1,150 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/x/feemarket/types"
+)
+
+// ----------------------------------------------------------------------------
+// Fee History
+// Rolling fee history of the recent blocks, served to the JSON-RPC
+// eth_feeHistory. The history of a block is stored in the slot of its height
+// modulo the retention, so that the store size is bounded.
+// ----------------------------------------------------------------------------
+
+// AddTransientTxReward adds the gas used by an EVM transaction paying the given
+// priority fee per gas to the rewards of the current block. The rewards are
+// keyed by their big endian value, so that they are iterated in ascending
+// order. The bookkeeping is not charged to the transaction.
+func (k Keeper) AddTransientTxReward(ctx sdk.Context, reward *big.Int, gasUsed uint64) {
+	if reward == nil || reward.Sign() < 0 {
+		reward = new(big.Int)
+	}
+
+	store := prefix.NewStore(
+		ctx.WithTransientKVGasConfig(storetypes.GasConfig{}).TransientStore(k.transientKey),
+		types.KeyPrefixTransientTxReward,
+	)
+	key := reward.FillBytes(make([]byte, 32))
+	if bz := store.Get(key); len(bz) > 0 {
+		gasUsed += sdk.BigEndianToUint64(bz)
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(gasUsed))
+}
+
+// GetTransientTxRewards returns the rewards of the EVM transactions of the
+// current block, in ascending order.
+func (k Keeper) GetTransientTxRewards(ctx sdk.Context) []types.TxReward {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxReward)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var rewards []types.TxReward
+	for ; iterator.Valid(); iterator.Next() {
+		rewards = append(rewards, types.TxReward{
+			Reward:  sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(iterator.Key())),
+			GasUsed: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return rewards
+}
+
+// SetBlockFeeHistory stores the fee history of a block in the slot of its
+// height, overwriting the block that falls out of the given retention.
+func (k Keeper) SetBlockFeeHistory(ctx sdk.Context, history types.BlockFeeHistory, retention uint64) {
+	if retention == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	slot := uint64(history.Height) % retention //#nosec G701 -- block heights are never negative
+	store.Set(types.FeeHistoryKey(slot), k.cdc.MustMarshal(&history))
+}
+
+// GetBlockFeeHistory returns the fee history of the block at the given height.
+// It returns false if the block is not within the fee history retention of the
+// current height.
+func (k Keeper) GetBlockFeeHistory(ctx sdk.Context, height int64) (types.BlockFeeHistory, bool) {
+	return k.getBlockFeeHistory(ctx, height, k.GetParams(ctx).FeeHistoryRetention)
+}
+
+// getBlockFeeHistory returns the fee history of the block at the given height
+// for the given retention.
+func (k Keeper) getBlockFeeHistory(ctx sdk.Context, height int64, retention uint64) (types.BlockFeeHistory, bool) {
+	if retention == 0 || height <= 0 || height > ctx.BlockHeight() ||
+		uint64(ctx.BlockHeight()-height) >= retention { //#nosec G701 -- checked for negative values above
+		return types.BlockFeeHistory{}, false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeHistoryKey(uint64(height) % retention))
+	if bz == nil {
+		return types.BlockFeeHistory{}, false
+	}
+
+	var history types.BlockFeeHistory
+	k.cdc.MustUnmarshal(bz, &history)
+
+	// the slot holds another block if no history was recorded for the height
+	if history.Height != height {
+		return types.BlockFeeHistory{}, false
+	}
+	return history, true
+}
+
+// pruneFeeHistory deletes the fee history slots beyond the given retention.
+func (k Keeper) pruneFeeHistory(ctx sdk.Context, retention uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeHistory)
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(retention), nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// recordFeeHistory stores the fee history of the current block, with the
+// rewards of its EVM transactions.
+func (k Keeper) recordFeeHistory(ctx sdk.Context, params types.Params, gasUsed uint64) {
+	if params.FeeHistoryRetention == 0 {
+		return
+	}
+
+	history := types.BlockFeeHistory{
+		Height:   ctx.BlockHeight(),
+		BaseFee:  sdkmath.ZeroInt(),
+		GasUsed:  gasUsed,
+		GasLimit: feeHistoryGasLimit(ctx),
+		Rewards:  k.GetTransientTxRewards(ctx),
+	}
+
+	if baseFee := k.GetBaseFee(ctx); baseFee != nil {
+		history.BaseFee = sdkmath.NewIntFromBigInt(baseFee)
+	}
+
+	k.SetBlockFeeHistory(ctx, history, params.FeeHistoryRetention)
+}
+
+// feeHistoryGasLimit returns the gas limit of the block as reported by the
+// JSON-RPC, which is the max uint32 value if the block gas is unlimited.
+func feeHistoryGasLimit(ctx sdk.Context) uint64 {
+	gasLimit := blockGasLimit(ctx)
+	if !gasLimit.IsUint64() || gasLimit.Uint64() == math.MaxUint64 {
+		return math.MaxUint32
+	}
+	return gasLimit.Uint64()
+}
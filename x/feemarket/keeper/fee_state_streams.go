@@ -0,0 +1,222 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/x/feemarket/types"
+)
+
+const (
+	// DefaultFeeStateMaxStreams is the default maximum number of concurrent
+	// SubscribeFeeState streams.
+	DefaultFeeStateMaxStreams = 100
+	// DefaultFeeStateBufferSize is the default number of fee states buffered
+	// for a stream whose client doesn't keep up, before the oldest ones are
+	// dropped.
+	DefaultFeeStateBufferSize = 64
+
+	// feeStateSubscriber is the subscriber name of the new block events of the
+	// CometBFT event bus.
+	feeStateSubscriber = "feemarket-fee-state"
+)
+
+// FeeStateStreams fans out the fee states of the new blocks, read from the
+// CometBFT event bus, to the SubscribeFeeState gRPC streams.
+type FeeStateStreams struct {
+	maxStreams int
+	bufferSize int
+	started    atomic.Bool
+
+	mtx     sync.Mutex
+	streams map[*feeStateStream]struct{}
+}
+
+// feeStateStream is the buffer of the fee states of a SubscribeFeeState stream.
+type feeStateStream struct {
+	mtx     sync.Mutex
+	queue   []types.EventFeeState
+	dropped uint64
+	// notify is signaled when a fee state is queued
+	notify chan struct{}
+}
+
+// NewFeeStateStreams returns the fee state streams accepting at most maxStreams
+// concurrent streams, that buffer at most bufferSize fee states each. The
+// default values are used for the non-positive arguments.
+func NewFeeStateStreams(maxStreams, bufferSize int) *FeeStateStreams {
+	if maxStreams <= 0 {
+		maxStreams = DefaultFeeStateMaxStreams
+	}
+	if bufferSize <= 0 {
+		bufferSize = DefaultFeeStateBufferSize
+	}
+
+	return &FeeStateStreams{
+		maxStreams: maxStreams,
+		bufferSize: bufferSize,
+		streams:    make(map[*feeStateStream]struct{}),
+	}
+}
+
+// Start subscribes to the new block events of the CometBFT event bus, and
+// publishes their fee state events to the streams.
+func (s *FeeStateStreams) Start(client rpcclient.EventsClient) error {
+	query := tmtypes.QueryForEvent(tmtypes.EventNewBlock).String()
+	eventCh, err := client.Subscribe(context.Background(), feeStateSubscriber, query, s.bufferSize)
+	if err != nil {
+		return err
+	}
+
+	s.started.Store(true)
+
+	go func() {
+		defer s.started.Store(false)
+
+		for event := range eventCh {
+			data, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+
+			for _, blockEvent := range data.ResultEndBlock.Events {
+				if blockEvent.Type != proto.MessageName(&types.EventFeeState{}) {
+					continue
+				}
+
+				msg, err := sdk.ParseTypedEvent(blockEvent)
+				if err != nil {
+					continue
+				}
+
+				if state, ok := msg.(*types.EventFeeState); ok {
+					s.Publish(*state)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Started returns true if the streams are fed by the CometBFT event bus.
+func (s *FeeStateStreams) Started() bool {
+	return s.started.Load()
+}
+
+// NumStreams returns the number of open streams.
+func (s *FeeStateStreams) NumStreams() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return len(s.streams)
+}
+
+// Publish queues the fee state to all the open streams. The oldest fee state
+// of a stream whose buffer is full is dropped, and counted as such.
+func (s *FeeStateStreams) Publish(state types.EventFeeState) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for stream := range s.streams {
+		stream.push(state, s.bufferSize)
+	}
+}
+
+// Serve sends the published fee states to the stream until it is closed by
+// the client.
+func (s *FeeStateStreams) Serve(srv types.Query_SubscribeFeeStateServer) error {
+	stream, err := s.subscribe()
+	if err != nil {
+		return err
+	}
+	defer s.unsubscribe(stream)
+
+	ctx := srv.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-stream.notify:
+		}
+
+		for {
+			state, dropped, ok := stream.pop()
+			if !ok {
+				break
+			}
+
+			if err := srv.Send(&types.QuerySubscribeFeeStateResponse{
+				FeeState: state,
+				Dropped:  dropped,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// subscribe opens a stream, unless the maximum number of streams is reached.
+func (s *FeeStateStreams) subscribe() (*feeStateStream, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.streams) >= s.maxStreams {
+		return nil, status.Errorf(codes.ResourceExhausted, "maximum number of fee state streams reached (%d)", s.maxStreams)
+	}
+
+	stream := &feeStateStream{notify: make(chan struct{}, 1)}
+	s.streams[stream] = struct{}{}
+	return stream, nil
+}
+
+// unsubscribe closes the stream.
+func (s *FeeStateStreams) unsubscribe(stream *feeStateStream) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	delete(s.streams, stream)
+}
+
+// push queues the fee state, dropping the oldest one if the buffer is full.
+func (fs *feeStateStream) push(state types.EventFeeState, bufferSize int) {
+	fs.mtx.Lock()
+	defer fs.mtx.Unlock()
+
+	if len(fs.queue) >= bufferSize {
+		fs.queue = fs.queue[1:]
+		fs.dropped++
+	}
+	fs.queue = append(fs.queue, state)
+
+	select {
+	case fs.notify <- struct{}{}:
+	default:
+	}
+}
+
+// pop returns the oldest queued fee state and the number of fee states dropped
+// so far, or false if the queue is empty.
+func (fs *feeStateStream) pop() (types.EventFeeState, uint64, bool) {
+	fs.mtx.Lock()
+	defer fs.mtx.Unlock()
+
+	if len(fs.queue) == 0 {
+		return types.EventFeeState{}, fs.dropped, false
+	}
+
+	state := fs.queue[0]
+	fs.queue = fs.queue[1:]
+	return state, fs.dropped, true
+}
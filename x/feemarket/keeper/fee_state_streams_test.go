@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/evmos/v19/x/feemarket/keeper"
+	"github.com/evmos/evmos/v19/x/feemarket/types"
+)
+
+// slowFeeStateServer is a SubscribeFeeState stream whose client only receives
+// the fee states when the test reads them.
+type slowFeeStateServer struct {
+	grpc.ServerStream
+
+	ctx context.Context
+	// sending is signaled when a fee state is being sent
+	sending chan struct{}
+	sent    chan *types.QuerySubscribeFeeStateResponse
+}
+
+func newSlowFeeStateServer(ctx context.Context) *slowFeeStateServer {
+	return &slowFeeStateServer{
+		ctx:     ctx,
+		sending: make(chan struct{}, 1),
+		sent:    make(chan *types.QuerySubscribeFeeStateResponse),
+	}
+}
+
+func (s *slowFeeStateServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *slowFeeStateServer) Send(res *types.QuerySubscribeFeeStateResponse) error {
+	select {
+	case s.sending <- struct{}{}:
+	default:
+	}
+
+	select {
+	case s.sent <- res:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// receive returns the next n fee states sent to the stream.
+func (s *slowFeeStateServer) receive(t *testing.T, n int) []*types.QuerySubscribeFeeStateResponse {
+	var received []*types.QuerySubscribeFeeStateResponse
+	for i := 0; i < n; i++ {
+		select {
+		case res := <-s.sent:
+			received = append(received, res)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d fee states out of %d", len(received), n)
+		}
+	}
+
+	// reset the signal of the last fee state sent
+	select {
+	case <-s.sending:
+	default:
+	}
+	return received
+}
+
+// serve serves the stream in the background, and waits for it to be open.
+func serve(t *testing.T, streams *keeper.FeeStateStreams, srv *slowFeeStateServer) <-chan error {
+	numStreams := streams.NumStreams()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- streams.Serve(srv)
+	}()
+
+	require.Eventually(t, func() bool {
+		return streams.NumStreams() == numStreams+1
+	}, 5*time.Second, time.Millisecond)
+	return errCh
+}
+
+// publish publishes the fee states of the given heights.
+func publish(streams *keeper.FeeStateStreams, from, to int64) {
+	for height := from; height <= to; height++ {
+		streams.Publish(types.EventFeeState{Height: height, BaseFee: "1000000000"})
+	}
+}
+
+func requireHeights(t *testing.T, received []*types.QuerySubscribeFeeStateResponse, from int64, dropped uint64) {
+	for i, res := range received {
+		require.Equal(t, from+int64(i), res.FeeState.Height)
+		require.Equal(t, "1000000000", res.FeeState.BaseFee)
+		require.Equal(t, dropped, res.Dropped)
+	}
+}
+
+func TestFeeStateStreamsSlowConsumer(t *testing.T) {
+	const bufferSize = 8
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	streams := keeper.NewFeeStateStreams(1, bufferSize)
+	srv := newSlowFeeStateServer(ctx)
+	errCh := serve(t, streams, srv)
+
+	// the stream is blocked sending the first fee state while the buffer fills
+	// up, without missing any height
+	publish(streams, 1, 1)
+	<-srv.sending
+	publish(streams, 2, 1+bufferSize)
+	requireHeights(t, srv.receive(t, 1+bufferSize), 1, 0)
+
+	// the oldest fee states are dropped past the buffer size
+	publish(streams, 10, 10)
+	<-srv.sending
+	publish(streams, 11, 13+bufferSize)
+	received := srv.receive(t, 1+bufferSize)
+	requireHeights(t, received[:1], 10, 0)
+	requireHeights(t, received[1:], 14, 3)
+
+	// the dropped counter is cumulative
+	publish(streams, 100, 100)
+	requireHeights(t, srv.receive(t, 1), 100, 3)
+
+	cancel()
+	require.Equal(t, codes.Canceled, status.Code(<-errCh))
+	require.Equal(t, 0, streams.NumStreams())
+}
+
+func TestFeeStateStreamsFanOut(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	streams := keeper.NewFeeStateStreams(2, 4)
+	srvs := []*slowFeeStateServer{newSlowFeeStateServer(ctx), newSlowFeeStateServer(ctx)}
+	for _, srv := range srvs {
+		serve(t, streams, srv)
+	}
+
+	// the maximum number of concurrent streams is enforced
+	err := streams.Serve(newSlowFeeStateServer(ctx))
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	publish(streams, 1, 4)
+	for _, srv := range srvs {
+		requireHeights(t, srv.receive(t, 4), 1, 0)
+	}
+}
+
+func TestNewFeeStateStreamsDefaults(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	streams := keeper.NewFeeStateStreams(0, 0)
+	require.False(t, streams.Started())
+
+	for i := 0; i < keeper.DefaultFeeStateMaxStreams; i++ {
+		serve(t, streams, newSlowFeeStateServer(ctx))
+	}
+
+	err := streams.Serve(newSlowFeeStateServer(ctx))
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/evmos/v19/x/feemarket/types"
 )
@@ -25,12 +27,19 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 }
 
 // BaseFee implements the Query/BaseFee gRPC method
-func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+func (k Keeper) BaseFee(c context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
 	res := &types.QueryBaseFeeResponse{}
 	baseFee := k.GetBaseFee(ctx)
 
+	if req != nil && req.Denom != "" {
+		if _, found := k.FeeConversionRate(ctx, req.Denom); !found {
+			return nil, status.Errorf(codes.NotFound, "fee denom %s is not accepted", req.Denom)
+		}
+		baseFee = k.GetDenomBaseFee(ctx, req.Denom)
+	}
+
 	if baseFee != nil {
 		aux := sdkmath.NewIntFromBigInt(baseFee)
 		res.BaseFee = &aux
@@ -48,7 +57,113 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		return nil, errorsmod.Wrapf(sdk.ErrIntOverflowCoin, "block gas %s is higher than MaxInt64", gas)
 	}
 
-	return &types.QueryBlockGasResponse{
-		Gas: gas.Int64(),
+	params := k.GetParams(ctx)
+	res := &types.QueryBlockGasResponse{
+		Gas:               gas.Int64(),
+		ExplicitGasTarget: params.GasTarget != 0,
+	}
+
+	// NOTE: the consensus params are not available in the context of the gRPC
+	// queries, in which case a gas target derived from the block max gas is
+	// unknown.
+	if consParams := ctx.ConsensusParams(); res.ExplicitGasTarget || (consParams != nil && consParams.Block != nil) {
+		res.GasTarget = params.BlockGasTarget(blockGasLimit(ctx)).Uint64()
+	}
+
+	if params.GasWantedEmaWindow > 0 {
+		res.GasWantedEma = k.GetParentGasWanted(ctx, params)
+	}
+
+	return res, nil
+}
+
+// BlockBaseFee implements the Query/BlockBaseFee gRPC method
+func (k Keeper) BlockBaseFee(c context.Context, req *types.QueryBlockBaseFeeRequest) (*types.QueryBlockBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	baseFee := k.GetBlockBaseFee(ctx, req.Height)
+	if baseFee == nil {
+		return nil, status.Errorf(codes.NotFound, "base fee not found for height %d", req.Height)
+	}
+
+	aux := sdkmath.NewIntFromBigInt(baseFee)
+	return &types.QueryBlockBaseFeeResponse{BaseFee: &aux}, nil
+}
+
+// FeeConversionRates implements the Query/FeeConversionRates gRPC method
+func (k Keeper) FeeConversionRates(c context.Context, _ *types.QueryFeeConversionRatesRequest) (*types.QueryFeeConversionRatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryFeeConversionRatesResponse{
+		FeeConversions: params.FeeConversions,
+	}, nil
+}
+
+// FeeConversionPool implements the Query/FeeConversionPool gRPC method
+func (k Keeper) FeeConversionPool(c context.Context, _ *types.QueryFeeConversionPoolRequest) (*types.QueryFeeConversionPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeeConversionPoolResponse{
+		Address: k.FeeConversionPoolAddress().String(),
+		Balance: k.GetFeeConversionPool(ctx),
 	}, nil
 }
+
+// FeeHistory implements the Query/FeeHistory gRPC method
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateRewardPercentiles(req.RewardPercentiles); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	lastHeight := req.LastHeight
+	if lastHeight == 0 {
+		lastHeight = ctx.BlockHeight()
+	}
+	if lastHeight < 0 || lastHeight > ctx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid last height %d, latest height is %d", lastHeight, ctx.BlockHeight())
+	}
+
+	retention := k.GetParams(ctx).FeeHistoryRetention
+	blockCount := req.BlockCount
+	if blockCount > retention {
+		blockCount = retention
+	}
+	if blockCount > uint64(lastHeight) {
+		blockCount = uint64(lastHeight)
+	}
+
+	res := &types.QueryFeeHistoryResponse{}
+	for height := lastHeight - int64(blockCount) + 1; height <= lastHeight; height++ { //#nosec G701 -- block count is capped to the last height
+		history, found := k.getBlockFeeHistory(ctx, height, retention)
+		if !found {
+			continue
+		}
+
+		res.Blocks = append(res.Blocks, types.FeeHistoryEntry{
+			Height:       history.Height,
+			BaseFee:      history.BaseFee,
+			GasUsedRatio: history.GasUsedRatio(),
+			Rewards:      history.RewardPercentiles(req.RewardPercentiles),
+		})
+	}
+
+	return res, nil
+}
+
+// SubscribeFeeState implements the Query/SubscribeFeeState gRPC method
+func (k Keeper) SubscribeFeeState(_ *types.QuerySubscribeFeeStateRequest, srv types.Query_SubscribeFeeStateServer) error {
+	if k.feeStateStreams == nil || !k.feeStateStreams.Started() {
+		return status.Error(codes.Unavailable, "the fee state streams are not served by this node")
+	}
+
+	return k.feeStateStreams.Serve(srv)
+}
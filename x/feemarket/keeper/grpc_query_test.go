@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v19/x/feemarket/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -77,17 +80,33 @@ func (suite *KeeperTestSuite) TestQueryBaseFee() {
 
 func (suite *KeeperTestSuite) TestQueryBlockGas() {
 	testCases := []struct {
-		name    string
-		expPass bool
+		name      string
+		gasTarget uint64
+		expPass   bool
 	}{
 		{
-			"pass",
+			"pass - gas target derived from the block max gas",
+			0,
+			true,
+		},
+		{
+			"pass - explicit gas target",
+			25,
 			true,
 		},
 	}
 	for _, tc := range testCases {
+		params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+		params.GasTarget = tc.gasTarget
+		err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+		suite.Require().NoError(err)
+
 		gas := suite.app.FeeMarketKeeper.GetBlockGasWanted(suite.ctx)
-		exp := &types.QueryBlockGasResponse{Gas: int64(gas)}
+		exp := &types.QueryBlockGasResponse{
+			Gas:               int64(gas),
+			GasTarget:         tc.gasTarget,
+			ExplicitGasTarget: tc.gasTarget != 0,
+		}
 
 		res, err := suite.queryClient.BlockGas(suite.ctx.Context(), &types.QueryBlockGasRequest{})
 		if tc.expPass {
@@ -98,3 +117,102 @@ func (suite *KeeperTestSuite) TestQueryBlockGas() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryFeeHistory() {
+	suite.SetupTest()
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.FeeHistoryRetention = 4
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	// no history is recorded for the height 5, whose slot holds the height 1
+	for _, height := range []int64{1, 2, 3, 4, 6} {
+		suite.app.FeeMarketKeeper.SetBlockFeeHistory(suite.ctx, types.BlockFeeHistory{
+			Height:   height,
+			BaseFee:  sdkmath.NewInt(height * 100),
+			GasUsed:  50,
+			GasLimit: 100,
+			Rewards:  []types.TxReward{{Reward: sdkmath.NewInt(height), GasUsed: 50}},
+		}, params.FeeHistoryRetention)
+	}
+	ctx := sdk.WrapSDKContext(suite.ctx.WithBlockHeight(6))
+
+	entry := func(height int64) types.FeeHistoryEntry {
+		return types.FeeHistoryEntry{
+			Height:       height,
+			BaseFee:      sdkmath.NewInt(height * 100),
+			GasUsedRatio: 0.5,
+			Rewards:      []sdkmath.Int{sdkmath.NewInt(height), sdkmath.NewInt(height)},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		req       *types.QueryFeeHistoryRequest
+		expBlocks []types.FeeHistoryEntry
+		expCode   codes.Code
+	}{
+		{
+			"pass - latest blocks capped to the retention",
+			&types.QueryFeeHistoryRequest{BlockCount: 10, RewardPercentiles: []float64{0, 100}},
+			[]types.FeeHistoryEntry{entry(3), entry(4), entry(6)},
+			codes.OK,
+		},
+		{
+			"pass - range ending at a past block",
+			&types.QueryFeeHistoryRequest{LastHeight: 4, BlockCount: 2, RewardPercentiles: []float64{0, 100}},
+			[]types.FeeHistoryEntry{entry(3), entry(4)},
+			codes.OK,
+		},
+		{
+			"pass - range out of the retention",
+			&types.QueryFeeHistoryRequest{LastHeight: 2, BlockCount: 2},
+			nil,
+			codes.OK,
+		},
+		{
+			"fail - empty request",
+			nil,
+			nil,
+			codes.InvalidArgument,
+		},
+		{
+			"fail - last height higher than the latest height",
+			&types.QueryFeeHistoryRequest{LastHeight: 7, BlockCount: 1},
+			nil,
+			codes.InvalidArgument,
+		},
+		{
+			"fail - reward percentiles not in ascending order",
+			&types.QueryFeeHistoryRequest{BlockCount: 1, RewardPercentiles: []float64{100, 0}},
+			nil,
+			codes.InvalidArgument,
+		},
+	}
+	for _, tc := range testCases {
+		res, err := suite.app.FeeMarketKeeper.FeeHistory(ctx, tc.req)
+		if tc.expCode != codes.OK {
+			suite.Require().Equal(tc.expCode, status.Code(err), tc.name)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(tc.expBlocks, res.Blocks, tc.name)
+	}
+
+	// reducing the retention drops the slots beyond it, i.e. the heights 3 and 6
+	params.FeeHistoryRetention = 2
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+	params.FeeHistoryRetention = 4
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	res, err := suite.app.FeeMarketKeeper.FeeHistory(ctx, &types.QueryFeeHistoryRequest{BlockCount: 4})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FeeHistoryEntry{{Height: 4, BaseFee: sdkmath.NewInt(400), GasUsedRatio: 0.5, Rewards: []sdkmath.Int{}}}, res.Blocks)
+}
+
+func (suite *KeeperTestSuite) TestSubscribeFeeStateNotStarted() {
+	// the fee state streams are only fed by the nodes running CometBFT
+	err := suite.app.FeeMarketKeeper.SubscribeFeeState(&types.QuerySubscribeFeeStateRequest{}, nil)
+	suite.Require().Equal(codes.Unavailable, status.Code(err))
+}
@@ -3,9 +3,12 @@
 package keeper
 
 import (
+	"fmt"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,11 +31,24 @@ type Keeper struct {
 	authority sdk.AccAddress
 	// Legacy subspace
 	ss paramstypes.Subspace
+	// keepers used to move the funds of the fee conversion pool
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
+	// feeStateStreams serves the fee state of the new blocks to the
+	// SubscribeFeeState gRPC streams
+	feeStateStreams *FeeStateStreams
+	// erc20Keeper restricts the alternate fee denominations to the ones
+	// registered in x/erc20, if set
+	erc20Keeper types.Erc20Keeper
+	// feeRateOracle provides the conversion rates of the alternate fee
+	// denominations, if set
+	feeRateOracle types.FeeRateOracle
 }
 
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
 	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey, ss paramstypes.Subspace,
+	bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper,
 ) Keeper {
 	// ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -45,9 +61,42 @@ func NewKeeper(
 		authority:    authority,
 		transientKey: transientKey,
 		ss:           ss,
+		bankKeeper:   bankKeeper,
+		distrKeeper:  distrKeeper,
 	}
 }
 
+// WithFeeStateStreams sets the fee state streams served by the
+// SubscribeFeeState gRPC method.
+func (k Keeper) WithFeeStateStreams(streams *FeeStateStreams) Keeper {
+	k.feeStateStreams = streams
+	return k
+}
+
+// WithErc20Keeper sets the keeper restricting the alternate fee denominations
+// to the ones registered in x/erc20.
+func (k Keeper) WithErc20Keeper(erc20Keeper types.Erc20Keeper) Keeper {
+	k.erc20Keeper = erc20Keeper
+	return k
+}
+
+// WithFeeRateOracle sets the hook providing the conversion rates of the
+// alternate fee denominations, which otherwise come from the params.
+func (k Keeper) WithFeeRateOracle(oracle types.FeeRateOracle) Keeper {
+	k.feeRateOracle = oracle
+	return k
+}
+
+// StartFeeStateStreams feeds the fee state streams with the new blocks of the
+// CometBFT event bus.
+func (k Keeper) StartFeeStateStreams(client rpcclient.EventsClient) error {
+	if k.feeStateStreams == nil {
+		return nil
+	}
+
+	return k.feeStateStreams.Start(client)
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
@@ -77,6 +126,53 @@ func (k Keeper) GetBlockGasWanted(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// SetBlockGasWantedEMA sets the exponential moving average of the block gas
+// wanted to the store.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockGasWantedEMA(ctx sdk.Context, ema sdkmath.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := ema.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal block gas wanted ema: %w", err))
+	}
+	store.Set(types.KeyPrefixBlockGasWantedEMA, bz)
+}
+
+// GetBlockGasWantedEMA returns the exponential moving average of the block
+// gas wanted from the store. It returns false if the average is unset.
+func (k Keeper) GetBlockGasWantedEMA(ctx sdk.Context) (sdkmath.LegacyDec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixBlockGasWantedEMA)
+	if len(bz) == 0 {
+		return sdkmath.LegacyDec{}, false
+	}
+
+	var ema sdkmath.LegacyDec
+	if err := ema.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("failed to unmarshal block gas wanted ema: %w", err))
+	}
+	return ema, true
+}
+
+// DeleteBlockGasWantedEMA deletes the exponential moving average of the block
+// gas wanted from the store.
+func (k Keeper) DeleteBlockGasWantedEMA(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPrefixBlockGasWantedEMA)
+}
+
+// GetParentGasWanted returns the gas wanted of the parent block used to
+// calculate the base fee, which is the exponential moving average of the block
+// gas wanted if the gas wanted EMA window is enabled and the average is set,
+// and the gas wanted of the parent block otherwise.
+func (k Keeper) GetParentGasWanted(ctx sdk.Context, params types.Params) uint64 {
+	if params.GasWantedEmaWindow > 0 {
+		if ema, ok := k.GetBlockGasWantedEMA(ctx); ok && ema.TruncateInt().IsUint64() {
+			return ema.TruncateInt().Uint64()
+		}
+	}
+	return k.GetBlockGasWanted(ctx)
+}
+
 // GetTransientGasWanted returns the gas wanted in the current block from transient store.
 func (k Keeper) GetTransientGasWanted(ctx sdk.Context) uint64 {
 	store := ctx.TransientStore(k.transientKey)
@@ -101,6 +197,44 @@ func (k Keeper) AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64
 	return result, nil
 }
 
+// ----------------------------------------------------------------------------
+// Block Base Fee
+// Applied base fee per height, served to the JSON-RPC for historical blocks.
+// ----------------------------------------------------------------------------
+
+// SetBlockBaseFee stores the base fee applied at the given height and prunes
+// the entry that falls out of the retention window.
+// CONTRACT: this should be only called during BeginBlock.
+func (k Keeper) SetBlockBaseFee(ctx sdk.Context, height int64, baseFee *big.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := sdkmath.NewIntFromBigInt(baseFee).Marshal()
+	if err != nil {
+		// NOTE: marshaling an integer never fails
+		panic(err)
+	}
+	store.Set(types.BlockBaseFeeKey(height), bz)
+
+	if pruneHeight := height - types.BlockBaseFeeRetention; pruneHeight > 0 {
+		store.Delete(types.BlockBaseFeeKey(pruneHeight))
+	}
+}
+
+// GetBlockBaseFee returns the base fee applied at the given height. It returns
+// nil if the height is pruned or predates the base fee persistence.
+func (k Keeper) GetBlockBaseFee(ctx sdk.Context, height int64) *big.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockBaseFeeKey(height))
+	if bz == nil {
+		return nil
+	}
+
+	var baseFee sdkmath.Int
+	if err := baseFee.Unmarshal(bz); err != nil {
+		return nil
+	}
+	return baseFee.BigInt()
+}
+
 // GetBaseFeeV1 get the base fee from v1 version of states.
 // return nil if base fee is not enabled
 // TODO: Figure out if this will be deleted ?
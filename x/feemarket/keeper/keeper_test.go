@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"cosmossdk.io/math"
+	"github.com/evmos/evmos/v19/x/feemarket/types"
 )
 
 func (suite *KeeperTestSuite) TestSetGetBlockGasWanted() {
@@ -50,3 +51,20 @@ func (suite *KeeperTestSuite) TestSetGetGasFee() {
 		suite.Require().Equal(tc.expFee, fee, tc.name)
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetGetBlockBaseFee() {
+	suite.SetupTest()
+
+	suite.Require().Nil(suite.app.FeeMarketKeeper.GetBlockBaseFee(suite.ctx, 10))
+
+	suite.app.FeeMarketKeeper.SetBlockBaseFee(suite.ctx, 10, big.NewInt(1000))
+	suite.app.FeeMarketKeeper.SetBlockBaseFee(suite.ctx, 11, big.NewInt(0))
+	suite.Require().Equal(big.NewInt(1000), suite.app.FeeMarketKeeper.GetBlockBaseFee(suite.ctx, 10))
+	suite.Require().Equal(0, suite.app.FeeMarketKeeper.GetBlockBaseFee(suite.ctx, 11).Sign())
+
+	// the entry out of the retention window is pruned
+	suite.app.FeeMarketKeeper.SetBlockBaseFee(suite.ctx, 10+types.BlockBaseFeeRetention, big.NewInt(2000))
+	suite.Require().Nil(suite.app.FeeMarketKeeper.GetBlockBaseFee(suite.ctx, 10))
+	suite.Require().NotNil(suite.app.FeeMarketKeeper.GetBlockBaseFee(suite.ctx, 11))
+	suite.Require().Equal(big.NewInt(2000), suite.app.FeeMarketKeeper.GetBlockBaseFee(suite.ctx, 10+types.BlockBaseFeeRetention))
+}
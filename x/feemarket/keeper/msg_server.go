@@ -7,6 +7,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/evmos/evmos/v19/x/feemarket/types"
 )
@@ -20,10 +22,104 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
 	}
 
+	// NOTE: the params are validated again on execution, as the proposal may
+	// have been submitted before the validation was tightened
+	if err := req.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(govtypes.ErrInvalidProposalMsg, err.Error())
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if consParams := ctx.ConsensusParams(); consParams != nil && consParams.Block != nil {
+		if err := req.Params.ValidateGasTarget(consParams.Block.MaxGas); err != nil {
+			return nil, errorsmod.Wrap(govtypes.ErrInvalidProposalMsg, err.Error())
+		}
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateFeeConversionRate implements the gRPC MsgServer interface. It updates
+// the conversion rate of an accepted alternate fee denomination. The update
+// can only be performed by the governance module account or by the fee
+// conversion oracle set in the module parameters.
+func (k *Keeper) UpdateFeeConversionRate(goCtx context.Context, req *types.MsgUpdateFeeConversionRate) (*types.MsgUpdateFeeConversionRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if k.authority.String() != req.Sender && (params.FeeConversionOracle == "" || params.FeeConversionOracle != req.Sender) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid sender; expected %s or the fee conversion oracle, got %s", k.authority.String(), req.Sender,
+		)
+	}
+
+	found := false
+	for i := range params.FeeConversions {
+		if params.FeeConversions[i].Denom == req.Denom {
+			params.FeeConversions[i].Rate = req.Rate
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "fee denom %s is not accepted", req.Denom)
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeeConversionRateResponse{}, nil
+}
+
+// FundFeeConversionPool implements the gRPC MsgServer interface. It moves the
+// given amount from the community pool to the fee conversion pool. The
+// transfer can only be performed if the requested authority is the Cosmos SDK
+// governance module account.
+func (k *Keeper) FundFeeConversionPool(goCtx context.Context, req *types.MsgFundFeeConversionPool) (*types.MsgFundFeeConversionPoolResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: the distribution keeper can't distribute to a module account, so
+	// the community pool is debited and the funds moved between the modules.
+	feePool := k.distrKeeper.GetFeePool(ctx)
+	communityPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(req.Amount...))
+	if negative {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"community pool %s is lower than %s", feePool.CommunityPool, req.Amount,
+		)
+	}
+	feePool.CommunityPool = communityPool
+	k.distrKeeper.SetFeePool(ctx, feePool)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundFeeConversionPoolResponse{}, nil
+}
+
+// DrainFeeConversionPool implements the gRPC MsgServer interface. It returns
+// the given amount from the fee conversion pool to the community pool. The
+// transfer can only be performed if the requested authority is the Cosmos SDK
+// governance module account.
+func (k *Keeper) DrainFeeConversionPool(goCtx context.Context, req *types.MsgDrainFeeConversionPool) (*types.MsgDrainFeeConversionPoolResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.distrKeeper.FundCommunityPool(ctx, req.Amount, k.FeeConversionPoolAddress()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDrainFeeConversionPoolResponse{}, nil
+}
@@ -1,8 +1,14 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/evmos/evmos/v19/x/feemarket/types"
 )
 
@@ -25,10 +31,35 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "pass - gas target equal to the block max gas",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    withGasTarget(100),
+			},
+			expectErr: false,
+		},
+		{
+			name: "fail - invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.Params{},
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - gas target higher than the block max gas",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    withGasTarget(101),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run("MsgUpdateParams", func() {
+			suite.ctx = suite.ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100}})
 			_, err := suite.app.FeeMarketKeeper.UpdateParams(suite.ctx, tc.request)
 			if tc.expectErr {
 				suite.Require().Error(err)
@@ -38,3 +69,116 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func withGasTarget(gasTarget uint64) types.Params {
+	params := types.DefaultParams()
+	params.GasTarget = gasTarget
+	return params
+}
+
+// TestUpdateParamsProposal submits proposals with params that would break the
+// base fee calculation through the governance flow, and checks that they are
+// rejected when submitted and when executed, so that the following blocks are
+// processed with the previous params.
+func (suite *KeeperTestSuite) TestUpdateParamsProposal() {
+	testCases := []struct {
+		name     string
+		malleate func(*types.Params)
+		// expSubmitErr is false for the params that can only be checked
+		// against the state at execution
+		expSubmitErr bool
+	}{
+		{
+			"base fee change denominator is 0",
+			func(params *types.Params) { params.BaseFeeChangeDenominator = 0 },
+			true,
+		},
+		{
+			"elasticity multiplier is 0",
+			func(params *types.Params) { params.ElasticityMultiplier = 0 },
+			true,
+		},
+		{
+			"negative min gas price",
+			func(params *types.Params) { params.MinGasPrice = math.LegacyNewDec(-1) },
+			true,
+		},
+		{
+			"base fee lower than the min gas price",
+			func(params *types.Params) { params.MinGasPrice = math.LegacyNewDecFromInt(params.BaseFee.AddRaw(1)) },
+			true,
+		},
+		{
+			"negative enable height",
+			func(params *types.Params) { params.EnableHeight = -1 },
+			true,
+		},
+		{
+			"gas target higher than the block max gas",
+			func(params *types.Params) { params.GasTarget = 101 },
+			false,
+		},
+		{
+			"block max gas lower than the elasticity multiplier",
+			func(params *types.Params) { params.ElasticityMultiplier = 101 },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.ctx = suite.ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100}})
+
+			expParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params := expParams
+			tc.malleate(&params)
+
+			msgs := []sdk.Msg{&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    params,
+			}}
+			proposer := sdk.AccAddress(suite.address.Bytes())
+
+			proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, msgs, "", tc.name, tc.name, proposer)
+			if tc.expSubmitErr {
+				suite.Require().ErrorIs(err, govtypes.ErrInvalidProposalMsg)
+
+				// store the proposal as if it was submitted before the
+				// validation of the params
+				id, err := suite.app.GovKeeper.GetProposalID(suite.ctx)
+				suite.Require().NoError(err)
+				proposal, err = govv1.NewProposal(msgs, id, suite.ctx.BlockTime(), suite.ctx.BlockTime(), "", tc.name, tc.name, proposer)
+				suite.Require().NoError(err)
+				suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
+				suite.app.GovKeeper.SetProposalID(suite.ctx, id+1)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+
+			// all the delegators vote yes
+			for _, delegation := range suite.app.StakingKeeper.GetAllDelegations(suite.ctx) {
+				err := suite.app.GovKeeper.AddVote(suite.ctx, proposal.Id, delegation.GetDelegatorAddr(), govv1.NewNonSplitVoteOption(govv1.OptionYes), "")
+				suite.Require().NoError(err)
+			}
+
+			proposal, found := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
+			suite.Require().True(found)
+			suite.ctx = suite.ctx.WithBlockTime(*proposal.VotingEndTime)
+			gov.EndBlocker(suite.ctx, &suite.app.GovKeeper)
+
+			// the proposal passed but its execution failed
+			proposal, found = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
+			suite.Require().True(found)
+			suite.Require().Equal(govv1.StatusFailed, proposal.Status)
+			suite.Require().Equal(expParams, suite.app.FeeMarketKeeper.GetParams(suite.ctx))
+
+			suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+			suite.Require().NotPanics(func() {
+				suite.app.FeeMarketKeeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{})
+			})
+			suite.Require().NotNil(suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx))
+		})
+	}
+}
@@ -41,6 +41,15 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 		return err
 	}
 
+	// drop the fee history slots beyond a reduced retention
+	if oldBz := store.Get(types.ParamsKey); oldBz != nil {
+		var oldParams types.Params
+		k.cdc.MustUnmarshal(oldBz, &oldParams)
+		if params.FeeHistoryRetention < oldParams.FeeHistoryRetention {
+			k.pruneFeeHistory(ctx, params.FeeHistoryRetention)
+		}
+	}
+
 	store.Set(types.ParamsKey, bz)
 
 	return nil
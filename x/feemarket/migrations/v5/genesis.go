@@ -0,0 +1,24 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v5
+
+import (
+	"cosmossdk.io/math"
+	"github.com/evmos/evmos/v19/x/feemarket/types"
+)
+
+// MigrateGenesis migrates the x/feemarket genesis state exported by the
+// previous versions, which only carry the base fee in the params, to hold the
+// base fee of the last block as a first-class field.
+func MigrateGenesis(state types.GenesisState) types.GenesisState {
+	if !state.BaseFee.IsNil() && !state.BaseFee.IsZero() {
+		return state
+	}
+
+	state.BaseFee = math.ZeroInt()
+	if !state.Params.BaseFee.IsNil() {
+		state.BaseFee = state.Params.BaseFee
+	}
+
+	return state
+}
@@ -0,0 +1,35 @@
+package v5_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/encoding"
+	v5 "github.com/evmos/evmos/v19/x/feemarket/migrations/v5"
+	"github.com/evmos/evmos/v19/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateGenesis(t *testing.T) {
+	cdc := encoding.MakeConfig(app.ModuleBasics).Codec
+
+	params := types.DefaultParams()
+	params.BaseFee = math.NewInt(1234567890)
+	paramsBz, err := cdc.MarshalJSON(&params)
+	require.NoError(t, err)
+
+	// the genesis state exported by the previous versions has no base fee
+	var oldState types.GenesisState
+	err = cdc.UnmarshalJSON([]byte(`{"params":`+string(paramsBz)+`,"block_gas":"1000"}`), &oldState)
+	require.NoError(t, err)
+
+	state := v5.MigrateGenesis(oldState)
+	require.NoError(t, state.Validate())
+	require.Equal(t, params.BaseFee, state.BaseFee)
+	require.Equal(t, uint64(1000), state.BlockGas)
+
+	// the exported base fee is kept
+	state.BaseFee = math.NewInt(42)
+	require.Equal(t, math.NewInt(42), v5.MigrateGenesis(state).BaseFee)
+}
@@ -21,7 +21,10 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/feemarket/MsgUpdateParams"
+	updateParamsName            = "ethermint/feemarket/MsgUpdateParams"
+	updateFeeConversionRateName = "ethermint/feemarket/MsgUpdateFeeConversionRate"
+	fundFeeConversionPoolName   = "ethermint/feemarket/MsgFundFeeConversionPool"
+	drainFeeConversionPoolName  = "ethermint/feemarket/MsgDrainFeeConversionPool"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -35,6 +38,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateFeeConversionRate{},
+		&MsgFundFeeConversionPool{},
+		&MsgDrainFeeConversionPool{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -42,4 +48,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgUpdateFeeConversionRate{}, updateFeeConversionRateName, nil)
+	cdc.RegisterConcrete(&MsgFundFeeConversionPool{}, fundFeeConversionPoolName, nil)
+	cdc.RegisterConcrete(&MsgDrainFeeConversionPool{}, drainFeeConversionPoolName, nil)
 }
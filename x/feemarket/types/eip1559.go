@@ -0,0 +1,156 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// BlockGasTarget returns the gas target of a block with the given gas limit.
+// It is the gas target parameter if set, capped to the gas limit, and the gas
+// limit divided by the elasticity multiplier otherwise.
+func (p Params) BlockGasTarget(gasLimit *big.Int) *big.Int {
+	if p.GasTarget != 0 {
+		return math.BigMin(new(big.Int).SetUint64(p.GasTarget), gasLimit)
+	}
+
+	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
+	// validation
+	return new(big.Int).Div(gasLimit, new(big.Int).SetUint64(uint64(p.ElasticityMultiplier)))
+}
+
+// CalcBaseFee calculates the base fee of the block that follows a parent block
+// with the given gas used (i.e gas wanted) and gas limit, using the parameter
+// base fee as the parent base fee, within the bounds of BoundBaseFee. It
+// returns nil if the parent base fee is not defined or the gas target
+// overflows.
+func (p Params) CalcBaseFee(parentGasUsed uint64, gasLimit *big.Int) *big.Int {
+	return p.BoundBaseFee(p.calcBaseFee(parentGasUsed, gasLimit))
+}
+
+// calcBaseFee calculates the EIP-1559 base fee of the block that follows a
+// parent block with the given gas used and gas limit.
+// NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
+// chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+func (p Params) calcBaseFee(parentGasUsed uint64, gasLimit *big.Int) *big.Int {
+	// NOTE: this is not the parent's base fee but the current block's base fee,
+	// as it is retrieved from the transient store, which is committed to the
+	// persistent KVStore after EndBlock (ABCI Commit).
+	parentBaseFee := p.BaseFee.BigInt()
+	if parentBaseFee == nil {
+		return nil
+	}
+
+	parentGasTargetBig := p.BlockGasTarget(gasLimit)
+	if !parentGasTargetBig.IsUint64() {
+		return nil
+	}
+
+	parentGasTarget := parentGasTargetBig.Uint64()
+	baseFeeChangeDenominator := new(big.Int).SetUint64(uint64(p.BaseFeeChangeDenominator))
+
+	// If the parent gasUsed is the same as the target, the baseFee remains
+	// unchanged.
+	if parentGasUsed == parentGasTarget {
+		return new(big.Int).Set(parentBaseFee)
+	}
+
+	if parentGasUsed > parentGasTarget {
+		// If the parent block used more gas than its target, the baseFee should
+		// increase by at least 1.
+		gasUsedDelta := new(big.Int).SetUint64(parentGasUsed - parentGasTarget)
+		baseFeeDelta := math.BigMax(
+			computeBaseFeeDelta(parentBaseFee, gasUsedDelta, parentGasTargetBig, baseFeeChangeDenominator),
+			common.Big1,
+		)
+
+		return new(big.Int).Add(parentBaseFee, baseFeeDelta)
+	}
+
+	// Otherwise if the parent block used less gas than its target, the baseFee
+	// should decrease.
+	gasUsedDelta := new(big.Int).SetUint64(parentGasTarget - parentGasUsed)
+	baseFeeDelta := computeBaseFeeDelta(parentBaseFee, gasUsedDelta, parentGasTargetBig, baseFeeChangeDenominator)
+
+	// Set global min gas price as lower bound of the base fee, transactions below
+	// the min gas price don't even reach the mempool.
+	minGasPrice := p.MinGasPrice.TruncateInt().BigInt()
+	return math.BigMax(new(big.Int).Sub(parentBaseFee, baseFeeDelta), minGasPrice)
+}
+
+// computeBaseFeeDelta returns the absolute change of the base fee of a block
+// whose parent gas used differs from the gas target by gasDelta, i.e.
+// parentBaseFee * gasDelta / gasTarget / denominator. The result is a new
+// value, the arguments are never modified.
+func computeBaseFeeDelta(parentBaseFee, gasDelta, gasTarget, denominator *big.Int) *big.Int {
+	delta := new(big.Int).Mul(parentBaseFee, gasDelta)
+	delta.Div(delta, gasTarget)
+	return delta.Div(delta, denominator)
+}
+
+// ClampBaseFeeChange bounds the increase of the given base fee over the
+// parameter base fee to the standard EIP-1559 maximum change of a block, i.e.
+// the parameter base fee divided by the base fee change denominator, and at
+// least 1. The decrease calculated by CalcBaseFee is already within this bound,
+// and the global min gas price remains the lower bound of the base fee. It
+// returns nil if the parameter base fee is not defined.
+func (p Params) ClampBaseFeeChange(baseFee *big.Int) *big.Int {
+	parentBaseFee := p.BaseFee.BigInt()
+	if parentBaseFee == nil || baseFee == nil {
+		return nil
+	}
+
+	maxDelta := math.BigMax(
+		new(big.Int).Div(parentBaseFee, new(big.Int).SetUint64(uint64(p.BaseFeeChangeDenominator))),
+		common.Big1,
+	)
+
+	upper := math.BigMax(new(big.Int).Add(parentBaseFee, maxDelta), p.MinGasPrice.TruncateInt().BigInt())
+	return math.BigMin(baseFee, upper)
+}
+
+// BoundBaseFee bounds the change of the given base fee over the parameter base
+// fee by the max base fee change rate, and at least 1, then caps it to the max
+// base fee. The unset bounds are disabled. As for ClampBaseFeeChange, the
+// global min gas price remains the lower bound of the base fee. It returns nil
+// if the given base fee is nil.
+func (p Params) BoundBaseFee(baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return nil
+	}
+
+	parentBaseFee := p.BaseFee.BigInt()
+	if rate := p.MaxBaseFeeChangeRate; parentBaseFee != nil && !rate.IsNil() && rate.IsPositive() {
+		maxDelta := math.BigMax(p.BaseFee.ToLegacyDec().Mul(rate).TruncateInt().BigInt(), common.Big1)
+
+		lower := new(big.Int).Sub(parentBaseFee, maxDelta)
+		upper := math.BigMax(new(big.Int).Add(parentBaseFee, maxDelta), p.MinGasPrice.TruncateInt().BigInt())
+		baseFee = math.BigMin(math.BigMax(baseFee, lower), upper)
+	}
+
+	if maxBaseFee := p.MaxBaseFee; !maxBaseFee.IsNil() && maxBaseFee.IsPositive() {
+		baseFee = math.BigMin(baseFee, maxBaseFee.BigInt())
+	}
+
+	return baseFee
+}
+
+// NextGasWantedEMA returns the exponential moving average of the block gas
+// wanted updated with the gas wanted of a new block, using the smoothing
+// factor 2 / (N + 1) of the gas wanted EMA window N. The average is seeded with
+// the gas wanted of the block if the previous average is unset.
+// CONTRACT: the gas wanted EMA window is enabled.
+func (p Params) NextGasWantedEMA(prevEMA sdkmath.LegacyDec, gasWanted uint64) sdkmath.LegacyDec {
+	gas := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasWanted))
+	if prevEMA.IsNil() {
+		return gas
+	}
+
+	window := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(p.GasWantedEmaWindow))
+	alpha := sdkmath.LegacyNewDec(2).Quo(window.Add(sdkmath.LegacyOneDec()))
+	return prevEMA.Add(gas.Sub(prevEMA).Mul(alpha))
+}
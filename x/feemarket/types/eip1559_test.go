@@ -0,0 +1,351 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/stretchr/testify/require"
+)
+
+// calcBaseFeeReference is the former implementation of CalcBaseFee, reusing a
+// single big.Int for the intermediate values, that the current implementation
+// must match bit for bit.
+func calcBaseFeeReference(p Params, parentGasUsed uint64, gasLimit *big.Int) *big.Int {
+	parentBaseFee := p.BaseFee.BigInt()
+	if parentBaseFee == nil {
+		return nil
+	}
+
+	parentGasTargetBig := p.BlockGasTarget(gasLimit)
+	if !parentGasTargetBig.IsUint64() {
+		return nil
+	}
+
+	parentGasTarget := parentGasTargetBig.Uint64()
+	baseFeeChangeDenominator := new(big.Int).SetUint64(uint64(p.BaseFeeChangeDenominator))
+
+	if parentGasUsed == parentGasTarget {
+		return new(big.Int).Set(parentBaseFee)
+	}
+
+	if parentGasUsed > parentGasTarget {
+		gasUsedDelta := new(big.Int).SetUint64(parentGasUsed - parentGasTarget)
+		x := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
+		y := x.Div(x, parentGasTargetBig)
+		baseFeeDelta := gethmath.BigMax(
+			x.Div(y, baseFeeChangeDenominator),
+			common.Big1,
+		)
+
+		return x.Add(parentBaseFee, baseFeeDelta)
+	}
+
+	gasUsedDelta := new(big.Int).SetUint64(parentGasTarget - parentGasUsed)
+	x := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
+	y := x.Div(x, parentGasTargetBig)
+	baseFeeDelta := x.Div(y, baseFeeChangeDenominator)
+
+	minGasPrice := p.MinGasPrice.TruncateInt().BigInt()
+	return gethmath.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
+}
+
+// baseFeeParams returns the params of a fee market with the given base fee and
+// the go-ethereum change denominator and elasticity multiplier.
+func baseFeeParams(baseFee *big.Int) Params {
+	params := DefaultParams()
+	params.BaseFee = math.NewIntFromBigInt(baseFee)
+	params.BaseFeeChangeDenominator = 8
+	params.ElasticityMultiplier = 2
+	params.MinGasPrice = sdk.ZeroDec()
+	return params
+}
+
+func TestCalcBaseFee(t *testing.T) {
+	var (
+		oneGwei   = big.NewInt(1_000_000_000)
+		gasLimit  = big.NewInt(30_000_000)
+		gasTarget = uint64(15_000_000)
+		maxUint64 = new(big.Int).SetUint64(gethmath.MaxUint64)
+		maxTarget = uint64(gethmath.MaxUint64 / 2)
+		maxFee    = new(big.Int).Lsh(big.NewInt(1), 255)
+	)
+
+	testCases := []struct {
+		name          string
+		params        func() Params
+		parentGasUsed uint64
+		gasLimit      *big.Int
+		expBaseFee    string // empty means nil
+	}{
+		{
+			"parent gas used at target",
+			func() Params { return baseFeeParams(oneGwei) },
+			gasTarget,
+			gasLimit,
+			"1000000000",
+		},
+		{
+			"full parent block",
+			func() Params { return baseFeeParams(oneGwei) },
+			2 * gasTarget,
+			gasLimit,
+			"1125000000",
+		},
+		{
+			"empty parent block",
+			func() Params { return baseFeeParams(oneGwei) },
+			0,
+			gasLimit,
+			"875000000",
+		},
+		{
+			"increase - gas delta of 1",
+			func() Params { return baseFeeParams(oneGwei) },
+			gasTarget + 1,
+			gasLimit,
+			"1000000008",
+		},
+		{
+			"decrease - gas delta of 1",
+			func() Params { return baseFeeParams(oneGwei) },
+			gasTarget - 1,
+			gasLimit,
+			"999999992",
+		},
+		{
+			"increase - base fee delta of 0 raised to 1",
+			func() Params { return baseFeeParams(big.NewInt(100)) },
+			gasTarget + 1,
+			gasLimit,
+			"101",
+		},
+		{
+			"decrease - base fee delta of 0",
+			func() Params { return baseFeeParams(big.NewInt(100)) },
+			gasTarget - 1,
+			gasLimit,
+			"100",
+		},
+		{
+			"increase - base fee delta of 1",
+			func() Params { return baseFeeParams(big.NewInt(8)) },
+			2 * gasTarget,
+			gasLimit,
+			"9",
+		},
+		{
+			"decrease - base fee delta of 1",
+			func() Params { return baseFeeParams(big.NewInt(8)) },
+			0,
+			gasLimit,
+			"7",
+		},
+		{
+			"decrease - bounded by the min gas price",
+			func() Params {
+				params := baseFeeParams(oneGwei)
+				params.MinGasPrice = sdk.NewDec(900_000_000)
+				return params
+			},
+			0,
+			gasLimit,
+			"900000000",
+		},
+		{
+			"increase - near overflow",
+			func() Params { return baseFeeParams(maxFee) },
+			gethmath.MaxUint64,
+			maxUint64,
+			"65133050195990359926543316784310283263028910644054010190082752458903936565249",
+		},
+		{
+			"decrease - near overflow",
+			func() Params { return baseFeeParams(maxFee) },
+			0,
+			maxUint64,
+			"50659039041325835497812305941300959685805618291217746767262693003461994217472",
+		},
+		{
+			"at target - near overflow",
+			func() Params { return baseFeeParams(maxFee) },
+			maxTarget,
+			maxUint64,
+			maxFee.String(),
+		},
+		{
+			"gas target overflows",
+			func() Params {
+				params := baseFeeParams(oneGwei)
+				params.ElasticityMultiplier = 1
+				return params
+			},
+			0,
+			new(big.Int).Add(maxUint64, common.Big1),
+			"",
+		},
+		{
+			"nil base fee",
+			func() Params {
+				params := baseFeeParams(oneGwei)
+				params.BaseFee = math.Int{}
+				return params
+			},
+			0,
+			gasLimit,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := tc.params()
+			gasLimit := new(big.Int).Set(tc.gasLimit)
+
+			baseFee := params.CalcBaseFee(tc.parentGasUsed, gasLimit)
+			if tc.expBaseFee == "" {
+				require.Nil(t, baseFee)
+			} else {
+				require.Equal(t, tc.expBaseFee, baseFee.String())
+			}
+			require.Equal(t, calcBaseFeeReference(params, tc.parentGasUsed, tc.gasLimit).String(), baseFee.String())
+
+			// the gas limit is left unchanged
+			require.Equal(t, tc.gasLimit.String(), gasLimit.String())
+		})
+	}
+}
+
+func TestBoundBaseFee(t *testing.T) {
+	oneGwei := big.NewInt(1_000_000_000)
+
+	testCases := []struct {
+		name          string
+		maxBaseFee    math.Int
+		maxChangeRate math.LegacyDec
+		minGasPrice   math.LegacyDec
+		baseFee       *big.Int
+		expBaseFee    string
+	}{
+		{"bounds disabled", math.ZeroInt(), math.LegacyZeroDec(), math.LegacyZeroDec(), big.NewInt(5_000_000_000), "5000000000"},
+		{"bounds unset", math.Int{}, math.LegacyDec{}, math.LegacyZeroDec(), big.NewInt(5_000_000_000), "5000000000"},
+		{"increase within the change rate", math.ZeroInt(), math.LegacyNewDecWithPrec(5, 2), math.LegacyZeroDec(), big.NewInt(1_040_000_000), "1040000000"},
+		{"increase bounded by the change rate", math.ZeroInt(), math.LegacyNewDecWithPrec(5, 2), math.LegacyZeroDec(), big.NewInt(1_125_000_000), "1050000000"},
+		{"decrease bounded by the change rate", math.ZeroInt(), math.LegacyNewDecWithPrec(5, 2), math.LegacyZeroDec(), big.NewInt(875_000_000), "950000000"},
+		{"change rate bounds the change by at least 1", math.ZeroInt(), math.LegacyNewDecWithPrec(1, 18), math.LegacyZeroDec(), big.NewInt(1_125_000_000), "1000000001"},
+		{"change rate doesn't bound below the min gas price", math.ZeroInt(), math.LegacyNewDecWithPrec(5, 2), math.LegacyNewDec(2_000_000_000), big.NewInt(2_000_000_000), "2000000000"},
+		{"capped to the max base fee", math.NewInt(1_100_000_000), math.LegacyZeroDec(), math.LegacyZeroDec(), big.NewInt(1_125_000_000), "1100000000"},
+		{"capped to the max base fee within the change rate", math.NewInt(1_020_000_000), math.LegacyNewDecWithPrec(5, 2), math.LegacyZeroDec(), big.NewInt(1_125_000_000), "1020000000"},
+		{"max base fee below the parent base fee", math.NewInt(500_000_000), math.LegacyNewDecWithPrec(5, 2), math.LegacyZeroDec(), big.NewInt(875_000_000), "500000000"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := baseFeeParams(oneGwei)
+			params.MaxBaseFee = tc.maxBaseFee
+			params.MaxBaseFeeChangeRate = tc.maxChangeRate
+			params.MinGasPrice = tc.minGasPrice
+
+			require.Equal(t, tc.expBaseFee, params.BoundBaseFee(tc.baseFee).String())
+		})
+	}
+
+	require.Nil(t, DefaultParams().BoundBaseFee(nil))
+}
+
+func TestCalcBaseFeeBounds(t *testing.T) {
+	var (
+		oneGwei  = big.NewInt(1_000_000_000)
+		gasLimit = big.NewInt(30_000_000)
+	)
+
+	params := baseFeeParams(oneGwei)
+	params.MaxBaseFee = math.NewInt(1_100_000_000)
+	params.MaxBaseFeeChangeRate = math.LegacyNewDecWithPrec(5, 2)
+
+	// a full block raises the base fee by 12.5% without bounds
+	require.Equal(t, "1050000000", params.CalcBaseFee(30_000_000, gasLimit).String())
+	// an empty block lowers the base fee by 12.5% without bounds
+	require.Equal(t, "950000000", params.CalcBaseFee(0, gasLimit).String())
+
+	params.MaxBaseFee = math.NewInt(1_010_000_000)
+	require.Equal(t, "1010000000", params.CalcBaseFee(30_000_000, gasLimit).String())
+}
+
+func TestComputeBaseFeeDelta(t *testing.T) {
+	var (
+		parentBaseFee = big.NewInt(1_000_000_000)
+		gasDelta      = big.NewInt(15_000_000)
+		gasTarget     = big.NewInt(15_000_000)
+		denominator   = big.NewInt(8)
+	)
+
+	delta := computeBaseFeeDelta(parentBaseFee, gasDelta, gasTarget, denominator)
+	require.Equal(t, "125000000", delta.String())
+
+	// the result is a fresh value and the arguments are left unchanged
+	delta.SetInt64(0)
+	require.Equal(t, big.NewInt(1_000_000_000), parentBaseFee)
+	require.Equal(t, big.NewInt(15_000_000), gasDelta)
+	require.Equal(t, big.NewInt(15_000_000), gasTarget)
+	require.Equal(t, big.NewInt(8), denominator)
+}
+
+func FuzzCalcBaseFee(f *testing.F) {
+	f.Add([]byte{0x3b, 0x9a, 0xca, 0x00}, uint64(30_000_000), uint64(30_000_000), uint32(8), uint32(2), uint64(0), uint64(0))
+	f.Add([]byte{0x3b, 0x9a, 0xca, 0x00}, uint64(0), uint64(30_000_000), uint32(8), uint32(2), uint64(0), uint64(900_000_000))
+	f.Add([]byte{0x64}, uint64(15_000_001), uint64(30_000_000), uint32(8), uint32(2), uint64(0), uint64(0))
+	f.Add([]byte{0x64}, uint64(1_000_000), uint64(30_000_000), uint32(50), uint32(4), uint64(5_000_000), uint64(0))
+	f.Add(maxFeeBytes(), uint64(gethmath.MaxUint64), uint64(gethmath.MaxUint64), uint32(1), uint32(2), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, baseFee []byte, parentGasUsed, gasLimit uint64, denominator, elasticity uint32, gasTarget, minGasPrice uint64) {
+		// the params validation bounds the values
+		if len(baseFee) > 32 || denominator == 0 || elasticity == 0 {
+			t.Skip()
+		}
+
+		params := baseFeeParams(new(big.Int).SetBytes(baseFee))
+		params.BaseFeeChangeDenominator = denominator
+		params.ElasticityMultiplier = elasticity
+		params.GasTarget = gasTarget
+		params.MinGasPrice = sdk.NewDecFromBigInt(new(big.Int).SetUint64(minGasPrice))
+
+		gasLimitBig := new(big.Int).SetUint64(gasLimit)
+		if target := params.BlockGasTarget(gasLimitBig); target.Sign() == 0 && parentGasUsed != 0 {
+			// both implementations divide by the zero gas target
+			t.Skip()
+		}
+
+		require.Equal(t,
+			calcBaseFeeReference(params, parentGasUsed, gasLimitBig).String(),
+			params.CalcBaseFee(parentGasUsed, gasLimitBig).String(),
+		)
+	})
+}
+
+// maxFeeBytes returns the largest base fee allowed by the params.
+func maxFeeBytes() []byte {
+	return new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)).Bytes()
+}
+
+func TestNextGasWantedEMA(t *testing.T) {
+	params := DefaultParams()
+	params.GasWantedEmaWindow = 3
+
+	// the average is seeded with the gas wanted of the first block
+	ema := params.NextGasWantedEMA(math.LegacyDec{}, 1_000)
+	require.Equal(t, math.LegacyNewDec(1_000), ema)
+
+	// a window of 3 blocks weights the new block by a half
+	ema = params.NextGasWantedEMA(ema, 0)
+	require.Equal(t, math.LegacyNewDec(500), ema)
+	ema = params.NextGasWantedEMA(ema, 2_500)
+	require.Equal(t, math.LegacyNewDec(1_500), ema)
+
+	// a window of 1 block tracks the gas wanted of the last block
+	params.GasWantedEmaWindow = 1
+	require.Equal(t, math.LegacyNewDec(42), params.NextGasWantedEMA(ema, 42))
+}
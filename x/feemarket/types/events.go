@@ -4,7 +4,12 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket     = "fee_market"
+	EventTypeFeeConversion = "fee_conversion"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee        = "base_fee"
+	AttributeKeyFeePayer       = "fee_payer"
+	AttributeKeyFee            = "fee"
+	AttributeKeyConvertedFee   = "converted_fee"
+	AttributeKeyConversionRate = "conversion_rate"
 )
@@ -123,9 +123,93 @@ func (m *EventBlockGas) GetAmount() string {
 	return ""
 }
 
+// EventFeeState defines the fee state of a block, emitted at the end of each
+// block for the off-chain fee oracles
+type EventFeeState struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee applied to the txs of the block, empty if the base fee is disabled
+	BaseFee string `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// gas_wanted of the block, used to calculate the base fee of the next block
+	GasWanted uint64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_target of the block, used to calculate the base fee of the next block
+	GasTarget uint64 `protobuf:"varint,4,opt,name=gas_target,json=gasTarget,proto3" json:"gas_target,omitempty"`
+	// min_gas_price is the minimum gas price of the fee market params
+	MinGasPrice string `protobuf:"bytes,5,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+}
+
+func (m *EventFeeState) Reset()         { *m = EventFeeState{} }
+func (m *EventFeeState) String() string { return proto.CompactTextString(m) }
+func (*EventFeeState) ProtoMessage()    {}
+func (*EventFeeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6edce8d670faff7, []int{2}
+}
+func (m *EventFeeState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeState.Merge(m, src)
+}
+func (m *EventFeeState) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeState proto.InternalMessageInfo
+
+func (m *EventFeeState) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventFeeState) GetBaseFee() string {
+	if m != nil {
+		return m.BaseFee
+	}
+	return ""
+}
+
+func (m *EventFeeState) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *EventFeeState) GetGasTarget() uint64 {
+	if m != nil {
+		return m.GasTarget
+	}
+	return 0
+}
+
+func (m *EventFeeState) GetMinGasPrice() string {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventFeeMarket)(nil), "ethermint.feemarket.v1.EventFeeMarket")
 	proto.RegisterType((*EventBlockGas)(nil), "ethermint.feemarket.v1.EventBlockGas")
+	proto.RegisterType((*EventFeeState)(nil), "ethermint.feemarket.v1.EventFeeState")
 }
 
 func init() {
@@ -133,21 +217,26 @@ func init() {
 }
 
 var fileDescriptor_c6edce8d670faff7 = []byte{
-	// 215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x83, 0x2b, 0xd2, 0x83, 0x2b, 0xd2, 0x2b, 0x33, 0x54, 0xd2, 0xe6, 0xe2, 0x73, 0x05, 0xa9,
-	0x73, 0x4b, 0x4d, 0xf5, 0x05, 0x0b, 0x0a, 0x49, 0x72, 0x71, 0x24, 0x25, 0x16, 0xa7, 0xc6, 0xa7,
-	0xa5, 0xa6, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0xb1, 0x83, 0xf8, 0x6e, 0xa9, 0xa9, 0x4a,
-	0xf6, 0x5c, 0xbc, 0x60, 0xc5, 0x4e, 0x39, 0xf9, 0xc9, 0xd9, 0xee, 0x89, 0xc5, 0x42, 0x62, 0x5c,
-	0x6c, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x50, 0x95, 0x50, 0x1e, 0x48, 0x3c, 0x31, 0x37, 0xbf,
-	0x34, 0xaf, 0x44, 0x82, 0x09, 0x22, 0x0e, 0xe1, 0x39, 0xb9, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x7e, 0x6a, 0x59, 0x6e, 0x7e, 0x31, 0x94, 0x2c, 0x33, 0xb4, 0xd0, 0xaf, 0x40, 0xf2, 0x57, 0x49,
-	0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x53, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb8,
-	0x18, 0x5d, 0x8d, 0xfb, 0x00, 0x00, 0x00,
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0xbb, 0x6d, 0xad, 0x76, 0xa5, 0x1e, 0x72, 0x28, 0xf1, 0xe0, 0x52, 0xe2, 0xa5, 0xa0,
+	0x24, 0x14, 0x4f, 0x9e, 0x84, 0x82, 0xe9, 0x49, 0x90, 0x2a, 0x08, 0x5e, 0xca, 0xb6, 0x4e, 0x93,
+	0xa5, 0xee, 0x6e, 0xc8, 0x4e, 0xa3, 0xbe, 0x85, 0x0f, 0xe1, 0xc3, 0x78, 0xec, 0xd1, 0xa3, 0x24,
+	0x2f, 0x22, 0xd9, 0xc6, 0x50, 0xbd, 0x0c, 0xfc, 0xdf, 0x7c, 0xfc, 0x0c, 0x43, 0x4f, 0x01, 0x63,
+	0x48, 0xa5, 0x50, 0x18, 0x2c, 0x01, 0x24, 0x4f, 0x57, 0x80, 0x41, 0x36, 0x0a, 0x20, 0x03, 0x85,
+	0xc6, 0x4f, 0x52, 0x8d, 0xda, 0xe9, 0xd7, 0x92, 0x5f, 0x4b, 0x7e, 0x36, 0xf2, 0xce, 0xe8, 0xd1,
+	0x75, 0xe9, 0x85, 0x00, 0x37, 0x16, 0x3a, 0xc7, 0xf4, 0x60, 0xce, 0x0d, 0xcc, 0x96, 0x00, 0x2e,
+	0x19, 0x90, 0x61, 0x77, 0xba, 0x5f, 0xe6, 0x10, 0xc0, 0xbb, 0xa2, 0x3d, 0x2b, 0x8f, 0x9f, 0xf5,
+	0x62, 0x35, 0xe1, 0xc6, 0xe9, 0xd3, 0x4e, 0x0c, 0x22, 0x8a, 0xb1, 0x32, 0xab, 0x54, 0x72, 0x2e,
+	0xf5, 0x5a, 0xa1, 0xdb, 0xdc, 0xf2, 0x6d, 0xf2, 0x3e, 0x48, 0xd5, 0x10, 0x02, 0xdc, 0x21, 0x47,
+	0xf8, 0xd7, 0xd0, 0xaa, 0x1b, 0x76, 0xaf, 0x68, 0xfe, 0xb9, 0xc2, 0x39, 0xa1, 0x34, 0xe2, 0x66,
+	0xf6, 0xc2, 0x15, 0xc2, 0x93, 0xdb, 0x1a, 0x90, 0x61, 0x7b, 0xda, 0x8d, 0xb8, 0x79, 0xb0, 0xe0,
+	0x77, 0x8d, 0x3c, 0x8d, 0x00, 0xdd, 0x76, 0xbd, 0xbe, 0xb7, 0xc0, 0xf1, 0x68, 0x4f, 0x0a, 0x35,
+	0x2b, 0x95, 0x24, 0x15, 0x0b, 0x70, 0xf7, 0x6c, 0xfb, 0xa1, 0x14, 0x6a, 0xc2, 0xcd, 0x6d, 0x89,
+	0xc6, 0xe1, 0x67, 0xce, 0xc8, 0x26, 0x67, 0xe4, 0x3b, 0x67, 0xe4, 0xbd, 0x60, 0x8d, 0x4d, 0xc1,
+	0x1a, 0x5f, 0x05, 0x6b, 0x3c, 0x9e, 0x47, 0x02, 0xe3, 0xf5, 0xdc, 0x5f, 0x68, 0x19, 0x40, 0x26,
+	0xb5, 0xa9, 0x66, 0x36, 0xba, 0x0c, 0x5e, 0x77, 0xde, 0x8f, 0x6f, 0x09, 0x98, 0x79, 0xc7, 0xfe,
+	0xfe, 0xe2, 0x67, 0x00, 0x37, 0xfe, 0x59, 0x79, 0xa2, 0x01, 0x00, 0x00,
 }
 
 func (m *EventFeeMarket) Marshal() (dAtA []byte, err error) {
@@ -217,6 +306,58 @@ func (m *EventBlockGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFeeState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinGasPrice) > 0 {
+		i -= len(m.MinGasPrice)
+		copy(dAtA[i:], m.MinGasPrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MinGasPrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasTarget != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasTarget))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BaseFee) > 0 {
+		i -= len(m.BaseFee)
+		copy(dAtA[i:], m.BaseFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BaseFee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -258,6 +399,32 @@ func (m *EventBlockGas) Size() (n int) {
 	return n
 }
 
+func (m *EventFeeState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	l = len(m.BaseFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovEvents(uint64(m.GasWanted))
+	}
+	if m.GasTarget != 0 {
+		n += 1 + sovEvents(uint64(m.GasTarget))
+	}
+	l = len(m.MinGasPrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -460,6 +627,177 @@ func (m *EventBlockGas) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventFeeState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTarget", wireType)
+			}
+			m.GasTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasTarget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
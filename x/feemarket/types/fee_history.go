@@ -0,0 +1,58 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// GasUsedRatio returns the ratio of the gas used by the block to its gas limit.
+func (h BlockFeeHistory) GasUsedRatio() float64 {
+	if h.GasLimit == 0 {
+		return 0
+	}
+	return float64(h.GasUsed) / float64(h.GasLimit)
+}
+
+// RewardPercentiles returns the priority fees per gas at the given
+// percentiles, weighted by the gas used of the transactions as done by
+// go-ethereum. The rewards are all zero if the block has no EVM transactions.
+func (h BlockFeeHistory) RewardPercentiles(percentiles []float64) []math.Int {
+	rewards := make([]math.Int, len(percentiles))
+	if len(h.Rewards) == 0 {
+		for i := range rewards {
+			rewards[i] = math.ZeroInt()
+		}
+		return rewards
+	}
+
+	var txIndex int
+	sumGasUsed := h.Rewards[0].GasUsed
+
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(h.GasUsed) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(h.Rewards)-1 {
+			txIndex++
+			sumGasUsed += h.Rewards[txIndex].GasUsed
+		}
+		rewards[i] = h.Rewards[txIndex].Reward
+	}
+
+	return rewards
+}
+
+// ValidateRewardPercentiles returns an error if the reward percentiles are not
+// in ascending order within [0, 100].
+func ValidateRewardPercentiles(percentiles []float64) error {
+	for i, p := range percentiles {
+		if p < 0 || p > 100 {
+			return fmt.Errorf("reward percentile %f out of the [0, 100] range", p)
+		}
+		if i > 0 && p < percentiles[i-1] {
+			return fmt.Errorf("reward percentiles %f and %f are not in ascending order", percentiles[i-1], p)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardPercentiles(t *testing.T) {
+	history := BlockFeeHistory{
+		GasUsed: 100_000,
+		Rewards: []TxReward{
+			{Reward: math.NewInt(1), GasUsed: 21_000},
+			{Reward: math.NewInt(2), GasUsed: 50_000},
+			{Reward: math.NewInt(10), GasUsed: 29_000},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		history     BlockFeeHistory
+		percentiles []float64
+		exp         []math.Int
+	}{
+		{
+			"no percentiles",
+			history,
+			nil,
+			[]math.Int{},
+		},
+		{
+			"no transactions",
+			BlockFeeHistory{GasUsed: 100_000},
+			[]float64{0, 50, 100},
+			[]math.Int{math.ZeroInt(), math.ZeroInt(), math.ZeroInt()},
+		},
+		{
+			"weighted by gas used",
+			history,
+			[]float64{0, 21, 22, 71, 72, 100},
+			[]math.Int{math.NewInt(1), math.NewInt(1), math.NewInt(2), math.NewInt(2), math.NewInt(10), math.NewInt(10)},
+		},
+		{
+			"block gas used higher than the transactions gas used",
+			BlockFeeHistory{GasUsed: 200_000, Rewards: history.Rewards},
+			[]float64{50, 100},
+			[]math.Int{math.NewInt(10), math.NewInt(10)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, tc.history.RewardPercentiles(tc.percentiles))
+		})
+	}
+}
+
+func TestGasUsedRatio(t *testing.T) {
+	require.Equal(t, 0.25, BlockFeeHistory{GasUsed: 25, GasLimit: 100}.GasUsedRatio())
+	require.Equal(t, float64(0), BlockFeeHistory{GasUsed: 25}.GasUsedRatio())
+}
+
+func TestValidateRewardPercentiles(t *testing.T) {
+	require.NoError(t, ValidateRewardPercentiles(nil))
+	require.NoError(t, ValidateRewardPercentiles([]float64{0, 25, 25, 100}))
+	require.Error(t, ValidateRewardPercentiles([]float64{-1}))
+	require.Error(t, ValidateRewardPercentiles([]float64{101}))
+	require.Error(t, ValidateRewardPercentiles([]float64{50, 25}))
+}
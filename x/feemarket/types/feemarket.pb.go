@@ -43,6 +43,43 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// fee_conversions defines the alternate denominations accepted for the fees
+	// of the Cosmos transactions, which are converted into the EVM denomination
+	// through the fee conversion pool
+	FeeConversions []FeeConversion `protobuf:"bytes,9,rep,name=fee_conversions,json=feeConversions,proto3" json:"fee_conversions"`
+	// fee_conversion_oracle defines the address allowed to update the fee
+	// conversion rates in addition to the governance account. Empty if none.
+	FeeConversionOracle string `protobuf:"bytes,10,opt,name=fee_conversion_oracle,json=feeConversionOracle,proto3" json:"fee_conversion_oracle,omitempty"`
+	// gas_target defines the block gas target used to calculate the base fee.
+	// If zero, the gas target is derived from the consensus block max gas
+	// divided by the elasticity multiplier. Otherwise the elasticity multiplier
+	// is ignored.
+	GasTarget uint64 `protobuf:"varint,11,opt,name=gas_target,json=gasTarget,proto3" json:"gas_target,omitempty"`
+	// reject_underpriced_proposals defines if the block proposals including EVM
+	// transactions whose fee cap is below the base fee of the block are
+	// rejected by the validators.
+	RejectUnderpricedProposals bool `protobuf:"varint,12,opt,name=reject_underpriced_proposals,json=rejectUnderpricedProposals,proto3" json:"reject_underpriced_proposals,omitempty"`
+	// seed_gas_target_at_activation defines if the block gas wanted of the block
+	// at enable_height is recorded as the block gas target, so that the base fee
+	// of the next block doesn't depend on the gas wanted by the transactions
+	// accepted under the fee rules preceding the activation.
+	SeedGasTargetAtActivation bool `protobuf:"varint,13,opt,name=seed_gas_target_at_activation,json=seedGasTargetAtActivation,proto3" json:"seed_gas_target_at_activation,omitempty"`
+	// fee_history_retention defines the number of recent blocks whose fee
+	// history is kept in the store, served to the JSON-RPC eth_feeHistory. Zero
+	// disables the fee history. The history recorded before a change of the
+	// retention is only partially available until refilled by the new blocks.
+	FeeHistoryRetention uint64 `protobuf:"varint,14,opt,name=fee_history_retention,json=feeHistoryRetention,proto3" json:"fee_history_retention,omitempty"`
+	// max_base_fee defines the upper bound of the base fee. Zero disables the
+	// bound. It cannot be lower than the min gas price.
+	MaxBaseFee cosmossdk_io_math.Int `protobuf:"bytes,15,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_base_fee"`
+	// max_base_fee_change_rate defines the maximum change of the base fee between
+	// two blocks, as a fraction of the base fee of the parent block, in addition
+	// to the bound of the base fee change denominator. Zero disables the bound.
+	MaxBaseFeeChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=max_base_fee_change_rate,json=maxBaseFeeChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee_change_rate"`
+	// gas_wanted_ema_window defines the window, in blocks, of the exponential
+	// moving average of the block gas wanted, used instead of the gas wanted of
+	// the parent block to calculate the base fee. Zero disables the average.
+	GasWantedEmaWindow uint64 `protobuf:"varint,17,opt,name=gas_wanted_ema_window,json=gasWantedEmaWindow,proto3" json:"gas_wanted_ema_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,8 +143,235 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeConversions() []FeeConversion {
+	if m != nil {
+		return m.FeeConversions
+	}
+	return nil
+}
+
+func (m *Params) GetFeeConversionOracle() string {
+	if m != nil {
+		return m.FeeConversionOracle
+	}
+	return ""
+}
+
+func (m *Params) GetGasTarget() uint64 {
+	if m != nil {
+		return m.GasTarget
+	}
+	return 0
+}
+
+func (m *Params) GetRejectUnderpricedProposals() bool {
+	if m != nil {
+		return m.RejectUnderpricedProposals
+	}
+	return false
+}
+
+func (m *Params) GetSeedGasTargetAtActivation() bool {
+	if m != nil {
+		return m.SeedGasTargetAtActivation
+	}
+	return false
+}
+
+func (m *Params) GetFeeHistoryRetention() uint64 {
+	if m != nil {
+		return m.FeeHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetGasWantedEmaWindow() uint64 {
+	if m != nil {
+		return m.GasWantedEmaWindow
+	}
+	return 0
+}
+
+// BlockFeeHistory defines the fee history of a block, recorded at the end of
+// the block.
+type BlockFeeHistory struct {
+	// height is the height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the base fee applied to the block
+	BaseFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee"`
+	// gas_used is the gas used by the block
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit is the gas limit of the block
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// rewards are the priority fees per gas paid by the EVM transactions of the
+	// block, in ascending order
+	Rewards []TxReward `protobuf:"bytes,5,rep,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *BlockFeeHistory) Reset()         { *m = BlockFeeHistory{} }
+func (m *BlockFeeHistory) String() string { return proto.CompactTextString(m) }
+func (*BlockFeeHistory) ProtoMessage()    {}
+func (*BlockFeeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *BlockFeeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFeeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFeeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFeeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFeeHistory.Merge(m, src)
+}
+func (m *BlockFeeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFeeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFeeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFeeHistory proto.InternalMessageInfo
+
+func (m *BlockFeeHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetRewards() []TxReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// TxReward defines the gas used by the EVM transactions of a block paying the
+// same priority fee per gas.
+type TxReward struct {
+	// reward is the priority fee per gas paid to the block proposer
+	Reward cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=reward,proto3,customtype=cosmossdk.io/math.Int" json:"reward"`
+	// gas_used is the gas used by the transactions paying the reward
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *TxReward) Reset()         { *m = TxReward{} }
+func (m *TxReward) String() string { return proto.CompactTextString(m) }
+func (*TxReward) ProtoMessage()    {}
+func (*TxReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{2}
+}
+func (m *TxReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxReward.Merge(m, src)
+}
+func (m *TxReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxReward proto.InternalMessageInfo
+
+func (m *TxReward) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// FeeConversion defines an alternate fee denomination and its conversion rate
+// to the EVM denomination
+type FeeConversion struct {
+	// denom is the alternate fee denomination
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of the EVM denomination paid per unit of denom
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *FeeConversion) Reset()         { *m = FeeConversion{} }
+func (m *FeeConversion) String() string { return proto.CompactTextString(m) }
+func (*FeeConversion) ProtoMessage()    {}
+func (*FeeConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{3}
+}
+func (m *FeeConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeConversion.Merge(m, src)
+}
+func (m *FeeConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeConversion proto.InternalMessageInfo
+
+func (m *FeeConversion) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*BlockFeeHistory)(nil), "ethermint.feemarket.v1.BlockFeeHistory")
+	proto.RegisterType((*TxReward)(nil), "ethermint.feemarket.v1.TxReward")
+	proto.RegisterType((*FeeConversion)(nil), "ethermint.feemarket.v1.FeeConversion")
 }
 
 func init() {
@@ -115,33 +379,56 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xdb, 0x30,
-	0x14, 0xc7, 0xad, 0xa5, 0x4d, 0x5d, 0x75, 0x81, 0x20, 0xda, 0x61, 0x56, 0xe6, 0x9a, 0x15, 0x86,
-	0x0f, 0xc3, 0x26, 0xf4, 0xd2, 0xcb, 0x2e, 0x59, 0x69, 0xb7, 0xb1, 0x41, 0xe7, 0xe3, 0x2e, 0x42,
-	0x76, 0x5f, 0x6c, 0x11, 0x4b, 0x32, 0x96, 0x62, 0x96, 0x6f, 0xb1, 0x6f, 0xb2, 0xaf, 0x91, 0x63,
-	0x8e, 0x63, 0x87, 0x30, 0x92, 0x2f, 0x32, 0x62, 0x27, 0x76, 0x60, 0x97, 0x5d, 0x84, 0xf4, 0xfe,
-	0xff, 0xdf, 0x9f, 0xf7, 0xd0, 0xc3, 0x6f, 0xc0, 0x64, 0x50, 0x0a, 0x2e, 0x4d, 0x38, 0x01, 0x10,
-	0xac, 0x9c, 0x82, 0x09, 0xab, 0x51, 0xf7, 0x08, 0x8a, 0x52, 0x19, 0x45, 0x5e, 0xb4, 0xbe, 0xa0,
-	0x93, 0xaa, 0xd1, 0xcb, 0xf3, 0x54, 0xa5, 0xaa, 0xb6, 0x84, 0xdb, 0x5b, 0xe3, 0x7e, 0xfd, 0xb3,
-	0x87, 0xfb, 0x8f, 0xac, 0x64, 0x42, 0x13, 0x17, 0x9f, 0x49, 0x45, 0x63, 0xa6, 0x81, 0x4e, 0x00,
-	0x1c, 0xe4, 0x21, 0xdf, 0x8e, 0x4e, 0xa5, 0x1a, 0x33, 0x0d, 0xf7, 0x00, 0xe4, 0x1d, 0xbe, 0xdc,
-	0x8b, 0x34, 0xc9, 0x98, 0x4c, 0x81, 0x3e, 0x81, 0x54, 0x82, 0x4b, 0x66, 0x54, 0xe9, 0x3c, 0xf3,
-	0x90, 0x3f, 0x88, 0x9c, 0xb8, 0x71, 0xbf, 0xaf, 0x0d, 0x77, 0x9d, 0x4e, 0x6e, 0xf0, 0x05, 0xe4,
-	0x4c, 0x1b, 0x9e, 0x70, 0x33, 0xa7, 0x62, 0x96, 0x1b, 0x5e, 0xe4, 0x1c, 0x4a, 0xa7, 0x57, 0x83,
-	0xe7, 0x9d, 0xf8, 0xa5, 0xd5, 0xc8, 0x35, 0x1e, 0x80, 0x64, 0x71, 0x0e, 0x34, 0x03, 0x9e, 0x66,
-	0xc6, 0x39, 0xf6, 0x90, 0xdf, 0x8b, 0x9e, 0x37, 0xc5, 0x0f, 0x75, 0x8d, 0xdc, 0x62, 0xbb, 0xed,
-	0xba, 0xef, 0x21, 0xff, 0x74, 0xfc, 0x6a, 0xb1, 0xba, 0xb2, 0x7e, 0xaf, 0xae, 0x2e, 0x12, 0xa5,
-	0x85, 0xd2, 0xfa, 0x69, 0x1a, 0x70, 0x15, 0x0a, 0x66, 0xb2, 0xe0, 0xa3, 0x34, 0xd1, 0xc9, 0xae,
-	0x49, 0xf2, 0x80, 0x07, 0x82, 0x4b, 0x9a, 0x32, 0x4d, 0x8b, 0x92, 0x27, 0xe0, 0x9c, 0xd4, 0xf8,
-	0xf5, 0x0e, 0xbf, 0xfc, 0x17, 0xff, 0x0c, 0x29, 0x4b, 0xe6, 0x77, 0x90, 0x44, 0x67, 0x82, 0xcb,
-	0x07, 0xa6, 0x1f, 0xb7, 0x1c, 0xf9, 0x8a, 0xc9, 0x3e, 0xe8, 0x60, 0x32, 0xfb, 0xff, 0xd3, 0x86,
-	0x4d, 0x5a, 0x37, 0xfa, 0xa7, 0x23, 0xfb, 0x68, 0x78, 0x1c, 0x0d, 0xb9, 0xe4, 0x86, 0xb3, 0xbc,
-	0xfd, 0x97, 0xf1, 0xfd, 0x62, 0xed, 0xa2, 0xe5, 0xda, 0x45, 0x7f, 0xd6, 0x2e, 0xfa, 0xb1, 0x71,
-	0xad, 0xe5, 0xc6, 0xb5, 0x7e, 0x6d, 0x5c, 0xeb, 0xdb, 0xdb, 0x94, 0x9b, 0x6c, 0x16, 0x07, 0x89,
-	0x12, 0x21, 0x54, 0x42, 0xe9, 0xdd, 0x59, 0x8d, 0x6e, 0xc3, 0xef, 0x07, 0x4b, 0x63, 0xe6, 0x05,
-	0xe8, 0xb8, 0x5f, 0x2f, 0xc0, 0xcd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x3d, 0xcc, 0x8c,
-	0x58, 0x02, 0x00, 0x00,
+	// 780 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5d, 0x6f, 0x1b, 0x45,
+	0x14, 0xcd, 0x26, 0x8e, 0xed, 0x4c, 0xe2, 0x26, 0x0c, 0x76, 0x35, 0x6d, 0x88, 0x6b, 0xb9, 0x02,
+	0xf9, 0x01, 0xd9, 0x72, 0x2b, 0x04, 0x3c, 0x20, 0x52, 0x53, 0x92, 0x82, 0x8a, 0x08, 0xab, 0x54,
+	0x95, 0x00, 0x31, 0x1a, 0xef, 0xde, 0xec, 0x0e, 0xd9, 0x99, 0xb1, 0x66, 0xc6, 0x5f, 0xff, 0x82,
+	0x9f, 0xd5, 0xc7, 0x3e, 0x22, 0x24, 0x2a, 0x48, 0xfe, 0x08, 0xda, 0x59, 0xaf, 0xd7, 0x56, 0x40,
+	0x32, 0x2f, 0x51, 0xe6, 0x9e, 0x73, 0xef, 0x9c, 0x7b, 0xe7, 0x9e, 0x35, 0xfa, 0x08, 0x6c, 0x0c,
+	0x5a, 0x70, 0x69, 0x7b, 0x57, 0x00, 0x82, 0xe9, 0x6b, 0xb0, 0xbd, 0x49, 0xbf, 0x38, 0x74, 0x47,
+	0x5a, 0x59, 0x85, 0xef, 0x2f, 0x79, 0xdd, 0x02, 0x9a, 0xf4, 0x1f, 0xd6, 0x23, 0x15, 0x29, 0x47,
+	0xe9, 0xa5, 0xff, 0x65, 0xec, 0xf6, 0xdf, 0x15, 0x54, 0xbe, 0x60, 0x9a, 0x09, 0x83, 0x9b, 0x68,
+	0x5f, 0x2a, 0x3a, 0x64, 0x06, 0xe8, 0x15, 0x00, 0xf1, 0x5a, 0x5e, 0xa7, 0xea, 0xef, 0x49, 0x35,
+	0x60, 0x06, 0xce, 0x00, 0xf0, 0x17, 0xe8, 0x38, 0x07, 0x69, 0x10, 0x33, 0x19, 0x01, 0x0d, 0x41,
+	0x2a, 0xc1, 0x25, 0xb3, 0x4a, 0x93, 0xed, 0x96, 0xd7, 0xa9, 0xf9, 0x64, 0x98, 0xb1, 0xbf, 0x72,
+	0x84, 0xe7, 0x05, 0x8e, 0x9f, 0xa2, 0x06, 0x24, 0xcc, 0x58, 0x1e, 0x70, 0x3b, 0xa7, 0x62, 0x9c,
+	0x58, 0x3e, 0x4a, 0x38, 0x68, 0xb2, 0xe3, 0x12, 0xeb, 0x05, 0xf8, 0xdd, 0x12, 0xc3, 0x8f, 0x51,
+	0x0d, 0x24, 0x1b, 0x26, 0x40, 0x63, 0xe0, 0x51, 0x6c, 0xc9, 0x6e, 0xcb, 0xeb, 0xec, 0xf8, 0x07,
+	0x59, 0xf0, 0x85, 0x8b, 0xe1, 0xcf, 0x50, 0x75, 0xa9, 0xba, 0xdc, 0xf2, 0x3a, 0x7b, 0x83, 0x93,
+	0x37, 0xef, 0x1e, 0x6d, 0xfd, 0xf1, 0xee, 0x51, 0x23, 0x50, 0x46, 0x28, 0x63, 0xc2, 0xeb, 0x2e,
+	0x57, 0x3d, 0xc1, 0x6c, 0xdc, 0xfd, 0x46, 0x5a, 0xbf, 0xb2, 0x10, 0x89, 0xcf, 0x51, 0x4d, 0x70,
+	0x49, 0x23, 0x66, 0xe8, 0x48, 0xf3, 0x00, 0x48, 0xc5, 0xa5, 0x3f, 0x5e, 0xa4, 0x1f, 0xdf, 0x4d,
+	0x7f, 0x09, 0x11, 0x0b, 0xe6, 0xcf, 0x21, 0xf0, 0xf7, 0x05, 0x97, 0xe7, 0xcc, 0x5c, 0xa4, 0x79,
+	0xf8, 0x07, 0x84, 0xf3, 0x42, 0x2b, 0x9d, 0x55, 0x37, 0xaf, 0x76, 0x94, 0x55, 0x5b, 0x69, 0xfd,
+	0x12, 0x1d, 0xba, 0x49, 0x2b, 0x39, 0x01, 0x6d, 0xb8, 0x92, 0x86, 0xec, 0xb5, 0x76, 0x3a, 0xfb,
+	0x4f, 0x3e, 0xec, 0xfe, 0xfb, 0x0b, 0x77, 0xd3, 0xb1, 0x2f, 0xd9, 0x83, 0x52, 0x7a, 0xad, 0x7f,
+	0xef, 0x6a, 0x35, 0x68, 0xf0, 0x13, 0xd4, 0x58, 0xaf, 0x4a, 0x95, 0x66, 0x41, 0x02, 0x04, 0xa5,
+	0x5a, 0xfd, 0xf7, 0xd7, 0xe8, 0xdf, 0x3b, 0x08, 0x9f, 0x20, 0x94, 0x36, 0x66, 0x99, 0x8e, 0xc0,
+	0x92, 0xfd, 0x96, 0xd7, 0x29, 0xf9, 0x7b, 0x11, 0x33, 0x97, 0x2e, 0x80, 0x4f, 0xd1, 0x07, 0x1a,
+	0x7e, 0x85, 0xc0, 0xd2, 0xb1, 0x0c, 0x41, 0xbb, 0x41, 0x86, 0x74, 0xa4, 0xd5, 0x48, 0x19, 0x96,
+	0x18, 0x72, 0xe0, 0x16, 0xe9, 0x61, 0xc6, 0x79, 0x55, 0x50, 0x2e, 0x72, 0x06, 0x3e, 0x45, 0x27,
+	0x06, 0x20, 0xa4, 0xc5, 0x2d, 0x94, 0x59, 0xca, 0x02, 0xcb, 0x27, 0xcc, 0x72, 0x25, 0x49, 0xcd,
+	0x95, 0x78, 0x90, 0x92, 0xce, 0xf3, 0x7b, 0x9f, 0xd9, 0x67, 0x4b, 0x42, 0xde, 0x56, 0xcc, 0x8d,
+	0x55, 0x7a, 0x4e, 0x35, 0x58, 0x90, 0x2e, 0xf3, 0x9e, 0x53, 0x9b, 0xb6, 0xf5, 0x22, 0xc3, 0xfc,
+	0x1c, 0xc2, 0x5f, 0xa2, 0x03, 0xc1, 0x66, 0xc5, 0xc2, 0x1f, 0x6e, 0xb2, 0x3a, 0x48, 0xb0, 0x59,
+	0x6e, 0x88, 0x9f, 0x10, 0x59, 0x2d, 0x90, 0x9b, 0x42, 0x33, 0x0b, 0xe4, 0x68, 0xf3, 0xa7, 0xaf,
+	0x17, 0x25, 0x33, 0xd7, 0xf8, 0xcc, 0x02, 0xee, 0xa3, 0x46, 0x3a, 0x8e, 0x29, 0x93, 0x16, 0x42,
+	0x0a, 0x82, 0xd1, 0x29, 0x97, 0xa1, 0x9a, 0x92, 0xf7, 0x5c, 0x47, 0x38, 0x62, 0xe6, 0xb5, 0xc3,
+	0xbe, 0x16, 0xec, 0xb5, 0x43, 0xbe, 0x2d, 0x55, 0x4b, 0x47, 0xbb, 0xfe, 0x11, 0x97, 0xdc, 0x72,
+	0x96, 0x2c, 0x75, 0xb5, 0xff, 0xf4, 0xd0, 0xe1, 0x20, 0x51, 0xc1, 0xf5, 0xd9, 0x72, 0x0a, 0xf8,
+	0x3e, 0x2a, 0x2f, 0x1c, 0xe5, 0x39, 0x47, 0x95, 0xe3, 0xbb, 0x5e, 0xda, 0xfe, 0x5f, 0x5e, 0x7a,
+	0x80, 0xaa, 0xa9, 0xe0, 0xb1, 0x81, 0xd0, 0x59, 0xba, 0xe4, 0x57, 0x22, 0x66, 0x5e, 0x19, 0x08,
+	0xf1, 0x31, 0x4a, 0xd7, 0x85, 0x26, 0x5c, 0x70, 0x4b, 0x4a, 0x0e, 0x4b, 0xb9, 0x2f, 0xd3, 0x33,
+	0x3e, 0x45, 0x15, 0x0d, 0x53, 0xa6, 0x43, 0x43, 0x76, 0xdd, 0x7e, 0xb7, 0xfe, 0x6b, 0xbf, 0x2f,
+	0x67, 0xbe, 0x23, 0x2e, 0x56, 0x3b, 0x4f, 0x6b, 0xff, 0x8c, 0xaa, 0x39, 0x84, 0x3f, 0x41, 0xe5,
+	0x2c, 0x4c, 0xbc, 0x4d, 0xd4, 0x2f, 0xc8, 0x6b, 0xe2, 0xb7, 0xd7, 0xc4, 0xb7, 0x7f, 0x41, 0xb5,
+	0x35, 0x63, 0xe1, 0x3a, 0xda, 0x75, 0xdf, 0xbd, 0xec, 0x06, 0x3f, 0x3b, 0xe0, 0x4f, 0x51, 0xc9,
+	0x3d, 0xfc, 0xf6, 0xe6, 0x0f, 0xef, 0x12, 0x06, 0x67, 0x6f, 0x6e, 0x9a, 0xde, 0xdb, 0x9b, 0xa6,
+	0xf7, 0xd7, 0x4d, 0xd3, 0xfb, 0xed, 0xb6, 0xb9, 0xf5, 0xf6, 0xb6, 0xb9, 0xf5, 0xfb, 0x6d, 0x73,
+	0xeb, 0xc7, 0x8f, 0x23, 0x6e, 0xe3, 0xf1, 0xb0, 0x1b, 0x28, 0xd1, 0x83, 0x89, 0x50, 0x66, 0xf1,
+	0x77, 0xd2, 0xff, 0xbc, 0x37, 0x5b, 0xf9, 0x11, 0xb0, 0xf3, 0x11, 0x98, 0x61, 0xd9, 0x7d, 0xd0,
+	0x9f, 0xfe, 0x33, 0x00, 0x81, 0xbf, 0xd7, 0x72, 0x28, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -164,6 +451,86 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasWantedEmaWindow != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWantedEmaWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.MaxBaseFeeChangeRate.Size()
+		i -= size
+		if _, err := m.MaxBaseFeeChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.FeeHistoryRetention != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.FeeHistoryRetention))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.SeedGasTargetAtActivation {
+		i--
+		if m.SeedGasTargetAtActivation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.RejectUnderpricedProposals {
+		i--
+		if m.RejectUnderpricedProposals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.GasTarget != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasTarget))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FeeConversionOracle) > 0 {
+		i -= len(m.FeeConversionOracle)
+		copy(dAtA[i:], m.FeeConversionOracle)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.FeeConversionOracle)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.FeeConversions) > 0 {
+		for iNdEx := len(m.FeeConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -216,57 +583,741 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		} else {
 			dAtA[i] = 0
 		}
-		i--
-		dAtA[i] = 0x8
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockFeeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFeeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFeeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoBaseFee {
+		n += 2
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	}
+	if m.EnableHeight != 0 {
+		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasMultiplier.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.FeeConversions) > 0 {
+		for _, e := range m.FeeConversions {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	l = len(m.FeeConversionOracle)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	if m.GasTarget != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasTarget))
+	}
+	if m.RejectUnderpricedProposals {
+		n += 2
+	}
+	if m.SeedGasTargetAtActivation {
+		n += 2
+	}
+	if m.FeeHistoryRetention != 0 {
+		n += 1 + sovFeemarket(uint64(m.FeeHistoryRetention))
+	}
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxBaseFeeChangeRate.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	if m.GasWantedEmaWindow != 0 {
+		n += 2 + sovFeemarket(uint64(m.GasWantedEmaWindow))
+	}
+	return n
+}
+
+func (m *BlockFeeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reward.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *FeeConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoBaseFee = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+			}
+			m.EnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeConversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeConversions = append(m.FeeConversions, FeeConversion{})
+			if err := m.FeeConversions[len(m.FeeConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeConversionOracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeConversionOracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTarget", wireType)
+			}
+			m.GasTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasTarget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectUnderpricedProposals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectUnderpricedProposals = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedGasTargetAtActivation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SeedGasTargetAtActivation = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistoryRetention", wireType)
+			}
+			m.FeeHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFeeChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFeeChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWantedEmaWindow", wireType)
+			}
+			m.GasWantedEmaWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWantedEmaWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeemarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NoBaseFee {
-		n += 2
-	}
-	if m.BaseFeeChangeDenominator != 0 {
-		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
-	}
-	if m.ElasticityMultiplier != 0 {
-		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
-	}
-	if m.EnableHeight != 0 {
-		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.BaseFee.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasMultiplier.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	return n
-}
-
-func sovFeemarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeemarket(x uint64) (n int) {
-	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BlockFeeHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -289,17 +1340,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockFeeHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockFeeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -309,17 +1360,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoBaseFee = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
-			m.BaseFeeChangeDenominator = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -329,16 +1379,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			m.ElasticityMultiplier = 0
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -348,16 +1413,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			m.EnableHeight = 0
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -367,14 +1432,98 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EnableHeight |= int64(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, TxReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -402,13 +1551,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -436,13 +1654,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -470,7 +1686,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,24 +2,42 @@
 // SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:   DefaultParams(),
-		BlockGas: 0,
+		Params:      DefaultParams(),
+		BlockGas:    0,
+		BaseFee:     math.ZeroInt(),
+		BlockGasEma: math.LegacyZeroDec(),
 	}
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, blockGas uint64) *GenesisState {
+func NewGenesisState(params Params, blockGas uint64, baseFee math.Int) *GenesisState {
 	return &GenesisState{
-		Params:   params,
-		BlockGas: blockGas,
+		Params:      params,
+		BlockGas:    blockGas,
+		BaseFee:     baseFee,
+		BlockGasEma: math.LegacyZeroDec(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if !gs.BaseFee.IsNil() && gs.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", gs.BaseFee)
+	}
+
+	if !gs.BlockGasEma.IsNil() && gs.BlockGasEma.IsNegative() {
+		return fmt.Errorf("block gas ema cannot be negative: %s", gs.BlockGasEma)
+	}
+
 	return gs.Params.Validate()
 }
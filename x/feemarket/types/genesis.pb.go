@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +31,12 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// base_fee is the base fee of the last block before the export. It takes
+	// precedence over the base fee of the params, unless zero.
+	BaseFee cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee"`
+	// block_gas_ema is the exponential moving average of the block gas wanted
+	// of the last block before the export. Zero by default.
+	BlockGasEma cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=block_gas_ema,json=blockGasEma,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"block_gas_ema"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,23 +95,27 @@ func init() {
 }
 
 var fileDescriptor_6241c21661288629 = []byte{
-	// 246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xab, 0xd2, 0x83, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x52, 0xc3, 0xa1, 0x1b, 0xa1,
-	0x08, 0xac, 0x5f, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a,
-	0x95, 0x5c, 0x3c, 0xee, 0x10, 0x6b, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x6c, 0xb8, 0xd8, 0x0a,
-	0x12, 0x8b, 0x12, 0x73, 0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0xb0, 0x5b,
-	0xab, 0x17, 0x00, 0x56, 0xe5, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x8f, 0x90, 0x34,
-	0x17, 0x67, 0x52, 0x4e, 0x7e, 0x72, 0x76, 0x7c, 0x7a, 0x62, 0xb1, 0x04, 0xb3, 0x02, 0xa3, 0x06,
-	0x4b, 0x10, 0x07, 0x58, 0xc0, 0x3d, 0xb1, 0xd8, 0x8b, 0x85, 0x83, 0x49, 0x80, 0x39, 0x88, 0x23,
-	0x29, 0xb1, 0x38, 0x35, 0x3e, 0x2d, 0x35, 0xd5, 0xc9, 0xed, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x74, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x53, 0xcb, 0x72, 0xf3, 0x8b, 0xa1, 0x64, 0x99, 0xa1, 0x85, 0x7e, 0x05, 0x92, 0x2f, 0x4b, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x3e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x70,
-	0xda, 0x21, 0x47, 0x01, 0x00, 0x00,
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xc1, 0x4a, 0x02, 0x41,
+	0x1c, 0xc6, 0x77, 0x72, 0x33, 0x1d, 0x0b, 0x62, 0xa9, 0x58, 0x94, 0x46, 0xa9, 0x08, 0x0f, 0x31,
+	0x83, 0x75, 0x29, 0xe8, 0x24, 0xa5, 0x14, 0x1d, 0x62, 0xbb, 0x75, 0x91, 0xd9, 0xed, 0xef, 0xb8,
+	0xd8, 0x38, 0xb2, 0x33, 0x2d, 0xf9, 0x16, 0x3d, 0x96, 0x47, 0x8f, 0xd1, 0x41, 0x42, 0xdf, 0xa0,
+	0x27, 0x08, 0x47, 0xb3, 0xa0, 0xba, 0x0c, 0x7f, 0x3e, 0x7e, 0xbf, 0xf9, 0xe0, 0xc3, 0x07, 0x60,
+	0x3a, 0x90, 0xc8, 0xb8, 0x67, 0x58, 0x1b, 0x40, 0xf2, 0xa4, 0x0b, 0x86, 0xa5, 0x35, 0x26, 0xa0,
+	0x07, 0x3a, 0xd6, 0xb4, 0x9f, 0x28, 0xa3, 0xbc, 0x9d, 0x25, 0x45, 0x97, 0x14, 0x4d, 0x6b, 0xc5,
+	0xc3, 0x7f, 0xec, 0x6f, 0xc8, 0xfa, 0xc5, 0x2d, 0xa1, 0x84, 0xb2, 0x27, 0x9b, 0x5d, 0xf3, 0x74,
+	0xef, 0x03, 0xe1, 0xf5, 0xe6, 0xbc, 0xe7, 0xce, 0x70, 0x03, 0xde, 0x39, 0xce, 0xf6, 0x79, 0xc2,
+	0xa5, 0xf6, 0x51, 0x05, 0x55, 0x0b, 0xc7, 0x84, 0xfe, 0xdd, 0x4b, 0x6f, 0x2d, 0x55, 0x77, 0x87,
+	0xe3, 0xb2, 0x13, 0x2c, 0x1c, 0xaf, 0x84, 0xf3, 0xe1, 0xa3, 0x8a, 0xba, 0x2d, 0xc1, 0xb5, 0x9f,
+	0xa9, 0xa0, 0xaa, 0x1b, 0xe4, 0x6c, 0xd0, 0xe4, 0xda, 0x3b, 0xc5, 0xb9, 0x90, 0x6b, 0x68, 0xb5,
+	0x01, 0x7c, 0xb7, 0x82, 0xaa, 0xf9, 0xfa, 0xee, 0x4c, 0x7e, 0x1b, 0x97, 0xb7, 0x23, 0xa5, 0xa5,
+	0xd2, 0xfa, 0xa1, 0x4b, 0x63, 0xc5, 0x24, 0x37, 0x1d, 0x7a, 0xd5, 0x33, 0xc1, 0xda, 0x0c, 0x6f,
+	0x00, 0x78, 0x4d, 0xbc, 0xb1, 0xfc, 0xb6, 0x05, 0x92, 0xfb, 0xab, 0x56, 0xdf, 0x5f, 0xe8, 0xa5,
+	0xdf, 0xfa, 0x0d, 0x08, 0x1e, 0x0d, 0x2e, 0x20, 0x0a, 0x0a, 0x5f, 0xfd, 0x97, 0x92, 0x5f, 0xbb,
+	0xb9, 0x95, 0xcd, 0x4c, 0xbd, 0x31, 0x9c, 0x10, 0x34, 0x9a, 0x10, 0xf4, 0x3e, 0x21, 0xe8, 0x65,
+	0x4a, 0x9c, 0xd1, 0x94, 0x38, 0xaf, 0x53, 0xe2, 0xdc, 0x1f, 0x89, 0xd8, 0x74, 0x9e, 0x42, 0x1a,
+	0x29, 0xc9, 0x20, 0x95, 0x4a, 0x2f, 0xde, 0xb4, 0x76, 0xc6, 0x9e, 0x7f, 0xec, 0x6b, 0x06, 0x7d,
+	0xd0, 0x61, 0xd6, 0x6e, 0x78, 0xf2, 0x39, 0x00, 0xc5, 0xb1, 0x8d, 0x0c, 0xc1, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +138,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BlockGasEma.Size()
+		i -= size
+		if _, err := m.BlockGasEma.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -167,6 +198,10 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BlockGasEma.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -257,6 +292,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasEma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockGasEma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/stretchr/testify/suite"
 )
 
@@ -28,8 +30,8 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			"valid genesis",
 			&GenesisState{
-				DefaultParams(),
-				uint64(1),
+				Params:   DefaultParams(),
+				BlockGas: uint64(1),
 			},
 			true,
 		},
@@ -38,9 +40,19 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			NewGenesisState(
 				DefaultParams(),
 				uint64(1),
+				math.NewInt(1000000000),
 			),
 			true,
 		},
+		{
+			"negative base fee",
+			NewGenesisState(
+				DefaultParams(),
+				uint64(1),
+				math.NewInt(-1),
+			),
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{
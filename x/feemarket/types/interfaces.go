@@ -3,10 +3,45 @@
 package types
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// BankKeeper defines the expected interface needed to move the funds of the
+// fee conversion pool.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the expected interface needed to fund the fee
+// conversion pool from the community pool.
+type DistributionKeeper interface {
+	GetFeePool(ctx sdk.Context) distrtypes.FeePool
+	SetFeePool(ctx sdk.Context, feePool distrtypes.FeePool)
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// Erc20Keeper defines the expected interface needed to accept only the
+// alternate fee denominations registered in x/erc20.
+type Erc20Keeper interface {
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
+}
+
+// FeeRateOracle defines the hook providing the conversion rates of the
+// alternate fee denominations, applied to the base fee at each block.
+type FeeRateOracle interface {
+	// FeeConversionRate returns the amount of the EVM denomination per unit of
+	// the given alternate fee denomination, or false to fall back to the rate
+	// of the fee market params.
+	FeeConversionRate(ctx sdk.Context, denom string) (math.LegacyDec, bool)
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
@@ -2,6 +2,8 @@
 // SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -22,18 +24,49 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockBaseFee
+	prefixFeeHistory
+	prefixDenomBaseFee
+	prefixBlockGasWantedEMA
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientTxReward
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted    = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockBaseFee      = []byte{prefixBlockBaseFee}
+	KeyPrefixFeeHistory        = []byte{prefixFeeHistory}
+	KeyPrefixDenomBaseFee      = []byte{prefixDenomBaseFee}
+	KeyPrefixBlockGasWantedEMA = []byte{prefixBlockGasWantedEMA}
 )
 
+// BlockBaseFeeRetention is the number of recent block heights for which the
+// applied base fee is kept in the store.
+const BlockBaseFeeRetention int64 = 100_000
+
+// BlockBaseFeeKey returns the store key of the base fee applied at the given height
+func BlockBaseFeeKey(height int64) []byte {
+	return append(KeyPrefixBlockBaseFee, sdk.Uint64ToBigEndian(uint64(height))...) //#nosec G701 -- block heights are never negative
+}
+
+// FeeHistoryKey returns the store key of the given slot of the fee history
+// ring buffer
+func FeeHistoryKey(slot uint64) []byte {
+	return append(KeyPrefixFeeHistory, sdk.Uint64ToBigEndian(slot)...)
+}
+
+// DenomBaseFeeKey returns the store key of the base fee converted into the
+// given alternate fee denomination
+func DenomBaseFeeKey(denom string) []byte {
+	return append(KeyPrefixDenomBaseFee, []byte(denom)...)
+}
+
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientTxReward       = []byte{prefixTransientTxReward}
 )
@@ -4,8 +4,10 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -19,8 +21,18 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultFeeHistoryRetention is 1024 blocks
+	DefaultFeeHistoryRetention = uint64(1024)
+	// DefaultMaxBaseFee is 0 (i.e disabled)
+	DefaultMaxBaseFee = math.ZeroInt()
+	// DefaultMaxBaseFeeChangeRate is 0 (i.e disabled)
+	DefaultMaxBaseFeeChangeRate = math.LegacyZeroDec()
 )
 
+// MaxFeeHistoryRetention is the maximum number of blocks whose fee history can
+// be kept in the store.
+const MaxFeeHistoryRetention uint64 = 10_000
+
 // Parameter keys
 var (
 	ParamsKey                             = []byte("Params")
@@ -47,7 +59,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseFee, &p.BaseFee, validateBaseFee),
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableHeight, &p.EnableHeight, validateEnableHeight),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
-		paramtypes.NewParamSetPair(ParamStoreKeyMinGasMultiplier, &p.MinGasMultiplier, validateMinGasPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinGasMultiplier, &p.MinGasMultiplier, validateMinGasMultiplier),
 	}
 }
 
@@ -82,28 +94,122 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		FeeHistoryRetention:      DefaultFeeHistoryRetention,
+		MaxBaseFee:               DefaultMaxBaseFee,
+		MaxBaseFeeChangeRate:     DefaultMaxBaseFeeChangeRate,
 	}
 }
 
-// Validate performs basic validation on fee market parameters.
+// Validate performs basic validation on fee market parameters. It runs on
+// genesis and on the MsgUpdateParams of the governance proposals, both at
+// submission and at execution, so that the parameters can't break the base fee
+// calculation of the following blocks.
 func (p Params) Validate() error {
-	if p.BaseFeeChangeDenominator == 0 {
-		return fmt.Errorf("base fee change denominator cannot be 0")
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+
+	if err := validateElasticityMultiplier(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateBaseFee(p.BaseFee); err != nil {
+		return err
 	}
 
-	if p.BaseFee.IsNegative() {
-		return fmt.Errorf("initial base fee cannot be negative: %s", p.BaseFee)
+	if err := validateEnableHeight(p.EnableHeight); err != nil {
+		return err
 	}
 
-	if p.EnableHeight < 0 {
-		return fmt.Errorf("enable height cannot be negative: %d", p.EnableHeight)
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
 	}
 
 	if err := validateMinGasMultiplier(p.MinGasMultiplier); err != nil {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	// the calculated base fee never goes below the min gas price, so a lower
+	// base fee would only be raised at the next block
+	if !p.NoBaseFee && p.BaseFee.LT(p.MinGasPrice.TruncateInt()) {
+		return fmt.Errorf("base fee %s cannot be lower than the min gas price %s", p.BaseFee, p.MinGasPrice)
+	}
+
+	if p.FeeHistoryRetention > MaxFeeHistoryRetention {
+		return fmt.Errorf("fee history retention %d cannot be higher than %d", p.FeeHistoryRetention, MaxFeeHistoryRetention)
+	}
+
+	if err := p.validateBaseFeeBounds(); err != nil {
+		return err
+	}
+
+	if err := validateFeeConversions(p.FeeConversions); err != nil {
+		return err
+	}
+
+	if p.FeeConversionOracle != "" {
+		if _, err := sdk.AccAddressFromBech32(p.FeeConversionOracle); err != nil {
+			return fmt.Errorf("invalid fee conversion oracle address %s: %w", p.FeeConversionOracle, err)
+		}
+	}
+
+	return nil
+}
+
+// validateBaseFeeBounds checks that the max base fee is not lower than the min
+// gas price, and that the max base fee change rate is within [0, 1]. The unset
+// bounds are disabled.
+func (p Params) validateBaseFeeBounds() error {
+	if !p.MaxBaseFee.IsNil() {
+		if p.MaxBaseFee.IsNegative() {
+			return fmt.Errorf("max base fee cannot be negative: %s", p.MaxBaseFee)
+		}
+		if p.MaxBaseFee.IsPositive() && p.MaxBaseFee.LT(p.MinGasPrice.TruncateInt()) {
+			return fmt.Errorf("max base fee %s cannot be lower than the min gas price %s", p.MaxBaseFee, p.MinGasPrice)
+		}
+	}
+
+	if !p.MaxBaseFeeChangeRate.IsNil() {
+		if p.MaxBaseFeeChangeRate.IsNegative() {
+			return fmt.Errorf("max base fee change rate cannot be negative: %s", p.MaxBaseFeeChangeRate)
+		}
+		if p.MaxBaseFeeChangeRate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("max base fee change rate cannot be greater than 1: %s", p.MaxBaseFeeChangeRate)
+		}
+	}
+
+	return nil
+}
+
+// ValidateGasTarget checks that the block gas target used to calculate the
+// base fee, from either the gas target parameter or the elasticity multiplier,
+// is positive and doesn't exceed the given consensus block max gas. A max gas
+// of -1 means that the block gas is unlimited.
+func (p Params) ValidateGasTarget(maxGas int64) error {
+	if maxGas < 0 {
+		return nil
+	}
+
+	if p.GasTarget > uint64(maxGas) {
+		return fmt.Errorf("gas target %d cannot be higher than the block max gas %d", p.GasTarget, maxGas)
+	}
+
+	if p.ElasticityMultiplier != 0 && p.BlockGasTarget(big.NewInt(maxGas)).Sign() == 0 {
+		return fmt.Errorf("block gas target of the block max gas %d cannot be 0", maxGas)
+	}
+
+	return nil
+}
+
+// FeeConversionRate returns the conversion rate of the given alternate fee
+// denomination to the EVM denomination, and false if it is not accepted.
+func (p Params) FeeConversionRate(denom string) (math.LegacyDec, bool) {
+	for _, conversion := range p.FeeConversions {
+		if conversion.Denom == denom {
+			return conversion.Rate, true
+		}
+	}
+	return math.LegacyDec{}, false
 }
 
 func validateBool(i interface{}) error {
@@ -150,10 +256,15 @@ func validateBaseFeeChangeDenominator(i interface{}) error {
 }
 
 func validateElasticityMultiplier(i interface{}) error {
-	_, ok := i.(uint32)
+	value, ok := i.(uint32)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+
+	if value == 0 {
+		return fmt.Errorf("elasticity multiplier cannot be 0")
+	}
+
 	return nil
 }
 
@@ -163,6 +274,10 @@ func validateBaseFee(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if value.IsNil() {
+		return fmt.Errorf("base fee cannot be nil")
+	}
+
 	if value.IsNegative() {
 		return fmt.Errorf("base fee cannot be negative")
 	}
@@ -183,6 +298,39 @@ func validateEnableHeight(i interface{}) error {
 	return nil
 }
 
+func validateFeeConversions(conversions []FeeConversion) error {
+	seenDenoms := make(map[string]bool, len(conversions))
+	for _, conversion := range conversions {
+		if err := conversion.Validate(); err != nil {
+			return err
+		}
+
+		if seenDenoms[conversion.Denom] {
+			return fmt.Errorf("duplicate fee conversion denom %s", conversion.Denom)
+		}
+		seenDenoms[conversion.Denom] = true
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the fee conversion.
+func (c FeeConversion) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("invalid fee conversion denom: %w", err)
+	}
+
+	return ValidateFeeConversionRate(c.Rate)
+}
+
+// ValidateFeeConversionRate returns an error if the given fee conversion rate
+// is not positive.
+func ValidateFeeConversionRate(rate math.LegacyDec) error {
+	if rate.IsNil() || !rate.IsPositive() {
+		return fmt.Errorf("fee conversion rate must be positive: %s", rate)
+	}
+	return nil
+}
+
 func validateMinGasMultiplier(i interface{}) error {
 	v, ok := i.(math.LegacyDec)
 
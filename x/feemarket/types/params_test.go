@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -37,6 +38,36 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 0, 3, 2000000000, int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), DefaultMinGasMultiplier),
 			true,
 		},
+		{
+			"elasticity multiplier is 0",
+			NewParams(true, 7, 0, 2000000000, int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), DefaultMinGasMultiplier),
+			true,
+		},
+		{
+			"invalid: base fee is nil",
+			Params{BaseFeeChangeDenominator: 7, ElasticityMultiplier: 3, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier},
+			true,
+		},
+		{
+			"invalid: enable height negative",
+			NewParams(true, 7, 3, 2000000000, -1, math.LegacyNewDecWithPrec(20, 4), DefaultMinGasMultiplier),
+			true,
+		},
+		{
+			"invalid: base fee lower than the min gas price",
+			NewParams(false, 7, 3, 2000000000, 0, math.LegacyNewDec(2000000001), DefaultMinGasMultiplier),
+			true,
+		},
+		{
+			"valid: base fee equal to the truncated min gas price",
+			NewParams(false, 7, 3, 2000000000, 0, math.LegacyMustNewDecFromStr("2000000000.5"), DefaultMinGasMultiplier),
+			false,
+		},
+		{
+			"valid: base fee lower than the min gas price with the base fee disabled",
+			NewParams(true, 7, 3, 2000000000, 0, math.LegacyNewDec(2000000001), DefaultMinGasMultiplier),
+			false,
+		},
 		{
 			"invalid: min gas price negative",
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), math.LegacyNewDecFromInt(math.NewInt(-1)), DefaultMinGasMultiplier),
@@ -57,6 +88,91 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), math.LegacyNewDec(2)),
 			true,
 		},
+		{
+			"valid: fee conversions with an oracle",
+			withFeeConversions(sdk.AccAddress([]byte("fee_conversion_oracle")).String(), FeeConversion{Denom: "ibc/usdc", Rate: math.LegacyNewDec(2)}),
+			false,
+		},
+		{
+			"invalid: fee conversion rate is zero",
+			withFeeConversions("", FeeConversion{Denom: "ibc/usdc", Rate: math.LegacyZeroDec()}),
+			true,
+		},
+		{
+			"invalid: fee conversion rate is nil",
+			withFeeConversions("", FeeConversion{Denom: "ibc/usdc"}),
+			true,
+		},
+		{
+			"invalid: fee conversion denom",
+			withFeeConversions("", FeeConversion{Denom: "1", Rate: math.LegacyNewDec(2)}),
+			true,
+		},
+		{
+			"invalid: duplicate fee conversion denom",
+			withFeeConversions("", FeeConversion{Denom: "ibc/usdc", Rate: math.LegacyNewDec(2)}, FeeConversion{Denom: "ibc/usdc", Rate: math.LegacyNewDec(3)}),
+			true,
+		},
+		{
+			"invalid: fee conversion oracle address",
+			withFeeConversions("oracle"),
+			true,
+		},
+		{
+			"valid: max fee history retention",
+			withFeeHistoryRetention(MaxFeeHistoryRetention),
+			false,
+		},
+		{
+			"invalid: fee history retention higher than the max",
+			withFeeHistoryRetention(MaxFeeHistoryRetention + 1),
+			true,
+		},
+		{
+			"valid: base fee bounds unset",
+			withBaseFeeBounds(math.Int{}, math.LegacyDec{}),
+			false,
+		},
+		{
+			"valid: base fee bounds",
+			withBaseFeeBounds(math.NewInt(1_000_000_000_000), math.LegacyNewDecWithPrec(5, 2)),
+			false,
+		},
+		{
+			"valid: max base fee lower than the base fee",
+			withBaseFeeBounds(math.NewInt(1), math.LegacyZeroDec()),
+			false,
+		},
+		{
+			"valid: max base fee change rate of 1",
+			withBaseFeeBounds(math.ZeroInt(), math.LegacyOneDec()),
+			false,
+		},
+		{
+			"invalid: negative max base fee",
+			withBaseFeeBounds(math.NewInt(-1), math.LegacyZeroDec()),
+			true,
+		},
+		{
+			"invalid: max base fee lower than the min gas price",
+			func() Params {
+				params := withBaseFeeBounds(math.NewInt(1), math.LegacyZeroDec())
+				params.NoBaseFee = true
+				params.MinGasPrice = math.LegacyNewDec(2)
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: negative max base fee change rate",
+			withBaseFeeBounds(math.ZeroInt(), math.LegacyNewDecWithPrec(-1, 2)),
+			true,
+		},
+		{
+			"invalid: max base fee change rate greater than 1",
+			withBaseFeeBounds(math.ZeroInt(), math.LegacyNewDecWithPrec(101, 2)),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -77,10 +193,12 @@ func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateBaseFeeChangeDenominator(uint32(0)))
 	suite.Require().NoError(validateBaseFeeChangeDenominator(uint32(7)))
 	suite.Require().Error(validateElasticityMultiplier(""))
+	suite.Require().Error(validateElasticityMultiplier(uint32(0)))
 	suite.Require().NoError(validateElasticityMultiplier(uint32(2)))
 	suite.Require().Error(validateBaseFee(""))
 	suite.Require().Error(validateBaseFee(int64(2000000000)))
 	suite.Require().Error(validateBaseFee(math.NewInt(-2000000000)))
+	suite.Require().Error(validateBaseFee(math.Int{}))
 	suite.Require().NoError(validateBaseFee(math.NewInt(2000000000)))
 	suite.Require().Error(validateEnableHeight(""))
 	suite.Require().Error(validateEnableHeight(int64(-544435345345435345)))
@@ -117,3 +235,52 @@ func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
 		}
 	}
 }
+
+func (suite *ParamsTestSuite) TestParamsValidateGasTarget() {
+	testCases := []struct {
+		name      string
+		gasTarget uint64
+		maxGas    int64
+		expError  bool
+	}{
+		{"valid - gas target not set", 0, 100, false},
+		{"valid - block max gas unlimited", 200, -1, false},
+		{"valid - gas target lower than the block max gas", 50, 100, false},
+		{"valid - gas target equal to the block max gas", 100, 100, false},
+		{"invalid - gas target higher than the block max gas", 101, 100, true},
+		{"invalid - block max gas lower than the elasticity multiplier", 0, 1, true},
+		{"invalid - zero block max gas", 0, 0, true},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.GasTarget = tc.gasTarget
+		err := params.ValidateGasTarget(tc.maxGas)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func withFeeConversions(oracle string, conversions ...FeeConversion) Params {
+	params := DefaultParams()
+	params.FeeConversions = conversions
+	params.FeeConversionOracle = oracle
+	return params
+}
+
+func withFeeHistoryRetention(retention uint64) Params {
+	params := DefaultParams()
+	params.FeeHistoryRetention = retention
+	return params
+}
+
+func withBaseFeeBounds(maxBaseFee math.Int, maxChangeRate math.LegacyDec) Params {
+	params := DefaultParams()
+	params.MaxBaseFee = maxBaseFee
+	params.MaxBaseFeeChangeRate = maxChangeRate
+	return params
+}
@@ -6,7 +6,10 @@ package types
 import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -116,6 +119,9 @@ func (m *QueryParamsResponse) GetParams() Params {
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
+	// denom is the alternate fee denomination to convert the base fee into. The
+	// base fee of the EVM denomination is returned if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
@@ -151,6 +157,13 @@ func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
 
+func (m *QueryBaseFeeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // QueryBaseFeeResponse returns the EIP1559 base fee.
 type QueryBaseFeeResponse struct {
 	// base_fee is the EIP1559 base fee
@@ -232,6 +245,18 @@ var xxx_messageInfo_QueryBlockGasRequest proto.InternalMessageInfo
 type QueryBlockGasResponse struct {
 	// gas is the returned block gas
 	Gas int64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	// gas_target is the block gas target used to calculate the base fee of the
+	// next block. It is zero if the gas target is derived from the consensus
+	// block max gas and the latter is unknown to the query.
+	GasTarget uint64 `protobuf:"varint,2,opt,name=gas_target,json=gasTarget,proto3" json:"gas_target,omitempty"`
+	// explicit_gas_target is true if the gas target is set by the gas_target
+	// parameter, and false if it is derived from the consensus block max gas and
+	// the elasticity multiplier
+	ExplicitGasTarget bool `protobuf:"varint,3,opt,name=explicit_gas_target,json=explicitGasTarget,proto3" json:"explicit_gas_target,omitempty"`
+	// gas_wanted_ema is the exponential moving average of the block gas wanted
+	// used to calculate the base fee of the next block. It is zero if the
+	// gas_wanted_ema_window parameter is disabled.
+	GasWantedEma uint64 `protobuf:"varint,4,opt,name=gas_wanted_ema,json=gasWantedEma,proto3" json:"gas_wanted_ema,omitempty"`
 }
 
 func (m *QueryBlockGasResponse) Reset()         { *m = QueryBlockGasResponse{} }
@@ -274,455 +299,2684 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.feemarket.v1.QueryBaseFeeRequest")
-	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
-	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
-	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+func (m *QueryBlockGasResponse) GetGasTarget() uint64 {
+	if m != nil {
+		return m.GasTarget
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("ethermint/feemarket/v1/query.proto", fileDescriptor_71a07c1ffd85fde2)
+func (m *QueryBlockGasResponse) GetExplicitGasTarget() bool {
+	if m != nil {
+		return m.ExplicitGasTarget
+	}
+	return false
 }
 
-var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x11, 0x48, 0xcb, 0xb1, 0xa0, 0x23, 0xa9, 0xc0, 0x0a, 0x17, 0x64, 0x01, 0xe2, 0x47,
-	0xb9, 0x53, 0x0a, 0x03, 0x03, 0x93, 0x87, 0x22, 0x24, 0x06, 0x30, 0x1b, 0x4b, 0x75, 0x0e, 0xaf,
-	0x8e, 0x95, 0xda, 0xe7, 0xfa, 0x2e, 0x16, 0x5d, 0x91, 0x58, 0x18, 0x10, 0x12, 0x7f, 0x07, 0xff,
-	0x47, 0xc7, 0x4a, 0x2c, 0x88, 0xa1, 0x42, 0x09, 0x7f, 0x08, 0xf2, 0xdd, 0x39, 0x60, 0x48, 0x68,
-	0x16, 0xeb, 0xe9, 0xf9, 0x7b, 0xdf, 0xf7, 0xbd, 0xef, 0xe9, 0xb0, 0x0f, 0x7a, 0x0c, 0x45, 0x9a,
-	0x64, 0x9a, 0xef, 0x03, 0xa4, 0xa2, 0x98, 0x80, 0xe6, 0xe5, 0x90, 0x1f, 0x4e, 0xa1, 0x38, 0x62,
-	0x79, 0x21, 0xb5, 0x24, 0x5b, 0x0b, 0x0c, 0x5b, 0x60, 0x58, 0x39, 0xf4, 0x6e, 0xaf, 0x98, 0xfd,
-	0x0d, 0x32, 0xf3, 0x5e, 0x37, 0x96, 0xb1, 0x34, 0x25, 0xaf, 0x2a, 0xd7, 0xed, 0xc7, 0x52, 0xc6,
-	0x07, 0xc0, 0x45, 0x9e, 0x70, 0x91, 0x65, 0x52, 0x0b, 0x9d, 0xc8, 0x4c, 0xd9, 0xbf, 0x7e, 0x17,
-	0x93, 0x97, 0x95, 0x85, 0x17, 0xa2, 0x10, 0xa9, 0x0a, 0xe1, 0x70, 0x0a, 0x4a, 0xfb, 0xaf, 0xf0,
-	0x95, 0x46, 0x57, 0xe5, 0x32, 0x53, 0x40, 0x9e, 0xe0, 0x4e, 0x6e, 0x3a, 0x57, 0xd1, 0x0d, 0x74,
-	0xe7, 0xd2, 0x0e, 0x65, 0xcb, 0x1d, 0x33, 0x3b, 0x17, 0x9c, 0x3f, 0x3e, 0x1d, 0xb4, 0x42, 0x37,
-	0xe3, 0xf7, 0x1c, 0x69, 0x20, 0x14, 0xec, 0x02, 0xd4, 0x5a, 0xcf, 0x71, 0xb7, 0xd9, 0x76, 0x62,
-	0x8f, 0xf0, 0x66, 0x24, 0x14, 0xec, 0xed, 0x03, 0x18, 0xb9, 0x8b, 0xc1, 0xb5, 0xef, 0xa7, 0x83,
-	0xde, 0x48, 0xaa, 0x54, 0x2a, 0xf5, 0x66, 0xc2, 0x12, 0xc9, 0x53, 0xa1, 0xc7, 0xec, 0x59, 0xa6,
-	0xc3, 0x8d, 0xc8, 0x4e, 0xfb, 0x5b, 0x35, 0xdb, 0x81, 0x1c, 0x4d, 0x9e, 0x8a, 0xc5, 0x46, 0x77,
-	0x71, 0xef, 0xaf, 0xbe, 0x93, 0xb9, 0x8c, 0xdb, 0xb1, 0xb0, 0x0b, 0xb5, 0xc3, 0xaa, 0xdc, 0xf9,
-	0xd2, 0xc6, 0x17, 0x0c, 0x96, 0xbc, 0x47, 0xb8, 0x63, 0x57, 0x21, 0xf7, 0x56, 0xad, 0xfa, 0x6f,
-	0x7a, 0xde, 0xfd, 0xb5, 0xb0, 0x56, 0xdf, 0xf7, 0xdf, 0x7d, 0xfd, 0xf9, 0xf9, 0x5c, 0x9f, 0x78,
-	0x1c, 0xca, 0x54, 0xaa, 0xe6, 0x85, 0x6d, 0x72, 0xe4, 0x03, 0xc2, 0x1b, 0x2e, 0x1e, 0xf2, 0x7f,
-	0xf2, 0x66, 0xb6, 0xde, 0xf6, 0x7a, 0x60, 0x67, 0xe5, 0xa6, 0xb1, 0x42, 0x49, 0x7f, 0x99, 0x95,
-	0xfa, 0x16, 0xe4, 0x23, 0xc2, 0x9b, 0x75, 0x8a, 0xe4, 0x0c, 0x81, 0xe6, 0x11, 0xbc, 0x07, 0x6b,
-	0xa2, 0x9d, 0x9f, 0x5b, 0xc6, 0xcf, 0x80, 0x5c, 0x5f, 0xea, 0xa7, 0x42, 0xef, 0xc5, 0x42, 0x05,
-	0xbb, 0xc7, 0x33, 0x8a, 0x4e, 0x66, 0x14, 0xfd, 0x98, 0x51, 0xf4, 0x69, 0x4e, 0x5b, 0x27, 0x73,
-	0xda, 0xfa, 0x36, 0xa7, 0xad, 0xd7, 0xdb, 0x71, 0xa2, 0xc7, 0xd3, 0x88, 0x8d, 0x64, 0xea, 0x28,
-	0xec, 0xb7, 0x1c, 0x3e, 0xe6, 0x6f, 0xff, 0xa0, 0xd3, 0x47, 0x39, 0xa8, 0xa8, 0x63, 0x5e, 0xc4,
-	0xc3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x58, 0x21, 0xec, 0xab, 0x03, 0x00, 0x00,
+func (m *QueryBlockGasResponse) GetGasWantedEma() uint64 {
+	if m != nil {
+		return m.GasWantedEma
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of x/feemarket module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// BaseFee queries the base fee of the parent block of the current block.
-	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
-	// BlockGas queries the gas used at a given block height
-	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+// QueryBlockBaseFeeRequest defines the request type for querying the EIP1559
+// base fee of a given block height.
+type QueryBlockBaseFeeRequest struct {
+	// height is the block height of the base fee
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryBlockBaseFeeRequest) Reset()         { *m = QueryBlockBaseFeeRequest{} }
+func (m *QueryBlockBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBlockBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBlockBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBlockBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
-	out := new(QueryBaseFeeResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BaseFee", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBlockBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBlockBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockBaseFeeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error) {
-	out := new(QueryBlockGasResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BlockGas", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlockBaseFeeRequest proto.InternalMessageInfo
+
+func (m *QueryBlockBaseFeeRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/feemarket module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// BaseFee queries the base fee of the parent block of the current block.
-	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
-	// BlockGas queries the gas used at a given block height
-	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+// QueryBlockBaseFeeResponse returns the EIP1559 base fee of a block height.
+type QueryBlockBaseFeeResponse struct {
+	// base_fee is the EIP1559 base fee applied at the block height
+	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryBlockBaseFeeResponse) Reset()         { *m = QueryBlockBaseFeeResponse{} }
+func (m *QueryBlockBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBlockBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryBlockBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+func (m *QueryBlockBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
+func (m *QueryBlockBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBlockBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockBaseFeeResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryBlockBaseFeeResponse proto.InternalMessageInfo
+
+// QueryFeeConversionRatesRequest defines the request type for querying the
+// fee conversion rates.
+type QueryFeeConversionRatesRequest struct {
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.feemarket.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryFeeConversionRatesRequest) Reset()         { *m = QueryFeeConversionRatesRequest{} }
+func (m *QueryFeeConversionRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeConversionRatesRequest) ProtoMessage()    {}
+func (*QueryFeeConversionRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{8}
+}
+func (m *QueryFeeConversionRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeConversionRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeConversionRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFeeConversionRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeConversionRatesRequest.Merge(m, src)
+}
+func (m *QueryFeeConversionRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeConversionRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeConversionRatesRequest.DiscardUnknown(m)
 }
 
-func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBaseFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BaseFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.feemarket.v1.Query/BaseFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryFeeConversionRatesRequest proto.InternalMessageInfo
+
+// QueryFeeConversionRatesResponse returns the fee conversion rates.
+type QueryFeeConversionRatesResponse struct {
+	// fee_conversions are the alternate fee denominations and their conversion rates
+	FeeConversions []FeeConversion `protobuf:"bytes,1,rep,name=fee_conversions,json=feeConversions,proto3" json:"fee_conversions"`
 }
 
-func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockGasRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlockGas(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.feemarket.v1.Query/BlockGas",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlockGas(ctx, req.(*QueryBlockGasRequest))
+func (m *QueryFeeConversionRatesResponse) Reset()         { *m = QueryFeeConversionRatesResponse{} }
+func (m *QueryFeeConversionRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeConversionRatesResponse) ProtoMessage()    {}
+func (*QueryFeeConversionRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{9}
+}
+func (m *QueryFeeConversionRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeConversionRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeConversionRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethermint.feemarket.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "BaseFee",
-			Handler:    _Query_BaseFee_Handler,
-		},
-		{
-			MethodName: "BlockGas",
-			Handler:    _Query_BlockGas_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ethermint/feemarket/v1/query.proto",
+func (m *QueryFeeConversionRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeConversionRatesResponse.Merge(m, src)
+}
+func (m *QueryFeeConversionRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeConversionRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeConversionRatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeConversionRatesResponse proto.InternalMessageInfo
+
+func (m *QueryFeeConversionRatesResponse) GetFeeConversions() []FeeConversion {
+	if m != nil {
+		return m.FeeConversions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeConversionPoolRequest defines the request type for querying the
+// balance of the fee conversion pool.
+type QueryFeeConversionPoolRequest struct {
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFeeConversionPoolRequest) Reset()         { *m = QueryFeeConversionPoolRequest{} }
+func (m *QueryFeeConversionPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeConversionPoolRequest) ProtoMessage()    {}
+func (*QueryFeeConversionPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{10}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeConversionPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeConversionPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeConversionPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFeeConversionPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeConversionPoolRequest.Merge(m, src)
+}
+func (m *QueryFeeConversionPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeConversionPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeConversionPoolRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFeeConversionPoolRequest proto.InternalMessageInfo
+
+// QueryFeeConversionPoolResponse returns the balance of the fee conversion pool.
+type QueryFeeConversionPoolResponse struct {
+	// address is the address of the fee conversion pool
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance is the balance of the fee conversion pool
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFeeConversionPoolResponse) Reset()         { *m = QueryFeeConversionPoolResponse{} }
+func (m *QueryFeeConversionPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeConversionPoolResponse) ProtoMessage()    {}
+func (*QueryFeeConversionPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{11}
+}
+func (m *QueryFeeConversionPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeConversionPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeConversionPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeConversionPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeConversionPoolResponse.Merge(m, src)
+}
+func (m *QueryFeeConversionPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeConversionPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeConversionPoolResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeConversionPoolResponse proto.InternalMessageInfo
+
+func (m *QueryFeeConversionPoolResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeeConversionPoolResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryFeeHistoryRequest defines the request type for querying the fee
+// history of a range of recent blocks.
+type QueryFeeHistoryRequest struct {
+	// last_height is the height of the last block of the range. The latest block
+	// is used if zero.
+	LastHeight int64 `protobuf:"varint,1,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// block_count is the number of blocks of the range. It is capped to the fee
+	// history retention.
+	BlockCount uint64 `protobuf:"varint,2,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// reward_percentiles are the percentiles of the priority fees per gas,
+	// weighted by gas used, returned for each block, in ascending order
+	RewardPercentiles []float64 `protobuf:"fixed64,3,rep,packed,name=reward_percentiles,json=rewardPercentiles,proto3" json:"reward_percentiles,omitempty"`
 }
 
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{12}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
 }
 
-func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BaseFee != nil {
-		{
-			size := m.BaseFee.Size()
-			i -= size
-			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBlockGasRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeHistoryRequest) GetRewardPercentiles() []float64 {
+	if m != nil {
+		return m.RewardPercentiles
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBlockGasRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeHistoryResponse returns the fee history of a range of blocks.
+type QueryFeeHistoryResponse struct {
+	// blocks are the fee histories of the blocks of the range, in ascending
+	// height order. The blocks whose fee history isn't retained are omitted.
+	Blocks []FeeHistoryEntry `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks"`
 }
 
-func (m *QueryBlockGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{13}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlockGasResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetBlocks() []FeeHistoryEntry {
+	if m != nil {
+		return m.Blocks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBlockGasResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FeeHistoryEntry defines the fee history of a block, in the eth_feeHistory
+// format.
+type FeeHistoryEntry struct {
+	// height is the height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the base fee applied to the block
+	BaseFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee"`
+	// gas_used_ratio is the ratio of the gas used by the block to its gas limit
+	GasUsedRatio float64 `protobuf:"fixed64,3,opt,name=gas_used_ratio,json=gasUsedRatio,proto3" json:"gas_used_ratio,omitempty"`
+	// rewards are the priority fees per gas at the requested percentiles
+	Rewards []cosmossdk_io_math.Int `protobuf:"bytes,4,rep,name=rewards,proto3,customtype=cosmossdk.io/math.Int" json:"rewards"`
 }
 
-func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Gas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
-		i--
-		dAtA[i] = 0x8
+func (m *FeeHistoryEntry) Reset()         { *m = FeeHistoryEntry{} }
+func (m *FeeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryEntry) ProtoMessage()    {}
+func (*FeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{14}
+}
+func (m *FeeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FeeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryEntry.Merge(m, src)
+}
+func (m *FeeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryEntry.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_FeeHistoryEntry proto.InternalMessageInfo
+
+func (m *FeeHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *FeeHistoryEntry) GetGasUsedRatio() float64 {
+	if m != nil {
+		return m.GasUsedRatio
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QuerySubscribeFeeStateRequest defines the request type for streaming the fee
+// state of the new blocks.
+type QuerySubscribeFeeStateRequest struct {
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySubscribeFeeStateRequest) Reset()         { *m = QuerySubscribeFeeStateRequest{} }
+func (m *QuerySubscribeFeeStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscribeFeeStateRequest) ProtoMessage()    {}
+func (*QuerySubscribeFeeStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{15}
+}
+func (m *QuerySubscribeFeeStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscribeFeeStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscribeFeeStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QuerySubscribeFeeStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscribeFeeStateRequest.Merge(m, src)
+}
+func (m *QuerySubscribeFeeStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscribeFeeStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscribeFeeStateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySubscribeFeeStateRequest proto.InternalMessageInfo
+
+// QuerySubscribeFeeStateResponse returns the fee state of a new block.
+type QuerySubscribeFeeStateResponse struct {
+	// fee_state is the fee state of the block
+	FeeState EventFeeState `protobuf:"bytes,1,opt,name=fee_state,json=feeState,proto3" json:"fee_state"`
+	// dropped is the number of fee states dropped so far by the stream, because
+	// the client didn't keep up with the new blocks
+	Dropped uint64 `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (m *QuerySubscribeFeeStateResponse) Reset()         { *m = QuerySubscribeFeeStateResponse{} }
+func (m *QuerySubscribeFeeStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscribeFeeStateResponse) ProtoMessage()    {}
+func (*QuerySubscribeFeeStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{16}
+}
+func (m *QuerySubscribeFeeStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscribeFeeStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscribeFeeStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySubscribeFeeStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscribeFeeStateResponse.Merge(m, src)
+}
+func (m *QuerySubscribeFeeStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscribeFeeStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscribeFeeStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubscribeFeeStateResponse proto.InternalMessageInfo
+
+func (m *QuerySubscribeFeeStateResponse) GetFeeState() EventFeeState {
+	if m != nil {
+		return m.FeeState
 	}
-	return n
+	return EventFeeState{}
 }
 
-func (m *QueryBlockGasRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySubscribeFeeStateResponse) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
 	}
-	var l int
-	_ = l
-	return n
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.feemarket.v1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
+	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBlockBaseFeeRequest)(nil), "ethermint.feemarket.v1.QueryBlockBaseFeeRequest")
+	proto.RegisterType((*QueryBlockBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBlockBaseFeeResponse")
+	proto.RegisterType((*QueryFeeConversionRatesRequest)(nil), "ethermint.feemarket.v1.QueryFeeConversionRatesRequest")
+	proto.RegisterType((*QueryFeeConversionRatesResponse)(nil), "ethermint.feemarket.v1.QueryFeeConversionRatesResponse")
+	proto.RegisterType((*QueryFeeConversionPoolRequest)(nil), "ethermint.feemarket.v1.QueryFeeConversionPoolRequest")
+	proto.RegisterType((*QueryFeeConversionPoolResponse)(nil), "ethermint.feemarket.v1.QueryFeeConversionPoolResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "ethermint.feemarket.v1.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "ethermint.feemarket.v1.QueryFeeHistoryResponse")
+	proto.RegisterType((*FeeHistoryEntry)(nil), "ethermint.feemarket.v1.FeeHistoryEntry")
+	proto.RegisterType((*QuerySubscribeFeeStateRequest)(nil), "ethermint.feemarket.v1.QuerySubscribeFeeStateRequest")
+	proto.RegisterType((*QuerySubscribeFeeStateResponse)(nil), "ethermint.feemarket.v1.QuerySubscribeFeeStateResponse")
+}
+
+func init() {
+	proto.RegisterFile("ethermint/feemarket/v1/query.proto", fileDescriptor_71a07c1ffd85fde2)
+}
+
+var fileDescriptor_71a07c1ffd85fde2 = []byte{
+	// 1093 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc4, 0x69, 0x7e, 0xbc, 0x56, 0xed, 0x37, 0xd3, 0x34, 0x5f, 0xd7, 0x4a, 0x6c, 0xb3,
+	0xb4, 0xc4, 0x34, 0xcd, 0x6e, 0x9c, 0x42, 0x0a, 0x12, 0xa7, 0x44, 0x49, 0x83, 0xc4, 0x21, 0xdd,
+	0x14, 0x21, 0x71, 0x31, 0x63, 0xef, 0xcb, 0x7a, 0x15, 0x7b, 0xc7, 0xdd, 0x19, 0x3b, 0x8d, 0x10,
+	0x17, 0x54, 0x0e, 0x70, 0x40, 0x48, 0x48, 0x1c, 0x91, 0xb8, 0x21, 0xae, 0x1c, 0xb8, 0x73, 0xea,
+	0xb1, 0x12, 0x17, 0xc4, 0xa1, 0xa0, 0x84, 0x03, 0x7f, 0x06, 0x9a, 0xd9, 0x59, 0xc7, 0x8e, 0xbd,
+	0x89, 0x23, 0x2e, 0xc9, 0xce, 0x9b, 0xcf, 0x7b, 0xef, 0xf3, 0xde, 0xbc, 0x1f, 0x06, 0x0b, 0x65,
+	0x1d, 0xa3, 0x66, 0x10, 0x4a, 0x67, 0x1f, 0xb1, 0xc9, 0xa2, 0x03, 0x94, 0x4e, 0xa7, 0xec, 0x3c,
+	0x6d, 0x63, 0x74, 0x64, 0xb7, 0x22, 0x2e, 0x39, 0x9d, 0xef, 0x62, 0xec, 0x2e, 0xc6, 0xee, 0x94,
+	0x73, 0xf9, 0x1a, 0x17, 0x4d, 0x2e, 0x9c, 0x2a, 0x13, 0xe8, 0x74, 0xca, 0x55, 0x94, 0xac, 0xec,
+	0xd4, 0x78, 0x10, 0xc6, 0x7a, 0xb9, 0xd7, 0x53, 0x6c, 0x63, 0x07, 0x43, 0x29, 0x0c, 0xe8, 0x8d,
+	0x14, 0xd0, 0xa9, 0xa7, 0x18, 0x37, 0xe7, 0x73, 0x9f, 0xeb, 0x4f, 0x47, 0x7d, 0x19, 0xe9, 0x82,
+	0xcf, 0xb9, 0xdf, 0x40, 0x87, 0xb5, 0x02, 0x87, 0x85, 0x21, 0x97, 0x4c, 0x06, 0x3c, 0x34, 0xb6,
+	0xad, 0x39, 0xa0, 0x8f, 0x55, 0x1c, 0xbb, 0x2c, 0x62, 0x4d, 0xe1, 0xe2, 0xd3, 0x36, 0x0a, 0x69,
+	0xed, 0xc1, 0xcd, 0x3e, 0xa9, 0x68, 0xf1, 0x50, 0x20, 0x7d, 0x0f, 0x26, 0x5b, 0x5a, 0x92, 0x25,
+	0x45, 0x52, 0xba, 0xba, 0x96, 0xb7, 0x87, 0x87, 0x6d, 0xc7, 0x7a, 0x1b, 0x13, 0x2f, 0x5e, 0x15,
+	0xc6, 0x5c, 0xa3, 0x63, 0x2d, 0x1b, 0xa3, 0x1b, 0x4c, 0xe0, 0x36, 0xa2, 0xf1, 0x45, 0xe7, 0xe0,
+	0x8a, 0x87, 0x21, 0x6f, 0x6a, 0x9b, 0x33, 0x6e, 0x7c, 0xb0, 0x3e, 0x80, 0xb9, 0x7e, 0xb0, 0xa1,
+	0xf0, 0x16, 0x4c, 0xab, 0x5c, 0x56, 0xf6, 0x11, 0x63, 0x85, 0x8d, 0xdb, 0x7f, 0xbc, 0x2a, 0xdc,
+	0x8a, 0xd3, 0x2c, 0xbc, 0x03, 0x3b, 0xe0, 0x4e, 0x93, 0xc9, 0xba, 0xfd, 0x7e, 0x28, 0xdd, 0xa9,
+	0x6a, 0xac, 0x6d, 0xcd, 0x27, 0xd6, 0x1a, 0xbc, 0x76, 0xf0, 0x88, 0x75, 0xe3, 0xfc, 0x9e, 0xc0,
+	0xad, 0x33, 0x17, 0xc6, 0xcf, 0xff, 0x20, 0xe3, 0xb3, 0x38, 0xce, 0x8c, 0xab, 0x3e, 0xe9, 0x22,
+	0x80, 0xcf, 0x44, 0x45, 0xb2, 0xc8, 0x47, 0x99, 0x1d, 0x2f, 0x92, 0xd2, 0x84, 0x3b, 0xe3, 0x33,
+	0xf1, 0x44, 0x0b, 0xa8, 0x0d, 0x37, 0xf1, 0x59, 0xab, 0x11, 0xd4, 0x02, 0x59, 0xe9, 0xc1, 0x65,
+	0x8a, 0xa4, 0x34, 0xed, 0xce, 0x26, 0x57, 0x8f, 0xba, 0xf8, 0x3b, 0x70, 0x5d, 0xc1, 0x0e, 0x59,
+	0x28, 0xd1, 0xab, 0x60, 0x93, 0x65, 0x27, 0xb4, 0xc9, 0x6b, 0x3e, 0x13, 0x1f, 0x69, 0xe1, 0x56,
+	0x93, 0x59, 0x6b, 0x90, 0x3d, 0xe5, 0x77, 0x26, 0x71, 0xf3, 0x30, 0x59, 0xc7, 0xc0, 0xaf, 0x4b,
+	0xc3, 0xd2, 0x9c, 0xac, 0xc7, 0x70, 0x7b, 0x88, 0xce, 0x7f, 0xca, 0x5f, 0x11, 0xf2, 0xda, 0xe4,
+	0x36, 0xe2, 0x26, 0x0f, 0x3b, 0x18, 0x89, 0x80, 0x87, 0x2e, 0x93, 0xd8, 0xcd, 0xe4, 0x21, 0x14,
+	0x52, 0x11, 0xc6, 0xf5, 0x13, 0xb8, 0xb1, 0x8f, 0x58, 0xa9, 0x75, 0xaf, 0x55, 0x7a, 0x33, 0xa5,
+	0xab, 0x6b, 0x77, 0xd3, 0xca, 0xa8, 0xcf, 0x98, 0xa9, 0xa6, 0xeb, 0xfb, 0xbd, 0x42, 0x61, 0x15,
+	0x60, 0x71, 0xd0, 0xf1, 0x2e, 0xe7, 0x8d, 0x84, 0xd9, 0x0f, 0x04, 0xf2, 0x69, 0x08, 0xc3, 0x2c,
+	0x0b, 0x53, 0xcc, 0xf3, 0x22, 0x14, 0xc2, 0x14, 0x61, 0x72, 0xa4, 0x08, 0x53, 0x55, 0xd6, 0x60,
+	0x61, 0x0d, 0xb3, 0xe3, 0x9a, 0xeb, 0x6d, 0x3b, 0x4e, 0x95, 0xad, 0x52, 0x63, 0x9b, 0x8e, 0xb6,
+	0x37, 0x79, 0x10, 0x6e, 0xac, 0x2a, 0x7e, 0x3f, 0xfd, 0x59, 0x28, 0xf9, 0x81, 0xac, 0xb7, 0xab,
+	0x76, 0x8d, 0x37, 0x1d, 0xd3, 0xfe, 0xf1, 0xbf, 0x15, 0xe1, 0x1d, 0x38, 0xf2, 0xa8, 0x85, 0x42,
+	0x2b, 0x08, 0x37, 0xb1, 0x6d, 0x7d, 0x49, 0x60, 0x3e, 0xe1, 0xb8, 0x13, 0x08, 0xc9, 0xa3, 0xa3,
+	0xe4, 0x95, 0x0b, 0x70, 0xb5, 0xc1, 0x84, 0xac, 0xf4, 0x3d, 0x35, 0x28, 0xd1, 0x8e, 0x96, 0x28,
+	0x40, 0x55, 0xbd, 0x74, 0xa5, 0xc6, 0xdb, 0x61, 0x52, 0x98, 0xa0, 0x45, 0x9b, 0x4a, 0x42, 0x57,
+	0x80, 0x46, 0x78, 0xc8, 0x22, 0xaf, 0xd2, 0xc2, 0xa8, 0x86, 0xa1, 0x0c, 0x1a, 0x28, 0xb2, 0x99,
+	0x62, 0xa6, 0x44, 0xdc, 0xd9, 0xf8, 0x66, 0xf7, 0xf4, 0xc2, 0xfa, 0x04, 0xfe, 0x3f, 0x40, 0xc5,
+	0xe4, 0x69, 0x0b, 0x26, 0xb5, 0xdd, 0xe4, 0xe1, 0x96, 0xce, 0x79, 0x38, 0xa3, 0xbb, 0x15, 0xca,
+	0xe8, 0x28, 0x19, 0x04, 0xb1, 0xb2, 0xf5, 0x2b, 0x81, 0x1b, 0x67, 0x10, 0x69, 0xc5, 0x4c, 0xdf,
+	0xe9, 0xa9, 0xd7, 0x71, 0x5d, 0xaf, 0x8b, 0xca, 0xd6, 0xc5, 0x35, 0x9b, 0x34, 0x58, 0x5b, 0xa0,
+	0x57, 0x89, 0xd4, 0xcc, 0xd3, 0xbd, 0x48, 0x74, 0x83, 0x7d, 0x28, 0xd0, 0x73, 0x95, 0x8c, 0x3e,
+	0x84, 0xa9, 0x38, 0x05, 0x22, 0x3b, 0x51, 0xcc, 0x8c, 0x60, 0xde, 0xa0, 0xbb, 0x75, 0xb7, 0xd7,
+	0xae, 0x8a, 0x5a, 0x14, 0x54, 0x95, 0xcf, 0x3d, 0xc9, 0x64, 0xd2, 0x9e, 0xd6, 0xf3, 0xa4, 0xee,
+	0x86, 0x20, 0x4c, 0x3e, 0x77, 0x60, 0x46, 0x75, 0x84, 0x50, 0x42, 0x33, 0x52, 0x53, 0x7b, 0x61,
+	0x4b, 0x6d, 0x84, 0xc4, 0x82, 0x49, 0xe8, 0xf4, 0xbe, 0x39, 0xab, 0x0a, 0xf6, 0x22, 0xde, 0x6a,
+	0xa1, 0x67, 0x0a, 0x20, 0x39, 0xae, 0xfd, 0x33, 0x03, 0x57, 0x34, 0x0d, 0xfa, 0x05, 0x81, 0xc9,
+	0x78, 0x30, 0xd3, 0x7b, 0x69, 0x5e, 0x06, 0x77, 0x41, 0x6e, 0x79, 0x24, 0x6c, 0x1c, 0x91, 0x65,
+	0x7d, 0xfe, 0xdb, 0xdf, 0xdf, 0x8e, 0x2f, 0xd0, 0x9c, 0x83, 0x1d, 0x55, 0xf8, 0x7d, 0xfb, 0x2a,
+	0xde, 0x03, 0xf4, 0x2b, 0x02, 0x53, 0x66, 0x2c, 0xd1, 0xf3, 0x8d, 0xf7, 0x0f, 0xbc, 0xdc, 0xfd,
+	0xd1, 0xc0, 0x86, 0xca, 0x1d, 0x4d, 0x25, 0x4f, 0x17, 0x86, 0x51, 0x49, 0x6a, 0x8a, 0x7e, 0x4d,
+	0x60, 0x3a, 0x19, 0xfe, 0xf4, 0x02, 0x07, 0xfd, 0xcb, 0x23, 0xb7, 0x32, 0x22, 0xda, 0xf0, 0xb9,
+	0xab, 0xf9, 0x14, 0xe8, 0xe2, 0x50, 0x3e, 0xba, 0x83, 0xd5, 0x9a, 0xf9, 0x91, 0xc0, 0xb5, 0xde,
+	0xc9, 0x4d, 0x57, 0x2f, 0x76, 0x73, 0x26, 0x4f, 0xe5, 0x4b, 0x68, 0x18, 0x72, 0x0f, 0x34, 0xb9,
+	0x15, 0xba, 0x9c, 0x4e, 0x2e, 0x49, 0x99, 0xf3, 0x69, 0xdc, 0x9a, 0x9f, 0xd1, 0x5f, 0x08, 0xd0,
+	0xc1, 0x79, 0x4f, 0xd7, 0xcf, 0x75, 0x9f, 0xba, 0x42, 0x72, 0x0f, 0x2f, 0xad, 0x67, 0xc8, 0xaf,
+	0x6a, 0xf2, 0xf7, 0x68, 0x69, 0x18, 0xf9, 0xfe, 0x95, 0xa3, 0x26, 0x01, 0x0a, 0xfa, 0x33, 0x81,
+	0xd9, 0x81, 0x75, 0x40, 0xdf, 0x1e, 0x9d, 0x40, 0xcf, 0x82, 0xc9, 0xad, 0x5f, 0x56, 0xcd, 0xd0,
+	0x76, 0x34, 0xed, 0x37, 0xe9, 0xd2, 0x08, 0xb4, 0x5b, 0x8a, 0xdf, 0x77, 0x04, 0xe0, 0x74, 0x6e,
+	0x52, 0xfb, 0x22, 0xbf, 0xfd, 0x9b, 0x24, 0xe7, 0x8c, 0x8c, 0x37, 0x04, 0x97, 0x34, 0xc1, 0xd7,
+	0x68, 0x21, 0x8d, 0x60, 0xdd, 0x30, 0x79, 0x4e, 0x60, 0x76, 0x60, 0xca, 0x5d, 0x90, 0xce, 0xb4,
+	0xb9, 0x99, 0x5b, 0xbf, 0xac, 0x5a, 0xcc, 0x76, 0x95, 0x6c, 0x6c, 0xbf, 0x38, 0xce, 0x93, 0x97,
+	0xc7, 0x79, 0xf2, 0xd7, 0x71, 0x9e, 0x7c, 0x73, 0x92, 0x1f, 0x7b, 0x79, 0x92, 0x1f, 0xfb, 0xfd,
+	0x24, 0x3f, 0xf6, 0xf1, 0xfd, 0x9e, 0x95, 0x1c, 0xc7, 0x12, 0xff, 0xed, 0x94, 0xdf, 0x75, 0x9e,
+	0xf5, 0xc4, 0xa5, 0x97, 0x73, 0x75, 0x52, 0xff, 0x34, 0x7e, 0xf0, 0xef, 0x00, 0x30, 0xad, 0xf7,
+	0xa1, 0xf9, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/feemarket module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BaseFee queries the base fee of the parent block of the current block.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// BlockGas queries the gas used at a given block height
+	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BlockBaseFee queries the base fee that was applied at a given block height
+	BlockBaseFee(ctx context.Context, in *QueryBlockBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlockBaseFeeResponse, error)
+	// FeeConversionRates queries the alternate fee denominations and their
+	// conversion rates to the EVM denomination
+	FeeConversionRates(ctx context.Context, in *QueryFeeConversionRatesRequest, opts ...grpc.CallOption) (*QueryFeeConversionRatesResponse, error)
+	// FeeConversionPool queries the balance of the fee conversion pool
+	FeeConversionPool(ctx context.Context, in *QueryFeeConversionPoolRequest, opts ...grpc.CallOption) (*QueryFeeConversionPoolResponse, error)
+	// FeeHistory queries the fee history of a range of recent blocks
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
+	// SubscribeFeeState streams the fee state of each new block. It is only
+	// served over gRPC, by the nodes running CometBFT in process.
+	SubscribeFeeState(ctx context.Context, in *QuerySubscribeFeeStateRequest, opts ...grpc.CallOption) (Query_SubscribeFeeStateClient, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error) {
+	out := new(QueryBlockGasResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BlockGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockBaseFee(ctx context.Context, in *QueryBlockBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlockBaseFeeResponse, error) {
+	out := new(QueryBlockBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BlockBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeConversionRates(ctx context.Context, in *QueryFeeConversionRatesRequest, opts ...grpc.CallOption) (*QueryFeeConversionRatesResponse, error) {
+	out := new(QueryFeeConversionRatesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeConversionRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeConversionPool(ctx context.Context, in *QueryFeeConversionPoolRequest, opts ...grpc.CallOption) (*QueryFeeConversionPoolResponse, error) {
+	out := new(QueryFeeConversionPoolResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeConversionPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubscribeFeeState(ctx context.Context, in *QuerySubscribeFeeStateRequest, opts ...grpc.CallOption) (Query_SubscribeFeeStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/ethermint.feemarket.v1.Query/SubscribeFeeState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &querySubscribeFeeStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_SubscribeFeeStateClient interface {
+	Recv() (*QuerySubscribeFeeStateResponse, error)
+	grpc.ClientStream
+}
+
+type querySubscribeFeeStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *querySubscribeFeeStateClient) Recv() (*QuerySubscribeFeeStateResponse, error) {
+	m := new(QuerySubscribeFeeStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/feemarket module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BaseFee queries the base fee of the parent block of the current block.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// BlockGas queries the gas used at a given block height
+	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BlockBaseFee queries the base fee that was applied at a given block height
+	BlockBaseFee(context.Context, *QueryBlockBaseFeeRequest) (*QueryBlockBaseFeeResponse, error)
+	// FeeConversionRates queries the alternate fee denominations and their
+	// conversion rates to the EVM denomination
+	FeeConversionRates(context.Context, *QueryFeeConversionRatesRequest) (*QueryFeeConversionRatesResponse, error)
+	// FeeConversionPool queries the balance of the fee conversion pool
+	FeeConversionPool(context.Context, *QueryFeeConversionPoolRequest) (*QueryFeeConversionPoolResponse, error)
+	// FeeHistory queries the fee history of a range of recent blocks
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
+	// SubscribeFeeState streams the fee state of each new block. It is only
+	// served over gRPC, by the nodes running CometBFT in process.
+	SubscribeFeeState(*QuerySubscribeFeeStateRequest, Query_SubscribeFeeStateServer) error
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
+func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
+}
+func (*UnimplementedQueryServer) BlockBaseFee(ctx context.Context, req *QueryBlockBaseFeeRequest) (*QueryBlockBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockBaseFee not implemented")
+}
+func (*UnimplementedQueryServer) FeeConversionRates(ctx context.Context, req *QueryFeeConversionRatesRequest) (*QueryFeeConversionRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeConversionRates not implemented")
+}
+func (*UnimplementedQueryServer) FeeConversionPool(ctx context.Context, req *QueryFeeConversionPoolRequest) (*QueryFeeConversionPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeConversionPool not implemented")
+}
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) SubscribeFeeState(req *QuerySubscribeFeeStateRequest, srv Query_SubscribeFeeStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeFeeState not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BlockGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockGas(ctx, req.(*QueryBlockGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BlockBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockBaseFee(ctx, req.(*QueryBlockBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeConversionRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeConversionRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeConversionRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeConversionRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeConversionRates(ctx, req.(*QueryFeeConversionRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeConversionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeConversionPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeConversionPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeConversionPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeConversionPool(ctx, req.(*QueryFeeConversionPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubscribeFeeState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QuerySubscribeFeeStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).SubscribeFeeState(m, &querySubscribeFeeStateServer{stream})
+}
+
+type Query_SubscribeFeeStateServer interface {
+	Send(*QuerySubscribeFeeStateResponse) error
+	grpc.ServerStream
+}
+
+type querySubscribeFeeStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *querySubscribeFeeStateServer) Send(m *QuerySubscribeFeeStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethermint.feemarket.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
+		{
+			MethodName: "BlockGas",
+			Handler:    _Query_BlockGas_Handler,
+		},
+		{
+			MethodName: "BlockBaseFee",
+			Handler:    _Query_BlockBaseFee_Handler,
+		},
+		{
+			MethodName: "FeeConversionRates",
+			Handler:    _Query_FeeConversionRates_Handler,
+		},
+		{
+			MethodName: "FeeConversionPool",
+			Handler:    _Query_FeeConversionPool_Handler,
+		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeFeeState",
+			Handler:       _Query_SubscribeFeeState_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ethermint/feemarket/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasWantedEma != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasWantedEma))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExplicitGasTarget {
+		i--
+		if m.ExplicitGasTarget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasTarget != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasTarget))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeConversionRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeConversionRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeConversionRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeConversionRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeConversionRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeConversionRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeConversions) > 0 {
+		for iNdEx := len(m.FeeConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeConversionPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeConversionPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeConversionPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeConversionPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeConversionPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeConversionPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPercentiles) > 0 {
+		for iNdEx := len(m.RewardPercentiles) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float64bits(float64(m.RewardPercentiles[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardPercentiles)*8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Rewards[iNdEx].Size()
+				i -= size
+				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GasUsedRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasUsedRatio))))
+		i--
+		dAtA[i] = 0x19
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscribeFeeStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscribeFeeStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscribeFeeStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscribeFeeStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscribeFeeStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscribeFeeStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dropped != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Dropped))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.FeeState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryBlockGasResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.Gas != 0 {
-		n += 1 + sovQuery(uint64(m.Gas))
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	if m.GasTarget != 0 {
+		n += 1 + sovQuery(uint64(m.GasTarget))
+	}
+	if m.ExplicitGasTarget {
+		n += 2
+	}
+	if m.GasWantedEma != 0 {
+		n += 1 + sovQuery(uint64(m.GasWantedEma))
+	}
+	return n
+}
+
+func (m *QueryBlockBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeConversionRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeConversionRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeConversions) > 0 {
+		for _, e := range m.FeeConversions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeConversionPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeConversionPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastHeight))
+	}
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	if len(m.RewardPercentiles) > 0 {
+		n += 1 + sovQuery(uint64(len(m.RewardPercentiles)*8)) + len(m.RewardPercentiles)*8
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.GasUsedRatio != 0 {
+		n += 9
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySubscribeFeeStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySubscribeFeeStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Dropped != 0 {
+		n += 1 + sovQuery(uint64(m.Dropped))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTarget", wireType)
+			}
+			m.GasTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasTarget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitGasTarget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExplicitGasTarget = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWantedEma", wireType)
+			}
+			m.GasWantedEma = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWantedEma |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeConversionRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeConversionRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeConversionRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeConversionRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeConversionRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeConversionRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeConversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeConversions = append(m.FeeConversions, FeeConversion{})
+			if err := m.FeeConversions[len(m.FeeConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeConversionPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeConversionPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeConversionPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeConversionPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -745,12 +2999,78 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeConversionPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeConversionPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -772,7 +3092,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -795,17 +3115,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
 			}
-			var msglen int
+			m.LastHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -815,25 +3135,84 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.RewardPercentiles = append(m.RewardPercentiles, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RewardPercentiles) == 0 {
+					m.RewardPercentiles = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.RewardPercentiles = append(m.RewardPercentiles, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPercentiles", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -855,7 +3234,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -878,12 +3257,46 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, FeeHistoryEntry{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -905,7 +3318,7 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *FeeHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -928,13 +3341,32 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
@@ -964,12 +3396,57 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFee = &v
 			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsedRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GasUsedRatio = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Rewards = append(m.Rewards, v)
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -991,7 +3468,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubscribeFeeStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1014,10 +3491,10 @@ func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockGasRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubscribeFeeStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubscribeFeeStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1041,7 +3518,7 @@ func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubscribeFeeStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1064,17 +3541,50 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubscribeFeeStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubscribeFeeStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dropped", wireType)
 			}
-			m.Gas = 0
+			m.Dropped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1084,7 +3594,7 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= int64(b&0x7F) << shift
+				m.Dropped |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
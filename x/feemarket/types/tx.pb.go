@@ -5,8 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -124,36 +127,343 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateFeeConversionRate defines a Msg for updating the conversion rate of
+// an alternate fee denomination.
+type MsgUpdateFeeConversionRate struct {
+	// sender is the address of the governance account or the fee conversion oracle.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// denom is the alternate fee denomination
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of the EVM denomination paid per unit of denom
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *MsgUpdateFeeConversionRate) Reset()         { *m = MsgUpdateFeeConversionRate{} }
+func (m *MsgUpdateFeeConversionRate) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeConversionRate) ProtoMessage()    {}
+func (*MsgUpdateFeeConversionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{2}
+}
+func (m *MsgUpdateFeeConversionRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeConversionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeConversionRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeConversionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeConversionRate.Merge(m, src)
+}
+func (m *MsgUpdateFeeConversionRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeConversionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeConversionRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeConversionRate proto.InternalMessageInfo
+
+func (m *MsgUpdateFeeConversionRate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdateFeeConversionRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgUpdateFeeConversionRateResponse defines the response structure for
+// executing a MsgUpdateFeeConversionRate message.
+type MsgUpdateFeeConversionRateResponse struct {
+}
+
+func (m *MsgUpdateFeeConversionRateResponse) Reset()         { *m = MsgUpdateFeeConversionRateResponse{} }
+func (m *MsgUpdateFeeConversionRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeConversionRateResponse) ProtoMessage()    {}
+func (*MsgUpdateFeeConversionRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{3}
+}
+func (m *MsgUpdateFeeConversionRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeConversionRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeConversionRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeConversionRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeConversionRateResponse.Merge(m, src)
+}
+func (m *MsgUpdateFeeConversionRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeConversionRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeConversionRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeConversionRateResponse proto.InternalMessageInfo
+
+// MsgFundFeeConversionPool defines a Msg for funding the fee conversion pool
+// from the community pool.
+type MsgFundFeeConversionPool struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// amount is the amount transferred from the community pool
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundFeeConversionPool) Reset()         { *m = MsgFundFeeConversionPool{} }
+func (m *MsgFundFeeConversionPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundFeeConversionPool) ProtoMessage()    {}
+func (*MsgFundFeeConversionPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{4}
+}
+func (m *MsgFundFeeConversionPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundFeeConversionPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundFeeConversionPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundFeeConversionPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundFeeConversionPool.Merge(m, src)
+}
+func (m *MsgFundFeeConversionPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundFeeConversionPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundFeeConversionPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundFeeConversionPool proto.InternalMessageInfo
+
+func (m *MsgFundFeeConversionPool) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgFundFeeConversionPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgFundFeeConversionPoolResponse defines the response structure for
+// executing a MsgFundFeeConversionPool message.
+type MsgFundFeeConversionPoolResponse struct {
+}
+
+func (m *MsgFundFeeConversionPoolResponse) Reset()         { *m = MsgFundFeeConversionPoolResponse{} }
+func (m *MsgFundFeeConversionPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundFeeConversionPoolResponse) ProtoMessage()    {}
+func (*MsgFundFeeConversionPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{5}
+}
+func (m *MsgFundFeeConversionPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundFeeConversionPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundFeeConversionPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundFeeConversionPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundFeeConversionPoolResponse.Merge(m, src)
+}
+func (m *MsgFundFeeConversionPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundFeeConversionPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundFeeConversionPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundFeeConversionPoolResponse proto.InternalMessageInfo
+
+// MsgDrainFeeConversionPool defines a Msg for returning the funds of the fee
+// conversion pool to the community pool.
+type MsgDrainFeeConversionPool struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// amount is the amount transferred to the community pool
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgDrainFeeConversionPool) Reset()         { *m = MsgDrainFeeConversionPool{} }
+func (m *MsgDrainFeeConversionPool) String() string { return proto.CompactTextString(m) }
+func (*MsgDrainFeeConversionPool) ProtoMessage()    {}
+func (*MsgDrainFeeConversionPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{6}
+}
+func (m *MsgDrainFeeConversionPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDrainFeeConversionPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDrainFeeConversionPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDrainFeeConversionPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDrainFeeConversionPool.Merge(m, src)
+}
+func (m *MsgDrainFeeConversionPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDrainFeeConversionPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDrainFeeConversionPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDrainFeeConversionPool proto.InternalMessageInfo
+
+func (m *MsgDrainFeeConversionPool) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDrainFeeConversionPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgDrainFeeConversionPoolResponse defines the response structure for
+// executing a MsgDrainFeeConversionPool message.
+type MsgDrainFeeConversionPoolResponse struct {
+}
+
+func (m *MsgDrainFeeConversionPoolResponse) Reset()         { *m = MsgDrainFeeConversionPoolResponse{} }
+func (m *MsgDrainFeeConversionPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDrainFeeConversionPoolResponse) ProtoMessage()    {}
+func (*MsgDrainFeeConversionPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{7}
+}
+func (m *MsgDrainFeeConversionPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDrainFeeConversionPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDrainFeeConversionPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDrainFeeConversionPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDrainFeeConversionPoolResponse.Merge(m, src)
+}
+func (m *MsgDrainFeeConversionPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDrainFeeConversionPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDrainFeeConversionPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDrainFeeConversionPoolResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.feemarket.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.feemarket.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateFeeConversionRate)(nil), "ethermint.feemarket.v1.MsgUpdateFeeConversionRate")
+	proto.RegisterType((*MsgUpdateFeeConversionRateResponse)(nil), "ethermint.feemarket.v1.MsgUpdateFeeConversionRateResponse")
+	proto.RegisterType((*MsgFundFeeConversionPool)(nil), "ethermint.feemarket.v1.MsgFundFeeConversionPool")
+	proto.RegisterType((*MsgFundFeeConversionPoolResponse)(nil), "ethermint.feemarket.v1.MsgFundFeeConversionPoolResponse")
+	proto.RegisterType((*MsgDrainFeeConversionPool)(nil), "ethermint.feemarket.v1.MsgDrainFeeConversionPool")
+	proto.RegisterType((*MsgDrainFeeConversionPoolResponse)(nil), "ethermint.feemarket.v1.MsgDrainFeeConversionPoolResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/feemarket/v1/tx.proto", fileDescriptor_78aff2584dbf2838) }
 
 var fileDescriptor_78aff2584dbf2838 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x2b, 0xd0,
-	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x2d,
-	0x4e, 0x07, 0xa9, 0xcf, 0x2d, 0x4e, 0x87, 0x68, 0x90, 0x92, 0x84, 0x48, 0xc4, 0x83, 0x79, 0xfa,
-	0x10, 0x0e, 0x54, 0x4a, 0x0d, 0x87, 0x65, 0x08, 0x83, 0x21, 0xea, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0x21, 0xfa, 0x41, 0x2c, 0x88, 0xa8, 0xd2, 0x74, 0x46, 0x2e, 0x7e, 0xdf, 0xe2, 0xf4, 0xd0, 0x82,
-	0x94, 0xc4, 0x92, 0xd4, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x33, 0x2e, 0xce, 0xc4, 0xd2,
-	0x92, 0x8c, 0xfc, 0xa2, 0xcc, 0x92, 0x4a, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x89, 0x4b,
-	0x5b, 0x74, 0x45, 0xa0, 0xd6, 0x3a, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65,
-	0xe6, 0xa5, 0x07, 0x21, 0x94, 0x0a, 0xd9, 0x70, 0xb1, 0x15, 0x80, 0x4d, 0x90, 0x60, 0x52, 0x60,
-	0xd4, 0xe0, 0x36, 0x92, 0xd3, 0xc3, 0xee, 0x4d, 0x3d, 0x88, 0x3d, 0x4e, 0x2c, 0x27, 0xee, 0xc9,
-	0x33, 0x04, 0x41, 0xf5, 0x58, 0xf1, 0x35, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0x4d, 0x49, 0x92, 0x4b,
-	0x1c, 0xcd, 0x61, 0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46, 0xf9, 0x5c, 0xcc, 0xbe,
-	0xc5, 0xe9, 0x42, 0x19, 0x5c, 0x3c, 0x28, 0xee, 0x56, 0xc7, 0x65, 0x1f, 0x9a, 0x39, 0x52, 0xfa,
-	0x44, 0x2a, 0x84, 0x59, 0xe8, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0x3a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xa9, 0x65, 0xa0,
-	0xb8, 0x83, 0x90, 0x65, 0x86, 0x16, 0xfa, 0x15, 0x48, 0x11, 0x52, 0x52, 0x59, 0x90, 0x5a, 0x9c,
-	0xc4, 0x06, 0x0e, 0x74, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x9c, 0xe1, 0x8d, 0x21,
-	0x02, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xce, 0xb5, 0xfd, 0x45, 0xca, 0xe5, 0x27, 0x90, 0xac, 0xd0, 0x38, 0x46, 0x72, 0x82, 0x8b,
+	0x20, 0x42, 0xd4, 0x8e, 0x83, 0x04, 0x34, 0x62, 0x21, 0xad, 0x32, 0x11, 0xa9, 0x32, 0x62, 0x61,
+	0x41, 0x17, 0xfb, 0x70, 0xac, 0xe0, 0xbb, 0xc8, 0x77, 0xb1, 0x9a, 0x15, 0x24, 0x24, 0x98, 0xd8,
+	0xf8, 0x0e, 0x9d, 0x18, 0xf8, 0x0e, 0x64, 0xac, 0x98, 0x10, 0x43, 0x41, 0xc9, 0xc0, 0xd7, 0x40,
+	0xb6, 0x2f, 0x4e, 0xff, 0xc4, 0x2d, 0x61, 0x63, 0x49, 0x7c, 0x7e, 0xdf, 0xe7, 0x79, 0x9f, 0xc7,
+	0xf7, 0xdc, 0xc1, 0x2a, 0xe6, 0x7d, 0x1c, 0xf8, 0x1e, 0xe1, 0xc6, 0x4b, 0x8c, 0x7d, 0x14, 0x0c,
+	0x30, 0x37, 0x42, 0xd3, 0xe0, 0x07, 0xfa, 0x30, 0xa0, 0x9c, 0x4a, 0x9b, 0x69, 0x83, 0x9e, 0x36,
+	0xe8, 0xa1, 0xa9, 0xa8, 0x36, 0x65, 0x3e, 0x65, 0x46, 0x0f, 0x31, 0x6c, 0x84, 0x66, 0x0f, 0x73,
+	0x64, 0x1a, 0x36, 0xf5, 0x48, 0x82, 0x53, 0xca, 0xa2, 0xee, 0x33, 0x37, 0xe2, 0xf3, 0x99, 0x2b,
+	0x0a, 0x95, 0xa4, 0xf0, 0x22, 0x5e, 0x19, 0xc9, 0x42, 0x94, 0x6e, 0x65, 0x88, 0x59, 0x0c, 0x4e,
+	0xfa, 0x4a, 0x2e, 0x75, 0x69, 0x82, 0x8f, 0x9e, 0x92, 0xb7, 0xda, 0x47, 0x00, 0xaf, 0x76, 0x99,
+	0xfb, 0x6c, 0xe8, 0x20, 0x8e, 0xf7, 0x51, 0x80, 0x7c, 0x26, 0xdd, 0x87, 0x05, 0x34, 0xe2, 0x7d,
+	0x1a, 0x78, 0x7c, 0x2c, 0x83, 0x1a, 0xa8, 0x17, 0xda, 0xf2, 0xd7, 0xcf, 0xdb, 0x25, 0x31, 0xf6,
+	0xb1, 0xe3, 0x04, 0x98, 0xb1, 0xa7, 0x3c, 0xf0, 0x88, 0x6b, 0x2d, 0x5a, 0xa5, 0x47, 0x30, 0x3f,
+	0x8c, 0x19, 0xe4, 0xb5, 0x1a, 0xa8, 0x17, 0x9b, 0xaa, 0xbe, 0xfc, 0x33, 0xe8, 0xc9, 0x9c, 0xf6,
+	0xc6, 0xe4, 0xb8, 0x9a, 0xb3, 0x04, 0xa6, 0x75, 0xe5, 0xf5, 0xaf, 0x4f, 0x77, 0x16, 0x6c, 0x5a,
+	0x05, 0x96, 0xcf, 0x08, 0xb3, 0x30, 0x1b, 0x52, 0xc2, 0xb0, 0x76, 0x08, 0xa0, 0x92, 0xd6, 0x3a,
+	0x18, 0xef, 0x52, 0x12, 0xe2, 0x80, 0x79, 0x94, 0x58, 0x88, 0x63, 0xa9, 0x01, 0xf3, 0x0c, 0x13,
+	0x07, 0x07, 0x97, 0x8a, 0x17, 0x7d, 0x52, 0x09, 0xfe, 0xe7, 0x60, 0x42, 0xfd, 0x58, 0x78, 0xc1,
+	0x4a, 0x16, 0xd2, 0x03, 0xb8, 0x11, 0x20, 0x8e, 0xe5, 0xf5, 0x98, 0x65, 0x2b, 0x52, 0xfb, 0xfd,
+	0xb8, 0x7a, 0x3d, 0x61, 0x62, 0xce, 0x40, 0xf7, 0xa8, 0xe1, 0x23, 0xde, 0xd7, 0x9f, 0x60, 0x17,
+	0xd9, 0xe3, 0x3d, 0x6c, 0x5b, 0x31, 0xa0, 0x55, 0x8c, 0xac, 0x08, 0x6e, 0xed, 0x26, 0xd4, 0xb2,
+	0xb5, 0xa6, 0x96, 0xbe, 0x00, 0x28, 0x77, 0x99, 0xdb, 0x19, 0x11, 0xe7, 0x54, 0xd3, 0x3e, 0xa5,
+	0xaf, 0xfe, 0x7a, 0x43, 0x6c, 0x98, 0x47, 0x3e, 0x1d, 0x11, 0x2e, 0xaf, 0xd5, 0xd6, 0xeb, 0xc5,
+	0x66, 0x45, 0x17, 0x88, 0x28, 0x7f, 0xba, 0xc8, 0x9f, 0xbe, 0x4b, 0x3d, 0xd2, 0x6e, 0x44, 0xee,
+	0x0e, 0x7f, 0x54, 0xeb, 0xae, 0xc7, 0xfb, 0xa3, 0x9e, 0x6e, 0x53, 0x5f, 0xc4, 0x4c, 0xfc, 0x6d,
+	0x33, 0x67, 0x60, 0xf0, 0xf1, 0x10, 0xb3, 0x18, 0xc0, 0x2c, 0x41, 0x7d, 0x6e, 0xdf, 0x34, 0x58,
+	0xcb, 0x32, 0x92, 0xba, 0x9d, 0x00, 0x58, 0xe9, 0x32, 0x77, 0x2f, 0x40, 0x1e, 0xf9, 0xc7, 0xed,
+	0x6e, 0xc1, 0x1b, 0x99, 0x4e, 0xe6, 0x7e, 0x9b, 0xef, 0x37, 0xe0, 0x7a, 0x97, 0xb9, 0x52, 0x1f,
+	0xfe, 0x7f, 0xea, 0xa4, 0xdd, 0xce, 0x3a, 0x21, 0x67, 0x92, 0xaf, 0x18, 0x7f, 0xd8, 0x38, 0x9f,
+	0x28, 0xbd, 0x03, 0xb0, 0x9c, 0x75, 0x3e, 0x9a, 0x97, 0x92, 0x9d, 0xc3, 0x28, 0xad, 0xd5, 0x31,
+	0xa9, 0x96, 0x37, 0x00, 0x5e, 0x5b, 0x1e, 0xec, 0xc6, 0x05, 0xac, 0x4b, 0x11, 0xca, 0xc3, 0x55,
+	0x11, 0xa9, 0x8a, 0xb7, 0x00, 0x6e, 0x66, 0x04, 0xce, 0xbc, 0x80, 0x74, 0x39, 0x44, 0xd9, 0x59,
+	0x19, 0x32, 0x17, 0xd2, 0xee, 0x4c, 0xa6, 0x2a, 0x38, 0x9a, 0xaa, 0xe0, 0xe7, 0x54, 0x05, 0x1f,
+	0x66, 0x6a, 0xee, 0x68, 0xa6, 0xe6, 0xbe, 0xcd, 0xd4, 0xdc, 0xf3, 0xbb, 0x27, 0xd2, 0x88, 0xc3,
+	0x28, 0x8c, 0xc9, 0x6f, 0x68, 0xee, 0x18, 0x07, 0x27, 0x6e, 0xf7, 0x38, 0x97, 0xbd, 0x7c, 0x7c,
+	0x83, 0xdf, 0xfb, 0x3d, 0x00, 0x2f, 0xa3, 0xcb, 0xce, 0x8e, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -171,6 +481,16 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeConversionRate defines an operation for updating the conversion
+	// rate of an alternate fee denomination. The sender must be the governance
+	// account or the fee conversion oracle.
+	UpdateFeeConversionRate(ctx context.Context, in *MsgUpdateFeeConversionRate, opts ...grpc.CallOption) (*MsgUpdateFeeConversionRateResponse, error)
+	// FundFeeConversionPool defines a governance operation for funding the fee
+	// conversion pool from the community pool.
+	FundFeeConversionPool(ctx context.Context, in *MsgFundFeeConversionPool, opts ...grpc.CallOption) (*MsgFundFeeConversionPoolResponse, error)
+	// DrainFeeConversionPool defines a governance operation for returning the
+	// funds of the fee conversion pool to the community pool.
+	DrainFeeConversionPool(ctx context.Context, in *MsgDrainFeeConversionPool, opts ...grpc.CallOption) (*MsgDrainFeeConversionPoolResponse, error)
 }
 
 type msgClient struct {
@@ -190,11 +510,48 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateFeeConversionRate(ctx context.Context, in *MsgUpdateFeeConversionRate, opts ...grpc.CallOption) (*MsgUpdateFeeConversionRateResponse, error) {
+	out := new(MsgUpdateFeeConversionRateResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Msg/UpdateFeeConversionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundFeeConversionPool(ctx context.Context, in *MsgFundFeeConversionPool, opts ...grpc.CallOption) (*MsgFundFeeConversionPoolResponse, error) {
+	out := new(MsgFundFeeConversionPoolResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Msg/FundFeeConversionPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DrainFeeConversionPool(ctx context.Context, in *MsgDrainFeeConversionPool, opts ...grpc.CallOption) (*MsgDrainFeeConversionPoolResponse, error) {
+	out := new(MsgDrainFeeConversionPoolResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Msg/DrainFeeConversionPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeConversionRate defines an operation for updating the conversion
+	// rate of an alternate fee denomination. The sender must be the governance
+	// account or the fee conversion oracle.
+	UpdateFeeConversionRate(context.Context, *MsgUpdateFeeConversionRate) (*MsgUpdateFeeConversionRateResponse, error)
+	// FundFeeConversionPool defines a governance operation for funding the fee
+	// conversion pool from the community pool.
+	FundFeeConversionPool(context.Context, *MsgFundFeeConversionPool) (*MsgFundFeeConversionPoolResponse, error)
+	// DrainFeeConversionPool defines a governance operation for returning the
+	// funds of the fee conversion pool to the community pool.
+	DrainFeeConversionPool(context.Context, *MsgDrainFeeConversionPool) (*MsgDrainFeeConversionPoolResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -204,6 +561,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateFeeConversionRate(ctx context.Context, req *MsgUpdateFeeConversionRate) (*MsgUpdateFeeConversionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeConversionRate not implemented")
+}
+func (*UnimplementedMsgServer) FundFeeConversionPool(ctx context.Context, req *MsgFundFeeConversionPool) (*MsgFundFeeConversionPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundFeeConversionPool not implemented")
+}
+func (*UnimplementedMsgServer) DrainFeeConversionPool(ctx context.Context, req *MsgDrainFeeConversionPool) (*MsgDrainFeeConversionPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainFeeConversionPool not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -227,6 +593,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateFeeConversionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeConversionRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeConversionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Msg/UpdateFeeConversionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeConversionRate(ctx, req.(*MsgUpdateFeeConversionRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundFeeConversionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundFeeConversionPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundFeeConversionPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Msg/FundFeeConversionPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundFeeConversionPool(ctx, req.(*MsgFundFeeConversionPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DrainFeeConversionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDrainFeeConversionPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DrainFeeConversionPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Msg/DrainFeeConversionPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DrainFeeConversionPool(ctx, req.(*MsgDrainFeeConversionPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -235,6 +655,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateFeeConversionRate",
+			Handler:    _Msg_UpdateFeeConversionRate_Handler,
+		},
+		{
+			MethodName: "FundFeeConversionPool",
+			Handler:    _Msg_FundFeeConversionPool_Handler,
+		},
+		{
+			MethodName: "DrainFeeConversionPool",
+			Handler:    _Msg_DrainFeeConversionPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/tx.proto",
@@ -303,25 +735,229 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateFeeConversionRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateFeeConversionRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeConversionRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeConversionRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeConversionRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeConversionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundFeeConversionPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundFeeConversionPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundFeeConversionPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundFeeConversionPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundFeeConversionPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundFeeConversionPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrainFeeConversionPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrainFeeConversionPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrainFeeConversionPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrainFeeConversionPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrainFeeConversionPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrainFeeConversionPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.Params.Size()
@@ -338,6 +974,90 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateFeeConversionRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateFeeConversionRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundFeeConversionPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundFeeConversionPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDrainFeeConversionPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDrainFeeConversionPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -509,6 +1229,536 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateFeeConversionRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeConversionRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeConversionRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeConversionRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeConversionRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeConversionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundFeeConversionPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundFeeConversionPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundFeeConversionPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundFeeConversionPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundFeeConversionPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundFeeConversionPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrainFeeConversionPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrainFeeConversionPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrainFeeConversionPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrainFeeConversionPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrainFeeConversionPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrainFeeConversionPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -31,6 +32,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCirculatingSupply(),
 		GetInflationRate(),
 		GetParams(),
+		GetEpochSupplyChange(),
+		GetNetSupplyChange(),
 	)
 
 	return cmd
@@ -213,3 +216,68 @@ func GetParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetEpochSupplyChange implements a command to return the supply changes of
+// the mint denom during an inflation epoch.
+func GetEpochSupplyChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-supply-change EPOCH",
+		Short: "Query the supply changes of the mint denom during an inflation epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid epoch %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEpochSupplyChangeRequest{Epoch: epoch}
+			res, err := queryClient.EpochSupplyChange(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetNetSupplyChange implements a command to return the cumulative supply
+// changes of the mint denom.
+func GetNetSupplyChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "net-supply-change",
+		Short: "Query the cumulative supply changes of the mint denom",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryNetSupplyChangeRequest{}
+			res, err := queryClient.NetSupplyChange(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -6,6 +6,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v19/x/inflation/v1/types"
 )
@@ -82,3 +85,40 @@ func (k Keeper) Params(
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// EpochSupplyChange returns the supply changes of the mint denom during the
+// given epoch.
+func (k Keeper) EpochSupplyChange(
+	c context.Context,
+	req *types.QueryEpochSupplyChangeRequest,
+) (*types.QueryEpochSupplyChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Epoch < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid epoch %d", req.Epoch)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	changes := k.GetEpochSupplyChanges(ctx, req.Epoch)
+
+	return &types.QueryEpochSupplyChangeResponse{
+		Changes: changes,
+		Net:     types.NetSupplyChange(changes),
+	}, nil
+}
+
+// NetSupplyChange returns the cumulative supply changes of the mint denom.
+func (k Keeper) NetSupplyChange(
+	c context.Context,
+	_ *types.QueryNetSupplyChangeRequest,
+) (*types.QueryNetSupplyChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	changes := k.GetNetSupplyChanges(ctx)
+
+	return &types.QueryNetSupplyChangeResponse{
+		Changes: changes,
+		Net:     types.NetSupplyChange(changes),
+	}, nil
+}
@@ -14,12 +14,21 @@ import (
 	"github.com/evmos/evmos/v19/x/inflation/v1/types"
 )
 
-// BeforeEpochStart: noop, We don't need to do anything here
-func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {
+// BeforeEpochStart accounts the supply changes from now on to the new epoch
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	if epochIdentifier == k.GetEpochIdentifier(ctx) {
+		k.SetSupplyChangeEpoch(ctx, epochNumber)
+	}
 }
 
 // AfterEpochEnd mints and allocates coins at the end of each epoch end
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	// the epoch number is the one of the new epoch, so that the inflation
+	// minted below is accounted to it
+	if epochIdentifier == k.GetEpochIdentifier(ctx) {
+		k.SetSupplyChangeEpoch(ctx, epochNumber)
+	}
+
 	params := k.GetParams(ctx)
 	skippedEpochs := k.GetSkippedEpochs(ctx)
 
@@ -34,6 +34,7 @@ func (k Keeper) MintAndAllocateInflation(
 	if err := k.MintCoins(ctx, coin); err != nil {
 		return nil, nil, err
 	}
+	k.AfterCoinsMinted(ctx, types.SupplySourceInflation, sdk.Coins{coin})
 
 	// Allocate minted coins according to allocation proportions (staking, usage
 	// incentives, community pool)
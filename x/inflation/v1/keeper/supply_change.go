@@ -0,0 +1,116 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/x/inflation/v1/types"
+)
+
+var _ types.SupplyChangeHooks = Keeper{}
+
+// AfterCoinsMinted accounts the amount of the mint denom minted by the given
+// source to the current epoch. The other denoms are ignored.
+func (k Keeper) AfterCoinsMinted(ctx sdk.Context, source string, coins sdk.Coins) {
+	amount := coins.AmountOf(k.GetParams(ctx).MintDenom)
+	if !amount.IsPositive() {
+		return
+	}
+
+	k.addSupplyChange(ctx, source, func(change *types.SupplyChange) {
+		change.Minted = change.Minted.Add(amount)
+	})
+}
+
+// AfterCoinsBurned accounts the amount of the mint denom burned by the given
+// source to the current epoch. The other denoms are ignored.
+func (k Keeper) AfterCoinsBurned(ctx sdk.Context, source string, coins sdk.Coins) {
+	amount := coins.AmountOf(k.GetParams(ctx).MintDenom)
+	if !amount.IsPositive() {
+		return
+	}
+
+	k.addSupplyChange(ctx, source, func(change *types.SupplyChange) {
+		change.Burned = change.Burned.Add(amount)
+	})
+}
+
+// addSupplyChange applies the given update to the supply change of the source
+// in the current epoch, and to its cumulative supply change.
+func (k Keeper) addSupplyChange(ctx sdk.Context, source string, update func(change *types.SupplyChange)) {
+	store := ctx.KVStore(k.storeKey)
+	epochStore := prefix.NewStore(store, types.EpochSupplyChangePrefix(k.GetSupplyChangeEpoch(ctx)))
+	netStore := prefix.NewStore(store, types.KeyPrefixNetSupplyChange)
+
+	for _, s := range []prefix.Store{epochStore, netStore} {
+		change := k.getSupplyChange(s, source)
+		update(&change)
+		s.Set([]byte(source), k.cdc.MustMarshal(&change))
+	}
+}
+
+// getSupplyChange returns the supply change of the given source in the given
+// store, or an empty one if the source has not changed the supply.
+func (k Keeper) getSupplyChange(store prefix.Store, source string) types.SupplyChange {
+	bz := store.Get([]byte(source))
+	if len(bz) == 0 {
+		return types.NewSupplyChange(source)
+	}
+
+	var change types.SupplyChange
+	k.cdc.MustUnmarshal(bz, &change)
+	return change
+}
+
+// getSupplyChanges returns all the supply changes of the given store, sorted
+// by source.
+func (k Keeper) getSupplyChanges(store prefix.Store) []types.SupplyChange {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	changes := []types.SupplyChange{}
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.SupplyChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// GetEpochSupplyChanges returns the supply changes of the mint denom during
+// the given epoch, by source.
+func (k Keeper) GetEpochSupplyChanges(ctx sdk.Context, epoch int64) []types.SupplyChange {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochSupplyChangePrefix(epoch))
+	return k.getSupplyChanges(store)
+}
+
+// GetNetSupplyChanges returns the cumulative supply changes of the mint denom,
+// by source.
+func (k Keeper) GetNetSupplyChanges(ctx sdk.Context) []types.SupplyChange {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNetSupplyChange)
+	return k.getSupplyChanges(store)
+}
+
+// GetSupplyChangeEpoch returns the number of the epoch the supply changes are
+// currently accounted to. The changes prior to the first epoch are accounted
+// to the epoch 0.
+func (k Keeper) GetSupplyChangeEpoch(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixSupplyChangeEpoch)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetSupplyChangeEpoch sets the number of the epoch the supply changes are
+// accounted to.
+func (k Keeper) SetSupplyChangeEpoch(ctx sdk.Context, epoch int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixSupplyChangeEpoch, sdk.Uint64ToBigEndian(uint64(epoch)))
+}
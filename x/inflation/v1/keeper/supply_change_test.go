@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/testutil"
+	erc20types "github.com/evmos/evmos/v19/x/erc20/types"
+	"github.com/evmos/evmos/v19/x/inflation/v1/types"
+)
+
+func (suite *KeeperTestSuite) TestSupplyChangeHooks() {
+	suite.SetupTest()
+
+	mintDenom := suite.app.InflationKeeper.GetParams(suite.ctx).MintDenom
+	suite.app.InflationKeeper.SetSupplyChangeEpoch(suite.ctx, 5)
+
+	suite.app.InflationKeeper.AfterCoinsMinted(suite.ctx, "source", sdk.NewCoins(
+		sdk.NewInt64Coin(mintDenom, 300),
+		sdk.NewInt64Coin("other", 1000),
+	))
+	suite.app.InflationKeeper.AfterCoinsBurned(suite.ctx, "source", sdk.NewCoins(sdk.NewInt64Coin(mintDenom, 100)))
+	// the changes of other denoms are ignored
+	suite.app.InflationKeeper.AfterCoinsBurned(suite.ctx, "other", sdk.NewCoins(sdk.NewInt64Coin("other", 100)))
+
+	expChanges := []types.SupplyChange{
+		{Source: "source", Minted: math.NewInt(300), Burned: math.NewInt(100)},
+	}
+	suite.Require().Equal(expChanges, suite.app.InflationKeeper.GetEpochSupplyChanges(suite.ctx, 5))
+	suite.Require().Equal(expChanges, suite.app.InflationKeeper.GetNetSupplyChanges(suite.ctx))
+	suite.Require().Empty(suite.app.InflationKeeper.GetEpochSupplyChanges(suite.ctx, 4))
+
+	// the changes of the next epoch are accumulated separately
+	suite.app.InflationKeeper.SetSupplyChangeEpoch(suite.ctx, 6)
+	suite.app.InflationKeeper.AfterCoinsBurned(suite.ctx, "source", sdk.NewCoins(sdk.NewInt64Coin(mintDenom, 500)))
+
+	res, err := suite.queryClient.EpochSupplyChange(suite.ctx, &types.QueryEpochSupplyChangeRequest{Epoch: 6})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SupplyChange{
+		{Source: "source", Minted: math.ZeroInt(), Burned: math.NewInt(500)},
+	}, res.Changes)
+	suite.Require().Equal(math.NewInt(-500), res.Net)
+
+	netRes, err := suite.queryClient.NetSupplyChange(suite.ctx, &types.QueryNetSupplyChangeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SupplyChange{
+		{Source: "source", Minted: math.NewInt(300), Burned: math.NewInt(600)},
+	}, netRes.Changes)
+	suite.Require().Equal(math.NewInt(-300), netRes.Net)
+
+	_, err = suite.queryClient.EpochSupplyChange(suite.ctx, &types.QueryEpochSupplyChangeRequest{Epoch: -1})
+	suite.Require().ErrorContains(err, "invalid epoch")
+}
+
+// TestSupplyChangeReconciliation checks that the supply changes accounted per
+// epoch match the changes of the bank total supply over several epochs with
+// inflation mints and reported burns.
+func (suite *KeeperTestSuite) TestSupplyChangeReconciliation() {
+	suite.SetupTest()
+
+	mintDenom := suite.app.InflationKeeper.GetParams(suite.ctx).MintDenom
+	burned := sdk.NewCoins(sdk.NewInt64Coin(mintDenom, 1_000_000))
+
+	// fund the burner ahead, as the funding mints unaccounted coins
+	funds := sdk.NewCoins(sdk.NewInt64Coin(mintDenom, 3_000_000))
+	suite.Require().NoError(testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, erc20types.ModuleName, funds))
+
+	startSupply := suite.app.BankKeeper.GetSupply(suite.ctx, mintDenom).Amount
+	prevSupply := startSupply
+	prevEpoch := suite.app.InflationKeeper.GetSupplyChangeEpoch(suite.ctx)
+
+	for i := 0; i < 3; i++ {
+		// start a new epoch, minting the inflation of the ended one
+		suite.CommitAfter(24*time.Hour + time.Second)
+
+		epoch := suite.app.InflationKeeper.GetSupplyChangeEpoch(suite.ctx)
+		suite.Require().Greater(epoch, prevEpoch)
+		prevEpoch = epoch
+
+		// burn coins during the epoch and report them
+		suite.Require().NoError(suite.app.BankKeeper.BurnCoins(suite.ctx, erc20types.ModuleName, burned))
+		suite.app.InflationKeeper.AfterCoinsBurned(suite.ctx, erc20types.ModuleName, burned)
+
+		supply := suite.app.BankKeeper.GetSupply(suite.ctx, mintDenom).Amount
+
+		res, err := suite.queryClient.EpochSupplyChange(suite.ctx, &types.QueryEpochSupplyChangeRequest{Epoch: epoch})
+		suite.Require().NoError(err)
+		suite.Require().Equal(supply.Sub(prevSupply), res.Net, "epoch %d", epoch)
+
+		prevSupply = supply
+	}
+
+	res, err := suite.queryClient.NetSupplyChange(suite.ctx, &types.QueryNetSupplyChangeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(prevSupply.Sub(startSupply), res.Net)
+	suite.Require().Len(res.Changes, 2)
+	suite.Require().Equal(erc20types.ModuleName, res.Changes[0].Source)
+	suite.Require().Equal(burned.AmountOf(mintDenom).MulRaw(3), res.Changes[0].Burned)
+	suite.Require().Equal(types.SupplySourceInflation, res.Changes[1].Source)
+	suite.Require().True(res.Changes[1].Minted.IsPositive())
+}
@@ -54,4 +54,6 @@ func (suite *KeeperTestSuite) CommitAfter(t time.Duration) {
 	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
 	evm.RegisterQueryServer(queryHelper, suite.app.EvmKeeper)
 	suite.queryClientEvm = evm.NewQueryClient(queryHelper)
+	types.RegisterQueryServer(queryHelper, suite.app.InflationKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
 }
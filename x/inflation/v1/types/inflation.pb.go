@@ -127,9 +127,61 @@ func (m *ExponentialCalculation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExponentialCalculation proto.InternalMessageInfo
 
+// SupplyChange defines the amounts of the mint denom minted and burned by a
+// source of supply changes, such as the inflation.
+type SupplyChange struct {
+	// source is the name of the module or mechanism that changed the supply
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// minted is the amount of the mint denom minted by the source
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	// burned is the amount of the mint denom burned by the source
+	Burned cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=burned,proto3,customtype=cosmossdk.io/math.Int" json:"burned"`
+}
+
+func (m *SupplyChange) Reset()         { *m = SupplyChange{} }
+func (m *SupplyChange) String() string { return proto.CompactTextString(m) }
+func (*SupplyChange) ProtoMessage()    {}
+func (*SupplyChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{2}
+}
+func (m *SupplyChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyChange.Merge(m, src)
+}
+func (m *SupplyChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyChange proto.InternalMessageInfo
+
+func (m *SupplyChange) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InflationDistribution)(nil), "evmos.inflation.v1.InflationDistribution")
 	proto.RegisterType((*ExponentialCalculation)(nil), "evmos.inflation.v1.ExponentialCalculation")
+	proto.RegisterType((*SupplyChange)(nil), "evmos.inflation.v1.SupplyChange")
 }
 
 func init() {
@@ -137,31 +189,35 @@ func init() {
 }
 
 var fileDescriptor_d064cb35c3ff7df8 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6b, 0xdb, 0x30,
-	0x18, 0xc6, 0xad, 0xec, 0x0f, 0x4c, 0xdb, 0x92, 0x61, 0xb6, 0x61, 0x36, 0x70, 0x46, 0xc6, 0x60,
-	0x27, 0x1b, 0xb3, 0xcb, 0xce, 0x59, 0x36, 0xc8, 0xc8, 0x21, 0x84, 0xd2, 0x43, 0x2f, 0x46, 0x56,
-	0x54, 0x47, 0xc4, 0xd2, 0x6b, 0x24, 0xd9, 0x75, 0xce, 0xfd, 0x02, 0xfd, 0x52, 0x85, 0x1c, 0x73,
-	0x2c, 0x3d, 0x84, 0x92, 0x7c, 0x91, 0xe2, 0x3f, 0x4d, 0xe8, 0xcd, 0xb9, 0x88, 0x57, 0xd2, 0xfb,
-	0x7b, 0x78, 0x1f, 0xe9, 0xc1, 0x03, 0x96, 0x0b, 0xd0, 0x3e, 0x97, 0x97, 0x09, 0x31, 0x1c, 0xa4,
-	0x9f, 0x07, 0xc7, 0x8d, 0x97, 0x2a, 0x30, 0x60, 0xdb, 0x55, 0x8f, 0x77, 0x3c, 0xce, 0x83, 0x2f,
-	0x1f, 0x63, 0x88, 0xa1, 0xba, 0xf6, 0xcb, 0xaa, 0xee, 0x1c, 0x5c, 0x77, 0xf0, 0xa7, 0xf1, 0x53,
-	0xdb, 0x88, 0x6b, 0xa3, 0x78, 0x94, 0x95, 0xb5, 0x3d, 0xc1, 0x3d, 0x6d, 0xc8, 0x92, 0xcb, 0x38,
-	0x54, 0xec, 0x8a, 0xa8, 0xb9, 0x76, 0xd0, 0x37, 0xf4, 0xf3, 0xcd, 0xf0, 0xfb, 0x7a, 0xdb, 0xb7,
-	0xee, 0xb7, 0xfd, 0xaf, 0x14, 0xb4, 0x00, 0xad, 0xe7, 0x4b, 0x8f, 0x83, 0x2f, 0x88, 0x59, 0x78,
-	0x13, 0x16, 0x13, 0xba, 0x1a, 0x31, 0x3a, 0xeb, 0x36, 0xec, 0xac, 0x46, 0xed, 0x29, 0xfe, 0x90,
-	0x69, 0x12, 0xb3, 0x90, 0x4b, 0xca, 0xa4, 0xe1, 0x39, 0xd3, 0x4e, 0xa7, 0x92, 0xfb, 0xd1, 0x42,
-	0xce, 0x41, 0xb3, 0x5e, 0x85, 0x8f, 0x0f, 0xb4, 0xfd, 0x1f, 0x77, 0x29, 0x08, 0x91, 0x49, 0x6e,
-	0x56, 0x61, 0x0a, 0x90, 0x38, 0x2f, 0xda, 0x8f, 0xf7, 0xfe, 0x80, 0x4e, 0x01, 0x92, 0xc1, 0x6d,
-	0x07, 0x7f, 0xfe, 0x5b, 0xa4, 0x20, 0x4b, 0x71, 0x92, 0xfc, 0x21, 0x09, 0xcd, 0xea, 0x27, 0xb1,
-	0x03, 0x8c, 0xc8, 0x29, 0xc6, 0x11, 0x29, 0x11, 0xd5, 0x98, 0x6b, 0x87, 0xa8, 0x12, 0xa1, 0xa7,
-	0xcc, 0x8f, 0x68, 0xe9, 0x3f, 0x02, 0x39, 0x2f, 0xff, 0xc7, 0x10, 0x15, 0x33, 0xe3, 0xbc, 0x3c,
-	0xc1, 0x7f, 0x83, 0x9e, 0x55, 0xa4, 0xfd, 0x0f, 0xbf, 0x13, 0xa4, 0x08, 0x73, 0xa2, 0x38, 0x91,
-	0x94, 0x39, 0xaf, 0xda, 0x2b, 0xbd, 0x15, 0xa4, 0x38, 0x6f, 0xb8, 0xe1, 0x78, 0xbd, 0x73, 0xd1,
-	0x66, 0xe7, 0xa2, 0x87, 0x9d, 0x8b, 0x6e, 0xf6, 0xae, 0xb5, 0xd9, 0xbb, 0xd6, 0xdd, 0xde, 0xb5,
-	0x2e, 0xfc, 0x98, 0x9b, 0x45, 0x16, 0x79, 0x14, 0x84, 0x5f, 0x07, 0xb8, 0x5e, 0xf3, 0xe0, 0xb7,
-	0x5f, 0x3c, 0x0f, 0xb3, 0x59, 0xa5, 0x4c, 0x47, 0xaf, 0xab, 0x7c, 0xfe, 0x7a, 0x0c, 0x00, 0x00,
-	0xff, 0xff, 0x89, 0x88, 0x96, 0xe6, 0xef, 0x02, 0x00, 0x00,
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0xb6, 0xba, 0xe0, 0x58, 0x5b, 0x19, 0x6c, 0x09, 0x8a, 0xa9, 0xac, 0x08, 0x9e,
+	0x12, 0x16, 0xf1, 0xe0, 0xb5, 0xad, 0xc2, 0x4a, 0x0f, 0x25, 0x8a, 0x07, 0x2f, 0xcb, 0xcb, 0x64,
+	0xcc, 0x0e, 0xcd, 0xcc, 0x0b, 0x33, 0x93, 0xb8, 0x7b, 0xf6, 0x1f, 0xf0, 0xe0, 0xbf, 0x24, 0xf4,
+	0xd8, 0xa3, 0x78, 0x28, 0xb2, 0xfb, 0x8f, 0xc8, 0x24, 0xb1, 0x8b, 0xf4, 0xb2, 0xb9, 0x84, 0xf7,
+	0x92, 0xef, 0xe7, 0xfb, 0x7e, 0x84, 0x47, 0xc7, 0xa2, 0x56, 0x68, 0x63, 0xa9, 0xbf, 0x14, 0xe0,
+	0x24, 0xea, 0xb8, 0x9e, 0x6c, 0x92, 0xa8, 0x34, 0xe8, 0x90, 0xb1, 0x46, 0x13, 0x6d, 0x5e, 0xd7,
+	0x93, 0xc7, 0x8f, 0x72, 0xcc, 0xb1, 0xf9, 0x1c, 0xfb, 0xa8, 0x55, 0x8e, 0xbf, 0x0d, 0xe9, 0xc1,
+	0xf4, 0x9f, 0xec, 0x54, 0x5a, 0x67, 0x64, 0x5a, 0xf9, 0x98, 0x9d, 0xd1, 0x7d, 0xeb, 0xe0, 0x42,
+	0xea, 0x7c, 0x66, 0xc4, 0x57, 0x30, 0x99, 0x0d, 0xc8, 0x33, 0xf2, 0xf2, 0xde, 0xf1, 0xf3, 0xcb,
+	0xeb, 0xa3, 0xc1, 0xef, 0xeb, 0xa3, 0x27, 0x1c, 0xad, 0x42, 0x6b, 0xb3, 0x8b, 0x48, 0x62, 0xac,
+	0xc0, 0xcd, 0xa3, 0x33, 0x91, 0x03, 0x5f, 0x9e, 0x0a, 0x9e, 0xec, 0x75, 0x6c, 0xd2, 0xa2, 0xec,
+	0x9c, 0x3e, 0xac, 0x2c, 0xe4, 0x62, 0x26, 0x35, 0x17, 0xda, 0xc9, 0x5a, 0xd8, 0x60, 0xd8, 0xd8,
+	0xbd, 0xd8, 0xc2, 0x2e, 0x20, 0xc9, 0x7e, 0x83, 0x4f, 0x6f, 0x68, 0xf6, 0x9e, 0xee, 0x71, 0x54,
+	0xaa, 0xd2, 0xd2, 0x2d, 0x67, 0x25, 0x62, 0x11, 0xec, 0x6c, 0xdf, 0xde, 0x83, 0x1b, 0xf4, 0x1c,
+	0xb1, 0x18, 0xff, 0x1c, 0xd2, 0xc3, 0xb7, 0x8b, 0x12, 0xb5, 0x37, 0x87, 0xe2, 0x04, 0x0a, 0x5e,
+	0xb5, 0x2b, 0x61, 0x13, 0x4a, 0xa0, 0xcf, 0xe0, 0x04, 0x3c, 0x62, 0x82, 0x61, 0x0f, 0xc4, 0x78,
+	0x84, 0xf7, 0xe9, 0x9f, 0x70, 0x3f, 0x7f, 0x8a, 0x3a, 0xf3, 0xff, 0xc7, 0x81, 0xc9, 0x85, 0x0b,
+	0xee, 0xf4, 0x98, 0xbf, 0x43, 0x3f, 0x36, 0x24, 0x7b, 0x47, 0x77, 0x15, 0x2c, 0x66, 0x35, 0x18,
+	0x09, 0x9a, 0x8b, 0xe0, 0xee, 0xf6, 0x4e, 0xf7, 0x15, 0x2c, 0x3e, 0x75, 0xdc, 0xf8, 0x07, 0xa1,
+	0xbb, 0x1f, 0xaa, 0xb2, 0x2c, 0x96, 0x27, 0x73, 0xd0, 0xb9, 0x60, 0x87, 0x74, 0x64, 0xb1, 0x32,
+	0x5c, 0xb4, 0x2b, 0x4c, 0xba, 0x8c, 0xbd, 0xa6, 0x23, 0x25, 0xb5, 0x13, 0x59, 0xb7, 0xa7, 0xa7,
+	0x5d, 0xa9, 0x83, 0xdb, 0xa5, 0xa6, 0xda, 0x25, 0x9d, 0xd8, 0x63, 0x69, 0x65, 0xb4, 0xc8, 0x82,
+	0x9d, 0xad, 0xb0, 0x56, 0x7c, 0x3c, 0xbd, 0x5c, 0x85, 0xe4, 0x6a, 0x15, 0x92, 0x3f, 0xab, 0x90,
+	0x7c, 0x5f, 0x87, 0x83, 0xab, 0x75, 0x38, 0xf8, 0xb5, 0x0e, 0x07, 0x9f, 0xe3, 0x5c, 0xba, 0x79,
+	0x95, 0x46, 0x1c, 0x55, 0xdc, 0xde, 0x55, 0xfb, 0xac, 0x27, 0x6f, 0xe2, 0xc5, 0xff, 0x37, 0xe6,
+	0x96, 0xa5, 0xb0, 0xe9, 0xa8, 0x39, 0x9b, 0x57, 0x7f, 0x07, 0x00, 0xe8, 0x14, 0xf8, 0x3a, 0x86,
+	0x03, 0x00, 0x00,
 }
 
 func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -290,6 +346,56 @@ func (m *ExponentialCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplyChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInflation(v)
 	base := offset
@@ -335,6 +441,23 @@ func (m *ExponentialCalculation) Size() (n int) {
 	return n
 }
 
+func (m *SupplyChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
 func sovInflation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -713,6 +836,156 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SupplyChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipInflation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,8 @@
 
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 // constants
 const (
 	// module name
@@ -22,6 +24,9 @@ const (
 	prefixEpochIdentifier
 	prefixEpochsPerPeriod
 	prefixSkippedEpochs
+	prefixSupplyChangeEpoch
+	prefixEpochSupplyChange
+	prefixNetSupplyChange
 )
 
 // KVStore key prefixes
@@ -30,4 +35,19 @@ var (
 	KeyPrefixEpochIdentifier = []byte{prefixEpochIdentifier}
 	KeyPrefixEpochsPerPeriod = []byte{prefixEpochsPerPeriod}
 	KeyPrefixSkippedEpochs   = []byte{prefixSkippedEpochs}
+	// KeyPrefixSupplyChangeEpoch holds the number of the epoch the supply
+	// changes are currently accounted to
+	KeyPrefixSupplyChangeEpoch = []byte{prefixSupplyChangeEpoch}
+	// KeyPrefixEpochSupplyChange maps the epochs and sources to their supply
+	// changes
+	KeyPrefixEpochSupplyChange = []byte{prefixEpochSupplyChange}
+	// KeyPrefixNetSupplyChange maps the sources to their cumulative supply
+	// changes
+	KeyPrefixNetSupplyChange = []byte{prefixNetSupplyChange}
 )
+
+// EpochSupplyChangePrefix returns the prefix of the supply changes of the
+// given epoch.
+func EpochSupplyChangePrefix(epoch int64) []byte {
+	return append(KeyPrefixEpochSupplyChange, sdk.Uint64ToBigEndian(uint64(epoch))...)
+}
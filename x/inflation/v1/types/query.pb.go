@@ -530,6 +530,191 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryEpochSupplyChangeRequest is the request type for the
+// Query/EpochSupplyChange RPC method.
+type QueryEpochSupplyChangeRequest struct {
+	// epoch is the number of the inflation epoch
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *QueryEpochSupplyChangeRequest) Reset()         { *m = QueryEpochSupplyChangeRequest{} }
+func (m *QueryEpochSupplyChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSupplyChangeRequest) ProtoMessage()    {}
+func (*QueryEpochSupplyChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{12}
+}
+func (m *QueryEpochSupplyChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSupplyChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSupplyChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochSupplyChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSupplyChangeRequest.Merge(m, src)
+}
+func (m *QueryEpochSupplyChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSupplyChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSupplyChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochSupplyChangeRequest proto.InternalMessageInfo
+
+func (m *QueryEpochSupplyChangeRequest) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// QueryEpochSupplyChangeResponse is the response type for the
+// Query/EpochSupplyChange RPC method.
+type QueryEpochSupplyChangeResponse struct {
+	// changes are the supply changes of the epoch, by source
+	Changes []SupplyChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// net is the net supply change of the epoch, i.e. the minted amounts minus
+	// the burned amounts
+	Net cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=net,proto3,customtype=cosmossdk.io/math.Int" json:"net"`
+}
+
+func (m *QueryEpochSupplyChangeResponse) Reset()         { *m = QueryEpochSupplyChangeResponse{} }
+func (m *QueryEpochSupplyChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSupplyChangeResponse) ProtoMessage()    {}
+func (*QueryEpochSupplyChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{13}
+}
+func (m *QueryEpochSupplyChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSupplyChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSupplyChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochSupplyChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSupplyChangeResponse.Merge(m, src)
+}
+func (m *QueryEpochSupplyChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSupplyChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSupplyChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochSupplyChangeResponse proto.InternalMessageInfo
+
+func (m *QueryEpochSupplyChangeResponse) GetChanges() []SupplyChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// QueryNetSupplyChangeRequest is the request type for the Query/NetSupplyChange
+// RPC method.
+type QueryNetSupplyChangeRequest struct {
+}
+
+func (m *QueryNetSupplyChangeRequest) Reset()         { *m = QueryNetSupplyChangeRequest{} }
+func (m *QueryNetSupplyChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetSupplyChangeRequest) ProtoMessage()    {}
+func (*QueryNetSupplyChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{14}
+}
+func (m *QueryNetSupplyChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetSupplyChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetSupplyChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetSupplyChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetSupplyChangeRequest.Merge(m, src)
+}
+func (m *QueryNetSupplyChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetSupplyChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetSupplyChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetSupplyChangeRequest proto.InternalMessageInfo
+
+// QueryNetSupplyChangeResponse is the response type for the
+// Query/NetSupplyChange RPC method.
+type QueryNetSupplyChangeResponse struct {
+	// changes are the cumulative supply changes, by source
+	Changes []SupplyChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// net is the cumulative net supply change, i.e. the minted amounts minus the
+	// burned amounts
+	Net cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=net,proto3,customtype=cosmossdk.io/math.Int" json:"net"`
+}
+
+func (m *QueryNetSupplyChangeResponse) Reset()         { *m = QueryNetSupplyChangeResponse{} }
+func (m *QueryNetSupplyChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetSupplyChangeResponse) ProtoMessage()    {}
+func (*QueryNetSupplyChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{15}
+}
+func (m *QueryNetSupplyChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetSupplyChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetSupplyChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetSupplyChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetSupplyChangeResponse.Merge(m, src)
+}
+func (m *QueryNetSupplyChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetSupplyChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetSupplyChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetSupplyChangeResponse proto.InternalMessageInfo
+
+func (m *QueryNetSupplyChangeResponse) GetChanges() []SupplyChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryPeriodRequest)(nil), "evmos.inflation.v1.QueryPeriodRequest")
 	proto.RegisterType((*QueryPeriodResponse)(nil), "evmos.inflation.v1.QueryPeriodResponse")
@@ -543,55 +728,69 @@ func init() {
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "evmos.inflation.v1.QueryInflationRateResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.inflation.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.inflation.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryEpochSupplyChangeRequest)(nil), "evmos.inflation.v1.QueryEpochSupplyChangeRequest")
+	proto.RegisterType((*QueryEpochSupplyChangeResponse)(nil), "evmos.inflation.v1.QueryEpochSupplyChangeResponse")
+	proto.RegisterType((*QueryNetSupplyChangeRequest)(nil), "evmos.inflation.v1.QueryNetSupplyChangeRequest")
+	proto.RegisterType((*QueryNetSupplyChangeResponse)(nil), "evmos.inflation.v1.QueryNetSupplyChangeResponse")
 }
 
 func init() { proto.RegisterFile("evmos/inflation/v1/query.proto", fileDescriptor_91b9f1b5d47c7fd7) }
 
 var fileDescriptor_91b9f1b5d47c7fd7 = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x4e, 0x13, 0x41,
-	0x18, 0xc7, 0xbb, 0x06, 0x6b, 0x1c, 0x03, 0x09, 0x23, 0x31, 0xba, 0xe0, 0xb6, 0x59, 0x15, 0x1b,
-	0x12, 0x66, 0x6c, 0xb9, 0x70, 0x06, 0x3d, 0x60, 0x34, 0x62, 0xb9, 0x79, 0x69, 0xb6, 0xcb, 0xb8,
-	0x9d, 0x40, 0x67, 0x86, 0x9d, 0x69, 0x43, 0x0f, 0x5e, 0x7c, 0x02, 0x13, 0x0f, 0x26, 0x9a, 0xf8,
-	0x00, 0x5c, 0x7c, 0x0d, 0x8e, 0x24, 0x5e, 0x8c, 0x07, 0x34, 0xe0, 0x83, 0x98, 0x9d, 0x9d, 0x2d,
-	0x2c, 0x9d, 0x5d, 0x24, 0xf1, 0xd2, 0x6c, 0xe7, 0xfb, 0x7f, 0xdf, 0xf7, 0xcf, 0x7f, 0xe7, 0x97,
-	0x05, 0x1e, 0x19, 0xf6, 0xb9, 0xc4, 0x94, 0xbd, 0xdd, 0x0d, 0x14, 0xe5, 0x0c, 0x0f, 0x9b, 0x78,
-	0x6f, 0x40, 0xe2, 0x11, 0x12, 0x31, 0x57, 0x1c, 0x42, 0x5d, 0x47, 0xe3, 0x3a, 0x1a, 0x36, 0x5d,
-	0x2f, 0xe4, 0x32, 0x69, 0xea, 0x06, 0x92, 0xe0, 0x61, 0xb3, 0x4b, 0x54, 0xd0, 0xc4, 0x21, 0xa7,
-	0x2c, 0xed, 0x71, 0xeb, 0x96, 0x99, 0x11, 0x61, 0x44, 0x52, 0x69, 0x14, 0x73, 0x11, 0x8f, 0xb8,
-	0x7e, 0xc4, 0xc9, 0x93, 0x39, 0x5d, 0x88, 0x38, 0x8f, 0x76, 0x09, 0x0e, 0x04, 0xc5, 0x01, 0x63,
-	0x5c, 0xe9, 0x6e, 0xd3, 0xe3, 0xcf, 0x01, 0xf8, 0x3a, 0x31, 0xb6, 0x49, 0x62, 0xca, 0xb7, 0xdb,
-	0x64, 0x6f, 0x40, 0xa4, 0xf2, 0x97, 0xc1, 0xed, 0xdc, 0xa9, 0x14, 0x9c, 0x49, 0x02, 0xef, 0x80,
-	0xaa, 0xd0, 0x27, 0x77, 0x9d, 0xba, 0xd3, 0x98, 0x6a, 0x9b, 0x7f, 0x7e, 0x1d, 0x78, 0x5a, 0xfe,
-	0x4c, 0xf0, 0xb0, 0xf7, 0x92, 0x32, 0xb5, 0x19, 0xf3, 0x21, 0x95, 0x94, 0xb3, 0x6c, 0xe0, 0x17,
-	0x07, 0xd4, 0x0a, 0x25, 0x66, 0xfa, 0x3e, 0x98, 0x23, 0x49, 0xb5, 0xd3, 0xa7, 0x4c, 0x75, 0x44,
-	0x56, 0xd7, 0xbb, 0x6e, 0xb5, 0x16, 0x50, 0x9a, 0x0f, 0x4a, 0xf2, 0x41, 0x26, 0x1f, 0xf4, 0x94,
-	0x84, 0xeb, 0x9c, 0xb2, 0xb5, 0xc6, 0xe1, 0x71, 0xad, 0x72, 0xf0, 0xab, 0x56, 0x4f, 0x45, 0x72,
-	0x7b, 0x07, 0x51, 0x8e, 0xfb, 0x81, 0xea, 0xa1, 0x17, 0x24, 0x0a, 0xc2, 0x91, 0x11, 0xca, 0x36,
-	0x24, 0x13, 0x0e, 0xfc, 0x79, 0x70, 0x4f, 0x9b, 0xdb, 0xda, 0xa1, 0x42, 0x90, 0x6d, 0xed, 0x51,
-	0x66, 0xd6, 0xd7, 0x81, 0x6b, 0x2b, 0x1a, 0xd3, 0x8f, 0xc0, 0x8c, 0x4c, 0x0b, 0x1d, 0x3d, 0x58,
-	0x9a, 0x68, 0xa6, 0xe5, 0x79, 0xb9, 0x5f, 0x03, 0xf7, 0xf5, 0x90, 0x75, 0x1a, 0x87, 0x83, 0xe4,
-	0xfd, 0xb1, 0x68, 0x6b, 0x20, 0xc4, 0xee, 0x28, 0xdb, 0xf2, 0xc9, 0x31, 0x19, 0x5a, 0x14, 0x66,
-	0xd5, 0x00, 0xc0, 0xf0, 0xac, 0xd8, 0x91, 0xba, 0xfa, 0x9f, 0xd3, 0x99, 0x0d, 0x2f, 0xae, 0x1f,
-	0x87, 0xb3, 0x91, 0x5d, 0xbc, 0x76, 0xa0, 0x48, 0x66, 0xbb, 0x67, 0xc2, 0xb9, 0x50, 0x34, 0x8e,
-	0x9f, 0x83, 0x99, 0xf1, 0x75, 0xed, 0xc4, 0x81, 0x22, 0xda, 0xed, 0xcd, 0xb5, 0x07, 0x89, 0x9f,
-	0x9f, 0xc7, 0xb5, 0xf9, 0x12, 0x3f, 0xed, 0x69, 0x7a, 0x7e, 0xe6, 0xd9, 0x45, 0x0d, 0xe2, 0xa0,
-	0x3f, 0x7e, 0x39, 0xaf, 0xb2, 0x8b, 0x6a, 0x4e, 0xcd, 0xe2, 0x55, 0x50, 0x15, 0xfa, 0xc4, 0xc4,
-	0xe3, 0xa2, 0x49, 0xe0, 0x50, 0xda, 0xb3, 0x36, 0x95, 0x98, 0x69, 0x1b, 0x7d, 0xeb, 0xeb, 0x0d,
-	0x70, 0x5d, 0x4f, 0x84, 0xef, 0x40, 0x35, 0xbd, 0xfe, 0x70, 0xd1, 0xd6, 0x3d, 0x49, 0x8d, 0xfb,
-	0xf8, 0x52, 0x5d, 0x6a, 0xcf, 0xf7, 0xdf, 0x7f, 0xff, 0xf3, 0xf1, 0xda, 0x02, 0x74, 0xb1, 0x85,
-	0xe9, 0x94, 0x29, 0xf8, 0xcd, 0x01, 0x70, 0x12, 0x16, 0xd8, 0x2a, 0xdc, 0x51, 0x08, 0x9f, 0xbb,
-	0x72, 0xa5, 0x1e, 0xe3, 0xf1, 0x89, 0xf6, 0xb8, 0x04, 0x1b, 0x36, 0x8f, 0x36, 0x4e, 0xe1, 0x67,
-	0x07, 0x4c, 0xe7, 0x20, 0x81, 0xcb, 0x85, 0x8b, 0x6d, 0xa4, 0xb9, 0xe8, 0x5f, 0xe5, 0xc6, 0xe2,
-	0x92, 0xb6, 0xf8, 0x10, 0xfa, 0x36, 0x8b, 0x79, 0x2a, 0xe1, 0x81, 0x03, 0x66, 0x27, 0xd0, 0x82,
-	0xcd, 0xc2, 0x8d, 0x45, 0xa0, 0xba, 0xad, 0xab, 0xb4, 0x18, 0xa3, 0x48, 0x1b, 0x6d, 0xc0, 0x45,
-	0x9b, 0xd1, 0x49, 0xa6, 0x75, 0x92, 0x39, 0xa2, 0x4a, 0x92, 0xb4, 0x61, 0x59, 0x92, 0xa4, 0x15,
-	0xd4, 0xf2, 0x24, 0xf3, 0x08, 0x6b, 0x2e, 0x34, 0x2b, 0x65, 0x5c, 0x9c, 0x87, 0xb4, 0x8c, 0x8b,
-	0x1c, 0xb6, 0x97, 0x70, 0x91, 0xe2, 0xba, 0x71, 0x78, 0xe2, 0x39, 0x47, 0x27, 0x9e, 0xf3, 0xfb,
-	0xc4, 0x73, 0x3e, 0x9c, 0x7a, 0x95, 0xa3, 0x53, 0xaf, 0xf2, 0xe3, 0xd4, 0xab, 0xbc, 0xc1, 0x11,
-	0x55, 0xbd, 0x41, 0x17, 0x85, 0xbc, 0x6f, 0xfa, 0xd3, 0xdf, 0x61, 0x73, 0x15, 0xef, 0xe7, 0x67,
-	0xa9, 0x91, 0x20, 0xb2, 0x5b, 0xd5, 0x9f, 0xc0, 0x95, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x73,
-	0x0e, 0x29, 0x77, 0xae, 0x07, 0x00, 0x00,
+	// 848 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x4f, 0x4f, 0x3b, 0x45,
+	0x18, 0xc7, 0xbb, 0x3f, 0xa0, 0x86, 0x21, 0x60, 0x18, 0xaa, 0xc1, 0xa5, 0x6c, 0x9b, 0x55, 0xa0,
+	0x21, 0x61, 0x87, 0x96, 0x18, 0xf5, 0x66, 0x5a, 0x3d, 0x60, 0xfc, 0x83, 0xcb, 0xcd, 0x4b, 0xb3,
+	0x5d, 0xc6, 0xed, 0x04, 0x3a, 0xb3, 0x74, 0xa6, 0x0d, 0x8d, 0xe1, 0xe2, 0x2b, 0xd0, 0x70, 0x30,
+	0xd1, 0xa3, 0x37, 0x2e, 0x1e, 0x7c, 0x13, 0x1c, 0x49, 0xbc, 0x18, 0x0f, 0x68, 0xc0, 0x57, 0xe1,
+	0xc9, 0xec, 0xec, 0x6c, 0xe9, 0xb2, 0xb3, 0xa5, 0x24, 0x26, 0x5e, 0x48, 0x77, 0x9e, 0xef, 0xf3,
+	0x3c, 0x9f, 0x7d, 0x9e, 0x9d, 0x6f, 0x00, 0x16, 0x1e, 0xf6, 0x18, 0x47, 0x84, 0x7e, 0x7d, 0xe6,
+	0x09, 0xc2, 0x28, 0x1a, 0xd6, 0xd1, 0xf9, 0x00, 0xf7, 0x47, 0x4e, 0xd8, 0x67, 0x82, 0x41, 0x28,
+	0xe3, 0xce, 0x38, 0xee, 0x0c, 0xeb, 0xa6, 0xe5, 0x33, 0x1e, 0x25, 0x75, 0x3c, 0x8e, 0xd1, 0xb0,
+	0xde, 0xc1, 0xc2, 0xab, 0x23, 0x9f, 0x11, 0x1a, 0xe7, 0x98, 0x55, 0x4d, 0xcd, 0x00, 0x53, 0xcc,
+	0x09, 0x57, 0x0a, 0x5b, 0xa3, 0x78, 0x6c, 0x11, 0x6b, 0x4a, 0x01, 0x0b, 0x98, 0xfc, 0x89, 0xa2,
+	0x5f, 0xea, 0xb4, 0x1c, 0x30, 0x16, 0x9c, 0x61, 0xe4, 0x85, 0x04, 0x79, 0x94, 0x32, 0x21, 0x53,
+	0x54, 0x5d, 0xbb, 0x04, 0xe0, 0x97, 0x11, 0xfc, 0x11, 0xee, 0x13, 0x76, 0xe2, 0xe2, 0xf3, 0x01,
+	0xe6, 0xc2, 0xde, 0x03, 0x6b, 0xa9, 0x53, 0x1e, 0x32, 0xca, 0x31, 0x7c, 0x13, 0x14, 0x43, 0x79,
+	0xb2, 0x6e, 0x54, 0x8d, 0xda, 0xbc, 0xab, 0x9e, 0xec, 0x2a, 0xb0, 0xa4, 0xfc, 0xe3, 0x90, 0xf9,
+	0xdd, 0xcf, 0x08, 0x15, 0x47, 0x7d, 0x36, 0x24, 0x9c, 0x30, 0x9a, 0x14, 0xfc, 0xc9, 0x00, 0x95,
+	0x5c, 0x89, 0xaa, 0x7e, 0x01, 0x4a, 0x38, 0x8a, 0xb6, 0x7b, 0x84, 0x8a, 0x76, 0x98, 0xc4, 0x65,
+	0xaf, 0xa5, 0x46, 0xd9, 0x89, 0x67, 0xe8, 0x44, 0x33, 0x74, 0xd4, 0x0c, 0x9d, 0x8f, 0xb0, 0xdf,
+	0x62, 0x84, 0x36, 0x6b, 0x37, 0x77, 0x95, 0xc2, 0xf5, 0x9f, 0x95, 0x6a, 0x2c, 0xe2, 0x27, 0xa7,
+	0x0e, 0x61, 0xa8, 0xe7, 0x89, 0xae, 0xf3, 0x29, 0x0e, 0x3c, 0x7f, 0xa4, 0x84, 0xdc, 0x85, 0x38,
+	0x43, 0x60, 0x6f, 0x80, 0xb7, 0x24, 0xdc, 0xf1, 0x29, 0x09, 0x43, 0x7c, 0x22, 0x19, 0x79, 0x82,
+	0xde, 0x02, 0xa6, 0x2e, 0xa8, 0xa0, 0xb7, 0xc0, 0x0a, 0x8f, 0x03, 0x6d, 0x59, 0x98, 0xab, 0xd1,
+	0x2c, 0xf3, 0x49, 0xb9, 0x5d, 0x01, 0x9b, 0xb2, 0x48, 0x8b, 0xf4, 0xfd, 0x41, 0xb4, 0x34, 0x1a,
+	0x1c, 0x0f, 0xc2, 0xf0, 0x6c, 0x94, 0x74, 0xf9, 0xc1, 0x00, 0x56, 0x9e, 0x42, 0xb5, 0x1a, 0x00,
+	0xe8, 0x3f, 0x06, 0xdb, 0x5c, 0x46, 0xff, 0xe3, 0xe9, 0xac, 0xfa, 0x4f, 0xdb, 0x8f, 0x87, 0x73,
+	0x98, 0x7c, 0x6d, 0xae, 0x27, 0x70, 0x82, 0xdd, 0x05, 0xa6, 0x2e, 0xa8, 0x88, 0x3f, 0x01, 0x2b,
+	0xe3, 0x6f, 0xb4, 0xdd, 0xf7, 0x04, 0x96, 0xb4, 0x8b, 0xcd, 0xb7, 0x23, 0x9e, 0x3f, 0xee, 0x2a,
+	0x1b, 0x53, 0x78, 0xdc, 0x65, 0x32, 0x59, 0xf3, 0xf1, 0x43, 0xf5, 0xfa, 0x5e, 0x6f, 0xbc, 0x9c,
+	0x2f, 0xc0, 0x5a, 0xea, 0x54, 0x35, 0x7e, 0x1f, 0x14, 0x43, 0x79, 0xa2, 0xc6, 0x63, 0x3a, 0xd9,
+	0x4b, 0xe9, 0xc4, 0x39, 0xcd, 0xf9, 0x08, 0xc6, 0x55, 0x7a, 0xfb, 0x5d, 0xb5, 0x28, 0xb9, 0xb7,
+	0x78, 0x02, 0xad, 0xae, 0x47, 0x83, 0xe4, 0x8d, 0x61, 0x09, 0x2c, 0xc8, 0x45, 0xcb, 0xca, 0x73,
+	0x6e, 0xfc, 0x60, 0x5f, 0x19, 0xc0, 0xca, 0xcb, 0x53, 0x4c, 0x1f, 0x82, 0xd7, 0x7c, 0x79, 0x12,
+	0x41, 0xcd, 0xd5, 0x96, 0x1a, 0x55, 0x1d, 0xd4, 0x64, 0xaa, 0x42, 0x4b, 0xd2, 0x20, 0x02, 0x73,
+	0x14, 0x8b, 0xf5, 0x57, 0x72, 0x86, 0x9b, 0x6a, 0x86, 0x6f, 0x64, 0x67, 0x78, 0x48, 0x85, 0x1b,
+	0x29, 0xed, 0x4d, 0xb0, 0x21, 0xa1, 0x3e, 0xc7, 0x42, 0xf3, 0x2a, 0xf6, 0xf7, 0x06, 0x28, 0xeb,
+	0xe3, 0xff, 0x1b, 0x72, 0xe3, 0x9f, 0x45, 0xb0, 0x20, 0x99, 0xe0, 0x25, 0x28, 0xc6, 0xf6, 0x03,
+	0xb7, 0x75, 0x5d, 0xb3, 0xae, 0x65, 0xee, 0x3c, 0xab, 0x8b, 0xdf, 0xcb, 0xb6, 0xbf, 0xfd, 0xed,
+	0xef, 0xab, 0x57, 0x65, 0x68, 0x22, 0x8d, 0xab, 0xc6, 0x9e, 0x06, 0x7f, 0x31, 0x00, 0xcc, 0x9a,
+	0x15, 0x6c, 0xe4, 0xf6, 0xc8, 0x35, 0x3f, 0xf3, 0xe0, 0x45, 0x39, 0x8a, 0x71, 0x5f, 0x32, 0xee,
+	0xc2, 0x9a, 0x8e, 0x51, 0xe7, 0x93, 0xf0, 0x47, 0x03, 0x2c, 0xa7, 0x4c, 0x0a, 0xee, 0xe5, 0x36,
+	0xd6, 0x39, 0x9d, 0xe9, 0xcc, 0x2a, 0x57, 0x88, 0xbb, 0x12, 0xf1, 0x1d, 0x68, 0xeb, 0x10, 0xd3,
+	0xae, 0x08, 0xaf, 0x0d, 0xb0, 0x9a, 0xb1, 0x36, 0x58, 0xcf, 0xed, 0x98, 0x67, 0x94, 0x66, 0xe3,
+	0x25, 0x29, 0x0a, 0xd4, 0x91, 0xa0, 0x35, 0xb8, 0xad, 0x03, 0xcd, 0x7a, 0xaa, 0x9c, 0x64, 0xca,
+	0xd1, 0xa6, 0x4c, 0x52, 0x67, 0x8b, 0xa6, 0x33, 0xab, 0x7c, 0x96, 0x49, 0xa6, 0x2d, 0x54, 0xde,
+	0x0b, 0xe9, 0x55, 0xd3, 0xee, 0xc5, 0xa4, 0x49, 0x9a, 0x3b, 0xcf, 0xea, 0x66, 0xba, 0x17, 0x71,
+	0xd3, 0x5f, 0x0d, 0xb0, 0x9a, 0x31, 0xb9, 0x29, 0x8b, 0xcc, 0x33, 0x52, 0xb3, 0xf1, 0x92, 0x14,
+	0x05, 0xf8, 0x9e, 0x04, 0xac, 0x43, 0x94, 0x7f, 0x29, 0xe2, 0x15, 0xb6, 0x63, 0x03, 0x42, 0xdf,
+	0xc8, 0xc3, 0x4b, 0xf8, 0xb3, 0x01, 0x5e, 0x7f, 0xe2, 0x72, 0x10, 0xe5, 0x02, 0xe8, 0xfd, 0xd2,
+	0xdc, 0x9f, 0x3d, 0x41, 0xf1, 0xee, 0x49, 0xde, 0x1d, 0xb8, 0xa5, 0xe3, 0xa5, 0x58, 0xa4, 0x69,
+	0x9b, 0x87, 0x37, 0xf7, 0x96, 0x71, 0x7b, 0x6f, 0x19, 0x7f, 0xdd, 0x5b, 0xc6, 0x77, 0x0f, 0x56,
+	0xe1, 0xf6, 0xc1, 0x2a, 0xfc, 0xfe, 0x60, 0x15, 0xbe, 0x42, 0x01, 0x11, 0xdd, 0x41, 0xc7, 0xf1,
+	0x59, 0x4f, 0x95, 0x8a, 0xff, 0x0e, 0xeb, 0x1f, 0xa0, 0x8b, 0x74, 0x59, 0x31, 0x0a, 0x31, 0xef,
+	0x14, 0xe5, 0xbf, 0x77, 0x07, 0xff, 0x0e, 0x00, 0x5f, 0xf4, 0x6d, 0xba, 0xae, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -619,6 +818,12 @@ type QueryClient interface {
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EpochSupplyChange retrieves the supply changes of the mint denom during
+	// the given epoch, by source.
+	EpochSupplyChange(ctx context.Context, in *QueryEpochSupplyChangeRequest, opts ...grpc.CallOption) (*QueryEpochSupplyChangeResponse, error)
+	// NetSupplyChange retrieves the cumulative supply changes of the mint denom,
+	// by source.
+	NetSupplyChange(ctx context.Context, in *QueryNetSupplyChangeRequest, opts ...grpc.CallOption) (*QueryNetSupplyChangeResponse, error)
 }
 
 type queryClient struct {
@@ -683,6 +888,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) EpochSupplyChange(ctx context.Context, in *QueryEpochSupplyChangeRequest, opts ...grpc.CallOption) (*QueryEpochSupplyChangeResponse, error) {
+	out := new(QueryEpochSupplyChangeResponse)
+	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/EpochSupplyChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NetSupplyChange(ctx context.Context, in *QueryNetSupplyChangeRequest, opts ...grpc.CallOption) (*QueryNetSupplyChangeResponse, error) {
+	out := new(QueryNetSupplyChangeResponse)
+	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/NetSupplyChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Period retrieves current period.
@@ -698,6 +921,12 @@ type QueryServer interface {
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EpochSupplyChange retrieves the supply changes of the mint denom during
+	// the given epoch, by source.
+	EpochSupplyChange(context.Context, *QueryEpochSupplyChangeRequest) (*QueryEpochSupplyChangeResponse, error)
+	// NetSupplyChange retrieves the cumulative supply changes of the mint denom,
+	// by source.
+	NetSupplyChange(context.Context, *QueryNetSupplyChangeRequest) (*QueryNetSupplyChangeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -722,6 +951,12 @@ func (*UnimplementedQueryServer) InflationRate(ctx context.Context, req *QueryIn
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) EpochSupplyChange(ctx context.Context, req *QueryEpochSupplyChangeRequest) (*QueryEpochSupplyChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochSupplyChange not implemented")
+}
+func (*UnimplementedQueryServer) NetSupplyChange(ctx context.Context, req *QueryNetSupplyChangeRequest) (*QueryNetSupplyChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetSupplyChange not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -835,6 +1070,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochSupplyChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochSupplyChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochSupplyChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.inflation.v1.Query/EpochSupplyChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochSupplyChange(ctx, req.(*QueryEpochSupplyChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NetSupplyChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetSupplyChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetSupplyChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.inflation.v1.Query/NetSupplyChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetSupplyChange(ctx, req.(*QueryNetSupplyChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.inflation.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -863,6 +1134,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "EpochSupplyChange",
+			Handler:    _Query_EpochSupplyChange_Handler,
+		},
+		{
+			MethodName: "NetSupplyChange",
+			Handler:    _Query_NetSupplyChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/inflation/v1/query.proto",
@@ -1194,6 +1473,151 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochSupplyChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSupplyChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSupplyChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochSupplyChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSupplyChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSupplyChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Net.Size()
+		i -= size
+		if _, err := m.Net.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetSupplyChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetSupplyChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetSupplyChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetSupplyChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetSupplyChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetSupplyChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Net.Size()
+		i -= size
+		if _, err := m.Net.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1327,13 +1751,68 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochSupplyChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryEpochSupplyChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Net.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNetSupplyChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNetSupplyChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Net.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,6 +2583,361 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochSupplyChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSupplyChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSupplyChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochSupplyChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSupplyChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSupplyChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, SupplyChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Net", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Net.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetSupplyChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetSupplyChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetSupplyChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetSupplyChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetSupplyChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetSupplyChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, SupplyChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Net", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Net.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SupplySourceInflation is the source of the supply changes of the inflation
+// mint.
+const SupplySourceInflation = ModuleName
+
+// SupplyChangeHooks defines the hooks called by the modules minting or burning
+// coins, so that the supply changes of the mint denom are accounted by source
+// and epoch.
+type SupplyChangeHooks interface {
+	AfterCoinsMinted(ctx sdk.Context, source string, coins sdk.Coins)
+	AfterCoinsBurned(ctx sdk.Context, source string, coins sdk.Coins)
+}
+
+// NewSupplyChange returns an empty supply change of the given source.
+func NewSupplyChange(source string) SupplyChange {
+	return SupplyChange{
+		Source: source,
+		Minted: math.ZeroInt(),
+		Burned: math.ZeroInt(),
+	}
+}
+
+// Net returns the minted amount minus the burned amount.
+func (sc SupplyChange) Net() math.Int {
+	return sc.Minted.Sub(sc.Burned)
+}
+
+// NetSupplyChange returns the sum of the net amounts of the given supply
+// changes.
+func NetSupplyChange(changes []SupplyChange) math.Int {
+	net := math.ZeroInt()
+	for _, change := range changes {
+		net = net.Add(change.Net())
+	}
+	return net
+}
@@ -0,0 +1,411 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package cli
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/x/vesting/types"
+)
+
+// Statuses of the grants written to the report.
+const (
+	// GrantStatusInvalid is the status of the rows that can't be parsed or validated.
+	GrantStatusInvalid = "invalid"
+	// GrantStatusUnsigned is the status of the grants written to an unsigned tx file.
+	GrantStatusUnsigned = "unsigned"
+	// GrantStatusBroadcast is the status of the grants of a tx accepted by the node.
+	GrantStatusBroadcast = "broadcast"
+	// GrantStatusFailed is the status of the grants of a tx rejected by the node.
+	GrantStatusFailed = "failed"
+	// GrantStatusSkipped is the status of the grants not broadcast after a
+	// broadcast error.
+	GrantStatusSkipped = "skipped"
+)
+
+// grantColumns are the columns of the rows of a grants CSV file.
+var grantColumns = []string{"address", "lockup", "vesting", "amount"}
+
+// Grant is the lockup and vesting schedules granted to a clawback vesting
+// account, read from a row of a grants CSV file.
+type Grant struct {
+	// Row is the line number of the grant in the CSV file.
+	Row            int
+	VestingAddress sdk.AccAddress
+	StartTime      int64
+	LockupPeriods  sdkvesting.Periods
+	VestingPeriods sdkvesting.Periods
+	Amount         sdk.Coins
+}
+
+// Msg returns the msg funding the grant from the given funder.
+func (g Grant) Msg(funder sdk.AccAddress) *types.MsgFundVestingAccount {
+	return types.NewMsgFundVestingAccount(funder, g.VestingAddress, time.Unix(g.StartTime, 0), g.LockupPeriods, g.VestingPeriods)
+}
+
+// GrantResult is the outcome of a row of a grants CSV file, written to the
+// report.
+type GrantResult struct {
+	Row     int
+	Address string
+	Amount  string
+	// Batch is the index of the tx including the grant, starting from 1.
+	Batch int
+	// Sequence is the sequence of the funder signing the tx of the batch.
+	Sequence uint64
+	Status   string
+	TxHash   string
+	Error    string
+}
+
+// ReadGrantsFile reads the grants of the CSV file at path. Each row holds the
+// address of a clawback vesting account, the paths of its lockup and vesting
+// schedule files, either of which can be empty, and the amount granted, which
+// must match the total of the given schedules. The schedule paths are
+// relative to the directory of the CSV file. A header row is skipped.
+//
+// The rows that can't be parsed are returned as invalid results, along with
+// the grants of the valid rows.
+func ReadGrantsFile(path string) ([]Grant, []GrantResult, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1 // the number of fields is validated per row
+	reader.TrimLeadingSpace = true
+
+	var (
+		grants  []Grant
+		invalid []GrantResult
+		dir     = filepath.Dir(path)
+	)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			// the quoting errors only affect the current row
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, nil, err
+			}
+			invalid = append(invalid, GrantResult{Row: parseErr.Line, Status: GrantStatusInvalid, Error: parseErr.Err.Error()})
+			continue
+		}
+
+		row, _ := reader.FieldPos(0)
+		if row == 1 && strings.EqualFold(record[0], grantColumns[0]) {
+			continue
+		}
+
+		grant, err := parseGrant(dir, record)
+		if err != nil {
+			result := GrantResult{Row: row, Status: GrantStatusInvalid, Error: err.Error()}
+			if len(record) > 0 {
+				result.Address = record[0]
+			}
+			invalid = append(invalid, result)
+			continue
+		}
+
+		grant.Row = row
+		grants = append(grants, grant)
+	}
+
+	return grants, invalid, nil
+}
+
+// parseGrant parses the grant of the given CSV record.
+func parseGrant(dir string, record []string) (Grant, error) {
+	if len(record) != len(grantColumns) {
+		return Grant{}, fmt.Errorf("expected %d columns (%s), got %d", len(grantColumns), strings.Join(grantColumns, ", "), len(record))
+	}
+
+	address, err := parseGrantAddress(record[0])
+	if err != nil {
+		return Grant{}, err
+	}
+
+	amount, err := sdk.ParseCoinsNormalized(record[3])
+	if err != nil {
+		return Grant{}, fmt.Errorf("invalid amount: %w", err)
+	}
+	if amount.IsZero() {
+		return Grant{}, errors.New("amount cannot be zero")
+	}
+
+	var (
+		lockupStart, vestingStart     int64
+		lockupPeriods, vestingPeriods sdkvesting.Periods
+	)
+
+	lockupFile, vestingFile := record[1], record[2]
+	if lockupFile == "" && vestingFile == "" {
+		return Grant{}, errors.New("must specify at least one of the lockup or vesting schedules")
+	}
+	if lockupFile != "" {
+		lockupStart, lockupPeriods, err = ReadScheduleFile(schedulePath(dir, lockupFile))
+		if err != nil {
+			return Grant{}, fmt.Errorf("invalid lockup schedule: %w", err)
+		}
+		if total := lockupPeriods.TotalAmount(); !total.IsEqual(amount) {
+			return Grant{}, fmt.Errorf("lockup schedule total %s doesn't match the amount %s", total, amount)
+		}
+	}
+	if vestingFile != "" {
+		vestingStart, vestingPeriods, err = ReadScheduleFile(schedulePath(dir, vestingFile))
+		if err != nil {
+			return Grant{}, fmt.Errorf("invalid vesting schedule: %w", err)
+		}
+		if total := vestingPeriods.TotalAmount(); !total.IsEqual(amount) {
+			return Grant{}, fmt.Errorf("vesting schedule total %s doesn't match the amount %s", total, amount)
+		}
+	}
+
+	// an absent schedule starts along with the given one
+	if lockupFile == "" {
+		lockupStart = vestingStart
+	}
+	if vestingFile == "" {
+		vestingStart = lockupStart
+	}
+	commonStart, _ := types.AlignSchedules(lockupStart, vestingStart, lockupPeriods, vestingPeriods)
+
+	grant := Grant{
+		VestingAddress: address,
+		StartTime:      commonStart,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+		Amount:         amount,
+	}
+
+	// the funder is only known when sending the grants, any valid address is
+	// used to run the stateless checks of the msg
+	if err := grant.Msg(address).ValidateBasic(); err != nil {
+		return Grant{}, err
+	}
+
+	return grant, nil
+}
+
+// parseGrantAddress parses a bech32 or hex address.
+func parseGrantAddress(address string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q: %w", address, err)
+	}
+	return addr, nil
+}
+
+// schedulePath returns the path of a schedule file given in a CSV file of the
+// given directory.
+func schedulePath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// GrantsTotal returns the total amount of the given grants.
+func GrantsTotal(grants []Grant) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, grant := range grants {
+		total = total.Add(grant.Amount...)
+	}
+	return total
+}
+
+// BatchGrants splits the given grants into batches of at most batchSize
+// grants, each batch being sent in a single tx.
+func BatchGrants(grants []Grant, batchSize int) [][]Grant {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	batches := make([][]Grant, 0, (len(grants)+batchSize-1)/batchSize)
+	for start := 0; start < len(grants); start += batchSize {
+		end := start + batchSize
+		if end > len(grants) {
+			end = len(grants)
+		}
+		batches = append(batches, grants[start:end])
+	}
+	return batches
+}
+
+// grantMsgs returns the msgs funding the given grants from the given funder.
+func grantMsgs(grants []Grant, funder sdk.AccAddress) []sdk.Msg {
+	msgs := make([]sdk.Msg, len(grants))
+	for i, grant := range grants {
+		msgs[i] = grant.Msg(funder)
+	}
+	return msgs
+}
+
+// grantTxFactory returns the factory of the tx of the given msgs, signed with
+// the given sequence.
+type grantTxFactory func(msgs []sdk.Msg, sequence uint64) (tx.Factory, error)
+
+// writeUnsignedGrantBatches writes the unsigned tx of each of the given
+// batches to a batch-<index>.json file of the given directory. The txs are
+// expected to be signed with consecutive sequences of the funder, starting
+// from the given one.
+func writeUnsignedGrantBatches(clientCtx client.Context, batches [][]Grant, sequence uint64, dir string, txFactory grantTxFactory) ([]GrantResult, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	var results []GrantResult
+	for i, batch := range batches {
+		msgs := grantMsgs(batch, clientCtx.GetFromAddress())
+		txf, err := txFactory(msgs, sequence)
+		if err != nil {
+			return nil, err
+		}
+
+		txBuilder, err := txf.BuildUnsignedTx(msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("batch-%d.json", i+1)), bz, 0o600); err != nil {
+			return nil, err
+		}
+
+		for _, grant := range batch {
+			results = append(results, grantResult(grant, i+1, sequence, GrantStatusUnsigned, "", ""))
+		}
+		sequence++
+	}
+
+	return results, nil
+}
+
+// grantBroadcaster signs a tx with the given msgs and sequence, and broadcasts
+// it.
+type grantBroadcaster func(msgs []sdk.Msg, sequence uint64) (*sdk.TxResponse, error)
+
+// broadcastGrantBatches signs and broadcasts the given batches in order,
+// starting from the given sequence of the funder.
+//
+// The sequence is only incremented for the txs accepted by the node, so that
+// the batches following a rejected tx are still signed with the next sequence
+// of the funder. As the state of the sequence is unknown after a broadcast
+// error, the remaining batches are skipped.
+func broadcastGrantBatches(batches [][]Grant, funder sdk.AccAddress, sequence uint64, broadcast grantBroadcaster) []GrantResult {
+	var (
+		results      []GrantResult
+		broadcastErr error
+	)
+	for i, batch := range batches {
+		if broadcastErr != nil {
+			errMsg := fmt.Sprintf("skipped after a broadcast error: %s", broadcastErr)
+			for _, grant := range batch {
+				results = append(results, grantResult(grant, i+1, 0, GrantStatusSkipped, "", errMsg))
+			}
+			continue
+		}
+
+		var (
+			status, txHash, errMsg string
+			batchSequence          = sequence
+		)
+
+		res, err := broadcast(grantMsgs(batch, funder), sequence)
+		switch {
+		case err != nil:
+			broadcastErr = err
+			status, errMsg = GrantStatusFailed, err.Error()
+		case res.Code != 0:
+			status, txHash, errMsg = GrantStatusFailed, res.TxHash, res.RawLog
+		default:
+			status, txHash = GrantStatusBroadcast, res.TxHash
+			sequence++
+		}
+
+		for _, grant := range batch {
+			results = append(results, grantResult(grant, i+1, batchSequence, status, txHash, errMsg))
+		}
+	}
+
+	return results
+}
+
+// grantResult returns the result of the given grant.
+func grantResult(grant Grant, batch int, sequence uint64, status, txHash, errMsg string) GrantResult {
+	return GrantResult{
+		Row:      grant.Row,
+		Address:  grant.VestingAddress.String(),
+		Amount:   grant.Amount.String(),
+		Batch:    batch,
+		Sequence: sequence,
+		Status:   status,
+		TxHash:   txHash,
+		Error:    errMsg,
+	}
+}
+
+// WriteGrantsReport writes the given results to a CSV report at path.
+func WriteGrantsReport(path string, results []GrantResult) error {
+	file, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write([]string{"row", "address", "amount", "batch", "sequence", "status", "tx_hash", "error"}); err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		var batch, sequence string
+		if result.Batch > 0 {
+			batch = strconv.Itoa(result.Batch)
+		}
+		// the skipped batches are not signed
+		if result.Batch > 0 && result.Status != GrantStatusSkipped {
+			sequence = strconv.FormatUint(result.Sequence, 10)
+		}
+
+		record := []string{strconv.Itoa(result.Row), result.Address, result.Amount, batch, sequence, result.Status, result.TxHash, result.Error}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+	return file.Close()
+}
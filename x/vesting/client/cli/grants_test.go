@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"encoding/csv"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestReadGrantsFile(t *testing.T) {
+	grants, invalid, err := ReadGrantsFile(filepath.Join("testdata", "grants.csv"))
+	require.NoError(t, err)
+
+	require.Len(t, grants, 3)
+	for i, expRow := range []int{2, 3, 10} {
+		require.Equal(t, expRow, grants[i].Row)
+		require.Equal(t, "1000aevmos", grants[i].Amount.String())
+	}
+
+	// the schedules are aligned on the earliest start time
+	require.Equal(t, common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes(), grants[0].VestingAddress.Bytes())
+	require.Equal(t, int64(1700000000), grants[0].StartTime)
+	require.Equal(t, int64(2592000), grants[0].LockupPeriods[0].Length)
+	require.Equal(t, int64(2592000+86400), grants[0].VestingPeriods[0].Length)
+
+	// an absent schedule is left to the default instant schedule of the msg
+	require.Empty(t, grants[1].LockupPeriods)
+	require.Len(t, grants[1].VestingPeriods, 2)
+	require.Equal(t, int64(1700086400), grants[1].StartTime)
+	require.Equal(t, int64(2592000), grants[1].VestingPeriods[0].Length)
+	require.Empty(t, grants[2].VestingPeriods)
+
+	expInvalid := []struct {
+		row    int
+		errMsg string
+	}{
+		{4, "invalid address"},
+		{5, "lockup schedule total 1000aevmos doesn't match the amount 2000aevmos"},
+		{6, "expected 4 columns"},
+		{7, "must specify at least one of the lockup or vesting schedules"},
+		{8, "invalid amount"},
+		{9, "invalid lockup schedule"},
+	}
+	require.Len(t, invalid, len(expInvalid))
+	for i, exp := range expInvalid {
+		require.Equal(t, exp.row, invalid[i].Row)
+		require.Equal(t, GrantStatusInvalid, invalid[i].Status)
+		require.Contains(t, invalid[i].Error, exp.errMsg)
+	}
+}
+
+func TestBatchGrants(t *testing.T) {
+	grants := make([]Grant, 7)
+	for i := range grants {
+		grants[i].Row = i + 2
+	}
+
+	batches := BatchGrants(grants, 3)
+	require.Len(t, batches, 3)
+	require.Len(t, batches[0], 3)
+	require.Len(t, batches[1], 3)
+	require.Len(t, batches[2], 1)
+	require.Equal(t, 8, batches[2][0].Row)
+
+	require.Len(t, BatchGrants(grants, 0), 7)
+	require.Empty(t, BatchGrants(nil, 3))
+}
+
+func TestBroadcastGrantBatches(t *testing.T) {
+	funder := sdk.AccAddress(common.HexToAddress("0xf").Bytes())
+	grants, _, err := ReadGrantsFile(filepath.Join("testdata", "grants.csv"))
+	require.NoError(t, err)
+	batches := BatchGrants(grants, 1)
+
+	testCases := []struct {
+		name         string
+		responses    []*sdk.TxResponse
+		errs         []error
+		expSequences []uint64
+		expStatuses  []string
+	}{
+		{
+			"all batches broadcast",
+			[]*sdk.TxResponse{{TxHash: "A"}, {TxHash: "B"}, {TxHash: "C"}},
+			[]error{nil, nil, nil},
+			[]uint64{5, 6, 7},
+			[]string{GrantStatusBroadcast, GrantStatusBroadcast, GrantStatusBroadcast},
+		},
+		{
+			"rejected batch keeps the sequence",
+			[]*sdk.TxResponse{{TxHash: "A"}, {TxHash: "B", Code: 5, RawLog: "insufficient funds"}, {TxHash: "C"}},
+			[]error{nil, nil, nil},
+			[]uint64{5, 6, 6},
+			[]string{GrantStatusBroadcast, GrantStatusFailed, GrantStatusBroadcast},
+		},
+		{
+			"broadcast error skips the remaining batches",
+			[]*sdk.TxResponse{{TxHash: "A"}, nil},
+			[]error{nil, errors.New("connection refused")},
+			[]uint64{5, 6},
+			[]string{GrantStatusBroadcast, GrantStatusFailed, GrantStatusSkipped},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var sequences []uint64
+			results := broadcastGrantBatches(batches, funder, 5, func(msgs []sdk.Msg, sequence uint64) (*sdk.TxResponse, error) {
+				require.Len(t, msgs, 1)
+				i := len(sequences)
+				sequences = append(sequences, sequence)
+				return tc.responses[i], tc.errs[i]
+			})
+
+			require.Equal(t, tc.expSequences, sequences)
+			require.Len(t, results, len(grants))
+			for i, result := range results {
+				require.Equal(t, grants[i].Row, result.Row)
+				require.Equal(t, i+1, result.Batch)
+				require.Equal(t, tc.expStatuses[i], result.Status)
+			}
+		})
+	}
+}
+
+func TestWriteGrantsReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.csv")
+	results := []GrantResult{
+		{Row: 2, Address: "evmos1a", Amount: "1000aevmos", Batch: 1, Sequence: 5, Status: GrantStatusBroadcast, TxHash: "A"},
+		{Row: 3, Address: "evmos1b", Amount: "1000aevmos", Batch: 2, Sequence: 6, Status: GrantStatusFailed, TxHash: "B", Error: "out of gas, with a comma"},
+		{Row: 4, Address: "evmos1c", Amount: "1000aevmos", Batch: 3, Status: GrantStatusSkipped},
+		{Row: 5, Address: "0xinvalid", Status: GrantStatusInvalid, Error: "invalid address"},
+	}
+	require.NoError(t, WriteGrantsReport(path, results))
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"row", "address", "amount", "batch", "sequence", "status", "tx_hash", "error"},
+		{"2", "evmos1a", "1000aevmos", "1", "5", GrantStatusBroadcast, "A", ""},
+		{"3", "evmos1b", "1000aevmos", "2", "6", GrantStatusFailed, "B", "out of gas, with a comma"},
+		{"4", "evmos1c", "1000aevmos", "3", "", GrantStatusSkipped, "", ""},
+		{"5", "0xinvalid", "", "", "", GrantStatusInvalid, "", "invalid address"},
+	}, records)
+}
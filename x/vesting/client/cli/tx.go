@@ -4,18 +4,24 @@
 package cli
 
 import (
+	"bufio"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
@@ -29,6 +35,12 @@ const (
 	FlagVesting  = "vesting"
 	FlagClawback = "clawback"
 	FlagFunder   = "funder"
+
+	FlagCSV         = "csv"
+	FlagBatchSize   = "batch-size"
+	FlagGasPerGrant = "gas-per-grant"
+	FlagOutputDir   = "output-dir"
+	FlagReport      = "report"
 )
 
 // NewTxCmd returns a root CLI command handler for vesting
@@ -48,6 +60,7 @@ func NewTxCmd() *cobra.Command {
 		NewMsgClawbackCmd(),
 		NewMsgUpdateVestingFunderCmd(),
 		NewMsgConvertVestingAccountCmd(),
+		NewCreateClawbackAccountsCmd(),
 	)
 
 	return txCmd
@@ -271,6 +284,168 @@ func NewMsgConvertVestingAccountCmd() *cobra.Command {
 	return cmd
 }
 
+// NewCreateClawbackAccountsCmd returns a CLI command handler for funding the
+// grants of many clawback vesting accounts listed in a CSV file.
+func NewCreateClawbackAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-accounts --csv GRANTS_FILE",
+		Short: "Fund the grants of many clawback vesting accounts from a CSV file.",
+		Long: `Fund the lockup and vesting schedules of the clawback vesting accounts listed in a CSV file, from the --from address.
+The accounts must have been created with the create-clawback-vesting-account subcommand, designating the --from address as their funder.
+
+Each row of the CSV file holds the address of a vesting account, the paths of its lockup and vesting periods files,
+either of which can be empty, and the amount granted, which must match the total of the given schedules.
+The paths are relative to the directory of the CSV file, and the periods files have the format of the fund-vesting-account subcommand.
+
+The rows are validated and their total is checked against the spendable balance of the funder before any tx is signed.
+The grants are then sent in batches of --batch-size grants per tx, each batch being signed with the next sequence of the funder.
+With --gas-per-grant, the batches are also bounded by the gas limit of the txs (--gas).
+A report with the status and tx hash of every row is written to --report.
+
+With --dry-run, the txs are not signed and --from takes the address of the funder: a file holding the unsigned tx
+of each batch is written to --output-dir, to be signed offline with the sign subcommand and the sequences written to the report.`,
+		Example: fmt.Sprintf(`$ %s tx vesting create-clawback-accounts --csv grants.csv --batch-size 50 --from funder
+
+Sample CSV file contents:
+address,lockup,vesting,amount
+evmos1...,lockup.json,vesting.json,1000aevmos
+0x...,,vesting.json,1000aevmos`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			csvFile, _ := cmd.Flags().GetString(FlagCSV)
+			batchSize, _ := cmd.Flags().GetInt(FlagBatchSize)
+			gasPerGrant, _ := cmd.Flags().GetUint64(FlagGasPerGrant)
+			outputDir, _ := cmd.Flags().GetString(FlagOutputDir)
+			reportFile, _ := cmd.Flags().GetString(FlagReport)
+			if reportFile == "" {
+				reportFile = strings.TrimSuffix(csvFile, filepath.Ext(csvFile)) + "-report.csv"
+			}
+
+			grants, invalid, err := ReadGrantsFile(csvFile)
+			if err != nil {
+				return err
+			}
+			if len(invalid) > 0 {
+				if err := WriteGrantsReport(reportFile, invalid); err != nil {
+					return err
+				}
+				return fmt.Errorf("%d invalid rows in %s, see the report at %s", len(invalid), csvFile, reportFile)
+			}
+			if len(grants) == 0 {
+				return fmt.Errorf("no grants in %s", csvFile)
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf, err = txf.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			if gasPerGrant > 0 && !txf.SimulateAndExecute() {
+				maxGrants := txf.Gas() / gasPerGrant
+				if maxGrants == 0 {
+					return fmt.Errorf("gas limit %d cannot cover a grant of %d gas", txf.Gas(), gasPerGrant)
+				}
+				if maxGrants < uint64(batchSize) {
+					batchSize = int(maxGrants)
+				}
+			}
+
+			total := GrantsTotal(grants)
+			if !clientCtx.Offline {
+				res, err := banktypes.NewQueryClient(clientCtx).SpendableBalances(cmd.Context(), &banktypes.QuerySpendableBalancesRequest{
+					Address: clientCtx.GetFromAddress().String(),
+				})
+				if err != nil {
+					return err
+				}
+				if !res.Balances.IsAllGTE(total) {
+					return fmt.Errorf("funder spendable balance %s is lower than the total of the grants %s", res.Balances, total)
+				}
+			}
+
+			batches := BatchGrants(grants, batchSize)
+
+			if !clientCtx.Simulate && !clientCtx.SkipConfirm {
+				msg := fmt.Sprintf("fund %d grants for a total of %s in %d txs", len(grants), total, len(batches))
+				ok, err := input.GetConfirmation(msg, bufio.NewReader(clientCtx.Input), os.Stderr)
+				if err != nil || !ok {
+					_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled transactions")
+					return err
+				}
+			}
+
+			// the gas of the txs is simulated with the sequence of the funder
+			// account, as the previous batches are not committed yet
+			simTxf := txf
+			txFactory := func(msgs []sdk.Msg, sequence uint64) (tx.Factory, error) {
+				txf := txf.WithSequence(sequence)
+				if txf.SimulateAndExecute() {
+					_, adjusted, err := tx.CalculateGas(clientCtx, simTxf, msgs...)
+					if err != nil {
+						return txf, err
+					}
+					txf = txf.WithGas(adjusted)
+				}
+				return txf, nil
+			}
+
+			var results []GrantResult
+			if clientCtx.Simulate {
+				results, err = writeUnsignedGrantBatches(clientCtx, batches, txf.Sequence(), outputDir, txFactory)
+			} else {
+				results = broadcastGrantBatches(batches, clientCtx.GetFromAddress(), txf.Sequence(), func(msgs []sdk.Msg, sequence uint64) (*sdk.TxResponse, error) {
+					txf, err := txFactory(msgs, sequence)
+					if err != nil {
+						return nil, err
+					}
+
+					txBuilder, err := txf.BuildUnsignedTx(msgs...)
+					if err != nil {
+						return nil, err
+					}
+					if err := tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true); err != nil {
+						return nil, err
+					}
+
+					txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+					if err != nil {
+						return nil, err
+					}
+					return clientCtx.BroadcastTx(txBytes)
+				})
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := WriteGrantsReport(reportFile, results); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("%d grants in %d txs, see the report at %s\n", len(grants), len(batches), reportFile))
+		},
+	}
+
+	cmd.Flags().String(FlagCSV, "", "path to the CSV file listing the grants")
+	cmd.Flags().Int(FlagBatchSize, 50, "maximum number of grants per tx")
+	cmd.Flags().Uint64(FlagGasPerGrant, 0, "gas consumed by a grant, bounding the number of grants per tx to the gas limit (disabled if zero)")
+	cmd.Flags().String(FlagOutputDir, ".", "directory of the unsigned tx files written with --dry-run")
+	cmd.Flags().String(FlagReport, "", "path to the CSV report (defaults to the CSV file path with a -report suffix)")
+	if err := cmd.MarkFlagRequired(FlagCSV); err != nil {
+		panic(err)
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewClawbackProposalCmd implements the command to submit
 // a proposal to clawback funds from a specified vesting account,
 // that has this functionality enabled.